@@ -2,10 +2,16 @@ package app
 
 import (
 	"techmind/internal/connetions/elasticsearch"
+	"techmind/internal/connetions/embedding"
 	"techmind/internal/connetions/gotenberg"
 	"techmind/internal/connetions/minio"
+	"techmind/internal/connetions/ocr"
 	"techmind/internal/connetions/postgres"
+	"techmind/internal/connetions/search"
 	"techmind/internal/di"
+	"techmind/internal/search/elastic"
+	"techmind/internal/search/outbox"
+	"techmind/internal/search/semantic"
 
 	"go.uber.org/fx"
 )
@@ -15,8 +21,16 @@ var App = fx.Options(
 		postgres.New,
 		minio.New,
 		elasticsearch.New,
+		elastic.NewIndices,
+		search.New,
 		gotenberg.New,
+		ocr.New,
+		embedding.New,
+		semantic.NewEmbedder,
+		outbox.NewNotifier,
 	),
+	// Изменения документов попадают в outbox поискового индекса через хуки ent
+	fx.Invoke(outbox.RegisterHooks),
 	di.Repository,
 	di.Service,
 	di.Transport,
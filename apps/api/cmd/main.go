@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"techmind/app"
 	"techmind/internal/connetions/postgres"
+	"techmind/internal/service"
 	"techmind/pkg/config"
 
 	"go.uber.org/fx"
@@ -17,8 +20,41 @@ func main() {
 	}
 	postgres.RunMigrations(cfg)
 
+	// ./app reindex - перестроить поисковый индекс из БД и выйти
+	if len(os.Args) > 1 && os.Args[1] == "reindex" {
+		if err := reindex(cfg); err != nil {
+			fmt.Printf("Reindex failed: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	fx.New(
 		fx.Supply(cfg),
 		app.App,
 	).Run()
 }
+
+// reindex собирает зависимости без запуска HTTP сервера и синхронно перестраивает индекс
+func reindex(cfg *config.Config) error {
+	var searchIndexService service.SearchIndexService
+	application := fx.New(
+		fx.Supply(cfg),
+		app.App,
+		fx.Populate(&searchIndexService),
+		fx.NopLogger,
+	)
+	if err := application.Err(); err != nil {
+		return err
+	}
+
+	index, err := searchIndexService.Rebuild(context.Background(), func(done, total int) {
+		fmt.Printf("Indexed %d/%d documents\n", done, total)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Search index rebuilt: %s\n", index)
+	return nil
+}
@@ -0,0 +1,349 @@
+// Package classifier обучает модель, определяющую тип документа по тексту, и проверяет ее качество
+// Модель - мультиномиальный наивный байесовский классификатор над нормированными весами TF-IDF
+// основ слов, обучение и предсказание выполняются в памяти без внешних зависимостей
+package classifier
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"techmind/pkg/stemmer"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ApplyThreshold - уверенность, с которой предсказанный тип присваивается документу без типа
+	ApplyThreshold = 0.8
+	// MinClassDocuments - типы с меньшим числом обучающих документов в модель не входят
+	MinClassDocuments = 3
+	// MaxFolds - число частей перекрестной проверки, меньше, если у типа мало документов
+	MaxFolds = 5
+
+	// maxVocabulary - основы слов сверх этого числа отбрасываются, начиная с самых редких
+	maxVocabulary = 10000
+	// maxDocumentTerms - из длинных документов учитывается только начало
+	maxDocumentTerms = 5000
+	// minDocumentFrequency - основа должна встречаться хотя бы в стольких документах, если их больше smallCorpus
+	minDocumentFrequency = 2
+	smallCorpus          = 20
+	// smoothing - сглаживание Лидстоуна для основ, не встречавшихся в документах типа
+	smoothing = 0.1
+)
+
+// ErrNotEnoughData - меньше двух типов, у которых есть MinClassDocuments обучающих документов
+var ErrNotEnoughData = errors.New("not enough training documents: at least two types with 3 documents each are required")
+
+// Example - обучающий документ
+type Example struct {
+	TypeID uuid.UUID
+	Text   string
+}
+
+// sample - обучающий документ, разбитый на основы слов
+type sample struct {
+	typeID uuid.UUID
+	terms  []string
+}
+
+// Train обучает модель на документах типов, у которых не меньше MinClassDocuments документов,
+// и возвращает число документов, вошедших в обучение
+func Train(examples []Example) (types.ClassifierModel, int, error) {
+	samples, err := prepare(examples)
+	if err != nil {
+		return types.ClassifierModel{}, 0, err
+	}
+	return train(samples), len(samples), nil
+}
+
+// Evaluate оценивает качество модели стратифицированной перекрестной проверкой:
+// документы каждого типа делятся на части, и каждая часть предсказывается моделью, обученной на остальных
+func Evaluate(examples []Example) (types.ClassifierEvaluation, error) {
+	samples, err := prepare(examples)
+	if err != nil {
+		return types.ClassifierEvaluation{}, err
+	}
+
+	byType := make(map[uuid.UUID][]int)
+	var order []uuid.UUID
+	for i, s := range samples {
+		if _, ok := byType[s.typeID]; !ok {
+			order = append(order, s.typeID)
+		}
+		byType[s.typeID] = append(byType[s.typeID], i)
+	}
+	folds := MaxFolds
+	for _, indexes := range byType {
+		if len(indexes) < folds {
+			folds = len(indexes)
+		}
+	}
+	fold := make([]int, len(samples))
+	for _, indexes := range byType {
+		for i, index := range indexes {
+			fold[index] = i % folds
+		}
+	}
+
+	support := make(map[uuid.UUID]int)
+	correct := make(map[uuid.UUID]int)
+	predicted := make(map[uuid.UUID]int)
+	for f := 0; f < folds; f++ {
+		var training, test []sample
+		for i, s := range samples {
+			if fold[i] == f {
+				test = append(test, s)
+			} else {
+				training = append(training, s)
+			}
+		}
+
+		scorer := newScorer(train(training))
+		for _, s := range test {
+			support[s.typeID]++
+			predictions := scorer.predict(s.terms)
+			if len(predictions) == 0 {
+				continue
+			}
+			predicted[predictions[0].TypeID]++
+			if predictions[0].TypeID == s.typeID {
+				correct[s.typeID]++
+			}
+		}
+	}
+
+	evaluation := types.ClassifierEvaluation{
+		Folds:     folds,
+		Documents: len(samples),
+		Classes:   make([]types.ClassEvaluation, 0, len(order)),
+	}
+	total := 0
+	for _, typeID := range order {
+		c := types.ClassEvaluation{
+			TypeID:  typeID,
+			Support: support[typeID],
+			Correct: correct[typeID],
+		}
+		c.Recall = ratio(c.Correct, c.Support)
+		c.Precision = ratio(c.Correct, predicted[typeID])
+		if c.Recall+c.Precision > 0 {
+			c.F1 = round(2 * c.Recall * c.Precision / (c.Recall + c.Precision))
+		}
+		total += c.Correct
+		evaluation.Classes = append(evaluation.Classes, c)
+	}
+	evaluation.Accuracy = ratio(total, len(samples))
+	return evaluation, nil
+}
+
+// Predict возвращает типы документа по убыванию уверенности
+// Пустой результат - в тексте нет ни одной основы из словаря модели
+func Predict(model types.ClassifierModel, text string) []types.ClassPrediction {
+	return newScorer(model).predict(Terms(text))
+}
+
+// Terms разбивает текст на основы слов, пропуская числа, однобуквенные и слишком длинные слова
+func Terms(text string) []string {
+	words := stemmer.Words(text)
+	terms := make([]string, 0, min(len(words), maxDocumentTerms))
+	for _, word := range words {
+		if len(terms) == maxDocumentTerms {
+			break
+		}
+		length := len([]rune(word))
+		if length < 2 || length > 30 || strings.IndexFunc(word, unicode.IsLetter) < 0 {
+			continue
+		}
+		terms = append(terms, stemmer.Stem(word))
+	}
+	return terms
+}
+
+// prepare разбивает тексты на основы и оставляет типы с достаточным числом документов
+func prepare(examples []Example) ([]sample, error) {
+	counts := make(map[uuid.UUID]int)
+	for _, e := range examples {
+		counts[e.TypeID]++
+	}
+	classes := 0
+	for _, count := range counts {
+		if count >= MinClassDocuments {
+			classes++
+		}
+	}
+	if classes < 2 {
+		return nil, ErrNotEnoughData
+	}
+
+	samples := make([]sample, 0, len(examples))
+	for _, e := range examples {
+		if counts[e.TypeID] >= MinClassDocuments {
+			samples = append(samples, sample{typeID: e.TypeID, terms: Terms(e.Text)})
+		}
+	}
+	return samples, nil
+}
+
+// train строит словарь по документной частоте и оценивает вероятности основ для каждого типа
+func train(samples []sample) types.ClassifierModel {
+	df := make(map[string]int)
+	for _, s := range samples {
+		for term := range termCounts(s.terms) {
+			df[term]++
+		}
+	}
+
+	minDF := 1
+	if len(samples) > smallCorpus {
+		minDF = minDocumentFrequency
+	}
+	terms := make([]string, 0, len(df))
+	for term, count := range df {
+		if count >= minDF {
+			terms = append(terms, term)
+		}
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if df[terms[i]] != df[terms[j]] {
+			return df[terms[i]] > df[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+	if len(terms) > maxVocabulary {
+		terms = terms[:maxVocabulary]
+	}
+
+	model := types.ClassifierModel{
+		Terms: terms,
+		IDF:   make([]float64, len(terms)),
+	}
+	for i, term := range terms {
+		model.IDF[i] = math.Log(float64(1+len(samples))/float64(1+df[term])) + 1
+	}
+
+	scorer := newScorer(model)
+	classIndex := make(map[uuid.UUID]int)
+	var weights [][]float64
+	for _, s := range samples {
+		index, ok := classIndex[s.typeID]
+		if !ok {
+			index = len(model.Classes)
+			classIndex[s.typeID] = index
+			model.Classes = append(model.Classes, types.ClassifierClass{TypeID: s.typeID})
+			weights = append(weights, make([]float64, len(terms)))
+		}
+		model.Classes[index].Documents++
+		for term, weight := range scorer.vector(s.terms) {
+			weights[index][term] += weight
+		}
+	}
+
+	for i := range model.Classes {
+		class := &model.Classes[i]
+		class.LogPrior = math.Log(float64(class.Documents) / float64(len(samples)))
+		total := 0.0
+		for _, weight := range weights[i] {
+			total += weight
+		}
+		class.LogLikelihood = make([]float64, len(terms))
+		for term, weight := range weights[i] {
+			class.LogLikelihood[term] = math.Log((weight + smoothing) / (total + smoothing*float64(len(terms))))
+		}
+	}
+	return model
+}
+
+// scorer предсказывает типы по модели с построенным индексом словаря
+type scorer struct {
+	model types.ClassifierModel
+	index map[string]int
+}
+
+func newScorer(model types.ClassifierModel) *scorer {
+	index := make(map[string]int, len(model.Terms))
+	for i, term := range model.Terms {
+		index[term] = i
+	}
+	return &scorer{model: model, index: index}
+}
+
+// vector возвращает веса TF-IDF основ документа, нормированные по длине вектора,
+// чтобы длинные документы не получали заведомо более уверенные предсказания
+func (s *scorer) vector(terms []string) map[int]float64 {
+	vector := make(map[int]float64)
+	for term, count := range termCounts(terms) {
+		index, ok := s.index[term]
+		if !ok {
+			continue
+		}
+		vector[index] = (1 + math.Log(float64(count))) * s.model.IDF[index]
+	}
+
+	norm := 0.0
+	for _, weight := range vector {
+		norm += weight * weight
+	}
+	norm = math.Sqrt(norm)
+	for index := range vector {
+		vector[index] /= norm
+	}
+	return vector
+}
+
+func (s *scorer) predict(terms []string) []types.ClassPrediction {
+	vector := s.vector(terms)
+	if len(vector) == 0 || len(s.model.Classes) == 0 {
+		return nil
+	}
+
+	scores := make([]float64, len(s.model.Classes))
+	best := math.Inf(-1)
+	for i, class := range s.model.Classes {
+		scores[i] = class.LogPrior
+		for index, weight := range vector {
+			scores[i] += weight * class.LogLikelihood[index]
+		}
+		best = math.Max(best, scores[i])
+	}
+
+	// Вероятности типов - softmax оценок, максимум вычитается для численной устойчивости
+	sum := 0.0
+	for i := range scores {
+		scores[i] = math.Exp(scores[i] - best)
+		sum += scores[i]
+	}
+	predictions := make([]types.ClassPrediction, 0, len(scores))
+	for i, class := range s.model.Classes {
+		predictions = append(predictions, types.ClassPrediction{
+			TypeID:     class.TypeID,
+			Confidence: round(scores[i] / sum),
+		})
+	}
+	sort.SliceStable(predictions, func(i, j int) bool {
+		return predictions[i].Confidence > predictions[j].Confidence
+	})
+	return predictions
+}
+
+func termCounts(terms []string) map[string]int {
+	counts := make(map[string]int, len(terms))
+	for _, term := range terms {
+		counts[term]++
+	}
+	return counts
+}
+
+func ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return round(float64(a) / float64(b))
+}
+
+func round(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
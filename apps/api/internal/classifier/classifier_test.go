@@ -0,0 +1,141 @@
+package classifier_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"techmind/internal/classifier"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+var (
+	invoiceType  = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	contractType = uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	actType      = uuid.MustParse("00000000-0000-0000-0000-000000000003")
+	otherType    = uuid.MustParse("00000000-0000-0000-0000-000000000004")
+)
+
+// examples - по пять синтетических счетов, договоров и актов и два документа редкого типа
+func examples() []classifier.Example {
+	texts := map[uuid.UUID]string{
+		invoiceType:  "Счет на оплату № %d. Поставщик ООО «Ромашка». Итого к оплате %d руб., НДС включен. Оплатить счет до конца месяца",
+		contractType: "Договор поставки № %d. Стороны заключили настоящий договор, предмет договора, права и обязанности сторон, срок действия %d дней",
+		actType:      "Акт выполненных работ № %d. Исполнитель выполнил работы, заказчик принял работы, претензий по объему и срокам нет, сумма %d",
+	}
+
+	var result []classifier.Example
+	for i := 1; i <= 5; i++ {
+		for _, typeID := range []uuid.UUID{invoiceType, contractType, actType} {
+			result = append(result, classifier.Example{TypeID: typeID, Text: fmt.Sprintf(texts[typeID], i, i*1000)})
+		}
+	}
+	for i := 1; i <= 2; i++ {
+		result = append(result, classifier.Example{TypeID: otherType, Text: "Доверенность на получение груза"})
+	}
+	return result
+}
+
+func TestTrain(t *testing.T) {
+	model, documents, err := classifier.Train(examples())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Документы типа, у которого меньше MinClassDocuments документов, в обучение не входят
+	if documents != 15 {
+		t.Fatalf("expected 15 training documents, got %d", documents)
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want uuid.UUID
+	}{
+		{"счет", "Счет на оплату № 42, итого к оплате 5000 руб.", invoiceType},
+		{"договор", "Договор № 7: стороны договорились о предмете договора", contractType},
+		{"акт", "Акт: работы выполнены, заказчик работы принял", actType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			predictions := classifier.Predict(model, tt.text)
+			if len(predictions) != 3 {
+				t.Fatalf("expected predictions for 3 types, got %+v", predictions)
+			}
+			if predictions[0].TypeID != tt.want {
+				t.Fatalf("expected type %s, got %+v", tt.want, predictions)
+			}
+			if predictions[0].Confidence < classifier.ApplyThreshold {
+				t.Fatalf("expected confidence above %v, got %v", classifier.ApplyThreshold, predictions[0].Confidence)
+			}
+			sum := 0.0
+			for i, p := range predictions {
+				sum += p.Confidence
+				if i > 0 && p.Confidence > predictions[i-1].Confidence {
+					t.Fatalf("predictions are not sorted by confidence: %+v", predictions)
+				}
+			}
+			if sum < 0.99 || sum > 1.01 {
+				t.Fatalf("expected confidences to sum to 1, got %v", sum)
+			}
+		})
+	}
+
+	if predictions := classifier.Predict(model, "12345 xyz"); len(predictions) != 0 {
+		t.Fatalf("expected no predictions for unknown words, got %+v", predictions)
+	}
+}
+
+func TestTrain_NotEnoughData(t *testing.T) {
+	tests := map[string][]classifier.Example{
+		"нет документов":                     nil,
+		"по одному документу каждого типа":   examples()[:3],
+		"у остальных типов по два документа": examples()[:7],
+	}
+	for name, ex := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := classifier.Train(ex); !errors.Is(err, classifier.ErrNotEnoughData) {
+				t.Fatalf("expected ErrNotEnoughData, got %v", err)
+			}
+			if _, err := classifier.Evaluate(ex); !errors.Is(err, classifier.ErrNotEnoughData) {
+				t.Fatalf("expected ErrNotEnoughData, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	evaluation, err := classifier.Evaluate(examples())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if evaluation.Folds != classifier.MaxFolds || evaluation.Documents != 15 || evaluation.Accuracy != 1 {
+		t.Fatalf("expected 5 folds over 15 documents with accuracy 1, got %+v", evaluation)
+	}
+	want := []types.ClassEvaluation{
+		{TypeID: invoiceType, Support: 5, Correct: 5, Recall: 1, Precision: 1, F1: 1},
+		{TypeID: contractType, Support: 5, Correct: 5, Recall: 1, Precision: 1, F1: 1},
+		{TypeID: actType, Support: 5, Correct: 5, Recall: 1, Precision: 1, F1: 1},
+	}
+	if len(evaluation.Classes) != len(want) {
+		t.Fatalf("expected %d classes, got %+v", len(want), evaluation.Classes)
+	}
+	for i := range want {
+		if evaluation.Classes[i] != want[i] {
+			t.Fatalf("class %d: expected %+v, got %+v", i, want[i], evaluation.Classes[i])
+		}
+	}
+}
+
+func TestEvaluate_FoldsLimitedBySmallestType(t *testing.T) {
+	ex := examples()[:9]
+	evaluation, err := classifier.Evaluate(ex)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if evaluation.Folds != 3 || evaluation.Documents != 9 {
+		t.Fatalf("expected 3 folds over 9 documents, got %+v", evaluation)
+	}
+}
@@ -0,0 +1,33 @@
+package embedding
+
+import (
+	"fmt"
+	"time"
+
+	"techmind/pkg/config"
+	"techmind/pkg/embedding"
+)
+
+// New создает провайдер эмбеддингов, если семантический поиск включен в конфигурации
+// При выключенном семантическом поиске возвращается nil и документы индексируются без векторов
+func New(cfg *config.Config) (embedding.Provider, error) {
+	if !cfg.Embedding.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Embedding.Provider {
+	case "", "hashing":
+		return embedding.NewHashing(cfg.Embedding.Dimensions), nil
+	case "http":
+		if cfg.Embedding.URL == "" {
+			return nil, fmt.Errorf("embedding.url is required for http provider")
+		}
+		return embedding.NewHTTP(cfg.Embedding.URL,
+			embedding.WithModel(cfg.Embedding.Model),
+			embedding.WithAPIKey(cfg.Embedding.APIKey),
+			embedding.WithTimeout(time.Duration(cfg.Embedding.Timeout)*time.Second),
+		), nil
+	}
+
+	return nil, fmt.Errorf("unknown embedding provider %q", cfg.Embedding.Provider)
+}
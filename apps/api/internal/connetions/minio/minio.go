@@ -8,6 +8,7 @@ import (
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"go.uber.org/fx"
 )
 
@@ -38,6 +39,18 @@ func New(lc fx.Lifecycle, config *config.Config) *minio.Client {
 		}
 	}
 
+	// Копии документов с водяным знаком строятся для каждого скачивания и удаляются через сутки
+	rules := lifecycle.NewConfiguration()
+	rules.Rules = []lifecycle.Rule{{
+		ID:         "expire-watermarks",
+		Status:     "Enabled",
+		RuleFilter: lifecycle.Filter{Prefix: "watermarks/"},
+		Expiration: lifecycle.Expiration{Days: lifecycle.ExpirationDays(1)},
+	}}
+	if err := client.SetBucketLifecycle(ctx, bucketName, rules); err != nil {
+		log.Printf("Error setting bucket lifecycle: %v", err)
+	}
+
 	lc.Append(fx.Hook{
 		OnStop: func(ctx context.Context) error {
 			return nil
@@ -0,0 +1,21 @@
+package ocr
+
+import (
+	"techmind/pkg/config"
+	"techmind/pkg/ocr"
+)
+
+// New создает движок OCR, если распознавание включено в конфигурации
+// При выключенном OCR возвращается nil и сканы индексируются без текста
+func New(cfg *config.Config) ocr.Engine {
+	if !cfg.OCR.Enabled {
+		return nil
+	}
+
+	return ocr.NewTesseract(
+		ocr.WithBinary(cfg.OCR.TesseractPath),
+		ocr.WithPdftoppm(cfg.OCR.PdftoppmPath),
+		ocr.WithLanguages(cfg.OCR.Languages),
+		ocr.WithDPI(cfg.OCR.DPI),
+	)
+}
@@ -0,0 +1,26 @@
+package search
+
+import (
+	"fmt"
+
+	"techmind/internal/search"
+	"techmind/internal/search/elastic"
+	"techmind/internal/search/postgres"
+	"techmind/pkg/config"
+	"techmind/schema/ent"
+
+	"github.com/elastic/go-elasticsearch/v8"
+)
+
+// New создает поисковый индекс на движке, выбранном в конфигурации
+// По умолчанию используется Elasticsearch, postgres подходит для небольших установок без него
+func New(cfg *config.Config, client *elasticsearch.Client, indices *elastic.Indices, db *ent.Client) (search.SearchIndex, error) {
+	switch cfg.Search.Backend {
+	case "", search.BackendElasticsearch:
+		return elastic.NewIndex(client, indices), nil
+	case search.BackendPostgres:
+		return postgres.NewIndex(db), nil
+	}
+
+	return nil, fmt.Errorf("unknown search backend %q", cfg.Search.Backend)
+}
@@ -0,0 +1,320 @@
+// Package counterparty проверяет и нормализует реквизиты контрагентов
+// и находит похожие карточки в справочнике компании
+package counterparty
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"techmind/pkg/stemmer"
+	"techmind/schema/ent"
+)
+
+// DuplicateThreshold - минимальная оценка сходства, при которой карточка считается возможным дублем
+const DuplicateThreshold = 0.7
+
+// Оценки сходства по отдельным признакам
+const (
+	scoreINN         = 1.0
+	scoreEmail       = 0.9
+	scoreSameName    = 0.9
+	scoreBranch      = 0.8
+	scorePhone       = 0.7
+	scoreWebsite     = 0.7
+	similarNameRatio = 0.85
+)
+
+// legalForms - организационно-правовые формы, которые не учитываются при сравнении названий
+// Многословные формы заменяются раньше однословных
+var legalForms = []string{
+	"общество с ограниченной ответственностью",
+	"публичное акционерное общество",
+	"закрытое акционерное общество",
+	"открытое акционерное общество",
+	"непубличное акционерное общество",
+	"акционерное общество",
+	"индивидуальный предприниматель",
+	"ооо", "пао", "зао", "оао", "нао", "ао", "ип", "нко", "ано", "гуп", "муп", "фгуп",
+	"llc", "ltd", "inc", "corp", "gmbh", "co", "plc", "jsc",
+}
+
+// Card - реквизиты контрагента для проверки и сравнения
+type Card struct {
+	Name      string
+	LegalName string
+	INN       string
+	KPP       string
+	Emails    []string
+	Phones    []string
+	Website   string
+}
+
+// Match - результат сравнения двух карточек
+type Match struct {
+	// Score - оценка сходства от 0 до 1
+	Score float64
+	// Reasons - совпавшие признаки, например inn или similar name
+	Reasons []string
+}
+
+// Duplicate проверяет, что карточки похожи настолько, чтобы считаться возможными дублями
+func (m Match) Duplicate() bool {
+	return m.Score >= DuplicateThreshold
+}
+
+// Validate проверяет название, контрольные суммы ИНН, формат КПП и адреса почты
+func Validate(card Card) error {
+	if strings.TrimSpace(card.Name) == "" {
+		return errors.New("name is required")
+	}
+	if card.INN != "" && !ValidINN(card.INN) {
+		return fmt.Errorf("invalid inn %q", card.INN)
+	}
+	if card.KPP != "" {
+		if !ValidKPP(card.KPP) {
+			return fmt.Errorf("invalid kpp %q", card.KPP)
+		}
+		if len(card.INN) != 10 {
+			return errors.New("kpp requires a 10-digit organization inn")
+		}
+	}
+	for _, email := range card.Emails {
+		if at := strings.Index(email, "@"); at <= 0 || at == len(email)-1 || strings.ContainsAny(email, " \t") {
+			return fmt.Errorf("invalid email %q", email)
+		}
+	}
+	if card.Website != "" && Domain(card.Website) == "" {
+		return fmt.Errorf("invalid website %q", card.Website)
+	}
+	return nil
+}
+
+// ValidINN проверяет ИНН организации (10 цифр) или физического лица (12 цифр) по контрольным цифрам
+func ValidINN(inn string) bool {
+	digits := make([]int, 0, len(inn))
+	for _, r := range inn {
+		if r < '0' || r > '9' {
+			return false
+		}
+		digits = append(digits, int(r-'0'))
+	}
+
+	switch len(digits) {
+	case 10:
+		return checkDigit(digits, []int{2, 4, 10, 3, 5, 9, 4, 6, 8}) == digits[9]
+	case 12:
+		return checkDigit(digits, []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) == digits[10] &&
+			checkDigit(digits, []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}) == digits[11]
+	}
+	return false
+}
+
+func checkDigit(digits, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += digits[i] * weight
+	}
+	return sum % 11 % 10
+}
+
+// ValidKPP проверяет формат КПП: 4 цифры кода налогового органа, 2 символа причины постановки на учет, 3 цифры
+func ValidKPP(kpp string) bool {
+	if len(kpp) != 9 {
+		return false
+	}
+	for i, r := range kpp {
+		digit := r >= '0' && r <= '9'
+		if !digit && (i < 4 || i > 5 || r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// NormalizeEmail приводит адрес почты к нижнему регистру без пробелов
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// NormalizePhone оставляет в номере только цифры, российский номер с 8 в начале приводится к 7
+func NormalizePhone(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) == 11 && digits[0] == '8' {
+		digits = "7" + digits[1:]
+	}
+	return digits
+}
+
+// Domain возвращает домен сайта без www, например "example.ru" для "https://www.example.ru/about"
+func Domain(website string) string {
+	website = strings.ToLower(strings.TrimSpace(website))
+	if !strings.Contains(website, "://") {
+		website = "http://" + website
+	}
+	u, err := url.Parse(website)
+	if err != nil || !strings.Contains(u.Hostname(), ".") {
+		return ""
+	}
+	return strings.TrimPrefix(u.Hostname(), "www.")
+}
+
+// NormalizeName приводит название к словам без организационно-правовой формы, кавычек и знаков препинания,
+// например "ООО «Ромашка»" и "Ромашка, ООО" дают "ромашка"
+func NormalizeName(name string) string {
+	text := " " + strings.Join(stemmer.Words(name), " ") + " "
+	for _, form := range legalForms {
+		text = strings.ReplaceAll(text, " "+form+" ", " ")
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// NameSimilarity возвращает сходство названий от 0 до 1 по расстоянию Левенштейна
+// между нормализованными названиями со словами в алфавитном порядке
+func NameSimilarity(a, b string) float64 {
+	a, b = sortedWords(NormalizeName(a)), sortedWords(NormalizeName(b))
+	if a == "" || b == "" {
+		return 0
+	}
+	if a == b {
+		return 1
+	}
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func sortedWords(name string) string {
+	words := strings.Fields(name)
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
+
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+// Compare сравнивает карточки по ИНН и КПП, почте, телефонам, сайту и названиям
+// Итоговая оценка - наибольшая из оценок совпавших признаков
+func Compare(a, b Card) Match {
+	var match Match
+	add := func(score float64, reason string) {
+		if score > match.Score {
+			match.Score = score
+		}
+		match.Reasons = append(match.Reasons, reason)
+	}
+
+	if a.INN != "" && a.INN == b.INN {
+		if a.KPP != "" && b.KPP != "" && a.KPP != b.KPP {
+			// Один ИНН и разные КПП - обособленные подразделения одной организации
+			add(scoreBranch, "inn, different kpp")
+		} else {
+			add(scoreINN, "inn")
+		}
+	}
+
+	if intersects(a.Emails, b.Emails, NormalizeEmail) {
+		add(scoreEmail, "email")
+	}
+	if intersects(a.Phones, b.Phones, NormalizePhone) {
+		add(scorePhone, "phone")
+	}
+	if a.Website != "" && Domain(a.Website) != "" && Domain(a.Website) == Domain(b.Website) {
+		add(scoreWebsite, "website")
+	}
+
+	best := 0.0
+	for _, nameA := range []string{a.Name, a.LegalName} {
+		for _, nameB := range []string{b.Name, b.LegalName} {
+			if similarity := NameSimilarity(nameA, nameB); similarity > best {
+				best = similarity
+			}
+		}
+	}
+	switch {
+	case best == 1:
+		add(scoreSameName, "name")
+	case best >= similarNameRatio:
+		add(best*scoreSameName, "similar name")
+	}
+
+	return match
+}
+
+// intersects проверяет, что у списков есть общее значение после нормализации
+func intersects(a, b []string, normalize func(string) string) bool {
+	values := make(map[string]bool, len(a))
+	for _, value := range a {
+		if key := normalize(value); key != "" {
+			values[key] = true
+		}
+	}
+	for _, value := range b {
+		if values[normalize(value)] {
+			return true
+		}
+	}
+	return false
+}
+
+// SenderCard собирает реквизиты контрагента из справочника, основной адрес почты идет первым
+func SenderCard(sender *ent.Sender) Card {
+	emails := sender.Emails
+	if sender.Email != nil {
+		emails = append([]string{*sender.Email}, sender.Emails...)
+	}
+	return Card{
+		Name:      sender.Name,
+		LegalName: value(sender.LegalName),
+		INN:       value(sender.Inn),
+		KPP:       value(sender.Kpp),
+		Emails:    emails,
+		Phones:    sender.Phones,
+		Website:   value(sender.Website),
+	}
+}
+
+// CompanyCard собирает реквизиты самой компании из ее названия и настроек
+func CompanyCard(company *ent.Company) Card {
+	card := Card{Name: company.Name}
+	if requisites := company.Settings.Requisites; requisites != nil {
+		card.LegalName = requisites.LegalName
+		card.INN = requisites.INN
+		card.KPP = requisites.KPP
+	}
+	return card
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
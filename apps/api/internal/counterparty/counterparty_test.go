@@ -0,0 +1,182 @@
+package counterparty_test
+
+import (
+	"math"
+	"testing"
+
+	"techmind/internal/counterparty"
+)
+
+func TestValidINN(t *testing.T) {
+	tests := []struct {
+		inn  string
+		want bool
+	}{
+		{"7707083893", true},
+		{"7736207543", true},
+		{"500100732259", true},
+		{"7707083894", false},
+		{"500100732250", false},
+		{"500100732209", false},
+		{"770708389", false},
+		{"77070838930", false},
+		{"770708389A", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := counterparty.ValidINN(tt.inn); got != tt.want {
+			t.Errorf("ValidINN(%q) = %v, want %v", tt.inn, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		card    counterparty.Card
+		wantErr string
+	}{
+		{
+			name: "корректная карточка",
+			card: counterparty.Card{Name: "Ромашка", INN: "7707083893", KPP: "77360A001", Emails: []string{"info@romashka.ru"}, Website: "romashka.ru"},
+		},
+		{
+			name:    "без названия",
+			card:    counterparty.Card{Name: " "},
+			wantErr: "name is required",
+		},
+		{
+			name:    "неверная контрольная цифра ИНН",
+			card:    counterparty.Card{Name: "Ромашка", INN: "7707083894"},
+			wantErr: `invalid inn "7707083894"`,
+		},
+		{
+			name:    "КПП у предпринимателя",
+			card:    counterparty.Card{Name: "Иванов", INN: "500100732259", KPP: "773601001"},
+			wantErr: "kpp requires a 10-digit organization inn",
+		},
+		{
+			name:    "неверный КПП",
+			card:    counterparty.Card{Name: "Ромашка", INN: "7707083893", KPP: "77A601001"},
+			wantErr: `invalid kpp "77A601001"`,
+		},
+		{
+			name:    "неверная почта",
+			card:    counterparty.Card{Name: "Ромашка", Emails: []string{"info@"}},
+			wantErr: `invalid email "info@"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := counterparty.Validate(tt.card)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	tests := map[string]string{
+		"ООО «Ромашка»": "ромашка",
+		"Ромашка, ООО":  "ромашка",
+		`АО "Техмайнд"`: "техмайнд",
+		"Romashka LLC":  "romashka",
+		"Общество с ограниченной ответственностью «Ромашка»": "ромашка",
+	}
+
+	for name, want := range tests {
+		if got := counterparty.NormalizeName(name); got != want {
+			t.Errorf("NormalizeName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestNameSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"ООО «Ромашка»", "Ромашка", 1},
+		{"Ромашка Плюс", "Плюс Ромашка", 1},
+		{"Ромашка", "Ромашко", 1 - 1.0/7},
+		{"Ромашка", "Василек", 0},
+		{"ООО", "Ромашка", 0},
+	}
+
+	for _, tt := range tests {
+		if got := counterparty.NameSimilarity(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("NameSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	romashka := counterparty.Card{Name: "Ромашка", INN: "7707083893", KPP: "773601001"}
+
+	tests := []struct {
+		name          string
+		card          counterparty.Card
+		wantScore     float64
+		wantReasons   []string
+		wantDuplicate bool
+	}{
+		{
+			name:          "тот же ИНН",
+			card:          counterparty.Card{Name: "Другое название", INN: "7707083893"},
+			wantScore:     1,
+			wantReasons:   []string{"inn"},
+			wantDuplicate: true,
+		},
+		{
+			name:          "филиал с другим КПП",
+			card:          counterparty.Card{Name: "Филиал", INN: "7707083893", KPP: "773602001"},
+			wantScore:     0.8,
+			wantReasons:   []string{"inn, different kpp"},
+			wantDuplicate: true,
+		},
+		{
+			name:          "то же название с формой",
+			card:          counterparty.Card{Name: "Ромашка", LegalName: "ООО «Ромашка»"},
+			wantScore:     0.9,
+			wantReasons:   []string{"name"},
+			wantDuplicate: true,
+		},
+		{
+			name:          "опечатка в названии",
+			card:          counterparty.Card{Name: "Ромашко"},
+			wantScore:     (1 - 1.0/7) * 0.9,
+			wantReasons:   []string{"similar name"},
+			wantDuplicate: true,
+		},
+		{
+			name: "другое название",
+			card: counterparty.Card{Name: "Василек"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := counterparty.Compare(tt.card, romashka)
+			if math.Abs(match.Score-tt.wantScore) > 1e-9 {
+				t.Fatalf("expected score %v, got %v", tt.wantScore, match.Score)
+			}
+			if len(match.Reasons) != len(tt.wantReasons) {
+				t.Fatalf("expected reasons %v, got %v", tt.wantReasons, match.Reasons)
+			}
+			for i := range tt.wantReasons {
+				if match.Reasons[i] != tt.wantReasons[i] {
+					t.Fatalf("expected reasons %v, got %v", tt.wantReasons, match.Reasons)
+				}
+			}
+			if match.Duplicate() != tt.wantDuplicate {
+				t.Fatalf("expected duplicate %v, got %v", tt.wantDuplicate, match.Duplicate())
+			}
+		})
+	}
+}
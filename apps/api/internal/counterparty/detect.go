@@ -0,0 +1,375 @@
+package counterparty
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AttachThreshold - минимальная уверенность, при которой найденный контрагент присваивается документу
+const AttachThreshold = 0.8
+
+// Уверенность по отдельным признакам, найденным в документе
+const (
+	confidenceINNKPP      = 0.95
+	confidenceINN         = 0.9
+	confidenceHeaderEmail = 0.9
+	confidenceEmail       = 0.8
+	confidenceDomain      = 0.7
+	confidenceLegalName   = 0.7
+	confidenceBranch      = 0.6
+	confidenceName        = 0.6
+	// minNameLength - более короткие названия слишком часто встречаются в тексте случайно
+	minNameLength = 4
+	// requisiteWindow - сколько символов после ИНН просматривается в поисках КПП
+	requisiteWindow = 80
+	// organizationWindow - сколько символов перед ИНН просматривается в поисках названия организации
+	organizationWindow = 300
+)
+
+var (
+	emailPattern   = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	headerPattern  = regexp.MustCompile(`(?im)^[ \t]*(?:from|от|отправитель)[ \t]*:[ \t]*(.+)$`)
+	websitePattern = regexp.MustCompile(`(?i)(?:https?://|www\.)[a-z0-9.\-]+\.[a-z]{2,}`)
+	innPattern     = regexp.MustCompile(`(?i)инн[ \t]*(?:/[ \t]*кпп)?[ \t]*[:№]?[ \t]*(\d{12}|\d{10})(?:[ \t]*[/\\][ \t]*(\d{4}[0-9A-Z]{2}\d{3}))?`)
+	kppPattern     = regexp.MustCompile(`(?i)кпп[ \t]*[:№]?[ \t]*(\d{4}[0-9A-Z]{2}\d{3})`)
+	// organizationPattern - организация с формой и названием в кавычках, например ООО «Ромашка»
+	organizationPattern = regexp.MustCompile(`(?i)(?:^|[^\p{L}])((?:общество с ограниченной ответственностью|(?:публичное |закрытое |открытое |непубличное )?акционерное общество|ооо|пао|зао|оао|нао|ао)[ \t]*[«"“]([^»"”\n]{2,80})[»"”])`)
+	// entrepreneurPattern - индивидуальный предприниматель, например ИП Иванов И.И.
+	entrepreneurPattern = regexp.MustCompile(`(?:^|[^\p{L}])((?:ИП|Индивидуальный предприниматель)[ \t]+([А-ЯЁ][а-яё]+(?:[ \t]+[А-ЯЁ]\.[ \t]*[А-ЯЁ]\.|[ \t]+[А-ЯЁ][а-яё]+[ \t]+[А-ЯЁ][а-яё]+)))`)
+)
+
+// publicDomains - почтовые сервисы, домен которых не указывает на контрагента
+var publicDomains = map[string]bool{
+	"gmail.com": true, "googlemail.com": true, "yandex.ru": true, "ya.ru": true, "yandex.com": true,
+	"mail.ru": true, "bk.ru": true, "list.ru": true, "inbox.ru": true, "internet.ru": true,
+	"rambler.ru": true, "outlook.com": true, "hotmail.com": true, "live.com": true,
+	"yahoo.com": true, "icloud.com": true, "me.com": true, "proton.me": true, "protonmail.com": true,
+}
+
+// Signals - признаки контрагентов, найденные в тексте и метаданных документа
+type Signals struct {
+	// HeaderEmails - адреса из строк From, От и Отправитель, например заголовков письма
+	HeaderEmails []string
+	Emails       []string
+	// Domains - домены сайтов и адресов почты без публичных почтовых сервисов
+	Domains       []string
+	Requisites    []Requisite
+	Organizations []Organization
+	// text - нормализованный текст для поиска названий известных контрагентов
+	text string
+}
+
+// Requisite - ИНН и КПП, найденные в документе, и организация, указанная перед ними
+type Requisite struct {
+	INN          string
+	KPP          string
+	Organization string
+}
+
+// Organization - название организации с организационно-правовой формой, например ООО «Ромашка»
+type Organization struct {
+	// LegalName - название с формой, Name - название без формы
+	LegalName string
+	Name      string
+}
+
+// Known - контрагент из справочника компании
+type Known struct {
+	ID   uuid.UUID
+	Card Card
+}
+
+// Detection - известный контрагент, признаки которого найдены в документе
+type Detection struct {
+	ID uuid.UUID
+	// Confidence - уверенность от 0 до 1, растет с числом совпавших признаков
+	Confidence float64
+	Reasons    []string
+}
+
+// Extract находит в тексте и строках метаданных (автор PDF, заголовки письма) адреса почты,
+// сайты, ИНН с КПП и названия организаций
+func Extract(text string, metadata ...string) Signals {
+	content := text
+	for _, line := range metadata {
+		if line != "" {
+			content += "\n" + line
+		}
+	}
+
+	signals := Signals{text: " " + NormalizeName(content) + " "}
+
+	for _, header := range headerPattern.FindAllStringSubmatch(content, -1) {
+		signals.HeaderEmails = append(signals.HeaderEmails, emailPattern.FindAllString(header[1], -1)...)
+	}
+	signals.HeaderEmails = uniqueValues(signals.HeaderEmails, NormalizeEmail)
+	signals.Emails = uniqueValues(emailPattern.FindAllString(content, -1), NormalizeEmail)
+
+	domains := make([]string, 0)
+	for _, email := range signals.Emails {
+		domains = append(domains, email[strings.LastIndex(email, "@")+1:])
+	}
+	for _, website := range websitePattern.FindAllString(content, -1) {
+		domains = append(domains, Domain(website))
+	}
+	for _, domain := range uniqueValues(domains, strings.ToLower) {
+		if domain != "" && !publicDomains[domain] {
+			signals.Domains = append(signals.Domains, domain)
+		}
+	}
+
+	organizations := make([]organizationMatch, 0)
+	for _, pattern := range []*regexp.Regexp{organizationPattern, entrepreneurPattern} {
+		for _, m := range pattern.FindAllStringSubmatchIndex(content, -1) {
+			organizations = append(organizations, organizationMatch{
+				Organization: Organization{
+					LegalName: strings.Join(strings.Fields(content[m[2]:m[3]]), " "),
+					Name:      strings.TrimSpace(content[m[4]:m[5]]),
+				},
+				end: m[3],
+			})
+		}
+	}
+	sort.SliceStable(organizations, func(i, j int) bool { return organizations[i].end < organizations[j].end })
+	seen := make(map[string]bool)
+	for _, org := range organizations {
+		if key := NormalizeName(org.LegalName); !seen[key] {
+			seen[key] = true
+			signals.Organizations = append(signals.Organizations, org.Organization)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, m := range innPattern.FindAllStringSubmatchIndex(content, -1) {
+		requisite := Requisite{INN: content[m[2]:m[3]]}
+		if !ValidINN(requisite.INN) || seen[requisite.INN] {
+			continue
+		}
+		seen[requisite.INN] = true
+
+		if m[4] >= 0 {
+			requisite.KPP = strings.ToUpper(content[m[4]:m[5]])
+		} else if len(requisite.INN) == 10 {
+			window := content[m[1]:min(len(content), m[1]+requisiteWindow)]
+			if kpp := kppPattern.FindStringSubmatch(window); kpp != nil {
+				requisite.KPP = strings.ToUpper(kpp[1])
+			}
+		}
+		// Название организации обычно стоит перед ее реквизитами
+		for i := len(organizations) - 1; i >= 0; i-- {
+			if organizations[i].end <= m[0] && m[0]-organizations[i].end <= organizationWindow {
+				requisite.Organization = organizations[i].LegalName
+				break
+			}
+		}
+		signals.Requisites = append(signals.Requisites, requisite)
+	}
+
+	return signals
+}
+
+type organizationMatch struct {
+	Organization
+	end int
+}
+
+// Detect сравнивает признаки с известными контрагентами и возвращает найденных,
+// сначала с наибольшей уверенностью
+func Detect(signals Signals, known []Known) []Detection {
+	detections := make([]Detection, 0)
+	for _, k := range known {
+		detection := Detection{ID: k.ID}
+		var confidences []float64
+		add := func(confidence float64, reason string) {
+			confidences = append(confidences, confidence)
+			detection.Reasons = append(detection.Reasons, reason)
+		}
+
+		if k.Card.INN != "" {
+			for _, requisite := range signals.Requisites {
+				if requisite.INN != k.Card.INN {
+					continue
+				}
+				switch {
+				case requisite.KPP != "" && requisite.KPP == k.Card.KPP:
+					add(confidenceINNKPP, "inn and kpp")
+				case requisite.KPP != "" && k.Card.KPP != "":
+					add(confidenceBranch, "inn, different kpp")
+				default:
+					add(confidenceINN, "inn")
+				}
+				break
+			}
+		}
+
+		switch {
+		case intersects(k.Card.Emails, signals.HeaderEmails, NormalizeEmail):
+			add(confidenceHeaderEmail, "sender email")
+		case intersects(k.Card.Emails, signals.Emails, NormalizeEmail):
+			add(confidenceEmail, "email")
+		}
+
+		if domainMatches(k.Card, signals.Domains) {
+			add(confidenceDomain, "domain")
+		}
+
+		switch {
+		case containsName(signals.text, k.Card.LegalName):
+			add(confidenceLegalName, "legal name")
+		case containsName(signals.text, k.Card.Name):
+			add(confidenceName, "name")
+		}
+
+		if len(confidences) == 0 {
+			continue
+		}
+		detection.Confidence = combine(confidences)
+		detections = append(detections, detection)
+	}
+
+	sort.SliceStable(detections, func(i, j int) bool {
+		return detections[i].Confidence > detections[j].Confidence
+	})
+	return detections
+}
+
+// Best возвращает контрагента для присвоения документу: уверенность не ниже AttachThreshold
+// и строго выше, чем у следующего кандидата
+func Best(detections []Detection) (Detection, bool) {
+	if len(detections) == 0 || detections[0].Confidence < AttachThreshold {
+		return Detection{}, false
+	}
+	if len(detections) > 1 && detections[1].Confidence >= detections[0].Confidence {
+		return Detection{}, false
+	}
+	return detections[0], true
+}
+
+// Propose собирает карточки новых контрагентов из реквизитов и организаций,
+// которые не совпали ни с одним известным контрагентом и не относятся к самой компании own
+// Адрес отправителя предлагается, только если других карточек нет и отправитель не определен уверенно
+func Propose(signals Signals, own Card, known []Known, detections []Detection) []Card {
+	var (
+		proposals []Card
+		used      = make(map[string]bool)
+	)
+	matchesKnown := func(card Card) bool {
+		// В счетах и договорах реквизиты компании стоят рядом с реквизитами контрагента
+		if Compare(card, own).Duplicate() {
+			return true
+		}
+		for _, k := range known {
+			if Compare(card, k.Card).Duplicate() {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, requisite := range signals.Requisites {
+		card := Card{INN: requisite.INN, KPP: requisite.KPP, LegalName: requisite.Organization}
+		card.Name = NameWithoutForm(requisite.Organization)
+		if card.Name == "" {
+			card.Name = "ИНН " + requisite.INN
+		}
+		used[NormalizeName(requisite.Organization)] = true
+		if !matchesKnown(card) {
+			proposals = append(proposals, card)
+		}
+	}
+
+	for _, org := range signals.Organizations {
+		if used[NormalizeName(org.LegalName)] {
+			continue
+		}
+		card := Card{Name: org.Name, LegalName: org.LegalName}
+		if !matchesKnown(card) {
+			proposals = append(proposals, card)
+		}
+	}
+
+	if len(proposals) > 0 || (len(detections) > 0 && detections[0].Confidence >= AttachThreshold) {
+		return proposals
+	}
+	for _, email := range signals.HeaderEmails {
+		domain := email[strings.LastIndex(email, "@")+1:]
+		card := Card{Name: domain, Emails: []string{NormalizeEmail(email)}}
+		if !publicDomains[strings.ToLower(domain)] {
+			card.Website = strings.ToLower(domain)
+		}
+		if !matchesKnown(card) {
+			proposals = append(proposals, card)
+		}
+	}
+
+	return proposals
+}
+
+// NameWithoutForm возвращает название без организационно-правовой формы и кавычек,
+// например "Ромашка" для "ООО «Ромашка»"
+func NameWithoutForm(legalName string) string {
+	for _, pattern := range []*regexp.Regexp{organizationPattern, entrepreneurPattern} {
+		if m := pattern.FindStringSubmatch(legalName); m != nil {
+			return strings.TrimSpace(m[2])
+		}
+	}
+	return strings.TrimSpace(legalName)
+}
+
+// containsName ищет нормализованное название в нормализованном тексте целыми словами
+func containsName(text, name string) bool {
+	normalized := NormalizeName(name)
+	if len([]rune(normalized)) < minNameLength {
+		return false
+	}
+	return strings.Contains(text, " "+normalized+" ")
+}
+
+func domainMatches(card Card, domains []string) bool {
+	own := make([]string, 0, len(card.Emails)+1)
+	for _, email := range card.Emails {
+		own = append(own, email[strings.LastIndex(email, "@")+1:])
+	}
+	if card.Website != "" {
+		own = append(own, Domain(card.Website))
+	}
+	for _, domain := range own {
+		domain = strings.ToLower(domain)
+		if domain == "" || publicDomains[domain] {
+			continue
+		}
+		for _, found := range domains {
+			if found == domain {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// combine объединяет независимые признаки: 1 - произведение вероятностей ошибки каждого признака
+func combine(confidences []float64) float64 {
+	miss := 1.0
+	for _, confidence := range confidences {
+		miss *= 1 - confidence
+	}
+	return 1 - miss
+}
+
+// uniqueValues убирает повторы, значения сравниваются после нормализации
+func uniqueValues(values []string, normalize func(string) string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		key := normalize(v)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,67 @@
+package counterparty_test
+
+import (
+	"reflect"
+	"testing"
+
+	"techmind/internal/counterparty"
+)
+
+// invoice - счет, в котором указаны и поставщик, и сама компания как покупатель
+const invoice = `Счет на оплату № 123 от 05.03.2024
+Поставщик: ООО «Ромашка», ИНН 7707083893, КПП 773601001
+Покупатель: АО «Техмайнд», ИНН 7736207543 КПП 770401001
+Итого к оплате: 12 345,67 руб.`
+
+func TestPropose_SkipsOwnCompany(t *testing.T) {
+	signals := counterparty.Extract(invoice)
+	if len(signals.Requisites) != 2 {
+		t.Fatalf("expected requisites of both parties, got %+v", signals.Requisites)
+	}
+
+	tests := []struct {
+		name string
+		own  counterparty.Card
+	}{
+		{
+			name: "по ИНН компании",
+			own:  counterparty.Card{Name: "Главный офис", INN: "7736207543"},
+		},
+		{
+			name: "по названию компании без реквизитов",
+			own:  counterparty.Card{Name: "Техмайнд"},
+		},
+	}
+
+	want := []counterparty.Card{{
+		Name:      "Ромашка",
+		LegalName: "ООО «Ромашка»",
+		INN:       "7707083893",
+		KPP:       "773601001",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := counterparty.Propose(signals, tt.own, nil, nil)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("Propose() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestPropose_WithoutOwnRequisites(t *testing.T) {
+	got := counterparty.Propose(counterparty.Extract(invoice), counterparty.Card{}, nil, nil)
+	if len(got) != 2 || got[0].INN != "7707083893" || got[1].INN != "7736207543" {
+		t.Fatalf("expected both parties to be proposed, got %+v", got)
+	}
+}
+
+func TestPropose_SkipsKnownSender(t *testing.T) {
+	signals := counterparty.Extract(invoice)
+	known := []counterparty.Known{{Card: counterparty.Card{Name: "Ромашка", INN: "7707083893"}}}
+	own := counterparty.Card{Name: "Техмайнд", INN: "7736207543"}
+
+	if got := counterparty.Propose(signals, own, known, nil); len(got) != 0 {
+		t.Fatalf("expected no proposals, got %+v", got)
+	}
+}
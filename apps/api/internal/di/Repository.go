@@ -4,10 +4,20 @@ import (
 	"techmind/internal/repo/company"
 	"techmind/internal/repo/company_user"
 	"techmind/internal/repo/document"
+	"techmind/internal/repo/document_classifier"
+	"techmind/internal/repo/document_content"
 	"techmind/internal/repo/document_tag"
+	"techmind/internal/repo/document_tag_suppression"
+	"techmind/internal/repo/document_type"
 	"techmind/internal/repo/folder"
+	"techmind/internal/repo/job"
+	"techmind/internal/repo/saved_search"
+	"techmind/internal/repo/search_index_task"
+	"techmind/internal/repo/search_log"
 	"techmind/internal/repo/sender"
 	"techmind/internal/repo/tag"
+	"techmind/internal/repo/tag_group"
+	"techmind/internal/repo/tag_rule"
 	"techmind/internal/repo/user"
 
 	"go.uber.org/fx"
@@ -21,7 +31,17 @@ var Repository = fx.Options(
 		folder.NewRepository,
 		sender.NewRepository,
 		document.NewRepository,
+		document_content.NewRepository,
+		document_classifier.NewRepository,
 		tag.NewRepository,
 		document_tag.NewRepository,
+		document_tag_suppression.NewRepository,
+		document_type.NewRepository,
+		job.NewRepository,
+		search_index_task.NewRepository,
+		saved_search.NewRepository,
+		search_log.NewRepository,
+		tag_rule.NewRepository,
+		tag_group.NewRepository,
 	),
 )
@@ -5,9 +5,17 @@ import (
 	"techmind/internal/service/company"
 	"techmind/internal/service/company_user"
 	"techmind/internal/service/document"
+	"techmind/internal/service/documentclassifier"
 	"techmind/internal/service/documenttag"
+	"techmind/internal/service/documenttype"
 	"techmind/internal/service/folder"
+	"techmind/internal/service/job"
+	"techmind/internal/service/pdf"
+	"techmind/internal/service/savedsearch"
+	"techmind/internal/service/searchanalytics"
+	"techmind/internal/service/searchindex"
 	"techmind/internal/service/sender"
+	"techmind/internal/service/tagrule"
 
 	"go.uber.org/fx"
 )
@@ -16,10 +24,20 @@ var Service = fx.Options(
 	fx.Provide(
 		auth.NewService,
 		document.NewService,
+		documentclassifier.NewService,
 		documenttag.NewService,
+		documenttype.NewService,
 		folder.NewService,
 		company_user.NewService,
 		company.NewService,
 		sender.NewService,
+		job.NewService,
+		pdf.NewService,
+		searchindex.NewService,
+		savedsearch.NewService,
+		searchanalytics.NewService,
+		tagrule.NewService,
 	),
+	fx.Invoke(searchindex.NewSyncWorker),
+	fx.Invoke(searchanalytics.NewRetentionWorker),
 )
@@ -19,19 +19,35 @@ var Transport = fx.Options(
 			documentService service.DocumentService,
 			documentTagService service.DocumentTagService,
 			senderService service.SenderService,
+			documentTypeService service.DocumentTypeService,
+			classifierService service.ClassifierService,
 			companyUserService service.CompanyUserService,
 			companyService service.CompanyService,
+			jobService service.JobService,
+			pdfService service.PDFService,
+			searchIndexService service.SearchIndexService,
+			savedSearchService service.SavedSearchService,
+			analyticsService service.SearchAnalyticsService,
+			tagRuleService service.TagRuleService,
 			cfg *config.Config,
 		) *http.Server {
 			deps := http.ServerDeps{
-				AuthService:        authService,
-				FolderService:      folderService,
-				DocumentService:    documentService,
-				DocumentTagService: documentTagService,
-				SenderService:      senderService,
-				CompanyUserService: companyUserService,
-				CompanyService:     companyService,
-				Config:             cfg,
+				AuthService:         authService,
+				FolderService:       folderService,
+				DocumentService:     documentService,
+				DocumentTagService:  documentTagService,
+				SenderService:       senderService,
+				DocumentTypeService: documentTypeService,
+				ClassifierService:   classifierService,
+				CompanyUserService:  companyUserService,
+				CompanyService:      companyService,
+				JobService:          jobService,
+				PDFService:          pdfService,
+				SearchIndexService:  searchIndexService,
+				SavedSearchService:  savedSearchService,
+				AnalyticsService:    analyticsService,
+				TagRuleService:      tagRuleService,
+				Config:              cfg,
 			}
 			return http.NewServer(deps)
 		},
@@ -0,0 +1,238 @@
+// Package doctype проверяет схемы полей типов документов
+// и приводит значения полей документа к типам из схемы
+package doctype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+var (
+	keyPattern      = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+	currencyPattern = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// ValidateFields нормализует и проверяет схему полей: ключи уникальны и подходят для фильтров поиска,
+// у каждого поля есть название и известный тип, у перечисления есть варианты
+func ValidateFields(fields []types.DocumentField) ([]types.DocumentField, error) {
+	result := make([]types.DocumentField, 0, len(fields))
+	keys := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		f.Key = strings.TrimSpace(f.Key)
+		f.Name = strings.TrimSpace(f.Name)
+		f.Currency = strings.ToUpper(strings.TrimSpace(f.Currency))
+
+		if !keyPattern.MatchString(f.Key) {
+			return nil, fmt.Errorf("field key %q must start with a latin letter and contain only a-z, 0-9 and _", f.Key)
+		}
+		if keys[f.Key] {
+			return nil, fmt.Errorf("duplicate field key %q", f.Key)
+		}
+		keys[f.Key] = true
+		if f.Name == "" {
+			return nil, fmt.Errorf("field %q: name is required", f.Key)
+		}
+
+		switch f.Type {
+		case types.FieldTypeString, types.FieldTypeNumber, types.FieldTypeDate, types.FieldTypeSender:
+			f.Options, f.Currency = nil, ""
+		case types.FieldTypeEnum:
+			options, err := enumOptions(f.Options)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", f.Key, err)
+			}
+			f.Options, f.Currency = options, ""
+		case types.FieldTypeMoney:
+			if f.Currency != "" && !currencyPattern.MatchString(f.Currency) {
+				return nil, fmt.Errorf("field %q: currency must be a 3-letter ISO 4217 code", f.Key)
+			}
+			f.Options = nil
+		default:
+			return nil, fmt.Errorf("field %q: unknown type %q", f.Key, f.Type)
+		}
+		result = append(result, f)
+	}
+	return result, nil
+}
+
+func enumOptions(options []string) ([]string, error) {
+	seen := make(map[string]bool, len(options))
+	result := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			continue
+		}
+		seen[option] = true
+		result = append(result, option)
+	}
+	if len(result) == 0 {
+		return nil, errors.New("enum requires at least one option")
+	}
+	return result, nil
+}
+
+// ParseValues проверяет значения полей документа по схеме типа
+// base - текущие значения документа: подходящие по ключу и типу к схеме сохраняются, остальные отбрасываются,
+// поэтому при смене типа переносятся совпадающие поля. Значения raw заменяют base,
+// null и пустая строка удаляют значение, ключи, которых нет в схеме, считаются ошибкой
+func ParseValues(fields []types.DocumentField, base map[string]types.FieldValue, raw map[string]json.RawMessage) (map[string]types.FieldValue, error) {
+	schema := make(map[string]types.DocumentField, len(fields))
+	for _, f := range fields {
+		schema[f.Key] = f
+	}
+
+	values := make(map[string]types.FieldValue, len(base)+len(raw))
+	for key, value := range base {
+		f, ok := schema[key]
+		if !ok {
+			continue
+		}
+		data, err := json.Marshal(value.Value())
+		if err != nil {
+			continue
+		}
+		if converted, ok, err := parseValue(f, data); err == nil && ok {
+			values[key] = converted
+		}
+	}
+
+	for key, data := range raw {
+		f, ok := schema[key]
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", key)
+		}
+		value, ok, err := parseValue(f, data)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", key, err)
+		}
+		if ok {
+			values[key] = value
+		} else {
+			delete(values, key)
+		}
+	}
+
+	for _, f := range fields {
+		if _, ok := values[f.Key]; f.Required && !ok {
+			return nil, fmt.Errorf("field %q is required", f.Key)
+		}
+	}
+	return values, nil
+}
+
+// ParseValue проверяет значение поля key схемы, второй результат false, если значение не задано
+func ParseValue(fields []types.DocumentField, key string, data json.RawMessage) (types.FieldValue, bool, error) {
+	for _, f := range fields {
+		if f.Key == key {
+			return parseValue(f, data)
+		}
+	}
+	return types.FieldValue{}, false, fmt.Errorf("unknown field %q", key)
+}
+
+// SenderIDs возвращает контрагентов, на которых ссылаются значения полей
+func SenderIDs(values map[string]types.FieldValue) []uuid.UUID {
+	ids := make([]uuid.UUID, 0)
+	for _, value := range values {
+		if value.SenderID != nil {
+			ids = append(ids, *value.SenderID)
+		}
+	}
+	return ids
+}
+
+// parseValue приводит значение к типу поля, второй результат false, если значение не задано
+func parseValue(f types.DocumentField, data json.RawMessage) (types.FieldValue, bool, error) {
+	if string(data) == "null" || len(data) == 0 {
+		return types.FieldValue{}, false, nil
+	}
+
+	switch f.Type {
+	case types.FieldTypeNumber:
+		var number float64
+		if err := json.Unmarshal(data, &number); err != nil {
+			return types.FieldValue{}, false, errors.New("expected a number")
+		}
+		return types.FieldValue{Number: &number}, true, nil
+
+	case types.FieldTypeMoney:
+		return parseMoney(f, data)
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return types.FieldValue{}, false, errors.New("expected a string")
+	}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return types.FieldValue{}, false, nil
+	}
+
+	switch f.Type {
+	case types.FieldTypeString:
+		return types.FieldValue{String: &s}, true, nil
+
+	case types.FieldTypeEnum:
+		for _, option := range f.Options {
+			if option == s {
+				return types.FieldValue{String: &s}, true, nil
+			}
+		}
+		return types.FieldValue{}, false, fmt.Errorf("value %q is not one of the options", s)
+
+	case types.FieldTypeDate:
+		date, err := ParseDate(s)
+		if err != nil {
+			return types.FieldValue{}, false, err
+		}
+		return types.FieldValue{Date: &date}, true, nil
+
+	case types.FieldTypeSender:
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return types.FieldValue{}, false, errors.New("expected a sender id")
+		}
+		return types.FieldValue{SenderID: &id}, true, nil
+	}
+	return types.FieldValue{}, false, fmt.Errorf("unknown type %q", f.Type)
+}
+
+// parseMoney принимает сумму числом в валюте поля по умолчанию или объект {amount, currency}
+func parseMoney(f types.DocumentField, data json.RawMessage) (types.FieldValue, bool, error) {
+	var money types.MoneyValue
+	if err := json.Unmarshal(data, &money.Amount); err != nil {
+		if err := json.Unmarshal(data, &money); err != nil {
+			return types.FieldValue{}, false, errors.New("expected an amount or {amount, currency}")
+		}
+	}
+
+	money.Currency = strings.ToUpper(strings.TrimSpace(money.Currency))
+	if money.Currency == "" {
+		money.Currency = f.Currency
+	}
+	if !currencyPattern.MatchString(money.Currency) {
+		return types.FieldValue{}, false, errors.New("currency must be a 3-letter ISO 4217 code")
+	}
+	return types.FieldValue{Number: &money.Amount, Currency: money.Currency}, true, nil
+}
+
+// ParseDate разбирает дату в формате 2006-01-02 или RFC 3339, время отбрасывается
+func ParseDate(s string) (time.Time, error) {
+	if date, err := time.Parse(types.DateLayout, s); err == nil {
+		return date, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.New("expected a date in 2006-01-02 format")
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+}
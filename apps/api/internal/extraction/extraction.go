@@ -0,0 +1,366 @@
+// Package extraction находит значения полей типа документа в тексте
+// по шаблону из регулярных выражений и якорей и оценивает уверенность в каждом значении
+package extraction
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"techmind/schema/types"
+)
+
+// Уверенность по умолчанию для способов поиска
+const (
+	confidenceRegex  = 0.9
+	confidenceAnchor = 0.75
+	// qualityNormalized - значение прочитано не в основном формате, например дата словами
+	qualityNormalized = 0.9
+	// qualityPartial - вариант перечисления найден внутри более длинного текста
+	qualityPartial = 0.8
+	// maxStringLength - длина строкового значения, дальше текст обрезается
+	maxStringLength = 200
+)
+
+const (
+	datePattern     = `\d{1,2}[./-]\d{1,2}[./-]\d{2,4}|\d{4}-\d{2}-\d{2}|\d{1,2}[ \t]+(?:января|февраля|марта|апреля|мая|июня|июля|августа|сентября|октября|ноября|декабря)[ \t]+\d{4}`
+	numberPattern   = `\d{1,3}(?:[ \t]\d{3})+(?:[.,]\d{1,2})?|\d+(?:[.,]\d+)?`
+	currencyPattern = `руб(?:\.|лей|ля|ль)?|р\.|₽|rub|usd|\$|eur|€|долл(?:\.|аров)?|евро`
+)
+
+var (
+	// macros - макросы шаблонов, заменяемые группами
+	macros = map[string]string{
+		"(date)":   "(" + datePattern + ")",
+		"(amount)": "((?:" + numberPattern + ")(?:[ \t]*(?:" + currencyPattern + "))?)",
+		"(number)": "(" + numberPattern + ")",
+	}
+
+	// valuePatterns - значение после якоря для каждого типа поля
+	valuePatterns = map[string]string{
+		types.FieldTypeDate:   "(" + datePattern + ")",
+		types.FieldTypeMoney:  macros["(amount)"],
+		types.FieldTypeNumber: macros["(number)"],
+		types.FieldTypeString: `([^\n]+)`,
+		types.FieldTypeEnum:   `([^\n]+)`,
+	}
+
+	numberRegexp   = regexp.MustCompile(numberPattern)
+	currencyRegexp = regexp.MustCompile(`(?i)` + currencyPattern)
+	spacesRegexp   = regexp.MustCompile(`[ \t\x{00a0}]+`)
+	dateRegexp     = regexp.MustCompile(`(?i)^` + datePattern + `$`)
+	numericDate    = regexp.MustCompile(`^(\d{1,2})[./-](\d{1,2})[./-](\d{2,4})$`)
+
+	months = map[string]time.Month{
+		"января": time.January, "февраля": time.February, "марта": time.March, "апреля": time.April,
+		"мая": time.May, "июня": time.June, "июля": time.July, "августа": time.August,
+		"сентября": time.September, "октября": time.October, "ноября": time.November, "декабря": time.December,
+	}
+
+	currencies = map[string]string{
+		"руб": "RUB", "р.": "RUB", "₽": "RUB", "rub": "RUB",
+		"usd": "USD", "$": "USD", "долл": "USD",
+		"eur": "EUR", "€": "EUR", "евро": "EUR",
+	}
+)
+
+// Match - значение поля, найденное в тексте
+type Match struct {
+	Key   string
+	Value types.FieldValue
+	// Text - фрагмент текста, из которого прочитано значение
+	Text       string
+	Confidence float64
+}
+
+// locator - скомпилированный способ поиска
+type locator struct {
+	pattern    *regexp.Regexp
+	fields     []string
+	confidence float64
+}
+
+// ValidateTemplate проверяет шаблон по схеме полей типа: выражения компилируются,
+// число групп не меньше числа полей, поля есть в схеме и их значения можно прочитать из текста
+func ValidateTemplate(fields []types.DocumentField, template types.ExtractionTemplate) error {
+	if template.ReviewThreshold < 0 || template.ReviewThreshold > 1 {
+		return errors.New("review threshold must be between 0 and 1")
+	}
+	_, err := compile(schema(fields), template)
+	return err
+}
+
+// Extract ищет в тексте значения полей по шаблону
+// Для каждого поля возвращается одно значение: совпадения нескольких способов поиска повышают уверенность,
+// а разные значения одного поля снижают ее пропорционально их весу. Поля-ссылки на контрагентов не извлекаются
+func Extract(text string, fields []types.DocumentField, template types.ExtractionTemplate) ([]Match, error) {
+	byKey := schema(fields)
+	locators, err := compile(byKey, template)
+	if err != nil {
+		return nil, err
+	}
+	text = normalize(text)
+
+	// candidates - уверенность каждого способа в каждом значении поля, значения сравниваются по Value
+	type candidate struct {
+		match       Match
+		confidences []float64
+	}
+	candidates := make(map[string]map[string]*candidate)
+	for _, l := range locators {
+		// Один способ поиска голосует за значение один раз, даже если оно встречается в тексте несколько раз
+		seen := make(map[string]bool)
+		for _, groups := range l.pattern.FindAllStringSubmatch(text, -1) {
+			for i, key := range l.fields {
+				if key == "" || i+1 >= len(groups) {
+					continue
+				}
+				raw := strings.TrimSpace(groups[i+1])
+				value, quality, ok := parse(byKey[key], raw)
+				if !ok {
+					continue
+				}
+				id := fmt.Sprint(value.Value())
+				if seen[key+"\x00"+id] {
+					continue
+				}
+				seen[key+"\x00"+id] = true
+
+				if candidates[key] == nil {
+					candidates[key] = make(map[string]*candidate)
+				}
+				c, ok := candidates[key][id]
+				if !ok {
+					c = &candidate{match: Match{Key: key, Value: value, Text: raw}}
+					candidates[key][id] = c
+				}
+				c.confidences = append(c.confidences, l.confidence*quality)
+			}
+		}
+	}
+
+	matches := make([]Match, 0, len(candidates))
+	for _, f := range fields {
+		values := candidates[f.Key]
+		if len(values) == 0 {
+			continue
+		}
+		var (
+			best  *candidate
+			total float64
+		)
+		for _, c := range values {
+			// Независимые способы поиска, нашедшие одно значение, подтверждают друг друга
+			miss := 1.0
+			for _, confidence := range c.confidences {
+				miss *= 1 - confidence
+			}
+			c.match.Confidence = 1 - miss
+			total += c.match.Confidence
+			if best == nil || c.match.Confidence > best.match.Confidence ||
+				(c.match.Confidence == best.match.Confidence && c.match.Text < best.match.Text) {
+				best = c
+			}
+		}
+		match := best.match
+		match.Confidence = round(match.Confidence * match.Confidence / total)
+		matches = append(matches, match)
+	}
+	return matches, nil
+}
+
+// compile компилирует способы поиска шаблона
+func compile(byKey map[string]types.DocumentField, template types.ExtractionTemplate) ([]locator, error) {
+	locators := make([]locator, 0, len(template.Locators))
+	for i, l := range template.Locators {
+		if l.Confidence < 0 || l.Confidence > 1 {
+			return nil, fmt.Errorf("locator %d: confidence must be between 0 and 1", i)
+		}
+		for _, key := range l.Fields {
+			if key == "" {
+				continue
+			}
+			f, ok := byKey[key]
+			if !ok {
+				return nil, fmt.Errorf("locator %d: unknown field %q", i, key)
+			}
+			if f.Type == types.FieldTypeSender {
+				return nil, fmt.Errorf("locator %d: sender field %q cannot be extracted from text", i, key)
+			}
+		}
+
+		compiled := locator{fields: l.Fields, confidence: l.Confidence}
+		var expr string
+		switch l.Kind {
+		case types.LocatorRegex:
+			if strings.TrimSpace(l.Pattern) == "" {
+				return nil, fmt.Errorf("locator %d: pattern is required", i)
+			}
+			expr = normalize(l.Pattern)
+			for macro, group := range macros {
+				expr = strings.ReplaceAll(expr, macro, group)
+			}
+			if compiled.confidence == 0 {
+				compiled.confidence = confidenceRegex
+			}
+		case types.LocatorAnchor:
+			anchor := strings.TrimSpace(normalize(l.Anchor))
+			if anchor == "" {
+				return nil, fmt.Errorf("locator %d: anchor is required", i)
+			}
+			if len(l.Fields) != 1 || l.Fields[0] == "" {
+				return nil, fmt.Errorf("locator %d: anchor fills exactly one field", i)
+			}
+			// Между якорем и значением допускаются пробелы, двоеточие, знак номера и тире
+			expr = strings.ReplaceAll(regexp.QuoteMeta(anchor), " ", `[ \t]+`) + `[ \t:№#=-]*` + valuePatterns[byKey[l.Fields[0]].Type]
+			if compiled.confidence == 0 {
+				compiled.confidence = confidenceAnchor
+			}
+		default:
+			return nil, fmt.Errorf("locator %d: unknown kind %q", i, l.Kind)
+		}
+
+		pattern, err := regexp.Compile(`(?im)` + expr)
+		if err != nil {
+			return nil, fmt.Errorf("locator %d: %w", i, err)
+		}
+		if pattern.NumSubexp() < len(l.Fields) {
+			return nil, fmt.Errorf("locator %d: pattern has %d groups for %d fields", i, pattern.NumSubexp(), len(l.Fields))
+		}
+		compiled.pattern = pattern
+		locators = append(locators, compiled)
+	}
+	return locators, nil
+}
+
+// parse читает значение поля из найденного фрагмента и возвращает качество разбора от 0 до 1
+func parse(f types.DocumentField, raw string) (types.FieldValue, float64, bool) {
+	if raw == "" {
+		return types.FieldValue{}, 0, false
+	}
+	switch f.Type {
+	case types.FieldTypeString:
+		if len([]rune(raw)) > maxStringLength {
+			raw = string([]rune(raw)[:maxStringLength])
+		}
+		return types.FieldValue{String: &raw}, 1, true
+
+	case types.FieldTypeEnum:
+		lower := strings.ToLower(raw)
+		for _, option := range f.Options {
+			if strings.ToLower(option) == lower {
+				return types.FieldValue{String: &option}, 1, true
+			}
+		}
+		for _, option := range f.Options {
+			if strings.Contains(lower, strings.ToLower(option)) {
+				return types.FieldValue{String: &option}, qualityPartial, true
+			}
+		}
+
+	case types.FieldTypeNumber:
+		if number, ok := parseNumber(raw); ok {
+			return types.FieldValue{Number: &number}, 1, true
+		}
+
+	case types.FieldTypeMoney:
+		number, ok := parseNumber(raw)
+		if !ok {
+			break
+		}
+		quality := 1.0
+		currency := f.Currency
+		if symbol := currencyRegexp.FindString(raw); symbol != "" {
+			currency = currencyCode(symbol)
+		} else if currency == "" {
+			// Валюта не указана ни в тексте, ни в поле
+			currency, quality = "RUB", qualityNormalized
+		}
+		return types.FieldValue{Number: &number, Currency: currency}, quality, true
+
+	case types.FieldTypeDate:
+		if date, quality, ok := parseDate(raw); ok {
+			return types.FieldValue{Date: &date}, quality, true
+		}
+	}
+	return types.FieldValue{}, 0, false
+}
+
+// parseNumber читает число с пробелами между разрядами и запятой или точкой перед дробной частью
+func parseNumber(raw string) (float64, bool) {
+	s := numberRegexp.FindString(raw)
+	if s == "" {
+		return 0, false
+	}
+	s = strings.ReplaceAll(strings.ReplaceAll(s, " ", ""), "\t", "")
+	number, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", "."), 64)
+	return number, err == nil
+}
+
+// parseDate читает дату в форматах 02.01.2006, 02.01.06, 2006-01-02 и 2 января 2006
+func parseDate(raw string) (time.Time, float64, bool) {
+	raw = strings.ToLower(raw)
+	if !dateRegexp.MatchString(raw) {
+		return time.Time{}, 0, false
+	}
+	if date, err := time.Parse(types.DateLayout, raw); err == nil {
+		return date, 1, true
+	}
+
+	if parts := numericDate.FindStringSubmatch(raw); parts != nil {
+		day, _ := strconv.Atoi(parts[1])
+		month, _ := strconv.Atoi(parts[2])
+		year, _ := strconv.Atoi(parts[3])
+		quality := 1.0
+		if len(parts[3]) == 2 {
+			year += 2000
+			quality = qualityNormalized
+		}
+		return validDate(year, time.Month(month), day, quality)
+	}
+
+	fields := strings.Fields(raw)
+	day, _ := strconv.Atoi(fields[0])
+	year, _ := strconv.Atoi(fields[2])
+	return validDate(year, months[fields[1]], day, qualityNormalized)
+}
+
+// validDate отбрасывает несуществующие даты, например 31.02
+func validDate(year int, month time.Month, day int, quality float64) (time.Time, float64, bool) {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	if date.Day() != day || date.Month() != month || year < 1900 {
+		return time.Time{}, 0, false
+	}
+	return date, quality, true
+}
+
+func currencyCode(symbol string) string {
+	symbol = strings.ToLower(symbol)
+	for prefix, code := range currencies {
+		if strings.HasPrefix(symbol, prefix) {
+			return code
+		}
+	}
+	return "RUB"
+}
+
+// normalize приводит текст и шаблоны к общему виду: ё заменяется на е, пробелы схлопываются
+func normalize(text string) string {
+	text = strings.NewReplacer("ё", "е", "Ё", "Е").Replace(text)
+	return spacesRegexp.ReplaceAllString(text, " ")
+}
+
+func schema(fields []types.DocumentField) map[string]types.DocumentField {
+	byKey := make(map[string]types.DocumentField, len(fields))
+	for _, f := range fields {
+		byKey[f.Key] = f
+	}
+	return byKey
+}
+
+func round(confidence float64) float64 {
+	return float64(int(confidence*100+0.5)) / 100
+}
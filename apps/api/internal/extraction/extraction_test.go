@@ -0,0 +1,212 @@
+package extraction_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"techmind/internal/extraction"
+	"techmind/schema/types"
+)
+
+const invoice = `Счёт на оплату № 123 от 05.03.2024
+Поставщик: ООО «Ромашка»
+Дата счета: 5 марта 2024
+Итого к оплате: 12 345,67 руб.
+Назначение платежа: аванс по договору № 7`
+
+var fields = []types.DocumentField{
+	{Key: "number", Name: "Номер", Type: types.FieldTypeNumber},
+	{Key: "date", Name: "Дата", Type: types.FieldTypeDate},
+	{Key: "total", Name: "Сумма", Type: types.FieldTypeMoney, Currency: "USD"},
+	{Key: "payment", Name: "Назначение", Type: types.FieldTypeEnum, Options: []string{"Аванс", "Постоплата"}},
+	{Key: "supplier", Name: "Поставщик", Type: types.FieldTypeString},
+	{Key: "sender", Name: "Контрагент", Type: types.FieldTypeSender},
+}
+
+func TestExtract(t *testing.T) {
+	template := types.ExtractionTemplate{Locators: []types.FieldLocator{
+		{Kind: types.LocatorRegex, Pattern: `Счет на оплату № (number) от (date)`, Fields: []string{"number", "date"}},
+		{Kind: types.LocatorAnchor, Anchor: "Дата счета", Fields: []string{"date"}},
+		{Kind: types.LocatorAnchor, Anchor: "Итого к оплате", Fields: []string{"total"}},
+		{Kind: types.LocatorRegex, Pattern: `к оплате: (amount)`, Fields: []string{"total"}, Confidence: 0.5},
+		{Kind: types.LocatorAnchor, Anchor: "Назначение платежа", Fields: []string{"payment"}},
+		{Kind: types.LocatorAnchor, Anchor: "Поставщик", Fields: []string{"supplier"}},
+	}}
+
+	matches, err := extraction.Extract(invoice, fields, template)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := make(map[string]interface{}, len(matches))
+	confidences := make(map[string]float64, len(matches))
+	for _, m := range matches {
+		got[m.Key] = m.Value.Value()
+		confidences[m.Key] = m.Confidence
+	}
+	want := map[string]interface{}{
+		"number":   123.0,
+		"date":     "2024-03-05",
+		"total":    types.MoneyValue{Amount: 12345.67, Currency: "RUB"},
+		"payment":  "Аванс",
+		"supplier": "ООО «Ромашка»",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Extract() values = %v, want %v", got, want)
+	}
+
+	wantConfidences := map[string]float64{
+		// Регулярное выражение с уверенностью по умолчанию
+		"number": 0.9,
+		// Выражение и якорь подтверждают одну дату: 1 - (1 - 0.9) * (1 - 0.75 * 0.9)
+		"date": 0.97,
+		// Два способа поиска нашли одну сумму: 1 - (1 - 0.75) * (1 - 0.5)
+		"total": 0.88,
+		// Вариант перечисления найден внутри более длинного текста: 0.75 * 0.8
+		"payment":  0.6,
+		"supplier": 0.75,
+	}
+	if !reflect.DeepEqual(confidences, wantConfidences) {
+		t.Fatalf("Extract() confidences = %v, want %v", confidences, wantConfidences)
+	}
+}
+
+func TestExtract_ConflictingValues(t *testing.T) {
+	text := "Номер: 10\nНомер документа: 20"
+	template := types.ExtractionTemplate{Locators: []types.FieldLocator{
+		{Kind: types.LocatorAnchor, Anchor: "Номер", Fields: []string{"number"}, Confidence: 0.8},
+		{Kind: types.LocatorRegex, Pattern: `документа: (number)`, Fields: []string{"number"}, Confidence: 0.4},
+	}}
+
+	matches, err := extraction.Extract(text, fields, template)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected one value, got %+v", matches)
+	}
+	// Разные значения одного поля снижают уверенность пропорционально весу: 0.8 * 0.8 / (0.8 + 0.4)
+	if got := matches[0]; got.Value.Value() != 10.0 || got.Confidence != 0.53 {
+		t.Fatalf("expected 10 with confidence 0.53, got %v with %v", got.Value.Value(), got.Confidence)
+	}
+}
+
+func TestExtract_Values(t *testing.T) {
+	date := func(year int, month time.Month, day int) string {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Format(types.DateLayout)
+	}
+
+	tests := []struct {
+		name  string
+		field string
+		text  string
+		want  interface{}
+	}{
+		{"дата с коротким годом", "date", "Дата: 05.03.24", date(2024, time.March, 5)},
+		{"дата в формате ISO", "date", "Дата: 2024-03-05", date(2024, time.March, 5)},
+		{"несуществующая дата", "date", "Дата: 31.02.2024", nil},
+		{"сумма в долларах", "total", "Сумма: 1 000.50 $", types.MoneyValue{Amount: 1000.5, Currency: "USD"}},
+		{"валюта поля по умолчанию", "total", "Сумма: 250", types.MoneyValue{Amount: 250, Currency: "USD"}},
+		{"неизвестный вариант перечисления", "payment", "Назначение: оплата услуг", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var anchor string
+			for _, f := range fields {
+				if f.Key == tt.field {
+					anchor = f.Name
+				}
+			}
+			template := types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorAnchor, Anchor: anchor, Fields: []string{tt.field}},
+			}}
+			matches, err := extraction.Extract(tt.text, fields, template)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got interface{}
+			if len(matches) > 0 {
+				got = matches[0].Value.Value()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template types.ExtractionTemplate
+		wantErr  string
+	}{
+		{
+			name: "корректный шаблон",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorRegex, Pattern: `№ (number) от (date)`, Fields: []string{"number", ""}},
+			}},
+		},
+		{
+			name:     "порог проверки больше 1",
+			template: types.ExtractionTemplate{ReviewThreshold: 1.5},
+			wantErr:  "review threshold must be between 0 and 1",
+		},
+		{
+			name: "ошибка в выражении",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorRegex, Pattern: `№ (\d+`, Fields: []string{"number"}},
+			}},
+			wantErr: "locator 0: error parsing regexp: missing closing ): `(?im)№ (\\d+`",
+		},
+		{
+			name: "групп меньше, чем полей",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorRegex, Pattern: `№ (\d+)`, Fields: []string{"number", "date"}},
+			}},
+			wantErr: "locator 0: pattern has 1 groups for 2 fields",
+		},
+		{
+			name: "неизвестное поле",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorAnchor, Anchor: "Итого", Fields: []string{"amount"}},
+			}},
+			wantErr: `locator 0: unknown field "amount"`,
+		},
+		{
+			name: "поле-ссылка на контрагента",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorAnchor, Anchor: "Поставщик", Fields: []string{"sender"}},
+			}},
+			wantErr: `locator 0: sender field "sender" cannot be extracted from text`,
+		},
+		{
+			name: "якорь для двух полей",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: types.LocatorAnchor, Anchor: "Счет", Fields: []string{"number", "date"}},
+			}},
+			wantErr: "locator 0: anchor fills exactly one field",
+		},
+		{
+			name: "неизвестный способ поиска",
+			template: types.ExtractionTemplate{Locators: []types.FieldLocator{
+				{Kind: "xpath", Fields: []string{"number"}},
+			}},
+			wantErr: `locator 0: unknown kind "xpath"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := extraction.ValidateTemplate(fields, tt.template)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Fatalf("expected error %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"techmind/internal/repo"
 	"techmind/schema/ent"
 	"techmind/schema/ent/company"
+	"techmind/schema/types"
 
 	"github.com/google/uuid"
 )
@@ -49,3 +50,10 @@ func (r *companyRepo) List(ctx context.Context) ([]*ent.Company, error) {
 		Query().
 		All(ctx)
 }
+
+func (r *companyRepo) UpdateSettings(ctx context.Context, id uuid.UUID, settings types.CompanySettings) (*ent.Company, error) {
+	return r.client.Company.
+		UpdateOneID(id).
+		SetSettings(settings).
+		Save(ctx)
+}
@@ -2,11 +2,14 @@ package document
 
 import (
 	"context"
+	"encoding/json"
 
 	"techmind/internal/repo"
 	"techmind/schema/ent"
 	"techmind/schema/ent/document"
+	"techmind/schema/types"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
@@ -18,7 +21,7 @@ func NewRepository(client *ent.Client) repo.DocumentRepository {
 	return &documentRepo{client: client}
 }
 
-func (r *documentRepo) Create(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID, name string, filePath string, fileSize int64, mimeType string, checksum string, createdBy uuid.UUID) (*ent.Document, error) {
+func (r *documentRepo) Create(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, filePath string, fileSize int64, mimeType string, checksum string, createdBy uuid.UUID, fields *repo.DocumentFields) (*ent.Document, error) {
 	create := r.client.Document.
 		Create().
 		SetCompanyID(companyID).
@@ -33,6 +36,12 @@ func (r *documentRepo) Create(ctx context.Context, companyID uuid.UUID, folderID
 	if folderID != nil {
 		create = create.SetFolderID(*folderID)
 	}
+	if senderID != nil {
+		create = create.SetSenderID(*senderID)
+	}
+	if fields != nil && fields.TypeID != nil {
+		create = create.SetTypeID(*fields.TypeID).SetFieldValues(fields.Values)
+	}
 
 	return create.Save(ctx)
 }
@@ -45,8 +54,26 @@ func (r *documentRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.Document
 		Only(ctx)
 }
 
+func (r *documentRepo) GetByChecksum(ctx context.Context, companyID uuid.UUID, checksum string) (*ent.Document, error) {
+	return r.client.Document.
+		Query().
+		Where(
+			document.CompanyID(companyID),
+			document.Checksum(checksum),
+		).
+		Only(ctx)
+}
+
+func (r *documentRepo) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Document, error) {
+	return r.client.Document.
+		Query().
+		Where(document.IDIn(ids...)).
+		WithSender().
+		All(ctx)
+}
+
 // func (r *documentRepo) Update(ctx context.Context, id uuid.UUID, filePath string, fileSize int64, mimeType string, checksum string) (*ent.Document, error) {
-func (r *documentRepo) Update(ctx context.Context, id uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, updatedBy uuid.UUID) (*ent.Document, error) {
+func (r *documentRepo) Update(ctx context.Context, id uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, updatedBy uuid.UUID, fields *repo.DocumentFields) (*ent.Document, error) {
 	update := r.client.Document.
 		UpdateOneID(id).
 		SetName(name).
@@ -58,6 +85,13 @@ func (r *documentRepo) Update(ctx context.Context, id uuid.UUID, folderID *uuid.
 	if senderID != nil {
 		update = update.SetSenderID(*senderID)
 	}
+	if fields != nil {
+		if fields.TypeID != nil {
+			update = update.SetTypeID(*fields.TypeID).SetFieldValues(fields.Values)
+		} else {
+			update = update.ClearTypeID().ClearFieldValues()
+		}
+	}
 
 	return update.Save(ctx)
 }
@@ -69,6 +103,116 @@ func (r *documentRepo) UpdatePreviewPath(ctx context.Context, id uuid.UUID, prev
 		Exec(ctx)
 }
 
+func (r *documentRepo) AttachSender(ctx context.Context, id, senderID uuid.UUID) (bool, error) {
+	// Условие в UPDATE не дает затереть контрагента, выбранного пользователем во время обработки
+	updated, err := r.client.Document.
+		Update().
+		Where(
+			document.ID(id),
+			document.SenderIDIsNil(),
+		).
+		SetSenderID(senderID).
+		Save(ctx)
+	return updated > 0, err
+}
+
+func (r *documentRepo) MergeMetadata(ctx context.Context, id uuid.UUID, patch types.DocumentMetadata) error {
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+
+	// Слияние выполняется в одном UPDATE, чтобы параллельные обработчики
+	// (метаданные PDF, OCR) не затирали разделы друг друга
+	return r.client.Document.
+		UpdateOneID(id).
+		Modify(func(u *sql.UpdateBuilder) {
+			u.Set(document.FieldMetadata, sql.Expr("COALESCE("+document.FieldMetadata+", '{}'::jsonb) || ?::jsonb", string(data)))
+		}).
+		Exec(ctx)
+}
+
+func (r *documentRepo) AttachType(ctx context.Context, id, typeID uuid.UUID) (bool, error) {
+	// Условие в UPDATE не дает затереть тип, выбранный пользователем во время обработки
+	updated, err := r.client.Document.
+		Update().
+		Where(
+			document.ID(id),
+			document.TypeIDIsNil(),
+		).
+		SetTypeID(typeID).
+		Save(ctx)
+	return updated > 0, err
+}
+
+func (r *documentRepo) ListClassifierExamples(ctx context.Context, companyID uuid.UUID, taggedIDs []uuid.UUID, limit int) ([]*ent.Document, error) {
+	return r.client.Document.
+		Query().
+		Where(
+			document.CompanyID(companyID),
+			document.HasContent(),
+			document.Or(
+				// Тип, присвоенный самим классификатором, не подтверждает модель и в обучение не попадает
+				func(s *sql.Selector) {
+					s.Where(sql.And(
+						sql.NotNull(s.C(document.FieldTypeID)),
+						sql.ExprP("("+s.C(document.FieldMetadata)+" -> 'classification' ->> 'applied_type_id') IS DISTINCT FROM "+s.C(document.FieldTypeID)+"::text"),
+					))
+				},
+				document.IDIn(taggedIDs...),
+			),
+		).
+		WithContent().
+		Order(ent.Desc(document.FieldCreatedAt), ent.Desc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *documentRepo) SetFieldValues(ctx context.Context, id, typeID uuid.UUID, values map[string]types.FieldValue) (bool, error) {
+	// Условие на тип не дает записать значения по старой схеме, если тип сменили во время извлечения
+	updated, err := r.client.Document.
+		Update().
+		Where(
+			document.ID(id),
+			document.TypeID(typeID),
+		).
+		SetFieldValues(values).
+		Save(ctx)
+	return updated > 0, err
+}
+
+func (r *documentRepo) ListFieldExtractionReview(ctx context.Context, companyID uuid.UUID, offset, limit int) ([]*ent.Document, int, error) {
+	query := r.client.Document.
+		Query().
+		Where(
+			document.CompanyID(companyID),
+			document.TypeIDNotNil(),
+			// Результаты извлечения для прежнего типа документа в очередь не попадают
+			func(s *sql.Selector) {
+				extraction := s.C(document.FieldMetadata) + " -> 'field_extraction'"
+				s.Where(sql.And(
+					sql.ExprP("("+extraction+" ->> 'pending')::int > 0"),
+					sql.ExprP(extraction+" ->> 'type_id' = "+s.C(document.FieldTypeID)+"::text"),
+				))
+			},
+		)
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	documents, err := query.
+		Order(ent.Desc(document.FieldCreatedAt), ent.Desc(document.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return documents, total, nil
+}
+
 func (r *documentRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.client.Document.
 		DeleteOneID(id).
@@ -96,3 +240,27 @@ func (r *documentRepo) ListByFolder(ctx context.Context, folderID uuid.UUID) ([]
 		WithSender().
 		All(ctx)
 }
+
+func (r *documentRepo) ListBySender(ctx context.Context, senderID uuid.UUID) ([]*ent.Document, error) {
+	return r.client.Document.
+		Query().
+		Where(document.SenderID(senderID)).
+		All(ctx)
+}
+
+func (r *documentRepo) Count(ctx context.Context) (int, error) {
+	return r.client.Document.
+		Query().
+		Count(ctx)
+}
+
+func (r *documentRepo) ListAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*ent.Document, error) {
+	return r.client.Document.
+		Query().
+		Where(document.IDGT(afterID)).
+		WithContent().
+		WithDocumentTags().
+		Order(ent.Asc(document.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
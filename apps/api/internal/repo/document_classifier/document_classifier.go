@@ -0,0 +1,65 @@
+package document_classifier
+
+import (
+	"context"
+	"fmt"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type documentClassifierRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.DocumentClassifierRepository {
+	return &documentClassifierRepo{client: client}
+}
+
+func (r *documentClassifierRepo) Save(ctx context.Context, companyID uuid.UUID, model types.ClassifierModel, evaluation types.ClassifierEvaluation, documents int) (*ent.DocumentClassifier, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	classifier, err := replaceClassifier(ctx, tx.Client(), companyID, model, evaluation, documents)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return classifier, nil
+}
+
+// replaceClassifier удаляет прежнюю модель компании и сохраняет новую
+func replaceClassifier(ctx context.Context, client *ent.Client, companyID uuid.UUID, model types.ClassifierModel, evaluation types.ClassifierEvaluation, documents int) (*ent.DocumentClassifier, error) {
+	if _, err := client.DocumentClassifier.
+		Delete().
+		Where(documentclassifier.CompanyID(companyID)).
+		Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to delete previous classifier: %w", err)
+	}
+	return client.DocumentClassifier.
+		Create().
+		SetCompanyID(companyID).
+		SetModel(model).
+		SetEvaluation(evaluation).
+		SetDocuments(documents).
+		Save(ctx)
+}
+
+func (r *documentClassifierRepo) GetByCompany(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error) {
+	return r.client.DocumentClassifier.
+		Query().
+		Where(documentclassifier.CompanyID(companyID)).
+		Only(ctx)
+}
@@ -0,0 +1,55 @@
+package document_content
+
+import (
+	"context"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type documentContentRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.DocumentContentRepository {
+	return &documentContentRepo{client: client}
+}
+
+func (r *documentContentRepo) Save(ctx context.Context, documentID uuid.UUID, text string, pages []types.PageOffset, language, engine, engineVersion string) (*ent.DocumentContent, error) {
+	existing, err := r.GetByDocumentID(ctx, documentID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	if existing != nil {
+		return r.client.DocumentContent.
+			UpdateOne(existing).
+			SetText(text).
+			SetPages(pages).
+			SetLanguage(language).
+			SetEngine(engine).
+			SetEngineVersion(engineVersion).
+			Save(ctx)
+	}
+
+	return r.client.DocumentContent.
+		Create().
+		SetDocumentID(documentID).
+		SetText(text).
+		SetPages(pages).
+		SetLanguage(language).
+		SetEngine(engine).
+		SetEngineVersion(engineVersion).
+		Save(ctx)
+}
+
+func (r *documentContentRepo) GetByDocumentID(ctx context.Context, documentID uuid.UUID) (*ent.DocumentContent, error) {
+	return r.client.DocumentContent.
+		Query().
+		Where(documentcontent.DocumentID(documentID)).
+		Only(ctx)
+}
@@ -2,9 +2,13 @@ package document_tag
 
 import (
 	"context"
+	"fmt"
 	"techmind/internal/repo"
 	"techmind/schema/ent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/tag"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -25,6 +29,122 @@ func (r *documentTagRepo) Create(ctx context.Context, documentID, tagID uuid.UUI
 		Save(ctx)
 }
 
+func (r *documentTagRepo) Assign(ctx context.Context, documentID, tagID uuid.UUID, assignment repo.TagAssignment) ([]uuid.UUID, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := assign(ctx, tx.Client(), documentID, tagID, assignment)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// assign связывает тег с документом и убирает остальные теги исключающей группы.
+// Ручное назначение делает существующую автоматическую связь ручной и снимает запрет на тег,
+// автоматическое не заменяет тег группы, присвоенный вручную
+func assign(ctx context.Context, client *ent.Client, documentID, tagID uuid.UUID, assignment repo.TagAssignment) ([]uuid.UUID, error) {
+	t, err := client.Tag.
+		Query().
+		Where(tag.ID(tagID)).
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []uuid.UUID
+	if t.Edges.Group != nil && t.Edges.Group.Exclusive {
+		groupTagIDs, err := client.Tag.
+			Query().
+			Where(
+				tag.GroupID(t.Edges.Group.ID),
+				tag.IDNEQ(tagID),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		links, err := client.DocumentTag.
+			Query().
+			Where(
+				documenttag.DocumentID(documentID),
+				documenttag.TagIDIn(groupTagIDs...),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uuid.UUID, 0, len(links))
+		for _, link := range links {
+			if assignment.Source != documenttag.SourceManual && link.Source == documenttag.SourceManual {
+				return nil, repo.ErrManualTagInGroup
+			}
+			ids = append(ids, link.ID)
+			removed = append(removed, link.TagID)
+		}
+		if len(ids) > 0 {
+			if _, err := client.DocumentTag.Delete().Where(documenttag.IDIn(ids...)).Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	link, err := client.DocumentTag.
+		Query().
+		Where(
+			documenttag.DocumentID(documentID),
+			documenttag.TagID(tagID),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		_, err = client.DocumentTag.
+			Create().
+			SetDocumentID(documentID).
+			SetTagID(tagID).
+			SetSource(assignment.Source).
+			SetNillableRuleID(assignment.RuleID).
+			SetNillableRunID(assignment.RunID).
+			SetNillableCreatedBy(assignment.CreatedBy).
+			Save(ctx)
+	case err == nil && assignment.Source == documenttag.SourceManual && link.Source != documenttag.SourceManual:
+		_, err = link.Update().
+			SetSource(documenttag.SourceManual).
+			ClearRuleID().
+			ClearRunID().
+			SetNillableCreatedBy(assignment.CreatedBy).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if assignment.Source == documenttag.SourceManual {
+		_, err := client.DocumentTagSuppression.
+			Delete().
+			Where(
+				documenttagsuppression.DocumentID(documentID),
+				documenttagsuppression.TagID(tagID),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return removed, nil
+}
+
 func (r *documentTagRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentTag, error) {
 	return r.client.DocumentTag.
 		Query().
@@ -51,6 +171,13 @@ func (r *documentTagRepo) ListByDocument(ctx context.Context, documentID uuid.UU
 		All(ctx)
 }
 
+func (r *documentTagRepo) ListByDocuments(ctx context.Context, documentIDs []uuid.UUID) ([]*ent.DocumentTag, error) {
+	return r.client.DocumentTag.
+		Query().
+		Where(documenttag.DocumentIDIn(documentIDs...)).
+		All(ctx)
+}
+
 func (r *documentTagRepo) ListByTag(ctx context.Context, tagID uuid.UUID) ([]*ent.DocumentTag, error) {
 	return r.client.DocumentTag.
 		Query().
@@ -58,13 +185,95 @@ func (r *documentTagRepo) ListByTag(ctx context.Context, tagID uuid.UUID) ([]*en
 		All(ctx)
 }
 
-func (r *documentTagRepo) DeleteByDocumentAndTag(ctx context.Context, documentID, tagID uuid.UUID) error {
-	_, err := r.client.DocumentTag.
+func (r *documentTagRepo) DeleteByDocumentAndTag(ctx context.Context, documentID, tagID uuid.UUID) (int, error) {
+	return r.client.DocumentTag.
+		Delete().
+		Where(
+			documenttag.DocumentID(documentID),
+			documenttag.TagID(tagID),
+		).
+		Exec(ctx)
+}
+
+func (r *documentTagRepo) Remove(ctx context.Context, documentID, tagID uuid.UUID, removedBy *uuid.UUID, suppress bool) (bool, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	removed, err := remove(ctx, tx.Client(), documentID, tagID, removedBy, suppress)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return false, fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return removed, nil
+}
+
+// remove удаляет связь и записывает запрет на тег, если связь была или suppress требует запрета.
+// Повторное снятие тега обновляет существующий запрет
+func remove(ctx context.Context, client *ent.Client, documentID, tagID uuid.UUID, removedBy *uuid.UUID, suppress bool) (bool, error) {
+	deleted, err := client.DocumentTag.
 		Delete().
 		Where(
 			documenttag.DocumentID(documentID),
 			documenttag.TagID(tagID),
 		).
 		Exec(ctx)
-	return err
+	if err != nil {
+		return false, err
+	}
+	if deleted == 0 && !suppress {
+		return false, nil
+	}
+
+	updated, err := client.DocumentTagSuppression.
+		Update().
+		Where(
+			documenttagsuppression.DocumentID(documentID),
+			documenttagsuppression.TagID(tagID),
+		).
+		SetNillableRemovedBy(removedBy).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if updated == 0 {
+		_, err = client.DocumentTagSuppression.
+			Create().
+			SetDocumentID(documentID).
+			SetTagID(tagID).
+			SetNillableRemovedBy(removedBy).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	return deleted > 0, nil
+}
+
+func (r *documentTagRepo) ListByRun(ctx context.Context, runID uuid.UUID) ([]*ent.DocumentTag, error) {
+	return r.client.DocumentTag.
+		Query().
+		Where(
+			documenttag.RunID(runID),
+			documenttag.SourceEQ(documenttag.SourceRule),
+		).
+		All(ctx)
+}
+
+func (r *documentTagRepo) DeleteByRun(ctx context.Context, runID uuid.UUID) (int, error) {
+	return r.client.DocumentTag.
+		Delete().
+		Where(
+			documenttag.RunID(runID),
+			documenttag.SourceEQ(documenttag.SourceRule),
+		).
+		Exec(ctx)
 }
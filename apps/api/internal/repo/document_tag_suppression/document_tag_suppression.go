@@ -0,0 +1,49 @@
+package document_tag_suppression
+
+import (
+	"context"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/documenttagsuppression"
+
+	"github.com/google/uuid"
+)
+
+type documentTagSuppressionRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.DocumentTagSuppressionRepository {
+	return &documentTagSuppressionRepo{client: client}
+}
+
+func (r *documentTagSuppressionRepo) Create(ctx context.Context, documentID, tagID uuid.UUID, removedBy *uuid.UUID) error {
+	updated, err := r.client.DocumentTagSuppression.
+		Update().
+		Where(
+			documenttagsuppression.DocumentID(documentID),
+			documenttagsuppression.TagID(tagID),
+		).
+		SetNillableRemovedBy(removedBy).
+		SetCreatedAt(time.Now()).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+	_, err = r.client.DocumentTagSuppression.
+		Create().
+		SetDocumentID(documentID).
+		SetTagID(tagID).
+		SetNillableRemovedBy(removedBy).
+		Save(ctx)
+	return err
+}
+
+func (r *documentTagSuppressionRepo) ListByDocument(ctx context.Context, documentID uuid.UUID) ([]*ent.DocumentTagSuppression, error) {
+	return r.client.DocumentTagSuppression.
+		Query().
+		Where(documenttagsuppression.DocumentID(documentID)).
+		All(ctx)
+}
@@ -0,0 +1,100 @@
+package document_type
+
+import (
+	"context"
+	"fmt"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttype"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type documentTypeRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.DocumentTypeRepository {
+	return &documentTypeRepo{client: client}
+}
+
+func (r *documentTypeRepo) Create(ctx context.Context, companyID uuid.UUID, name string, description *string, fields []types.DocumentField, extraction *types.ExtractionTemplate) (*ent.DocumentType, error) {
+	create := r.client.DocumentType.
+		Create().
+		SetCompanyID(companyID).
+		SetName(name).
+		SetNillableDescription(description).
+		SetFields(fields)
+	if extraction != nil {
+		create = create.SetExtraction(*extraction)
+	}
+	return create.Save(ctx)
+}
+
+func (r *documentTypeRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentType, error) {
+	return r.client.DocumentType.
+		Query().
+		Where(documenttype.ID(id)).
+		Only(ctx)
+}
+
+func (r *documentTypeRepo) Update(ctx context.Context, id uuid.UUID, name string, description *string, fields []types.DocumentField, extraction *types.ExtractionTemplate) (*ent.DocumentType, error) {
+	update := r.client.DocumentType.
+		UpdateOneID(id).
+		SetName(name).
+		SetFields(fields)
+	if description != nil {
+		update = update.SetDescription(*description)
+	} else {
+		update = update.ClearDescription()
+	}
+	if extraction != nil {
+		update = update.SetExtraction(*extraction)
+	} else {
+		update = update.ClearExtraction()
+	}
+	return update.Save(ctx)
+}
+
+func (r *documentTypeRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := deleteType(ctx, tx.Client(), id); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// deleteType снимает тип с документов через ent, а не через ON DELETE SET NULL,
+// чтобы изменение документов попало в outbox поискового индекса
+func deleteType(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	if _, err := client.Document.
+		Update().
+		Where(document.TypeID(id)).
+		ClearTypeID().
+		ClearFieldValues().
+		Save(ctx); err != nil {
+		return fmt.Errorf("failed to clear document type: %w", err)
+	}
+	return client.DocumentType.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r *documentTypeRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.DocumentType, error) {
+	return r.client.DocumentType.
+		Query().
+		Where(documenttype.CompanyID(companyID)).
+		Order(ent.Asc(documenttype.FieldName)).
+		All(ctx)
+}
@@ -0,0 +1,93 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/job"
+
+	"github.com/google/uuid"
+)
+
+type jobRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.JobRepository {
+	return &jobRepo{client: client}
+}
+
+func (r *jobRepo) Create(ctx context.Context, companyID *uuid.UUID, jobType string, payload map[string]interface{}, createdBy *uuid.UUID) (*ent.Job, error) {
+	create := r.client.Job.
+		Create().
+		SetType(jobType).
+		SetPayload(payload)
+
+	if companyID != nil {
+		create = create.SetCompanyID(*companyID)
+	}
+	if createdBy != nil {
+		create = create.SetCreatedBy(*createdBy)
+	}
+
+	return create.Save(ctx)
+}
+
+func (r *jobRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.Job, error) {
+	return r.client.Job.
+		Query().
+		Where(job.ID(id)).
+		Only(ctx)
+}
+
+func (r *jobRepo) MarkRunning(ctx context.Context, id uuid.UUID, total int) error {
+	return r.client.Job.
+		UpdateOneID(id).
+		SetStatus(job.StatusRunning).
+		SetTotal(total).
+		SetProgress(0).
+		Exec(ctx)
+}
+
+func (r *jobRepo) UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error {
+	return r.client.Job.
+		UpdateOneID(id).
+		SetProgress(progress).
+		Exec(ctx)
+}
+
+func (r *jobRepo) UpdatePayload(ctx context.Context, id uuid.UUID, payload map[string]interface{}) error {
+	return r.client.Job.
+		UpdateOneID(id).
+		SetPayload(payload).
+		Exec(ctx)
+}
+
+func (r *jobRepo) MarkCompleted(ctx context.Context, id uuid.UUID, resultDocumentIDs []uuid.UUID) error {
+	return r.client.Job.
+		UpdateOneID(id).
+		SetStatus(job.StatusCompleted).
+		SetResultDocumentIds(resultDocumentIDs).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *jobRepo) MarkFailed(ctx context.Context, id uuid.UUID, errMsg string, resultDocumentIDs []uuid.UUID) error {
+	return r.client.Job.
+		UpdateOneID(id).
+		SetStatus(job.StatusFailed).
+		SetError(errMsg).
+		SetResultDocumentIds(resultDocumentIDs).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *jobRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Job, error) {
+	return r.client.Job.
+		Query().
+		Where(job.CompanyID(companyID)).
+		Order(ent.Desc(job.FieldCreatedAt)).
+		All(ctx)
+}
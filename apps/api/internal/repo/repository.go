@@ -2,12 +2,20 @@ package repo
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"techmind/schema/ent"
+	"techmind/schema/ent/documenttag"
+	"techmind/schema/types"
 
 	"github.com/google/uuid"
 )
 
+// ErrManualTagInGroup is returned when an automatic tag assignment would replace
+// a manually assigned tag of the same exclusive group
+var ErrManualTagInGroup = errors.New("document has a manually assigned tag in the exclusive group")
+
 // UserRepository defines user-related database operations
 type UserRepository interface {
 	// Create creates a new user
@@ -22,6 +30,8 @@ type UserRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all users
 	List(ctx context.Context) ([]*ent.User, error)
+	// SetStoreSearchQueries enables or disables storing the user's search query text in search analytics
+	SetStoreSearchQueries(ctx context.Context, id uuid.UUID, store bool) (*ent.User, error)
 }
 
 // CompanyRepository defines company-related database operations
@@ -36,6 +46,8 @@ type CompanyRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all companies
 	List(ctx context.Context) ([]*ent.Company, error)
+	// UpdateSettings replaces company settings
+	UpdateSettings(ctx context.Context, id uuid.UUID, settings types.CompanySettings) (*ent.Company, error)
 }
 
 // CompanyUserRepository defines company user relationship operations
@@ -87,29 +99,117 @@ type FolderRepository interface {
 // SenderRepository defines sender-related database operations
 type SenderRepository interface {
 	// Create creates a new sender
-	Create(ctx context.Context, companyID uuid.UUID, name string, email *string) (*ent.Sender, error)
+	Create(ctx context.Context, companyID uuid.UUID, fields SenderFields) (*ent.Sender, error)
 	// GetByID retrieves a sender by ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Sender, error)
-	// Update updates an existing sender
-	Update(ctx context.Context, id uuid.UUID, name string, email *string) (*ent.Sender, error)
+	// Update replaces all profile fields of a sender
+	Update(ctx context.Context, id uuid.UUID, fields SenderFields) (*ent.Sender, error)
 	// Delete deletes a sender by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all senders
 	List(ctx context.Context) ([]*ent.Sender, error)
 	// ListByCompany retrieves all senders for a company
 	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Sender, error)
+	// Search retrieves a page of company senders ordered by name whose name, legal name, INN or email
+	// contains the query, and the total number of matching senders
+	Search(ctx context.Context, companyID uuid.UUID, query string, offset, limit int) ([]*ent.Sender, int, error)
+	// Merge replaces the target's fields, moves documents and references in tag rules and saved searches
+	// from the source sender to the target and deletes the source in a single transaction
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID, fields SenderFields) error
+	// ListDocuments retrieves a page of the sender's documents matching the filter ordered by creation time,
+	// newest first unless ascending, and the total number of matching documents
+	ListDocuments(ctx context.Context, id uuid.UUID, filter SenderDocumentFilter, ascending bool, offset, limit int) ([]*ent.Document, int, error)
+	// DocumentStats aggregates the sender's documents matching the filter
+	DocumentStats(ctx context.Context, id uuid.UUID, filter SenderDocumentFilter) (*SenderDocumentStats, error)
+}
+
+// SenderDocumentFilter selects documents of a sender, empty fields are not applied
+type SenderDocumentFilter struct {
+	// From is inclusive, To is exclusive
+	From      *time.Time
+	To        *time.Time
+	MimeTypes []string
+	// TagIDs selects documents having any of the tags
+	TagIDs   []uuid.UUID
+	FolderID *uuid.UUID
+}
+
+// SenderDocumentStats contains aggregated metrics of sender documents
+type SenderDocumentStats struct {
+	Documents int64
+	TotalSize int64
+	// FirstAt and LastAt are nil when no documents match
+	FirstAt *time.Time
+	LastAt  *time.Time
+	// Months are ordered oldest first, months without documents are omitted
+	Months []*SenderDocumentMonth
+	// MimeTypes and Tags are ordered by number of documents, most frequent first
+	MimeTypes []*SenderDocumentMimeType
+	Tags      []*SenderDocumentTag
+}
+
+// SenderDocumentMonth contains metrics of sender documents created in one month
+type SenderDocumentMonth struct {
+	Start     time.Time
+	Documents int64
+	TotalSize int64
+}
+
+// SenderDocumentMimeType contains the number of sender documents of one MIME type
+type SenderDocumentMimeType struct {
+	MimeType  string
+	Documents int64
+}
+
+// SenderDocumentTag contains the number of sender documents with a tag
+type SenderDocumentTag struct {
+	TagID     uuid.UUID
+	Documents int64
+}
+
+// SenderFields holds sender profile fields, nil and empty values are stored as absent
+type SenderFields struct {
+	Name      string
+	Email     *string
+	LegalName *string
+	INN       *string
+	KPP       *string
+	Emails    []string
+	Phones    []string
+	Addresses []string
+	Website   *string
+	Notes     *string
 }
 
 // DocumentRepository defines document-related database operations
 type DocumentRepository interface {
-	// Create creates a new document
-	Create(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID, name string, filePath string, fileSize int64, mimeType string, checksum string, createdBy uuid.UUID) (*ent.Document, error)
+	// Create creates a new document, fields sets its type and field values when not nil
+	Create(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, filePath string, fileSize int64, mimeType string, checksum string, createdBy uuid.UUID, fields *DocumentFields) (*ent.Document, error)
 	// GetByID retrieves a document by ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Document, error)
-	// Update updates an existing document
-	Update(ctx context.Context, id uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, updatedBy uuid.UUID) (*ent.Document, error)
+	// GetByChecksum retrieves a company document by the SHA-256 checksum of its file
+	GetByChecksum(ctx context.Context, companyID uuid.UUID, checksum string) (*ent.Document, error)
+	// ListByIDs retrieves documents by IDs in a single query, missing IDs are skipped
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Document, error)
+	// Update updates an existing document, the type and field values are left unchanged when fields is nil
+	Update(ctx context.Context, id uuid.UUID, folderID *uuid.UUID, senderID *uuid.UUID, name string, updatedBy uuid.UUID, fields *DocumentFields) (*ent.Document, error)
 	// UpdatePreviewPath updates the preview file path of a document
 	UpdatePreviewPath(ctx context.Context, id uuid.UUID, previewFilePath string) error
+	// AttachSender sets the sender of a document that has none and reports whether it was set
+	AttachSender(ctx context.Context, id, senderID uuid.UUID) (bool, error)
+	// MergeMetadata atomically merges the non-empty top-level sections of patch into document metadata
+	MergeMetadata(ctx context.Context, id uuid.UUID, patch types.DocumentMetadata) error
+	// AttachType sets the type of a document that has none and reports whether it was set
+	AttachType(ctx context.Context, id, typeID uuid.UUID) (bool, error)
+	// ListClassifierExamples retrieves up to limit newest company documents with extracted text that either
+	// have a type not assigned by the classifier or are listed in taggedIDs, with content loaded
+	ListClassifierExamples(ctx context.Context, companyID uuid.UUID, taggedIDs []uuid.UUID, limit int) ([]*ent.Document, error)
+	// SetFieldValues replaces the field values of a document that still has the given type
+	// and reports whether they were set
+	SetFieldValues(ctx context.Context, id, typeID uuid.UUID, values map[string]types.FieldValue) (bool, error)
+	// ListFieldExtractionReview retrieves a page of company documents whose extracted field values
+	// for their current type are waiting for review, newest first, and the total number of such documents
+	ListFieldExtractionReview(ctx context.Context, companyID uuid.UUID, offset, limit int) ([]*ent.Document, int, error)
 	// Delete deletes a document by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all documents
@@ -118,30 +218,116 @@ type DocumentRepository interface {
 	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Document, error)
 	// ListByFolder retrieves all documents in a folder
 	ListByFolder(ctx context.Context, folderID uuid.UUID) ([]*ent.Document, error)
+	// ListBySender retrieves all documents from a sender
+	ListBySender(ctx context.Context, senderID uuid.UUID) ([]*ent.Document, error)
+	// Count returns the total number of documents
+	Count(ctx context.Context) (int, error)
+	// ListAfter retrieves up to limit documents ordered by ID, starting after afterID,
+	// with extracted content and tag relations loaded
+	ListAfter(ctx context.Context, afterID uuid.UUID, limit int) ([]*ent.Document, error)
+}
+
+// DocumentFields contains the type of a document and its typed field values,
+// a nil TypeID clears both the type and the values
+type DocumentFields struct {
+	TypeID *uuid.UUID
+	Values map[string]types.FieldValue
+}
+
+// DocumentTypeRepository defines company document type operations
+type DocumentTypeRepository interface {
+	// Create creates a new document type with a field schema and an optional extraction template for a company
+	Create(ctx context.Context, companyID uuid.UUID, name string, description *string, fields []types.DocumentField, extraction *types.ExtractionTemplate) (*ent.DocumentType, error)
+	// GetByID retrieves a document type by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentType, error)
+	// Update replaces the name, description, field schema and extraction template of a document type
+	Update(ctx context.Context, id uuid.UUID, name string, description *string, fields []types.DocumentField, extraction *types.ExtractionTemplate) (*ent.DocumentType, error)
+	// Delete clears the type and field values of its documents and deletes the type in a single transaction
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByCompany retrieves all document types of a company ordered by name
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.DocumentType, error)
+}
+
+// DocumentClassifierRepository defines operations on company document type classification models
+type DocumentClassifierRepository interface {
+	// Save replaces the classification model of a company in a single transaction
+	Save(ctx context.Context, companyID uuid.UUID, model types.ClassifierModel, evaluation types.ClassifierEvaluation, documents int) (*ent.DocumentClassifier, error)
+	// GetByCompany retrieves the classification model of a company
+	GetByCompany(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error)
+}
+
+// DocumentContentRepository defines operations on extracted document text
+type DocumentContentRepository interface {
+	// Save creates or replaces the extracted content of a document
+	Save(ctx context.Context, documentID uuid.UUID, text string, pages []types.PageOffset, language, engine, engineVersion string) (*ent.DocumentContent, error)
+	// GetByDocumentID retrieves the extracted content of a document
+	GetByDocumentID(ctx context.Context, documentID uuid.UUID) (*ent.DocumentContent, error)
 }
 
 // TagRepository defines tag-related database operations
 type TagRepository interface {
-	// Create creates a new tag for a company
-	Create(ctx context.Context, companyID uuid.UUID, name string) (*ent.Tag, error)
+	// Create creates a new tag for a company together with the default rule
+	// that assigns the tag to documents mentioning its name in any word form
+	Create(ctx context.Context, companyID uuid.UUID, parentID, groupID *uuid.UUID, name string, color, icon, description *string) (*ent.Tag, error)
 	// GetByID retrieves a tag by ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Tag, error)
-	// GetByName retrieves a tag by name (within a company)
+	// GetByName retrieves a tag by name (within a company), case-insensitive
 	GetByName(ctx context.Context, companyID uuid.UUID, name string) (*ent.Tag, error)
-	// Update updates an existing tag
-	Update(ctx context.Context, id uuid.UUID, name string) (*ent.Tag, error)
+	// Update replaces the name, parent, group and appearance of a tag
+	Update(ctx context.Context, id uuid.UUID, parentID, groupID *uuid.UUID, name string, color, icon, description *string) (*ent.Tag, error)
 	// Delete deletes a tag by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all tags
 	List(ctx context.Context) ([]*ent.Tag, error)
 	// ListByCompany retrieves all tags for a company
 	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Tag, error)
+	// ListByIDs retrieves tags by IDs in a single query, missing IDs are skipped
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Tag, error)
+	// ReparentChildren moves the child tags of a tag to a new parent
+	ReparentChildren(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error
+	// Merge moves document links, rules, child tags and saved search filter references of sourceID
+	// to targetID and deletes sourceID in a single transaction; links that would duplicate an existing
+	// target link are dropped, conflicts in the target's exclusive group are resolved as in DocumentTagRepository.Assign
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) error
+	// Usage returns document counts and last assignment times for all tags of a company
+	Usage(ctx context.Context, companyID uuid.UUID) ([]TagUsage, error)
+}
+
+// TagUsage holds usage statistics of a tag
+type TagUsage struct {
+	TagID         uuid.UUID
+	DocumentCount int64
+	LastUsedAt    *time.Time
+}
+
+// TagGroupRepository defines tag group operations
+type TagGroupRepository interface {
+	// Create creates a new tag group for a company
+	Create(ctx context.Context, companyID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error)
+	// GetByID retrieves a tag group by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.TagGroup, error)
+	// Update updates an existing tag group
+	Update(ctx context.Context, id uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error)
+	// Delete deletes a tag group by ID, tags of the group stay ungrouped
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByCompany retrieves all tag groups for a company
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagGroup, error)
 }
 
 // DocumentTagRepository defines document tag relationship operations
 type DocumentTagRepository interface {
 	// Create creates a new document tag relationship
 	Create(ctx context.Context, documentID, tagID uuid.UUID) (*ent.DocumentTag, error)
+	// Assign links a tag to a document unless already linked; if the tag belongs to an exclusive group,
+	// the document's other tags of that group are removed in the same transaction.
+	// A manual assignment takes over an existing automatic link and lifts the tag's suppression.
+	// An automatic assignment never replaces a manually assigned tag of the group and fails with ErrManualTagInGroup.
+	// Returns the IDs of the removed tags
+	Assign(ctx context.Context, documentID, tagID uuid.UUID, assignment TagAssignment) ([]uuid.UUID, error)
+	// ListByRun retrieves tags applied by a tag rule run
+	ListByRun(ctx context.Context, runID uuid.UUID) ([]*ent.DocumentTag, error)
+	// DeleteByRun deletes tags applied by a tag rule run and returns the number of deleted links
+	DeleteByRun(ctx context.Context, runID uuid.UUID) (int, error)
 	// GetByID retrieves a document tag by ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentTag, error)
 	// Delete deletes a document tag relationship by ID
@@ -150,8 +336,153 @@ type DocumentTagRepository interface {
 	List(ctx context.Context) ([]*ent.DocumentTag, error)
 	// ListByDocument retrieves all tags for a document
 	ListByDocument(ctx context.Context, documentID uuid.UUID) ([]*ent.DocumentTag, error)
+	// ListByDocuments retrieves all tags for the documents
+	ListByDocuments(ctx context.Context, documentIDs []uuid.UUID) ([]*ent.DocumentTag, error)
 	// ListByTag retrieves all documents with a tag
 	ListByTag(ctx context.Context, tagID uuid.UUID) ([]*ent.DocumentTag, error)
-	// DeleteByDocumentAndTag deletes a document tag relationship
-	DeleteByDocumentAndTag(ctx context.Context, documentID, tagID uuid.UUID) error
+	// DeleteByDocumentAndTag deletes a document tag relationship and returns the number of deleted links
+	DeleteByDocumentAndTag(ctx context.Context, documentID, tagID uuid.UUID) (int, error)
+	// Remove deletes a document tag relationship and records the tag suppression in one transaction.
+	// The suppression is recorded only if a link was deleted or suppress is set; reports whether a link was deleted
+	Remove(ctx context.Context, documentID, tagID uuid.UUID, removedBy *uuid.UUID, suppress bool) (bool, error)
+}
+
+// TagAssignment describes who or what assigns a tag to a document
+type TagAssignment struct {
+	Source documenttag.Source
+	// RuleID and RunID are set for tags applied by tag rules
+	RuleID    *uuid.UUID
+	RunID     *uuid.UUID
+	CreatedBy *uuid.UUID
+}
+
+// DocumentTagSuppressionRepository defines operations on tags explicitly removed from documents
+type DocumentTagSuppressionRepository interface {
+	// Create records that a tag was removed from a document, repeated removal updates the record
+	Create(ctx context.Context, documentID, tagID uuid.UUID, removedBy *uuid.UUID) error
+	// ListByDocument retrieves suppressed tags of a document
+	ListByDocument(ctx context.Context, documentID uuid.UUID) ([]*ent.DocumentTagSuppression, error)
+}
+
+// JobRepository defines background job operations
+type JobRepository interface {
+	// Create creates a new pending job
+	Create(ctx context.Context, companyID *uuid.UUID, jobType string, payload map[string]interface{}, createdBy *uuid.UUID) (*ent.Job, error)
+	// GetByID retrieves a job by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.Job, error)
+	// MarkRunning marks a job as running and sets the total amount of work
+	MarkRunning(ctx context.Context, id uuid.UUID, total int) error
+	// UpdateProgress updates the amount of processed work
+	UpdateProgress(ctx context.Context, id uuid.UUID, progress int) error
+	// UpdatePayload replaces the job payload
+	UpdatePayload(ctx context.Context, id uuid.UUID, payload map[string]interface{}) error
+	// MarkCompleted marks a job as completed and stores produced documents
+	MarkCompleted(ctx context.Context, id uuid.UUID, resultDocumentIDs []uuid.UUID) error
+	// MarkFailed marks a job as failed with an error message
+	MarkFailed(ctx context.Context, id uuid.UUID, errMsg string, resultDocumentIDs []uuid.UUID) error
+	// ListByCompany retrieves all jobs for a company
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Job, error)
+}
+
+// SearchIndexTaskRepository defines operations on the search index outbox
+type SearchIndexTaskRepository interface {
+	// ListDue retrieves up to limit tasks whose next attempt is due at now, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.SearchIndexTask, error)
+	// DeleteByIDs removes processed tasks
+	DeleteByIDs(ctx context.Context, ids []uuid.UUID) error
+	// MarkFailed increments the attempt counter and postpones the tasks until nextAttemptAt
+	MarkFailed(ctx context.Context, ids []uuid.UUID, errMsg string, nextAttemptAt time.Time) error
+	// Count returns the number of pending tasks
+	Count(ctx context.Context) (int, error)
+	// CountFailing returns the number of pending tasks that have failed at least once
+	CountFailing(ctx context.Context) (int, error)
+}
+
+// SavedSearchRepository defines saved search operations
+type SavedSearchRepository interface {
+	// Create creates a new saved search owned by a user
+	Create(ctx context.Context, companyID, userID uuid.UUID, name, query string, filters types.SearchFilters, sort string, shared bool) (*ent.SavedSearch, error)
+	// GetByID retrieves a saved search by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.SavedSearch, error)
+	// Update replaces the name, query, filters and sort of a saved search
+	Update(ctx context.Context, id uuid.UUID, name, query string, filters types.SearchFilters, sort string) (*ent.SavedSearch, error)
+	// SetShared shares a saved search with the whole company or makes it private again
+	SetShared(ctx context.Context, id uuid.UUID, shared bool) (*ent.SavedSearch, error)
+	// SetPinned pins a saved search to the folder tree under parentFolderID (nil for the root) or unpins it
+	SetPinned(ctx context.Context, id uuid.UUID, pinned bool, parentFolderID *uuid.UUID) (*ent.SavedSearch, error)
+	// Delete deletes a saved search by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListVisible retrieves saved searches of a company owned by the user or shared with the company
+	ListVisible(ctx context.Context, companyID, userID uuid.UUID) ([]*ent.SavedSearch, error)
+	// ListPinned retrieves visible saved searches pinned to the folder tree
+	ListPinned(ctx context.Context, companyID, userID uuid.UUID) ([]*ent.SavedSearch, error)
+}
+
+// TagRuleRepository defines automatic tagging rule operations
+type TagRuleRepository interface {
+	// Create creates a new tagging rule for a tag of a company
+	Create(ctx context.Context, companyID, tagID uuid.UUID, name string, conditions types.TagRuleConditions, priority int, stop, enabled bool) (*ent.TagRule, error)
+	// GetByID retrieves a tagging rule by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.TagRule, error)
+	// Update replaces all editable fields of a tagging rule
+	Update(ctx context.Context, id, tagID uuid.UUID, name string, conditions types.TagRuleConditions, priority int, stop, enabled bool) (*ent.TagRule, error)
+	// Delete deletes a tagging rule by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByCompany retrieves all tagging rules of a company in evaluation order: highest priority first, then oldest first
+	ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagRule, error)
+}
+
+// SearchLogRepository defines search analytics operations
+type SearchLogRepository interface {
+	// Create records an executed search, query is nil when the query text must not be stored
+	Create(ctx context.Context, companyID uuid.UUID, userID *uuid.UUID, query *string, filters types.SearchFilters, mode, sort string, resultCount, latencyMs int64) (*ent.SearchLog, error)
+	// GetByID retrieves a search record by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.SearchLog, error)
+	// RecordClick counts a click on a search result, the first click also stores the document and its position
+	RecordClick(ctx context.Context, id, documentID uuid.UUID, position int, clickedAt time.Time) error
+	// ClearUserQueries removes the stored query text of all searches made by the user
+	ClearUserQueries(ctx context.Context, userID uuid.UUID) (int, error)
+	// ClearQueriesBefore removes the stored query text of searches made before the given time
+	ClearQueriesBefore(ctx context.Context, before time.Time) (int, error)
+	// DeleteBefore deletes searches made before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
+	// Summary aggregates searches matching the filter
+	Summary(ctx context.Context, filter SearchLogFilter) (*SearchLogStats, error)
+	// Periods aggregates searches matching the filter by period (day, week or month), oldest first
+	Periods(ctx context.Context, filter SearchLogFilter, period string) ([]*SearchLogPeriod, error)
+	// TopQueries retrieves the most frequent stored queries, compared case-insensitively
+	TopQueries(ctx context.Context, filter SearchLogFilter, limit int) ([]*SearchQueryStats, error)
+	// ZeroResultQueries retrieves the most frequent stored queries that found nothing
+	ZeroResultQueries(ctx context.Context, filter SearchLogFilter, limit int) ([]*SearchQueryStats, error)
+}
+
+// SearchLogFilter selects searches for analytics, CompanyID nil means all companies
+type SearchLogFilter struct {
+	CompanyID *uuid.UUID
+	From      time.Time
+	To        time.Time
+}
+
+// SearchLogStats contains aggregated search metrics
+type SearchLogStats struct {
+	Searches           int64
+	ZeroResultSearches int64
+	ClickedSearches    int64
+	AvgLatencyMs       float64
+}
+
+// SearchLogPeriod contains search metrics of one period
+type SearchLogPeriod struct {
+	Start time.Time
+	SearchLogStats
+}
+
+// SearchQueryStats contains metrics of one query
+type SearchQueryStats struct {
+	Query              string
+	Searches           int64
+	ZeroResultSearches int64
+	ClickedSearches    int64
+	AvgResults         float64
+	LastSearchedAt     time.Time
 }
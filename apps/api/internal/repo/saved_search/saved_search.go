@@ -0,0 +1,104 @@
+package saved_search
+
+import (
+	"context"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type savedSearchRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.SavedSearchRepository {
+	return &savedSearchRepo{client: client}
+}
+
+func (r *savedSearchRepo) Create(ctx context.Context, companyID, userID uuid.UUID, name, query string, filters types.SearchFilters, sort string, shared bool) (*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		Create().
+		SetCompanyID(companyID).
+		SetUserID(userID).
+		SetName(name).
+		SetQuery(query).
+		SetFilters(filters).
+		SetSort(sort).
+		SetShared(shared).
+		Save(ctx)
+}
+
+func (r *savedSearchRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		Query().
+		Where(savedsearch.ID(id)).
+		Only(ctx)
+}
+
+func (r *savedSearchRepo) Update(ctx context.Context, id uuid.UUID, name, query string, filters types.SearchFilters, sort string) (*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		UpdateOneID(id).
+		SetName(name).
+		SetQuery(query).
+		SetFilters(filters).
+		SetSort(sort).
+		Save(ctx)
+}
+
+func (r *savedSearchRepo) SetShared(ctx context.Context, id uuid.UUID, shared bool) (*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		UpdateOneID(id).
+		SetShared(shared).
+		Save(ctx)
+}
+
+func (r *savedSearchRepo) SetPinned(ctx context.Context, id uuid.UUID, pinned bool, parentFolderID *uuid.UUID) (*ent.SavedSearch, error) {
+	update := r.client.SavedSearch.
+		UpdateOneID(id).
+		SetPinned(pinned)
+	if pinned && parentFolderID != nil {
+		update.SetParentFolderID(*parentFolderID)
+	} else {
+		update.ClearParentFolderID()
+	}
+	return update.Save(ctx)
+}
+
+func (r *savedSearchRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.SavedSearch.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r *savedSearchRepo) ListVisible(ctx context.Context, companyID, userID uuid.UUID) ([]*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		Query().
+		Where(
+			savedsearch.CompanyID(companyID),
+			savedsearch.Or(
+				savedsearch.UserID(userID),
+				savedsearch.Shared(true),
+			),
+		).
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx)
+}
+
+func (r *savedSearchRepo) ListPinned(ctx context.Context, companyID, userID uuid.UUID) ([]*ent.SavedSearch, error) {
+	return r.client.SavedSearch.
+		Query().
+		Where(
+			savedsearch.CompanyID(companyID),
+			savedsearch.Pinned(true),
+			savedsearch.Or(
+				savedsearch.UserID(userID),
+				savedsearch.Shared(true),
+			),
+		).
+		Order(ent.Asc(savedsearch.FieldName)).
+		All(ctx)
+}
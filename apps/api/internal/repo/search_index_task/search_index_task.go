@@ -0,0 +1,60 @@
+package search_index_task
+
+import (
+	"context"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/searchindextask"
+
+	"github.com/google/uuid"
+)
+
+type searchIndexTaskRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.SearchIndexTaskRepository {
+	return &searchIndexTaskRepo{client: client}
+}
+
+func (r *searchIndexTaskRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*ent.SearchIndexTask, error) {
+	return r.client.SearchIndexTask.
+		Query().
+		Where(searchindextask.NextAttemptAtLTE(now)).
+		Order(ent.Asc(searchindextask.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *searchIndexTaskRepo) DeleteByIDs(ctx context.Context, ids []uuid.UUID) error {
+	_, err := r.client.SearchIndexTask.
+		Delete().
+		Where(searchindextask.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
+
+func (r *searchIndexTaskRepo) MarkFailed(ctx context.Context, ids []uuid.UUID, errMsg string, nextAttemptAt time.Time) error {
+	return r.client.SearchIndexTask.
+		Update().
+		Where(searchindextask.IDIn(ids...)).
+		AddAttempts(1).
+		SetLastError(errMsg).
+		SetNextAttemptAt(nextAttemptAt).
+		Exec(ctx)
+}
+
+func (r *searchIndexTaskRepo) Count(ctx context.Context) (int, error) {
+	return r.client.SearchIndexTask.
+		Query().
+		Count(ctx)
+}
+
+func (r *searchIndexTaskRepo) CountFailing(ctx context.Context) (int, error) {
+	return r.client.SearchIndexTask.
+		Query().
+		Where(searchindextask.AttemptsGT(0)).
+		Count(ctx)
+}
@@ -0,0 +1,188 @@
+package search_log
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/searchlog"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type searchLogRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.SearchLogRepository {
+	return &searchLogRepo{client: client}
+}
+
+func (r *searchLogRepo) Create(ctx context.Context, companyID uuid.UUID, userID *uuid.UUID, query *string, filters types.SearchFilters, mode, sort string, resultCount, latencyMs int64) (*ent.SearchLog, error) {
+	return r.client.SearchLog.
+		Create().
+		SetCompanyID(companyID).
+		SetNillableUserID(userID).
+		SetNillableQuery(query).
+		SetFilters(filters).
+		SetMode(mode).
+		SetSort(sort).
+		SetResultCount(resultCount).
+		SetLatencyMs(latencyMs).
+		Save(ctx)
+}
+
+func (r *searchLogRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.SearchLog, error) {
+	return r.client.SearchLog.
+		Query().
+		Where(searchlog.ID(id)).
+		Only(ctx)
+}
+
+func (r *searchLogRepo) RecordClick(ctx context.Context, id, documentID uuid.UUID, position int, clickedAt time.Time) error {
+	// Первый переход сохраняется условным UPDATE, чтобы параллельные клики его не перезаписали
+	_, err := r.client.SearchLog.
+		Update().
+		Where(searchlog.ID(id), searchlog.ClickedDocumentIDIsNil()).
+		SetClickedDocumentID(documentID).
+		SetClickedPosition(position).
+		SetClickedAt(clickedAt).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.client.SearchLog.
+		UpdateOneID(id).
+		AddClicks(1).
+		Exec(ctx)
+}
+
+func (r *searchLogRepo) ClearUserQueries(ctx context.Context, userID uuid.UUID) (int, error) {
+	return r.client.SearchLog.
+		Update().
+		Where(searchlog.UserID(userID), searchlog.QueryNotNil()).
+		ClearQuery().
+		Save(ctx)
+}
+
+func (r *searchLogRepo) ClearQueriesBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.SearchLog.
+		Update().
+		Where(searchlog.CreatedAtLT(before), searchlog.QueryNotNil()).
+		ClearQuery().
+		Save(ctx)
+}
+
+func (r *searchLogRepo) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.client.SearchLog.
+		Delete().
+		Where(searchlog.CreatedAtLT(before)).
+		Exec(ctx)
+}
+
+// statsColumns - общие для всех отчетов агрегаты по выбранным поискам
+const statsColumns = `
+	count(*),
+	count(*) FILTER (WHERE result_count = 0),
+	count(*) FILTER (WHERE clicks > 0)`
+
+func (r *searchLogRepo) Summary(ctx context.Context, filter repo.SearchLogFilter) (*repo.SearchLogStats, error) {
+	where, args := filterWhere(filter)
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT `+statsColumns+`, COALESCE(avg(latency_ms), 0)::float8
+		FROM search_logs
+		WHERE `+where, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := &repo.SearchLogStats{}
+	if rows.Next() {
+		if err := rows.Scan(&stats.Searches, &stats.ZeroResultSearches, &stats.ClickedSearches, &stats.AvgLatencyMs); err != nil {
+			return nil, err
+		}
+	}
+	return stats, rows.Err()
+}
+
+func (r *searchLogRepo) Periods(ctx context.Context, filter repo.SearchLogFilter, period string) ([]*repo.SearchLogPeriod, error) {
+	where, args := filterWhere(filter)
+	args = append(args, period)
+	rows, err := r.client.QueryContext(ctx, fmt.Sprintf(`
+		SELECT date_trunc($%d, created_at) AS period, `+statsColumns+`, avg(latency_ms)::float8
+		FROM search_logs
+		WHERE %s
+		GROUP BY period
+		ORDER BY period`, len(args), where), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	periods := make([]*repo.SearchLogPeriod, 0)
+	for rows.Next() {
+		p := &repo.SearchLogPeriod{}
+		if err := rows.Scan(&p.Start, &p.Searches, &p.ZeroResultSearches, &p.ClickedSearches, &p.AvgLatencyMs); err != nil {
+			return nil, err
+		}
+		periods = append(periods, p)
+	}
+	return periods, rows.Err()
+}
+
+func (r *searchLogRepo) TopQueries(ctx context.Context, filter repo.SearchLogFilter, limit int) ([]*repo.SearchQueryStats, error) {
+	return r.queryStats(ctx, filter, false, limit)
+}
+
+func (r *searchLogRepo) ZeroResultQueries(ctx context.Context, filter repo.SearchLogFilter, limit int) ([]*repo.SearchQueryStats, error) {
+	return r.queryStats(ctx, filter, true, limit)
+}
+
+// queryStats группирует сохраненные запросы без учета регистра и пробелов по краям,
+// поиски только по фильтрам (с пустым запросом) не учитываются
+func (r *searchLogRepo) queryStats(ctx context.Context, filter repo.SearchLogFilter, zeroOnly bool, limit int) ([]*repo.SearchQueryStats, error) {
+	where, args := filterWhere(filter)
+	where += ` AND query IS NOT NULL AND btrim(query) <> ''`
+	if zeroOnly {
+		where += ` AND result_count = 0`
+	}
+	args = append(args, limit)
+
+	rows, err := r.client.QueryContext(ctx, fmt.Sprintf(`
+		SELECT lower(btrim(query)) AS normalized, `+statsColumns+`, avg(result_count)::float8, max(created_at)
+		FROM search_logs
+		WHERE %s
+		GROUP BY normalized
+		ORDER BY count(*) DESC, normalized
+		LIMIT $%d`, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	queries := make([]*repo.SearchQueryStats, 0)
+	for rows.Next() {
+		q := &repo.SearchQueryStats{}
+		if err := rows.Scan(&q.Query, &q.Searches, &q.ZeroResultSearches, &q.ClickedSearches, &q.AvgResults, &q.LastSearchedAt); err != nil {
+			return nil, err
+		}
+		queries = append(queries, q)
+	}
+	return queries, rows.Err()
+}
+
+// filterWhere строит условие WHERE по периоду и компании
+func filterWhere(filter repo.SearchLogFilter) (string, []interface{}) {
+	where := `created_at >= $1 AND created_at < $2`
+	args := []interface{}{filter.From, filter.To}
+	if filter.CompanyID != nil {
+		args = append(args, *filter.CompanyID)
+		where += fmt.Sprintf(` AND company_id = $%d`, len(args))
+	}
+	return where, args
+}
@@ -2,12 +2,21 @@ package sender
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 
 	"techmind/internal/repo"
 	"techmind/schema/ent"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/savedsearch"
 	"techmind/schema/ent/sender"
+	"techmind/schema/ent/tagrule"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type senderRepo struct {
@@ -18,17 +27,21 @@ func NewRepository(client *ent.Client) repo.SenderRepository {
 	return &senderRepo{client: client}
 }
 
-func (r *senderRepo) Create(ctx context.Context, companyID uuid.UUID, name string, email *string) (*ent.Sender, error) {
-	create := r.client.Sender.
+func (r *senderRepo) Create(ctx context.Context, companyID uuid.UUID, fields repo.SenderFields) (*ent.Sender, error) {
+	return r.client.Sender.
 		Create().
 		SetCompanyID(companyID).
-		SetName(name)
-
-	if email != nil {
-		create = create.SetEmail(*email)
-	}
-
-	return create.Save(ctx)
+		SetName(fields.Name).
+		SetNillableEmail(fields.Email).
+		SetNillableLegalName(fields.LegalName).
+		SetNillableInn(fields.INN).
+		SetNillableKpp(fields.KPP).
+		SetEmails(fields.Emails).
+		SetPhones(fields.Phones).
+		SetAddresses(fields.Addresses).
+		SetNillableWebsite(fields.Website).
+		SetNillableNotes(fields.Notes).
+		Save(ctx)
 }
 
 func (r *senderRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.Sender, error) {
@@ -38,18 +51,51 @@ func (r *senderRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.Sender, er
 		Only(ctx)
 }
 
-func (r *senderRepo) Update(ctx context.Context, id uuid.UUID, name string, email *string) (*ent.Sender, error) {
-	update := r.client.Sender.
+func (r *senderRepo) Update(ctx context.Context, id uuid.UUID, fields repo.SenderFields) (*ent.Sender, error) {
+	return update(r.client, id, fields).Save(ctx)
+}
+
+// update заменяет все поля карточки, nil очищает поле
+func update(client *ent.Client, id uuid.UUID, fields repo.SenderFields) *ent.SenderUpdateOne {
+	update := client.Sender.
 		UpdateOneID(id).
-		SetName(name)
+		SetName(fields.Name).
+		SetEmails(fields.Emails).
+		SetPhones(fields.Phones).
+		SetAddresses(fields.Addresses)
 
-	if email != nil {
-		update = update.SetEmail(*email)
+	if fields.Email != nil {
+		update.SetEmail(*fields.Email)
 	} else {
-		update = update.ClearEmail()
+		update.ClearEmail()
+	}
+	if fields.LegalName != nil {
+		update.SetLegalName(*fields.LegalName)
+	} else {
+		update.ClearLegalName()
+	}
+	if fields.INN != nil {
+		update.SetInn(*fields.INN)
+	} else {
+		update.ClearInn()
+	}
+	if fields.KPP != nil {
+		update.SetKpp(*fields.KPP)
+	} else {
+		update.ClearKpp()
+	}
+	if fields.Website != nil {
+		update.SetWebsite(*fields.Website)
+	} else {
+		update.ClearWebsite()
+	}
+	if fields.Notes != nil {
+		update.SetNotes(*fields.Notes)
+	} else {
+		update.ClearNotes()
 	}
 
-	return update.Save(ctx)
+	return update
 }
 
 func (r *senderRepo) Delete(ctx context.Context, id uuid.UUID) error {
@@ -70,3 +116,304 @@ func (r *senderRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]
 		Where(sender.CompanyID(companyID)).
 		All(ctx)
 }
+
+func (r *senderRepo) Search(ctx context.Context, companyID uuid.UUID, query string, offset, limit int) ([]*ent.Sender, int, error) {
+	q := r.client.Sender.
+		Query().
+		Where(sender.CompanyID(companyID))
+
+	if query != "" {
+		q = q.Where(sender.Or(
+			sender.NameContainsFold(query),
+			sender.LegalNameContainsFold(query),
+			sender.InnHasPrefix(query),
+			sender.EmailContainsFold(query),
+		))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	senders, err := q.
+		Order(ent.Asc(sender.FieldName), ent.Asc(sender.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return senders, total, nil
+}
+
+func (r *senderRepo) Merge(ctx context.Context, sourceID, targetID uuid.UUID, fields repo.SenderFields) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := merge(ctx, tx.Client(), sourceID, targetID, fields); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// merge обновляет карточку целевого контрагента, переносит на него документы и ссылки
+// в условиях правил тегирования и фильтрах сохраненных поисков, затем удаляет исходного
+func merge(ctx context.Context, client *ent.Client, sourceID, targetID uuid.UUID, fields repo.SenderFields) error {
+	source, err := client.Sender.Get(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := update(client, targetID, fields).Save(ctx); err != nil {
+		return err
+	}
+
+	if err := client.Document.
+		Update().
+		Where(document.SenderID(sourceID)).
+		SetSenderID(targetID).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	rules, err := client.TagRule.
+		Query().
+		Where(tagrule.CompanyID(source.CompanyID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		conditions := rule.Conditions
+		if !replaceID(conditions.SenderIDs, sourceID, targetID) {
+			continue
+		}
+		conditions.SenderIDs = uniqueIDs(conditions.SenderIDs)
+		if err := client.TagRule.UpdateOne(rule).SetConditions(conditions).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	searches, err := client.SavedSearch.
+		Query().
+		Where(savedsearch.CompanyID(source.CompanyID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, search := range searches {
+		filters := search.Filters
+		if !replaceID(filters.SenderIDs, sourceID, targetID) {
+			continue
+		}
+		filters.SenderIDs = uniqueIDs(filters.SenderIDs)
+		if err := client.SavedSearch.UpdateOne(search).SetFilters(filters).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return client.Sender.
+		DeleteOneID(sourceID).
+		Exec(ctx)
+}
+
+// replaceID заменяет в списке from на to и сообщает, была ли замена
+func replaceID(ids []uuid.UUID, from, to uuid.UUID) bool {
+	replaced := false
+	for i, id := range ids {
+		if id == from {
+			ids[i] = to
+			replaced = true
+		}
+	}
+	return replaced
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func (r *senderRepo) ListDocuments(ctx context.Context, id uuid.UUID, filter repo.SenderDocumentFilter, ascending bool, offset, limit int) ([]*ent.Document, int, error) {
+	q := r.client.Sender.
+		Query().
+		Where(sender.ID(id)).
+		QueryDocuments().
+		Where(documentPredicates(filter)...)
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := ent.Desc
+	if ascending {
+		order = ent.Asc
+	}
+	documents, err := q.
+		Order(order(document.FieldCreatedAt), order(document.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	return documents, total, nil
+}
+
+// documentPredicates переводит фильтр документов контрагента в условия запроса
+func documentPredicates(filter repo.SenderDocumentFilter) []predicate.Document {
+	predicates := make([]predicate.Document, 0)
+	if filter.From != nil {
+		predicates = append(predicates, document.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		predicates = append(predicates, document.CreatedAtLT(*filter.To))
+	}
+	if len(filter.MimeTypes) > 0 {
+		predicates = append(predicates, document.MimeTypeIn(filter.MimeTypes...))
+	}
+	if filter.FolderID != nil {
+		predicates = append(predicates, document.FolderID(*filter.FolderID))
+	}
+	if len(filter.TagIDs) > 0 {
+		// Связь document_tags в ent описана как M2M, поэтому документы с тегами выбираются подзапросом
+		tagIDs := make([]interface{}, 0, len(filter.TagIDs))
+		for _, tagID := range filter.TagIDs {
+			tagIDs = append(tagIDs, tagID)
+		}
+		predicates = append(predicates, func(s *sql.Selector) {
+			tagged := sql.Select(documenttag.FieldDocumentID).
+				From(sql.Table(documenttag.Table)).
+				Where(sql.In(documenttag.FieldTagID, tagIDs...))
+			s.Where(sql.In(s.C(document.FieldID), tagged))
+		})
+	}
+	return predicates
+}
+
+func (r *senderRepo) DocumentStats(ctx context.Context, id uuid.UUID, filter repo.SenderDocumentFilter) (*repo.SenderDocumentStats, error) {
+	where, args := documentFilterWhere(id, filter)
+	stats := &repo.SenderDocumentStats{}
+
+	if err := r.queryRows(ctx, func(rows *stdsql.Rows) error {
+		return rows.Scan(&stats.Documents, &stats.TotalSize, &stats.FirstAt, &stats.LastAt)
+	}, `
+		SELECT count(*), COALESCE(sum(d.file_size), 0), min(d.created_at), max(d.created_at)
+		FROM documents d
+		WHERE `+where, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.queryRows(ctx, func(rows *stdsql.Rows) error {
+		month := &repo.SenderDocumentMonth{}
+		if err := rows.Scan(&month.Start, &month.Documents, &month.TotalSize); err != nil {
+			return err
+		}
+		stats.Months = append(stats.Months, month)
+		return nil
+	}, `
+		SELECT date_trunc('month', d.created_at) AS month, count(*), COALESCE(sum(d.file_size), 0)
+		FROM documents d
+		WHERE `+where+`
+		GROUP BY month
+		ORDER BY month`, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.queryRows(ctx, func(rows *stdsql.Rows) error {
+		mimeType := &repo.SenderDocumentMimeType{}
+		if err := rows.Scan(&mimeType.MimeType, &mimeType.Documents); err != nil {
+			return err
+		}
+		stats.MimeTypes = append(stats.MimeTypes, mimeType)
+		return nil
+	}, `
+		SELECT d.mime_type, count(*) AS documents
+		FROM documents d
+		WHERE `+where+`
+		GROUP BY d.mime_type
+		ORDER BY documents DESC, d.mime_type`, args...); err != nil {
+		return nil, err
+	}
+
+	if err := r.queryRows(ctx, func(rows *stdsql.Rows) error {
+		tag := &repo.SenderDocumentTag{}
+		if err := rows.Scan(&tag.TagID, &tag.Documents); err != nil {
+			return err
+		}
+		stats.Tags = append(stats.Tags, tag)
+		return nil
+	}, `
+		SELECT dt.tag_id, count(DISTINCT d.id) AS documents
+		FROM documents d
+		JOIN document_tags dt ON dt.document_id = d.id
+		WHERE `+where+`
+		GROUP BY dt.tag_id
+		ORDER BY documents DESC, dt.tag_id`, args...); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// queryRows выполняет запрос и передает каждую строку результата в scan
+func (r *senderRepo) queryRows(ctx context.Context, scan func(rows *stdsql.Rows) error, query string, args ...interface{}) error {
+	rows, err := r.client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// documentFilterWhere строит условие SQL по фильтру документов контрагента
+func documentFilterWhere(id uuid.UUID, filter repo.SenderDocumentFilter) (string, []interface{}) {
+	where := `d.sender_id = $1`
+	args := []interface{}{id}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		where += fmt.Sprintf(` AND d.created_at >= $%d`, len(args))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		where += fmt.Sprintf(` AND d.created_at < $%d`, len(args))
+	}
+	if len(filter.MimeTypes) > 0 {
+		args = append(args, pq.Array(filter.MimeTypes))
+		where += fmt.Sprintf(` AND d.mime_type = ANY($%d)`, len(args))
+	}
+	if filter.FolderID != nil {
+		args = append(args, *filter.FolderID)
+		where += fmt.Sprintf(` AND d.folder_id = $%d`, len(args))
+	}
+	if len(filter.TagIDs) > 0 {
+		tagIDs := make([]string, 0, len(filter.TagIDs))
+		for _, tagID := range filter.TagIDs {
+			tagIDs = append(tagIDs, tagID.String())
+		}
+		args = append(args, pq.Array(tagIDs))
+		where += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM document_tags f WHERE f.document_id = d.id AND f.tag_id = ANY($%d::uuid[]))`, len(args))
+	}
+	return where, args
+}
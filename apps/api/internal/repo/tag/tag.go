@@ -2,9 +2,15 @@ package tag
 
 import (
 	"context"
+	"fmt"
 	"techmind/internal/repo"
 	"techmind/schema/ent"
+	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/savedsearch"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/tagrule"
+	"techmind/schema/types"
 
 	"github.com/google/uuid"
 )
@@ -17,12 +23,57 @@ func NewRepository(client *ent.Client) repo.TagRepository {
 	return &tagRepo{client: client}
 }
 
-func (r *tagRepo) Create(ctx context.Context, companyID uuid.UUID, name string) (*ent.Tag, error) {
-	return r.client.Tag.
+func (r *tagRepo) Create(ctx context.Context, companyID uuid.UUID, parentID, groupID *uuid.UUID, name string, color, icon, description *string) (*ent.Tag, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := create(ctx, tx.Client(), companyID, parentID, groupID, name, color, icon, description)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return nil, fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// create создает тег и правило, которое присваивает его документам с названием тега в любой форме слова,
+// как миграция 00014 для тегов, созданных до появления правил
+func create(ctx context.Context, client *ent.Client, companyID uuid.UUID, parentID, groupID *uuid.UUID, name string, color, icon, description *string) (*ent.Tag, error) {
+	t, err := client.Tag.
+		Create().
+		SetCompanyID(companyID).
+		SetNillableParentID(parentID).
+		SetNillableGroupID(groupID).
+		SetName(name).
+		SetNillableColor(color).
+		SetNillableIcon(icon).
+		SetNillableDescription(description).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.TagRule.
 		Create().
 		SetCompanyID(companyID).
+		SetTagID(t.ID).
 		SetName(name).
+		SetConditions(types.TagRuleConditions{
+			Keywords: []string{name},
+			Match:    types.TagRuleMatchStem,
+		}).
 		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
 }
 
 func (r *tagRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.Tag, error) {
@@ -37,16 +88,43 @@ func (r *tagRepo) GetByName(ctx context.Context, companyID uuid.UUID, name strin
 		Query().
 		Where(
 			tag.CompanyID(companyID),
-			tag.Name(name),
+			tag.NameEqualFold(name),
 		).
 		Only(ctx)
 }
 
-func (r *tagRepo) Update(ctx context.Context, id uuid.UUID, name string) (*ent.Tag, error) {
-	return r.client.Tag.
+func (r *tagRepo) Update(ctx context.Context, id uuid.UUID, parentID, groupID *uuid.UUID, name string, color, icon, description *string) (*ent.Tag, error) {
+	update := r.client.Tag.
 		UpdateOneID(id).
-		SetName(name).
-		Save(ctx)
+		SetName(name)
+
+	if parentID != nil {
+		update.SetParentID(*parentID)
+	} else {
+		update.ClearParentID()
+	}
+	if groupID != nil {
+		update.SetGroupID(*groupID)
+	} else {
+		update.ClearGroupID()
+	}
+	if color != nil {
+		update.SetColor(*color)
+	} else {
+		update.ClearColor()
+	}
+	if icon != nil {
+		update.SetIcon(*icon)
+	} else {
+		update.ClearIcon()
+	}
+	if description != nil {
+		update.SetDescription(*description)
+	} else {
+		update.ClearDescription()
+	}
+
+	return update.Save(ctx)
 }
 
 func (r *tagRepo) Delete(ctx context.Context, id uuid.UUID) error {
@@ -67,3 +145,286 @@ func (r *tagRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*en
 		Where(tag.CompanyID(companyID)).
 		All(ctx)
 }
+
+func (r *tagRepo) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Tag, error) {
+	return r.client.Tag.
+		Query().
+		Where(tag.IDIn(ids...)).
+		All(ctx)
+}
+
+func (r *tagRepo) ReparentChildren(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) error {
+	update := r.client.Tag.
+		Update().
+		Where(tag.ParentID(id))
+
+	if parentID != nil {
+		update.SetParentID(*parentID)
+	} else {
+		update.ClearParentID()
+	}
+
+	_, err := update.Save(ctx)
+	return err
+}
+
+func (r *tagRepo) Merge(ctx context.Context, sourceID, targetID uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := merge(ctx, tx.Client(), sourceID, targetID); err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("%w: rollback failed: %v", err, rollbackErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// merge переносит связи, правила, дочерние теги и ссылки в фильтрах сохраненных поисков
+// на целевой тег и удаляет исходный
+func merge(ctx context.Context, client *ent.Client, sourceID, targetID uuid.UUID) error {
+	source, err := client.Tag.Get(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	tagged, err := client.DocumentTag.
+		Query().
+		Where(documenttag.TagID(targetID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	documentIDs := make([]uuid.UUID, 0, len(tagged))
+	for _, link := range tagged {
+		documentIDs = append(documentIDs, link.DocumentID)
+	}
+
+	// У документа уже есть целевой тег - связь с исходным просто удаляется
+	if len(documentIDs) > 0 {
+		if _, err := client.DocumentTag.
+			Delete().
+			Where(
+				documenttag.TagID(sourceID),
+				documenttag.DocumentIDIn(documentIDs...),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := resolveExclusiveGroup(ctx, client, sourceID, targetID); err != nil {
+		return err
+	}
+
+	if _, err := client.DocumentTag.
+		Update().
+		Where(documenttag.TagID(sourceID)).
+		SetTagID(targetID).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	// Запрет на повторное присвоение переходит к целевому тегу, если у документа нет
+	// целевого тега или своего запрета на него; остальные запреты удалятся вместе с исходным тегом
+	suppressed, err := client.DocumentTagSuppression.
+		Query().
+		Where(documenttagsuppression.TagID(targetID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, suppression := range suppressed {
+		documentIDs = append(documentIDs, suppression.DocumentID)
+	}
+	if _, err := client.DocumentTagSuppression.
+		Update().
+		Where(
+			documenttagsuppression.TagID(sourceID),
+			documenttagsuppression.DocumentIDNotIn(documentIDs...),
+		).
+		SetTagID(targetID).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	if _, err := client.TagRule.
+		Update().
+		Where(tagrule.TagID(sourceID)).
+		SetTagID(targetID).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	if _, err := client.Tag.
+		Update().
+		Where(
+			tag.ParentID(sourceID),
+			tag.IDNEQ(targetID),
+		).
+		SetParentID(targetID).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	searches, err := client.SavedSearch.
+		Query().
+		Where(savedsearch.CompanyID(source.CompanyID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, search := range searches {
+		filters := search.Filters
+		replaced := false
+		for _, ids := range []*[]uuid.UUID{&filters.TagsAll, &filters.TagsAny, &filters.TagsNone} {
+			if replaceID(*ids, sourceID, targetID) {
+				*ids = uniqueIDs(*ids)
+				replaced = true
+			}
+		}
+		if !replaced {
+			continue
+		}
+		if err := client.SavedSearch.UpdateOne(search).SetFilters(filters).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return client.Tag.
+		DeleteOneID(sourceID).
+		Exec(ctx)
+}
+
+// resolveExclusiveGroup снимает конфликты исключающей группы целевого тега у документов,
+// которые получат его вместо исходного, по тем же правилам, что и при присвоении тега:
+// перенесенная связь заменяет другие теги группы, но автоматическая связь не заменяет ручную
+// и в этом случае удаляется сама
+func resolveExclusiveGroup(ctx context.Context, client *ent.Client, sourceID, targetID uuid.UUID) error {
+	target, err := client.Tag.
+		Query().
+		Where(tag.ID(targetID)).
+		WithGroup().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+	if target.Edges.Group == nil || !target.Edges.Group.Exclusive {
+		return nil
+	}
+
+	groupTagIDs, err := client.Tag.
+		Query().
+		Where(
+			tag.GroupID(target.Edges.Group.ID),
+			tag.IDNotIn(sourceID, targetID),
+		).
+		IDs(ctx)
+	if err != nil || len(groupTagIDs) == 0 {
+		return err
+	}
+
+	moved, err := client.DocumentTag.
+		Query().
+		Where(documenttag.TagID(sourceID)).
+		All(ctx)
+	if err != nil || len(moved) == 0 {
+		return err
+	}
+	documentIDs := make([]uuid.UUID, 0, len(moved))
+	for _, link := range moved {
+		documentIDs = append(documentIDs, link.DocumentID)
+	}
+
+	conflicts, err := client.DocumentTag.
+		Query().
+		Where(
+			documenttag.DocumentIDIn(documentIDs...),
+			documenttag.TagIDIn(groupTagIDs...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	byDocument := make(map[uuid.UUID][]*ent.DocumentTag)
+	for _, link := range conflicts {
+		byDocument[link.DocumentID] = append(byDocument[link.DocumentID], link)
+	}
+
+	var deleteIDs []uuid.UUID
+	for _, link := range moved {
+		others := byDocument[link.DocumentID]
+		manual := false
+		for _, other := range others {
+			if other.Source == documenttag.SourceManual {
+				manual = true
+			}
+		}
+		if manual && link.Source != documenttag.SourceManual {
+			deleteIDs = append(deleteIDs, link.ID)
+			continue
+		}
+		for _, other := range others {
+			deleteIDs = append(deleteIDs, other.ID)
+		}
+	}
+	if len(deleteIDs) == 0 {
+		return nil
+	}
+	_, err = client.DocumentTag.
+		Delete().
+		Where(documenttag.IDIn(deleteIDs...)).
+		Exec(ctx)
+	return err
+}
+
+// replaceID заменяет в списке from на to и сообщает, была ли замена
+func replaceID(ids []uuid.UUID, from, to uuid.UUID) bool {
+	replaced := false
+	for i, id := range ids {
+		if id == from {
+			ids[i] = to
+			replaced = true
+		}
+	}
+	return replaced
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+func (r *tagRepo) Usage(ctx context.Context, companyID uuid.UUID) ([]repo.TagUsage, error) {
+	rows, err := r.client.QueryContext(ctx, `
+		SELECT t.id, count(dt.id), max(dt.created_at)
+		FROM tags t
+		LEFT JOIN document_tags dt ON dt.tag_id = t.id
+		WHERE t.company_id = $1
+		GROUP BY t.id`, companyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usage := make([]repo.TagUsage, 0)
+	for rows.Next() {
+		var item repo.TagUsage
+		if err := rows.Scan(&item.TagID, &item.DocumentCount, &item.LastUsedAt); err != nil {
+			return nil, err
+		}
+		usage = append(usage, item)
+	}
+	return usage, rows.Err()
+}
@@ -0,0 +1,57 @@
+package tag_group
+
+import (
+	"context"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/taggroup"
+
+	"github.com/google/uuid"
+)
+
+type tagGroupRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.TagGroupRepository {
+	return &tagGroupRepo{client: client}
+}
+
+func (r *tagGroupRepo) Create(ctx context.Context, companyID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error) {
+	return r.client.TagGroup.
+		Create().
+		SetCompanyID(companyID).
+		SetName(name).
+		SetExclusive(exclusive).
+		Save(ctx)
+}
+
+func (r *tagGroupRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.TagGroup, error) {
+	return r.client.TagGroup.
+		Query().
+		Where(taggroup.ID(id)).
+		Only(ctx)
+}
+
+func (r *tagGroupRepo) Update(ctx context.Context, id uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error) {
+	return r.client.TagGroup.
+		UpdateOneID(id).
+		SetName(name).
+		SetExclusive(exclusive).
+		Save(ctx)
+}
+
+func (r *tagGroupRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.TagGroup.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r *tagGroupRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagGroup, error) {
+	return r.client.TagGroup.
+		Query().
+		Where(taggroup.CompanyID(companyID)).
+		Order(ent.Asc(taggroup.FieldName)).
+		All(ctx)
+}
@@ -0,0 +1,66 @@
+package tag_rule
+
+import (
+	"context"
+
+	"techmind/internal/repo"
+	"techmind/schema/ent"
+	"techmind/schema/ent/tagrule"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type tagRuleRepo struct {
+	client *ent.Client
+}
+
+func NewRepository(client *ent.Client) repo.TagRuleRepository {
+	return &tagRuleRepo{client: client}
+}
+
+func (r *tagRuleRepo) Create(ctx context.Context, companyID, tagID uuid.UUID, name string, conditions types.TagRuleConditions, priority int, stop, enabled bool) (*ent.TagRule, error) {
+	return r.client.TagRule.
+		Create().
+		SetCompanyID(companyID).
+		SetTagID(tagID).
+		SetName(name).
+		SetConditions(conditions).
+		SetPriority(priority).
+		SetStop(stop).
+		SetEnabled(enabled).
+		Save(ctx)
+}
+
+func (r *tagRuleRepo) GetByID(ctx context.Context, id uuid.UUID) (*ent.TagRule, error) {
+	return r.client.TagRule.
+		Query().
+		Where(tagrule.ID(id)).
+		Only(ctx)
+}
+
+func (r *tagRuleRepo) Update(ctx context.Context, id, tagID uuid.UUID, name string, conditions types.TagRuleConditions, priority int, stop, enabled bool) (*ent.TagRule, error) {
+	return r.client.TagRule.
+		UpdateOneID(id).
+		SetTagID(tagID).
+		SetName(name).
+		SetConditions(conditions).
+		SetPriority(priority).
+		SetStop(stop).
+		SetEnabled(enabled).
+		Save(ctx)
+}
+
+func (r *tagRuleRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.TagRule.
+		DeleteOneID(id).
+		Exec(ctx)
+}
+
+func (r *tagRuleRepo) ListByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagRule, error) {
+	return r.client.TagRule.
+		Query().
+		Where(tagrule.CompanyID(companyID)).
+		Order(ent.Desc(tagrule.FieldPriority), ent.Asc(tagrule.FieldCreatedAt)).
+		All(ctx)
+}
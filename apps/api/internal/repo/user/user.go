@@ -60,3 +60,10 @@ func (r *userRepo) List(ctx context.Context) ([]*ent.User, error) {
 		Query().
 		All(ctx)
 }
+
+func (r *userRepo) SetStoreSearchQueries(ctx context.Context, id uuid.UUID, store bool) (*ent.User, error) {
+	return r.client.User.
+		UpdateOneID(id).
+		SetStoreSearchQueries(store).
+		Save(ctx)
+}
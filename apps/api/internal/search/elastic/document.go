@@ -0,0 +1,98 @@
+package elastic
+
+import (
+	"time"
+
+	"techmind/internal/search"
+)
+
+// BuildDocument собирает документ индекса Elasticsearch
+func BuildDocument(document *search.Document) map[string]interface{} {
+	pages := make([]map[string]interface{}, 0, len(document.Pages))
+	for _, page := range document.Pages {
+		pages = append(pages, map[string]interface{}{
+			"number": page.Number,
+			"text":   page.Text,
+		})
+	}
+
+	esDocument := BuildAttributes(&document.Attributes)
+	esDocument["text"] = document.Text
+	esDocument["pages"] = pages
+
+	// Документ без векторов доступен только полнотекстовому поиску
+	if len(document.Chunks) > 0 {
+		chunks := make([]map[string]interface{}, 0, len(document.Chunks))
+		for _, chunk := range document.Chunks {
+			chunks = append(chunks, map[string]interface{}{
+				"page":   chunk.Page,
+				"vector": chunk.Vector,
+			})
+		}
+		esDocument["chunks"] = chunks
+	}
+
+	return esDocument
+}
+
+// BuildAttributes собирает поля индекса, не зависящие от текста документа
+// Используется для частичного обновления без повторной загрузки текста
+func BuildAttributes(attributes *search.Attributes) map[string]interface{} {
+	tags := make([]string, 0, len(attributes.TagIDs))
+	for _, id := range attributes.TagIDs {
+		tags = append(tags, id.String())
+	}
+
+	esDocument := map[string]interface{}{
+		"document_id": attributes.ID.String(),
+		"company_id":  attributes.CompanyID.String(),
+		"folder_id":   nil,
+		"name":        attributes.Name,
+		"mime_type":   attributes.MimeType,
+		"file_size":   attributes.FileSize,
+		"tag_ids":     tags,
+		"sender_id":   nil,
+		"created_by":  nil,
+		"type_id":     nil,
+		"fields":      buildFields(attributes.Fields),
+		"created_at":  attributes.CreatedAt.Format(time.RFC3339),
+		"updated_at":  attributes.UpdatedAt.Format(time.RFC3339),
+		"indexed_at":  time.Now().Format(time.RFC3339),
+	}
+
+	if attributes.FolderID != nil {
+		esDocument["folder_id"] = attributes.FolderID.String()
+	}
+	if attributes.SenderID != nil {
+		esDocument["sender_id"] = attributes.SenderID.String()
+	}
+	if attributes.CreatedBy != nil {
+		esDocument["created_by"] = attributes.CreatedBy.String()
+	}
+	if attributes.TypeID != nil {
+		esDocument["type_id"] = attributes.TypeID.String()
+	}
+
+	return esDocument
+}
+
+// buildFields собирает nested значения полей типа документа
+func buildFields(values []search.FieldValue) []map[string]interface{} {
+	fields := make([]map[string]interface{}, 0, len(values))
+	for _, value := range values {
+		field := map[string]interface{}{
+			"key": value.Key,
+		}
+		if value.Keyword != nil {
+			field["keyword"] = *value.Keyword
+		}
+		if value.Number != nil {
+			field["number"] = *value.Number
+		}
+		if value.Date != nil {
+			field["date"] = value.Date.Format(time.RFC3339)
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
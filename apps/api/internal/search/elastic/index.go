@@ -0,0 +1,140 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"techmind/internal/search"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/google/uuid"
+)
+
+// Index - поисковый индекс документов в Elasticsearch
+// Читает через алиас, а пишет во все индексы из Indices.WriteTargets
+type Index struct {
+	client  *elasticsearch.Client
+	indices *Indices
+}
+
+// NewIndex создает поисковый индекс поверх алиаса документов
+func NewIndex(client *elasticsearch.Client, indices *Indices) *Index {
+	return &Index{client: client, indices: indices}
+}
+
+func (ix *Index) Index(ctx context.Context, document *search.Document) error {
+	body, err := json.Marshal(BuildDocument(document))
+	if err != nil {
+		return fmt.Errorf("failed to marshal document for elasticsearch: %w", err)
+	}
+
+	// Пишем через алиас, а во время перестройки индекса - еще и в новый индекс
+	for _, index := range ix.indices.WriteTargets() {
+		res, err := esapi.IndexRequest{
+			Index:      index,
+			DocumentID: document.ID.String(),
+			Body:       bytes.NewReader(body),
+			Refresh:    "true",
+		}.Do(ctx, ix.client)
+		if err != nil {
+			return fmt.Errorf("failed to index document in elasticsearch: %w", err)
+		}
+		res.Body.Close()
+
+		if res.IsError() {
+			return fmt.Errorf("elasticsearch indexing error: %s", res.String())
+		}
+	}
+
+	return nil
+}
+
+func (ix *Index) Update(ctx context.Context, attributes *search.Attributes) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"doc": BuildAttributes(attributes),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal document for elasticsearch: %w", err)
+	}
+
+	for _, index := range ix.indices.WriteTargets() {
+		res, err := esapi.UpdateRequest{
+			Index:      index,
+			DocumentID: attributes.ID.String(),
+			Body:       bytes.NewReader(body),
+			Refresh:    "true",
+		}.Do(ctx, ix.client)
+		if err != nil {
+			return fmt.Errorf("failed to update document in elasticsearch: %w", err)
+		}
+		res.Body.Close()
+
+		// Документа еще нет в индексе, например его не успела загрузить перестройка
+		if res.StatusCode == http.StatusNotFound {
+			return search.ErrNotIndexed
+		}
+		if res.IsError() {
+			return fmt.Errorf("elasticsearch update error: %s", res.String())
+		}
+	}
+
+	return nil
+}
+
+func (ix *Index) Delete(ctx context.Context, documentID uuid.UUID) error {
+	ix.indices.NoteDeleted(documentID)
+	for _, index := range ix.indices.WriteTargets() {
+		res, err := esapi.DeleteRequest{
+			Index:      index,
+			DocumentID: documentID.String(),
+			Refresh:    "true",
+		}.Do(ctx, ix.client)
+		if err != nil {
+			return fmt.Errorf("failed to delete document from elasticsearch: %w", err)
+		}
+		res.Body.Close()
+
+		if res.IsError() && res.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("elasticsearch delete error: %s", res.String())
+		}
+	}
+
+	return nil
+}
+
+func (ix *Index) Count(ctx context.Context) (int64, error) {
+	return ix.indices.Count(ctx, Alias)
+}
+
+// searchRaw выполняет запрос к алиасу документов и разбирает ответ в result
+func (ix *Index) searchRaw(ctx context.Context, body map[string]interface{}, result interface{}) error {
+	queryJSON, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal search query: %w", err)
+	}
+
+	res, err := ix.client.Search(
+		ix.client.Search.WithContext(ctx),
+		ix.client.Search.WithIndex(Alias),
+		ix.client.Search.WithBody(bytes.NewReader(queryJSON)),
+	)
+	if err != nil {
+		return fmt.Errorf("elasticsearch search request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		bodyBytes, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("elasticsearch search error: %s", string(bodyBytes))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return fmt.Errorf("failed to parse elasticsearch response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package elastic_test
+
+import (
+	"os"
+	"testing"
+
+	"techmind/internal/search/elastic"
+	"techmind/internal/search/searchtest"
+	"techmind/pkg/config"
+
+	"github.com/elastic/go-elasticsearch/v8"
+)
+
+// Набор проверок поискового индекса на Elasticsearch
+// Запуск: TECHMIND_TEST_ELASTICSEARCH_URL=http://localhost:9200 go test ./internal/search/...
+
+func TestIndex_Conformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+	url := os.Getenv("TECHMIND_TEST_ELASTICSEARCH_URL")
+	if url == "" {
+		t.Skip("TECHMIND_TEST_ELASTICSEARCH_URL is not set")
+	}
+
+	client, err := elasticsearch.NewClient(elasticsearch.Config{Addresses: []string{url}})
+	if err != nil {
+		t.Fatalf("failed to create elasticsearch client: %v", err)
+	}
+
+	searchtest.Run(t, elastic.NewIndex(client, elastic.NewIndices(client, &config.Config{})))
+}
@@ -0,0 +1,304 @@
+package elastic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"techmind/internal/search"
+	"techmind/pkg/config"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esapi"
+	"github.com/google/uuid"
+)
+
+// Indices управляет версионированными индексами документов и алиасом над ними.
+// Во время перестройки запись идет и в алиас, и в новый индекс,
+// чтобы изменения, сделанные до переключения алиаса, не потерялись
+type Indices struct {
+	client *elasticsearch.Client
+
+	mu       sync.RWMutex
+	building string
+	// deleted - документы, удаленные во время перестройки: массовая загрузка могла прочитать их
+	// до удаления и записать в новый индекс уже после него
+	deleted map[uuid.UUID]struct{}
+}
+
+// NewIndices создает менеджер индексов и подготавливает алиас при запуске
+// Если поиск работает на другом движке, возвращает nil и к Elasticsearch не обращается
+func NewIndices(client *elasticsearch.Client, cfg *config.Config) *Indices {
+	if cfg.Search.Backend != "" && cfg.Search.Backend != search.BackendElasticsearch {
+		return nil
+	}
+
+	indices := &Indices{client: client}
+	if err := indices.ensureAlias(context.Background()); err != nil {
+		panic(err)
+	}
+	return indices
+}
+
+// WriteTargets возвращает индексы, в которые нужно записывать изменения документов
+func (i *Indices) WriteTargets() []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	if i.building != "" {
+		return []string{Alias, i.building}
+	}
+	return []string{Alias}
+}
+
+// BeginBuild отмечает индекс как строящийся, одновременно может строиться только один индекс
+func (i *Indices) BeginBuild(index string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.building != "" {
+		return fmt.Errorf("index %s is already being built", i.building)
+	}
+	i.building = index
+	i.deleted = make(map[uuid.UUID]struct{})
+	return nil
+}
+
+// EndBuild снимает отметку о строящемся индексе
+func (i *Indices) EndBuild() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.building = ""
+	i.deleted = nil
+}
+
+// NoteDeleted запоминает документ, удаленный во время перестройки
+func (i *Indices) NoteDeleted(documentID uuid.UUID) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.building != "" {
+		i.deleted[documentID] = struct{}{}
+	}
+}
+
+// DeletedDuringBuild возвращает документы, удаленные с начала перестройки
+func (i *Indices) DeletedDuringBuild() []uuid.UUID {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	ids := make([]uuid.UUID, 0, len(i.deleted))
+	for id := range i.deleted {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// Building возвращает имя строящегося индекса или пустую строку
+func (i *Indices) Building() string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.building
+}
+
+// Create создает физический индекс с текущим маппингом
+func (i *Indices) Create(ctx context.Context, index string) error {
+	body, err := json.Marshal(Definition())
+	if err != nil {
+		return fmt.Errorf("failed to marshal index definition: %w", err)
+	}
+
+	res, err := i.client.Indices.Create(index,
+		i.client.Indices.Create.WithBody(bytes.NewReader(body)),
+		i.client.Indices.Create.WithContext(ctx),
+	)
+	return checkResponse(res, err, "create index "+index)
+}
+
+// Delete удаляет физический индекс
+func (i *Indices) Delete(ctx context.Context, index string) error {
+	res, err := i.client.Indices.Delete([]string{index}, i.client.Indices.Delete.WithContext(ctx))
+	return checkResponse(res, err, "delete index "+index)
+}
+
+// DeleteDocument удаляет документ из физического индекса, отсутствие документа не считается ошибкой
+func (i *Indices) DeleteDocument(ctx context.Context, index string, documentID uuid.UUID) error {
+	res, err := esapi.DeleteRequest{
+		Index:      index,
+		DocumentID: documentID.String(),
+	}.Do(ctx, i.client)
+	if err != nil {
+		return fmt.Errorf("failed to delete document %s from %s: %w", documentID, index, err)
+	}
+	defer res.Body.Close()
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("failed to delete document %s from %s: %s", documentID, index, res.String())
+	}
+	return nil
+}
+
+// Exists проверяет существование индекса или алиаса
+func (i *Indices) Exists(ctx context.Context, name string) (bool, error) {
+	res, err := i.client.Indices.Exists([]string{name}, i.client.Indices.Exists.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("failed to check index %s: %w", name, err)
+	}
+	defer res.Body.Close()
+	return res.StatusCode == 200, nil
+}
+
+// AliasTargets возвращает физические индексы, на которые указывает алиас
+func (i *Indices) AliasTargets(ctx context.Context) ([]string, error) {
+	res, err := i.client.Indices.GetAlias(
+		i.client.Indices.GetAlias.WithName(Alias),
+		i.client.Indices.GetAlias.WithContext(ctx),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias %s: %w", Alias, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == 404 {
+		return nil, nil
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("failed to get alias %s: %s", Alias, res.String())
+	}
+
+	var aliases map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&aliases); err != nil {
+		return nil, fmt.Errorf("failed to decode alias response: %w", err)
+	}
+
+	targets := make([]string, 0, len(aliases))
+	for index := range aliases {
+		targets = append(targets, index)
+	}
+	return targets, nil
+}
+
+// SwapAlias атомарно переключает алиас со старых индексов на новый
+func (i *Indices) SwapAlias(ctx context.Context, index string, previous []string) error {
+	actions := make([]map[string]interface{}, 0, len(previous)+1)
+	for _, old := range previous {
+		actions = append(actions, map[string]interface{}{
+			"remove": map[string]interface{}{"index": old, "alias": Alias},
+		})
+	}
+	actions = append(actions, map[string]interface{}{
+		"add": map[string]interface{}{"index": index, "alias": Alias, "is_write_index": true},
+	})
+
+	body, err := json.Marshal(map[string]interface{}{"actions": actions})
+	if err != nil {
+		return fmt.Errorf("failed to marshal alias actions: %w", err)
+	}
+
+	res, err := i.client.Indices.UpdateAliases(bytes.NewReader(body), i.client.Indices.UpdateAliases.WithContext(ctx))
+	return checkResponse(res, err, "swap alias "+Alias)
+}
+
+// SetRefreshInterval меняет интервал обновления индекса, "-1" отключает обновление на время массовой загрузки
+func (i *Indices) SetRefreshInterval(ctx context.Context, index, interval string) error {
+	body := fmt.Sprintf(`{"index":{"refresh_interval":%q}}`, interval)
+	res, err := i.client.Indices.PutSettings(strings.NewReader(body),
+		i.client.Indices.PutSettings.WithIndex(index),
+		i.client.Indices.PutSettings.WithContext(ctx),
+	)
+	return checkResponse(res, err, "update settings of "+index)
+}
+
+// Refresh делает записанные документы доступными для поиска
+func (i *Indices) Refresh(ctx context.Context, index string) error {
+	res, err := i.client.Indices.Refresh(
+		i.client.Indices.Refresh.WithIndex(index),
+		i.client.Indices.Refresh.WithContext(ctx),
+	)
+	return checkResponse(res, err, "refresh index "+index)
+}
+
+// Count возвращает число документов в индексе или алиасе
+func (i *Indices) Count(ctx context.Context, name string) (int64, error) {
+	res, err := i.client.Count(i.client.Count.WithIndex(name), i.client.Count.WithContext(ctx))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents in %s: %w", name, err)
+	}
+	defer res.Body.Close()
+	if res.IsError() {
+		return 0, fmt.Errorf("failed to count documents in %s: %s", name, res.String())
+	}
+
+	var body struct {
+		Count int64 `json:"count"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return 0, fmt.Errorf("failed to decode count response: %w", err)
+	}
+	return body.Count, nil
+}
+
+// ensureAlias готовит алиас при запуске приложения:
+// создает индекс текущей версии, если индексов еще нет, и переносит данные
+// из индекса documents, созданного до появления версий
+func (i *Indices) ensureAlias(ctx context.Context) error {
+	targets, err := i.AliasTargets(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := IndexName(MappingVersion)
+	if len(targets) > 0 {
+		for _, target := range targets {
+			if IsVersion(target, MappingVersion) {
+				return nil
+			}
+		}
+		// Переключение на новый маппинг требует перестройки индекса из БД
+		fmt.Printf("Elasticsearch alias %s points to %v, mapping version %d requires reindex\n", Alias, targets, MappingVersion)
+		return nil
+	}
+
+	legacy, err := i.Exists(ctx, Alias)
+	if err != nil {
+		return err
+	}
+
+	if err := i.Create(ctx, current); err != nil {
+		return err
+	}
+
+	if legacy {
+		// Имя алиаса занято старым индексом: копируем данные средствами Elasticsearch
+		// и удаляем старый индекс, чтобы освободить имя
+		fmt.Printf("Migrating legacy Elasticsearch index %s to %s\n", Alias, current)
+		body := fmt.Sprintf(`{"source":{"index":%q},"dest":{"index":%q}}`, Alias, current)
+		res, err := i.client.Reindex(strings.NewReader(body),
+			i.client.Reindex.WithWaitForCompletion(true),
+			i.client.Reindex.WithContext(ctx),
+		)
+		if err := checkResponse(res, err, "reindex legacy index"); err != nil {
+			return err
+		}
+		if err := i.Delete(ctx, Alias); err != nil {
+			return err
+		}
+	}
+
+	return i.SwapAlias(ctx, current, nil)
+}
+
+// checkResponse закрывает ответ и превращает ошибочный статус в ошибку
+func checkResponse(res *esapi.Response, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("failed to %s: %s", action, res.String())
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package elastic
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// Alias - алиас, через который приложение читает и пишет документы
+	Alias = "documents"
+
+	// MappingVersion - версия маппинга индекса документов
+	// Увеличивается при каждом изменении Definition, после чего нужна переиндексация
+	MappingVersion = 5
+)
+
+// IndexName возвращает имя физического индекса для версии маппинга
+func IndexName(version int) string {
+	return fmt.Sprintf("%s_v%d", Alias, version)
+}
+
+// IsVersion проверяет, построен ли индекс с указанной версией маппинга
+// Повторная перестройка той же версии создает индекс с суффиксом, например documents_v1_1700000000
+func IsVersion(index string, version int) bool {
+	name := IndexName(version)
+	return index == name || strings.HasPrefix(index, name+"_")
+}
+
+// Definition возвращает настройки и маппинг индекса документов текущей версии
+func Definition() map[string]interface{} {
+	return map[string]interface{}{
+		"settings": map[string]interface{}{
+			"analysis": map[string]interface{}{
+				"analyzer": map[string]interface{}{
+					"rebuilt_russian": map[string]interface{}{
+						"tokenizer": "standard",
+						"filter": []string{
+							"lowercase",
+							"russian_stop",
+							"russian_stemmer",
+						},
+					},
+					// Префиксы слов для подсказок при вводе: "дог" находит "Договор"
+					"autocomplete": map[string]interface{}{
+						"tokenizer": "standard",
+						"filter": []string{
+							"lowercase",
+							"autocomplete_edge_ngram",
+						},
+					},
+					// Слова без стемминга: исправления опечаток должны быть настоящими словами
+					"suggest": map[string]interface{}{
+						"tokenizer": "standard",
+						"filter": []string{
+							"lowercase",
+						},
+					},
+				},
+				"filter": map[string]interface{}{
+					"russian_stop": map[string]interface{}{
+						"type":      "stop",
+						"stopwords": "_russian_",
+					},
+					"russian_stemmer": map[string]interface{}{
+						"type":     "stemmer",
+						"language": "russian",
+					},
+					"autocomplete_edge_ngram": map[string]interface{}{
+						"type":     "edge_ngram",
+						"min_gram": 1,
+						"max_gram": 20,
+					},
+				},
+			},
+		},
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"document_id": map[string]interface{}{
+					"type": "keyword",
+				},
+				"company_id": map[string]interface{}{
+					"type": "keyword",
+				},
+				"folder_id": map[string]interface{}{
+					"type": "keyword",
+				},
+				"name": map[string]interface{}{
+					"type":     "text",
+					"analyzer": "rebuilt_russian",
+					"fields": map[string]interface{}{
+						"autocomplete": map[string]interface{}{
+							"type":            "text",
+							"analyzer":        "autocomplete",
+							"search_analyzer": "suggest",
+						},
+						"suggest": map[string]interface{}{
+							"type":     "text",
+							"analyzer": "suggest",
+						},
+					},
+				},
+				"text": map[string]interface{}{
+					"type":     "text",
+					"analyzer": "rebuilt_russian",
+					"fields": map[string]interface{}{
+						"suggest": map[string]interface{}{
+							"type":     "text",
+							"analyzer": "suggest",
+						},
+					},
+				},
+				// Постраничный текст, nested позволяет искать в пределах одной страницы
+				"pages": map[string]interface{}{
+					"type": "nested",
+					"properties": map[string]interface{}{
+						"number": map[string]interface{}{
+							"type": "integer",
+						},
+						"text": map[string]interface{}{
+							"type":     "text",
+							"analyzer": "rebuilt_russian",
+						},
+					},
+				},
+				// Векторы фрагментов текста для семантического поиска, заполняются при включенных эмбеддингах
+				// Размерность определяется первым записанным вектором, смена модели требует переиндексации
+				"chunks": map[string]interface{}{
+					"type": "nested",
+					"properties": map[string]interface{}{
+						"page": map[string]interface{}{
+							"type": "integer",
+						},
+						"vector": map[string]interface{}{
+							"type":       "dense_vector",
+							"index":      true,
+							"similarity": "cosine",
+						},
+					},
+				},
+				"mime_type": map[string]interface{}{
+					"type": "keyword",
+				},
+				"file_size": map[string]interface{}{
+					"type": "long",
+				},
+				"tag_ids": map[string]interface{}{
+					"type": "keyword",
+				},
+				"sender_id": map[string]interface{}{
+					"type": "keyword",
+				},
+				"created_by": map[string]interface{}{
+					"type": "keyword",
+				},
+				"type_id": map[string]interface{}{
+					"type": "keyword",
+				},
+				// Значения полей типа документа, nested связывает значение с ключом поля
+				// keyword - строки, варианты перечислений и ID контрагентов, number - числа и суммы
+				"fields": map[string]interface{}{
+					"type": "nested",
+					"properties": map[string]interface{}{
+						"key": map[string]interface{}{
+							"type": "keyword",
+						},
+						"keyword": map[string]interface{}{
+							"type": "keyword",
+						},
+						"number": map[string]interface{}{
+							"type": "double",
+						},
+						"date": map[string]interface{}{
+							"type": "date",
+						},
+					},
+				},
+				"created_at": map[string]interface{}{
+					"type": "date",
+				},
+				"updated_at": map[string]interface{}{
+					"type": "date",
+				},
+				"indexed_at": map[string]interface{}{
+					"type": "date",
+				},
+			},
+		},
+	}
+}
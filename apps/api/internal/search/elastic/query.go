@@ -0,0 +1,639 @@
+package elastic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxResultWindow - ограничение Elasticsearch на from + size
+	maxResultWindow = 10000
+	// minSemanticCandidates - минимальное число ближайших фрагментов, которые ищет kNN на каждом шарде
+	// Дальше найденных кандидатов семантическая выдача не листается
+	minSemanticCandidates = 100
+)
+
+// esBucket - корзина агрегации Elasticsearch
+type esBucket struct {
+	Key         interface{} `json:"key"`
+	KeyAsString string      `json:"key_as_string"`
+	DocCount    int64       `json:"doc_count"`
+}
+
+// sortFields - поле и направление сортировки для каждого порядка выдачи
+var sortFields = map[string][2]string{
+	service.SearchSortRelevance:   {"_score", "desc"},
+	service.SearchSortCreatedDesc: {"created_at", "desc"},
+	service.SearchSortCreatedAsc:  {"created_at", "asc"},
+	service.SearchSortUpdatedDesc: {"updated_at", "desc"},
+	service.SearchSortUpdatedAsc:  {"updated_at", "asc"},
+	service.SearchSortSizeDesc:    {"file_size", "desc"},
+	service.SearchSortSizeAsc:     {"file_size", "asc"},
+}
+
+// Query выполняет поиск с фильтрами
+// Без условий по тексту возвращает отфильтрованные документы в порядке Sort
+func (ix *Index) Query(ctx context.Context, query *search.Query) (*search.Result, error) {
+	order, err := searchSort(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Фильтры - обязательные условия, не влияющие на релевантность
+	filter, mustNot := searchFilters(query)
+	root := map[string]interface{}{
+		"filter": filter,
+	}
+	if len(mustNot) > 0 {
+		root["must_not"] = mustNot
+	}
+
+	b := &queryBuilder{}
+	var must []map[string]interface{}
+	if query.Condition != nil {
+		clause, err := b.build(query.Condition, false)
+		if err != nil {
+			return nil, err
+		}
+		must = append(must, clause)
+	}
+	if query.Vector != nil {
+		candidates := semanticCandidates(query.Offset, query.Size)
+		if query.Text == nil {
+			must = append(must, knnQuery(query.Vector, candidates, 0))
+		} else {
+			text, err := b.build(query.Text, false)
+			if err != nil {
+				return nil, err
+			}
+			// Документ подходит по ключевым словам или по смыслу, оценки складываются
+			must = append(must, boolQuery("should", []map[string]interface{}{
+				text,
+				knnQuery(query.Vector, candidates, query.VectorWeight),
+			}, 1))
+		}
+	}
+	if len(must) > 0 {
+		root["must"] = must
+	}
+
+	searchQuery := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": root,
+		},
+		"size":             query.Size,
+		"track_total_hits": true,
+		"_source":          []string{"document_id"},
+		"aggs":             searchAggregations(),
+		"highlight": map[string]interface{}{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields": map[string]interface{}{
+				"name": map[string]interface{}{
+					"number_of_fragments": 0,
+				},
+				"text": map[string]interface{}{
+					"fragment_size":       150,
+					"number_of_fragments": 3,
+				},
+			},
+		},
+		// document_id делает сортировку однозначной для search_after
+		"sort": []interface{}{
+			order,
+			map[string]interface{}{"document_id": "asc"},
+		},
+	}
+	if query.After != nil {
+		searchQuery["search_after"] = query.After
+	} else {
+		searchQuery["from"] = query.Offset
+	}
+
+	// Парсим результаты
+	var response struct {
+		Hits struct {
+			Total struct {
+				Value int64 `json:"value"`
+			} `json:"total"`
+			Hits []struct {
+				Score  *float64 `json:"_score"`
+				Source struct {
+					DocumentID string `json:"document_id"`
+				} `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+				Sort      []interface{}       `json:"sort"`
+				InnerHits map[string]struct {
+					Hits struct {
+						Hits []struct {
+							Nested struct {
+								Offset int `json:"offset"`
+							} `json:"_nested"`
+							Source struct {
+								Number int `json:"number"`
+								// Page - страница фрагмента, найденного по смыслу
+								Page int `json:"page"`
+							} `json:"_source"`
+							Highlight map[string][]string `json:"highlight"`
+						} `json:"hits"`
+					} `json:"hits"`
+				} `json:"inner_hits"`
+			} `json:"hits"`
+		} `json:"hits"`
+		Aggregations map[string]struct {
+			Buckets []esBucket `json:"buckets"`
+		} `json:"aggregations"`
+	}
+	if err := ix.searchRaw(ctx, searchQuery, &response); err != nil {
+		return nil, err
+	}
+
+	result := &search.Result{
+		Hits:   make([]search.Hit, 0, len(response.Hits.Hits)),
+		Total:  response.Hits.Total.Value,
+		Facets: make(map[string][]search.Bucket, len(response.Aggregations)),
+	}
+	for _, hit := range response.Hits.Hits {
+		docID, err := uuid.Parse(hit.Source.DocumentID)
+		if err != nil {
+			continue
+		}
+
+		found := search.Hit{
+			DocumentID: docID,
+			Score:      hit.Score,
+			Highlights: hit.Highlight,
+			Sort:       hit.Sort,
+		}
+
+		// Каждое слово запроса дает свои inner_hits, совпадения объединяются по номеру страницы
+		pageIndex := make(map[int]int)
+		for _, name := range sortedKeys(hit.InnerHits) {
+			for _, pageHit := range hit.InnerHits[name].Hits.Hits {
+				number := pageHit.Source.Number
+				if pageHit.Source.Page > 0 {
+					number = pageHit.Source.Page
+				}
+				if number == 0 {
+					// Страницы хранятся по порядку, номер можно восстановить по позиции
+					number = pageHit.Nested.Offset + 1
+				}
+				if i, ok := pageIndex[number]; ok {
+					found.MatchedPages[i].Highlights = append(found.MatchedPages[i].Highlights, pageHit.Highlight["pages.text"]...)
+					continue
+				}
+				pageIndex[number] = len(found.MatchedPages)
+				found.MatchedPages = append(found.MatchedPages, service.PageMatch{
+					Number:     number,
+					Highlights: pageHit.Highlight["pages.text"],
+				})
+			}
+		}
+
+		result.Hits = append(result.Hits, found)
+	}
+
+	for name, aggregation := range response.Aggregations {
+		buckets := make([]search.Bucket, 0, len(aggregation.Buckets))
+		for _, bucket := range aggregation.Buckets {
+			key := bucket.KeyAsString
+			if key == "" {
+				key = fmt.Sprint(bucket.Key)
+			}
+			buckets = append(buckets, search.Bucket{Key: key, Count: bucket.DocCount})
+		}
+		result.Facets[name] = buckets
+	}
+
+	return result, nil
+}
+
+// searchSort возвращает основное условие сортировки выдачи
+func searchSort(query *search.Query) (map[string]interface{}, error) {
+	if query.Sort == service.SearchSortField && query.SortField != nil {
+		sortField := query.SortField
+		direction := "asc"
+		if sortField.Desc {
+			direction = "desc"
+		}
+		// Документы без значения поля идут в конце выдачи в обоих направлениях
+		return map[string]interface{}{
+			"fields." + sortField.Value: map[string]interface{}{
+				"order":   direction,
+				"missing": "_last",
+				"nested": map[string]interface{}{
+					"path": "fields",
+					"filter": map[string]interface{}{
+						"term": map[string]interface{}{"fields.key": sortField.Key},
+					},
+				},
+			},
+		}, nil
+	}
+
+	field, ok := sortFields[query.Sort]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", service.ErrInvalidSearchSort, query.Sort)
+	}
+	return map[string]interface{}{field[0]: field[1]}, nil
+}
+
+// searchFilters переводит фильтры поиска в условия bool запроса
+// Возвращает условия filter (обязательные) и must_not (исключающие)
+func searchFilters(query *search.Query) ([]map[string]interface{}, []map[string]interface{}) {
+	filters := query.Filters
+	filter := []map[string]interface{}{
+		{
+			"term": map[string]interface{}{
+				"company_id": query.CompanyID.String(),
+			},
+		},
+	}
+	var mustNot []map[string]interface{}
+
+	// Добавляем фильтр по папке, если указана
+	if filters.FolderID != nil {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"folder_id": filters.FolderID.String(),
+			},
+		})
+	}
+
+	// И: каждый тег отдельным условием
+	for _, tagID := range filters.TagsAll {
+		filter = append(filter, map[string]interface{}{
+			"term": map[string]interface{}{
+				"tag_ids": tagID.String(),
+			},
+		})
+	}
+	// И по наборам: из каждого набора хотя бы один тег
+	for _, set := range filters.TagSets {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"tag_ids": uuidStrings(set),
+			},
+		})
+	}
+	// ИЛИ: terms совпадает, если есть хотя бы одно значение
+	if len(filters.TagsAny) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"tag_ids": uuidStrings(filters.TagsAny),
+			},
+		})
+	}
+	// НЕ
+	if len(filters.TagsNone) > 0 {
+		mustNot = append(mustNot, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"tag_ids": uuidStrings(filters.TagsNone),
+			},
+		})
+	}
+
+	if len(filters.MimeTypes) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"mime_type": filters.MimeTypes,
+			},
+		})
+	}
+	if len(filters.SenderIDs) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"sender_id": uuidStrings(filters.SenderIDs),
+			},
+		})
+	}
+	if len(filters.CreatedBy) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"created_by": uuidStrings(filters.CreatedBy),
+			},
+		})
+	}
+
+	if r := dateRange(filters.CreatedFrom, filters.CreatedTo); r != nil {
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"created_at": r},
+		})
+	}
+	if r := dateRange(filters.UpdatedFrom, filters.UpdatedTo); r != nil {
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"updated_at": r},
+		})
+	}
+	if filters.SizeMin != nil || filters.SizeMax != nil {
+		r := map[string]interface{}{}
+		if filters.SizeMin != nil {
+			r["gte"] = *filters.SizeMin
+		}
+		if filters.SizeMax != nil {
+			r["lte"] = *filters.SizeMax
+		}
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"file_size": r},
+		})
+	}
+
+	if len(filters.TypeIDs) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{
+				"type_id": uuidStrings(filters.TypeIDs),
+			},
+		})
+	}
+	// Условие по полю проверяется внутри одного nested значения, чтобы ключ и значение совпали у одного поля
+	for _, field := range filters.Fields {
+		filter = append(filter, map[string]interface{}{
+			"nested": map[string]interface{}{
+				"path": "fields",
+				"query": map[string]interface{}{
+					"bool": map[string]interface{}{
+						"filter": fieldFilter(field),
+					},
+				},
+			},
+		})
+	}
+
+	return filter, mustNot
+}
+
+// fieldFilter строит условия по значению одного поля типа документа
+func fieldFilter(field types.FieldFilter) []map[string]interface{} {
+	filter := []map[string]interface{}{
+		{
+			"term": map[string]interface{}{"fields.key": field.Key},
+		},
+	}
+	if len(field.Values) > 0 {
+		filter = append(filter, map[string]interface{}{
+			"terms": map[string]interface{}{"fields.keyword": field.Values},
+		})
+	}
+	if field.Min != nil || field.Max != nil {
+		r := map[string]interface{}{}
+		if field.Min != nil {
+			r["gte"] = *field.Min
+		}
+		if field.Max != nil {
+			r["lte"] = *field.Max
+		}
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"fields.number": r},
+		})
+	}
+	if r := dateRange(field.From, field.To); r != nil {
+		filter = append(filter, map[string]interface{}{
+			"range": map[string]interface{}{"fields.date": r},
+		})
+	}
+	return filter
+}
+
+// dateRange строит условие range по датам, nil если границы не заданы
+func dateRange(from, to *time.Time) map[string]interface{} {
+	if from == nil && to == nil {
+		return nil
+	}
+	r := map[string]interface{}{}
+	if from != nil {
+		r["gte"] = from.Format(time.RFC3339)
+	}
+	if to != nil {
+		r["lte"] = to.Format(time.RFC3339)
+	}
+	return r
+}
+
+// searchAggregations описывает фасеты, которые считаются по всем найденным документам
+func searchAggregations() map[string]interface{} {
+	return map[string]interface{}{
+		service.FacetTags: map[string]interface{}{
+			"terms": map[string]interface{}{"field": "tag_ids", "size": 50},
+		},
+		service.FacetSenders: map[string]interface{}{
+			"terms": map[string]interface{}{"field": "sender_id", "size": 50},
+		},
+		service.FacetMimeTypes: map[string]interface{}{
+			"terms": map[string]interface{}{"field": "mime_type", "size": 20},
+		},
+		service.FacetMonths: map[string]interface{}{
+			"date_histogram": map[string]interface{}{
+				"field":             "created_at",
+				"calendar_interval": "month",
+				"format":            "yyyy-MM",
+				"min_doc_count":     1,
+				"order":             map[string]interface{}{"_key": "desc"},
+			},
+		},
+	}
+}
+
+// queryBuilder переводит условия запроса в запрос Elasticsearch
+type queryBuilder struct {
+	innerHits int
+}
+
+// build строит запрос для узла, negated - узел находится под NOT
+func (b *queryBuilder) build(node search.Node, negated bool) (map[string]interface{}, error) {
+	switch n := node.(type) {
+	case *search.Bool:
+		clauses := make([]map[string]interface{}, 0, len(n.Children))
+		for _, child := range n.Children {
+			clause, err := b.build(child, negated)
+			if err != nil {
+				return nil, err
+			}
+			clauses = append(clauses, clause)
+		}
+		if n.Or {
+			return boolQuery("should", clauses, 1), nil
+		}
+		return boolQuery("must", clauses, 0), nil
+
+	case *search.Not:
+		clause, err := b.build(n.Child, !negated)
+		if err != nil {
+			return nil, err
+		}
+		return boolQuery("must_not", []map[string]interface{}{clause}, 0), nil
+
+	case *search.Range:
+		bounds := map[string]interface{}{}
+		if n.Gte != nil {
+			bounds["gte"] = n.Gte
+		}
+		if n.Lt != nil {
+			bounds["lt"] = n.Lt
+		}
+		return map[string]interface{}{
+			"range": map[string]interface{}{n.Field: bounds},
+		}, nil
+
+	case *search.Terms:
+		if n.Wildcard && len(n.Values) == 1 {
+			return map[string]interface{}{
+				"wildcard": map[string]interface{}{n.Field: map[string]interface{}{"value": n.Values[0]}},
+			}, nil
+		}
+		// Пустой список не находит ничего
+		values := n.Values
+		if values == nil {
+			values = []string{}
+		}
+		return map[string]interface{}{
+			"terms": map[string]interface{}{n.Field: values},
+		}, nil
+
+	case *search.Text:
+		if n.Field == "" {
+			return b.buildFullText(n, negated), nil
+		}
+		return textLeaf(n.Field, n, 0), nil
+	}
+
+	return nil, fmt.Errorf("unsupported query node %T", node)
+}
+
+// buildFullText ищет по названию, тексту и страницам документа
+func (b *queryBuilder) buildFullText(term *search.Text, negated bool) map[string]interface{} {
+	nested := map[string]interface{}{
+		"path":       "pages",
+		"query":      textLeaf("pages.text", term, 0),
+		"score_mode": "max",
+	}
+	// Совпадения по страницам нужны, чтобы показать, где именно найден запрос,
+	// для исключаемых слов они не имеют смысла
+	if !negated {
+		b.innerHits++
+		nested["inner_hits"] = map[string]interface{}{
+			"name":    fmt.Sprintf("pages_%d", b.innerHits),
+			"size":    3,
+			"_source": []string{"pages.number"},
+			"highlight": map[string]interface{}{
+				"fields": map[string]interface{}{
+					"pages.text": map[string]interface{}{
+						"fragment_size":       150,
+						"number_of_fragments": 1,
+					},
+				},
+			},
+		}
+	}
+
+	return boolQuery("should", []map[string]interface{}{
+		// Повышаем релевантность совпадений в имени
+		textLeaf("name", term, 2.0),
+		textLeaf("text", term, 0),
+		{"nested": nested},
+	}, 1)
+}
+
+// textLeaf строит запрос к текстовому полю
+func textLeaf(field string, term *search.Text, boost float64) map[string]interface{} {
+	params := map[string]interface{}{}
+	if boost > 0 {
+		params["boost"] = boost
+	}
+
+	switch {
+	case term.Phrase:
+		params["query"] = term.Value
+		if term.Slop > 0 {
+			params["slop"] = term.Slop
+		}
+		return map[string]interface{}{"match_phrase": map[string]interface{}{field: params}}
+	case term.Wildcard:
+		params["value"] = strings.ToLower(term.Value)
+		params["case_insensitive"] = true
+		return map[string]interface{}{"wildcard": map[string]interface{}{field: params}}
+	case term.Fuzziness != "":
+		params["query"] = term.Value
+		params["fuzziness"] = term.Fuzziness
+		return map[string]interface{}{"match": map[string]interface{}{field: params}}
+	default:
+		// Соседние слова ищутся вместе: документ должен содержать каждое из них
+		params["query"] = term.Value
+		params["operator"] = "and"
+		return map[string]interface{}{"match": map[string]interface{}{field: params}}
+	}
+}
+
+// knnQuery ищет документы, фрагменты текста которых ближе всего к вектору запроса
+// Номера страниц с ближайшими фрагментами возвращаются в inner_hits
+func knnQuery(vector []float32, candidates int, boost float64) map[string]interface{} {
+	knn := map[string]interface{}{
+		"field":          "chunks.vector",
+		"query_vector":   vector,
+		"num_candidates": candidates,
+	}
+	if boost > 0 {
+		knn["boost"] = boost
+	}
+
+	return map[string]interface{}{
+		"nested": map[string]interface{}{
+			"path":       "chunks",
+			"query":      map[string]interface{}{"knn": knn},
+			"score_mode": "max",
+			"inner_hits": map[string]interface{}{
+				"name":    "chunks",
+				"size":    3,
+				"_source": []string{"chunks.page"},
+			},
+		},
+	}
+}
+
+// semanticCandidates возвращает число кандидатов kNN, достаточное для запрошенной страницы
+func semanticCandidates(offset, pageSize int) int {
+	candidates := 2 * (offset + pageSize)
+	if candidates < minSemanticCandidates {
+		candidates = minSemanticCandidates
+	}
+	if candidates > maxResultWindow {
+		candidates = maxResultWindow
+	}
+	return candidates
+}
+
+// boolQuery оборачивает условия в bool запрос
+func boolQuery(occur string, clauses []map[string]interface{}, minimumShouldMatch int) map[string]interface{} {
+	query := map[string]interface{}{occur: clauses}
+	if minimumShouldMatch > 0 {
+		query["minimum_should_match"] = minimumShouldMatch
+	}
+	return map[string]interface{}{"bool": query}
+}
+
+// uuidStrings преобразует ID в строки для запроса к Elasticsearch
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
+
+// sortedKeys возвращает ключи в порядке возрастания, чтобы выдача не зависела от порядка обхода map
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,136 @@
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"techmind/internal/search"
+)
+
+func TestQueryBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name string
+		node search.Node
+		want string
+	}{
+		{
+			name: "слово по названию",
+			node: &search.Text{Field: search.FieldName, Value: "акт"},
+			want: `{"match": {"name": {"query": "акт", "operator": "and"}}}`,
+		},
+		{
+			name: "несколько слов должны встретиться все",
+			node: &search.Text{Field: search.FieldText, Value: "оплата поставки"},
+			want: `{"match": {"text": {"query": "оплата поставки", "operator": "and"}}}`,
+		},
+		{
+			name: "фраза с расстоянием",
+			node: &search.Text{Field: search.FieldText, Value: "договор поставки", Phrase: true, Slop: 3},
+			want: `{"match_phrase": {"text": {"query": "договор поставки", "slop": 3}}}`,
+		},
+		{
+			name: "шаблон без учета регистра",
+			node: &search.Text{Field: search.FieldName, Value: "Акт*", Wildcard: true},
+			want: `{"wildcard": {"name": {"value": "акт*", "case_insensitive": true}}}`,
+		},
+		{
+			name: "нечеткий поиск",
+			node: &search.Text{Field: search.FieldText, Value: "договр", Fuzziness: "AUTO"},
+			want: `{"match": {"text": {"query": "договр", "fuzziness": "AUTO"}}}`,
+		},
+		{
+			name: "OR и NOT",
+			node: &search.Bool{Or: true, Children: []search.Node{
+				&search.Terms{Field: search.FieldTagIDs, Values: []string{"t1", "t2"}},
+				&search.Not{Child: &search.Terms{Field: search.FieldMimeType, Values: []string{"image/*"}, Wildcard: true}},
+			}},
+			want: `{"bool": {"minimum_should_match": 1, "should": [
+				{"terms": {"tag_ids": ["t1", "t2"]}},
+				{"bool": {"must_not": [{"wildcard": {"mime_type": {"value": "image/*"}}}]}}
+			]}}`,
+		},
+		{
+			name: "AND и диапазоны",
+			node: &search.Bool{Children: []search.Node{
+				&search.Range{Field: search.FieldCreatedAt, Gte: "2025-03-01T00:00:00Z", Lt: "2025-04-01T00:00:00Z"},
+				&search.Range{Field: search.FieldFileSize, Lt: int64(1024)},
+			}},
+			want: `{"bool": {"must": [
+				{"range": {"created_at": {"gte": "2025-03-01T00:00:00Z", "lt": "2025-04-01T00:00:00Z"}}},
+				{"range": {"file_size": {"lt": 1024}}}
+			]}}`,
+		},
+		{
+			name: "пустой список ничего не находит",
+			node: &search.Terms{Field: search.FieldSenderID},
+			want: `{"terms": {"sender_id": []}}`,
+		},
+		{
+			name: "исключаемое слово ищется без страниц с совпадениями",
+			node: &search.Not{Child: &search.Text{Value: "черновик"}},
+			want: `{"bool": {"must_not": [{"bool": {"minimum_should_match": 1, "should": [
+				{"match": {"name": {"query": "черновик", "operator": "and", "boost": 2}}},
+				{"match": {"text": {"query": "черновик", "operator": "and"}}},
+				{"nested": {"path": "pages", "score_mode": "max", "query": {"match": {"pages.text": {"query": "черновик", "operator": "and"}}}}}
+			]}}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&queryBuilder{}).build(tt.node, false)
+			if err != nil {
+				t.Fatalf("build error: %v", err)
+			}
+			assertJSONEqual(t, got, tt.want)
+		})
+	}
+}
+
+func TestQueryBuilder_BuildFullTextInnerHits(t *testing.T) {
+	b := &queryBuilder{}
+	query, err := b.build(&search.Bool{Children: []search.Node{
+		&search.Text{Value: "акт"},
+		&search.Text{Value: "сверки", Phrase: true},
+	}}, false)
+	if err != nil {
+		t.Fatalf("build error: %v", err)
+	}
+
+	// Каждое слово получает свое имя inner_hits, иначе Elasticsearch отклонит запрос
+	assertJSONEqual(t, query, `{"bool": {"must": [
+		{"bool": {"minimum_should_match": 1, "should": [
+			{"match": {"name": {"query": "акт", "operator": "and", "boost": 2}}},
+			{"match": {"text": {"query": "акт", "operator": "and"}}},
+			{"nested": {"path": "pages", "score_mode": "max",
+				"query": {"match": {"pages.text": {"query": "акт", "operator": "and"}}},
+				"inner_hits": {"name": "pages_1", "size": 3, "_source": ["pages.number"],
+					"highlight": {"fields": {"pages.text": {"fragment_size": 150, "number_of_fragments": 1}}}}}}
+		]}},
+		{"bool": {"minimum_should_match": 1, "should": [
+			{"match_phrase": {"name": {"query": "сверки", "boost": 2}}},
+			{"match_phrase": {"text": {"query": "сверки"}}},
+			{"nested": {"path": "pages", "score_mode": "max",
+				"query": {"match_phrase": {"pages.text": {"query": "сверки"}}},
+				"inner_hits": {"name": "pages_2", "size": 3, "_source": ["pages.number"],
+					"highlight": {"fields": {"pages.text": {"fragment_size": 150, "number_of_fragments": 1}}}}}}
+		]}}
+	]}}`)
+}
+
+// assertJSONEqual сравнивает запрос с ожидаемым JSON без учета порядка ключей и форматирования
+func assertJSONEqual(t *testing.T, got interface{}, want string) {
+	t.Helper()
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("failed to marshal query: %v", err)
+	}
+	var wantValue interface{}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("invalid expected JSON: %v", err)
+	}
+	wantJSON, _ := json.Marshal(wantValue)
+	if string(gotJSON) != string(wantJSON) {
+		t.Fatalf("query = %s\nwant    %s", gotJSON, wantJSON)
+	}
+}
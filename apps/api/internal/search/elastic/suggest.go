@@ -0,0 +1,152 @@
+package elastic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"techmind/internal/service"
+
+	"github.com/google/uuid"
+)
+
+// Suggest ищет документы компании, слова названия которых начинаются с введенных слов
+func (ix *Index) Suggest(ctx context.Context, companyID uuid.UUID, prefix string, limit int) ([]service.DocumentSuggestion, error) {
+	searchQuery := map[string]interface{}{
+		"size": limit,
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"filter": []map[string]interface{}{
+					{"term": map[string]interface{}{"company_id": companyID.String()}},
+				},
+				"must": map[string]interface{}{
+					"match": map[string]interface{}{
+						"name.autocomplete": map[string]interface{}{
+							"query":    prefix,
+							"operator": "and",
+						},
+					},
+				},
+				// Точное совпадение слов поднимает документ выше совпадения по префиксу
+				"should": map[string]interface{}{
+					"match": map[string]interface{}{
+						"name.suggest": prefix,
+					},
+				},
+			},
+		},
+		"_source": []string{"document_id", "name"},
+		"highlight": map[string]interface{}{
+			"pre_tags":  []string{"<em>"},
+			"post_tags": []string{"</em>"},
+			"fields": map[string]interface{}{
+				"name.autocomplete": map[string]interface{}{
+					"number_of_fragments": 0,
+				},
+			},
+		},
+	}
+
+	var response struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					DocumentID string `json:"document_id"`
+					Name       string `json:"name"`
+				} `json:"_source"`
+				Highlight map[string][]string `json:"highlight"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+	if err := ix.searchRaw(ctx, searchQuery, &response); err != nil {
+		return nil, err
+	}
+
+	documents := make([]service.DocumentSuggestion, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		id, err := uuid.Parse(hit.Source.DocumentID)
+		if err != nil {
+			continue
+		}
+		suggestion := service.DocumentSuggestion{
+			ID:        id,
+			Name:      hit.Source.Name,
+			Highlight: hit.Source.Name,
+		}
+		if highlights := hit.Highlight["name.autocomplete"]; len(highlights) > 0 {
+			suggestion.Highlight = highlights[0]
+		}
+		documents = append(documents, suggestion)
+	}
+	return documents, nil
+}
+
+// DidYouMean предлагает исправление опечаток phrase suggester'ом
+// Исправление проверяется запросом по документам компании, поэтому не предлагает слова,
+// которые встречаются только в документах других компаний
+func (ix *Index) DidYouMean(ctx context.Context, companyID uuid.UUID, text string) (string, error) {
+	collate, err := json.Marshal(map[string]interface{}{
+		"bool": map[string]interface{}{
+			"filter": []map[string]interface{}{
+				{"term": map[string]interface{}{"company_id": "{{company_id}}"}},
+			},
+			"must": map[string]interface{}{
+				"multi_match": map[string]interface{}{
+					"query":    "{{suggestion}}",
+					"fields":   []string{"name.suggest", "text.suggest"},
+					"operator": "and",
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal collate query: %w", err)
+	}
+
+	searchQuery := map[string]interface{}{
+		"size": 0,
+		"suggest": map[string]interface{}{
+			"text": text,
+			"did_you_mean": map[string]interface{}{
+				"phrase": map[string]interface{}{
+					"field":      "text.suggest",
+					"size":       1,
+					"max_errors": 2,
+					"direct_generator": []map[string]interface{}{
+						{"field": "text.suggest", "suggest_mode": "always"},
+						{"field": "name.suggest", "suggest_mode": "always"},
+					},
+					"collate": map[string]interface{}{
+						"query": map[string]interface{}{
+							"source": string(collate),
+						},
+						"params": map[string]interface{}{
+							"company_id": companyID.String(),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var response struct {
+		Suggest map[string][]struct {
+			Options []struct {
+				Text string `json:"text"`
+			} `json:"options"`
+		} `json:"suggest"`
+	}
+	if err := ix.searchRaw(ctx, searchQuery, &response); err != nil {
+		return "", err
+	}
+
+	for _, entry := range response.Suggest["did_you_mean"] {
+		for _, option := range entry.Options {
+			if !strings.EqualFold(option.Text, text) {
+				return option.Text, nil
+			}
+		}
+	}
+	return "", nil
+}
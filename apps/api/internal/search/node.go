@@ -0,0 +1,56 @@
+package search
+
+// Поля индекса, по которым строятся условия запроса
+const (
+	FieldName      = "name"
+	FieldText      = "text"
+	FieldTagIDs    = "tag_ids"
+	FieldSenderID  = "sender_id"
+	FieldMimeType  = "mime_type"
+	FieldCreatedAt = "created_at"
+	FieldUpdatedAt = "updated_at"
+	FieldFileSize  = "file_size"
+)
+
+// Node - условие запроса, в котором названия тегов и контрагентов уже заменены на ID
+type Node interface{}
+
+// Bool объединяет условия через AND или OR
+type Bool struct {
+	Or       bool
+	Children []Node
+}
+
+// Not исключает документы, подходящие под условие
+type Not struct {
+	Child Node
+}
+
+// Text - слово, фраза или шаблон в тексте
+// Field пустой - поиск по названию, тексту и страницам, иначе FieldName или FieldText
+type Text struct {
+	Field    string
+	Value    string
+	Phrase   bool
+	Slop     int
+	Wildcard bool
+	// Fuzziness - допустимое число опечаток, AUTO или пусто для точного совпадения
+	Fuzziness string
+}
+
+// Terms - поле равно любому из значений, пустой список не находит ничего
+// Wildcard - значения являются шаблонами с * и ?
+type Terms struct {
+	Field    string
+	Values   []string
+	Wildcard bool
+}
+
+// Range - диапазон даты или размера, границы nil - без ограничения
+// Даты задаются строками RFC3339, размер - int64
+type Range struct {
+	Field string
+	// Gte - нижняя граница включительно, Lt - верхняя граница не включительно
+	Gte interface{}
+	Lt  interface{}
+}
@@ -0,0 +1,318 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/schema/ent"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/hook"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/searchindextask"
+
+	"github.com/google/uuid"
+)
+
+// commitGrace - задержка обработки задачи, записанной до мутации
+// Если мутация завершилась успехом, задача сразу становится доступной обработчику,
+// если нет - она будет обработана позже и просто повторно синхронизирует документ с БД
+const commitGrace = 30 * time.Second
+
+// Notifier будит обработчик outbox после записи задач, не дожидаясь следующего опроса
+type Notifier struct {
+	ch chan struct{}
+}
+
+// NewNotifier создает Notifier
+func NewNotifier() *Notifier {
+	return &Notifier{ch: make(chan struct{}, 1)}
+}
+
+// Notify сообщает о новых задачах, не блокируясь, если обработчик уже оповещен
+func (n *Notifier) Notify() {
+	select {
+	case n.ch <- struct{}{}:
+	default:
+	}
+}
+
+// C возвращает канал оповещений
+func (n *Notifier) C() <-chan struct{} {
+	return n.ch
+}
+
+// indexedFields - поля документа, которые хранятся в поисковом индексе
+var indexedFields = map[string]bool{
+	document.FieldCompanyID:   true,
+	document.FieldFolderID:    true,
+	document.FieldSenderID:    true,
+	document.FieldName:        true,
+	document.FieldMimeType:    true,
+	document.FieldFileSize:    true,
+	document.FieldCreatedBy:   true,
+	document.FieldTypeID:      true,
+	document.FieldFieldValues: true,
+}
+
+// RegisterHooks подключает к клиенту ent хуки, которые записывают в outbox
+// изменения документов, их текста, тегов и связанных сущностей.
+// Задача пишется тем же клиентом, что и мутация, поэтому внутри транзакции
+// попадает в БД атомарно вместе с изменением
+func RegisterHooks(client *ent.Client, notifier *Notifier) {
+	client.Document.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentFunc(func(ctx context.Context, m *ent.DocumentMutation) (ent.Value, error) {
+			var (
+				ids []uuid.UUID
+				err error
+			)
+			operation := searchindextask.OperationUpdate
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				operation = searchindextask.OperationIndex
+				if id, ok := m.ID(); ok {
+					ids = []uuid.UUID{id}
+				}
+			case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+				operation = searchindextask.OperationDelete
+				ids, err = m.IDs(ctx)
+			case touchesIndexedFields(m):
+				ids, err = m.IDs(ctx)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, operation, ids)
+		})
+	})
+
+	client.DocumentContent.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentContentFunc(func(ctx context.Context, m *ent.DocumentContentMutation) (ent.Value, error) {
+			var ids []uuid.UUID
+			if documentID, ok := m.DocumentID(); ok {
+				ids = []uuid.UUID{documentID}
+			} else {
+				contentIDs, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				contents, err := m.Client().DocumentContent.Query().
+					Where(documentcontent.IDIn(contentIDs...)).
+					All(ctx)
+				if err != nil {
+					return nil, err
+				}
+				for _, content := range contents {
+					ids = append(ids, content.DocumentID)
+				}
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationIndex, ids)
+		})
+	})
+
+	client.DocumentTag.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.DocumentTagFunc(func(ctx context.Context, m *ent.DocumentTagMutation) (ent.Value, error) {
+			var ids []uuid.UUID
+			if documentID, ok := m.DocumentID(); ok {
+				ids = []uuid.UUID{documentID}
+			}
+			if !m.Op().Is(ent.OpCreate) {
+				linkIDs, err := m.IDs(ctx)
+				if err != nil {
+					return nil, err
+				}
+				linked, err := documentIDsByLinks(ctx, m.Client(), documenttag.IDIn(linkIDs...))
+				if err != nil {
+					return nil, err
+				}
+				ids = append(ids, linked...)
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationUpdate, ids)
+		})
+	})
+
+	// В индексе хранятся только ID тегов, поэтому переименование тега индекс не меняет.
+	// При удалении связи с документами удаляются каскадно в БД, минуя хуки DocumentTag
+	client.Tag.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TagFunc(func(ctx context.Context, m *ent.TagMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			tagIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := documentIDsByLinks(ctx, m.Client(), documenttag.TagIDIn(tagIDs...))
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationUpdate, ids)
+		})
+	})
+
+	// Удаление контрагента, папки и пользователя обнуляет ссылки в документах (ON DELETE SET NULL)
+	client.Sender.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.SenderFunc(func(ctx context.Context, m *ent.SenderMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			senderIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := m.Client().Document.Query().Where(document.SenderIDIn(senderIDs...)).IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationUpdate, ids)
+		})
+	})
+
+	client.Folder.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.FolderFunc(func(ctx context.Context, m *ent.FolderMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			folderIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := m.Client().Document.Query().Where(document.FolderIDIn(folderIDs...)).IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationUpdate, ids)
+		})
+	})
+
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			userIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := m.Client().Document.Query().Where(document.CreatedByIn(userIDs...)).IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationUpdate, ids)
+		})
+	})
+
+	// Документы компании удаляются каскадно в БД, минуя хуки Document
+	client.Company.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.CompanyFunc(func(ctx context.Context, m *ent.CompanyMutation) (ent.Value, error) {
+			if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				return next.Mutate(ctx, m)
+			}
+			companyIDs, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids, err := m.Client().Document.Query().Where(document.CompanyIDIn(companyIDs...)).IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return mutate(ctx, next, m, m.Client(), notifier, searchindextask.OperationDelete, ids)
+		})
+	})
+}
+
+// touchesIndexedFields проверяет, меняет ли обновление поля документа, хранящиеся в индексе
+func touchesIndexedFields(m *ent.DocumentMutation) bool {
+	for _, name := range append(m.Fields(), m.ClearedFields()...) {
+		if indexedFields[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// documentIDsByLinks возвращает ID документов по связям документ-тег
+func documentIDsByLinks(ctx context.Context, client *ent.Client, where ...predicate.DocumentTag) ([]uuid.UUID, error) {
+	links, err := client.DocumentTag.Query().Where(where...).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(links))
+	for _, link := range links {
+		ids = append(ids, link.DocumentID)
+	}
+	return ids, nil
+}
+
+// mutate записывает задачи до мутации, выполняет ее и открывает задачи для обработки.
+// Задача, записанная до мутации, не теряется при падении между мутацией и записью в outbox,
+// а обработчик всегда читает актуальное состояние документа из БД
+func mutate(ctx context.Context, next ent.Mutator, m ent.Mutation, client *ent.Client, notifier *Notifier, operation searchindextask.Operation, documentIDs []uuid.UUID) (ent.Value, error) {
+	documentIDs = unique(documentIDs)
+	if len(documentIDs) == 0 {
+		return next.Mutate(ctx, m)
+	}
+
+	taskIDs, err := enqueue(ctx, client, operation, documentIDs, time.Now().Add(commitGrace))
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue search index tasks: %w", err)
+	}
+
+	value, err := next.Mutate(ctx, m)
+	if err != nil {
+		return value, err
+	}
+
+	released, err := client.SearchIndexTask.Update().
+		Where(searchindextask.IDIn(taskIDs...)).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to release search index tasks: %w", err)
+	}
+	// Обработчик мог забрать задачи раньше, чем мутация применилась, и прочитать старое состояние
+	if released < len(taskIDs) {
+		if _, err := enqueue(ctx, client, operation, documentIDs, time.Now()); err != nil {
+			return nil, fmt.Errorf("failed to enqueue search index tasks: %w", err)
+		}
+	}
+
+	notifier.Notify()
+	return value, nil
+}
+
+// enqueue записывает задачи для документов и возвращает их ID
+func enqueue(ctx context.Context, client *ent.Client, operation searchindextask.Operation, documentIDs []uuid.UUID, nextAttemptAt time.Time) ([]uuid.UUID, error) {
+	builders := make([]*ent.SearchIndexTaskCreate, 0, len(documentIDs))
+	for _, documentID := range documentIDs {
+		builders = append(builders, client.SearchIndexTask.Create().
+			SetDocumentID(documentID).
+			SetOperation(operation).
+			SetNextAttemptAt(nextAttemptAt))
+	}
+
+	tasks, err := client.SearchIndexTask.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids, nil
+}
+
+// unique убирает повторяющиеся ID с сохранением порядка
+func unique(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := ids[:0]
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,226 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// Table - таблица документов поискового индекса
+const Table = "search_documents"
+
+// Index - поисковый индекс документов на полнотекстовом поиске PostgreSQL
+// Документы хранятся в таблице search_documents, tsvector названия и текста строится
+// русской и английской конфигурациями. Поиск по смыслу не поддерживается
+type Index struct {
+	client *ent.Client
+}
+
+// NewIndex создает поисковый индекс в таблице search_documents
+func NewIndex(client *ent.Client) *Index {
+	return &Index{client: client}
+}
+
+// indexPage - страница документа в колонке pages
+type indexPage struct {
+	Number int    `json:"number"`
+	Text   string `json:"text"`
+}
+
+func (ix *Index) Index(ctx context.Context, document *search.Document) error {
+	pages := make([]indexPage, 0, len(document.Pages))
+	for _, page := range document.Pages {
+		pages = append(pages, indexPage{Number: page.Number, Text: cleanText(page.Text)})
+	}
+	pagesJSON, err := json.Marshal(pages)
+	if err != nil {
+		return fmt.Errorf("failed to marshal document pages: %w", err)
+	}
+
+	a := &document.Attributes
+	fieldsJSON, err := fieldsJSON(a.Fields)
+	if err != nil {
+		return err
+	}
+	_, err = ix.client.ExecContext(ctx, `
+		INSERT INTO search_documents (document_id, company_id, folder_id, sender_id, created_by, name, mime_type,
+		                              file_size, tag_ids, created_at, updated_at, text, pages, type_id, fields, indexed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::uuid[], $10, $11, $12, $13, $14, $15, NOW())
+		ON CONFLICT (document_id) DO UPDATE SET
+			company_id = EXCLUDED.company_id,
+			folder_id  = EXCLUDED.folder_id,
+			sender_id  = EXCLUDED.sender_id,
+			created_by = EXCLUDED.created_by,
+			name       = EXCLUDED.name,
+			mime_type  = EXCLUDED.mime_type,
+			file_size  = EXCLUDED.file_size,
+			tag_ids    = EXCLUDED.tag_ids,
+			created_at = EXCLUDED.created_at,
+			updated_at = EXCLUDED.updated_at,
+			text       = EXCLUDED.text,
+			pages      = EXCLUDED.pages,
+			type_id    = EXCLUDED.type_id,
+			fields     = EXCLUDED.fields,
+			indexed_at = EXCLUDED.indexed_at`,
+		a.ID, a.CompanyID, a.FolderID, a.SenderID, a.CreatedBy, cleanText(a.Name), a.MimeType,
+		a.FileSize, pq.Array(uuidStrings(a.TagIDs)), a.CreatedAt, a.UpdatedAt, cleanText(document.Text), string(pagesJSON),
+		a.TypeID, fieldsJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to index document in postgres: %w", err)
+	}
+	return nil
+}
+
+func (ix *Index) Update(ctx context.Context, attributes *search.Attributes) error {
+	fieldsJSON, err := fieldsJSON(attributes.Fields)
+	if err != nil {
+		return err
+	}
+	res, err := ix.client.ExecContext(ctx, `
+		UPDATE search_documents SET
+			company_id = $2,
+			folder_id  = $3,
+			sender_id  = $4,
+			created_by = $5,
+			name       = $6,
+			mime_type  = $7,
+			file_size  = $8,
+			tag_ids    = $9::uuid[],
+			created_at = $10,
+			updated_at = $11,
+			type_id    = $12,
+			fields     = $13,
+			indexed_at = NOW()
+		WHERE document_id = $1`,
+		attributes.ID, attributes.CompanyID, attributes.FolderID, attributes.SenderID, attributes.CreatedBy,
+		cleanText(attributes.Name), attributes.MimeType, attributes.FileSize, pq.Array(uuidStrings(attributes.TagIDs)),
+		attributes.CreatedAt, attributes.UpdatedAt, attributes.TypeID, fieldsJSON,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update document in postgres index: %w", err)
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update document in postgres index: %w", err)
+	}
+	if affected == 0 {
+		return search.ErrNotIndexed
+	}
+	return nil
+}
+
+func (ix *Index) Delete(ctx context.Context, documentID uuid.UUID) error {
+	if _, err := ix.client.ExecContext(ctx, `DELETE FROM search_documents WHERE document_id = $1`, documentID); err != nil {
+		return fmt.Errorf("failed to delete document from postgres index: %w", err)
+	}
+	return nil
+}
+
+func (ix *Index) Count(ctx context.Context) (int64, error) {
+	rows, err := ix.client.QueryContext(ctx, `SELECT count(*) FROM search_documents`)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count documents in postgres index: %w", err)
+	}
+	defer rows.Close()
+
+	var count int64
+	if rows.Next() {
+		if err := rows.Scan(&count); err != nil {
+			return 0, fmt.Errorf("failed to count documents in postgres index: %w", err)
+		}
+	}
+	return count, rows.Err()
+}
+
+// Suggest ищет документы, слова названия которых начинаются с введенных слов
+func (ix *Index) Suggest(ctx context.Context, companyID uuid.UUID, prefix string, limit int) ([]service.DocumentSuggestion, error) {
+	documents := make([]service.DocumentSuggestion, 0)
+
+	words := strings.FieldsFunc(prefix, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return documents, nil
+	}
+
+	// Каждое слово приводится к основе и ищется как префикс: "договоры" находит "Договор поставки"
+	args := []interface{}{companyID, limit}
+	parts := make([]string, 0, len(words))
+	for _, word := range words {
+		args = append(args, strings.ToLower(word)+":*")
+		n := len(args)
+		parts = append(parts, fmt.Sprintf("(to_tsquery('russian', $%d) || to_tsquery('english', $%d))", n, n))
+	}
+
+	rows, err := ix.client.QueryContext(ctx, `
+		SELECT d.document_id, d.name, ts_headline('russian', d.name, q.tsq, 'HighlightAll=true, StartSel=<em>, StopSel=</em>')
+		FROM search_documents d, (SELECT `+strings.Join(parts, " && ")+` AS tsq) q
+		WHERE d.company_id = $1 AND d.name_tsv @@ q.tsq
+		ORDER BY ts_rank(d.name_tsv, q.tsq) DESC, d.name
+		LIMIT $2`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres suggest failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var suggestion service.DocumentSuggestion
+		if err := rows.Scan(&suggestion.ID, &suggestion.Name, &suggestion.Highlight); err != nil {
+			return nil, fmt.Errorf("failed to read suggestion: %w", err)
+		}
+		documents = append(documents, suggestion)
+	}
+	return documents, rows.Err()
+}
+
+// DidYouMean не поддерживается: для исправления опечаток нужен словарь слов индекса,
+// а полнотекстовый поиск PostgreSQL его не ведет
+func (ix *Index) DidYouMean(context.Context, uuid.UUID, string) (string, error) {
+	return "", nil
+}
+
+// indexField - значение поля типа документа в колонке fields, ключ объекта - ключ поля
+type indexField struct {
+	Keyword *string    `json:"keyword,omitempty"`
+	Number  *float64   `json:"number,omitempty"`
+	Date    *time.Time `json:"date,omitempty"`
+}
+
+// fieldsJSON собирает колонку fields
+func fieldsJSON(values []search.FieldValue) (string, error) {
+	fields := make(map[string]indexField, len(values))
+	for _, value := range values {
+		fields[value.Key] = indexField{Keyword: value.Keyword, Number: value.Number, Date: value.Date}
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal document fields: %w", err)
+	}
+	return string(data), nil
+}
+
+// cleanText удаляет нулевые символы, которые PostgreSQL не принимает в тексте
+func cleanText(text string) string {
+	return strings.ReplaceAll(text, "\x00", "")
+}
+
+// uuidStrings преобразует ID в строки для параметра uuid[]
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
@@ -0,0 +1,488 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+	"techmind/schema/types"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// headlineOptions - подсветка совпадений так же, как в выдаче Elasticsearch
+	headlineOptions = "StartSel=<em>, StopSel=</em>, MaxWords=25, MinWords=10"
+	// fragmentDelimiter разделяет фрагменты текста в результате ts_headline
+	fragmentDelimiter = "\x1f"
+	// maxMatchedPages - число страниц с совпадениями для каждого документа
+	maxMatchedPages = 3
+)
+
+// sortColumns - выражение сортировки для каждого порядка выдачи
+var sortColumns = map[string]string{
+	service.SearchSortRelevance:   "rank DESC",
+	service.SearchSortCreatedDesc: "d.created_at DESC",
+	service.SearchSortCreatedAsc:  "d.created_at ASC",
+	service.SearchSortUpdatedDesc: "d.updated_at DESC",
+	service.SearchSortUpdatedAsc:  "d.updated_at ASC",
+	service.SearchSortSizeDesc:    "d.file_size DESC",
+	service.SearchSortSizeAsc:     "d.file_size ASC",
+}
+
+// fieldSortTypes - приведение значения поля типа документа для сортировки
+var fieldSortTypes = map[string]string{
+	search.FieldSortKeyword: "text",
+	search.FieldSortNumber:  "float8",
+	search.FieldSortDate:    "timestamptz",
+}
+
+// sortColumn возвращает выражение сортировки выдачи
+// Ключ поля подставляется литералом: параметры запроса общие с подсчетом фасетов, где сортировки нет
+func sortColumn(query *search.Query) (string, error) {
+	if query.Sort == service.SearchSortField && query.SortField != nil {
+		cast, ok := fieldSortTypes[query.SortField.Value]
+		if !ok {
+			return "", fmt.Errorf("%w: %q", service.ErrInvalidSearchSort, query.SortField.Value)
+		}
+		direction := "ASC"
+		if query.SortField.Desc {
+			direction = "DESC"
+		}
+		return fmt.Sprintf("(d.fields->%s->>%s)::%s %s NULLS LAST",
+			pq.QuoteLiteral(query.SortField.Key), pq.QuoteLiteral(query.SortField.Value), cast, direction), nil
+	}
+
+	order, ok := sortColumns[query.Sort]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", service.ErrInvalidSearchSort, query.Sort)
+	}
+	return order, nil
+}
+
+// rangeColumns - поля, по которым возможен поиск по диапазону
+var rangeColumns = map[string]bool{
+	search.FieldCreatedAt: true,
+	search.FieldUpdatedAt: true,
+	search.FieldFileSize:  true,
+}
+
+// Query ищет документы запросом к search_documents
+// Релевантность - ts_rank по названию с весом 2 и по тексту. Шаблоны слов проверяются регулярными
+// выражениями и на релевантность и подсветку не влияют. Курсор выдачи - позиция документа в ней
+func (ix *Index) Query(ctx context.Context, query *search.Query) (*search.Result, error) {
+	if query.Vector != nil {
+		return nil, service.ErrSemanticSearchDisabled
+	}
+	order, err := sortColumn(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &queryBuilder{}
+	where := b.filters(query)
+	if query.Condition != nil {
+		condition, err := b.build(query.Condition, false)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, condition)
+	}
+	whereSQL := strings.Join(where, " AND ")
+
+	// Слова запроса, найденные в документе, определяют релевантность, подсветку и страницы
+	tsquery := "NULL::tsquery"
+	rank := "0::float8"
+	highlights := "NULL, NULL, '[]'::jsonb"
+	if len(b.highlight) > 0 {
+		tsquery = strings.Join(b.highlight, " || ")
+		rank = "ts_rank(d.name_tsv, q.tsq) * 2 + ts_rank(d.text_tsv, q.tsq)"
+		highlights = fmt.Sprintf(`
+			ts_headline('russian', d.name, q.tsq, 'HighlightAll=true, StartSel=<em>, StopSel=</em>'),
+			ts_headline('russian', d.text, q.tsq, '%[1]s, MaxFragments=3, FragmentDelimiter=%[2]s'),
+			COALESCE((
+				SELECT jsonb_agg(jsonb_build_object(
+					'number', (p.page->>'number')::int,
+					'highlight', ts_headline('russian', p.page->>'text', q.tsq, '%[1]s')
+				))
+				FROM (
+					SELECT e.page FROM jsonb_array_elements(d.pages) AS e(page)
+					WHERE (to_tsvector('russian', e.page->>'text') || to_tsvector('english', e.page->>'text')) @@ q.tsq
+					LIMIT %[3]d
+				) p
+			), '[]'::jsonb)`, headlineOptions, fragmentDelimiter, maxMatchedPages)
+	}
+	from := fmt.Sprintf("search_documents d, (SELECT %s AS tsq) q", tsquery)
+
+	offset := query.Offset
+	if len(query.After) > 0 {
+		switch position := query.After[0].(type) {
+		case int:
+			offset = position
+		case float64:
+			// Курсор прошел через JSON
+			offset = int(position)
+		}
+	}
+
+	result := &search.Result{
+		Hits:   []search.Hit{},
+		Facets: map[string][]search.Bucket{},
+	}
+
+	args := append(append([]interface{}{}, b.args...), query.Size, offset)
+	rows, err := ix.client.QueryContext(ctx, fmt.Sprintf(`
+		SELECT d.document_id, %s AS rank, %s
+		FROM %s
+		WHERE %s
+		ORDER BY %s, d.document_id
+		LIMIT $%d OFFSET $%d`, rank, highlights, from, whereSQL, order, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("postgres search failed: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			hit           search.Hit
+			score         float64
+			nameHighlight *string
+			textHighlight *string
+			pagesJSON     []byte
+		)
+		if err := rows.Scan(&hit.DocumentID, &score, &nameHighlight, &textHighlight, &pagesJSON); err != nil {
+			return nil, fmt.Errorf("failed to read search hit: %w", err)
+		}
+		hit.Score = &score
+		hit.Highlights = map[string][]string{}
+		if nameHighlight != nil && strings.Contains(*nameHighlight, "<em>") {
+			hit.Highlights["name"] = []string{*nameHighlight}
+		}
+		if textHighlight != nil && strings.Contains(*textHighlight, "<em>") {
+			hit.Highlights["text"] = strings.Split(*textHighlight, fragmentDelimiter)
+		}
+
+		var pages []struct {
+			Number    int    `json:"number"`
+			Highlight string `json:"highlight"`
+		}
+		if err := json.Unmarshal(pagesJSON, &pages); err != nil {
+			return nil, fmt.Errorf("failed to read matched pages: %w", err)
+		}
+		for _, page := range pages {
+			hit.MatchedPages = append(hit.MatchedPages, service.PageMatch{
+				Number:     page.Number,
+				Highlights: []string{page.Highlight},
+			})
+		}
+
+		offset++
+		hit.Sort = []interface{}{offset}
+		result.Hits = append(result.Hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("postgres search failed: %w", err)
+	}
+
+	if err := ix.scanOne(ctx, &result.Total,
+		fmt.Sprintf(`SELECT count(*) FROM %s WHERE %s`, from, whereSQL), b.args...); err != nil {
+		return nil, err
+	}
+
+	facets := map[string]string{
+		service.FacetTags: fmt.Sprintf(`
+			SELECT t.id::text, count(*) FROM %s, unnest(d.tag_ids) t(id) WHERE %s
+			GROUP BY t.id ORDER BY count(*) DESC, t.id LIMIT 50`, from, whereSQL),
+		service.FacetSenders: fmt.Sprintf(`
+			SELECT d.sender_id::text, count(*) FROM %s WHERE %s AND d.sender_id IS NOT NULL
+			GROUP BY d.sender_id ORDER BY count(*) DESC, d.sender_id LIMIT 50`, from, whereSQL),
+		service.FacetMimeTypes: fmt.Sprintf(`
+			SELECT d.mime_type, count(*) FROM %s WHERE %s
+			GROUP BY d.mime_type ORDER BY count(*) DESC, d.mime_type LIMIT 20`, from, whereSQL),
+		service.FacetMonths: fmt.Sprintf(`
+			SELECT to_char(d.created_at AT TIME ZONE 'UTC', 'YYYY-MM') AS month, count(*) FROM %s WHERE %s
+			GROUP BY month ORDER BY month DESC`, from, whereSQL),
+	}
+	for name, sql := range facets {
+		buckets, err := ix.buckets(ctx, sql, b.args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count facet %s: %w", name, err)
+		}
+		result.Facets[name] = buckets
+	}
+
+	return result, nil
+}
+
+// buckets выполняет запрос, возвращающий значение фасета и число документов
+func (ix *Index) buckets(ctx context.Context, sql string, args []interface{}) ([]search.Bucket, error) {
+	rows, err := ix.client.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buckets := make([]search.Bucket, 0)
+	for rows.Next() {
+		var bucket search.Bucket
+		if err := rows.Scan(&bucket.Key, &bucket.Count); err != nil {
+			return nil, err
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets, rows.Err()
+}
+
+// scanOne выполняет запрос, возвращающий одно значение
+func (ix *Index) scanOne(ctx context.Context, dest interface{}, sql string, args ...interface{}) error {
+	rows, err := ix.client.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("postgres search failed: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(dest); err != nil {
+			return fmt.Errorf("postgres search failed: %w", err)
+		}
+	}
+	return rows.Err()
+}
+
+// queryBuilder переводит условия запроса в условие WHERE с параметрами
+type queryBuilder struct {
+	args []interface{}
+	// highlight - tsquery слов, которые документ должен содержать, а не исключать
+	highlight []string
+}
+
+// arg добавляет параметр запроса и возвращает его плейсхолдер
+func (b *queryBuilder) arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// filters переводит структурированные фильтры в условия WHERE
+func (b *queryBuilder) filters(query *search.Query) []string {
+	filters := query.Filters
+	where := []string{"d.company_id = " + b.arg(query.CompanyID)}
+
+	if filters.FolderID != nil {
+		where = append(where, "d.folder_id = "+b.arg(*filters.FolderID))
+	}
+	if len(filters.TagsAll) > 0 {
+		where = append(where, "d.tag_ids @> "+b.arg(pq.Array(uuidStrings(filters.TagsAll)))+"::uuid[]")
+	}
+	for _, set := range filters.TagSets {
+		where = append(where, "d.tag_ids && "+b.arg(pq.Array(uuidStrings(set)))+"::uuid[]")
+	}
+	if len(filters.TagsAny) > 0 {
+		where = append(where, "d.tag_ids && "+b.arg(pq.Array(uuidStrings(filters.TagsAny)))+"::uuid[]")
+	}
+	if len(filters.TagsNone) > 0 {
+		where = append(where, "NOT d.tag_ids && "+b.arg(pq.Array(uuidStrings(filters.TagsNone)))+"::uuid[]")
+	}
+	if len(filters.MimeTypes) > 0 {
+		where = append(where, "d.mime_type = ANY("+b.arg(pq.Array(filters.MimeTypes))+")")
+	}
+	if len(filters.SenderIDs) > 0 {
+		where = append(where, "d.sender_id = ANY("+b.arg(pq.Array(uuidStrings(filters.SenderIDs)))+"::uuid[])")
+	}
+	if len(filters.CreatedBy) > 0 {
+		where = append(where, "d.created_by = ANY("+b.arg(pq.Array(uuidStrings(filters.CreatedBy)))+"::uuid[])")
+	}
+	if filters.CreatedFrom != nil {
+		where = append(where, "d.created_at >= "+b.arg(*filters.CreatedFrom))
+	}
+	if filters.CreatedTo != nil {
+		where = append(where, "d.created_at <= "+b.arg(*filters.CreatedTo))
+	}
+	if filters.UpdatedFrom != nil {
+		where = append(where, "d.updated_at >= "+b.arg(*filters.UpdatedFrom))
+	}
+	if filters.UpdatedTo != nil {
+		where = append(where, "d.updated_at <= "+b.arg(*filters.UpdatedTo))
+	}
+	if filters.SizeMin != nil {
+		where = append(where, "d.file_size >= "+b.arg(*filters.SizeMin))
+	}
+	if filters.SizeMax != nil {
+		where = append(where, "d.file_size <= "+b.arg(*filters.SizeMax))
+	}
+	if len(filters.TypeIDs) > 0 {
+		where = append(where, "d.type_id = ANY("+b.arg(pq.Array(uuidStrings(filters.TypeIDs)))+"::uuid[])")
+	}
+	for _, field := range filters.Fields {
+		where = append(where, b.fieldFilter(field)...)
+	}
+
+	return where
+}
+
+// fieldFilter переводит условие по значению поля типа документа в условия WHERE
+func (b *queryBuilder) fieldFilter(field types.FieldFilter) []string {
+	value := "d.fields->" + b.arg(field.Key)
+	where := []string{value + " IS NOT NULL"}
+	if len(field.Values) > 0 {
+		where = append(where, "("+value+"->>'keyword') = ANY("+b.arg(pq.Array(field.Values))+")")
+	}
+	if field.Min != nil {
+		where = append(where, "("+value+"->>'number')::float8 >= "+b.arg(*field.Min))
+	}
+	if field.Max != nil {
+		where = append(where, "("+value+"->>'number')::float8 <= "+b.arg(*field.Max))
+	}
+	if field.From != nil {
+		where = append(where, "("+value+"->>'date')::timestamptz >= "+b.arg(*field.From))
+	}
+	if field.To != nil {
+		where = append(where, "("+value+"->>'date')::timestamptz <= "+b.arg(*field.To))
+	}
+	return where
+}
+
+// build строит условие для узла, negated - узел находится под NOT
+func (b *queryBuilder) build(node search.Node, negated bool) (string, error) {
+	switch n := node.(type) {
+	case *search.Bool:
+		if len(n.Children) == 0 {
+			return "TRUE", nil
+		}
+		clauses := make([]string, 0, len(n.Children))
+		for _, child := range n.Children {
+			clause, err := b.build(child, negated)
+			if err != nil {
+				return "", err
+			}
+			clauses = append(clauses, clause)
+		}
+		operator := " AND "
+		if n.Or {
+			operator = " OR "
+		}
+		return "(" + strings.Join(clauses, operator) + ")", nil
+
+	case *search.Not:
+		clause, err := b.build(n.Child, !negated)
+		if err != nil {
+			return "", err
+		}
+		// Сравнение с NULL, например у документа без контрагента, не должно исключать документ
+		return "NOT COALESCE(" + clause + ", FALSE)", nil
+
+	case *search.Range:
+		if !rangeColumns[n.Field] {
+			return "", fmt.Errorf("unsupported range field %q", n.Field)
+		}
+		clauses := []string{"TRUE"}
+		if n.Gte != nil {
+			clauses = append(clauses, "d."+n.Field+" >= "+b.arg(n.Gte))
+		}
+		if n.Lt != nil {
+			clauses = append(clauses, "d."+n.Field+" < "+b.arg(n.Lt))
+		}
+		return "(" + strings.Join(clauses, " AND ") + ")", nil
+
+	case *search.Terms:
+		return b.buildTerms(n)
+
+	case *search.Text:
+		return b.buildText(n, negated)
+	}
+
+	return "", fmt.Errorf("unsupported query node %T", node)
+}
+
+// buildTerms строит условие по ID тегов и контрагентов или MIME типу
+func (b *queryBuilder) buildTerms(terms *search.Terms) (string, error) {
+	if len(terms.Values) == 0 {
+		return "FALSE", nil
+	}
+
+	switch terms.Field {
+	case search.FieldTagIDs:
+		return "d.tag_ids && " + b.arg(pq.Array(terms.Values)) + "::uuid[]", nil
+	case search.FieldSenderID:
+		return "d.sender_id = ANY(" + b.arg(pq.Array(terms.Values)) + "::uuid[])", nil
+	case search.FieldMimeType:
+		if terms.Wildcard {
+			clauses := make([]string, 0, len(terms.Values))
+			for _, value := range terms.Values {
+				clauses = append(clauses, "d.mime_type LIKE "+b.arg(likePattern(value)))
+			}
+			return "(" + strings.Join(clauses, " OR ") + ")", nil
+		}
+		return "d.mime_type = ANY(" + b.arg(pq.Array(terms.Values)) + ")", nil
+	}
+
+	return "", fmt.Errorf("unsupported terms field %q", terms.Field)
+}
+
+// buildText строит условие по словам названия и текста
+func (b *queryBuilder) buildText(text *search.Text, negated bool) (string, error) {
+	var columns []string
+	switch text.Field {
+	case "":
+		columns = []string{"name", "text"}
+	case search.FieldName, search.FieldText:
+		columns = []string{text.Field}
+	default:
+		return "", fmt.Errorf("unsupported text field %q", text.Field)
+	}
+
+	// Шаблон проверяется регулярным выражением по границам слов
+	if text.Wildcard {
+		pattern := b.arg(wildcardRegexp(text.Value))
+		clauses := make([]string, 0, len(columns))
+		for _, column := range columns {
+			clauses = append(clauses, "d."+column+" ~* "+pattern)
+		}
+		return "(" + strings.Join(clauses, " OR ") + ")", nil
+	}
+
+	// Нечеткий поиск не поддерживается, слово с ~ ищется как обычное.
+	// Расстояние между словами фразы не учитывается, фраза ищется точно
+	function := "plainto_tsquery"
+	if text.Phrase {
+		function = "phraseto_tsquery"
+	}
+	value := b.arg(text.Value)
+	tsquery := fmt.Sprintf("(%[1]s('russian', %[2]s) || %[1]s('english', %[2]s))", function, value)
+	if !negated {
+		b.highlight = append(b.highlight, tsquery)
+	}
+
+	clauses := make([]string, 0, len(columns))
+	for _, column := range columns {
+		clauses = append(clauses, "d."+column+"_tsv @@ "+tsquery)
+	}
+	return "(" + strings.Join(clauses, " OR ") + ")", nil
+}
+
+// wildcardRegexp переводит шаблон с * и ? в регулярное выражение для целого слова
+func wildcardRegexp(pattern string) string {
+	var sb strings.Builder
+	sb.WriteString(`\m`)
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			sb.WriteString(`\w*`)
+		case '?':
+			sb.WriteString(`\w`)
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(`\M`)
+	return sb.String()
+}
+
+// likePattern переводит шаблон с * и ? в шаблон LIKE
+func likePattern(pattern string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`, `*`, `%`, `?`, `_`)
+	return replacer.Replace(pattern)
+}
@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+
+	"techmind/internal/search"
+
+	"github.com/lib/pq"
+)
+
+func TestQueryBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name      string
+		node      search.Node
+		want      string
+		args      []interface{}
+		highlight int
+	}{
+		{
+			name:      "слово ищется по названию и тексту",
+			node:      &search.Text{Value: "акт сверки"},
+			want:      "(d.name_tsv @@ (plainto_tsquery('russian', $1) || plainto_tsquery('english', $1)) OR d.text_tsv @@ (plainto_tsquery('russian', $1) || plainto_tsquery('english', $1)))",
+			args:      []interface{}{"акт сверки"},
+			highlight: 1,
+		},
+		{
+			name:      "фраза по тексту",
+			node:      &search.Text{Field: search.FieldText, Value: "договор поставки", Phrase: true, Slop: 3},
+			want:      "(d.text_tsv @@ (phraseto_tsquery('russian', $1) || phraseto_tsquery('english', $1)))",
+			args:      []interface{}{"договор поставки"},
+			highlight: 1,
+		},
+		{
+			name: "шаблон по названию",
+			node: &search.Text{Field: search.FieldName, Value: "акт*.pdf", Wildcard: true},
+			want: "(d.name ~* $1)",
+			args: []interface{}{`\mакт\w*\.pdf\M`},
+		},
+		{
+			name: "исключаемое слово не подсвечивается",
+			node: &search.Not{Child: &search.Text{Field: search.FieldName, Value: "черновик"}},
+			want: "NOT COALESCE((d.name_tsv @@ (plainto_tsquery('russian', $1) || plainto_tsquery('english', $1))), FALSE)",
+			args: []interface{}{"черновик"},
+		},
+		{
+			name: "OR тегов и контрагентов",
+			node: &search.Bool{Or: true, Children: []search.Node{
+				&search.Terms{Field: search.FieldTagIDs, Values: []string{"t1", "t2"}},
+				&search.Terms{Field: search.FieldSenderID, Values: []string{"s1"}},
+			}},
+			want: "(d.tag_ids && $1::uuid[] OR d.sender_id = ANY($2::uuid[]))",
+			args: []interface{}{pq.Array([]string{"t1", "t2"}), pq.Array([]string{"s1"})},
+		},
+		{
+			name: "AND типа по шаблону и диапазона",
+			node: &search.Bool{Children: []search.Node{
+				&search.Terms{Field: search.FieldMimeType, Values: []string{"image/*"}, Wildcard: true},
+				&search.Range{Field: search.FieldCreatedAt, Gte: "2025-03-01T00:00:00Z", Lt: "2025-04-01T00:00:00Z"},
+				&search.Range{Field: search.FieldFileSize, Lt: int64(1024)},
+			}},
+			want: "((d.mime_type LIKE $1) AND (TRUE AND d.created_at >= $2 AND d.created_at < $3) AND (TRUE AND d.file_size < $4))",
+			args: []interface{}{"image/%", "2025-03-01T00:00:00Z", "2025-04-01T00:00:00Z", int64(1024)},
+		},
+		{
+			name: "пустой список ничего не находит",
+			node: &search.Terms{Field: search.FieldTagIDs},
+			want: "FALSE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &queryBuilder{}
+			got, err := b.build(tt.node, false)
+			if err != nil {
+				t.Fatalf("build error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("build = %s\nwant    %s", got, tt.want)
+			}
+			if !reflect.DeepEqual(b.args, tt.args) {
+				t.Fatalf("args = %#v, want %#v", b.args, tt.args)
+			}
+			if len(b.highlight) != tt.highlight {
+				t.Fatalf("highlight has %d queries, want %d", len(b.highlight), tt.highlight)
+			}
+		})
+	}
+}
+
+func TestQueryBuilder_BuildUnsupported(t *testing.T) {
+	nodes := []search.Node{
+		&search.Range{Field: "name"},
+		&search.Terms{Field: "name", Values: []string{"акт"}},
+		&search.Text{Field: "sender_id", Value: "акт"},
+	}
+	for _, node := range nodes {
+		if _, err := (&queryBuilder{}).build(node, false); err == nil {
+			t.Fatalf("build(%#v) succeeded, want error", node)
+		}
+	}
+}
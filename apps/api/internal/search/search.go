@@ -0,0 +1,248 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// Поисковые движки, выбираются параметром search.backend конфигурации
+const (
+	BackendElasticsearch = "elasticsearch"
+	BackendPostgres      = "postgres"
+)
+
+// ErrNotIndexed - документа еще нет в индексе, частичное обновление невозможно
+var ErrNotIndexed = errors.New("document is not indexed")
+
+// SearchIndex - поисковый индекс документов.
+// Индекс хранит копию атрибутов и текста документа, источник истины - БД
+type SearchIndex interface {
+	// Index добавляет документ в индекс или полностью заменяет его
+	Index(ctx context.Context, document *Document) error
+
+	// Update обновляет атрибуты документа, не трогая текст
+	// Возвращает ErrNotIndexed, если документа нет в индексе
+	Update(ctx context.Context, attributes *Attributes) error
+
+	// Delete удаляет документ из индекса, отсутствие документа ошибкой не считается
+	Delete(ctx context.Context, documentID uuid.UUID) error
+
+	// Query ищет документы компании
+	Query(ctx context.Context, query *Query) (*Result, error)
+
+	// Suggest ищет документы, слова названия которых начинаются с введенных слов
+	Suggest(ctx context.Context, companyID uuid.UUID, prefix string, limit int) ([]service.DocumentSuggestion, error)
+
+	// DidYouMean предлагает исправление опечаток в слове по документам компании,
+	// пустая строка - исправления нет
+	DidYouMean(ctx context.Context, companyID uuid.UUID, text string) (string, error)
+
+	// Count возвращает число документов в индексе
+	Count(ctx context.Context) (int64, error)
+}
+
+// Attributes - поля документа в индексе, не зависящие от текста
+type Attributes struct {
+	ID        uuid.UUID
+	CompanyID uuid.UUID
+	FolderID  *uuid.UUID
+	SenderID  *uuid.UUID
+	CreatedBy *uuid.UUID
+	Name      string
+	MimeType  string
+	FileSize  int64
+	TagIDs    []uuid.UUID
+	TypeID    *uuid.UUID
+	Fields    []FieldValue
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// FieldValue - значение поля типа документа в индексе, заполнена одна из частей:
+// Keyword для строк, вариантов перечислений и контрагентов, Number для чисел и сумм, Date для дат
+type FieldValue struct {
+	Key     string
+	Keyword *string
+	Number  *float64
+	Date    *time.Time
+}
+
+// NewFieldValues переводит значения полей документа в значения индекса, упорядоченные по ключу
+func NewFieldValues(values map[string]types.FieldValue) []FieldValue {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fields := make([]FieldValue, 0, len(values))
+	for _, key := range keys {
+		value := values[key]
+		field := FieldValue{Key: key, Number: value.Number, Date: value.Date}
+		switch {
+		case value.SenderID != nil:
+			id := value.SenderID.String()
+			field.Keyword = &id
+		case value.String != nil:
+			field.Keyword = value.String
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// Page - текст страницы документа
+type Page struct {
+	Number int
+	Text   string
+}
+
+// Chunk - вектор фрагмента текста для семантического поиска
+type Chunk struct {
+	Page   int
+	Vector []float32
+}
+
+// Document - документ индекса с текстом
+type Document struct {
+	Attributes
+	Text  string
+	Pages []Page
+	// Chunks - векторы фрагментов текста, пусто без семантического поиска
+	Chunks []Chunk
+}
+
+// NewAttributes собирает атрибуты индекса из записи документа и его тегов
+func NewAttributes(document *ent.Document, tagIDs []uuid.UUID) *Attributes {
+	return &Attributes{
+		ID:        document.ID,
+		CompanyID: document.CompanyID,
+		FolderID:  document.FolderID,
+		SenderID:  document.SenderID,
+		CreatedBy: document.CreatedBy,
+		Name:      document.Name,
+		MimeType:  document.MimeType,
+		FileSize:  document.FileSize,
+		TagIDs:    tagIDs,
+		TypeID:    document.TypeID,
+		Fields:    NewFieldValues(document.FieldValues),
+		CreatedAt: document.CreatedAt,
+		UpdatedAt: document.UpdatedAt,
+	}
+}
+
+// NewDocument собирает документ индекса из записи документа, сохраненного текста и тегов
+// content может быть nil: документы без текста индексируются для фильтров и фасетов
+func NewDocument(document *ent.Document, content *ent.DocumentContent, tagIDs []uuid.UUID) *Document {
+	doc := &Document{
+		Attributes: *NewAttributes(document, tagIDs),
+		Pages:      make([]Page, 0),
+	}
+	if content != nil {
+		doc.Text = content.Text
+		for _, page := range content.Pages {
+			doc.Pages = append(doc.Pages, Page{
+				Number: page.Number,
+				Text:   content.Text[page.Start:page.End],
+			})
+		}
+	}
+	return doc
+}
+
+// Filters - структурированные фильтры поиска, границы диапазонов включаются
+type Filters struct {
+	FolderID *uuid.UUID
+	// TagsAll - все теги, TagsAny - хотя бы один, TagsNone - ни одного
+	TagsAll  []uuid.UUID
+	TagsAny  []uuid.UUID
+	TagsNone []uuid.UUID
+	// TagSets - документ должен иметь хотя бы один тег из каждого набора,
+	// так задается условие "все" по тегам вместе с их дочерними тегами
+	TagSets [][]uuid.UUID
+	// MimeTypes, SenderIDs и CreatedBy - документ подходит, если совпадает любое из значений
+	MimeTypes   []string
+	SenderIDs   []uuid.UUID
+	CreatedBy   []uuid.UUID
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	SizeMin     *int64
+	SizeMax     *int64
+	// TypeIDs - документ подходит, если его тип совпадает с любым из значений
+	TypeIDs []uuid.UUID
+	// Fields - условия по значениям полей, документ должен подходить под все
+	Fields []types.FieldFilter
+}
+
+// FieldSort - сортировка по значению поля типа документа, Value - часть значения:
+// FieldSortKeyword, FieldSortNumber или FieldSortDate
+type FieldSort struct {
+	Key   string
+	Value string
+	Desc  bool
+}
+
+// Части значения поля для сортировки
+const (
+	FieldSortKeyword = "keyword"
+	FieldSortNumber  = "number"
+	FieldSortDate    = "date"
+)
+
+// Query - поисковый запрос к индексу
+type Query struct {
+	CompanyID uuid.UUID
+	Filters   Filters
+	// Condition - обязательное условие из текстового запроса, nil - все документы
+	Condition Node
+	// Vector - вектор запроса для поиска по смыслу, документ должен быть близок к нему
+	Vector []float32
+	// Text - свободный текст гибридного поиска: документ подходит по Text или по Vector,
+	// близость векторов учитывается с весом VectorWeight
+	Text         Node
+	VectorWeight float64
+	// Sort - порядок выдачи, одно из значений service.SearchSort*
+	Sort string
+	// SortField - поле, по которому упорядочена выдача при Sort = service.SearchSortField
+	SortField *FieldSort
+	// Offset - смещение от начала выдачи, After - значения Hit.Sort последнего документа
+	// предыдущей страницы; при заданном After смещение не используется
+	Offset int
+	After  []interface{}
+	Size   int
+}
+
+// Hit - найденный документ
+type Hit struct {
+	DocumentID uuid.UUID
+	Score      *float64
+	// Highlights - фрагменты с подсветкой по полям name и text
+	Highlights   map[string][]string
+	MatchedPages []service.PageMatch
+	// Sort - положение документа в выдаче для Query.After
+	Sort []interface{}
+}
+
+// Bucket - значение фасета и число документов с ним
+type Bucket struct {
+	Key   string
+	Count int64
+}
+
+// Result - страница совпадений и фасеты по всем найденным документам
+type Result struct {
+	Hits  []Hit
+	Total int64
+	// Facets - распределение по фасетам service.Facet*
+	Facets map[string][]Bucket
+}
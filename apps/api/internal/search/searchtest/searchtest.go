@@ -0,0 +1,425 @@
+// Package searchtest содержит общий набор проверок для реализаций search.SearchIndex.
+// Каждый движок запускает его в своем тесте, так поведение поиска не зависит от выбранного движка
+package searchtest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+
+	"github.com/google/uuid"
+)
+
+// fixture - документы одной компании, на которых проверяется поиск
+type fixture struct {
+	companyID uuid.UUID
+	folderID  uuid.UUID
+	tagA      uuid.UUID
+	tagB      uuid.UUID
+	senderID  uuid.UUID
+	base      time.Time
+
+	contract *search.Document
+	invoice  *search.Document
+	act      *search.Document
+	report   *search.Document
+	// foreign - документ другой компании, не должен попадать в выдачу
+	foreign *search.Document
+}
+
+// Run проверяет поведение, общее для всех поисковых движков
+// Индекс может содержать другие документы: набор использует новую компанию при каждом запуске
+func Run(t *testing.T, index search.SearchIndex) {
+	ctx := context.Background()
+	f := newFixture()
+
+	for _, doc := range []*search.Document{f.contract, f.invoice, f.act, f.report, f.foreign} {
+		if err := index.Index(ctx, doc); err != nil {
+			t.Fatalf("index %s: %v", doc.Name, err)
+		}
+	}
+	t.Cleanup(func() {
+		for _, doc := range []*search.Document{f.contract, f.invoice, f.act, f.report, f.foreign} {
+			_ = index.Delete(context.Background(), doc.ID)
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		// Слово ищется с учетом морфологии и только в документах компании
+		f.expect(t, index, f.query(&search.Text{Value: "договоры"}), f.contract)
+		f.expect(t, index, f.query(&search.Text{Value: "неустойка"}), f.contract)
+		f.expect(t, index, f.query(&search.Text{Value: "shareholders"}), f.report)
+		f.expect(t, index, f.query(&search.Text{Value: "несуществующее"}))
+	})
+
+	t.Run("words", func(t *testing.T) {
+		// Несколько слов в одном условии ищутся вместе: документ должен содержать каждое
+		f.expect(t, index, f.query(&search.Text{Value: "оплата поставки"}), f.contract)
+		f.expect(t, index, f.query(&search.Text{Value: "счет сверки"}))
+	})
+
+	t.Run("fields", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Text{Field: search.FieldName, Value: "сверки"}), f.act)
+		f.expect(t, index, f.query(&search.Text{Field: search.FieldText, Value: "оборудования"}), f.contract)
+		f.expect(t, index, f.query(&search.Text{Field: search.FieldName, Value: "оборудования"}))
+	})
+
+	t.Run("phrase", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Text{Value: "поставки оборудования", Phrase: true}), f.contract)
+		f.expect(t, index, f.query(&search.Text{Value: "оборудования поставки", Phrase: true}))
+	})
+
+	t.Run("wildcard", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Text{Value: "сверк*", Wildcard: true}), f.act)
+		f.expect(t, index, f.query(&search.Terms{
+			Field: search.FieldMimeType, Values: []string{"application/*"}, Wildcard: true,
+		}), f.contract, f.invoice, f.act)
+	})
+
+	t.Run("boolean", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Bool{Or: true, Children: []search.Node{
+			&search.Text{Value: "счет"},
+			&search.Text{Value: "акт"},
+		}}), f.invoice, f.act)
+		f.expect(t, index, f.query(&search.Bool{Children: []search.Node{
+			&search.Terms{Field: search.FieldTagIDs, Values: []string{f.tagA.String()}},
+			&search.Not{Child: &search.Text{Value: "счет"}},
+		}}), f.contract)
+	})
+
+	t.Run("terms", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Terms{Field: search.FieldTagIDs, Values: []string{f.tagB.String()}}), f.invoice, f.act)
+		f.expect(t, index, f.query(&search.Terms{Field: search.FieldSenderID, Values: []string{f.senderID.String()}}), f.invoice)
+		f.expect(t, index, f.query(&search.Terms{Field: search.FieldMimeType, Values: []string{"text/plain"}}), f.report)
+		// Пустой список - например, тег с таким названием не найден
+		f.expect(t, index, f.query(&search.Terms{Field: search.FieldTagIDs}))
+		// Документы без контрагента не совпадают с условием и поэтому не исключаются
+		f.expect(t, index, f.query(&search.Not{Child: &search.Terms{
+			Field: search.FieldSenderID, Values: []string{f.senderID.String()},
+		}}), f.contract, f.act, f.report)
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		f.expect(t, index, f.query(&search.Range{
+			Field: search.FieldCreatedAt, Gte: f.base.AddDate(0, 0, 15).Format(time.RFC3339),
+		}), f.invoice, f.act)
+		f.expect(t, index, f.query(&search.Range{Field: search.FieldFileSize, Lt: int64(3000)}), f.contract, f.report)
+		f.expect(t, index, f.query(&search.Range{Field: search.FieldFileSize, Gte: int64(1000), Lt: int64(3001)}), f.contract, f.act)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		from, to := f.base.AddDate(0, 0, -1), f.base.AddDate(0, 1, 1)
+		sizeMin, sizeMax := int64(1000), int64(3000)
+		for _, tc := range []struct {
+			name    string
+			filters search.Filters
+			want    []*search.Document
+		}{
+			{"folder", search.Filters{FolderID: &f.folderID}, []*search.Document{f.contract}},
+			{"tags all", search.Filters{TagsAll: []uuid.UUID{f.tagA, f.tagB}}, []*search.Document{f.invoice}},
+			{"tags any", search.Filters{TagsAny: []uuid.UUID{f.tagA, f.tagB}}, []*search.Document{f.contract, f.invoice, f.act}},
+			{"tags none", search.Filters{TagsNone: []uuid.UUID{f.tagA}}, []*search.Document{f.act, f.report}},
+			{"tag sets", search.Filters{TagSets: [][]uuid.UUID{{f.tagA, uuid.New()}, {f.tagB}}}, []*search.Document{f.invoice}},
+			{"mime types", search.Filters{MimeTypes: []string{"text/plain", "application/pdf"}}, []*search.Document{f.contract, f.invoice, f.report}},
+			{"senders", search.Filters{SenderIDs: []uuid.UUID{f.senderID}}, []*search.Document{f.invoice}},
+			{"created", search.Filters{CreatedFrom: &from, CreatedTo: &to}, []*search.Document{f.contract, f.invoice}},
+			{"size", search.Filters{SizeMin: &sizeMin, SizeMax: &sizeMax}, []*search.Document{f.contract, f.act}},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				query := f.query(nil)
+				query.Filters = tc.filters
+				f.expect(t, index, query, tc.want...)
+			})
+		}
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		query := f.query(nil)
+		query.Sort = service.SearchSortCreatedAsc
+		f.expectOrder(t, index, query, f.report, f.contract, f.invoice, f.act)
+
+		query.Sort = service.SearchSortSizeDesc
+		f.expectOrder(t, index, query, f.invoice, f.act, f.contract, f.report)
+
+		query = f.query(&search.Text{Value: "поставки"})
+		query.Sort = service.SearchSortRelevance
+		result := f.run(t, index, query)
+		for _, hit := range result.Hits {
+			if hit.Score == nil {
+				t.Fatalf("hit %s has no score", hit.DocumentID)
+			}
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		query := f.query(nil)
+		query.Sort = service.SearchSortCreatedAsc
+		query.Size = 2
+
+		first := f.run(t, index, query)
+		if first.Total != 4 || len(first.Hits) != 2 {
+			t.Fatalf("first page: total %d, %d hits, want 4 and 2", first.Total, len(first.Hits))
+		}
+
+		// Следующая страница по курсору совпадает со страницей по смещению
+		query.After = first.Hits[len(first.Hits)-1].Sort
+		byCursor := f.run(t, index, query)
+		query.After = nil
+		query.Offset = 2
+		byOffset := f.run(t, index, query)
+
+		want := []uuid.UUID{f.invoice.ID, f.act.ID}
+		assertIDs(t, "page by cursor", hitIDs(byCursor), want)
+		assertIDs(t, "page by offset", hitIDs(byOffset), want)
+	})
+
+	t.Run("facets", func(t *testing.T) {
+		result := f.run(t, index, f.query(nil))
+		assertBuckets(t, result.Facets[service.FacetTags], map[string]int64{
+			f.tagA.String(): 2,
+			f.tagB.String(): 2,
+		})
+		assertBuckets(t, result.Facets[service.FacetSenders], map[string]int64{
+			f.senderID.String(): 1,
+		})
+		assertBuckets(t, result.Facets[service.FacetMimeTypes], map[string]int64{
+			"application/pdf": 2,
+			"application/vnd.openxmlformats-officedocument.wordprocessingml.document": 1,
+			"text/plain": 1,
+		})
+		assertBuckets(t, result.Facets[service.FacetMonths], map[string]int64{
+			"2025-02": 1, "2025-03": 1, "2025-04": 1, "2025-05": 1,
+		})
+	})
+
+	t.Run("highlights", func(t *testing.T) {
+		result := f.run(t, index, f.query(&search.Text{Value: "неустойка"}))
+		if len(result.Hits) != 1 {
+			t.Fatalf("got %d hits, want 1", len(result.Hits))
+		}
+		hit := result.Hits[0]
+		if len(hit.MatchedPages) != 1 || hit.MatchedPages[0].Number != 2 {
+			t.Fatalf("matched pages %+v, want page 2", hit.MatchedPages)
+		}
+		if !containsHighlight(hit.Highlights["text"]) {
+			t.Fatalf("text highlights %q have no <em>", hit.Highlights["text"])
+		}
+
+		result = f.run(t, index, f.query(&search.Text{Value: "сверки"}))
+		if len(result.Hits) != 1 || !containsHighlight(result.Hits[0].Highlights["name"]) {
+			t.Fatalf("name is not highlighted: %+v", result.Hits)
+		}
+	})
+
+	t.Run("suggest", func(t *testing.T) {
+		suggestions, err := index.Suggest(ctx, f.companyID, "догов", 5)
+		if err != nil {
+			t.Fatalf("suggest: %v", err)
+		}
+		if len(suggestions) != 1 || suggestions[0].ID != f.contract.ID {
+			t.Fatalf("suggestions %+v, want only %q", suggestions, f.contract.Name)
+		}
+		if !strings.Contains(suggestions[0].Highlight, "<em>") {
+			t.Fatalf("suggestion highlight %q has no <em>", suggestions[0].Highlight)
+		}
+	})
+
+	t.Run("count", func(t *testing.T) {
+		count, err := index.Count(ctx)
+		if err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		if count < 5 {
+			t.Fatalf("count %d, want at least 5", count)
+		}
+	})
+
+	t.Run("update", func(t *testing.T) {
+		attributes := f.invoice.Attributes
+		attributes.TagIDs = []uuid.UUID{f.tagA}
+		attributes.Name = "Счет за март"
+		if err := index.Update(ctx, &attributes); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		f.expect(t, index, f.query(&search.Terms{Field: search.FieldTagIDs, Values: []string{f.tagB.String()}}), f.act)
+		f.expect(t, index, f.query(&search.Text{Field: search.FieldName, Value: "март"}), f.invoice)
+		// Текст документа при обновлении атрибутов сохраняется
+		f.expect(t, index, f.query(&search.Text{Value: "связи"}), f.invoice)
+
+		missing := attributes
+		missing.ID = uuid.New()
+		if err := index.Update(ctx, &missing); !errors.Is(err, search.ErrNotIndexed) {
+			t.Fatalf("update of missing document: %v, want ErrNotIndexed", err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		if err := index.Delete(ctx, f.act.ID); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+		f.expect(t, index, f.query(&search.Text{Value: "сверки"}))
+
+		// Повторное удаление не считается ошибкой
+		if err := index.Delete(ctx, f.act.ID); err != nil {
+			t.Fatalf("delete of missing document: %v", err)
+		}
+	})
+}
+
+func newFixture() *fixture {
+	f := &fixture{
+		companyID: uuid.New(),
+		folderID:  uuid.New(),
+		tagA:      uuid.New(),
+		tagB:      uuid.New(),
+		senderID:  uuid.New(),
+		base:      time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+	}
+
+	f.contract = f.document("Договор поставки", "application/pdf", 1000, f.base,
+		"Договор поставки оборудования", "Неустойка за просрочку оплаты")
+	f.contract.FolderID = &f.folderID
+	f.contract.TagIDs = []uuid.UUID{f.tagA}
+
+	f.invoice = f.document("Счет на оплату", "application/pdf", 5000, f.base.AddDate(0, 1, 0),
+		"Счет на оплату услуг связи")
+	f.invoice.SenderID = &f.senderID
+	f.invoice.TagIDs = []uuid.UUID{f.tagA, f.tagB}
+
+	f.act = f.document("Акт сверки", "application/vnd.openxmlformats-officedocument.wordprocessingml.document", 3000,
+		f.base.AddDate(0, 2, 0), "Акт сверки взаимных расчетов")
+	f.act.TagIDs = []uuid.UUID{f.tagB}
+
+	f.report = f.document("Annual report", "text/plain", 200, f.base.AddDate(0, -1, 0),
+		"Financial report for shareholders")
+
+	f.foreign = f.document("Договор аренды", "application/pdf", 100, f.base, "Договор аренды помещения")
+	f.foreign.CompanyID = uuid.New()
+
+	return f
+}
+
+// document создает документ компании, каждая страница - отдельный элемент pages
+func (f *fixture) document(name, mimeType string, size int64, createdAt time.Time, pages ...string) *search.Document {
+	doc := &search.Document{
+		Attributes: search.Attributes{
+			ID:        uuid.New(),
+			CompanyID: f.companyID,
+			Name:      name,
+			MimeType:  mimeType,
+			FileSize:  size,
+			TagIDs:    []uuid.UUID{},
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		},
+		Text: strings.Join(pages, "\n"),
+	}
+	for i, text := range pages {
+		doc.Pages = append(doc.Pages, search.Page{Number: i + 1, Text: text})
+	}
+	return doc
+}
+
+// query создает запрос по документам компании с условием condition
+func (f *fixture) query(condition search.Node) *search.Query {
+	return &search.Query{
+		CompanyID: f.companyID,
+		Condition: condition,
+		Sort:      service.SearchSortCreatedDesc,
+		Size:      20,
+	}
+}
+
+func (f *fixture) run(t *testing.T, index search.SearchIndex, query *search.Query) *search.Result {
+	t.Helper()
+	result, err := index.Query(context.Background(), query)
+	if err != nil {
+		t.Fatalf("query: %v", err)
+	}
+	return result
+}
+
+// expect проверяет, что запрос находит ровно указанные документы в любом порядке
+func (f *fixture) expect(t *testing.T, index search.SearchIndex, query *search.Query, want ...*search.Document) {
+	t.Helper()
+	result := f.run(t, index, query)
+
+	got := hitIDs(result)
+	wantIDs := documentIDs(want)
+	sortIDs(got)
+	sortIDs(wantIDs)
+	assertIDs(t, "hits", got, wantIDs)
+	if result.Total != int64(len(want)) {
+		t.Fatalf("total %d, want %d", result.Total, len(want))
+	}
+}
+
+// expectOrder проверяет, что запрос находит указанные документы в указанном порядке
+func (f *fixture) expectOrder(t *testing.T, index search.SearchIndex, query *search.Query, want ...*search.Document) {
+	t.Helper()
+	assertIDs(t, "hits", hitIDs(f.run(t, index, query)), documentIDs(want))
+}
+
+func assertIDs(t *testing.T, what string, got, want []uuid.UUID) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %v, want %v", what, got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("%s: got %v, want %v", what, got, want)
+		}
+	}
+}
+
+func assertBuckets(t *testing.T, buckets []search.Bucket, want map[string]int64) {
+	t.Helper()
+	got := make(map[string]int64, len(buckets))
+	for _, bucket := range buckets {
+		got[bucket.Key] = bucket.Count
+	}
+	if len(got) != len(want) {
+		t.Fatalf("buckets %v, want %v", got, want)
+	}
+	for key, count := range want {
+		if got[key] != count {
+			t.Fatalf("buckets %v, want %v", got, want)
+		}
+	}
+}
+
+func hitIDs(result *search.Result) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		ids = append(ids, hit.DocumentID)
+	}
+	return ids
+}
+
+func documentIDs(documents []*search.Document) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(documents))
+	for _, doc := range documents {
+		ids = append(ids, doc.ID)
+	}
+	return ids
+}
+
+func sortIDs(ids []uuid.UUID) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+}
+
+func containsHighlight(fragments []string) bool {
+	for _, fragment := range fragments {
+		if strings.Contains(fragment, "<em>") {
+			return true
+		}
+	}
+	return false
+}
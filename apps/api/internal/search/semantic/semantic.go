@@ -0,0 +1,122 @@
+package semantic
+
+import (
+	"context"
+	"fmt"
+
+	"techmind/internal/search"
+	"techmind/pkg/config"
+	"techmind/pkg/embedding"
+	"techmind/schema/ent"
+)
+
+const (
+	// defaultChunkSize и defaultChunkOverlap - размер фрагмента и перекрытие в словах
+	defaultChunkSize    = 200
+	defaultChunkOverlap = 40
+	// defaultMaxChunks ограничивает число запросов к модели для очень длинных документов
+	defaultMaxChunks = 200
+	// defaultSemanticWeight - вес близости векторов в гибридном поиске
+	defaultSemanticWeight = 5.0
+)
+
+// Embedder делит текст документов на фрагменты и вычисляет их векторы для поиска по смыслу
+type Embedder struct {
+	provider     embedding.Provider
+	chunkSize    int
+	chunkOverlap int
+	maxChunks    int
+	weight       float64
+}
+
+// NewEmbedder создает Embedder, provider nil отключает семантический поиск
+func NewEmbedder(cfg *config.Config, provider embedding.Provider) *Embedder {
+	e := &Embedder{
+		provider:     provider,
+		chunkSize:    cfg.Embedding.ChunkSize,
+		chunkOverlap: cfg.Embedding.ChunkOverlap,
+		maxChunks:    cfg.Embedding.MaxChunks,
+		weight:       cfg.Embedding.SemanticWeight,
+	}
+	if e.chunkSize <= 0 {
+		e.chunkSize = defaultChunkSize
+		if e.chunkOverlap == 0 {
+			e.chunkOverlap = defaultChunkOverlap
+		}
+	}
+	if e.maxChunks <= 0 {
+		e.maxChunks = defaultMaxChunks
+	}
+	if e.weight <= 0 {
+		e.weight = defaultSemanticWeight
+	}
+	return e
+}
+
+// Enabled сообщает, настроен ли провайдер эмбеддингов
+func (e *Embedder) Enabled() bool {
+	return e != nil && e.provider != nil
+}
+
+// Weight возвращает вес близости векторов относительно BM25 в гибридном поиске
+func (e *Embedder) Weight() float64 {
+	return e.weight
+}
+
+// DocumentChunks возвращает векторы фрагментов текста документа
+// Фрагменты строятся по страницам, поэтому совпадение указывает номер страницы
+func (e *Embedder) DocumentChunks(ctx context.Context, content *ent.DocumentContent) ([]search.Chunk, error) {
+	chunks := make([]search.Chunk, 0)
+	if content == nil {
+		return chunks, nil
+	}
+
+	sections := make([]embedding.Section, 0, len(content.Pages))
+	for _, page := range content.Pages {
+		sections = append(sections, embedding.Section{
+			Number: page.Number,
+			Text:   content.Text[page.Start:page.End],
+		})
+	}
+	// Текст без разметки страниц считается одной страницей
+	if len(sections) == 0 {
+		sections = append(sections, embedding.Section{Number: 1, Text: content.Text})
+	}
+
+	parts := embedding.Split(sections, e.chunkSize, e.chunkOverlap, e.maxChunks)
+	if len(parts) == 0 {
+		return chunks, nil
+	}
+
+	texts := make([]string, 0, len(parts))
+	for _, part := range parts {
+		texts = append(texts, part.Text)
+	}
+	vectors, err := e.provider.Embed(ctx, texts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed document text: %w", err)
+	}
+	if len(vectors) != len(parts) {
+		return nil, fmt.Errorf("embedding provider returned %d vectors for %d chunks", len(vectors), len(parts))
+	}
+
+	for i, part := range parts {
+		chunks = append(chunks, search.Chunk{
+			Page:   part.Section,
+			Vector: vectors[i],
+		})
+	}
+	return chunks, nil
+}
+
+// QueryVector вычисляет вектор поискового запроса
+func (e *Embedder) QueryVector(ctx context.Context, query string) ([]float32, error) {
+	vectors, err := e.provider.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if len(vectors) != 1 {
+		return nil, fmt.Errorf("embedding provider returned %d vectors for query", len(vectors))
+	}
+	return vectors[0], nil
+}
@@ -2,10 +2,14 @@ package company
 
 import (
 	"context"
+	"fmt"
+	"text/template"
 
+	"techmind/internal/counterparty"
 	"techmind/internal/repo"
 	"techmind/internal/service"
 	"techmind/schema/ent"
+	"techmind/schema/types"
 
 	"github.com/google/uuid"
 )
@@ -40,3 +44,58 @@ func (s *CompanyService) Create(ctx context.Context, name string, userID uuid.UU
 
 	return company, nil
 }
+
+// GetSettings возвращает настройки компании
+func (s *CompanyService) GetSettings(ctx context.Context, companyID uuid.UUID) (*types.CompanySettings, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+	return &company.Settings, nil
+}
+
+// UpdateWatermarkSettings проверяет шаблоны и сохраняет настройки водяного знака
+func (s *CompanyService) UpdateWatermarkSettings(ctx context.Context, companyID uuid.UUID, watermark types.WatermarkSettings) (*types.CompanySettings, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+
+	if _, err := template.New("watermark").Parse(watermark.Template); err != nil {
+		return nil, fmt.Errorf("invalid watermark template: %w", err)
+	}
+	if _, err := template.New("stamp").Parse(watermark.StampTemplate); err != nil {
+		return nil, fmt.Errorf("invalid stamp template: %w", err)
+	}
+
+	settings := company.Settings
+	settings.Watermark = &watermark
+
+	updated, err := s.companyRepo.UpdateSettings(ctx, companyID, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update company settings: %w", err)
+	}
+	return &updated.Settings, nil
+}
+
+// UpdateRequisites проверяет ИНН и КПП и сохраняет реквизиты компании
+func (s *CompanyService) UpdateRequisites(ctx context.Context, companyID uuid.UUID, requisites types.CompanyRequisites) (*types.CompanySettings, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+
+	card := counterparty.Card{Name: company.Name, LegalName: requisites.LegalName, INN: requisites.INN, KPP: requisites.KPP}
+	if err := counterparty.Validate(card); err != nil {
+		return nil, fmt.Errorf("invalid requisites: %w", err)
+	}
+
+	settings := company.Settings
+	settings.Requisites = &requisites
+
+	updated, err := s.companyRepo.UpdateSettings(ctx, companyID, settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update company settings: %w", err)
+	}
+	return &updated.Settings, nil
+}
@@ -0,0 +1,19 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"techmind/internal/service"
+
+	"github.com/google/uuid"
+)
+
+// classify предсказывает тип документа в фоновой обработке, ошибка только логируется
+// Компании без обученной модели пропускаются молча
+func (s *documentService) classify(ctx context.Context, documentID uuid.UUID) {
+	if _, err := s.classifierService.Classify(ctx, documentID); err != nil && !errors.Is(err, service.ErrClassifierNotFound) {
+		fmt.Printf("Failed to classify document %s: %v\n", documentID, err)
+	}
+}
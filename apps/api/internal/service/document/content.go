@@ -0,0 +1,101 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"unicode"
+
+	"techmind/internal/service"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// pageSeparator разделяет страницы в сохраненном тексте документа
+const pageSeparator = "\n\n"
+
+// GetText возвращает извлеченный текст документа целиком или одной страницы (page > 0)
+func (s *documentService) GetText(ctx context.Context, documentID uuid.UUID, page int) (*service.DocumentText, error) {
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document content not found: %w", err)
+	}
+
+	result := &service.DocumentText{
+		DocumentID:    documentID,
+		Text:          content.Text,
+		PagesCount:    len(content.Pages),
+		Language:      content.Language,
+		Engine:        content.Engine,
+		EngineVersion: content.EngineVersion,
+	}
+
+	if page > 0 {
+		if page > len(content.Pages) {
+			return nil, fmt.Errorf("page %d is out of range, document has %d pages", page, len(content.Pages))
+		}
+		offset := content.Pages[page-1]
+		result.Page = page
+		result.Text = content.Text[offset.Start:offset.End]
+	}
+
+	return result, nil
+}
+
+// joinPages склеивает очищенный текст страниц и вычисляет границы каждой страницы
+func joinPages(pages []string) (string, []types.PageOffset) {
+	var builder strings.Builder
+	offsets := make([]types.PageOffset, 0, len(pages))
+	for i, page := range pages {
+		if i > 0 {
+			builder.WriteString(pageSeparator)
+		}
+		start := builder.Len()
+		builder.WriteString(strings.TrimSpace(page))
+		offsets = append(offsets, types.PageOffset{Number: i + 1, Start: start, End: builder.Len()})
+	}
+
+	text := builder.String()
+	if strings.TrimSpace(text) == "" {
+		return "", nil
+	}
+	return text, offsets
+}
+
+// detectLanguage определяет основной язык текста по доле кириллических и латинских букв
+// Возвращает "ru", "en" или пустую строку, если букв слишком мало
+func detectLanguage(text string) string {
+	var cyrillic, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+
+	if cyrillic+latin < 20 {
+		return ""
+	}
+	if cyrillic >= latin {
+		return "ru"
+	}
+	return "en"
+}
+
+// moduleVersion возвращает версию зависимости из информации о сборке
+func moduleVersion(path string) string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == path {
+			return dep.Version
+		}
+	}
+	return ""
+}
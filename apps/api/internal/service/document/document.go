@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
-	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -12,13 +11,15 @@ import (
 	"time"
 
 	"techmind/internal/repo"
+	"techmind/internal/search"
+	"techmind/internal/search/semantic"
 	"techmind/internal/service"
+	"techmind/pkg/config"
 	"techmind/pkg/gotenberg"
+	"techmind/pkg/ocr"
 	"techmind/schema/ent"
 
 	"code.sajari.com/docconv/v2/client"
-	"github.com/elastic/go-elasticsearch/v8"
-	"github.com/elastic/go-elasticsearch/v8/esapi"
 	"github.com/google/uuid"
 	"github.com/minio/minio-go/v7"
 )
@@ -99,32 +100,68 @@ type documentService struct {
 	documentRepo        repo.DocumentRepository
 	documentTagRepo     repo.DocumentTagRepository
 	tagRepo             repo.TagRepository
+	senderRepo          repo.SenderRepository
+	documentTypeRepo    repo.DocumentTypeRepository
 	folderRepo          repo.FolderRepository
+	documentContentRepo repo.DocumentContentRepository
+	userRepo            repo.UserRepository
+	companyRepo         repo.CompanyRepository
 	minioClient         *minio.Client
 	bucketName          string
 	gotenbergClient     *gotenberg.Client
-	elasticsearchClient *elasticsearch.Client
+	index               search.SearchIndex
+	analyticsService    service.SearchAnalyticsService
+	tagRuleService      service.TagRuleService
+	senderService       service.SenderService
+	classifierService   service.ClassifierService
+	embedder            *semantic.Embedder
+	ocrEngine           ocr.Engine
+	searchablePDF       bool
 }
 
 func NewService(
 	documentRepo repo.DocumentRepository,
 	documentTagRepo repo.DocumentTagRepository,
 	tagRepo repo.TagRepository,
+	senderRepo repo.SenderRepository,
+	documentTypeRepo repo.DocumentTypeRepository,
 	folderRepo repo.FolderRepository,
+	documentContentRepo repo.DocumentContentRepository,
+	userRepo repo.UserRepository,
+	companyRepo repo.CompanyRepository,
 	minioClient *minio.Client,
 	gotenbergClient *gotenberg.Client,
-	elasticsearchClient *elasticsearch.Client,
+	index search.SearchIndex,
+	analyticsService service.SearchAnalyticsService,
+	tagRuleService service.TagRuleService,
+	senderService service.SenderService,
+	classifierService service.ClassifierService,
+	embedder *semantic.Embedder,
+	ocrEngine ocr.Engine,
+	cfg *config.Config,
 ) service.DocumentService {
 
 	return &documentService{
 		documentRepo:        documentRepo,
 		documentTagRepo:     documentTagRepo,
 		tagRepo:             tagRepo,
+		senderRepo:          senderRepo,
+		documentTypeRepo:    documentTypeRepo,
 		folderRepo:          folderRepo,
+		documentContentRepo: documentContentRepo,
+		userRepo:            userRepo,
+		companyRepo:         companyRepo,
 		minioClient:         minioClient,
 		bucketName:          "documents",
 		gotenbergClient:     gotenbergClient,
-		elasticsearchClient: elasticsearchClient,
+		index:               index,
+		analyticsService:    analyticsService,
+		tagRuleService:      tagRuleService,
+		senderService:       senderService,
+		classifierService:   classifierService,
+		embedder:            embedder,
+		ocrEngine:           ocrEngine,
+		searchablePDF:       cfg.OCR.SearchablePDF,
 	}
 }
 
@@ -156,6 +193,27 @@ func (s *documentService) Upload(ctx context.Context, input service.DocumentUplo
 		}
 	}
 
+	// Проверяем что контрагент принадлежит компании
+	if input.SenderID != nil {
+		sender, err := s.senderRepo.GetByID(ctx, *input.SenderID)
+		if err != nil {
+			return nil, fmt.Errorf("sender not found: %w", err)
+		}
+		if sender.CompanyID != input.CompanyID {
+			return nil, fmt.Errorf("sender belongs to different company")
+		}
+	}
+
+	// Проверяем тип документа и значения полей до загрузки файла
+	var fields *repo.DocumentFields
+	if input.TypeID != nil || len(input.Fields) > 0 {
+		var err error
+		fields, err = s.documentFields(ctx, input.CompanyID, input.TypeID, nil, input.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Генерируем уникальное имя файла
 	fileID := uuid.New()
 	objectName := fmt.Sprintf("%s/%s%s", input.CompanyID.String(), fileID.String(), ext)
@@ -181,12 +239,14 @@ func (s *documentService) Upload(ctx context.Context, input service.DocumentUplo
 		ctx,
 		input.CompanyID,
 		input.FolderID,
+		input.SenderID,
 		input.Name,
 		objectName,
 		input.FileSize,
 		input.MimeType,
 		checksum,
 		input.UserID,
+		fields,
 	)
 	if err != nil {
 		// Удаляем файл из MinIO если не удалось создать запись в БД
@@ -194,7 +254,7 @@ func (s *documentService) Upload(ctx context.Context, input service.DocumentUplo
 
 		// Проверяем на конфликт уникальности checksum
 		if ent.IsConstraintError(err) {
-			return nil, fmt.Errorf("document with this checksum already exists")
+			return nil, service.ErrDocumentDuplicate
 		}
 
 		return nil, fmt.Errorf("failed to create document record: %w", err)
@@ -216,20 +276,39 @@ func (s *documentService) Upload(ctx context.Context, input service.DocumentUplo
 		}()
 	}
 
-	// Извлечение текста и индексация в Elasticsearch
-	if s.isExtractableText(input.MimeType) {
-		// Запускаем извлечение текста асинхронно
-		go func() {
-			// Создаем новый контекст с таймаутом для фоновой задачи
-			extractCtx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
-			defer cancel()
+	// Чтение метаданных PDF, извлечение текста, определение контрагента и автоматическое тегирование,
+	// документ попадает в поисковый индекс через outbox уже при создании
+	go func() {
+		// Создаем новый контекст с таймаутом для фоновой задачи
+		extractCtx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
 
-			if err := s.ExtractAndIndexText(extractCtx, document.ID); err != nil {
-				// Логируем ошибку, но не прерываем процесс загрузки
-				fmt.Printf("Failed to extract and index text for document %s: %v\n", document.ID, err)
+		// Метаданные читаются до определения контрагента, которое ищет его и по автору, названию и теме PDF
+		if strings.EqualFold(input.MimeType, "application/pdf") {
+			metadataCtx, cancelMetadata := context.WithTimeout(extractCtx, 2*time.Minute)
+			if err := s.ReadPDFMetadata(metadataCtx, document.ID, input.MapKeywordsToTags); err != nil {
+				fmt.Printf("Failed to read pdf metadata for document %s: %v\n", document.ID, err)
 			}
-		}()
-	}
+			cancelMetadata()
+		}
+
+		if s.isExtractableText(input.MimeType) {
+			err := s.ExtractAndIndexText(extractCtx, document.ID)
+			if err == nil {
+				// Контрагент определен и правила тегирования применены после сохранения текста
+				return
+			}
+			// Логируем ошибку, но не прерываем процесс загрузки
+			fmt.Printf("Failed to extract and index text for document %s: %v\n", document.ID, err)
+		}
+
+		// Контрагент документа без текста ищется по метаданным, правила по контрагенту, типу,
+		// папке и названию применимы и к документам без текста
+		s.detectSender(extractCtx, document.ID)
+		if _, err := s.tagRuleService.ApplyRules(extractCtx, document.ID); err != nil {
+			fmt.Printf("Failed to auto-assign tags for document %s: %v\n", document.ID, err)
+		}
+	}()
 
 	return document, nil
 }
@@ -329,6 +408,22 @@ func (s *documentService) Update(ctx context.Context, documentID uuid.UUID, inpu
 		}
 	}
 
+	// Тип и значения полей меняются, только если они переданы
+	var fields *repo.DocumentFields
+	switch {
+	case input.ClearType:
+		fields = &repo.DocumentFields{}
+	case input.TypeID != nil || input.Fields != nil:
+		typeID := input.TypeID
+		if typeID == nil {
+			typeID = document.TypeID
+		}
+		fields, err = s.documentFields(ctx, document.CompanyID, typeID, document.FieldValues, input.Fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	updatedDocument, err := s.documentRepo.Update(
 		ctx,
 		documentID,
@@ -336,6 +431,7 @@ func (s *documentService) Update(ctx context.Context, documentID uuid.UUID, inpu
 		input.SenderID,
 		input.Name,
 		input.UserID,
+		fields,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update document: %w", err)
@@ -361,6 +457,9 @@ func (s *documentService) Delete(ctx context.Context, documentID uuid.UUID) erro
 		_ = s.minioClient.RemoveObject(ctx, s.bucketName, *document.PreviewFilePath, minio.RemoveObjectOptions{})
 	}
 
+	// Удаляем производные копии (с метаданными и т.д.)
+	s.removeRenditions(ctx, document)
+
 	// Удаляем запись из БД (каскадно удалятся связи с тегами)
 	if err := s.documentRepo.Delete(ctx, documentID); err != nil {
 		return fmt.Errorf("failed to delete document record: %w", err)
@@ -376,7 +475,11 @@ func (s *documentService) GetDownloadURL(ctx context.Context, documentID uuid.UU
 		return "", fmt.Errorf("document not found: %w", err)
 	}
 
-	// Генерируем presigned URL на 1 час
+	return s.downloadURL(ctx, document)
+}
+
+// downloadURL генерирует presigned URL файла уже загруженного документа на 1 час
+func (s *documentService) downloadURL(ctx context.Context, document *ent.Document) (string, error) {
 	url, err := s.minioClient.PresignedGetObject(ctx, s.bucketName, document.FilePath, 1*time.Hour, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to generate download url: %w", err)
@@ -392,6 +495,11 @@ func (s *documentService) GetPreviewURL(ctx context.Context, documentID uuid.UUI
 		return "", fmt.Errorf("document not found: %w", err)
 	}
 
+	return s.previewURL(ctx, document)
+}
+
+// previewURL генерирует presigned URL preview уже загруженного документа на 1 час
+func (s *documentService) previewURL(ctx context.Context, document *ent.Document) (string, error) {
 	// Если нет preview, возвращаем пустую строку
 	if document.PreviewFilePath == nil {
 		return "", nil
@@ -406,211 +514,6 @@ func (s *documentService) GetPreviewURL(ctx context.Context, documentID uuid.UUI
 	return url.String(), nil
 }
 
-func (s *documentService) Search(ctx context.Context, companyID uuid.UUID, query string, folderID *uuid.UUID, tagIDs []uuid.UUID) ([]*service.DocumentWithTags, error) {
-	var documents []*ent.Document
-	var err error
-
-	// Если есть поисковый запрос, используем ТОЛЬКО Elasticsearch для полнотекстового поиска
-	if query != "" {
-		if s.elasticsearchClient == nil {
-			return nil, fmt.Errorf("elasticsearch is not configured")
-		}
-
-		documentIDs, err := s.searchInElasticsearch(ctx, companyID, query, folderID)
-		if err != nil {
-			return nil, fmt.Errorf("elasticsearch search failed: %w", err)
-		}
-
-		// Если ничего не найдено в Elasticsearch, возвращаем пустой результат
-		if len(documentIDs) == 0 {
-			return []*service.DocumentWithTags{}, nil
-		}
-
-		// Получаем документы по ID из Elasticsearch
-		documents, err = s.getDocumentsByIDs(ctx, documentIDs)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get documents: %w", err)
-		}
-	} else {
-		// Если запрос пустой, получаем все документы компании/папки
-		if folderID != nil {
-			documents, err = s.documentRepo.ListByFolder(ctx, *folderID)
-		} else {
-			documents, err = s.documentRepo.ListByCompany(ctx, companyID)
-		}
-
-		if err != nil {
-			return nil, fmt.Errorf("failed to get documents: %w", err)
-		}
-	}
-
-	// Фильтруем по тегам если указаны
-	if len(tagIDs) > 0 {
-		var filtered []*ent.Document
-		for _, doc := range documents {
-			docTags, _ := s.getDocumentTags(ctx, doc.ID)
-			if s.hasAllTags(docTags, tagIDs) {
-				filtered = append(filtered, doc)
-			}
-		}
-		documents = filtered
-	}
-
-	// Обогащаем результаты
-	result := make([]*service.DocumentWithTags, 0, len(documents))
-	for _, doc := range documents {
-		tags, _ := s.getDocumentTags(ctx, doc.ID)
-		previewURL, _ := s.GetPreviewURL(ctx, doc.ID)
-		downloadURL, _ := s.GetDownloadURL(ctx, doc.ID)
-
-		result = append(result, &service.DocumentWithTags{
-			Document:    doc,
-			Tags:        tags,
-			PreviewURL:  previewURL,
-			DownloadURL: downloadURL,
-		})
-	}
-
-	return result, nil
-}
-
-// searchInElasticsearch выполняет полнотекстовой поиск в Elasticsearch
-func (s *documentService) searchInElasticsearch(ctx context.Context, companyID uuid.UUID, query string, folderID *uuid.UUID) ([]uuid.UUID, error) {
-	// Строим фильтры (must) - обязательные условия
-	must := []map[string]interface{}{
-		{
-			"term": map[string]interface{}{
-				"company_id": companyID.String(),
-			},
-		},
-	}
-
-	// Добавляем фильтр по папке, если указана
-	if folderID != nil {
-		must = append(must, map[string]interface{}{
-			"term": map[string]interface{}{
-				"folder_id": folderID.String(),
-			},
-		})
-	}
-
-	// Полнотекстовый поиск по имени и содержимому документа
-	should := []map[string]interface{}{
-		{
-			"match": map[string]interface{}{
-				"name": map[string]interface{}{
-					"query": query,
-					"boost": 2.0, // Повышаем релевантность совпадений в имени
-				},
-			},
-		},
-		{
-			"match": map[string]interface{}{
-				"text": map[string]interface{}{
-					"query": query,
-				},
-			},
-		},
-	}
-
-	searchQuery := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must":                 must,
-				"should":               should,
-				"minimum_should_match": 1,
-			},
-		},
-		"size": 1000, // Максимальное количество результатов
-	}
-
-	// Сериализуем запрос в JSON
-	queryJSON, err := json.Marshal(searchQuery)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal search query: %w", err)
-	}
-
-	// Логируем запрос для отладки
-	fmt.Printf("Elasticsearch query: %s\n", string(queryJSON))
-
-	// Выполняем поиск
-	res, err := s.elasticsearchClient.Search(
-		s.elasticsearchClient.Search.WithContext(ctx),
-		s.elasticsearchClient.Search.WithIndex("documents"),
-		s.elasticsearchClient.Search.WithBody(bytes.NewReader(queryJSON)),
-		s.elasticsearchClient.Search.WithTrackTotalHits(true),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("elasticsearch search request failed: %w", err)
-	}
-	defer res.Body.Close()
-
-	if res.IsError() {
-		bodyBytes, _ := io.ReadAll(res.Body)
-		return nil, fmt.Errorf("elasticsearch search error: %s", string(bodyBytes))
-	}
-
-	// Парсим результаты
-	var result map[string]interface{}
-	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
-		return nil, fmt.Errorf("failed to parse elasticsearch response: %w", err)
-	}
-
-	// Извлекаем ID документов из результатов
-	hits, ok := result["hits"].(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid elasticsearch response format")
-	}
-
-	hitsArray, ok := hits["hits"].([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("invalid elasticsearch hits format")
-	}
-
-	fmt.Printf("Elasticsearch found %d documents\n", len(hitsArray))
-
-	documentIDs := make([]uuid.UUID, 0, len(hitsArray))
-	for _, hit := range hitsArray {
-		hitMap, ok := hit.(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		source, ok := hitMap["_source"].(map[string]interface{})
-		if !ok {
-			continue
-		}
-
-		docIDStr, ok := source["document_id"].(string)
-		if !ok {
-			continue
-		}
-
-		docID, err := uuid.Parse(docIDStr)
-		if err != nil {
-			continue
-		}
-
-		documentIDs = append(documentIDs, docID)
-	}
-
-	return documentIDs, nil
-}
-
-// getDocumentsByIDs получает документы по списку ID
-func (s *documentService) getDocumentsByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Document, error) {
-	documents := make([]*ent.Document, 0, len(ids))
-	for _, id := range ids {
-		doc, err := s.documentRepo.GetByID(ctx, id)
-		if err != nil {
-			// Пропускаем документы, которые не удалось получить
-			continue
-		}
-		documents = append(documents, doc)
-	}
-	return documents, nil
-}
-
 func (s *documentService) getDocumentTags(ctx context.Context, documentID uuid.UUID) ([]*ent.Tag, error) {
 	// Получаем связи документ-тег
 	docTags, err := s.documentTagRepo.ListByDocument(ctx, documentID)
@@ -630,21 +533,6 @@ func (s *documentService) getDocumentTags(ctx context.Context, documentID uuid.U
 	return tags, nil
 }
 
-func (s *documentService) hasAllTags(docTags []*ent.Tag, tagIDs []uuid.UUID) bool {
-	tagMap := make(map[uuid.UUID]bool)
-	for _, tag := range docTags {
-		tagMap[tag.ID] = true
-	}
-
-	for _, tagID := range tagIDs {
-		if !tagMap[tagID] {
-			return false
-		}
-	}
-
-	return true
-}
-
 // GeneratePDFPreview конвертирует файл документа в PDF превью и загружает его в MinIO
 // Поддерживает конвертацию Office документов (docx, xlsx, pptx и т.д.) через Gotenberg
 // После успешной конвертации обновляет ссылку на preview в базе данных
@@ -799,9 +687,9 @@ func (s *documentService) isOfficeDocument(mimeType string) bool {
 	return false
 }
 
-// ExtractAndIndexText извлекает текст из документа и индексирует его в Elasticsearch
-// Использует docconv для извлечения текста из различных форматов документов
-// Сохраняет извлеченный текст в индекс "documents" в Elasticsearch
+// ExtractAndIndexText извлекает текст из документа, сохраняет его в БД и индексирует в Elasticsearch
+// Использует docconv для извлечения текста из различных форматов документов,
+// для сканов без текстового слоя - OCR
 func (s *documentService) ExtractAndIndexText(ctx context.Context, documentID uuid.UUID) error {
 	// Получаем документ из БД
 	document, err := s.documentRepo.GetByID(ctx, documentID)
@@ -815,70 +703,59 @@ func (s *documentService) ExtractAndIndexText(ctx context.Context, documentID uu
 	}
 
 	// Скачиваем оригинальный файл из MinIO
-	object, err := s.minioClient.GetObject(ctx, s.bucketName, document.FilePath, minio.GetObjectOptions{})
+	content, err := s.readObject(ctx, document.FilePath)
 	if err != nil {
-		return fmt.Errorf("failed to get file from minio: %w", err)
+		return err
 	}
-	defer object.Close()
 
 	// Извлекаем текст с помощью docconv
+	var pages []string
+	engine, engineVersion := "docconv", moduleVersion("code.sajari.com/docconv/v2")
 	c := client.New()
-	convRes, err := c.Convert(object, document.Name)
-	if err != nil {
-		return fmt.Errorf("failed to extract text from document: %w", err)
+	convRes, convErr := c.Convert(bytes.NewReader(content), document.Name)
+	if convErr == nil {
+		pages = ocr.SplitPages(convRes.Body)
 	}
 
 	// Очищаем текст от лишних пробелов и переносов строк
-	extractedText := strings.TrimSpace(convRes.Body)
-	if extractedText == "" {
-		return fmt.Errorf("no text extracted from document")
-	}
+	extractedText, offsets := joinPages(pages)
 
-	// Подготавливаем документ для индексации в Elasticsearch
-	// Индекс: documents
-	// ID документа: UUID документа
-	esDocument := map[string]interface{}{
-		"document_id": document.ID.String(),
-		"company_id":  document.CompanyID.String(),
-		"folder_id":   nil,
-		"name":        document.Name,
-		"text":        extractedText,
-		"mime_type":   document.MimeType,
-		"file_size":   document.FileSize,
-		"indexed_at":  time.Now().Format(time.RFC3339),
+	// Сканы не содержат текстового слоя, распознаем их через OCR
+	if extractedText == "" && s.ocrEngine != nil && ocr.IsSupported(document.MimeType) {
+		pages, err = s.recognizeText(ctx, document, content)
+		if err != nil {
+			return fmt.Errorf("failed to recognize text: %w", err)
+		}
+		extractedText, offsets = joinPages(pages)
+		engine = s.ocrEngine.Name()
+		engineVersion, _ = s.ocrEngine.Version(ctx)
 	}
 
-	if document.FolderID != nil {
-		esDocument["folder_id"] = document.FolderID.String()
+	if extractedText == "" {
+		if convErr != nil {
+			return fmt.Errorf("failed to extract text from document: %w", convErr)
+		}
+		return fmt.Errorf("no text extracted from document")
 	}
 
-	// Сериализуем в JSON
-	docJSON, err := json.Marshal(esDocument)
+	// Сохраняем текст в БД, чтобы индекс можно было перестроить без повторного извлечения
+	// Сохранение ставит документ в очередь на индексацию
+	_, err = s.documentContentRepo.Save(ctx, document.ID, extractedText, offsets, detectLanguage(extractedText), engine, engineVersion)
 	if err != nil {
-		return fmt.Errorf("failed to marshal document for elasticsearch: %w", err)
-	}
-	// Индексируем документ в Elasticsearch
-	req := esapi.IndexRequest{
-		Index:      "documents",
-		DocumentID: document.ID.String(),
-		Body:       bytes.NewReader(docJSON),
-		Refresh:    "true",
+		return fmt.Errorf("failed to save document content: %w", err)
 	}
 
-	esRes, err := req.Do(ctx, s.elasticsearchClient)
-	if err != nil {
-		return fmt.Errorf("failed to index document in elasticsearch: %w", err)
-	}
-	defer esRes.Body.Close()
+	// Контрагент определяется до правил тегирования, чтобы сработали условия по контрагенту
+	s.detectSender(ctx, document.ID)
 
-	if esRes.IsError() {
-		return fmt.Errorf("elasticsearch indexing error: %s", esRes.String())
-	}
+	// Тип предсказывается до извлечения значений, чтобы поля заполнились по шаблону предсказанного типа
+	s.classify(ctx, document.ID)
 
-	fmt.Printf("Successfully indexed document %s in Elasticsearch index 'documents'\n", document.ID)
+	// Значения полей извлекаются по шаблону типа документа
+	s.extractFields(ctx, document.ID)
 
-	// Автоматическое добавление тегов на основе содержимого документа
-	if err := s.autoAssignTags(ctx, document, extractedText); err != nil {
+	// Автоматическое добавление тегов по правилам тегирования компании
+	if _, err := s.tagRuleService.ApplyRules(ctx, document.ID); err != nil {
 		// Логируем ошибку, но не прерываем процесс индексации
 		fmt.Printf("Failed to auto-assign tags for document %s: %v\n", document.ID, err)
 	}
@@ -886,48 +763,53 @@ func (s *documentService) ExtractAndIndexText(ctx context.Context, documentID uu
 	return nil
 }
 
-// autoAssignTags автоматически присваивает теги документу на основе содержимого текста
-// Получает все теги компании и проверяет их наличие в тексте документа (case-insensitive)
-// Если название тега найдено в тексте, тег автоматически добавляется к документу
-func (s *documentService) autoAssignTags(ctx context.Context, document *ent.Document, text string) error {
-	// Получаем все теги компании
-	tags, err := s.tagRepo.ListByCompany(ctx, document.CompanyID)
+// IndexDocument индексирует документ в поисковом индексе по сохраненному в БД тексту, тегам и атрибутам
+// Не обращается к MinIO, поэтому подходит для полной перестройки индекса
+func (s *documentService) IndexDocument(ctx context.Context, documentID uuid.UUID) error {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
 	if err != nil {
-		return fmt.Errorf("failed to get company tags: %w", err)
+		return fmt.Errorf("document not found: %w", err)
 	}
 
-	if len(tags) == 0 {
-		return nil // Нет тегов для присвоения
+	// Документ без извлеченного текста индексируется только по атрибутам
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, documentID)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get document content: %w", err)
 	}
 
-	// Приводим текст к нижнему регистру для поиска без учета регистра
-	lowerText := strings.ToLower(text)
+	docTags, err := s.documentTagRepo.ListByDocument(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("failed to get document tags: %w", err)
+	}
+	tagIDs := make([]uuid.UUID, 0, len(docTags))
+	for _, dt := range docTags {
+		tagIDs = append(tagIDs, dt.TagID)
+	}
 
-	// Проверяем каждый тег
-	assignedCount := 0
-	for _, tag := range tags {
-		// Приводим название тега к нижнему регистру
-		lowerTagName := strings.ToLower(tag.Name)
+	indexDocument := search.NewDocument(document, content, tagIDs)
 
-		// Проверяем наличие тега в тексте
-		if strings.Contains(lowerText, lowerTagName) {
-			// Пытаемся добавить тег к документу
-			_, err := s.documentTagRepo.Create(ctx, document.ID, tag.ID)
-			if err != nil {
-				// Если тег уже добавлен или произошла другая ошибка, продолжаем
-				// В продакшене здесь должно быть логирование
-				fmt.Printf("Failed to assign tag '%s' to document %s: %v\n", tag.Name, document.ID, err)
-				continue
-			}
-			assignedCount++
-			fmt.Printf("Auto-assigned tag '%s' to document %s\n", tag.Name, document.ID)
+	// Без векторов документ все равно индексируется для полнотекстового поиска,
+	// а ошибка возвращается, чтобы индексация повторилась, когда модель станет доступна
+	var embedErr error
+	if s.embedder.Enabled() {
+		chunks, err := s.embedder.DocumentChunks(ctx, content)
+		if err != nil {
+			embedErr = err
+		} else {
+			indexDocument.Chunks = chunks
 		}
 	}
 
-	if assignedCount > 0 {
-		fmt.Printf("Successfully auto-assigned %d tag(s) to document %s\n", assignedCount, document.ID)
+	if err := s.index.Index(ctx, indexDocument); err != nil {
+		return err
 	}
 
+	if embedErr != nil {
+		return fmt.Errorf("document indexed without embeddings: %w", embedErr)
+	}
+
+	fmt.Printf("Successfully indexed document %s in search index\n", document.ID)
+
 	return nil
 }
 
@@ -961,7 +843,7 @@ func (s *documentService) isExtractableText(mimeType string) bool {
 		// HTML
 		"text/html",
 
-		// Images (если docconv поддерживает OCR)
+		// Images (без текстового слоя распознаются через OCR)
 		"image/jpeg",
 		"image/png",
 		"image/tiff",
@@ -0,0 +1,317 @@
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"techmind/internal/doctype"
+	"techmind/internal/extraction"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultReviewPageSize - размер страницы очереди проверки по умолчанию
+	defaultReviewPageSize = 20
+	// maxReviewPageSize - максимальный размер страницы очереди проверки
+	maxReviewPageSize = 100
+)
+
+// ExtractFields ищет значения полей в тексте документа по шаблону его типа
+// Решения пользователя по прежнему результату сохраняются: подтвержденное или отклоненное значение
+// не возвращается в очередь, пока в тексте находится то же значение
+func (s *documentService) ExtractFields(ctx context.Context, documentID uuid.UUID) (*types.FieldExtraction, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+	if document.TypeID == nil {
+		return nil, service.ErrFieldExtractionNotConfigured
+	}
+	documentType, err := s.documentTypeRepo.GetByID(ctx, *document.TypeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document type: %w", err)
+	}
+	template := documentType.Extraction
+	if len(template.Locators) == 0 {
+		return nil, service.ErrFieldExtractionNotConfigured
+	}
+	if template.ReviewThreshold == 0 {
+		template.ReviewThreshold = types.DefaultReviewThreshold
+	}
+
+	// Документ без текста получает пустой результат, чтобы было видно, что извлечение выполнялось
+	text := ""
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, documentID)
+	switch {
+	case err == nil:
+		text = content.Text
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get document content: %w", err)
+	}
+
+	matches, err := extraction.Extract(text, documentType.Fields, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract fields: %w", err)
+	}
+
+	previous := make(map[string]types.ExtractedField)
+	if fe := currentFieldExtraction(document); fe != nil {
+		for _, f := range fe.Fields {
+			previous[f.Key] = f
+		}
+	}
+
+	values := copyFieldValues(document.FieldValues)
+	applied := false
+	result := &types.FieldExtraction{
+		TypeID:      documentType.ID,
+		Fields:      make([]types.ExtractedField, 0, len(matches)),
+		ExtractedAt: time.Now(),
+	}
+	for _, m := range matches {
+		field := types.ExtractedField{
+			Key:        m.Key,
+			Value:      m.Value,
+			Text:       m.Text,
+			Confidence: m.Confidence,
+		}
+		prev, hasPrev := previous[m.Key]
+		_, hasValue := values[m.Key]
+		switch {
+		case hasPrev && (prev.Status == types.ExtractionConfirmed || prev.Status == types.ExtractionRejected) && sameValue(prev.Value, m.Value):
+			field = prev
+		case hasValue:
+			// Значение задано пользователем или записано раньше, в результате остается прежняя запись о поле
+			if !hasPrev {
+				continue
+			}
+			field = prev
+		case m.Confidence >= template.ReviewThreshold:
+			field.Status = types.ExtractionApplied
+			values[m.Key] = m.Value
+			applied = true
+		default:
+			field.Status = types.ExtractionPending
+		}
+		result.Fields = append(result.Fields, field)
+	}
+	result.Pending = countPending(result.Fields)
+
+	if applied {
+		if err := s.setFieldValues(ctx, document, values); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.saveFieldExtraction(ctx, documentID, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *documentService) GetFieldExtraction(ctx context.Context, documentID uuid.UUID) (*types.FieldExtraction, error) {
+	_, fe, err := s.fieldExtraction(ctx, documentID)
+	return fe, err
+}
+
+func (s *documentService) ConfirmExtractedField(ctx context.Context, documentID uuid.UUID, key string, value json.RawMessage) (*types.FieldExtraction, error) {
+	document, fe, err := s.fieldExtraction(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	index := extractedFieldIndex(fe, key)
+	if index < 0 {
+		return nil, service.ErrExtractedFieldNotFound
+	}
+
+	field := &fe.Fields[index]
+	if len(value) > 0 && string(value) != "null" {
+		documentType, err := s.documentTypeRepo.GetByID(ctx, fe.TypeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get document type: %w", err)
+		}
+		parsed, ok, err := doctype.ParseValue(documentType.Fields, key, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", service.ErrInvalidFieldValues, err)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: field %q: value is required", service.ErrInvalidFieldValues, key)
+		}
+		field.Value = parsed
+	}
+
+	values := copyFieldValues(document.FieldValues)
+	values[key] = field.Value
+	if err := s.setFieldValues(ctx, document, values); err != nil {
+		return nil, err
+	}
+
+	field.Status = types.ExtractionConfirmed
+	fe.Pending = countPending(fe.Fields)
+	if err := s.saveFieldExtraction(ctx, documentID, fe); err != nil {
+		return nil, err
+	}
+	return fe, nil
+}
+
+func (s *documentService) RejectExtractedField(ctx context.Context, documentID uuid.UUID, key string) (*types.FieldExtraction, error) {
+	document, fe, err := s.fieldExtraction(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	index := extractedFieldIndex(fe, key)
+	if index < 0 {
+		return nil, service.ErrExtractedFieldNotFound
+	}
+
+	field := &fe.Fields[index]
+	if current, ok := document.FieldValues[key]; ok && field.Status == types.ExtractionApplied && sameValue(current, field.Value) {
+		documentType, err := s.documentTypeRepo.GetByID(ctx, fe.TypeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get document type: %w", err)
+		}
+		if !requiredField(documentType.Fields, key) {
+			values := copyFieldValues(document.FieldValues)
+			delete(values, key)
+			if err := s.setFieldValues(ctx, document, values); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	field.Status = types.ExtractionRejected
+	fe.Pending = countPending(fe.Fields)
+	if err := s.saveFieldExtraction(ctx, documentID, fe); err != nil {
+		return nil, err
+	}
+	return fe, nil
+}
+
+func (s *documentService) GetFieldExtractionReview(ctx context.Context, companyID uuid.UUID, input service.FieldExtractionReviewInput) (*service.FieldExtractionReview, error) {
+	page, pageSize := input.Page, input.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultReviewPageSize
+	}
+	if pageSize > maxReviewPageSize {
+		pageSize = maxReviewPageSize
+	}
+
+	documents, total, err := s.documentRepo.ListFieldExtractionReview(ctx, companyID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field extraction review: %w", err)
+	}
+
+	review := &service.FieldExtractionReview{
+		Items:    make([]service.FieldExtractionReviewItem, 0, len(documents)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, document := range documents {
+		item := service.FieldExtractionReviewItem{Document: document, Fields: make([]types.ExtractedField, 0)}
+		for _, f := range document.Metadata.FieldExtraction.Fields {
+			if f.Status == types.ExtractionPending {
+				item.Fields = append(item.Fields, f)
+			}
+		}
+		review.Items = append(review.Items, item)
+	}
+	return review, nil
+}
+
+// fieldExtraction получает документ и результат извлечения значений для его текущего типа
+func (s *documentService) fieldExtraction(ctx context.Context, documentID uuid.UUID) (*ent.Document, *types.FieldExtraction, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("document not found: %w", err)
+	}
+	fe := currentFieldExtraction(document)
+	if fe == nil {
+		return nil, nil, service.ErrFieldExtractionNotFound
+	}
+	return document, fe, nil
+}
+
+// setFieldValues записывает значения полей, если тип документа не сменился с момента чтения
+func (s *documentService) setFieldValues(ctx context.Context, document *ent.Document, values map[string]types.FieldValue) error {
+	updated, err := s.documentRepo.SetFieldValues(ctx, document.ID, *document.TypeID, values)
+	if err != nil {
+		return fmt.Errorf("failed to set field values: %w", err)
+	}
+	if !updated {
+		return service.ErrFieldExtractionNotFound
+	}
+	return nil
+}
+
+func (s *documentService) saveFieldExtraction(ctx context.Context, documentID uuid.UUID, fe *types.FieldExtraction) error {
+	if err := s.documentRepo.MergeMetadata(ctx, documentID, types.DocumentMetadata{FieldExtraction: fe}); err != nil {
+		return fmt.Errorf("failed to save field extraction: %w", err)
+	}
+	return nil
+}
+
+// extractFields извлекает значения полей в фоновой обработке документа, ошибка только логируется
+func (s *documentService) extractFields(ctx context.Context, documentID uuid.UUID) {
+	if _, err := s.ExtractFields(ctx, documentID); err != nil && !errors.Is(err, service.ErrFieldExtractionNotConfigured) {
+		fmt.Printf("Failed to extract fields for document %s: %v\n", documentID, err)
+	}
+}
+
+// currentFieldExtraction возвращает результат извлечения, если он получен для текущего типа документа
+func currentFieldExtraction(document *ent.Document) *types.FieldExtraction {
+	fe := document.Metadata.FieldExtraction
+	if fe == nil || document.TypeID == nil || fe.TypeID != *document.TypeID {
+		return nil
+	}
+	return fe
+}
+
+func extractedFieldIndex(fe *types.FieldExtraction, key string) int {
+	for i, f := range fe.Fields {
+		if f.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func countPending(fields []types.ExtractedField) int {
+	pending := 0
+	for _, f := range fields {
+		if f.Status == types.ExtractionPending {
+			pending++
+		}
+	}
+	return pending
+}
+
+func requiredField(fields []types.DocumentField, key string) bool {
+	for _, f := range fields {
+		if f.Key == key {
+			return f.Required
+		}
+	}
+	return false
+}
+
+func sameValue(a, b types.FieldValue) bool {
+	return fmt.Sprint(a.Value()) == fmt.Sprint(b.Value())
+}
+
+func copyFieldValues(values map[string]types.FieldValue) map[string]types.FieldValue {
+	copied := make(map[string]types.FieldValue, len(values)+1)
+	for key, value := range values {
+		copied[key] = value
+	}
+	return copied
+}
@@ -0,0 +1,52 @@
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"techmind/internal/doctype"
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// documentFields проверяет, что тип принадлежит компании, и собирает значения полей по его схеме:
+// значения current, подходящие к схеме, заменяются значениями raw
+func (s *documentService) documentFields(ctx context.Context, companyID uuid.UUID, typeID *uuid.UUID, current map[string]types.FieldValue, raw map[string]json.RawMessage) (*repo.DocumentFields, error) {
+	if typeID == nil {
+		return nil, fmt.Errorf("%w: fields require a document type", service.ErrInvalidFieldValues)
+	}
+
+	documentType, err := s.documentTypeRepo.GetByID(ctx, *typeID)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrDocumentTypeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document type: %w", err)
+	}
+	if documentType.CompanyID != companyID {
+		return nil, service.ErrDocumentTypeNotFound
+	}
+
+	values, err := doctype.ParseValues(documentType.Fields, current, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidFieldValues, err)
+	}
+
+	// Поля-ссылки могут указывать только на контрагентов той же компании
+	for _, senderID := range doctype.SenderIDs(values) {
+		sender, err := s.senderRepo.GetByID(ctx, senderID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get sender: %w", err)
+		}
+		if err != nil || sender.CompanyID != companyID {
+			return nil, fmt.Errorf("%w: sender %s not found", service.ErrInvalidFieldValues, senderID)
+		}
+	}
+
+	return &repo.DocumentFields{TypeID: &documentType.ID, Values: values}, nil
+}
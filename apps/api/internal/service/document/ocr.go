@@ -0,0 +1,84 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/pkg/ocr"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// recognizeText распознает текст скана, сохраняет уверенность распознавания в метаданные
+// и при включенной настройке кладет рядом PDF с текстовым слоем. Возвращает текст по страницам
+func (s *documentService) recognizeText(ctx context.Context, document *ent.Document, content []byte) ([]string, error) {
+	result, err := s.ocrEngine.Recognize(ctx, ocr.Input{
+		Content:       content,
+		MimeType:      document.MimeType,
+		SearchablePDF: s.searchablePDF,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &types.OCRMetadata{
+		Engine:      s.ocrEngine.Name(),
+		Languages:   result.Languages,
+		Confidence:  result.Confidence,
+		Pages:       make([]types.OCRPageResult, 0, len(result.Pages)),
+		ProcessedAt: time.Now(),
+	}
+	if version, err := s.ocrEngine.Version(ctx); err == nil {
+		meta.Version = version
+	}
+
+	pages := make([]string, 0, len(result.Pages))
+	for _, page := range result.Pages {
+		pages = append(pages, page.Text)
+		meta.Pages = append(meta.Pages, types.OCRPageResult{Number: page.Number, Confidence: page.Confidence})
+	}
+
+	if len(result.SearchablePDF) > 0 {
+		objectName := s.renditionObjectName(document, "searchable.pdf")
+		_, err := s.minioClient.PutObject(ctx, s.bucketName, objectName, bytes.NewReader(result.SearchablePDF), int64(len(result.SearchablePDF)), minio.PutObjectOptions{
+			ContentType: "application/pdf",
+		})
+		if err != nil {
+			// Текст уже распознан, отсутствие копии не должно мешать индексации
+			fmt.Printf("Failed to upload searchable pdf for document %s: %v\n", document.ID, err)
+		} else {
+			meta.SearchablePDFPath = objectName
+		}
+	}
+
+	if err := s.documentRepo.MergeMetadata(ctx, document.ID, types.DocumentMetadata{OCR: meta}); err != nil {
+		return nil, fmt.Errorf("failed to save ocr metadata: %w", err)
+	}
+
+	fmt.Printf("Recognized %d pages of document %s with confidence %.1f\n", len(pages), document.ID, result.Confidence)
+	return pages, nil
+}
+
+// GetSearchablePDFURL возвращает временную ссылку на PDF с текстовым слоем, построенный при OCR
+func (s *documentService) GetSearchablePDFURL(ctx context.Context, documentID uuid.UUID) (string, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return "", fmt.Errorf("document not found: %w", err)
+	}
+
+	if document.Metadata.OCR == nil || document.Metadata.OCR.SearchablePDFPath == "" {
+		return "", fmt.Errorf("document has no searchable pdf")
+	}
+
+	url, err := s.minioClient.PresignedGetObject(ctx, s.bucketName, document.Metadata.OCR.SearchablePDFPath, 1*time.Hour, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate download url: %w", err)
+	}
+
+	return url.String(), nil
+}
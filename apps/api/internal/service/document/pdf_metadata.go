@@ -0,0 +1,249 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/pkg/gotenberg"
+	"techmind/schema/ent"
+	"techmind/schema/ent/documenttag"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// pdfDateLayouts - форматы дат, в которых Gotenberg (exiftool) возвращает CreationDate
+var pdfDateLayouts = []string{
+	"2006:01:02 15:04:05-07:00",
+	"2006:01:02 15:04:05Z07:00",
+	"2006:01:02 15:04:05Z",
+	"2006:01:02 15:04:05",
+	time.RFC3339,
+}
+
+// ReadPDFMetadata читает метаданные PDF и сохраняет их в поле metadata документа
+// Если mapKeywordsToTags включен, ключевые слова привязываются к одноименным тегам компании
+func (s *documentService) ReadPDFMetadata(ctx context.Context, documentID uuid.UUID, mapKeywordsToTags bool) error {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("document not found: %w", err)
+	}
+
+	if !strings.EqualFold(document.MimeType, "application/pdf") {
+		return fmt.Errorf("document type %s does not contain PDF metadata", document.MimeType)
+	}
+
+	content, err := s.readObject(ctx, document.FilePath)
+	if err != nil {
+		return err
+	}
+
+	raw, err := s.gotenbergClient.ReadPDFMetadata(ctx, []gotenberg.File{{Name: "document.pdf", Content: content}})
+	if err != nil {
+		return fmt.Errorf("failed to read pdf metadata: %w", err)
+	}
+
+	// В ответе метаданные сгруппированы по имени файла, файл у нас один
+	var fields map[string]interface{}
+	for _, f := range raw {
+		fields = f
+		break
+	}
+
+	pdfMeta := &types.PDFMetadata{
+		Title:    metadataString(fields, "Title"),
+		Author:   metadataString(fields, "Author"),
+		Subject:  metadataString(fields, "Subject"),
+		Keywords: metadataKeywords(fields["Keywords"]),
+		Producer: metadataString(fields, "Producer"),
+		ReadAt:   time.Now(),
+	}
+	if date := metadataString(fields, "CreationDate"); date != "" {
+		for _, layout := range pdfDateLayouts {
+			if t, err := time.Parse(layout, date); err == nil {
+				pdfMeta.CreationDate = &t
+				break
+			}
+		}
+	}
+
+	if err := s.documentRepo.MergeMetadata(ctx, documentID, types.DocumentMetadata{PDF: pdfMeta}); err != nil {
+		return fmt.Errorf("failed to save pdf metadata: %w", err)
+	}
+
+	if mapKeywordsToTags && len(pdfMeta.Keywords) > 0 {
+		if err := s.assignKeywordTags(ctx, document, pdfMeta.Keywords); err != nil {
+			return fmt.Errorf("failed to map keywords to tags: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// assignKeywordTags привязывает к документу существующие теги компании, совпадающие с ключевыми словами
+func (s *documentService) assignKeywordTags(ctx context.Context, document *ent.Document, keywords []string) error {
+	tags, err := s.tagRepo.ListByCompany(ctx, document.CompanyID)
+	if err != nil {
+		return fmt.Errorf("failed to get company tags: %w", err)
+	}
+
+	tagsByName := make(map[string]*ent.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByName[strings.ToLower(tag.Name)] = tag
+	}
+
+	existing, err := s.getDocumentTags(ctx, document.ID)
+	if err != nil {
+		return err
+	}
+	assigned := make(map[uuid.UUID]bool, len(existing))
+	for _, tag := range existing {
+		assigned[tag.ID] = true
+	}
+
+	for _, keyword := range keywords {
+		tag, ok := tagsByName[strings.ToLower(keyword)]
+		if !ok || assigned[tag.ID] {
+			continue
+		}
+		if _, err := s.documentTagRepo.Assign(ctx, document.ID, tag.ID, repo.TagAssignment{
+			Source: documenttag.SourceImport,
+		}); err != nil {
+			fmt.Printf("Failed to assign tag '%s' to document %s: %v\n", tag.Name, document.ID, err)
+			continue
+		}
+		assigned[tag.ID] = true
+	}
+
+	return nil
+}
+
+// GetDownloadURLWithMetadata записывает в копию PDF название, отправителя, теги и ID документа
+// и возвращает временную ссылку на эту копию
+func (s *documentService) GetDownloadURLWithMetadata(ctx context.Context, documentID uuid.UUID) (string, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return "", fmt.Errorf("document not found: %w", err)
+	}
+
+	if !strings.EqualFold(document.MimeType, "application/pdf") {
+		return "", fmt.Errorf("metadata stamping is supported only for PDF documents")
+	}
+
+	content, err := s.readObject(ctx, document.FilePath)
+	if err != nil {
+		return "", err
+	}
+
+	tags, err := s.getDocumentTags(ctx, documentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get document tags: %w", err)
+	}
+	keywords := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		keywords = append(keywords, tag.Name)
+	}
+
+	metadata := map[string]interface{}{
+		"Title":    document.Name,
+		"Subject":  fmt.Sprintf("Document ID: %s", document.ID),
+		"Keywords": keywords,
+		"Creator":  "techmind",
+	}
+	if document.Edges.Sender != nil {
+		metadata["Author"] = document.Edges.Sender.Name
+	}
+
+	res, err := s.gotenbergClient.WritePDFMetadata(ctx, []gotenberg.File{{Name: "document.pdf", Content: content}}, metadata, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to write pdf metadata: %w", err)
+	}
+
+	// Копия перезаписывается при каждом запросе, чтобы метаданные были актуальными
+	objectName := s.renditionObjectName(document, "metadata.pdf")
+	_, err = s.minioClient.PutObject(ctx, s.bucketName, objectName, bytes.NewReader(res.Body), int64(len(res.Body)), minio.PutObjectOptions{
+		ContentType: "application/pdf",
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload rendition to minio: %w", err)
+	}
+
+	url, err := s.minioClient.PresignedGetObject(ctx, s.bucketName, objectName, 1*time.Hour, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate download url: %w", err)
+	}
+
+	return url.String(), nil
+}
+
+// renditionObjectName возвращает путь к производной копии документа в MinIO
+func (s *documentService) renditionObjectName(document *ent.Document, name string) string {
+	return fmt.Sprintf("%s/renditions/%s/%s", document.CompanyID.String(), document.ID.String(), name)
+}
+
+// removeRenditions удаляет все производные копии документа и еще не истекшие копии с водяным знаком
+func (s *documentService) removeRenditions(ctx context.Context, document *ent.Document) {
+	prefixes := []string{
+		fmt.Sprintf("%s/renditions/%s/", document.CompanyID.String(), document.ID.String()),
+		fmt.Sprintf("%s/%s/%s/", watermarkPrefix, document.CompanyID.String(), document.ID.String()),
+	}
+	for _, prefix := range prefixes {
+		for object := range s.minioClient.ListObjects(ctx, s.bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if object.Err != nil {
+				continue
+			}
+			_ = s.minioClient.RemoveObject(ctx, s.bucketName, object.Key, minio.RemoveObjectOptions{})
+		}
+	}
+}
+
+// readObject читает объект из MinIO целиком
+func (s *documentService) readObject(ctx context.Context, objectName string) ([]byte, error) {
+	object, err := s.minioClient.GetObject(ctx, s.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file from minio: %w", err)
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file content: %w", err)
+	}
+	return content, nil
+}
+
+// metadataString возвращает строковое значение поля метаданных
+func metadataString(fields map[string]interface{}, key string) string {
+	value, ok := fields[key]
+	if !ok || value == nil {
+		return ""
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
+
+// metadataKeywords разбирает ключевые слова, которые могут прийти строкой или массивом
+func metadataKeywords(value interface{}) []string {
+	var parts []string
+	switch v := value.(type) {
+	case string:
+		parts = strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ';' })
+	case []interface{}:
+		for _, item := range v {
+			parts = append(parts, fmt.Sprint(item))
+		}
+	}
+
+	keywords := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			keywords = append(keywords, part)
+		}
+	}
+	return keywords
+}
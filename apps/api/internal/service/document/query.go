@@ -0,0 +1,595 @@
+package document
+
+// Язык поисковых запросов
+//
+//	договор поставки            слова ищутся в названии и тексте документа
+//	"договор поставки"          точная фраза, "договор поставки"~3 - слова не дальше 3 позиций друг от друга
+//	акт AND сверки, акт OR счет  логические операторы, AND можно не писать
+//	NOT черновик, -черновик     исключение
+//	(акт OR счет) AND 2025      группировка скобками
+//	акт*, ак?                   шаблоны: * - любое число символов, ? - один символ
+//	договр~, договр~1           нечеткий поиск с автоматическим или заданным числом опечаток
+//
+// Поля:
+//
+//	name:    название документа       name:акт*, name:"акт сверки"
+//	text:    текст документа          text:неустойка~
+//	tag:     название тега            tag:archived, -tag:черновик
+//	sender:  название контрагента     sender:Ромашка
+//	type:    MIME тип или расширение  type:pdf, type:image/*
+//	created: дата создания            created:>2025-01-01, created:2025-03, created:2024..2025-06
+//	updated: дата изменения           updated:<=2025-02-15
+//	size:    размер файла             size:>1mb, size:100kb..5mb
+//
+// Даты задаются как ГГГГ, ГГГГ-ММ или ГГГГ-ММ-ДД и обозначают весь год, месяц или день:
+// created:2025-03 - создан в марте, created:>2025-03 - после марта, created:>=2025-03 - с начала марта.
+// Вместо даты можно указать период относительно текущего момента (UTC): today, yesterday,
+// this_week, last_week, this_month, last_month, this_quarter, last_quarter, this_year, last_year -
+// created:this_quarter, created:>=last_month. Неделя начинается с понедельника.
+// Операторы AND, OR и NOT пишутся заглавными буквами, иначе считаются обычными словами.
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"techmind/internal/service"
+)
+
+// queryFields - поля запроса и поля индекса, на которые они отображаются
+var queryFields = map[string]string{
+	"name":    "name",
+	"text":    "text",
+	"content": "text",
+	"tag":     "tag_ids",
+	"sender":  "sender_id",
+	"type":    "mime_type",
+	"mime":    "mime_type",
+	"created": "created_at",
+	"updated": "updated_at",
+	"size":    "file_size",
+}
+
+// queryNode - узел разобранного запроса
+type queryNode interface{}
+
+// boolNode объединяет условия через AND или OR
+type boolNode struct {
+	Or       bool
+	Children []queryNode
+}
+
+// notNode исключает документы, подходящие под условие
+type notNode struct {
+	Child queryNode
+}
+
+// termNode - слово, фраза или шаблон, Field пустой для поиска по названию и тексту
+type termNode struct {
+	Field     string
+	Value     string
+	Phrase    bool
+	Slop      int
+	Wildcard  bool
+	Fuzziness string
+	Pos       int
+}
+
+// rangeNode - сравнение даты или размера, границы nil - без ограничения
+type rangeNode struct {
+	Field string
+	// Gte - нижняя граница включительно, Lt - верхняя граница не включительно
+	Gte interface{}
+	Lt  interface{}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenPhrase
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+)
+
+type queryToken struct {
+	Kind tokenKind
+	// Field - префикс поля перед двоеточием, если он известен
+	Field string
+	Value string
+	// Slop - расстояние для фразы "..."~N, Fuzziness - значение после ~ у слова
+	Slop      int
+	Fuzziness string
+	Pos       int
+}
+
+// parseQuery разбирает поисковый запрос в дерево условий
+func parseQuery(query string) (queryNode, error) {
+	tokens, err := tokenizeQuery([]rune(query))
+	if err != nil {
+		return nil, err
+	}
+
+	p := &queryParser{tokens: tokens}
+	if p.peek().Kind == tokenEOF {
+		return nil, syntaxError(0, "empty query")
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.Kind != tokenEOF {
+		if tok.Kind == tokenRParen {
+			return nil, syntaxError(tok.Pos, "unexpected ')' without matching '('")
+		}
+		return nil, syntaxError(tok.Pos, "unexpected token")
+	}
+	return node, nil
+}
+
+func syntaxError(pos int, format string, args ...interface{}) error {
+	return &service.QuerySyntaxError{
+		Position: pos,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// tokenizeQuery разбивает запрос на лексемы, позиции считаются в символах
+func tokenizeQuery(runes []rune) ([]queryToken, error) {
+	var tokens []queryToken
+	i := 0
+	for i < len(runes) {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, queryToken{Kind: tokenLParen, Pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, queryToken{Kind: tokenRParen, Pos: i})
+			i++
+		case (r == '-' || r == '!') && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) && runes[i+1] != ')':
+			tokens = append(tokens, queryToken{Kind: tokenNot, Pos: i})
+			i++
+		case r == '+' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]):
+			// +слово означает обязательное слово, что совпадает с AND по умолчанию
+			i++
+		case r == '"':
+			tok, next, err := readPhrase(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = next
+		default:
+			tok, next, err := readWord(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, tok)
+			i = next
+		}
+	}
+	return append(tokens, queryToken{Kind: tokenEOF, Pos: len(runes)}), nil
+}
+
+// readPhrase читает фразу в кавычках с необязательным ~N после нее
+func readPhrase(runes []rune, start int) (queryToken, int, error) {
+	var b strings.Builder
+	i := start + 1
+	for ; i < len(runes) && runes[i] != '"'; i++ {
+		if runes[i] == '\\' && i+1 < len(runes) {
+			i++
+		}
+		b.WriteRune(runes[i])
+	}
+	if i >= len(runes) {
+		return queryToken{}, 0, syntaxError(start, "unterminated phrase, missing closing '\"'")
+	}
+	i++
+
+	tok := queryToken{Kind: tokenPhrase, Value: strings.TrimSpace(b.String()), Pos: start}
+	if tok.Value == "" {
+		return queryToken{}, 0, syntaxError(start, "empty phrase")
+	}
+
+	if i < len(runes) && runes[i] == '~' {
+		numStart := i + 1
+		j := numStart
+		for j < len(runes) && unicode.IsDigit(runes[j]) {
+			j++
+		}
+		if j == numStart {
+			return queryToken{}, 0, syntaxError(i, "expected number of positions after '~'")
+		}
+		tok.Slop, _ = strconv.Atoi(string(runes[numStart:j]))
+		i = j
+	}
+	return tok, i, nil
+}
+
+// readWord читает слово, оператор или условие по полю
+func readWord(runes []rune, start int) (queryToken, int, error) {
+	i := start
+	for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != '(' && runes[i] != ')' && runes[i] != '"' {
+		i++
+	}
+	word := string(runes[start:i])
+
+	switch word {
+	case "AND", "&&":
+		return queryToken{Kind: tokenAnd, Pos: start}, i, nil
+	case "OR", "||":
+		return queryToken{Kind: tokenOr, Pos: start}, i, nil
+	case "NOT":
+		return queryToken{Kind: tokenNot, Pos: start}, i, nil
+	}
+
+	tok := queryToken{Kind: tokenWord, Value: word, Pos: start}
+
+	// Префикс поля учитывается, только если поле известно: "10:30" остается словом
+	if colon := strings.IndexRune(word, ':'); colon > 0 {
+		field := strings.ToLower(word[:colon])
+		if _, ok := queryFields[field]; ok {
+			tok.Field = field
+			tok.Value = word[colon+1:]
+			valuePos := start + len([]rune(word[:colon+1]))
+			if tok.Value == "" {
+				if i < len(runes) && runes[i] == '"' {
+					phrase, next, err := readPhrase(runes, i)
+					if err != nil {
+						return queryToken{}, 0, err
+					}
+					phrase.Field = field
+					phrase.Pos = start
+					return phrase, next, nil
+				}
+				return queryToken{}, 0, syntaxError(valuePos, "expected value after %q", field+":")
+			}
+		}
+	}
+
+	if tilde := strings.LastIndex(tok.Value, "~"); tilde > 0 {
+		fuzziness := tok.Value[tilde+1:]
+		switch fuzziness {
+		case "":
+			tok.Fuzziness = "AUTO"
+		case "0", "1", "2":
+			tok.Fuzziness = fuzziness
+		default:
+			pos := start + len([]rune(word)) - len([]rune(fuzziness))
+			return queryToken{}, 0, syntaxError(pos, "fuzziness must be 0, 1 or 2")
+		}
+		tok.Value = tok.Value[:tilde]
+	}
+
+	return tok, i, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) next() queryToken {
+	tok := p.tokens[p.pos]
+	if tok.Kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// parseOr: and (OR and)*
+func (p *queryParser) parseOr() (queryNode, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []queryNode{first}
+	for p.peek().Kind == tokenOr {
+		op := p.next()
+		if !p.startsOperand() {
+			return nil, syntaxError(op.Pos, "expected term after OR")
+		}
+		child, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+	if len(children) == 1 {
+		return first, nil
+	}
+	return &boolNode{Or: true, Children: children}, nil
+}
+
+// parseAnd: unary ((AND)? unary)*, соседние слова без операторов ищутся вместе
+func (p *queryParser) parseAnd() (queryNode, error) {
+	first, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	children := []queryNode{first}
+	// explicit[i] - перед условием i стоит явный AND
+	explicit := []bool{false}
+	for {
+		and := p.peek().Kind == tokenAnd
+		if and {
+			op := p.next()
+			if !p.startsOperand() {
+				return nil, syntaxError(op.Pos, "expected term after AND")
+			}
+		} else if !p.startsOperand() {
+			break
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+		explicit = append(explicit, and)
+	}
+
+	children = mergeTextTerms(children, explicit)
+	if len(children) == 1 {
+		return children[0], nil
+	}
+	return &boolNode{Children: children}, nil
+}
+
+// parseUnary: (NOT | -) unary | primary
+func (p *queryParser) parseUnary() (queryNode, error) {
+	if p.peek().Kind == tokenNot {
+		op := p.next()
+		if !p.startsOperand() {
+			return nil, syntaxError(op.Pos, "expected term after NOT")
+		}
+		child, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{Child: child}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary: '(' or ')' | слово | фраза
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	tok := p.next()
+	switch tok.Kind {
+	case tokenLParen:
+		if p.peek().Kind == tokenRParen {
+			return nil, syntaxError(tok.Pos, "empty group")
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek().Kind != tokenRParen {
+			return nil, syntaxError(tok.Pos, "missing closing ')'")
+		}
+		p.next()
+		return node, nil
+	case tokenPhrase:
+		if tok.Field == "created" || tok.Field == "updated" || tok.Field == "size" {
+			return wordNode(tok)
+		}
+		return &termNode{Field: tok.Field, Value: tok.Value, Phrase: true, Slop: tok.Slop, Pos: tok.Pos}, nil
+	case tokenWord:
+		return wordNode(tok)
+	case tokenRParen:
+		return nil, syntaxError(tok.Pos, "unexpected ')' without matching '('")
+	case tokenEOF:
+		return nil, syntaxError(tok.Pos, "unexpected end of query")
+	default:
+		return nil, syntaxError(tok.Pos, "expected term")
+	}
+}
+
+// startsOperand проверяет, может ли следующая лексема начинать условие
+func (p *queryParser) startsOperand() bool {
+	switch p.peek().Kind {
+	case tokenWord, tokenPhrase, tokenNot, tokenLParen:
+		return true
+	}
+	return false
+}
+
+// wordNode превращает слово в условие, разбирая сравнения для дат и размера
+func wordNode(tok queryToken) (queryNode, error) {
+	valuePos := tok.Pos + len([]rune(tok.Field))
+	if tok.Field != "" {
+		valuePos++
+	}
+
+	switch tok.Field {
+	case "created", "updated":
+		return parseRange(queryFields[tok.Field], tok.Value, valuePos, parseDatePeriod)
+	case "size":
+		return parseRange(queryFields[tok.Field], tok.Value, valuePos, parseSizeBound)
+	}
+
+	return &termNode{
+		Field:     tok.Field,
+		Value:     tok.Value,
+		Wildcard:  strings.ContainsAny(tok.Value, "*?"),
+		Fuzziness: tok.Fuzziness,
+		Pos:       tok.Pos,
+	}, nil
+}
+
+// boundParser разбирает значение и возвращает начало и конец обозначаемого интервала [from, to)
+type boundParser func(value string) (from, to interface{}, ok bool)
+
+// parseRange разбирает >X, >=X, <X, <=X, X и X..Y
+func parseRange(field, value string, pos int, parse boundParser) (queryNode, error) {
+	bound := func(value string, pos int) (interface{}, interface{}, error) {
+		from, to, ok := parse(value)
+		if !ok {
+			if field == "file_size" {
+				return nil, nil, syntaxError(pos, "invalid size %q, expected number with optional unit b, kb, mb or gb", value)
+			}
+			return nil, nil, syntaxError(pos, "invalid date %q, expected YYYY, YYYY-MM, YYYY-MM-DD or period like this_month", value)
+		}
+		return from, to, nil
+	}
+
+	node := &rangeNode{Field: field}
+	switch {
+	case strings.HasPrefix(value, ">="):
+		from, _, err := bound(value[2:], pos+2)
+		node.Gte = from
+		return node, err
+	case strings.HasPrefix(value, "<="):
+		_, to, err := bound(value[2:], pos+2)
+		node.Lt = to
+		return node, err
+	case strings.HasPrefix(value, ">"):
+		_, to, err := bound(value[1:], pos+1)
+		node.Gte = to
+		return node, err
+	case strings.HasPrefix(value, "<"):
+		from, _, err := bound(value[1:], pos+1)
+		node.Lt = from
+		return node, err
+	case strings.Contains(value, ".."):
+		parts := strings.SplitN(value, "..", 2)
+		if parts[0] != "" {
+			from, _, err := bound(parts[0], pos)
+			if err != nil {
+				return nil, err
+			}
+			node.Gte = from
+		}
+		if parts[1] != "" {
+			_, to, err := bound(parts[1], pos+len([]rune(parts[0]))+2)
+			if err != nil {
+				return nil, err
+			}
+			node.Lt = to
+		}
+		return node, nil
+	default:
+		from, to, err := bound(value, pos)
+		node.Gte, node.Lt = from, to
+		return node, err
+	}
+}
+
+// parseDatePeriod разбирает год, месяц, день или период относительно текущей даты
+func parseDatePeriod(value string) (interface{}, interface{}, bool) {
+	if from, to, ok := relativePeriod(strings.ToLower(value), time.Now().UTC()); ok {
+		return from.Format(time.RFC3339), to.Format(time.RFC3339), true
+	}
+
+	layouts := []struct {
+		layout string
+		next   func(time.Time) time.Time
+	}{
+		{"2006-01-02", func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }},
+		{"2006-01", func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }},
+		{"2006", func(t time.Time) time.Time { return t.AddDate(1, 0, 0) }},
+	}
+	for _, l := range layouts {
+		if t, err := time.Parse(l.layout, value); err == nil {
+			return t.Format(time.RFC3339), l.next(t).Format(time.RFC3339), true
+		}
+	}
+	return nil, nil, false
+}
+
+// relativePeriod возвращает интервал [from, to) для периода относительно now
+func relativePeriod(value string, now time.Time) (time.Time, time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	week := today.AddDate(0, 0, -(int(today.Weekday())+6)%7)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	quarter := time.Date(now.Year(), now.Month()-(now.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	year := time.Date(now.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+
+	switch value {
+	case "today":
+		return today, today.AddDate(0, 0, 1), true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), today, true
+	case "this_week":
+		return week, week.AddDate(0, 0, 7), true
+	case "last_week":
+		return week.AddDate(0, 0, -7), week, true
+	case "this_month":
+		return month, month.AddDate(0, 1, 0), true
+	case "last_month":
+		return month.AddDate(0, -1, 0), month, true
+	case "this_quarter":
+		return quarter, quarter.AddDate(0, 3, 0), true
+	case "last_quarter":
+		return quarter.AddDate(0, -3, 0), quarter, true
+	case "this_year":
+		return year, year.AddDate(1, 0, 0), true
+	case "last_year":
+		return year.AddDate(-1, 0, 0), year, true
+	}
+	return time.Time{}, time.Time{}, false
+}
+
+// parseSizeBound разбирает размер с единицей измерения, точное значение - интервал [n, n+1)
+func parseSizeBound(value string) (interface{}, interface{}, bool) {
+	units := []struct {
+		suffix     string
+		multiplier float64
+	}{
+		{"gb", 1 << 30},
+		{"mb", 1 << 20},
+		{"kb", 1 << 10},
+		{"b", 1},
+	}
+	lower := strings.ToLower(value)
+	multiplier := 1.0
+	for _, unit := range units {
+		if strings.HasSuffix(lower, unit.suffix) {
+			lower = strings.TrimSuffix(lower, unit.suffix)
+			multiplier = unit.multiplier
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(lower, 64)
+	if err != nil || n < 0 {
+		return nil, nil, false
+	}
+	size := int64(n * multiplier)
+	return size, size + 1, true
+}
+
+// mergeTextTerms объединяет соседние простые слова без поля в один полнотекстовый поиск,
+// чтобы запрос без операторов ранжировался так же, как обычный поиск по словам
+// Слова, разделенные явным AND, остаются отдельными условиями
+func mergeTextTerms(children []queryNode, explicit []bool) []queryNode {
+	merged := make([]queryNode, 0, len(children))
+	var current *termNode
+	for i, child := range children {
+		term, ok := child.(*termNode)
+		if !ok || term.Field != "" || term.Phrase || term.Wildcard || term.Fuzziness != "" {
+			current = nil
+			merged = append(merged, child)
+			continue
+		}
+		if current != nil && !explicit[i] {
+			current.Value += " " + term.Value
+			continue
+		}
+		copied := *term
+		current = &copied
+		merged = append(merged, current)
+	}
+	return merged
+}
@@ -0,0 +1,202 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	"techmind/internal/search"
+	"techmind/internal/tagging"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+// extensionMimeTypes дополняет системную таблицу типов для type:расширение
+var extensionMimeTypes = map[string]string{
+	"pdf":  "application/pdf",
+	"doc":  "application/msword",
+	"docx": "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"xls":  "application/vnd.ms-excel",
+	"xlsx": "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"ppt":  "application/vnd.ms-powerpoint",
+	"pptx": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"odt":  "application/vnd.oasis.opendocument.text",
+	"ods":  "application/vnd.oasis.opendocument.spreadsheet",
+	"txt":  "text/plain",
+	"csv":  "text/csv",
+	"rtf":  "application/rtf",
+	"jpg":  "image/jpeg",
+	"jpeg": "image/jpeg",
+	"png":  "image/png",
+	"tiff": "image/tiff",
+	"tif":  "image/tiff",
+}
+
+// queryBuilder переводит разобранный запрос в условия поискового индекса,
+// заменяя названия тегов и контрагентов компании на их ID
+type queryBuilder struct {
+	ctx       context.Context
+	service   *documentService
+	companyID uuid.UUID
+
+	tags    []*ent.Tag
+	senders []*ent.Sender
+}
+
+// buildTextQuery разбирает поисковый запрос и возвращает условие для индекса
+func (s *documentService) buildTextQuery(ctx context.Context, companyID uuid.UUID, query string) (search.Node, error) {
+	node, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &queryBuilder{ctx: ctx, service: s, companyID: companyID}
+	return b.build(node)
+}
+
+// build строит условие индекса для узла запроса
+func (b *queryBuilder) build(node queryNode) (search.Node, error) {
+	switch n := node.(type) {
+	case *boolNode:
+		children := make([]search.Node, 0, len(n.Children))
+		for _, child := range n.Children {
+			clause, err := b.build(child)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, clause)
+		}
+		return &search.Bool{Or: n.Or, Children: children}, nil
+
+	case *notNode:
+		child, err := b.build(n.Child)
+		if err != nil {
+			return nil, err
+		}
+		return &search.Not{Child: child}, nil
+
+	case *rangeNode:
+		return &search.Range{Field: n.Field, Gte: n.Gte, Lt: n.Lt}, nil
+
+	case *termNode:
+		return b.buildTerm(n)
+	}
+
+	return nil, fmt.Errorf("unsupported query node %T", node)
+}
+
+// buildTerm строит условие для слова или фразы с учетом поля
+func (b *queryBuilder) buildTerm(term *termNode) (search.Node, error) {
+	switch term.Field {
+	case "", "name", "text", "content":
+		return &search.Text{
+			Field:     queryFields[term.Field],
+			Value:     term.Value,
+			Phrase:    term.Phrase,
+			Slop:      term.Slop,
+			Wildcard:  term.Wildcard,
+			Fuzziness: term.Fuzziness,
+		}, nil
+	case "tag":
+		ids, err := b.tagIDs(term)
+		if err != nil {
+			return nil, err
+		}
+		return &search.Terms{Field: search.FieldTagIDs, Values: uuidStrings(ids)}, nil
+	case "sender":
+		ids, err := b.senderIDs(term)
+		if err != nil {
+			return nil, err
+		}
+		return &search.Terms{Field: search.FieldSenderID, Values: uuidStrings(ids)}, nil
+	case "type", "mime":
+		return mimeTypeQuery(term)
+	}
+
+	return nil, syntaxError(term.Pos, "field %q does not support text values", term.Field)
+}
+
+// tagIDs находит теги компании по названию без учета регистра или по шаблону вместе с их потомками
+func (b *queryBuilder) tagIDs(term *termNode) ([]uuid.UUID, error) {
+	if b.tags == nil {
+		tags, err := b.service.tagRepo.ListByCompany(b.ctx, b.companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags: %w", err)
+		}
+		b.tags = tags
+	}
+
+	// Тег находится по названию или пути (tag:Финансы/Счета) и охватывает свои дочерние теги
+	tree := tagging.NewTree(b.tags)
+	var ids []uuid.UUID
+	for _, tag := range b.tags {
+		if matchName(tag.Name, term, false) || matchName(tree.Path(tag.ID), term, false) {
+			ids = append(ids, tag.ID)
+		}
+	}
+	return tree.Expand(ids), nil
+}
+
+// senderIDs находит контрагентов компании, в названии которых есть значение, или по шаблону
+func (b *queryBuilder) senderIDs(term *termNode) ([]uuid.UUID, error) {
+	if b.senders == nil {
+		senders, err := b.service.senderRepo.ListByCompany(b.ctx, b.companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get senders: %w", err)
+		}
+		b.senders = senders
+	}
+
+	var ids []uuid.UUID
+	for _, sender := range b.senders {
+		if matchName(sender.Name, term, true) {
+			ids = append(ids, sender.ID)
+		}
+	}
+	return ids, nil
+}
+
+// matchName сравнивает название с условием без учета регистра
+// contains разрешает совпадение части названия: sender:Ромашка находит ООО "Ромашка"
+func matchName(name string, term *termNode, contains bool) bool {
+	name = strings.ToLower(name)
+	value := strings.ToLower(term.Value)
+	if term.Wildcard {
+		ok, _ := path.Match(value, name)
+		return ok
+	}
+	if contains {
+		return strings.Contains(name, value)
+	}
+	return name == value
+}
+
+// mimeTypeQuery строит условие по MIME типу или расширению файла
+func mimeTypeQuery(term *termNode) (search.Node, error) {
+	value := strings.ToLower(term.Value)
+	if strings.Contains(value, "/") {
+		return &search.Terms{Field: search.FieldMimeType, Values: []string{value}, Wildcard: term.Wildcard}, nil
+	}
+
+	ext := strings.TrimPrefix(value, ".")
+	mimeType, ok := extensionMimeTypes[ext]
+	if !ok {
+		mimeType, _, _ = strings.Cut(mime.TypeByExtension("."+ext), ";")
+	}
+	if mimeType == "" {
+		return nil, syntaxError(term.Pos, "unknown document type %q", term.Value)
+	}
+	return &search.Terms{Field: search.FieldMimeType, Values: []string{mimeType}}, nil
+}
+
+// uuidStrings преобразует ID в строки для условий индекса
+func uuidStrings(ids []uuid.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
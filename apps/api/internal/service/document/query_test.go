@@ -0,0 +1,406 @@
+package document
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  queryNode
+	}{
+		{
+			name:  "соседние слова объединяются в один поиск",
+			query: "договор поставки",
+			want:  &termNode{Value: "договор поставки", Pos: 0},
+		},
+		{
+			name:  "явный AND оставляет слова отдельными условиями",
+			query: "акт AND сверки",
+			want: &boolNode{Children: []queryNode{
+				&termNode{Value: "акт", Pos: 0},
+				&termNode{Value: "сверки", Pos: 8},
+			}},
+		},
+		{
+			name:  "соседние слова объединяются только до явного AND",
+			query: "акт сверки AND счет фактура",
+			want: &boolNode{Children: []queryNode{
+				&termNode{Value: "акт сверки", Pos: 0},
+				&termNode{Value: "счет фактура", Pos: 15},
+			}},
+		},
+		{
+			name:  "AND связывает сильнее OR",
+			query: "акт OR счет AND name:сверки",
+			want: &boolNode{Or: true, Children: []queryNode{
+				&termNode{Value: "акт", Pos: 0},
+				&boolNode{Children: []queryNode{
+					&termNode{Value: "счет", Pos: 7},
+					&termNode{Field: "name", Value: "сверки", Pos: 16},
+				}},
+			}},
+		},
+		{
+			name:  "NOT относится только к следующему условию",
+			query: "NOT черновик акт",
+			want: &boolNode{Children: []queryNode{
+				&notNode{Child: &termNode{Value: "черновик", Pos: 4}},
+				&termNode{Value: "акт", Pos: 13},
+			}},
+		},
+		{
+			name:  "минус перед условием по полю",
+			query: "-tag:черновик",
+			want:  &notNode{Child: &termNode{Field: "tag", Value: "черновик", Pos: 1}},
+		},
+		{
+			name:  "скобки меняют порядок",
+			query: "(акт OR счет) name:2025",
+			want: &boolNode{Children: []queryNode{
+				&boolNode{Or: true, Children: []queryNode{
+					&termNode{Value: "акт", Pos: 1},
+					&termNode{Value: "счет", Pos: 8},
+				}},
+				&termNode{Field: "name", Value: "2025", Pos: 14},
+			}},
+		},
+		{
+			name:  "операторы в нижнем регистре - обычные слова",
+			query: "акт or счет",
+			want:  &termNode{Value: "акт or счет", Pos: 0},
+		},
+		{
+			name:  "фраза с расстоянием",
+			query: `"договор поставки"~3`,
+			want:  &termNode{Value: "договор поставки", Phrase: true, Slop: 3, Pos: 0},
+		},
+		{
+			name:  "фраза по полю с экранированной кавычкой",
+			query: `name:"ООО \"Ромашка\""`,
+			want:  &termNode{Field: "name", Value: `ООО "Ромашка"`, Phrase: true, Pos: 0},
+		},
+		{
+			name:  "шаблон и нечеткий поиск не объединяются с соседями",
+			query: "акт* договр~ договр~1",
+			want: &boolNode{Children: []queryNode{
+				&termNode{Value: "акт*", Wildcard: true, Pos: 0},
+				&termNode{Value: "договр", Fuzziness: "AUTO", Pos: 5},
+				&termNode{Value: "договр", Fuzziness: "1", Pos: 13},
+			}},
+		},
+		{
+			name:  "поле без учета регистра",
+			query: "Content:неустойка",
+			want:  &termNode{Field: "content", Value: "неустойка", Pos: 0},
+		},
+		{
+			name:  "неизвестное поле остается словом",
+			query: "foo:bar 10:30",
+			want:  &termNode{Value: "foo:bar 10:30", Pos: 0},
+		},
+		{
+			name:  "месяц",
+			query: "created:2025-03",
+			want:  &rangeNode{Field: "created_at", Gte: "2025-03-01T00:00:00Z", Lt: "2025-04-01T00:00:00Z"},
+		},
+		{
+			name:  "после месяца",
+			query: "created:>2025-03",
+			want:  &rangeNode{Field: "created_at", Gte: "2025-04-01T00:00:00Z"},
+		},
+		{
+			name:  "с начала месяца",
+			query: "created:>=2025-03",
+			want:  &rangeNode{Field: "created_at", Gte: "2025-03-01T00:00:00Z"},
+		},
+		{
+			name:  "до дня включительно",
+			query: "updated:<=2025-02-15",
+			want:  &rangeNode{Field: "updated_at", Lt: "2025-02-16T00:00:00Z"},
+		},
+		{
+			name:  "до года",
+			query: "updated:<2025",
+			want:  &rangeNode{Field: "updated_at", Lt: "2025-01-01T00:00:00Z"},
+		},
+		{
+			name:  "интервал дат",
+			query: "created:2024..2025-06",
+			want:  &rangeNode{Field: "created_at", Gte: "2024-01-01T00:00:00Z", Lt: "2025-07-01T00:00:00Z"},
+		},
+		{
+			name:  "открытый интервал дат",
+			query: "created:2024-05-01..",
+			want:  &rangeNode{Field: "created_at", Gte: "2024-05-01T00:00:00Z"},
+		},
+		{
+			name:  "размер больше",
+			query: "size:>1mb",
+			want:  &rangeNode{Field: "file_size", Gte: int64(1<<20 + 1)},
+		},
+		{
+			name:  "интервал размеров",
+			query: "size:100kb..5mb",
+			want:  &rangeNode{Field: "file_size", Gte: int64(100 << 10), Lt: int64(5<<20 + 1)},
+		},
+		{
+			name:  "размер меньше",
+			query: "size:<1KB",
+			want:  &rangeNode{Field: "file_size", Lt: int64(1 << 10)},
+		},
+		{
+			name:  "диапазон вместе с текстом",
+			query: "акт size:>=1.5kb",
+			want: &boolNode{Children: []queryNode{
+				&termNode{Value: "акт", Pos: 0},
+				&rangeNode{Field: "file_size", Gte: int64(1536)},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("parseQuery(%q) error: %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseQuery(%q) = %s, want %s", tt.query, dumpNode(got), dumpNode(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		query    string
+		position int
+		message  string
+	}{
+		{"", 0, "empty query"},
+		{"   ", 0, "empty query"},
+		{"(акт OR счет", 0, "missing closing ')'"},
+		{"акт AND (счет OR (сверки)", 8, "missing closing ')'"},
+		{"акт) счет", 3, "unexpected ')' without matching '('"},
+		{")", 0, "unexpected ')' without matching '('"},
+		{"акт ()", 4, "empty group"},
+		{`акт "сверки`, 4, `unterminated phrase, missing closing '"'`},
+		{`акт ""`, 4, "empty phrase"},
+		{`"акт"~`, 5, "expected number of positions after '~'"},
+		{"акт OR", 4, "expected term after OR"},
+		{"акт AND", 4, "expected term after AND"},
+		{"NOT", 0, "expected term after NOT"},
+		{"акт name:", 9, `expected value after "name:"`},
+		{"договр~5", 7, "fuzziness must be 0, 1 or 2"},
+		{"created:2025-13", 8, `invalid date "2025-13", expected YYYY, YYYY-MM, YYYY-MM-DD or period like this_month`},
+		{"created:2024..завтра", 14, `invalid date "завтра", expected YYYY, YYYY-MM, YYYY-MM-DD or period like this_month`},
+		{"size:>много", 6, `invalid size "много", expected number with optional unit b, kb, mb or gb`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := parseQuery(tt.query)
+			assertSyntaxError(t, err, tt.position, tt.message)
+		})
+	}
+}
+
+func TestRelativePeriod(t *testing.T) {
+	// Среда, 14 мая 2025
+	now := time.Date(2025, 5, 14, 15, 4, 5, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		value    string
+		from, to time.Time
+	}{
+		{"today", date(2025, 5, 14), date(2025, 5, 15)},
+		{"yesterday", date(2025, 5, 13), date(2025, 5, 14)},
+		{"this_week", date(2025, 5, 12), date(2025, 5, 19)},
+		{"last_week", date(2025, 5, 5), date(2025, 5, 12)},
+		{"this_month", date(2025, 5, 1), date(2025, 6, 1)},
+		{"last_month", date(2025, 4, 1), date(2025, 5, 1)},
+		{"this_quarter", date(2025, 4, 1), date(2025, 7, 1)},
+		{"last_quarter", date(2025, 1, 1), date(2025, 4, 1)},
+		{"this_year", date(2025, 1, 1), date(2026, 1, 1)},
+		{"last_year", date(2024, 1, 1), date(2025, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			from, to, ok := relativePeriod(tt.value, now)
+			if !ok {
+				t.Fatalf("relativePeriod(%q) is not recognized", tt.value)
+			}
+			if !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Fatalf("relativePeriod(%q) = [%s, %s), want [%s, %s)", tt.value, from, to, tt.from, tt.to)
+			}
+		})
+	}
+
+	// Неделя начинается с понедельника и для воскресенья
+	sunday := time.Date(2025, 5, 18, 23, 0, 0, 0, time.UTC)
+	if from, _, _ := relativePeriod("this_week", sunday); !from.Equal(date(2025, 5, 12)) {
+		t.Fatalf("this_week for sunday starts at %s, want 2025-05-12", from)
+	}
+	if _, _, ok := relativePeriod("tomorrow", now); ok {
+		t.Fatal("unknown period is recognized")
+	}
+}
+
+func TestQueryBuilder(t *testing.T) {
+	finance := &ent.Tag{ID: uuid.New(), Name: "Финансы"}
+	invoices := &ent.Tag{ID: uuid.New(), Name: "Счета", ParentID: &finance.ID}
+	archive := &ent.Tag{ID: uuid.New(), Name: "Архив"}
+	romashka := &ent.Sender{ID: uuid.New(), Name: `ООО "Ромашка"`}
+	lutik := &ent.Sender{ID: uuid.New(), Name: "ИП Лютик"}
+
+	tests := []struct {
+		name  string
+		query string
+		want  search.Node
+	}{
+		{
+			name:  "слова ищутся по названию и тексту",
+			query: "договор поставки",
+			want:  &search.Text{Value: "договор поставки"},
+		},
+		{
+			name:  "поле content отображается на текст",
+			query: "content:неустойка~",
+			want:  &search.Text{Field: search.FieldText, Value: "неустойка", Fuzziness: "AUTO"},
+		},
+		{
+			name:  "тег охватывает дочерние теги",
+			query: "tag:финансы",
+			want:  &search.Terms{Field: search.FieldTagIDs, Values: []string{finance.ID.String(), invoices.ID.String()}},
+		},
+		{
+			name:  "тег по пути",
+			query: `tag:"Финансы/Счета"`,
+			want:  &search.Terms{Field: search.FieldTagIDs, Values: []string{invoices.ID.String()}},
+		},
+		{
+			name:  "несуществующий тег не находит ничего",
+			query: "tag:нет",
+			want:  &search.Terms{Field: search.FieldTagIDs, Values: []string{}},
+		},
+		{
+			name:  "контрагент по части названия",
+			query: "sender:ромашка",
+			want:  &search.Terms{Field: search.FieldSenderID, Values: []string{romashka.ID.String()}},
+		},
+		{
+			name:  "контрагент по шаблону",
+			query: "sender:ип*",
+			want:  &search.Terms{Field: search.FieldSenderID, Values: []string{lutik.ID.String()}},
+		},
+		{
+			name:  "тип по расширению",
+			query: "type:PDF",
+			want:  &search.Terms{Field: search.FieldMimeType, Values: []string{"application/pdf"}},
+		},
+		{
+			name:  "тип по шаблону MIME",
+			query: "type:image/*",
+			want:  &search.Terms{Field: search.FieldMimeType, Values: []string{"image/*"}, Wildcard: true},
+		},
+		{
+			name:  "логические операторы и диапазоны",
+			query: "-tag:архив (акт OR name:счет*) created:2025",
+			want: &search.Bool{Children: []search.Node{
+				&search.Not{Child: &search.Terms{Field: search.FieldTagIDs, Values: []string{archive.ID.String()}}},
+				&search.Bool{Or: true, Children: []search.Node{
+					&search.Text{Value: "акт"},
+					&search.Text{Field: search.FieldName, Value: "счет*", Wildcard: true},
+				}},
+				&search.Range{Field: search.FieldCreatedAt, Gte: "2025-01-01T00:00:00Z", Lt: "2026-01-01T00:00:00Z"},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node, err := parseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("parseQuery(%q) error: %v", tt.query, err)
+			}
+			// Теги и контрагенты загружены заранее, поэтому репозитории не нужны
+			b := &queryBuilder{
+				tags:    []*ent.Tag{finance, invoices, archive},
+				senders: []*ent.Sender{romashka, lutik},
+			}
+			got, err := b.build(node)
+			if err != nil {
+				t.Fatalf("build(%q) error: %v", tt.query, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("build(%q) = %s, want %s", tt.query, dumpNode(got), dumpNode(tt.want))
+			}
+		})
+	}
+
+	t.Run("неизвестный тип документа", func(t *testing.T) {
+		node, err := parseQuery("акт type:нечто")
+		if err != nil {
+			t.Fatalf("parseQuery error: %v", err)
+		}
+		_, err = (&queryBuilder{}).build(node)
+		assertSyntaxError(t, err, 4, `unknown document type "нечто"`)
+	})
+}
+
+func assertSyntaxError(t *testing.T, err error, position int, message string) {
+	t.Helper()
+	var syntaxErr *service.QuerySyntaxError
+	if !errors.As(err, &syntaxErr) {
+		t.Fatalf("error = %v, want QuerySyntaxError", err)
+	}
+	if syntaxErr.Position != position || syntaxErr.Message != message {
+		t.Fatalf("error at %d %q, want at %d %q", syntaxErr.Position, syntaxErr.Message, position, message)
+	}
+}
+
+// dumpNode выводит дерево условий вместе с вложенными узлами
+func dumpNode(node interface{}) string {
+	switch n := node.(type) {
+	case *boolNode:
+		return dumpChildren(n.Or, n.Children)
+	case *search.Bool:
+		return dumpChildren(n.Or, n.Children)
+	case *notNode:
+		return "NOT " + dumpNode(n.Child)
+	case *search.Not:
+		return "NOT " + dumpNode(n.Child)
+	}
+	return fmt.Sprintf("%+v", node)
+}
+
+func dumpChildren[N any](or bool, children []N) string {
+	operator := " AND "
+	if or {
+		operator = " OR "
+	}
+	s := "("
+	for i, child := range children {
+		if i > 0 {
+			s += operator
+		}
+		s += dumpNode(child)
+	}
+	return s + ")"
+}
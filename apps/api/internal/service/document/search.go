@@ -0,0 +1,479 @@
+package document
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+	"techmind/internal/tagging"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultSearchPageSize - размер страницы результатов по умолчанию
+	defaultSearchPageSize = 20
+	// maxSearchPageSize - максимальный размер страницы результатов
+	maxSearchPageSize = 100
+	// maxResultWindow - ограничение на from + size, дальше листать только курсором
+	maxResultWindow = 10000
+)
+
+// searchCursor - состояние постраничной выдачи, передается клиенту в base64
+type searchCursor struct {
+	// SearchAfter - значения сортировки последнего документа страницы
+	SearchAfter []interface{} `json:"search_after,omitempty"`
+	// Offset - смещение при переходе по номеру страницы
+	Offset int `json:"offset,omitempty"`
+}
+
+func (s *documentService) Search(ctx context.Context, input service.DocumentSearchInput) (*service.DocumentSearchResult, error) {
+	started := time.Now()
+
+	pageSize := input.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	if pageSize > maxSearchPageSize {
+		pageSize = maxSearchPageSize
+	}
+
+	var cursor searchCursor
+	if input.Cursor != "" && input.Page <= 0 {
+		if err := decodeCursor(input.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+	} else {
+		page := input.Page
+		if page <= 0 {
+			page = 1
+		}
+		cursor.Offset = (page - 1) * pageSize
+	}
+
+	if cursor.SearchAfter == nil && cursor.Offset+pageSize > maxResultWindow {
+		return nil, fmt.Errorf("page is too deep, use cursor pagination")
+	}
+
+	result := &service.DocumentSearchResult{
+		Hits:     []*service.DocumentSearchHit{},
+		PageSize: pageSize,
+	}
+	if cursor.SearchAfter == nil {
+		result.Page = cursor.Offset/pageSize + 1
+	}
+
+	order, sortField, err := s.searchSort(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := s.searchFilters(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	query := &search.Query{
+		CompanyID: input.CompanyID,
+		Filters:   filters,
+		Sort:      order,
+		SortField: sortField,
+		Offset:    cursor.Offset,
+		After:     cursor.SearchAfter,
+		Size:      pageSize,
+	}
+	if input.Query != "" {
+		if err := s.applyQuery(ctx, input, query); err != nil {
+			return nil, err
+		}
+	}
+
+	found, err := s.index.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+	result.Total = found.Total
+
+	// По запросу ничего не нашлось - возможно, в нем опечатка
+	if found.Total == 0 && input.Query != "" {
+		result.DidYouMean, err = s.didYouMean(ctx, input.CompanyID, input.Query)
+		if err != nil {
+			fmt.Printf("Failed to suggest spelling correction for %q: %v\n", input.Query, err)
+		}
+	}
+
+	facets, err := s.buildFacets(ctx, input.CompanyID, found.Facets)
+	if err != nil {
+		return nil, err
+	}
+	result.Facets = facets
+
+	ids := make([]uuid.UUID, 0, len(found.Hits))
+	for _, hit := range found.Hits {
+		ids = append(ids, hit.DocumentID)
+	}
+	documents, err := s.documentRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+	byID, err := s.withTags(ctx, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	// Сохраняем порядок выдачи, документы, удаленные из БД, пропускаем
+	for _, hit := range found.Hits {
+		doc, ok := byID[hit.DocumentID]
+		if !ok {
+			continue
+		}
+		searchHit := &service.DocumentSearchHit{
+			DocumentWithTags: doc,
+			Highlights:       hit.Highlights,
+			MatchedPages:     hit.MatchedPages,
+		}
+		// При сортировке по дате без запроса релевантность не вычисляется
+		if input.Query != "" {
+			searchHit.Score = hit.Score
+		}
+		result.Hits = append(result.Hits, searchHit)
+	}
+
+	if len(found.Hits) == pageSize {
+		next, err := encodeCursor(searchCursor{SearchAfter: found.Hits[len(found.Hits)-1].Sort})
+		if err != nil {
+			return nil, err
+		}
+		result.NextCursor = next
+	}
+
+	// Следующие страницы - продолжение того же поиска, поэтому записывается только первая
+	if input.UserID != uuid.Nil && cursor.SearchAfter == nil && cursor.Offset == 0 {
+		searchID, err := s.analyticsService.RecordSearch(ctx, service.SearchRecord{
+			CompanyID:   input.CompanyID,
+			UserID:      input.UserID,
+			Query:       input.Query,
+			Filters:     analyticsFilters(input),
+			Mode:        input.Mode,
+			Sort:        input.Sort,
+			ResultCount: found.Total,
+			Latency:     time.Since(started),
+		})
+		if err != nil {
+			fmt.Printf("Failed to record search analytics: %v\n", err)
+		} else {
+			result.SearchID = &searchID
+		}
+	}
+
+	return result, nil
+}
+
+func (s *documentService) SearchIDs(ctx context.Context, input service.DocumentSearchInput, limit int) ([]uuid.UUID, error) {
+	order, sortField, err := s.searchSort(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := s.searchFilters(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	query := &search.Query{
+		CompanyID: input.CompanyID,
+		Filters:   filters,
+		Sort:      order,
+		SortField: sortField,
+		Size:      maxSearchPageSize,
+	}
+	if input.Query != "" {
+		if err := s.applyQuery(ctx, input, query); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]uuid.UUID, 0)
+	for len(ids) < limit {
+		found, err := s.index.Query(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("search failed: %w", err)
+		}
+		for _, hit := range found.Hits {
+			if len(ids) == limit {
+				break
+			}
+			ids = append(ids, hit.DocumentID)
+		}
+		if len(found.Hits) < query.Size {
+			break
+		}
+		query.After = found.Hits[len(found.Hits)-1].Sort
+	}
+
+	return ids, nil
+}
+
+// analyticsFilters сохраняет фильтры поиска в виде фильтров сохраненного поиска
+func analyticsFilters(input service.DocumentSearchInput) types.SearchFilters {
+	filters := indexFilters(input)
+	return types.SearchFilters{
+		FolderID:    filters.FolderID,
+		TagsAll:     filters.TagsAll,
+		TagsAny:     filters.TagsAny,
+		TagsNone:    filters.TagsNone,
+		MimeTypes:   filters.MimeTypes,
+		SenderIDs:   filters.SenderIDs,
+		CreatedBy:   filters.CreatedBy,
+		CreatedFrom: filters.CreatedFrom,
+		CreatedTo:   filters.CreatedTo,
+		UpdatedFrom: filters.UpdatedFrom,
+		UpdatedTo:   filters.UpdatedTo,
+		SizeMin:     filters.SizeMin,
+		SizeMax:     filters.SizeMax,
+		TypeIDs:     filters.TypeIDs,
+		Fields:      filters.Fields,
+	}
+}
+
+// searchFilters переводит фильтры поиска в фильтры индекса,
+// условие по тегу распространяется на все его дочерние теги
+func (s *documentService) searchFilters(ctx context.Context, input service.DocumentSearchInput) (search.Filters, error) {
+	filters := indexFilters(input)
+	if len(filters.TagsAll) == 0 && len(filters.TagsAny) == 0 && len(filters.TagsNone) == 0 {
+		return filters, nil
+	}
+
+	tags, err := s.tagRepo.ListByCompany(ctx, input.CompanyID)
+	if err != nil {
+		return filters, fmt.Errorf("failed to get tags: %w", err)
+	}
+	tree := tagging.NewTree(tags)
+
+	// Для тега с потомками "все" означает хотя бы один тег из его поддерева
+	all := filters.TagsAll
+	filters.TagsAll = nil
+	for _, tagID := range all {
+		subtree := tree.Subtree(tagID)
+		if len(subtree) == 1 {
+			filters.TagsAll = append(filters.TagsAll, tagID)
+		} else {
+			filters.TagSets = append(filters.TagSets, subtree)
+		}
+	}
+	filters.TagsAny = tree.Expand(filters.TagsAny)
+	filters.TagsNone = tree.Expand(filters.TagsNone)
+
+	return filters, nil
+}
+
+// indexFilters переводит фильтры поиска в фильтры индекса без учета дерева тегов
+func indexFilters(input service.DocumentSearchInput) search.Filters {
+	return search.Filters{
+		FolderID:    input.FolderID,
+		TagsAll:     append(append([]uuid.UUID{}, input.TagIDs...), input.Tags.All...),
+		TagsAny:     input.Tags.Any,
+		TagsNone:    input.Tags.None,
+		MimeTypes:   input.MimeTypes,
+		SenderIDs:   input.SenderIDs,
+		CreatedBy:   input.CreatedBy,
+		CreatedFrom: input.CreatedFrom,
+		CreatedTo:   input.CreatedTo,
+		UpdatedFrom: input.UpdatedFrom,
+		UpdatedTo:   input.UpdatedTo,
+		SizeMin:     input.SizeMin,
+		SizeMax:     input.SizeMax,
+		TypeIDs:     input.TypeIDs,
+		Fields:      input.Fields,
+	}
+}
+
+// buildFacets превращает агрегации в фасеты и подставляет названия тегов и контрагентов
+func (s *documentService) buildFacets(ctx context.Context, companyID uuid.UUID, aggregations map[string][]search.Bucket) (map[string][]service.FacetBucket, error) {
+	labels := map[string]map[string]string{
+		service.FacetTags:    {},
+		service.FacetSenders: {},
+	}
+	if len(aggregations[service.FacetTags]) > 0 {
+		tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags: %w", err)
+		}
+		tree := tagging.NewTree(tags)
+		for _, tag := range tags {
+			labels[service.FacetTags][tag.ID.String()] = tree.Path(tag.ID)
+		}
+	}
+	if len(aggregations[service.FacetSenders]) > 0 {
+		senders, err := s.senderRepo.ListByCompany(ctx, companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get senders: %w", err)
+		}
+		for _, sender := range senders {
+			labels[service.FacetSenders][sender.ID.String()] = sender.Name
+		}
+	}
+
+	facets := make(map[string][]service.FacetBucket, len(aggregations))
+	for name, buckets := range aggregations {
+		facet := make([]service.FacetBucket, 0, len(buckets))
+		for _, bucket := range buckets {
+			label := bucket.Key
+			if names, ok := labels[name]; ok {
+				// Тег или контрагент мог быть удален до переиндексации документа
+				if label, ok = names[bucket.Key]; !ok {
+					continue
+				}
+			}
+			facet = append(facet, service.FacetBucket{
+				Key:   bucket.Key,
+				Label: label,
+				Count: bucket.Count,
+			})
+		}
+		facets[name] = facet
+	}
+	return facets, nil
+}
+
+// searchSorts - поддерживаемые порядки выдачи
+var searchSorts = map[string]bool{
+	service.SearchSortRelevance:   true,
+	service.SearchSortCreatedDesc: true,
+	service.SearchSortCreatedAsc:  true,
+	service.SearchSortUpdatedDesc: true,
+	service.SearchSortUpdatedAsc:  true,
+	service.SearchSortSizeDesc:    true,
+	service.SearchSortSizeAsc:     true,
+}
+
+// searchSort определяет порядок выдачи
+// Без запроса релевантность не вычисляется, поэтому по умолчанию сначала идут новые документы
+func (s *documentService) searchSort(ctx context.Context, input service.DocumentSearchInput) (string, *search.FieldSort, error) {
+	if key, desc, ok := service.ParseFieldSort(input.Sort); ok {
+		value, err := s.fieldSortValue(ctx, input.CompanyID, key)
+		if err != nil {
+			return "", nil, err
+		}
+		return service.SearchSortField, &search.FieldSort{Key: key, Value: value, Desc: desc}, nil
+	}
+
+	order := input.Sort
+	if order == "" {
+		order = service.SearchSortCreatedDesc
+		if input.Query != "" {
+			order = service.SearchSortRelevance
+		}
+	}
+	if !searchSorts[order] {
+		return "", nil, fmt.Errorf("%w: %q", service.ErrInvalidSearchSort, input.Sort)
+	}
+	if order == service.SearchSortRelevance && input.Query == "" {
+		order = service.SearchSortCreatedDesc
+	}
+	return order, nil, nil
+}
+
+// fieldSortValue определяет по схемам типов компании, какая часть значения поля сортируется
+// Поле с одним ключом в разных типах должно сортироваться одинаково
+func (s *documentService) fieldSortValue(ctx context.Context, companyID uuid.UUID, key string) (string, error) {
+	documentTypes, err := s.documentTypeRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get document types: %w", err)
+	}
+
+	value := ""
+	for _, documentType := range documentTypes {
+		for _, field := range documentType.Fields {
+			if field.Key != key {
+				continue
+			}
+			fieldValue := search.FieldSortKeyword
+			switch field.Type {
+			case types.FieldTypeNumber, types.FieldTypeMoney:
+				fieldValue = search.FieldSortNumber
+			case types.FieldTypeDate:
+				fieldValue = search.FieldSortDate
+			}
+			if value != "" && value != fieldValue {
+				return "", fmt.Errorf("%w: field %q has different types in document types", service.ErrInvalidSearchSort, key)
+			}
+			value = fieldValue
+		}
+	}
+	if value == "" {
+		return "", fmt.Errorf("%w: unknown field %q", service.ErrInvalidSearchSort, key)
+	}
+	return value, nil
+}
+
+// withTags дополняет документы тегами и ссылками на скачивание и preview
+// Теги всех документов загружаются двумя запросами, ссылки подписываются без повторного чтения документов
+func (s *documentService) withTags(ctx context.Context, documents []*ent.Document) (map[uuid.UUID]*service.DocumentWithTags, error) {
+	ids := make([]uuid.UUID, 0, len(documents))
+	for _, doc := range documents {
+		ids = append(ids, doc.ID)
+	}
+	docTags, err := s.documentTagRepo.ListByDocuments(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document tags: %w", err)
+	}
+
+	tagIDs := make([]uuid.UUID, 0, len(docTags))
+	seen := make(map[uuid.UUID]bool, len(docTags))
+	for _, dt := range docTags {
+		if !seen[dt.TagID] {
+			seen[dt.TagID] = true
+			tagIDs = append(tagIDs, dt.TagID)
+		}
+	}
+	tags, err := s.tagRepo.ListByIDs(ctx, tagIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]*ent.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByID[tag.ID] = tag
+	}
+
+	result := make(map[uuid.UUID]*service.DocumentWithTags, len(documents))
+	for _, doc := range documents {
+		previewURL, _ := s.previewURL(ctx, doc)
+		downloadURL, _ := s.downloadURL(ctx, doc)
+		result[doc.ID] = &service.DocumentWithTags{
+			Document:    doc,
+			Tags:        []*ent.Tag{},
+			PreviewURL:  previewURL,
+			DownloadURL: downloadURL,
+		}
+	}
+	for _, dt := range docTags {
+		if tag, ok := tagsByID[dt.TagID]; ok {
+			result[dt.DocumentID].Tags = append(result[dt.DocumentID].Tags, tag)
+		}
+	}
+	return result, nil
+}
+
+// encodeCursor упаковывает состояние выдачи в непрозрачную строку
+func encodeCursor(cursor searchCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor распаковывает курсор, полученный от клиента
+func decodeCursor(value string, cursor *searchCursor) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return fmt.Errorf("invalid cursor")
+	}
+	return nil
+}
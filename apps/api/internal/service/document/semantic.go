@@ -0,0 +1,126 @@
+package document
+
+import (
+	"context"
+	"strings"
+
+	"techmind/internal/search"
+	"techmind/internal/service"
+)
+
+// applyQuery добавляет в запрос к индексу условия из текстового запроса в выбранном режиме
+// В семантическом и гибридном режимах условия по полям (tag:, sender:, created: и т.д.) остаются
+// обязательными, а по смыслу сравнивается только свободный текст запроса
+func (s *documentService) applyQuery(ctx context.Context, input service.DocumentSearchInput, query *search.Query) error {
+	switch input.Mode {
+	case "", service.SearchModeKeyword:
+		// Полнотекстовое условие из разобранного запроса, см. синтаксис в query.go
+		condition, err := s.buildTextQuery(ctx, input.CompanyID, input.Query)
+		if err != nil {
+			return err
+		}
+		query.Condition = condition
+		return nil
+	case service.SearchModeSemantic, service.SearchModeHybrid:
+	default:
+		return service.ErrInvalidSearchMode
+	}
+
+	if !s.embedder.Enabled() {
+		return service.ErrSemanticSearchDisabled
+	}
+
+	node, err := parseQuery(input.Query)
+	if err != nil {
+		return err
+	}
+	text, rest := splitSemanticQuery(node)
+
+	b := &queryBuilder{ctx: ctx, service: s, companyID: input.CompanyID}
+	if len(rest) > 0 {
+		condition, err := b.build(&boolNode{Children: rest})
+		if err != nil {
+			return err
+		}
+		query.Condition = condition
+	}
+
+	// В запросе только условия по полям - сравнивать по смыслу нечего
+	if len(text) == 0 {
+		return nil
+	}
+
+	query.Vector, err = s.embedder.QueryVector(ctx, semanticText(text))
+	if err != nil {
+		return err
+	}
+
+	if input.Mode == service.SearchModeHybrid {
+		// Документ подходит по ключевым словам или по смыслу, оценки складываются
+		query.Text, err = b.build(&boolNode{Children: text})
+		if err != nil {
+			return err
+		}
+		query.VectorWeight = s.embedder.Weight()
+	}
+	return nil
+}
+
+// splitSemanticQuery отделяет свободный текст запроса от остальных условий
+// Свободный текст - слова и фразы без поля, в том числе объединенные через OR
+func splitSemanticQuery(node queryNode) ([]queryNode, []queryNode) {
+	if isFreeText(node) {
+		return []queryNode{node}, nil
+	}
+
+	n, ok := node.(*boolNode)
+	if !ok || n.Or {
+		return nil, []queryNode{node}
+	}
+
+	var text, rest []queryNode
+	for _, child := range n.Children {
+		if isFreeText(child) {
+			text = append(text, child)
+		} else {
+			rest = append(rest, child)
+		}
+	}
+	return text, rest
+}
+
+// isFreeText проверяет, что условие состоит только из слов и фраз без поля
+func isFreeText(node queryNode) bool {
+	switch n := node.(type) {
+	case *termNode:
+		return n.Field == ""
+	case *boolNode:
+		for _, child := range n.Children {
+			if !isFreeText(child) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// semanticText собирает слова свободного текста в строку для вычисления вектора запроса
+func semanticText(nodes []queryNode) string {
+	var words []string
+	var collect func(node queryNode)
+	collect = func(node queryNode) {
+		switch n := node.(type) {
+		case *termNode:
+			words = append(words, strings.Trim(n.Value, "*?"))
+		case *boolNode:
+			for _, child := range n.Children {
+				collect(child)
+			}
+		}
+	}
+	for _, node := range nodes {
+		collect(node)
+	}
+	return strings.Join(words, " ")
+}
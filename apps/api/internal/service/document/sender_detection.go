@@ -0,0 +1,186 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/internal/counterparty"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// DetectSender ищет контрагентов в тексте и метаданных PDF документа
+// Уверенно найденный известный контрагент присваивается документу без контрагента,
+// организации, которых нет в справочнике, сохраняются как предложения для пользователя
+func (s *documentService) DetectSender(ctx context.Context, documentID uuid.UUID) (*types.SenderDetection, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
+	// Документ без текста проверяется только по метаданным
+	text := ""
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, documentID)
+	switch {
+	case err == nil:
+		text = content.Text
+	case !ent.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get document content: %w", err)
+	}
+
+	var metadata []string
+	if pdf := document.Metadata.PDF; pdf != nil {
+		metadata = append(metadata, pdf.Author, pdf.Title, pdf.Subject)
+	}
+
+	company, err := s.companyRepo.GetByID(ctx, document.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("company not found: %w", err)
+	}
+
+	senders, err := s.senderRepo.ListByCompany(ctx, document.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get senders by company: %w", err)
+	}
+	known := make([]counterparty.Known, 0, len(senders))
+	for _, sender := range senders {
+		known = append(known, counterparty.Known{ID: sender.ID, Card: counterparty.SenderCard(sender)})
+	}
+
+	signals := counterparty.Extract(text, metadata...)
+	detections := counterparty.Detect(signals, known)
+
+	detection := &types.SenderDetection{
+		Candidates: make([]types.SenderCandidate, 0, len(detections)),
+		Proposals:  make([]types.SenderProposal, 0),
+		DetectedAt: time.Now(),
+	}
+	for _, d := range detections {
+		detection.Candidates = append(detection.Candidates, types.SenderCandidate{
+			SenderID:   d.ID,
+			Confidence: d.Confidence,
+			Reasons:    d.Reasons,
+		})
+	}
+	for _, card := range counterparty.Propose(signals, counterparty.CompanyCard(company), known, detections) {
+		detection.Proposals = append(detection.Proposals, types.SenderProposal{
+			Name:      card.Name,
+			LegalName: card.LegalName,
+			INN:       card.INN,
+			KPP:       card.KPP,
+			Emails:    card.Emails,
+			Website:   card.Website,
+		})
+	}
+
+	// Контрагент, выбранный пользователем при загрузке или позже, не заменяется
+	if best, ok := counterparty.Best(detections); ok && document.SenderID == nil {
+		attached, err := s.documentRepo.AttachSender(ctx, documentID, best.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to attach sender: %w", err)
+		}
+		if attached {
+			detection.SenderID = &best.ID
+			detection.Confidence = best.Confidence
+		}
+	}
+
+	if err := s.documentRepo.MergeMetadata(ctx, documentID, types.DocumentMetadata{SenderDetection: detection}); err != nil {
+		return nil, fmt.Errorf("failed to save sender detection: %w", err)
+	}
+	return detection, nil
+}
+
+func (s *documentService) GetSenderDetection(ctx context.Context, documentID uuid.UUID) (*types.SenderDetection, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+	if document.Metadata.SenderDetection == nil {
+		return nil, service.ErrSenderDetectionNotFound
+	}
+	return document.Metadata.SenderDetection, nil
+}
+
+func (s *documentService) ConfirmSenderProposal(ctx context.Context, documentID uuid.UUID, index int, force bool) (*ent.Sender, []service.SenderDuplicate, error) {
+	document, detection, err := s.senderProposal(ctx, documentID, index)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	proposal := detection.Proposals[index]
+	input := service.SenderInput{
+		Name:      proposal.Name,
+		LegalName: optional(proposal.LegalName),
+		INN:       optional(proposal.INN),
+		KPP:       optional(proposal.KPP),
+		Website:   optional(proposal.Website),
+	}
+	if len(proposal.Emails) > 0 {
+		input.Email = &proposal.Emails[0]
+		input.Emails = proposal.Emails[1:]
+	}
+
+	sender, duplicates, err := s.senderService.Create(ctx, document.CompanyID, input, force)
+	if err != nil {
+		return nil, duplicates, err
+	}
+
+	if document.SenderID == nil {
+		if _, err := s.documentRepo.AttachSender(ctx, documentID, sender.ID); err != nil {
+			return nil, nil, fmt.Errorf("failed to attach sender: %w", err)
+		}
+	}
+
+	if err := s.removeSenderProposal(ctx, documentID, detection, index); err != nil {
+		return nil, nil, err
+	}
+	return sender, nil, nil
+}
+
+func (s *documentService) DismissSenderProposal(ctx context.Context, documentID uuid.UUID, index int) error {
+	_, detection, err := s.senderProposal(ctx, documentID, index)
+	if err != nil {
+		return err
+	}
+	return s.removeSenderProposal(ctx, documentID, detection, index)
+}
+
+// senderProposal получает документ и результат определения контрагента с предложением index
+func (s *documentService) senderProposal(ctx context.Context, documentID uuid.UUID, index int) (*ent.Document, *types.SenderDetection, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("document not found: %w", err)
+	}
+	detection := document.Metadata.SenderDetection
+	if detection == nil || index < 0 || index >= len(detection.Proposals) {
+		return nil, nil, service.ErrSenderProposalNotFound
+	}
+	return document, detection, nil
+}
+
+func (s *documentService) removeSenderProposal(ctx context.Context, documentID uuid.UUID, detection *types.SenderDetection, index int) error {
+	detection.Proposals = append(detection.Proposals[:index:index], detection.Proposals[index+1:]...)
+	if err := s.documentRepo.MergeMetadata(ctx, documentID, types.DocumentMetadata{SenderDetection: detection}); err != nil {
+		return fmt.Errorf("failed to save sender detection: %w", err)
+	}
+	return nil
+}
+
+// detectSender определяет контрагента в фоновой обработке документа, ошибка только логируется
+func (s *documentService) detectSender(ctx context.Context, documentID uuid.UUID) {
+	if _, err := s.DetectSender(ctx, documentID); err != nil {
+		fmt.Printf("Failed to detect sender for document %s: %v\n", documentID, err)
+	}
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,117 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultSuggestLimit - число подсказок каждого вида по умолчанию
+	defaultSuggestLimit = 5
+	// maxSuggestLimit - максимальное число подсказок каждого вида
+	maxSuggestLimit = 20
+)
+
+func (s *documentService) Suggest(ctx context.Context, companyID uuid.UUID, prefix string, limit int) (*service.SearchSuggestions, error) {
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	suggestions := &service.SearchSuggestions{
+		Documents: []service.DocumentSuggestion{},
+		Tags:      []*ent.Tag{},
+		Senders:   []*ent.Sender{},
+	}
+
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return suggestions, nil
+	}
+
+	documents, err := s.index.Suggest(ctx, companyID, prefix, limit)
+	if err != nil {
+		return nil, fmt.Errorf("search suggest failed: %w", err)
+	}
+	suggestions.Documents = documents
+
+	tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	suggestions.Tags = matchSuggestions(tags, prefix, limit, func(tag *ent.Tag) string { return tag.Name })
+
+	senders, err := s.senderRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get senders: %w", err)
+	}
+	suggestions.Senders = matchSuggestions(senders, prefix, limit, func(sender *ent.Sender) string { return sender.Name })
+
+	return suggestions, nil
+}
+
+// didYouMean предлагает исправление опечаток в запросе из обычных слов
+// Исправление ищет индекс по документам компании, поэтому оно не предлагает слова,
+// которые встречаются только в документах других компаний
+func (s *documentService) didYouMean(ctx context.Context, companyID uuid.UUID, query string) (string, error) {
+	// Запросы с операторами и полями пользователь составил осознанно, их не исправляем
+	node, err := parseQuery(query)
+	if err != nil {
+		return "", nil
+	}
+	term, ok := node.(*termNode)
+	if !ok || term.Field != "" || term.Phrase || term.Wildcard || term.Fuzziness != "" {
+		return "", nil
+	}
+
+	return s.index.DidYouMean(ctx, companyID, term.Value)
+}
+
+// matchSuggestions отбирает элементы, название которых содержит введенный текст
+// Сначала идут названия, начинающиеся с текста, затем остальные по алфавиту
+func matchSuggestions[T any](items []T, prefix string, limit int, name func(T) string) []T {
+	prefix = strings.ToLower(prefix)
+
+	type candidate struct {
+		item   T
+		name   string
+		prefix bool
+	}
+	var candidates []candidate
+	for _, item := range items {
+		lower := strings.ToLower(name(item))
+		if !strings.Contains(lower, prefix) {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			item:   item,
+			name:   lower,
+			prefix: strings.HasPrefix(lower, prefix),
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].prefix != candidates[j].prefix {
+			return candidates[i].prefix
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	result := make([]T, 0, limit)
+	for _, c := range candidates {
+		if len(result) == limit {
+			break
+		}
+		result = append(result, c.item)
+	}
+	return result
+}
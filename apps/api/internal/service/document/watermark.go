@@ -0,0 +1,217 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"techmind/internal/service"
+	"techmind/pkg/gotenberg"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+// watermarkPrefix - копии с водяным знаком хранятся отдельно от производных копий документа,
+// правило жизненного цикла бакета удаляет их через сутки
+const watermarkPrefix = "watermarks"
+
+// Шаблоны и параметры по умолчанию, если компания не настроила водяной знак
+const (
+	defaultWatermarkTemplate = "CONFIDENTIAL – {{.UserEmail}} – {{.Timestamp}}"
+	defaultStampTemplate     = "{{.DocumentID}} | %p / %P"
+)
+
+var (
+	defaultWatermarkOptions = map[string]interface{}{
+		"opacity":  0.2,
+		"rotation": 45,
+		"points":   48,
+		"color":    "#808080",
+	}
+	defaultStampOptions = map[string]interface{}{
+		"position": "bc",
+		"offset":   "0 10",
+		"points":   9,
+		"rotation": 0,
+	}
+)
+
+// watermarkData - значения, доступные в шаблонах водяного знака и штампа
+type watermarkData struct {
+	UserEmail    string
+	UserName     string
+	DocumentID   string
+	DocumentName string
+	CompanyName  string
+	// Timestamp округляется до минуты, чтобы повторные скачивания попадали в кэш
+	Timestamp string
+}
+
+// GetWatermarkedDownloadURL накладывает на PDF копию документа водяной знак и штамп
+// и возвращает временную ссылку на результат
+func (s *documentService) GetWatermarkedDownloadURL(ctx context.Context, documentID, userID uuid.UUID, input service.WatermarkInput) (string, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return "", fmt.Errorf("document not found: %w", err)
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("user not found: %w", err)
+	}
+
+	company, err := s.companyRepo.GetByID(ctx, document.CompanyID)
+	if err != nil {
+		return "", fmt.Errorf("company not found: %w", err)
+	}
+
+	settings := resolveWatermarkSettings(company.Settings.Watermark, input)
+	data := watermarkData{
+		UserEmail:    user.Email,
+		UserName:     user.Name,
+		DocumentID:   document.ID.String(),
+		DocumentName: document.Name,
+		CompanyName:  company.Name,
+		Timestamp:    time.Now().UTC().Format("2006-01-02 15:04 UTC"),
+	}
+
+	watermarkText, err := renderWatermarkTemplate(settings.Template, data)
+	if err != nil {
+		return "", fmt.Errorf("invalid watermark template: %w", err)
+	}
+	stampText, err := renderWatermarkTemplate(settings.StampTemplate, data)
+	if err != nil {
+		return "", fmt.Errorf("invalid stamp template: %w", err)
+	}
+	if watermarkText == "" && stampText == "" {
+		return "", fmt.Errorf("neither watermark nor stamp is configured")
+	}
+
+	// Копия с одинаковым текстом и параметрами уже могла быть построена ранее
+	objectName := fmt.Sprintf("%s/%s/%s/%s.pdf", watermarkPrefix, document.CompanyID.String(), document.ID.String(),
+		watermarkCacheKey(document, settings, watermarkText, stampText))
+	if _, err := s.minioClient.StatObject(ctx, s.bucketName, objectName, minio.StatObjectOptions{}); err != nil {
+		content, err := s.loadPDFContent(ctx, document)
+		if err != nil {
+			return "", err
+		}
+
+		if watermarkText != "" {
+			res, err := s.gotenbergClient.WatermarkPDFs(ctx, []gotenberg.File{{Name: "document.pdf", Content: content}}, &gotenberg.OverlayRequest{
+				Source:     "text",
+				Expression: watermarkText,
+				Options:    settings.Options,
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to apply watermark: %w", err)
+			}
+			content = res.Body
+		}
+
+		if stampText != "" {
+			res, err := s.gotenbergClient.StampPDFs(ctx, []gotenberg.File{{Name: "document.pdf", Content: content}}, &gotenberg.OverlayRequest{
+				Source:     "text",
+				Expression: stampText,
+				Pages:      settings.StampPages,
+				Options:    settings.StampOptions,
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to apply stamp: %w", err)
+			}
+			content = res.Body
+		}
+
+		_, err = s.minioClient.PutObject(ctx, s.bucketName, objectName, bytes.NewReader(content), int64(len(content)), minio.PutObjectOptions{
+			ContentType: "application/pdf",
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to upload watermarked copy to minio: %w", err)
+		}
+	}
+
+	url, err := s.minioClient.PresignedGetObject(ctx, s.bucketName, objectName, 1*time.Hour, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate download url: %w", err)
+	}
+
+	return url.String(), nil
+}
+
+// loadPDFContent возвращает PDF документа: оригинал для PDF, иначе сгенерированный preview
+func (s *documentService) loadPDFContent(ctx context.Context, document *ent.Document) ([]byte, error) {
+	if strings.EqualFold(document.MimeType, "application/pdf") {
+		return s.readObject(ctx, document.FilePath)
+	}
+	if document.PreviewFilePath != nil && strings.HasSuffix(strings.ToLower(*document.PreviewFilePath), ".pdf") {
+		return s.readObject(ctx, *document.PreviewFilePath)
+	}
+	return nil, fmt.Errorf("document type %s has no PDF rendition", document.MimeType)
+}
+
+// resolveWatermarkSettings объединяет настройки компании, параметры запроса и значения по умолчанию
+func resolveWatermarkSettings(company *types.WatermarkSettings, input service.WatermarkInput) types.WatermarkSettings {
+	settings := types.WatermarkSettings{
+		Template:      defaultWatermarkTemplate,
+		Options:       defaultWatermarkOptions,
+		StampTemplate: defaultStampTemplate,
+		StampOptions:  defaultStampOptions,
+	}
+	if company != nil {
+		settings.Template = company.Template
+		settings.StampTemplate = company.StampTemplate
+		settings.StampPages = company.StampPages
+		if len(company.Options) > 0 {
+			settings.Options = company.Options
+		}
+		if len(company.StampOptions) > 0 {
+			settings.StampOptions = company.StampOptions
+		}
+	}
+
+	if input.Template != "" {
+		settings.Template = input.Template
+	}
+	if input.StampTemplate != "" {
+		settings.StampTemplate = input.StampTemplate
+	}
+	if input.StampPages != "" {
+		settings.StampPages = input.StampPages
+	}
+	return settings
+}
+
+// renderWatermarkTemplate подставляет данные в шаблон, пустой шаблон дает пустой текст
+func renderWatermarkTemplate(text string, data watermarkData) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return "", nil
+	}
+	tmpl, err := template.New("watermark").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// watermarkCacheKey строит ключ кэша по содержимому документа и итоговым параметрам наложения
+func watermarkCacheKey(document *ent.Document, settings types.WatermarkSettings, watermarkText, stampText string) string {
+	options, _ := json.Marshal([]interface{}{settings.Options, settings.StampOptions})
+	hash := sha256.New()
+	for _, part := range []string{document.Checksum, watermarkText, stampText, settings.StampPages, string(options)} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:32]
+}
@@ -0,0 +1,231 @@
+package documentclassifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"techmind/internal/classifier"
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxTrainingDocuments - модель обучается на стольких последних документах компании
+	maxTrainingDocuments = 5000
+	// maxPredictions - число типов в сохраненном предсказании
+	maxPredictions = 5
+)
+
+type classifierService struct {
+	repo                repo.DocumentClassifierRepository
+	documentRepo        repo.DocumentRepository
+	documentContentRepo repo.DocumentContentRepository
+	documentTypeRepo    repo.DocumentTypeRepository
+	tagRepo             repo.TagRepository
+	documentTagRepo     repo.DocumentTagRepository
+}
+
+func NewService(
+	repo repo.DocumentClassifierRepository,
+	documentRepo repo.DocumentRepository,
+	documentContentRepo repo.DocumentContentRepository,
+	documentTypeRepo repo.DocumentTypeRepository,
+	tagRepo repo.TagRepository,
+	documentTagRepo repo.DocumentTagRepository,
+) service.ClassifierService {
+	return &classifierService{
+		repo:                repo,
+		documentRepo:        documentRepo,
+		documentContentRepo: documentContentRepo,
+		documentTypeRepo:    documentTypeRepo,
+		tagRepo:             tagRepo,
+		documentTagRepo:     documentTagRepo,
+	}
+}
+
+func (s *classifierService) Train(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error) {
+	tagged, err := s.taggedDocuments(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	taggedIDs := make([]uuid.UUID, 0, len(tagged))
+	for documentID := range tagged {
+		taggedIDs = append(taggedIDs, documentID)
+	}
+
+	documents, err := s.documentRepo.ListClassifierExamples(ctx, companyID, taggedIDs, maxTrainingDocuments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training documents: %w", err)
+	}
+
+	examples := make([]classifier.Example, 0, len(documents))
+	for _, document := range documents {
+		if document.Edges.Content == nil {
+			continue
+		}
+		typeID, ok := tagged[document.ID]
+		if document.TypeID != nil && !appliedByClassifier(document) {
+			typeID, ok = *document.TypeID, true
+		}
+		if ok {
+			examples = append(examples, classifier.Example{TypeID: typeID, Text: document.Edges.Content.Text})
+		}
+	}
+
+	evaluation, err := classifier.Evaluate(examples)
+	if errors.Is(err, classifier.ErrNotEnoughData) {
+		return nil, fmt.Errorf("%w: %v", service.ErrNotEnoughTrainingData, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate classifier: %w", err)
+	}
+	model, trained, err := classifier.Train(examples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to train classifier: %w", err)
+	}
+
+	documentClassifier, err := s.repo.Save(ctx, companyID, model, evaluation, trained)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save classifier: %w", err)
+	}
+	return documentClassifier, nil
+}
+
+func (s *classifierService) GetByCompany(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error) {
+	documentClassifier, err := s.repo.GetByCompany(ctx, companyID)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrClassifierNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get classifier: %w", err)
+	}
+	return documentClassifier, nil
+}
+
+func (s *classifierService) Classify(ctx context.Context, documentID uuid.UUID) (*types.DocumentClassification, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+	documentClassifier, err := s.GetByCompany(ctx, document.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, documentID)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrDocumentTextNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document content: %w", err)
+	}
+
+	// Типы, удаленные после обучения модели, не предлагаются
+	documentTypes, err := s.documentTypeRepo.ListByCompany(ctx, document.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document types: %w", err)
+	}
+	exists := make(map[uuid.UUID]bool, len(documentTypes))
+	for _, documentType := range documentTypes {
+		exists[documentType.ID] = true
+	}
+
+	classification := &types.DocumentClassification{
+		Predictions:  make([]types.ClassPrediction, 0, maxPredictions),
+		TrainedAt:    documentClassifier.TrainedAt,
+		ClassifiedAt: time.Now(),
+	}
+	for _, prediction := range classifier.Predict(documentClassifier.Model, content.Text) {
+		if exists[prediction.TypeID] && len(classification.Predictions) < maxPredictions {
+			classification.Predictions = append(classification.Predictions, prediction)
+		}
+	}
+
+	// Отметка о присвоенном классификатором типе сохраняется при повторной классификации,
+	// чтобы такой документ и дальше не попадал в обучение
+	if appliedByClassifier(document) {
+		classification.AppliedTypeID = document.TypeID
+	}
+	if document.TypeID == nil && len(classification.Predictions) > 0 {
+		best := classification.Predictions[0]
+		if best.Confidence >= classifier.ApplyThreshold {
+			attached, err := s.documentRepo.AttachType(ctx, documentID, best.TypeID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to attach document type: %w", err)
+			}
+			if attached {
+				classification.AppliedTypeID = &best.TypeID
+			}
+		}
+	}
+
+	if err := s.documentRepo.MergeMetadata(ctx, documentID, types.DocumentMetadata{Classification: classification}); err != nil {
+		return nil, fmt.Errorf("failed to save document classification: %w", err)
+	}
+	return classification, nil
+}
+
+func (s *classifierService) GetClassification(ctx context.Context, documentID uuid.UUID) (*types.DocumentClassification, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+	if document.Metadata.Classification == nil {
+		return nil, service.ErrClassificationNotFound
+	}
+	return document.Metadata.Classification, nil
+}
+
+// taggedDocuments сопоставляет документам типы по тегам, название которых совпадает с названием типа
+// Документы с тегами разных типов пропускаются
+func (s *classifierService) taggedDocuments(ctx context.Context, companyID uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	documentTypes, err := s.documentTypeRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document types: %w", err)
+	}
+	byName := make(map[string]uuid.UUID, len(documentTypes))
+	for _, documentType := range documentTypes {
+		byName[strings.ToLower(strings.TrimSpace(documentType.Name))] = documentType.ID
+	}
+
+	tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags by company: %w", err)
+	}
+
+	tagged := make(map[uuid.UUID]uuid.UUID)
+	ambiguous := make(map[uuid.UUID]bool)
+	for _, tag := range tags {
+		typeID, ok := byName[strings.ToLower(strings.TrimSpace(tag.Name))]
+		if !ok {
+			continue
+		}
+		links, err := s.documentTagRepo.ListByTag(ctx, tag.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get documents by tag: %w", err)
+		}
+		for _, link := range links {
+			if current, ok := tagged[link.DocumentID]; ok && current != typeID {
+				ambiguous[link.DocumentID] = true
+			}
+			tagged[link.DocumentID] = typeID
+		}
+	}
+	for documentID := range ambiguous {
+		delete(tagged, documentID)
+	}
+	return tagged, nil
+}
+
+// appliedByClassifier проверяет, что текущий тип документа присвоен классификатором
+func appliedByClassifier(document *ent.Document) bool {
+	classification := document.Metadata.Classification
+	return classification != nil && classification.AppliedTypeID != nil &&
+		document.TypeID != nil && *classification.AppliedTypeID == *document.TypeID
+}
@@ -0,0 +1,126 @@
+package documenttag
+
+import (
+	"context"
+	"fmt"
+
+	"techmind/internal/service"
+
+	"github.com/google/uuid"
+)
+
+// maxBulkDocuments - ограничение на число документов в одной массовой операции
+const maxBulkDocuments = 5000
+
+func (s *documentTagService) BulkTag(ctx context.Context, input service.BulkTagInput) (*service.BulkTagResult, error) {
+	if len(input.Add) == 0 && len(input.Remove) == 0 {
+		return nil, fmt.Errorf("%w: no tags to add or remove", service.ErrInvalidBulkTag)
+	}
+	if len(input.DocumentIDs) == 0 && input.Search == nil {
+		return nil, fmt.Errorf("%w: document_ids or search is required", service.ErrInvalidBulkTag)
+	}
+	if err := s.checkCompanyTags(ctx, input.CompanyID, append(append([]uuid.UUID{}, input.Add...), input.Remove...)); err != nil {
+		return nil, err
+	}
+
+	documentIDs := unique(input.DocumentIDs)
+	if len(documentIDs) == 0 {
+		search := *input.Search
+		search.CompanyID = input.CompanyID
+		ids, err := s.documentService.SearchIDs(ctx, search, maxBulkDocuments+1)
+		if err != nil {
+			return nil, err
+		}
+		documentIDs = ids
+	}
+	if len(documentIDs) > maxBulkDocuments {
+		return nil, fmt.Errorf("%w: at most %d documents per request, narrow the search", service.ErrTooManyDocuments, maxBulkDocuments)
+	}
+
+	documents, err := s.documentRepo.ListByIDs(ctx, documentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	result := &service.BulkTagResult{}
+	for _, document := range documents {
+		if document.CompanyID != input.CompanyID {
+			continue
+		}
+		result.Documents++
+
+		links, err := s.documentTagRepo.ListByDocument(ctx, document.ID)
+		if err != nil {
+			return result, fmt.Errorf("failed to get document tags: %w", err)
+		}
+		assigned := make(map[uuid.UUID]bool, len(links))
+		for _, link := range links {
+			assigned[link.TagID] = true
+		}
+
+		for _, tagID := range input.Remove {
+			if !assigned[tagID] {
+				continue
+			}
+			deleted, err := s.removeTag(ctx, input.UserID, document.ID, tagID, false)
+			if err != nil {
+				return result, fmt.Errorf("failed to remove tag from document %s: %w", document.ID, err)
+			}
+			assigned[tagID] = false
+			if deleted {
+				result.Removed++
+			}
+		}
+
+		for _, tagID := range input.Add {
+			if assigned[tagID] {
+				continue
+			}
+			removed, err := s.documentTagRepo.Assign(ctx, document.ID, tagID, manualAssignment(input.UserID))
+			if err != nil {
+				return result, fmt.Errorf("failed to add tag to document %s: %w", document.ID, err)
+			}
+			assigned[tagID] = true
+			result.Added++
+			// Теги исключающей группы, снятые при присвоении
+			for _, removedID := range removed {
+				assigned[removedID] = false
+				result.Removed++
+			}
+		}
+	}
+	result.Skipped = len(documentIDs) - result.Documents
+
+	return result, nil
+}
+
+// checkCompanyTags проверяет, что все теги существуют и принадлежат компании
+func (s *documentTagService) checkCompanyTags(ctx context.Context, companyID uuid.UUID, tagIDs []uuid.UUID) error {
+	tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return fmt.Errorf("failed to get tags by company: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(tags))
+	for _, tag := range tags {
+		known[tag.ID] = true
+	}
+	for _, tagID := range tagIDs {
+		if !known[tagID] {
+			return fmt.Errorf("%w: tag %s not found in company", service.ErrInvalidBulkTag, tagID)
+		}
+	}
+	return nil
+}
+
+// unique убирает повторяющиеся ID с сохранением порядка
+func unique(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -3,32 +3,46 @@ package documenttag
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 	"techmind/internal/repo"
 	"techmind/internal/service"
+	"techmind/internal/tagging"
 	"techmind/schema/ent"
+	"techmind/schema/ent/documenttag"
 
 	"github.com/google/uuid"
 )
 
+// colorPattern - допустимый формат цвета тега
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
 type documentTagService struct {
 	documentTagRepo repo.DocumentTagRepository
 	tagRepo         repo.TagRepository
+	tagGroupRepo    repo.TagGroupRepository
 	documentRepo    repo.DocumentRepository
+	documentService service.DocumentService
 }
 
 func NewService(
 	documentTagRepo repo.DocumentTagRepository,
 	tagRepo repo.TagRepository,
+	tagGroupRepo repo.TagGroupRepository,
 	documentRepo repo.DocumentRepository,
+	documentService service.DocumentService,
 ) service.DocumentTagService {
 	return &documentTagService{
 		documentTagRepo: documentTagRepo,
 		tagRepo:         tagRepo,
+		tagGroupRepo:    tagGroupRepo,
 		documentRepo:    documentRepo,
+		documentService: documentService,
 	}
 }
 
-func (s *documentTagService) GetDocumentTags(ctx context.Context, documentID uuid.UUID) ([]*ent.Tag, error) {
+func (s *documentTagService) GetDocumentTags(ctx context.Context, documentID uuid.UUID) ([]service.AssignedTag, error) {
 	// Проверяем что документ существует
 	_, err := s.documentRepo.GetByID(ctx, documentID)
 	if err != nil {
@@ -42,19 +56,19 @@ func (s *documentTagService) GetDocumentTags(ctx context.Context, documentID uui
 	}
 
 	// Получаем полные объекты тегов
-	tags := make([]*ent.Tag, 0, len(docTags))
+	tags := make([]service.AssignedTag, 0, len(docTags))
 	for _, dt := range docTags {
 		tag, err := s.tagRepo.GetByID(ctx, dt.TagID)
 		if err != nil {
 			continue // Пропускаем удаленные теги
 		}
-		tags = append(tags, tag)
+		tags = append(tags, service.AssignedTag{Tag: tag, Link: dt})
 	}
 
 	return tags, nil
 }
 
-func (s *documentTagService) AddTagToDocument(ctx context.Context, documentID, tagID uuid.UUID) error {
+func (s *documentTagService) AddTagToDocument(ctx context.Context, userID, documentID, tagID uuid.UUID) error {
 	// Проверяем что документ существует
 	document, err := s.documentRepo.GetByID(ctx, documentID)
 	if err != nil {
@@ -72,18 +86,8 @@ func (s *documentTagService) AddTagToDocument(ctx context.Context, documentID, t
 		return fmt.Errorf("tag belongs to different company")
 	}
 
-	// Проверяем что связь еще не существует
-	existingTags, err := s.documentTagRepo.ListByDocument(ctx, documentID)
-	if err == nil {
-		for _, dt := range existingTags {
-			if dt.TagID == tagID {
-				return nil // Связь уже существует, не ошибка
-			}
-		}
-	}
-
-	// Создаем связь
-	_, err = s.documentTagRepo.Create(ctx, documentID, tagID)
+	// Создаем связь, если ее еще нет, и снимаем остальные теги исключающей группы
+	_, err = s.documentTagRepo.Assign(ctx, documentID, tagID, manualAssignment(userID))
 	if err != nil {
 		return fmt.Errorf("failed to add tag to document: %w", err)
 	}
@@ -91,25 +95,42 @@ func (s *documentTagService) AddTagToDocument(ctx context.Context, documentID, t
 	return nil
 }
 
-func (s *documentTagService) RemoveTagFromDocument(ctx context.Context, documentID, tagID uuid.UUID) error {
-	// Удаляем связь
-	err := s.documentTagRepo.DeleteByDocumentAndTag(ctx, documentID, tagID)
-	if err != nil {
+func (s *documentTagService) RemoveTagFromDocument(ctx context.Context, userID, documentID, tagID uuid.UUID, suppress bool) error {
+	if _, err := s.removeTag(ctx, userID, documentID, tagID, suppress); err != nil {
 		return fmt.Errorf("failed to remove tag from document: %w", err)
 	}
 
 	return nil
 }
 
-func (s *documentTagService) CreateTag(ctx context.Context, companyID uuid.UUID, name string) (*ent.Tag, error) {
+// removeTag удаляет связь и запоминает, что пользователь снял тег, чтобы правила не вернули его
+// Запрет создается, только если связь была, или если suppress требует запретить тег заранее.
+// Возвращает, была ли удалена связь
+func (s *documentTagService) removeTag(ctx context.Context, userID, documentID, tagID uuid.UUID, suppress bool) (bool, error) {
+	return s.documentTagRepo.Remove(ctx, documentID, tagID, &userID, suppress)
+}
+
+// manualAssignment - тег, добавленный пользователем
+func manualAssignment(userID uuid.UUID) repo.TagAssignment {
+	return repo.TagAssignment{
+		Source:    documenttag.SourceManual,
+		CreatedBy: &userID,
+	}
+}
+
+func (s *documentTagService) CreateTag(ctx context.Context, companyID uuid.UUID, input service.TagInput) (*ent.Tag, error) {
 	// Проверяем что тег с таким именем не существует в компании
-	existingTag, err := s.tagRepo.GetByName(ctx, companyID, name)
+	existingTag, err := s.tagRepo.GetByName(ctx, companyID, input.Name)
 	if err == nil && existingTag != nil {
 		return existingTag, nil // Возвращаем существующий тег
 	}
 
+	if err := s.checkInput(ctx, companyID, uuid.Nil, input); err != nil {
+		return nil, err
+	}
+
 	// Создаем новый тег
-	tag, err := s.tagRepo.Create(ctx, companyID, name)
+	tag, err := s.tagRepo.Create(ctx, companyID, input.ParentID, input.GroupID, input.Name, input.Color, input.Icon, input.Description)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create tag: %w", err)
 	}
@@ -119,11 +140,16 @@ func (s *documentTagService) CreateTag(ctx context.Context, companyID uuid.UUID,
 
 func (s *documentTagService) DeleteTag(ctx context.Context, tagID uuid.UUID) error {
 	// Проверяем что тег существует
-	_, err := s.tagRepo.GetByID(ctx, tagID)
+	tag, err := s.tagRepo.GetByID(ctx, tagID)
 	if err != nil {
 		return fmt.Errorf("tag not found: %w", err)
 	}
 
+	// Дочерние теги поднимаются на уровень удаляемого тега
+	if err := s.tagRepo.ReparentChildren(ctx, tagID, tag.ParentID); err != nil {
+		return fmt.Errorf("failed to move child tags: %w", err)
+	}
+
 	// Удаляем тег (каскадно удалятся все связи с документами)
 	if err := s.tagRepo.Delete(ctx, tagID); err != nil {
 		return fmt.Errorf("failed to delete tag: %w", err)
@@ -150,18 +176,222 @@ func (s *documentTagService) GetTagByID(ctx context.Context, tagID uuid.UUID) (*
 	return tag, nil
 }
 
-func (s *documentTagService) UpdateTag(ctx context.Context, tagID uuid.UUID, name string) (*ent.Tag, error) {
+func (s *documentTagService) GetTagTree(ctx context.Context, companyID uuid.UUID) ([]*service.TagNode, error) {
+	tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags by company: %w", err)
+	}
+
+	tree := tagging.NewTree(tags)
+	return tagNodes(tree, tree.Roots(), ""), nil
+}
+
+// tagNodes строит узлы дерева для тегов одного уровня
+func tagNodes(tree *tagging.Tree, tags []*ent.Tag, parentPath string) []*service.TagNode {
+	nodes := make([]*service.TagNode, 0, len(tags))
+	for _, tag := range tags {
+		path := tag.Name
+		if parentPath != "" {
+			path = parentPath + tagging.PathSeparator + tag.Name
+		}
+		nodes = append(nodes, &service.TagNode{
+			Tag:      tag,
+			Path:     path,
+			Children: tagNodes(tree, tree.Children(tag.ID), path),
+		})
+	}
+	return nodes
+}
+
+func (s *documentTagService) UpdateTag(ctx context.Context, tagID uuid.UUID, input service.TagInput) (*ent.Tag, error) {
 	// Проверяем что тег существует
-	_, err := s.tagRepo.GetByID(ctx, tagID)
+	tag, err := s.tagRepo.GetByID(ctx, tagID)
 	if err != nil {
 		return nil, fmt.Errorf("tag not found: %w", err)
 	}
 
-	// Обновляем название тега
-	updatedTag, err := s.tagRepo.Update(ctx, tagID, name)
+	if err := s.checkInput(ctx, tag.CompanyID, tagID, input); err != nil {
+		return nil, err
+	}
+
+	// Название уникально в компании без учета регистра
+	existingTag, err := s.tagRepo.GetByName(ctx, tag.CompanyID, input.Name)
+	if err == nil && existingTag.ID != tagID {
+		return nil, fmt.Errorf("%w: %q", service.ErrTagExists, existingTag.Name)
+	}
+
+	// Обновляем тег
+	updatedTag, err := s.tagRepo.Update(ctx, tagID, input.ParentID, input.GroupID, input.Name, input.Color, input.Icon, input.Description)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update tag: %w", err)
 	}
 
 	return updatedTag, nil
 }
+
+// checkInput проверяет параметры тега компании; tagID - изменяемый тег, uuid.Nil для нового тега
+func (s *documentTagService) checkInput(ctx context.Context, companyID, tagID uuid.UUID, input service.TagInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", service.ErrInvalidTag)
+	}
+	if strings.Contains(name, tagging.PathSeparator) {
+		return fmt.Errorf("%w: name must not contain %q, use parent_id for nested tags", service.ErrInvalidTag, tagging.PathSeparator)
+	}
+	if input.Color != nil && !colorPattern.MatchString(*input.Color) {
+		return fmt.Errorf("%w: color must be in #RRGGBB format", service.ErrInvalidTag)
+	}
+
+	if input.ParentID != nil {
+		if *input.ParentID == tagID {
+			return fmt.Errorf("%w: tag cannot be its own parent", service.ErrInvalidTag)
+		}
+		tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+		if err != nil {
+			return fmt.Errorf("failed to get tags by company: %w", err)
+		}
+		tree := tagging.NewTree(tags)
+		if tree.Get(*input.ParentID) == nil {
+			return fmt.Errorf("%w: parent tag not found in company", service.ErrInvalidTag)
+		}
+		if tagID != uuid.Nil && tree.IsDescendant(*input.ParentID, tagID) {
+			return fmt.Errorf("%w: tag cannot be moved into its own subtree", service.ErrInvalidTag)
+		}
+	}
+
+	if input.GroupID != nil {
+		group, err := s.tagGroupRepo.GetByID(ctx, *input.GroupID)
+		if ent.IsNotFound(err) || (err == nil && group.CompanyID != companyID) {
+			return fmt.Errorf("%w: tag group not found in company", service.ErrInvalidTag)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get tag group: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *documentTagService) MergeTags(ctx context.Context, sourceID, targetID uuid.UUID) (*ent.Tag, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: cannot merge a tag into itself", service.ErrInvalidTag)
+	}
+
+	source, err := s.tagRepo.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("tag not found: %w", err)
+	}
+	target, err := s.tagRepo.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("target tag not found: %w", err)
+	}
+	if source.CompanyID != target.CompanyID {
+		return nil, fmt.Errorf("%w: tags belong to different companies", service.ErrInvalidTag)
+	}
+
+	// Дочерние теги исходного переходят к целевому, поэтому целевой не может быть его потомком
+	tags, err := s.tagRepo.ListByCompany(ctx, source.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags by company: %w", err)
+	}
+	if tagging.NewTree(tags).IsDescendant(targetID, sourceID) {
+		return nil, fmt.Errorf("%w: cannot merge a tag into its own descendant", service.ErrInvalidTag)
+	}
+
+	if err := s.tagRepo.Merge(ctx, sourceID, targetID); err != nil {
+		return nil, fmt.Errorf("failed to merge tags: %w", err)
+	}
+
+	return s.tagRepo.GetByID(ctx, targetID)
+}
+
+func (s *documentTagService) GetTagUsage(ctx context.Context, companyID uuid.UUID) ([]service.TagUsage, error) {
+	tags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags by company: %w", err)
+	}
+	stats, err := s.tagRepo.Usage(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag usage: %w", err)
+	}
+	byTag := make(map[uuid.UUID]repo.TagUsage, len(stats))
+	for _, stat := range stats {
+		byTag[stat.TagID] = stat
+	}
+
+	tree := tagging.NewTree(tags)
+	usage := make([]service.TagUsage, 0, len(tags))
+	for _, tag := range tags {
+		stat := byTag[tag.ID]
+		usage = append(usage, service.TagUsage{
+			Tag:           tag,
+			Path:          tree.Path(tag.ID),
+			DocumentCount: stat.DocumentCount,
+			LastUsedAt:    stat.LastUsedAt,
+		})
+	}
+	sort.SliceStable(usage, func(i, j int) bool {
+		if usage[i].DocumentCount != usage[j].DocumentCount {
+			return usage[i].DocumentCount > usage[j].DocumentCount
+		}
+		return usage[i].Path < usage[j].Path
+	})
+
+	return usage, nil
+}
+
+func (s *documentTagService) CreateTagGroup(ctx context.Context, companyID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: group name is required", service.ErrInvalidTag)
+	}
+
+	group, err := s.tagGroupRepo.Create(ctx, companyID, name, exclusive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag group: %w", err)
+	}
+
+	return group, nil
+}
+
+func (s *documentTagService) UpdateTagGroup(ctx context.Context, groupID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("%w: group name is required", service.ErrInvalidTag)
+	}
+
+	// Проверяем что группа существует
+	_, err := s.tagGroupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("tag group not found: %w", err)
+	}
+
+	group, err := s.tagGroupRepo.Update(ctx, groupID, name, exclusive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag group: %w", err)
+	}
+
+	return group, nil
+}
+
+func (s *documentTagService) DeleteTagGroup(ctx context.Context, groupID uuid.UUID) error {
+	// Проверяем что группа существует
+	_, err := s.tagGroupRepo.GetByID(ctx, groupID)
+	if err != nil {
+		return fmt.Errorf("tag group not found: %w", err)
+	}
+
+	// Теги группы остаются, ссылка на группу обнуляется в БД
+	if err := s.tagGroupRepo.Delete(ctx, groupID); err != nil {
+		return fmt.Errorf("failed to delete tag group: %w", err)
+	}
+
+	return nil
+}
+
+func (s *documentTagService) GetTagGroupsByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagGroup, error) {
+	groups, err := s.tagGroupRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag groups by company: %w", err)
+	}
+
+	return groups, nil
+}
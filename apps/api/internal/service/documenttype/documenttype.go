@@ -0,0 +1,116 @@
+package documenttype
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"techmind/internal/doctype"
+	"techmind/internal/extraction"
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+type documentTypeService struct {
+	repo repo.DocumentTypeRepository
+}
+
+func NewService(repo repo.DocumentTypeRepository) service.DocumentTypeService {
+	return &documentTypeService{repo: repo}
+}
+
+func (s *documentTypeService) Create(ctx context.Context, companyID uuid.UUID, input service.DocumentTypeInput) (*ent.DocumentType, error) {
+	input, err := normalize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	documentType, err := s.repo.Create(ctx, companyID, input.Name, input.Description, input.Fields, input.Extraction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create document type: %w", err)
+	}
+	return documentType, nil
+}
+
+func (s *documentTypeService) GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentType, error) {
+	documentType, err := s.repo.GetByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrDocumentTypeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document type: %w", err)
+	}
+	return documentType, nil
+}
+
+func (s *documentTypeService) Update(ctx context.Context, id uuid.UUID, input service.DocumentTypeInput) (*ent.DocumentType, error) {
+	input, err := normalize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	documentType, err := s.repo.Update(ctx, id, input.Name, input.Description, input.Fields, input.Extraction)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrDocumentTypeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update document type: %w", err)
+	}
+	return documentType, nil
+}
+
+func (s *documentTypeService) Delete(ctx context.Context, id uuid.UUID) error {
+	err := s.repo.Delete(ctx, id)
+	if ent.IsNotFound(err) {
+		return service.ErrDocumentTypeNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete document type: %w", err)
+	}
+	return nil
+}
+
+func (s *documentTypeService) GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.DocumentType, error) {
+	documentTypes, err := s.repo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document types by company: %w", err)
+	}
+	return documentTypes, nil
+}
+
+// normalize убирает пробелы в названии и описании и проверяет схему полей и шаблон извлечения
+func normalize(input service.DocumentTypeInput) (service.DocumentTypeInput, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" {
+		return input, fmt.Errorf("%w: name is required", service.ErrInvalidDocumentType)
+	}
+	if input.Description != nil {
+		description := strings.TrimSpace(*input.Description)
+		input.Description = &description
+		if description == "" {
+			input.Description = nil
+		}
+	}
+
+	fields, err := doctype.ValidateFields(input.Fields)
+	if err != nil {
+		return input, fmt.Errorf("%w: %v", service.ErrInvalidDocumentType, err)
+	}
+	input.Fields = fields
+
+	if input.Extraction != nil {
+		template := *input.Extraction
+		if template.ReviewThreshold == 0 {
+			template.ReviewThreshold = types.DefaultReviewThreshold
+		}
+		if err := extraction.ValidateTemplate(input.Fields, template); err != nil {
+			return input, fmt.Errorf("%w: extraction: %v", service.ErrInvalidDocumentType, err)
+		}
+		input.Extraction = &template
+	}
+	return input, nil
+}
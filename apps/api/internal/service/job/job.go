@@ -0,0 +1,38 @@
+package job
+
+import (
+	"context"
+	"fmt"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+type jobService struct {
+	jobRepo repo.JobRepository
+}
+
+func NewService(jobRepo repo.JobRepository) service.JobService {
+	return &jobService{
+		jobRepo: jobRepo,
+	}
+}
+
+func (s *jobService) GetByID(ctx context.Context, jobID uuid.UUID) (*ent.Job, error) {
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("job not found: %w", err)
+	}
+	return job, nil
+}
+
+func (s *jobService) GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Job, error) {
+	jobs, err := s.jobRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs by company: %w", err)
+	}
+	return jobs, nil
+}
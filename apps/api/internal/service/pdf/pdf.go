@@ -0,0 +1,479 @@
+package pdf
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/pkg/gotenberg"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	// jobTimeout - максимальное время выполнения одной фоновой операции
+	jobTimeout = 15 * time.Minute
+
+	pdfMimeType = "application/pdf"
+)
+
+// pageRangePattern - допустимый формат диапазона страниц: "3", "1-5"
+var pageRangePattern = regexp.MustCompile(`^\d+(-\d+)?$`)
+
+// officeMimeTypes - типы документов, конвертируемые в PDF через LibreOffice
+var officeMimeTypes = map[string]bool{
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document":   true,
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":         true,
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation": true,
+	"application/msword":                              true,
+	"application/vnd.ms-excel":                        true,
+	"application/vnd.ms-powerpoint":                   true,
+	"application/vnd.oasis.opendocument.text":         true,
+	"application/vnd.oasis.opendocument.spreadsheet":  true,
+	"application/vnd.oasis.opendocument.presentation": true,
+	"application/rtf":                                 true,
+	"text/rtf":                                        true,
+	"text/plain":                                      true,
+	"text/csv":                                        true,
+}
+
+// imageMimeTypes - изображения, конвертируемые в PDF через Chromium
+var imageMimeTypes = map[string]bool{
+	"image/jpeg":    true,
+	"image/png":     true,
+	"image/gif":     true,
+	"image/webp":    true,
+	"image/svg+xml": true,
+	"image/bmp":     true,
+}
+
+type pdfService struct {
+	documentRepo    repo.DocumentRepository
+	folderRepo      repo.FolderRepository
+	jobRepo         repo.JobRepository
+	documentService service.DocumentService
+	minioClient     *minio.Client
+	bucketName      string
+	gotenbergClient *gotenberg.Client
+}
+
+func NewService(
+	documentRepo repo.DocumentRepository,
+	folderRepo repo.FolderRepository,
+	jobRepo repo.JobRepository,
+	documentService service.DocumentService,
+	minioClient *minio.Client,
+	gotenbergClient *gotenberg.Client,
+) service.PDFService {
+	return &pdfService{
+		documentRepo:    documentRepo,
+		folderRepo:      folderRepo,
+		jobRepo:         jobRepo,
+		documentService: documentService,
+		minioClient:     minioClient,
+		bucketName:      "documents",
+		gotenbergClient: gotenbergClient,
+	}
+}
+
+func (s *pdfService) Merge(ctx context.Context, input service.PDFMergeInput) (*ent.Job, error) {
+	if len(input.DocumentIDs) < 2 {
+		return nil, fmt.Errorf("at least two documents are required for merge")
+	}
+
+	documents, err := s.loadDocuments(ctx, input.DocumentIDs)
+	if err != nil {
+		return nil, err
+	}
+	companyID := documents[0].CompanyID
+
+	if err := s.checkFolder(ctx, companyID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	name := input.Name
+	if name == "" {
+		name = withSuffix(documents[0].Name, " (объединено)")
+	}
+	name = pdfName(name)
+
+	payload := map[string]interface{}{
+		"document_ids": input.DocumentIDs,
+		"folder_id":    input.FolderID,
+		"name":         name,
+	}
+
+	return s.startJob(ctx, companyID, service.JobTypePDFMerge, payload, input.UserID, len(documents)+1,
+		func(jobCtx context.Context, progress func(int)) ([]uuid.UUID, error) {
+			// Конвертируем все документы в PDF, сохраняя порядок
+			files := make([]gotenberg.File, 0, len(documents))
+			for i, doc := range documents {
+				content, err := s.loadAsPDF(jobCtx, doc)
+				if err != nil {
+					return nil, fmt.Errorf("failed to prepare document %s: %w", doc.ID, err)
+				}
+				// Gotenberg объединяет файлы в алфавитном порядке имен
+				files = append(files, gotenberg.File{
+					Name:    fmt.Sprintf("%04d.pdf", i),
+					Content: content,
+				})
+				progress(i + 1)
+			}
+
+			res, err := s.gotenbergClient.MergePDFs(jobCtx, files, &gotenberg.PDFEnginesRequest{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to merge documents: %w", err)
+			}
+
+			created, err := s.saveResult(jobCtx, companyID, input.FolderID, name, res.Body, input.UserID)
+			if err != nil {
+				return nil, err
+			}
+			progress(len(documents) + 1)
+
+			return []uuid.UUID{created.ID}, nil
+		})
+}
+
+func (s *pdfService) Split(ctx context.Context, input service.PDFSplitInput) (*ent.Job, error) {
+	if len(input.Ranges) == 0 {
+		return nil, fmt.Errorf("at least one page range is required")
+	}
+	for _, r := range input.Ranges {
+		if !pageRangePattern.MatchString(strings.TrimSpace(r)) {
+			return nil, fmt.Errorf("invalid page range: %q", r)
+		}
+	}
+
+	document, err := s.documentRepo.GetByID(ctx, input.DocumentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
+	if err := s.checkFolder(ctx, document.CompanyID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"document_id": input.DocumentID,
+		"ranges":      input.Ranges,
+		"folder_id":   input.FolderID,
+	}
+
+	return s.startJob(ctx, document.CompanyID, service.JobTypePDFSplit, payload, input.UserID, len(input.Ranges),
+		func(jobCtx context.Context, progress func(int)) ([]uuid.UUID, error) {
+			content, err := s.loadAsPDF(jobCtx, document)
+			if err != nil {
+				return nil, err
+			}
+
+			// Каждый диапазон выгружаем отдельным запросом, объединяя выбранные страницы в один файл
+			created := make([]uuid.UUID, 0, len(input.Ranges))
+			for i, r := range input.Ranges {
+				r = strings.TrimSpace(r)
+				res, err := s.gotenbergClient.SplitPDFs(jobCtx, []gotenberg.File{{Name: "source.pdf", Content: content}}, &gotenberg.SplitRequest{
+					SplitMode:  "pages",
+					SplitSpan:  r,
+					SplitUnify: true,
+				})
+				if err != nil {
+					return created, fmt.Errorf("failed to split pages %s: %w", r, err)
+				}
+
+				name := pdfName(withSuffix(document.Name, fmt.Sprintf(" (стр. %s)", r)))
+				doc, err := s.saveResult(jobCtx, document.CompanyID, input.FolderID, name, res.Body, input.UserID)
+				if err != nil {
+					return created, err
+				}
+				created = append(created, doc.ID)
+				progress(i + 1)
+			}
+
+			return created, nil
+		})
+}
+
+func (s *pdfService) Flatten(ctx context.Context, input service.PDFFlattenInput) (*ent.Job, error) {
+	if len(input.DocumentIDs) == 0 {
+		return nil, fmt.Errorf("at least one document is required")
+	}
+
+	documents, err := s.loadDocuments(ctx, input.DocumentIDs)
+	if err != nil {
+		return nil, err
+	}
+	companyID := documents[0].CompanyID
+
+	if err := s.checkFolder(ctx, companyID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]interface{}{
+		"document_ids": input.DocumentIDs,
+		"folder_id":    input.FolderID,
+	}
+
+	return s.startJob(ctx, companyID, service.JobTypePDFFlatten, payload, input.UserID, len(documents),
+		func(jobCtx context.Context, progress func(int)) ([]uuid.UUID, error) {
+			created := make([]uuid.UUID, 0, len(documents))
+			for i, doc := range documents {
+				content, err := s.loadAsPDF(jobCtx, doc)
+				if err != nil {
+					return created, fmt.Errorf("failed to prepare document %s: %w", doc.ID, err)
+				}
+
+				res, err := s.gotenbergClient.FlattenPDFs(jobCtx, []gotenberg.File{{Name: "source.pdf", Content: content}}, "")
+				if err != nil {
+					return created, fmt.Errorf("failed to flatten document %s: %w", doc.ID, err)
+				}
+
+				name := pdfName(withSuffix(doc.Name, " (сглажено)"))
+				result, err := s.saveResult(jobCtx, companyID, input.FolderID, name, res.Body, input.UserID)
+				if err != nil {
+					return created, err
+				}
+				created = append(created, result.ID)
+				progress(i + 1)
+			}
+
+			return created, nil
+		})
+}
+
+func (s *pdfService) Encrypt(ctx context.Context, input service.PDFEncryptInput) (*ent.Job, error) {
+	if input.UserPassword == "" {
+		return nil, fmt.Errorf("user password is required")
+	}
+
+	document, err := s.documentRepo.GetByID(ctx, input.DocumentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
+	if err := s.checkFolder(ctx, document.CompanyID, input.FolderID); err != nil {
+		return nil, err
+	}
+
+	// Пароли в payload задачи не сохраняем
+	payload := map[string]interface{}{
+		"document_id": input.DocumentID,
+		"folder_id":   input.FolderID,
+	}
+
+	return s.startJob(ctx, document.CompanyID, service.JobTypePDFEncrypt, payload, input.UserID, 1,
+		func(jobCtx context.Context, progress func(int)) ([]uuid.UUID, error) {
+			content, err := s.loadAsPDF(jobCtx, document)
+			if err != nil {
+				return nil, err
+			}
+
+			res, err := s.gotenbergClient.EncryptPDFs(jobCtx, []gotenberg.File{{Name: "source.pdf", Content: content}}, input.UserPassword, input.OwnerPassword, "")
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt document: %w", err)
+			}
+
+			name := pdfName(withSuffix(document.Name, " (защищено)"))
+			result, err := s.saveResult(jobCtx, document.CompanyID, input.FolderID, name, res.Body, input.UserID)
+			if err != nil {
+				return nil, err
+			}
+			progress(1)
+
+			return []uuid.UUID{result.ID}, nil
+		})
+}
+
+// startJob создает задачу и запускает ее выполнение в фоне
+// run получает функцию для обновления прогресса и возвращает ID созданных документов
+func (s *pdfService) startJob(
+	ctx context.Context,
+	companyID uuid.UUID,
+	jobType string,
+	payload map[string]interface{},
+	userID uuid.UUID,
+	total int,
+	run func(ctx context.Context, progress func(int)) ([]uuid.UUID, error),
+) (*ent.Job, error) {
+	job, err := s.jobRepo.Create(ctx, &companyID, jobType, payload, &userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	go func() {
+		// Создаем новый контекст с таймаутом для фоновой задачи
+		jobCtx, cancel := context.WithTimeout(context.Background(), jobTimeout)
+		defer cancel()
+
+		if err := s.jobRepo.MarkRunning(jobCtx, job.ID, total); err != nil {
+			fmt.Printf("Failed to mark job %s as running: %v\n", job.ID, err)
+		}
+
+		progress := func(done int) {
+			if err := s.jobRepo.UpdateProgress(jobCtx, job.ID, done); err != nil {
+				fmt.Printf("Failed to update progress of job %s: %v\n", job.ID, err)
+			}
+		}
+
+		created, err := run(jobCtx, progress)
+		if err != nil {
+			fmt.Printf("Job %s (%s) failed: %v\n", job.ID, jobType, err)
+			if markErr := s.jobRepo.MarkFailed(jobCtx, job.ID, err.Error(), created); markErr != nil {
+				fmt.Printf("Failed to mark job %s as failed: %v\n", job.ID, markErr)
+			}
+			return
+		}
+
+		if err := s.jobRepo.MarkCompleted(jobCtx, job.ID, created); err != nil {
+			fmt.Printf("Failed to mark job %s as completed: %v\n", job.ID, err)
+		}
+	}()
+
+	return job, nil
+}
+
+// loadDocuments получает документы по ID и проверяет что все они принадлежат одной компании
+func (s *pdfService) loadDocuments(ctx context.Context, ids []uuid.UUID) ([]*ent.Document, error) {
+	documents := make([]*ent.Document, 0, len(ids))
+	for _, id := range ids {
+		doc, err := s.documentRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("document %s not found: %w", id, err)
+		}
+		if len(documents) > 0 && doc.CompanyID != documents[0].CompanyID {
+			return nil, fmt.Errorf("documents belong to different companies")
+		}
+		documents = append(documents, doc)
+	}
+	return documents, nil
+}
+
+// checkFolder проверяет что папка существует и принадлежит компании
+func (s *pdfService) checkFolder(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID) error {
+	if folderID == nil {
+		return nil
+	}
+	folder, err := s.folderRepo.GetByID(ctx, *folderID)
+	if err != nil {
+		return fmt.Errorf("folder not found: %w", err)
+	}
+	if folder.CompanyID != companyID {
+		return fmt.Errorf("folder belongs to different company")
+	}
+	return nil
+}
+
+// loadAsPDF возвращает содержимое документа в формате PDF
+// Для PDF возвращается оригинал, для остальных типов используется готовый preview или конвертация через Gotenberg
+func (s *pdfService) loadAsPDF(ctx context.Context, document *ent.Document) ([]byte, error) {
+	mimeType := strings.ToLower(document.MimeType)
+
+	if mimeType == pdfMimeType {
+		return s.readObject(ctx, document.FilePath)
+	}
+
+	// Preview уже содержит PDF версию документа
+	if document.PreviewFilePath != nil {
+		return s.readObject(ctx, *document.PreviewFilePath)
+	}
+
+	content, err := s.readObject(ctx, document.FilePath)
+	if err != nil {
+		return nil, err
+	}
+	fileName := filepath.Base(document.FilePath)
+
+	switch {
+	case officeMimeTypes[mimeType]:
+		res, err := s.gotenbergClient.ConvertOfficeToPDF(ctx, []gotenberg.File{{Name: fileName, Content: content}}, &gotenberg.LibreOfficeRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert office document to PDF: %w", err)
+		}
+		return res.Body, nil
+	case imageMimeTypes[mimeType]:
+		// Изображение вписывается в страницу через HTML разметку
+		indexHTML := fmt.Sprintf(
+			`<!DOCTYPE html><html><head><meta charset="utf-8"><style>html,body{margin:0}img{display:block;max-width:100%%;max-height:100vh;margin:0 auto}</style></head><body><img src="%s"></body></html>`,
+			html.EscapeString(fileName),
+		)
+		res, err := s.gotenbergClient.ConvertHTMLToPDF(
+			ctx,
+			gotenberg.File{Name: "index.html", Content: []byte(indexHTML)},
+			[]gotenberg.File{{Name: fileName, Content: content}},
+			&gotenberg.ChromiumRequest{},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert image to PDF: %w", err)
+		}
+		return res.Body, nil
+	default:
+		return nil, fmt.Errorf("document type %s is not convertible to PDF", document.MimeType)
+	}
+}
+
+// readObject читает объект из MinIO целиком
+func (s *pdfService) readObject(ctx context.Context, objectName string) ([]byte, error) {
+	object, err := s.minioClient.GetObject(ctx, s.bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file from minio: %w", err)
+	}
+	defer object.Close()
+
+	content, err := io.ReadAll(object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file content: %w", err)
+	}
+	return content, nil
+}
+
+// saveResult сохраняет полученный PDF как новый документ компании
+// Если в компании уже есть документ с тем же содержимым, например при повторном запуске той же операции,
+// результатом считается существующий документ: его название и папка не меняются
+func (s *pdfService) saveResult(ctx context.Context, companyID uuid.UUID, folderID *uuid.UUID, name string, content []byte, userID uuid.UUID) (*ent.Document, error) {
+	document, err := s.documentService.Upload(ctx, service.DocumentUploadInput{
+		CompanyID: companyID,
+		FolderID:  folderID,
+		Name:      name,
+		File:      bytes.NewReader(content),
+		FileSize:  int64(len(content)),
+		MimeType:  pdfMimeType,
+		UserID:    userID,
+	})
+	if errors.Is(err, service.ErrDocumentDuplicate) {
+		existing, err := s.documentRepo.GetByChecksum(ctx, companyID, fmt.Sprintf("%x", sha256.Sum256(content)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get existing result document %q: %w", name, err)
+		}
+		return existing, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save result document %q: %w", name, err)
+	}
+	return document, nil
+}
+
+// withSuffix добавляет суффикс к имени файла перед расширением
+func withSuffix(name, suffix string) string {
+	ext := filepath.Ext(name)
+	return strings.TrimSuffix(name, ext) + suffix + ext
+}
+
+// pdfName заменяет расширение файла на .pdf
+func pdfName(name string) string {
+	ext := filepath.Ext(name)
+	if strings.EqualFold(ext, ".pdf") {
+		return name
+	}
+	return strings.TrimSuffix(name, ext) + ".pdf"
+}
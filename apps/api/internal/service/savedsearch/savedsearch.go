@@ -0,0 +1,226 @@
+package savedsearch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+// validSorts - допустимые значения сортировки сохраненного поиска
+var validSorts = map[string]bool{
+	"":                            true,
+	service.SearchSortRelevance:   true,
+	service.SearchSortCreatedDesc: true,
+	service.SearchSortCreatedAsc:  true,
+	service.SearchSortUpdatedDesc: true,
+	service.SearchSortUpdatedAsc:  true,
+	service.SearchSortSizeDesc:    true,
+	service.SearchSortSizeAsc:     true,
+}
+
+type savedSearchService struct {
+	savedSearchRepo repo.SavedSearchRepository
+	companyUserRepo repo.CompanyUserRepository
+	folderRepo      repo.FolderRepository
+	documentService service.DocumentService
+}
+
+func NewService(
+	savedSearchRepo repo.SavedSearchRepository,
+	companyUserRepo repo.CompanyUserRepository,
+	folderRepo repo.FolderRepository,
+	documentService service.DocumentService,
+) service.SavedSearchService {
+	return &savedSearchService{
+		savedSearchRepo: savedSearchRepo,
+		companyUserRepo: companyUserRepo,
+		folderRepo:      folderRepo,
+		documentService: documentService,
+	}
+}
+
+func (s *savedSearchService) Create(ctx context.Context, userID, companyID uuid.UUID, input service.SavedSearchInput, shared bool) (*ent.SavedSearch, error) {
+	if err := s.checkMember(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := s.savedSearchRepo.Create(ctx, companyID, userID, strings.TrimSpace(input.Name), input.Query, input.Filters, input.Sort, shared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved search: %w", err)
+	}
+	return savedSearch, nil
+}
+
+func (s *savedSearchService) GetByID(ctx context.Context, userID, id uuid.UUID) (*ent.SavedSearch, error) {
+	savedSearch, err := s.savedSearchRepo.GetByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrSavedSearchNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved search: %w", err)
+	}
+
+	if savedSearch.UserID == userID {
+		return savedSearch, nil
+	}
+	// Чужой личный поиск не раскрываем, как будто его нет
+	if !savedSearch.Shared {
+		return nil, service.ErrSavedSearchNotFound
+	}
+	if err := s.checkMember(ctx, userID, savedSearch.CompanyID); err != nil {
+		return nil, service.ErrSavedSearchNotFound
+	}
+	return savedSearch, nil
+}
+
+func (s *savedSearchService) List(ctx context.Context, userID, companyID uuid.UUID) ([]*ent.SavedSearch, error) {
+	if err := s.checkMember(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	savedSearches, err := s.savedSearchRepo.ListVisible(ctx, companyID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved searches: %w", err)
+	}
+	return savedSearches, nil
+}
+
+func (s *savedSearchService) Update(ctx context.Context, userID, id uuid.UUID, input service.SavedSearchInput) (*ent.SavedSearch, error) {
+	if _, err := s.getOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := s.savedSearchRepo.Update(ctx, id, strings.TrimSpace(input.Name), input.Query, input.Filters, input.Sort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update saved search: %w", err)
+	}
+	return savedSearch, nil
+}
+
+func (s *savedSearchService) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.getOwned(ctx, userID, id); err != nil {
+		return err
+	}
+
+	if err := s.savedSearchRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	return nil
+}
+
+func (s *savedSearchService) Share(ctx context.Context, userID, id uuid.UUID, shared bool) (*ent.SavedSearch, error) {
+	if _, err := s.getOwned(ctx, userID, id); err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := s.savedSearchRepo.SetShared(ctx, id, shared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to share saved search: %w", err)
+	}
+	return savedSearch, nil
+}
+
+func (s *savedSearchService) Pin(ctx context.Context, userID, id uuid.UUID, pinned bool, parentFolderID *uuid.UUID) (*ent.SavedSearch, error) {
+	savedSearch, err := s.getOwned(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if pinned && parentFolderID != nil {
+		folder, err := s.folderRepo.GetByID(ctx, *parentFolderID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent folder: %w", err)
+		}
+		if folder.CompanyID != savedSearch.CompanyID {
+			return nil, fmt.Errorf("parent folder belongs to another company")
+		}
+	}
+
+	savedSearch, err = s.savedSearchRepo.SetPinned(ctx, id, pinned, parentFolderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pin saved search: %w", err)
+	}
+	return savedSearch, nil
+}
+
+func (s *savedSearchService) Run(ctx context.Context, userID, id uuid.UUID, page, pageSize int, cursor string) (*service.DocumentSearchResult, error) {
+	savedSearch, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	input := service.NewDocumentSearchInput(savedSearch.CompanyID, savedSearch.Query, savedSearch.Filters, savedSearch.Sort)
+	input.Page = page
+	input.PageSize = pageSize
+	input.Cursor = cursor
+	input.UserID = userID
+	return s.documentService.Search(ctx, input)
+}
+
+func (s *savedSearchService) GetSmartFolders(ctx context.Context, userID, companyID uuid.UUID, parentFolderID *uuid.UUID, all bool) ([]*ent.SavedSearch, error) {
+	pinned, err := s.savedSearchRepo.ListPinned(ctx, companyID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get smart folders: %w", err)
+	}
+	if all {
+		return pinned, nil
+	}
+
+	result := make([]*ent.SavedSearch, 0, len(pinned))
+	for _, savedSearch := range pinned {
+		switch {
+		case parentFolderID == nil && savedSearch.ParentFolderID == nil:
+		case parentFolderID != nil && savedSearch.ParentFolderID != nil && *parentFolderID == *savedSearch.ParentFolderID:
+		default:
+			continue
+		}
+		result = append(result, savedSearch)
+	}
+	return result, nil
+}
+
+// getOwned получает поиск, который может изменять пользователь
+func (s *savedSearchService) getOwned(ctx context.Context, userID, id uuid.UUID) (*ent.SavedSearch, error) {
+	savedSearch, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if savedSearch.UserID != userID {
+		return nil, service.ErrSavedSearchForbidden
+	}
+	return savedSearch, nil
+}
+
+// checkMember проверяет, что пользователь состоит в компании
+func (s *savedSearchService) checkMember(ctx context.Context, userID, companyID uuid.UUID) error {
+	if _, err := s.companyUserRepo.GetByUserAndCompany(ctx, userID, companyID); err != nil {
+		if ent.IsNotFound(err) {
+			return service.ErrSavedSearchForbidden
+		}
+		return fmt.Errorf("failed to check company membership: %w", err)
+	}
+	return nil
+}
+
+// validateInput проверяет название и сортировку поиска
+func validateInput(input service.SavedSearchInput) error {
+	if strings.TrimSpace(input.Name) == "" {
+		return fmt.Errorf("saved search name is required")
+	}
+	if _, _, ok := service.ParseFieldSort(input.Sort); !validSorts[input.Sort] && !ok {
+		return fmt.Errorf("%w: %q", service.ErrInvalidSearchSort, input.Sort)
+	}
+	return nil
+}
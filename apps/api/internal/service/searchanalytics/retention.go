@@ -0,0 +1,74 @@
+package searchanalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/internal/service"
+
+	"go.uber.org/fx"
+)
+
+const (
+	// cleanupInterval - период удаления устаревших записей аналитики поиска
+	cleanupInterval = time.Hour
+	// cleanupTimeout - максимальное время одной очистки
+	cleanupTimeout = 5 * time.Minute
+)
+
+// RetentionWorker периодически удаляет записи аналитики поиска старше сроков хранения
+type RetentionWorker struct {
+	analyticsService service.SearchAnalyticsService
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewRetentionWorker создает очистку аналитики, которая работает, пока запущено приложение
+func NewRetentionWorker(lc fx.Lifecycle, analyticsService service.SearchAnalyticsService) *RetentionWorker {
+	w := &RetentionWorker{
+		analyticsService: analyticsService,
+		stop:             make(chan struct{}),
+		done:             make(chan struct{}),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go w.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(w.stop)
+			select {
+			case <-w.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return w
+}
+
+// run выполняет очистку при запуске и затем по таймеру
+func (w *RetentionWorker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		if err := w.analyticsService.Cleanup(ctx); err != nil {
+			fmt.Printf("Failed to clean up search analytics: %v\n", err)
+		}
+		cancel()
+
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
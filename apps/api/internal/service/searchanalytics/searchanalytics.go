@@ -0,0 +1,242 @@
+package searchanalytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/pkg/config"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// defaultRetentionDays - срок хранения записей о поисках, если он не задан в конфигурации
+	defaultRetentionDays = 90
+	// defaultReportDays - интервал отчета, если границы не заданы
+	defaultReportDays = 30
+	// defaultReportLimit и maxReportLimit ограничивают списки запросов в отчете
+	defaultReportLimit = 20
+	maxReportLimit     = 100
+)
+
+// validPeriods - допустимые периоды группировки отчета
+var validPeriods = map[string]bool{
+	service.SearchAnalyticsPeriodDay:   true,
+	service.SearchAnalyticsPeriodWeek:  true,
+	service.SearchAnalyticsPeriodMonth: true,
+}
+
+type searchAnalyticsService struct {
+	searchLogRepo  repo.SearchLogRepository
+	userRepo       repo.UserRepository
+	documentRepo   repo.DocumentRepository
+	retention      time.Duration
+	queryRetention time.Duration
+}
+
+func NewService(
+	searchLogRepo repo.SearchLogRepository,
+	userRepo repo.UserRepository,
+	documentRepo repo.DocumentRepository,
+	cfg *config.Config,
+) service.SearchAnalyticsService {
+	retentionDays := cfg.Search.Analytics.RetentionDays
+	if retentionDays <= 0 {
+		retentionDays = defaultRetentionDays
+	}
+	queryRetentionDays := cfg.Search.Analytics.QueryRetentionDays
+	if queryRetentionDays <= 0 || queryRetentionDays > retentionDays {
+		queryRetentionDays = retentionDays
+	}
+
+	return &searchAnalyticsService{
+		searchLogRepo:  searchLogRepo,
+		userRepo:       userRepo,
+		documentRepo:   documentRepo,
+		retention:      time.Duration(retentionDays) * 24 * time.Hour,
+		queryRetention: time.Duration(queryRetentionDays) * 24 * time.Hour,
+	}
+}
+
+func (s *searchAnalyticsService) RecordSearch(ctx context.Context, record service.SearchRecord) (uuid.UUID, error) {
+	user, err := s.userRepo.GetByID(ctx, record.UserID)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	var query *string
+	if user.StoreSearchQueries {
+		query = &record.Query
+	}
+
+	searchLog, err := s.searchLogRepo.Create(ctx, record.CompanyID, &record.UserID, query, record.Filters,
+		record.Mode, record.Sort, record.ResultCount, record.Latency.Milliseconds())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to record search: %w", err)
+	}
+	return searchLog.ID, nil
+}
+
+func (s *searchAnalyticsService) RecordClick(ctx context.Context, userID, searchID, documentID uuid.UUID, position int) error {
+	if position < 0 {
+		return fmt.Errorf("position must not be negative")
+	}
+
+	searchLog, err := s.searchLogRepo.GetByID(ctx, searchID)
+	if ent.IsNotFound(err) {
+		return service.ErrSearchLogNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get search: %w", err)
+	}
+	if searchLog.UserID == nil || *searchLog.UserID != userID {
+		return service.ErrSearchLogNotFound
+	}
+
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("document not found: %w", err)
+	}
+	if document.CompanyID != searchLog.CompanyID {
+		return fmt.Errorf("document belongs to another company")
+	}
+
+	if err := s.searchLogRepo.RecordClick(ctx, searchID, documentID, position, time.Now()); err != nil {
+		return fmt.Errorf("failed to record click: %w", err)
+	}
+	return nil
+}
+
+func (s *searchAnalyticsService) StoresQueries(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	return user.StoreSearchQueries, nil
+}
+
+func (s *searchAnalyticsService) SetStoreQueries(ctx context.Context, userID uuid.UUID, store bool) error {
+	if _, err := s.userRepo.SetStoreSearchQueries(ctx, userID, store); err != nil {
+		return fmt.Errorf("failed to update user settings: %w", err)
+	}
+	if store {
+		return nil
+	}
+
+	if _, err := s.searchLogRepo.ClearUserQueries(ctx, userID); err != nil {
+		return fmt.Errorf("failed to clear stored queries: %w", err)
+	}
+	return nil
+}
+
+func (s *searchAnalyticsService) Report(ctx context.Context, input service.SearchAnalyticsReportInput) (*service.SearchAnalyticsReport, error) {
+	period := input.Period
+	if period == "" {
+		period = service.SearchAnalyticsPeriodDay
+	}
+	if !validPeriods[period] {
+		return nil, service.ErrInvalidAnalyticsPeriod
+	}
+
+	to := time.Now()
+	if input.To != nil {
+		to = *input.To
+	}
+	from := to.AddDate(0, 0, -defaultReportDays)
+	if input.From != nil {
+		from = *input.From
+	}
+	if !from.Before(to) {
+		return nil, service.ErrInvalidAnalyticsPeriod
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultReportLimit
+	}
+	if limit > maxReportLimit {
+		limit = maxReportLimit
+	}
+
+	filter := repo.SearchLogFilter{CompanyID: input.CompanyID, From: from, To: to}
+
+	summary, err := s.searchLogRepo.Summary(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get search summary: %w", err)
+	}
+	periods, err := s.searchLogRepo.Periods(ctx, filter, period)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get search periods: %w", err)
+	}
+	topQueries, err := s.searchLogRepo.TopQueries(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top queries: %w", err)
+	}
+	zeroResultQueries, err := s.searchLogRepo.ZeroResultQueries(ctx, filter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zero result queries: %w", err)
+	}
+
+	report := &service.SearchAnalyticsReport{
+		From:                 from,
+		To:                   to,
+		Period:               period,
+		SearchAnalyticsStats: newStats(summary),
+		Periods:              make([]service.SearchAnalyticsPeriod, 0, len(periods)),
+		TopQueries:           newQueryStats(topQueries),
+		ZeroResultQueries:    newQueryStats(zeroResultQueries),
+	}
+	for _, p := range periods {
+		report.Periods = append(report.Periods, service.SearchAnalyticsPeriod{
+			Start:                p.Start,
+			SearchAnalyticsStats: newStats(&p.SearchLogStats),
+		})
+	}
+	return report, nil
+}
+
+func (s *searchAnalyticsService) Cleanup(ctx context.Context) error {
+	now := time.Now()
+
+	deleted, err := s.searchLogRepo.DeleteBefore(ctx, now.Add(-s.retention))
+	if err != nil {
+		return fmt.Errorf("failed to delete old searches: %w", err)
+	}
+	cleared, err := s.searchLogRepo.ClearQueriesBefore(ctx, now.Add(-s.queryRetention))
+	if err != nil {
+		return fmt.Errorf("failed to clear old queries: %w", err)
+	}
+
+	if deleted > 0 || cleared > 0 {
+		fmt.Printf("Search analytics cleanup: %d searches deleted, %d queries cleared\n", deleted, cleared)
+	}
+	return nil
+}
+
+func newStats(stats *repo.SearchLogStats) service.SearchAnalyticsStats {
+	return service.SearchAnalyticsStats{
+		Searches:           stats.Searches,
+		ZeroResultSearches: stats.ZeroResultSearches,
+		ClickedSearches:    stats.ClickedSearches,
+		AvgLatencyMs:       stats.AvgLatencyMs,
+	}
+}
+
+func newQueryStats(queries []*repo.SearchQueryStats) []service.SearchQueryStats {
+	result := make([]service.SearchQueryStats, 0, len(queries))
+	for _, q := range queries {
+		result = append(result, service.SearchQueryStats{
+			Query:              q.Query,
+			Searches:           q.Searches,
+			ZeroResultSearches: q.ZeroResultSearches,
+			ClickedSearches:    q.ClickedSearches,
+			AvgResults:         q.AvgResults,
+			LastSearchedAt:     q.LastSearchedAt,
+		})
+	}
+	return result
+}
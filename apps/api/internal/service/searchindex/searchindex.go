@@ -0,0 +1,463 @@
+package searchindex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/search"
+	"techmind/internal/search/elastic"
+	"techmind/internal/search/postgres"
+	"techmind/internal/search/semantic"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/elastic/go-elasticsearch/v8"
+	"github.com/elastic/go-elasticsearch/v8/esutil"
+	"github.com/google/uuid"
+)
+
+const (
+	// reindexTimeout - максимальное время перестройки индекса
+	reindexTimeout = 2 * time.Hour
+	// reindexBatchSize - число документов, читаемых из БД за один запрос
+	reindexBatchSize = 200
+)
+
+// searchIndexService перестраивает поисковый индекс.
+// Для Elasticsearch (indices не nil) строится новый индекс и переключается алиас,
+// другие движки перезаписывают документы в единственном индексе
+type searchIndexService struct {
+	documentRepo        repo.DocumentRepository
+	jobRepo             repo.JobRepository
+	taskRepo            repo.SearchIndexTaskRepository
+	elasticsearchClient *elasticsearch.Client
+	indices             *elastic.Indices
+	index               search.SearchIndex
+	embedder            *semantic.Embedder
+
+	// rebuilding - идет перезапись индекса без Elasticsearch
+	rebuilding atomic.Bool
+}
+
+func NewService(
+	documentRepo repo.DocumentRepository,
+	jobRepo repo.JobRepository,
+	taskRepo repo.SearchIndexTaskRepository,
+	elasticsearchClient *elasticsearch.Client,
+	indices *elastic.Indices,
+	index search.SearchIndex,
+	embedder *semantic.Embedder,
+) service.SearchIndexService {
+	return &searchIndexService{
+		documentRepo:        documentRepo,
+		jobRepo:             jobRepo,
+		taskRepo:            taskRepo,
+		elasticsearchClient: elasticsearchClient,
+		indices:             indices,
+		index:               index,
+		embedder:            embedder,
+	}
+}
+
+// building возвращает имя индекса, который строится в данный момент
+func (s *searchIndexService) building() string {
+	if s.indices != nil {
+		return s.indices.Building()
+	}
+	if s.rebuilding.Load() {
+		return postgres.Table
+	}
+	return ""
+}
+
+// Reindex создает задачу перестройки индекса и выполняет ее в фоне
+func (s *searchIndexService) Reindex(ctx context.Context, userID uuid.UUID) (*ent.Job, error) {
+	if building := s.building(); building != "" {
+		return nil, fmt.Errorf("index %s is already being built", building)
+	}
+
+	total, err := s.documentRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	payload := map[string]interface{}{}
+	if s.indices != nil {
+		payload["mapping_version"] = elastic.MappingVersion
+	}
+	job, err := s.jobRepo.Create(ctx, nil, service.JobTypeSearchReindex, payload, &userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	go func() {
+		// Создаем новый контекст с таймаутом для фоновой задачи
+		jobCtx, cancel := context.WithTimeout(context.Background(), reindexTimeout)
+		defer cancel()
+
+		if err := s.jobRepo.MarkRunning(jobCtx, job.ID, total); err != nil {
+			fmt.Printf("Failed to mark job %s as running: %v\n", job.ID, err)
+		}
+
+		_, err := s.Rebuild(jobCtx, func(done, _ int) {
+			if err := s.jobRepo.UpdateProgress(jobCtx, job.ID, done); err != nil {
+				fmt.Printf("Failed to update progress of job %s: %v\n", job.ID, err)
+			}
+		})
+		if err != nil {
+			fmt.Printf("Job %s (%s) failed: %v\n", job.ID, service.JobTypeSearchReindex, err)
+			if markErr := s.jobRepo.MarkFailed(jobCtx, job.ID, err.Error(), nil); markErr != nil {
+				fmt.Printf("Failed to mark job %s as failed: %v\n", job.ID, markErr)
+			}
+			return
+		}
+
+		if err := s.jobRepo.MarkCompleted(jobCtx, job.ID, nil); err != nil {
+			fmt.Printf("Failed to mark job %s as completed: %v\n", job.ID, err)
+		}
+	}()
+
+	return job, nil
+}
+
+// Rebuild строит новый индекс из БД, пока поиск продолжает работать через старый,
+// и переключает алиас только после успешной загрузки всех документов
+func (s *searchIndexService) Rebuild(ctx context.Context, progress func(done, total int)) (string, error) {
+	if s.indices == nil {
+		return s.rebuildInPlace(ctx, progress)
+	}
+
+	previous, err := s.indices.AliasTargets(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	index := elastic.IndexName(elastic.MappingVersion)
+	exists, err := s.indices.Exists(ctx, index)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		index = fmt.Sprintf("%s_%d", index, time.Now().Unix())
+	}
+
+	if err := s.indices.BeginBuild(index); err != nil {
+		return "", err
+	}
+	defer s.indices.EndBuild()
+
+	if err := s.indices.Create(ctx, index); err != nil {
+		return "", err
+	}
+
+	err = s.load(ctx, index, progress)
+	if err == nil {
+		err = s.purgeDeleted(ctx, index)
+	}
+	if err != nil {
+		if delErr := s.indices.Delete(context.Background(), index); delErr != nil {
+			fmt.Printf("Failed to delete incomplete index %s: %v\n", index, delErr)
+		}
+		return "", err
+	}
+
+	if err := s.indices.SwapAlias(ctx, index, previous); err != nil {
+		return "", err
+	}
+
+	// Старые индексы больше не читаются и не пишутся
+	for _, old := range previous {
+		if err := s.indices.Delete(ctx, old); err != nil {
+			fmt.Printf("Failed to delete previous index %s: %v\n", old, err)
+		}
+	}
+
+	fmt.Printf("Search index rebuilt into %s, alias %s switched from %v\n", index, elastic.Alias, previous)
+	return index, nil
+}
+
+// load загружает в индекс все документы вместе с сохраненным текстом
+func (s *searchIndexService) load(ctx context.Context, index string, progress func(done, total int)) error {
+	total, err := s.documentRepo.Count(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	// Обновление индекса на время массовой загрузки отключается
+	if err := s.indices.SetRefreshInterval(ctx, index, "-1"); err != nil {
+		return err
+	}
+
+	var (
+		processed int64
+		failed    int64
+		firstErr  error
+		errOnce   sync.Once
+	)
+
+	bulk, err := esutil.NewBulkIndexer(esutil.BulkIndexerConfig{
+		Client: s.elasticsearchClient,
+		Index:  index,
+		OnError: func(_ context.Context, err error) {
+			errOnce.Do(func() { firstErr = err })
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create bulk indexer: %w", err)
+	}
+
+	onDone := func() {
+		done := atomic.AddInt64(&processed, 1)
+		if progress != nil && done%reindexBatchSize == 0 {
+			progress(int(done), total)
+		}
+	}
+
+	afterID := uuid.Nil
+	for {
+		documents, err := s.documentRepo.ListAfter(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			_ = bulk.Close(ctx)
+			return fmt.Errorf("failed to load documents: %w", err)
+		}
+		if len(documents) == 0 {
+			break
+		}
+		afterID = documents[len(documents)-1].ID
+
+		for _, document := range documents {
+			body, err := json.Marshal(elastic.BuildDocument(s.buildDocument(ctx, document)))
+			if err != nil {
+				_ = bulk.Close(ctx)
+				return fmt.Errorf("failed to marshal document %s: %w", document.ID, err)
+			}
+
+			// create не перезаписывает документы, уже записанные в новый индекс
+			// параллельными изменениями: они свежее данных, прочитанных для загрузки
+			err = bulk.Add(ctx, esutil.BulkIndexerItem{
+				Action:     "create",
+				DocumentID: document.ID.String(),
+				Body:       bytes.NewReader(body),
+				OnSuccess: func(context.Context, esutil.BulkIndexerItem, esutil.BulkIndexerResponseItem) {
+					onDone()
+				},
+				OnFailure: func(_ context.Context, item esutil.BulkIndexerItem, res esutil.BulkIndexerResponseItem, err error) {
+					if res.Status == 409 {
+						onDone()
+						return
+					}
+					atomic.AddInt64(&failed, 1)
+					onDone()
+					errOnce.Do(func() {
+						if err == nil {
+							err = fmt.Errorf("%s: %s", res.Error.Type, res.Error.Reason)
+						}
+						firstErr = fmt.Errorf("document %s: %w", item.DocumentID, err)
+					})
+				},
+			})
+			if err != nil {
+				_ = bulk.Close(ctx)
+				return fmt.Errorf("failed to add document %s to bulk: %w", document.ID, err)
+			}
+		}
+	}
+
+	if err := bulk.Close(ctx); err != nil {
+		return fmt.Errorf("failed to flush bulk indexer: %w", err)
+	}
+
+	if progress != nil {
+		progress(int(atomic.LoadInt64(&processed)), total)
+	}
+
+	if failed > 0 || firstErr != nil {
+		return fmt.Errorf("%d documents failed to index, first error: %v", failed, firstErr)
+	}
+
+	if err := s.indices.SetRefreshInterval(ctx, index, "1s"); err != nil {
+		return err
+	}
+	return s.indices.Refresh(ctx, index)
+}
+
+// purgeDeleted убирает из нового индекса документы, удаленные во время загрузки.
+// Загрузка могла прочитать документ до удаления и записать его уже после того,
+// как outbox удалил документ из нового индекса, и после переключения алиаса документ вернулся бы в поиск
+func (s *searchIndexService) purgeDeleted(ctx context.Context, index string) error {
+	deleted := s.indices.DeletedDuringBuild()
+	for _, documentID := range deleted {
+		_, err := s.documentRepo.GetByID(ctx, documentID)
+		if err == nil {
+			continue
+		}
+		if !ent.IsNotFound(err) {
+			return fmt.Errorf("failed to get document %s: %w", documentID, err)
+		}
+		if err := s.indices.DeleteDocument(ctx, index, documentID); err != nil {
+			return err
+		}
+	}
+	if len(deleted) == 0 {
+		return nil
+	}
+	return s.indices.Refresh(ctx, index)
+}
+
+// rebuildInPlace перезаписывает в индексе все документы из БД
+// Поиск продолжает работать, документы обновляются по одному
+func (s *searchIndexService) rebuildInPlace(ctx context.Context, progress func(done, total int)) (string, error) {
+	if !s.rebuilding.CompareAndSwap(false, true) {
+		return "", fmt.Errorf("index %s is already being built", postgres.Table)
+	}
+	defer s.rebuilding.Store(false)
+
+	total, err := s.documentRepo.Count(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	var (
+		processed int
+		failed    int
+		firstErr  error
+	)
+	afterID := uuid.Nil
+	for {
+		documents, err := s.documentRepo.ListAfter(ctx, afterID, reindexBatchSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to load documents: %w", err)
+		}
+		if len(documents) == 0 {
+			break
+		}
+		afterID = documents[len(documents)-1].ID
+
+		for _, document := range documents {
+			if err := s.index.Index(ctx, s.buildDocument(ctx, document)); err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("document %s: %w", document.ID, err)
+				}
+			}
+			processed++
+		}
+		if progress != nil {
+			progress(processed, total)
+		}
+	}
+
+	if failed > 0 {
+		return "", fmt.Errorf("%d documents failed to index, first error: %v", failed, firstErr)
+	}
+
+	fmt.Printf("Search index %s rebuilt in place, %d documents\n", postgres.Table, processed)
+	return postgres.Table, nil
+}
+
+// buildDocument собирает документ индекса из документа, загруженного вместе с текстом и тегами
+func (s *searchIndexService) buildDocument(ctx context.Context, document *ent.Document) *search.Document {
+	tagIDs := make([]uuid.UUID, 0, len(document.Edges.DocumentTags))
+	for _, dt := range document.Edges.DocumentTags {
+		tagIDs = append(tagIDs, dt.TagID)
+	}
+
+	indexDocument := search.NewDocument(document, document.Edges.Content, tagIDs)
+	// Недоступность модели не останавливает перестройку: документ попадет в индекс
+	// без векторов и получит их при следующем изменении или перестройке индекса
+	if s.embedder.Enabled() {
+		chunks, err := s.embedder.DocumentChunks(ctx, document.Edges.Content)
+		if err != nil {
+			fmt.Printf("Failed to embed document %s during reindex: %v\n", document.ID, err)
+		} else {
+			indexDocument.Chunks = chunks
+		}
+	}
+	return indexDocument
+}
+
+// Status возвращает состояние алиаса и индексов
+func (s *searchIndexService) Status(ctx context.Context) (*service.SearchIndexStatus, error) {
+	var (
+		status *service.SearchIndexStatus
+		err    error
+	)
+	if s.indices != nil {
+		status, err = s.elasticsearchStatus(ctx)
+	} else {
+		status, err = s.inPlaceStatus(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status.PendingTasks, err = s.taskRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count search index tasks: %w", err)
+	}
+	status.FailingTasks, err = s.taskRepo.CountFailing(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count search index tasks: %w", err)
+	}
+
+	return status, nil
+}
+
+// elasticsearchStatus возвращает состояние алиаса и индексов Elasticsearch
+func (s *searchIndexService) elasticsearchStatus(ctx context.Context) (*service.SearchIndexStatus, error) {
+	targets, err := s.indices.AliasTargets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &service.SearchIndexStatus{
+		Backend:         search.BackendElasticsearch,
+		Alias:           elastic.Alias,
+		MappingVersion:  elastic.MappingVersion,
+		AliasTargets:    targets,
+		Building:        s.indices.Building(),
+		ReindexRequired: true,
+	}
+	for _, target := range targets {
+		if elastic.IsVersion(target, elastic.MappingVersion) {
+			status.ReindexRequired = false
+		}
+	}
+
+	if len(targets) > 0 {
+		status.DocumentsCount, err = s.indices.Count(ctx, elastic.Alias)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// inPlaceStatus возвращает состояние индекса в таблице PostgreSQL
+func (s *searchIndexService) inPlaceStatus(ctx context.Context) (*service.SearchIndexStatus, error) {
+	indexed, err := s.index.Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.documentRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count documents: %w", err)
+	}
+
+	return &service.SearchIndexStatus{
+		Backend:        search.BackendPostgres,
+		Alias:          postgres.Table,
+		AliasTargets:   []string{postgres.Table},
+		Building:       s.building(),
+		DocumentsCount: indexed,
+		// Пустой индекс при непустой БД - например, после переключения с Elasticsearch
+		ReindexRequired: indexed == 0 && total > 0,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package searchindex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/search"
+	"techmind/internal/search/outbox"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/ent/searchindextask"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+)
+
+const (
+	// syncPollInterval - период опроса outbox, если оповещений о новых задачах не было
+	syncPollInterval = 5 * time.Second
+	// syncBatchSize - число задач, забираемых из outbox за один запрос
+	syncBatchSize = 100
+	// syncTimeout - максимальное время обработки одной пачки задач
+	syncTimeout = 2 * time.Minute
+	// syncMinBackoff и syncMaxBackoff ограничивают паузу перед повтором неудачной задачи
+	syncMinBackoff = 5 * time.Second
+	syncMaxBackoff = 10 * time.Minute
+)
+
+// SyncWorker применяет к поисковому индексу изменения документов, записанные в outbox.
+// Задача - только сигнал: состояние документа всегда читается из БД,
+// поэтому повторная или запоздавшая обработка не портит индекс
+type SyncWorker struct {
+	taskRepo        repo.SearchIndexTaskRepository
+	documentRepo    repo.DocumentRepository
+	documentTagRepo repo.DocumentTagRepository
+	documentService service.DocumentService
+	index           search.SearchIndex
+	notifier        *outbox.Notifier
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewSyncWorker создает обработчик outbox, который работает, пока запущено приложение
+func NewSyncWorker(
+	lc fx.Lifecycle,
+	taskRepo repo.SearchIndexTaskRepository,
+	documentRepo repo.DocumentRepository,
+	documentTagRepo repo.DocumentTagRepository,
+	documentService service.DocumentService,
+	index search.SearchIndex,
+	notifier *outbox.Notifier,
+) *SyncWorker {
+	w := &SyncWorker{
+		taskRepo:        taskRepo,
+		documentRepo:    documentRepo,
+		documentTagRepo: documentTagRepo,
+		documentService: documentService,
+		index:           index,
+		notifier:        notifier,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go w.run()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			close(w.stop)
+			select {
+			case <-w.done:
+			case <-ctx.Done():
+			}
+			return nil
+		},
+	})
+
+	return w
+}
+
+// run обрабатывает задачи по оповещению из хуков и по таймеру
+func (w *SyncWorker) run() {
+	defer close(w.done)
+
+	ticker := time.NewTicker(syncPollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.processDue()
+
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		case <-w.notifier.C():
+		}
+	}
+}
+
+// processDue обрабатывает все задачи, время которых наступило
+func (w *SyncWorker) processDue() {
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+
+	for {
+		tasks, err := w.taskRepo.ListDue(ctx, time.Now(), syncBatchSize)
+		if err != nil {
+			fmt.Printf("Failed to load search index tasks: %v\n", err)
+			return
+		}
+		if len(tasks) == 0 {
+			return
+		}
+
+		// Несколько изменений одного документа применяются одной записью в индекс
+		var order []uuid.UUID
+		byDocument := make(map[uuid.UUID][]*ent.SearchIndexTask)
+		for _, task := range tasks {
+			if _, ok := byDocument[task.DocumentID]; !ok {
+				order = append(order, task.DocumentID)
+			}
+			byDocument[task.DocumentID] = append(byDocument[task.DocumentID], task)
+		}
+
+		for _, documentID := range order {
+			documentTasks := byDocument[documentID]
+			ids := make([]uuid.UUID, 0, len(documentTasks))
+			attempts := 0
+			for _, task := range documentTasks {
+				ids = append(ids, task.ID)
+				if task.Attempts > attempts {
+					attempts = task.Attempts
+				}
+			}
+
+			if err := w.apply(ctx, documentID, documentTasks); err != nil {
+				fmt.Printf("Failed to sync document %s to search index (attempt %d): %v\n", documentID, attempts+1, err)
+				if markErr := w.taskRepo.MarkFailed(ctx, ids, err.Error(), time.Now().Add(backoff(attempts))); markErr != nil {
+					fmt.Printf("Failed to postpone search index tasks: %v\n", markErr)
+				}
+				continue
+			}
+
+			if err := w.taskRepo.DeleteByIDs(ctx, ids); err != nil {
+				fmt.Printf("Failed to delete search index tasks: %v\n", err)
+			}
+		}
+
+		if len(tasks) < syncBatchSize {
+			return
+		}
+	}
+}
+
+// apply приводит документ в индексе к состоянию в БД
+func (w *SyncWorker) apply(ctx context.Context, documentID uuid.UUID, tasks []*ent.SearchIndexTask) error {
+	document, err := w.documentRepo.GetByID(ctx, documentID)
+	if ent.IsNotFound(err) {
+		return w.delete(ctx, documentID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get document: %w", err)
+	}
+
+	// Текст перечитывается только при его изменении или создании документа,
+	// для остальных изменений достаточно частичного обновления атрибутов
+	for _, task := range tasks {
+		if task.Operation != searchindextask.OperationUpdate {
+			return w.documentService.IndexDocument(ctx, documentID)
+		}
+	}
+	return w.update(ctx, document)
+}
+
+// update частично обновляет атрибуты документа в индексе
+func (w *SyncWorker) update(ctx context.Context, document *ent.Document) error {
+	docTags, err := w.documentTagRepo.ListByDocument(ctx, document.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get document tags: %w", err)
+	}
+	tagIDs := make([]uuid.UUID, 0, len(docTags))
+	for _, dt := range docTags {
+		tagIDs = append(tagIDs, dt.TagID)
+	}
+
+	err = w.index.Update(ctx, search.NewAttributes(document, tagIDs))
+	// Документа еще нет в индексе, например его не успела загрузить перестройка
+	if errors.Is(err, search.ErrNotIndexed) {
+		return w.documentService.IndexDocument(ctx, document.ID)
+	}
+	return err
+}
+
+// delete удаляет документ из индекса
+func (w *SyncWorker) delete(ctx context.Context, documentID uuid.UUID) error {
+	return w.index.Delete(ctx, documentID)
+}
+
+// backoff возвращает паузу перед следующей попыткой, удваивая ее с каждой неудачей
+func backoff(attempts int) time.Duration {
+	delay := syncMinBackoff
+	for i := 0; i < attempts && delay < syncMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > syncMaxBackoff {
+		delay = syncMaxBackoff
+	}
+	return delay
+}
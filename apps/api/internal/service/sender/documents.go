@@ -0,0 +1,167 @@
+package sender
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+func (s *senderService) GetDocuments(ctx context.Context, id uuid.UUID, input service.SenderDocumentsInput) (*service.SenderDocuments, error) {
+	sender, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := documentFilter(input.SenderDocumentsFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := pagination(input.Page, input.PageSize)
+	documents, total, err := s.repo.ListDocuments(ctx, id, filter, input.Ascending, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender documents: %w", err)
+	}
+
+	tags, err := s.documentTags(ctx, sender.CompanyID, documents)
+	if err != nil {
+		return nil, err
+	}
+
+	// Документы уже упорядочены по дате, поэтому месяц меняется только на границе группы
+	months := make([]service.SenderDocumentsMonth, 0)
+	for _, document := range documents {
+		month := monthStart(document.CreatedAt)
+		if len(months) == 0 || !months[len(months)-1].Month.Equal(month) {
+			months = append(months, service.SenderDocumentsMonth{Month: month})
+		}
+		last := &months[len(months)-1]
+		last.Documents = append(last.Documents, &service.DocumentWithTags{
+			Document: document,
+			Tags:     tags[document.ID],
+		})
+	}
+
+	return &service.SenderDocuments{
+		Months:   months,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+func (s *senderService) GetDocumentStats(ctx context.Context, id uuid.UUID, input service.SenderDocumentsFilter) (*service.SenderDocumentStats, error) {
+	sender, err := s.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := documentFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.repo.DocumentStats(ctx, id, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sender document stats: %w", err)
+	}
+
+	result := &service.SenderDocumentStats{
+		Documents: stats.Documents,
+		TotalSize: stats.TotalSize,
+		FirstAt:   stats.FirstAt,
+		LastAt:    stats.LastAt,
+		Months:    make([]service.SenderMonthStats, 0, len(stats.Months)),
+		MimeTypes: make([]service.SenderMimeTypeStats, 0, len(stats.MimeTypes)),
+		Tags:      make([]service.SenderTagStats, 0, len(stats.Tags)),
+	}
+	for _, month := range stats.Months {
+		result.Months = append(result.Months, service.SenderMonthStats{
+			Month:     month.Start,
+			Documents: month.Documents,
+			TotalSize: month.TotalSize,
+		})
+	}
+	for _, mimeType := range stats.MimeTypes {
+		result.MimeTypes = append(result.MimeTypes, service.SenderMimeTypeStats{
+			MimeType:  mimeType.MimeType,
+			Documents: mimeType.Documents,
+		})
+	}
+
+	if len(stats.Tags) > 0 {
+		companyTags, err := s.tagRepo.ListByCompany(ctx, sender.CompanyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags by company: %w", err)
+		}
+		tagsByID := make(map[uuid.UUID]*ent.Tag, len(companyTags))
+		for _, tag := range companyTags {
+			tagsByID[tag.ID] = tag
+		}
+		for _, tag := range stats.Tags {
+			// Теги другой компании у документа не показываются, как и в его карточке
+			if t, ok := tagsByID[tag.TagID]; ok {
+				result.Tags = append(result.Tags, service.SenderTagStats{Tag: t, Documents: tag.Documents})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// documentTags получает теги документов страницы
+func (s *senderService) documentTags(ctx context.Context, companyID uuid.UUID, documents []*ent.Document) (map[uuid.UUID][]*ent.Tag, error) {
+	tags := make(map[uuid.UUID][]*ent.Tag, len(documents))
+	if len(documents) == 0 {
+		return tags, nil
+	}
+
+	documentIDs := make([]uuid.UUID, 0, len(documents))
+	for _, document := range documents {
+		documentIDs = append(documentIDs, document.ID)
+	}
+	links, err := s.documentTagRepo.ListByDocuments(ctx, documentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document tags: %w", err)
+	}
+	if len(links) == 0 {
+		return tags, nil
+	}
+
+	companyTags, err := s.tagRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags by company: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]*ent.Tag, len(companyTags))
+	for _, tag := range companyTags {
+		tagsByID[tag.ID] = tag
+	}
+	for _, link := range links {
+		if tag, ok := tagsByID[link.TagID]; ok {
+			tags[link.DocumentID] = append(tags[link.DocumentID], tag)
+		}
+	}
+	return tags, nil
+}
+
+// documentFilter проверяет интервал и переводит фильтр в параметры репозитория
+func documentFilter(filter service.SenderDocumentsFilter) (repo.SenderDocumentFilter, error) {
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return repo.SenderDocumentFilter{}, fmt.Errorf("%w: from must be before to", service.ErrInvalidSenderDocumentsFilter)
+	}
+	return repo.SenderDocumentFilter{
+		From:      filter.From,
+		To:        filter.To,
+		MimeTypes: filter.MimeTypes,
+		TagIDs:    filter.TagIDs,
+		FolderID:  filter.FolderID,
+	}, nil
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
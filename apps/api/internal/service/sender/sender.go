@@ -3,6 +3,9 @@ package sender
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"techmind/internal/counterparty"
 	"techmind/internal/repo"
 	"techmind/internal/service"
 	"techmind/schema/ent"
@@ -10,34 +13,106 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// defaultPageSize - размер страницы списка контрагентов и их документов по умолчанию
+	defaultPageSize = 50
+	// maxPageSize - максимальный размер страницы списка контрагентов и их документов
+	maxPageSize = 200
+)
+
 type senderService struct {
-	repo repo.SenderRepository
+	repo            repo.SenderRepository
+	documentTagRepo repo.DocumentTagRepository
+	tagRepo         repo.TagRepository
 }
 
-func NewService(repo repo.SenderRepository) service.SenderService {
+func NewService(repo repo.SenderRepository, documentTagRepo repo.DocumentTagRepository, tagRepo repo.TagRepository) service.SenderService {
 	return &senderService{
-		repo: repo,
+		repo:            repo,
+		documentTagRepo: documentTagRepo,
+		tagRepo:         tagRepo,
 	}
 }
 
-func (s *senderService) Create(ctx context.Context, companyID uuid.UUID, name string, email *string) (*ent.Sender, error) {
-	sender, err := s.repo.Create(ctx, companyID, name, email)
+func (s *senderService) Create(ctx context.Context, companyID uuid.UUID, input service.SenderInput, force bool) (*ent.Sender, []service.SenderDuplicate, error) {
+	fields, err := senderFields(input)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create sender: %w", err)
+		return nil, nil, err
 	}
-	return sender, nil
+
+	if !force {
+		duplicates, err := s.findDuplicates(ctx, companyID, fields)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(duplicates) > 0 {
+			return nil, duplicates, service.ErrSenderDuplicate
+		}
+	}
+
+	sender, err := s.repo.Create(ctx, companyID, fields)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sender: %w", err)
+	}
+	return sender, nil, nil
+}
+
+func (s *senderService) FindDuplicates(ctx context.Context, companyID uuid.UUID, input service.SenderInput) ([]service.SenderDuplicate, error) {
+	fields, err := senderFields(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.findDuplicates(ctx, companyID, fields)
+}
+
+// findDuplicates сравнивает карточку со всеми контрагентами компании
+func (s *senderService) findDuplicates(ctx context.Context, companyID uuid.UUID, fields repo.SenderFields) ([]service.SenderDuplicate, error) {
+	senders, err := s.repo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get senders by company: %w", err)
+	}
+
+	card := fieldsCard(fields)
+	duplicates := make([]service.SenderDuplicate, 0)
+	for _, sender := range senders {
+		match := counterparty.Compare(card, counterparty.SenderCard(sender))
+		if !match.Duplicate() {
+			continue
+		}
+		duplicates = append(duplicates, service.SenderDuplicate{
+			Sender:  sender,
+			Score:   match.Score,
+			Reasons: match.Reasons,
+		})
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Score > duplicates[j].Score
+	})
+	return duplicates, nil
 }
 
 func (s *senderService) GetByID(ctx context.Context, id uuid.UUID) (*ent.Sender, error) {
 	sender, err := s.repo.GetByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrSenderNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sender: %w", err)
 	}
 	return sender, nil
 }
 
-func (s *senderService) Update(ctx context.Context, id uuid.UUID, name string, email *string) (*ent.Sender, error) {
-	sender, err := s.repo.Update(ctx, id, name, email)
+func (s *senderService) Update(ctx context.Context, id uuid.UUID, input service.SenderInput) (*ent.Sender, error) {
+	fields, err := senderFields(input)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := s.repo.Update(ctx, id, fields)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrSenderNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sender: %w", err)
 	}
@@ -52,10 +127,200 @@ func (s *senderService) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
-func (s *senderService) GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Sender, error) {
-	senders, err := s.repo.ListByCompany(ctx, companyID)
+func (s *senderService) GetByCompany(ctx context.Context, companyID uuid.UUID, input service.SenderListInput) (*service.SenderList, error) {
+	page, pageSize := pagination(input.Page, input.PageSize)
+	senders, total, err := s.repo.Search(ctx, companyID, strings.TrimSpace(input.Query), (page-1)*pageSize, pageSize)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get senders by company: %w", err)
 	}
-	return senders, nil
+	return &service.SenderList{
+		Senders:  senders,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+func (s *senderService) Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*ent.Sender, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: cannot merge sender into itself", service.ErrInvalidSender)
+	}
+	source, err := s.GetByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.GetByID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if source.CompanyID != target.CompanyID {
+		return nil, fmt.Errorf("%w: senders belong to different companies", service.ErrInvalidSender)
+	}
+
+	if err := s.repo.Merge(ctx, sourceID, targetID, mergeFields(target, source)); err != nil {
+		return nil, fmt.Errorf("failed to merge senders: %w", err)
+	}
+	return s.GetByID(ctx, targetID)
+}
+
+// pagination приводит номер и размер страницы к допустимым значениям
+func pagination(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	return page, pageSize
+}
+
+// mergeFields заполняет пустые поля target значениями source и объединяет списки без повторов
+func mergeFields(target, source *ent.Sender) repo.SenderFields {
+	fields := repo.SenderFields{
+		Name:      target.Name,
+		Email:     firstNonEmpty(target.Email, source.Email),
+		LegalName: firstNonEmpty(target.LegalName, source.LegalName),
+		INN:       target.Inn,
+		KPP:       target.Kpp,
+		Phones:    unique(append(append([]string{}, target.Phones...), source.Phones...), counterparty.NormalizePhone),
+		Addresses: unique(append(append([]string{}, target.Addresses...), source.Addresses...), strings.ToLower),
+		Website:   firstNonEmpty(target.Website, source.Website),
+		Notes:     target.Notes,
+	}
+	// КПП относится к ИНН, поэтому берется у того же контрагента, что и ИНН
+	switch {
+	case target.Inn == nil:
+		fields.INN, fields.KPP = source.Inn, source.Kpp
+	case target.Kpp == nil && value(source.Inn) == *target.Inn:
+		fields.KPP = source.Kpp
+	}
+
+	emails := append(append([]string{}, target.Emails...), source.Emails...)
+	if source.Email != nil {
+		emails = append(emails, *source.Email)
+	}
+	fields.Emails = withoutPrimary(unique(emails, counterparty.NormalizeEmail), fields.Email)
+
+	if source.Notes != nil && *source.Notes != "" {
+		if fields.Notes == nil || *fields.Notes == "" {
+			fields.Notes = source.Notes
+		} else if *fields.Notes != *source.Notes {
+			notes := *fields.Notes + "\n\n" + *source.Notes
+			fields.Notes = &notes
+		}
+	}
+	return fields
+}
+
+// senderFields проверяет карточку и приводит ее к виду для хранения:
+// пробелы по краям убираются, пустые значения становятся nil, повторы в списках удаляются
+func senderFields(input service.SenderInput) (repo.SenderFields, error) {
+	fields := repo.SenderFields{
+		Name:      strings.TrimSpace(input.Name),
+		LegalName: trimmed(input.LegalName),
+		INN:       trimmed(input.INN),
+		KPP:       trimmed(input.KPP),
+		Phones:    unique(input.Phones, counterparty.NormalizePhone),
+		Addresses: unique(input.Addresses, strings.ToLower),
+		Website:   trimmed(input.Website),
+		Notes:     trimmed(input.Notes),
+	}
+	if email := trimmed(input.Email); email != nil {
+		normalized := counterparty.NormalizeEmail(*email)
+		fields.Email = &normalized
+	}
+	emails := make([]string, 0, len(input.Emails))
+	for _, email := range input.Emails {
+		emails = append(emails, counterparty.NormalizeEmail(email))
+	}
+	fields.Emails = withoutPrimary(unique(emails, counterparty.NormalizeEmail), fields.Email)
+	if fields.KPP != nil {
+		kpp := strings.ToUpper(*fields.KPP)
+		fields.KPP = &kpp
+	}
+
+	if err := counterparty.Validate(fieldsCard(fields)); err != nil {
+		return fields, fmt.Errorf("%w: %v", service.ErrInvalidSender, err)
+	}
+	return fields, nil
+}
+
+// fieldsCard собирает реквизиты для сравнения, основной адрес почты идет первым
+func fieldsCard(fields repo.SenderFields) counterparty.Card {
+	return counterparty.Card{
+		Name:      fields.Name,
+		LegalName: value(fields.LegalName),
+		INN:       value(fields.INN),
+		KPP:       value(fields.KPP),
+		Emails:    emailsWithPrimary(fields.Email, fields.Emails),
+		Phones:    fields.Phones,
+		Website:   value(fields.Website),
+	}
+}
+
+func emailsWithPrimary(primary *string, emails []string) []string {
+	if primary == nil {
+		return emails
+	}
+	return append([]string{*primary}, emails...)
+}
+
+// withoutPrimary убирает из дополнительных адресов основной адрес
+func withoutPrimary(emails []string, primary *string) []string {
+	if primary == nil {
+		return emails
+	}
+	filtered := make([]string, 0, len(emails))
+	for _, email := range emails {
+		if counterparty.NormalizeEmail(email) != counterparty.NormalizeEmail(*primary) {
+			filtered = append(filtered, email)
+		}
+	}
+	return filtered
+}
+
+// unique убирает пустые значения и повторы, значения сравниваются после нормализации
+func unique(values []string, normalize func(string) string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		key := normalize(v)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, v)
+	}
+	return result
+}
+
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	if t == "" {
+		return nil
+	}
+	return &t
+}
+
+func firstNonEmpty(values ...*string) *string {
+	for _, v := range values {
+		if v != nil && *v != "" {
+			return v
+		}
+	}
+	return nil
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
 }
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"techmind/schema/ent"
+	"techmind/schema/types"
 
 	"github.com/google/uuid"
 )
@@ -60,6 +65,13 @@ type DocumentUploadInput struct {
 	MimeType  string
 	SenderID  *uuid.UUID
 	UserID    uuid.UUID // ID пользователя, который загружает документ
+
+	// TypeID - тип документа компании, Fields - значения полей по схеме типа
+	TypeID *uuid.UUID
+	Fields map[string]json.RawMessage
+
+	// MapKeywordsToTags включает привязку тегов компании по ключевым словам из метаданных PDF
+	MapKeywordsToTags bool
 }
 
 // DocumentUpdateInput содержит данные для обновления метаданных документа
@@ -68,6 +80,13 @@ type DocumentUpdateInput struct {
 	FolderID *uuid.UUID
 	SenderID *uuid.UUID
 	UserID   uuid.UUID // ID пользователя, который обновляет документ
+
+	// TypeID меняет тип документа: совпадающие по ключу и типу значения полей переносятся в новый тип
+	TypeID *uuid.UUID
+	// Fields заменяет значения перечисленных полей, null удаляет значение
+	Fields map[string]json.RawMessage
+	// ClearType снимает с документа тип и значения полей
+	ClearType bool
 }
 
 // DocumentWithTags содержит документ вместе с его тегами
@@ -108,12 +127,34 @@ type DocumentService interface {
 	// Возвращает presigned URL для доступа к файлу в MinIO
 	GetDownloadURL(ctx context.Context, documentID uuid.UUID) (url string, err error)
 
+	// GetDownloadURLWithMetadata получает временную ссылку на копию PDF документа
+	// с записанными в метаданные названием, отправителем, тегами и ID документа
+	GetDownloadURLWithMetadata(ctx context.Context, documentID uuid.UUID) (url string, err error)
+
+	// GetSearchablePDFURL получает временную ссылку на PDF с текстовым слоем,
+	// построенный при распознавании скана
+	GetSearchablePDFURL(ctx context.Context, documentID uuid.UUID) (url string, err error)
+
+	// GetWatermarkedDownloadURL получает временную ссылку на PDF копию документа
+	// с водяным знаком и штампом для указанного пользователя
+	// Пустые поля input берутся из настроек компании, готовые копии кэшируются в MinIO
+	GetWatermarkedDownloadURL(ctx context.Context, documentID, userID uuid.UUID, input WatermarkInput) (url string, err error)
+
 	// GetPreviewURL получает временную ссылку на preview документа
 	// Возвращает presigned URL для доступа к preview файлу
 	GetPreviewURL(ctx context.Context, documentID uuid.UUID) (url string, err error)
 
 	// Search ищет документы по различным критериям
-	Search(ctx context.Context, companyID uuid.UUID, query string, folderID *uuid.UUID, tagIDs []uuid.UUID) ([]*DocumentWithTags, error)
+	// Возвращает страницу результатов с общим числом найденных документов, релевантностью и подсветкой
+	Search(ctx context.Context, input DocumentSearchInput) (*DocumentSearchResult, error)
+
+	// SearchIDs возвращает ID документов, подходящих под поиск, в порядке выдачи, но не больше limit
+	// Страницы, фасеты и аналитика поиска не используются
+	SearchIDs(ctx context.Context, input DocumentSearchInput, limit int) ([]uuid.UUID, error)
+
+	// Suggest возвращает подсказки для строки поиска: названия документов, теги и контрагентов компании,
+	// начинающиеся с введенного текста или содержащие его
+	Suggest(ctx context.Context, companyID uuid.UUID, prefix string, limit int) (*SearchSuggestions, error)
 
 	// GeneratePDFPreview конвертирует файл документа в PDF превью и загружает его в MinIO
 	// Поддерживает конвертацию Office документов (docx, xlsx, pptx и т.д.) через Gotenberg
@@ -122,54 +163,540 @@ type DocumentService interface {
 
 	// ExtractAndIndexText извлекает текст из документа и индексирует его в Elasticsearch
 	// Использует docconv для извлечения текста из различных форматов документов
-	// Сохраняет извлеченный текст в индекс "documents" в Elasticsearch
+	// Сохраняет извлеченный текст в БД (DocumentContent), в индекс "documents" он попадает через outbox
 	ExtractAndIndexText(ctx context.Context, documentID uuid.UUID) error
+
+	// IndexDocument индексирует документ в Elasticsearch по тексту, сохраненному в БД
+	// Позволяет перестроить индекс без повторного скачивания и разбора файлов
+	IndexDocument(ctx context.Context, documentID uuid.UUID) error
+
+	// GetText возвращает извлеченный текст документа, page > 0 - текст одной страницы
+	GetText(ctx context.Context, documentID uuid.UUID, page int) (*DocumentText, error)
+
+	// ReadPDFMetadata читает метаданные PDF (Title, Author, Subject, Keywords, CreationDate, Producer)
+	// и сохраняет их в поле metadata документа
+	// Если mapKeywordsToTags включен, ключевые слова привязываются к одноименным тегам компании
+	ReadPDFMetadata(ctx context.Context, documentID uuid.UUID, mapKeywordsToTags bool) error
+
+	// DetectSender ищет в тексте и метаданных документа почту, домены, ИНН с КПП и названия организаций
+	// и сравнивает их со справочником контрагентов компании
+	// Документу без контрагента присваивается найденный с уверенностью не ниже counterparty.AttachThreshold,
+	// организации, которых нет в справочнике, сохраняются как предложения новых контрагентов
+	DetectSender(ctx context.Context, documentID uuid.UUID) (*types.SenderDetection, error)
+
+	// GetSenderDetection получает последний результат определения контрагента документа
+	GetSenderDetection(ctx context.Context, documentID uuid.UUID) (*types.SenderDetection, error)
+
+	// ConfirmSenderProposal создает контрагента из предложения index и присваивает его документу без контрагента
+	// Если в компании есть похожие карточки и force не задан, возвращаются возможные дубли и ErrSenderDuplicate
+	ConfirmSenderProposal(ctx context.Context, documentID uuid.UUID, index int, force bool) (*ent.Sender, []SenderDuplicate, error)
+
+	// DismissSenderProposal отклоняет предложение index, контрагент не создается
+	DismissSenderProposal(ctx context.Context, documentID uuid.UUID, index int) error
+
+	// ExtractFields ищет значения полей типа документа в тексте по шаблону типа
+	// Значения с уверенностью не ниже порога шаблона записываются в пустые поля документа,
+	// остальные ждут проверки. Заполненные пользователем поля не меняются
+	ExtractFields(ctx context.Context, documentID uuid.UUID) (*types.FieldExtraction, error)
+
+	// GetFieldExtraction получает последний результат извлечения значений полей для текущего типа документа
+	GetFieldExtraction(ctx context.Context, documentID uuid.UUID) (*types.FieldExtraction, error)
+
+	// ConfirmExtractedField записывает найденное значение поля key в документ
+	// Непустой value заменяет найденное значение, например если в тексте распознана неверная сумма
+	ConfirmExtractedField(ctx context.Context, documentID uuid.UUID, key string, value json.RawMessage) (*types.FieldExtraction, error)
+
+	// RejectExtractedField отклоняет найденное значение поля key
+	// Записанное автоматически значение удаляется из документа, если поле не обязательное и не менялось пользователем
+	RejectExtractedField(ctx context.Context, documentID uuid.UUID, key string) (*types.FieldExtraction, error)
+
+	// GetFieldExtractionReview получает документы компании со значениями полей, ожидающими проверки, сначала новые
+	GetFieldExtractionReview(ctx context.Context, companyID uuid.UUID, input FieldExtractionReviewInput) (*FieldExtractionReview, error)
+}
+
+// ErrDocumentDuplicate - в компании уже есть документ с таким же содержимым
+var ErrDocumentDuplicate = errors.New("document with this checksum already exists")
+
+// Ошибки определения контрагента документа
+var (
+	// ErrSenderDetectionNotFound - контрагент документа еще не определялся
+	ErrSenderDetectionNotFound = errors.New("sender detection not found")
+	// ErrSenderProposalNotFound - у документа нет предложения контрагента с таким номером
+	ErrSenderProposalNotFound = errors.New("sender proposal not found")
+)
+
+// Ошибки извлечения значений полей документа
+var (
+	// ErrFieldExtractionNotConfigured - у документа нет типа или у типа нет шаблона извлечения
+	ErrFieldExtractionNotConfigured = errors.New("field extraction is not configured for document type")
+	// ErrFieldExtractionNotFound - значения полей для текущего типа документа еще не извлекались
+	ErrFieldExtractionNotFound = errors.New("field extraction not found")
+	// ErrExtractedFieldNotFound - в результате извлечения нет значения поля с таким ключом
+	ErrExtractedFieldNotFound = errors.New("extracted field not found")
+)
+
+// FieldExtractionReviewInput содержит параметры очереди проверки извлеченных значений
+type FieldExtractionReviewInput struct {
+	// Page начинается с 1
+	Page     int
+	PageSize int
+}
+
+// FieldExtractionReview - страница очереди проверки извлеченных значений
+type FieldExtractionReview struct {
+	Items    []FieldExtractionReviewItem
+	Total    int
+	Page     int
+	PageSize int
+}
+
+// FieldExtractionReviewItem - документ и его значения, ожидающие проверки
+type FieldExtractionReviewItem struct {
+	Document *ent.Document
+	Fields   []types.ExtractedField
 }
 
 // DocumentTagService определяет интерфейс для работы с тегами документов
 type DocumentTagService interface {
-	// GetDocumentTags получает все теги конкретного документа
-	GetDocumentTags(ctx context.Context, documentID uuid.UUID) ([]*ent.Tag, error)
+	// GetDocumentTags получает все теги конкретного документа вместе с источником каждого тега
+	GetDocumentTags(ctx context.Context, documentID uuid.UUID) ([]AssignedTag, error)
 
-	// AddTagToDocument добавляет существующий тег к документу
-	AddTagToDocument(ctx context.Context, documentID, tagID uuid.UUID) error
+	// AddTagToDocument добавляет существующий тег к документу от имени пользователя
+	// Если тег входит в исключающую группу, остальные теги этой группы у документа снимаются
+	// Автоматически присвоенный тег становится ручным, запрет на повторное присвоение снимается
+	AddTagToDocument(ctx context.Context, userID, documentID, tagID uuid.UUID) error
 
 	// RemoveTagFromDocument удаляет тег у документа
-	RemoveTagFromDocument(ctx context.Context, documentID, tagID uuid.UUID) error
+	// Правила тегирования больше не присваивают этот тег документу, пока пользователь не добавит его вручную.
+	// Если тега у документа нет, запрет создается только при suppress
+	RemoveTagFromDocument(ctx context.Context, userID, documentID, tagID uuid.UUID, suppress bool) error
 
-	// CreateTag создает новый тег в компании
-	CreateTag(ctx context.Context, companyID uuid.UUID, name string) (*ent.Tag, error)
+	// CreateTag создает новый тег в компании и правило, присваивающее его документам с названием тега
+	// Если тег с таким названием уже есть, возвращает существующий
+	CreateTag(ctx context.Context, companyID uuid.UUID, input TagInput) (*ent.Tag, error)
 
 	// DeleteTag удаляет тег из системы
-	// Также удаляет все связи этого тега с документами
+	// Также удаляет все связи этого тега с документами, дочерние теги переходят к родителю удаленного тега
 	DeleteTag(ctx context.Context, tagID uuid.UUID) error
 
 	// GetTagsByCompany получает все теги компании
 	GetTagsByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Tag, error)
 
+	// GetTagTree получает теги компании в виде дерева, корни и дочерние теги отсортированы по названию
+	GetTagTree(ctx context.Context, companyID uuid.UUID) ([]*TagNode, error)
+
 	// GetTagByID получает тег по ID
 	GetTagByID(ctx context.Context, tagID uuid.UUID) (*ent.Tag, error)
 
-	// UpdateTag обновляет название тега
-	UpdateTag(ctx context.Context, tagID uuid.UUID, name string) (*ent.Tag, error)
+	// UpdateTag заменяет название, родителя, группу и оформление тега
+	// Тег нельзя переместить внутрь его собственного поддерева
+	UpdateTag(ctx context.Context, tagID uuid.UUID, input TagInput) (*ent.Tag, error)
+
+	// CreateTagGroup создает группу тегов в компании
+	CreateTagGroup(ctx context.Context, companyID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error)
+
+	// UpdateTagGroup обновляет название группы и признак исключающей группы
+	// Включение exclusive не меняет уже присвоенные теги, ограничение действует при следующих присвоениях
+	UpdateTagGroup(ctx context.Context, groupID uuid.UUID, name string, exclusive bool) (*ent.TagGroup, error)
+
+	// DeleteTagGroup удаляет группу, теги группы остаются без группы
+	DeleteTagGroup(ctx context.Context, groupID uuid.UUID) error
+
+	// GetTagGroupsByCompany получает все группы тегов компании
+	GetTagGroupsByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.TagGroup, error)
+
+	// MergeTags объединяет тег sourceID с тегом targetID той же компании в одной транзакции:
+	// документы, правила, дочерние теги и ссылки в сохраненных поисках переходят к targetID,
+	// повторные связи удаляются, у документа остается один тег исключающей группы targetID, sourceID удаляется
+	MergeTags(ctx context.Context, sourceID, targetID uuid.UUID) (*ent.Tag, error)
+
+	// BulkTag добавляет и снимает теги у списка документов или у всех документов результата поиска
+	BulkTag(ctx context.Context, input BulkTagInput) (*BulkTagResult, error)
+
+	// GetTagUsage возвращает теги компании с числом документов и временем последнего присвоения,
+	// сначала самые используемые
+	GetTagUsage(ctx context.Context, companyID uuid.UUID) ([]TagUsage, error)
+}
+
+var (
+	// ErrInvalidTag - неверное название, цвет, родитель или группа тега
+	ErrInvalidTag = errors.New("invalid tag")
+	// ErrTagExists - в компании уже есть тег с таким названием без учета регистра
+	ErrTagExists = errors.New("tag with this name already exists")
+	// ErrInvalidBulkTag - не заданы документы или теги массовой операции
+	ErrInvalidBulkTag = errors.New("invalid bulk tag request")
+	// ErrTooManyDocuments - результат поиска для массовой операции слишком большой
+	ErrTooManyDocuments = errors.New("too many documents")
+)
+
+// BulkTagInput содержит параметры массового изменения тегов
+// Документы задаются списком DocumentIDs или поиском Query и Filters
+type BulkTagInput struct {
+	// UserID - пользователь, от имени которого меняются теги
+	UserID      uuid.UUID
+	CompanyID   uuid.UUID
+	DocumentIDs []uuid.UUID
+	// Search - документы берутся из результата поиска, если DocumentIDs пуст
+	Search *DocumentSearchInput
+	// Add - теги, которые добавляются документам, Remove - снимаются
+	Add    []uuid.UUID
+	Remove []uuid.UUID
+}
+
+// BulkTagResult - итог массового изменения тегов
+type BulkTagResult struct {
+	// Documents - обработанные документы компании
+	Documents int
+	// Skipped - документы не найдены или принадлежат другой компании
+	Skipped int
+	// Added и Removed - число созданных и удаленных связей документ-тег
+	Added   int
+	Removed int
+}
+
+// AssignedTag - тег документа и сведения о том, кто и когда его присвоил
+type AssignedTag struct {
+	Tag  *ent.Tag
+	Link *ent.DocumentTag
+}
+
+// TagUsage - статистика использования тега
+type TagUsage struct {
+	Tag           *ent.Tag
+	Path          string
+	DocumentCount int64
+	// LastUsedAt - время последнего присвоения тега документу, nil - тег не используется
+	LastUsedAt *time.Time
+}
+
+// TagInput содержит параметры тега
+type TagInput struct {
+	// Name - название тега без разделителя пути "/"
+	Name string
+	// ParentID - родительский тег той же компании, nil - корневой тег
+	ParentID *uuid.UUID
+	// GroupID - группа тегов той же компании, nil - без группы
+	GroupID *uuid.UUID
+	// Color - цвет в формате #RRGGBB, nil - без цвета
+	Color       *string
+	Icon        *string
+	Description *string
+}
+
+// TagNode - тег в дереве тегов компании
+type TagNode struct {
+	Tag *ent.Tag
+	// Path - названия тегов от корня через "/", например "Финансы/Счета/Оплачено"
+	Path     string
+	Children []*TagNode
+}
+
+// Ошибки правил тегирования
+var (
+	// ErrTagRuleNotFound - правило не существует
+	ErrTagRuleNotFound = errors.New("tag rule not found")
+	// ErrTagRuleForbidden - пользователь не состоит в компании правила
+	ErrTagRuleForbidden = errors.New("tag rule access denied")
+	// ErrInvalidTagRule - правило без условий или с неверными режимами, выражениями или шаблонами
+	ErrInvalidTagRule = errors.New("invalid tag rule")
+	// ErrTagRuleRunNotFound - запуск правил не существует или присвоенные им теги уже сняты
+	ErrTagRuleRunNotFound = errors.New("tag rule run not found")
+)
+
+// TagRuleInput содержит параметры правила автоматического тегирования
+type TagRuleInput struct {
+	// TagID - тег, который правило присваивает документу
+	TagID      uuid.UUID
+	Name       string
+	Conditions types.TagRuleConditions
+	// Priority - правила проверяются по убыванию приоритета
+	Priority int
+	// Stop - после срабатывания правила следующие правила не проверяются
+	Stop    bool
+	Enabled bool
+}
+
+// TagRuleEvaluation - результат проверки правила на документе
+type TagRuleEvaluation struct {
+	Rule *ent.TagRule
+	Tag  *ent.Tag
+	// Matched - все условия правила выполнены, тег будет присвоен
+	Matched bool
+	// Skipped - правило отключено или пропущено после правила с флагом stop
+	Skipped bool
+	// AlreadyTagged - у документа уже есть тег правила
+	AlreadyTagged bool
+	// Suppressed - пользователь снял тег с документа, правило его не присвоит
+	Suppressed bool
+	// GroupTaken - тег исключающей группы уже выбран правилом с большим приоритетом, правило его не присвоит
+	GroupTaken bool
+	// ManualInGroup - у документа есть тег исключающей группы, присвоенный вручную, правило его не заменит
+	ManualInGroup bool
+	// Matches - выполненные условия, например keyword "договор"
+	Matches []string
+	// Reason - невыполненное условие или причина пропуска
+	Reason string
+}
+
+// TagRuleService определяет интерфейс для правил автоматического тегирования
+// Правила компании проверяются по убыванию приоритета после извлечения текста документа
+type TagRuleService interface {
+	// Create создает правило для тега компании
+	Create(ctx context.Context, userID uuid.UUID, input TagRuleInput) (*ent.TagRule, error)
+
+	// GetByID получает правило
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*ent.TagRule, error)
+
+	// List получает правила компании в порядке проверки
+	List(ctx context.Context, userID, companyID uuid.UUID) ([]*ent.TagRule, error)
+
+	// Update изменяет правило
+	Update(ctx context.Context, userID, id uuid.UUID, input TagRuleInput) (*ent.TagRule, error)
+
+	// Delete удаляет правило, уже присвоенные им теги остаются
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+
+	// DryRun проверяет все правила компании на документе, не изменяя его теги
+	DryRun(ctx context.Context, userID, documentID uuid.UUID) ([]TagRuleEvaluation, error)
+
+	// ApplyRules присваивает документу теги сработавших правил и возвращает добавленные теги
+	ApplyRules(ctx context.Context, documentID uuid.UUID) ([]*ent.Tag, error)
+
+	// Reapply запускает фоновое применение правил ко всем документам компании
+	// и возвращает задачу для отслеживания прогресса, ID задачи - ID запуска для UndoRun
+	Reapply(ctx context.Context, userID, companyID uuid.UUID) (*ent.Job, error)
+
+	// UndoRun снимает теги, присвоенные правилами в запуске runID, и возвращает число снятых тегов
+	// Теги, которые пользователь после запуска добавил вручную, остаются
+	UndoRun(ctx context.Context, userID, runID uuid.UUID) (int, error)
 }
 
 // SenderService определяет интерфейс для работы с контрагентами (отправителями)
 type SenderService interface {
 	// Create создает нового контрагента
-	Create(ctx context.Context, companyID uuid.UUID, name string, email *string) (*ent.Sender, error)
+	// Если в компании есть похожие карточки и force не задан, контрагент не создается:
+	// возвращаются возможные дубли и ErrSenderDuplicate
+	Create(ctx context.Context, companyID uuid.UUID, input SenderInput, force bool) (*ent.Sender, []SenderDuplicate, error)
+
+	// FindDuplicates ищет в компании карточки, похожие на input, сначала самые похожие
+	FindDuplicates(ctx context.Context, companyID uuid.UUID, input SenderInput) ([]SenderDuplicate, error)
 
 	// GetByID получает контрагента по ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Sender, error)
 
-	// Update обновляет данные контрагента
-	Update(ctx context.Context, id uuid.UUID, name string, email *string) (*ent.Sender, error)
+	// Update заменяет карточку контрагента
+	Update(ctx context.Context, id uuid.UUID, input SenderInput) (*ent.Sender, error)
 
 	// Delete удаляет контрагента
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// GetByCompany получает всех контрагентов компании
-	GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Sender, error)
+	// GetByCompany получает страницу контрагентов компании по названию, ИНН или почте
+	GetByCompany(ctx context.Context, companyID uuid.UUID, input SenderListInput) (*SenderList, error)
+
+	// Merge объединяет контрагента sourceID с контрагентом targetID той же компании:
+	// пустые поля targetID заполняются из sourceID, списки объединяются,
+	// документы и ссылки в правилах и сохраненных поисках переходят к targetID, sourceID удаляется
+	Merge(ctx context.Context, sourceID, targetID uuid.UUID) (*ent.Sender, error)
+
+	// GetDocuments получает страницу документов контрагента по дате создания, сгруппированную по месяцам
+	GetDocuments(ctx context.Context, id uuid.UUID, input SenderDocumentsInput) (*SenderDocuments, error)
+
+	// GetDocumentStats получает сводку по документам контрагента: даты первого и последнего документа,
+	// общий объем и число документов по месяцам, типам файлов и тегам
+	GetDocumentStats(ctx context.Context, id uuid.UUID, filter SenderDocumentsFilter) (*SenderDocumentStats, error)
+}
+
+// Ошибки справочника контрагентов
+var (
+	// ErrSenderNotFound - контрагент не существует
+	ErrSenderNotFound = errors.New("sender not found")
+	// ErrInvalidSender - неверное название, ИНН, КПП, почта или сайт контрагента
+	ErrInvalidSender = errors.New("invalid sender")
+	// ErrSenderDuplicate - в компании есть похожие контрагенты
+	ErrSenderDuplicate = errors.New("possible duplicate senders found")
+	// ErrInvalidSenderDocumentsFilter - начало интервала документов контрагента не раньше его конца
+	ErrInvalidSenderDocumentsFilter = errors.New("invalid sender documents filter")
+)
+
+// DocumentTypeService определяет интерфейс для работы с типами документов компании
+type DocumentTypeService interface {
+	// Create создает тип документа со схемой полей
+	Create(ctx context.Context, companyID uuid.UUID, input DocumentTypeInput) (*ent.DocumentType, error)
+
+	// GetByID получает тип документа по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.DocumentType, error)
+
+	// Update заменяет название, описание и схему полей типа документа
+	// Значения полей документов не пересчитываются и проверяются по новой схеме при следующем изменении
+	Update(ctx context.Context, id uuid.UUID, input DocumentTypeInput) (*ent.DocumentType, error)
+
+	// Delete удаляет тип документа, документы этого типа остаются без типа и значений полей
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// GetByCompany получает типы документов компании
+	GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.DocumentType, error)
+}
+
+// Ошибки типов документов
+var (
+	// ErrDocumentTypeNotFound - тип документа не существует или принадлежит другой компании
+	ErrDocumentTypeNotFound = errors.New("document type not found")
+	// ErrInvalidDocumentType - неверное название или схема полей типа документа
+	ErrInvalidDocumentType = errors.New("invalid document type")
+	// ErrInvalidFieldValues - значения полей документа не соответствуют схеме его типа
+	ErrInvalidFieldValues = errors.New("invalid document field values")
+)
+
+// ClassifierService определяет интерфейс для классификации документов по типам моделью компании
+type ClassifierService interface {
+	// Train обучает модель компании на документах с типом и документах без типа с тегом, совпадающим по названию с типом,
+	// оценивает ее перекрестной проверкой и заменяет прежнюю модель
+	// Типы, присвоенные самим классификатором, в обучение не попадают
+	Train(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error)
+
+	// GetByCompany получает модель компании с результатами проверки
+	GetByCompany(ctx context.Context, companyID uuid.UUID) (*ent.DocumentClassifier, error)
+
+	// Classify предсказывает тип документа по тексту
+	// Документу без типа присваивается тип с уверенностью не ниже classifier.ApplyThreshold
+	Classify(ctx context.Context, documentID uuid.UUID) (*types.DocumentClassification, error)
+
+	// GetClassification получает последнее предсказание типа документа
+	GetClassification(ctx context.Context, documentID uuid.UUID) (*types.DocumentClassification, error)
+}
+
+// Ошибки классификации документов
+var (
+	// ErrClassifierNotFound - модель компании еще не обучалась
+	ErrClassifierNotFound = errors.New("classifier not trained")
+	// ErrNotEnoughTrainingData - в компании мало документов с типом для обучения модели
+	ErrNotEnoughTrainingData = errors.New("not enough training documents")
+	// ErrClassificationNotFound - тип документа еще не предсказывался
+	ErrClassificationNotFound = errors.New("document classification not found")
+	// ErrDocumentTextNotFound - у документа нет извлеченного текста
+	ErrDocumentTextNotFound = errors.New("document text not found")
+)
+
+// DocumentTypeInput содержит тип документа
+type DocumentTypeInput struct {
+	Name        string
+	Description *string
+	Fields      []types.DocumentField
+	// Extraction - шаблон извлечения значений полей из текста, nil отключает извлечение
+	Extraction *types.ExtractionTemplate
+}
+
+// SenderInput содержит карточку контрагента
+type SenderInput struct {
+	Name string
+	// Email - основной адрес почты, Emails - дополнительные
+	Email     *string
+	LegalName *string
+	// INN - ИНН из 10 или 12 цифр, KPP - КПП организации
+	INN       *string
+	KPP       *string
+	Emails    []string
+	Phones    []string
+	Addresses []string
+	Website   *string
+	Notes     *string
+}
+
+// SenderDuplicate - возможный дубль контрагента
+type SenderDuplicate struct {
+	Sender *ent.Sender
+	// Score - оценка сходства от 0 до 1
+	Score float64
+	// Reasons - совпавшие признаки: inn, email, phone, website, name, similar name
+	Reasons []string
+}
+
+// SenderListInput содержит параметры списка контрагентов
+type SenderListInput struct {
+	// Query - часть названия, юридического названия или почты, начало ИНН
+	Query string
+	// Page начинается с 1
+	Page     int
+	PageSize int
+}
+
+// SenderList - страница списка контрагентов
+type SenderList struct {
+	Senders  []*ent.Sender
+	Total    int
+	Page     int
+	PageSize int
+}
+
+// SenderDocumentsFilter выбирает документы контрагента, пустые поля не учитываются
+type SenderDocumentsFilter struct {
+	// From входит в интервал, To - нет
+	From      *time.Time
+	To        *time.Time
+	MimeTypes []string
+	// TagIDs выбирает документы хотя бы с одним из тегов
+	TagIDs   []uuid.UUID
+	FolderID *uuid.UUID
+}
+
+// SenderDocumentsInput содержит параметры ленты документов контрагента
+type SenderDocumentsInput struct {
+	SenderDocumentsFilter
+	// Ascending - сначала старые документы, по умолчанию сначала новые
+	Ascending bool
+	// Page начинается с 1
+	Page     int
+	PageSize int
+}
+
+// SenderDocuments - страница ленты документов контрагента
+type SenderDocuments struct {
+	// Months - документы страницы по месяцам создания в порядке ленты
+	Months   []SenderDocumentsMonth
+	Total    int
+	Page     int
+	PageSize int
+}
+
+// SenderDocumentsMonth - документы страницы, созданные в одном месяце
+type SenderDocumentsMonth struct {
+	// Month - начало месяца
+	Month     time.Time
+	Documents []*DocumentWithTags
+}
+
+// SenderDocumentStats - сводка по документам контрагента
+type SenderDocumentStats struct {
+	Documents int64
+	// TotalSize - общий размер файлов в байтах
+	TotalSize int64
+	// FirstAt и LastAt - даты первого и последнего документа, nil если документов нет
+	FirstAt *time.Time
+	LastAt  *time.Time
+	// Months - от старых месяцев к новым, месяцы без документов пропущены
+	Months []SenderMonthStats
+	// MimeTypes и Tags - сначала самые частые
+	MimeTypes []SenderMimeTypeStats
+	Tags      []SenderTagStats
+}
+
+// SenderMonthStats - документы контрагента за месяц
+type SenderMonthStats struct {
+	Month     time.Time
+	Documents int64
+	TotalSize int64
+}
+
+// SenderMimeTypeStats - число документов контрагента одного типа файла
+type SenderMimeTypeStats struct {
+	MimeType  string
+	Documents int64
+}
+
+// SenderTagStats - число документов контрагента с тегом
+type SenderTagStats struct {
+	Tag       *ent.Tag
+	Documents int64
 }
 
 // CompanyUserService определяет интерфейс для работы с пользователями компании
@@ -184,8 +711,488 @@ type CompanyUserService interface {
 	GetCompanyUsers(ctx context.Context, companyID uuid.UUID) ([]*ent.CompanyUser, error)
 }
 
+// DocumentSearchInput содержит параметры поиска документов
+// Все фильтры выполняются в Elasticsearch и объединяются по И
+type DocumentSearchInput struct {
+	CompanyID uuid.UUID
+	// Query - поисковый запрос, синтаксис описан в internal/service/document/query.go
+	Query    string
+	FolderID *uuid.UUID
+	// TagIDs - документ должен иметь все указанные теги, то же что Tags.All
+	TagIDs []uuid.UUID
+	Tags   TagFilter
+	// MimeTypes, SenderIDs и CreatedBy - документ подходит, если совпадает любое из значений
+	MimeTypes []string
+	SenderIDs []uuid.UUID
+	CreatedBy []uuid.UUID
+	// Диапазоны дат и размера, границы включаются, nil - без ограничения
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	SizeMin     *int64
+	SizeMax     *int64
+	// TypeIDs - документ подходит, если его тип совпадает с любым из значений
+	TypeIDs []uuid.UUID
+	// Fields - условия по значениям полей типа документа, документ должен подходить под все
+	Fields []types.FieldFilter
+	// Mode - режим поиска по тексту запроса, одно из значений SearchMode*, по умолчанию ключевые слова
+	Mode string
+	// Sort - порядок выдачи, одно из значений SearchSort* или порядок по полю типа документа,
+	// см. SearchSortField; по умолчанию по релевантности, а без запроса - сначала новые
+	Sort string
+	// Page и PageSize задают постраничную выдачу, Page начинается с 1
+	Page     int
+	PageSize int
+	// Cursor - курсор следующей страницы из предыдущего ответа, при наличии Page игнорируется
+	Cursor string
+	// UserID - пользователь, выполняющий поиск; первая страница выдачи записывается в аналитику поиска,
+	// uuid.Nil - поиск не записывается
+	UserID uuid.UUID
+}
+
+// NewDocumentSearchInput собирает параметры поиска из запроса и фильтров сохраненного поиска
+func NewDocumentSearchInput(companyID uuid.UUID, query string, filters types.SearchFilters, sort string) DocumentSearchInput {
+	return DocumentSearchInput{
+		CompanyID: companyID,
+		Query:     query,
+		FolderID:  filters.FolderID,
+		Tags: TagFilter{
+			All:  filters.TagsAll,
+			Any:  filters.TagsAny,
+			None: filters.TagsNone,
+		},
+		MimeTypes:   filters.MimeTypes,
+		SenderIDs:   filters.SenderIDs,
+		CreatedBy:   filters.CreatedBy,
+		CreatedFrom: filters.CreatedFrom,
+		CreatedTo:   filters.CreatedTo,
+		UpdatedFrom: filters.UpdatedFrom,
+		UpdatedTo:   filters.UpdatedTo,
+		SizeMin:     filters.SizeMin,
+		SizeMax:     filters.SizeMax,
+		TypeIDs:     filters.TypeIDs,
+		Fields:      filters.Fields,
+		Sort:        sort,
+	}
+}
+
+// QuerySyntaxError - ошибка разбора поискового запроса
+type QuerySyntaxError struct {
+	// Position - позиция проблемы в запросе в символах, начиная с 0
+	Position int
+	Message  string
+}
+
+func (e *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("query syntax error at position %d: %s", e.Position, e.Message)
+}
+
+// DocumentSearchHit - найденный документ с релевантностью и подсветкой совпадений
+type DocumentSearchHit struct {
+	*DocumentWithTags
+	// Score - релевантность, nil для выдачи без текстового запроса
+	Score *float64
+	// Highlights - фрагменты с подсветкой по полям name и text
+	Highlights map[string][]string
+	// MatchedPages - страницы документа, на которых найдено совпадение
+	MatchedPages []PageMatch
+}
+
+// PageMatch - совпадение на странице документа
+type PageMatch struct {
+	Number     int
+	Highlights []string
+}
+
+// TagFilter задает условия по тегам
+type TagFilter struct {
+	// All - документ должен иметь все теги (И)
+	All []uuid.UUID
+	// Any - документ должен иметь хотя бы один из тегов (ИЛИ)
+	Any []uuid.UUID
+	// None - документ не должен иметь ни одного из тегов (НЕ)
+	None []uuid.UUID
+}
+
+// Порядок выдачи результатов поиска
+const (
+	SearchSortRelevance   = "relevance"
+	SearchSortCreatedDesc = "created_desc"
+	SearchSortCreatedAsc  = "created_asc"
+	SearchSortUpdatedDesc = "updated_desc"
+	SearchSortUpdatedAsc  = "updated_asc"
+	SearchSortSizeDesc    = "size_desc"
+	SearchSortSizeAsc     = "size_asc"
+	// SearchSortField - порядок по значению поля типа документа, в запросе задается как
+	// SearchSortFieldPrefix + ключ поля + ":asc" или ":desc", например field:amount:desc
+	SearchSortField       = "field"
+	SearchSortFieldPrefix = "field:"
+)
+
+// ParseFieldSort разбирает порядок выдачи по полю типа документа, например field:amount:desc
+func ParseFieldSort(sort string) (key string, desc bool, ok bool) {
+	rest, found := strings.CutPrefix(sort, SearchSortFieldPrefix)
+	if !found {
+		return "", false, false
+	}
+	key, direction, found := strings.Cut(rest, ":")
+	if !found || key == "" || (direction != "asc" && direction != "desc") {
+		return "", false, false
+	}
+	return key, direction == "desc", true
+}
+
+// Режимы поиска по тексту запроса
+const (
+	// SearchModeKeyword - полнотекстовый поиск BM25 с синтаксисом запроса
+	SearchModeKeyword = "keyword"
+	// SearchModeSemantic - поиск по близости векторов фрагментов текста к смыслу запроса
+	SearchModeSemantic = "semantic"
+	// SearchModeHybrid - BM25 и близость векторов складываются в общую релевантность
+	SearchModeHybrid = "hybrid"
+)
+
+var (
+	// ErrInvalidSearchSort - запрошен неизвестный порядок выдачи
+	ErrInvalidSearchSort = errors.New("invalid sort order")
+	// ErrInvalidSearchMode - запрошен неизвестный режим поиска
+	ErrInvalidSearchMode = errors.New("invalid search mode")
+	// ErrSemanticSearchDisabled - семантический поиск запрошен, но эмбеддинги не настроены
+	ErrSemanticSearchDisabled = errors.New("semantic search is not configured")
+)
+
+// Ключи фасетов в результатах поиска
+const (
+	FacetTags      = "tags"
+	FacetSenders   = "senders"
+	FacetMimeTypes = "mime_types"
+	FacetMonths    = "months"
+)
+
+// FacetBucket - значение фасета и число найденных документов с ним
+type FacetBucket struct {
+	// Key - ID тега или контрагента, MIME тип или месяц создания в формате 2006-01
+	Key   string
+	Label string
+	Count int64
+}
+
+// DocumentSearchResult - страница результатов поиска
+type DocumentSearchResult struct {
+	Hits     []*DocumentSearchHit
+	Total    int64
+	Page     int
+	PageSize int
+	// NextCursor - курсор для получения следующей страницы, пустой на последней странице
+	NextCursor string
+	// Facets - распределение всех найденных документов по тегам, контрагентам, типам и месяцам
+	Facets map[string][]FacetBucket
+	// DidYouMean - исправленный запрос, если по исходному ничего не найдено
+	DidYouMean string
+	// SearchID - запись поиска в аналитике для учета переходов по результатам, только на первой странице
+	SearchID *uuid.UUID
+}
+
+// SearchSuggestions - подсказки для строки поиска
+type SearchSuggestions struct {
+	Documents []DocumentSuggestion
+	Tags      []*ent.Tag
+	Senders   []*ent.Sender
+}
+
+// DocumentSuggestion - документ, название которого подходит под введенный текст
+type DocumentSuggestion struct {
+	ID   uuid.UUID
+	Name string
+	// Highlight - название с выделенной совпавшей частью
+	Highlight string
+}
+
+// DocumentText содержит извлеченный текст документа
+type DocumentText struct {
+	DocumentID    uuid.UUID
+	Text          string
+	Page          int
+	PagesCount    int
+	Language      string
+	Engine        string
+	EngineVersion string
+}
+
+// WatermarkInput переопределяет шаблоны водяного знака и штампа для одного скачивания
+// Синтаксис шаблонов описан в types.WatermarkSettings
+type WatermarkInput struct {
+	Template      string
+	StampTemplate string
+	StampPages    string
+}
+
 // CompanyService определяет интерфейс для работы с компаниями
 type CompanyService interface {
 	// Create создает новую компанию и добавляет создателя как администратора
 	Create(ctx context.Context, name string, userID uuid.UUID) (*ent.Company, error)
+
+	// GetSettings получает настройки компании
+	GetSettings(ctx context.Context, companyID uuid.UUID) (*types.CompanySettings, error)
+
+	// UpdateWatermarkSettings обновляет шаблоны водяного знака и штампа компании
+	// Шаблоны проверяются на корректность перед сохранением
+	UpdateWatermarkSettings(ctx context.Context, companyID uuid.UUID, settings types.WatermarkSettings) (*types.CompanySettings, error)
+
+	// UpdateRequisites обновляет юридическое название, ИНН и КПП компании
+	// Документы с этими реквизитами не предлагают компанию как нового контрагента
+	UpdateRequisites(ctx context.Context, companyID uuid.UUID, requisites types.CompanyRequisites) (*types.CompanySettings, error)
+}
+
+// Типы фоновых задач
+const (
+	JobTypePDFMerge   = "pdf_merge"
+	JobTypePDFSplit   = "pdf_split"
+	JobTypePDFFlatten = "pdf_flatten"
+	JobTypePDFEncrypt = "pdf_encrypt"
+
+	JobTypeSearchReindex = "search_reindex"
+	JobTypeTagRulesApply = "tag_rules_apply"
+)
+
+// SearchIndexStatus описывает состояние поискового индекса
+type SearchIndexStatus struct {
+	// Backend - поисковый движок, elasticsearch или postgres
+	Backend        string
+	Alias          string
+	MappingVersion int
+	// AliasTargets - физические индексы, на которые сейчас указывает алиас
+	AliasTargets []string
+	// Building - индекс, который строится в данный момент
+	Building       string
+	DocumentsCount int64
+	// ReindexRequired - алиас указывает на индекс устаревшей версии маппинга
+	ReindexRequired bool
+	// PendingTasks - изменения документов в outbox, еще не примененные к индексу
+	PendingTasks int
+	// FailingTasks - задачи outbox, которые не удалось применить и которые ждут повтора
+	FailingTasks int
+}
+
+// SearchIndexService определяет интерфейс для управления поисковым индексом
+type SearchIndexService interface {
+	// Reindex запускает фоновую перестройку индекса и возвращает задачу для отслеживания прогресса
+	Reindex(ctx context.Context, userID uuid.UUID) (*ent.Job, error)
+
+	// Rebuild синхронно строит индекс текущей версии маппинга из текста в БД
+	// и атомарно переключает на него алиас, progress вызывается по мере загрузки
+	Rebuild(ctx context.Context, progress func(done, total int)) (index string, err error)
+
+	// Status возвращает состояние индексов
+	Status(ctx context.Context) (*SearchIndexStatus, error)
+}
+
+// Ошибки сохраненных поисков
+var (
+	// ErrSavedSearchNotFound - поиск не существует или недоступен пользователю
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	// ErrSavedSearchForbidden - пользователь не владелец поиска или не состоит в компании
+	ErrSavedSearchForbidden = errors.New("saved search access denied")
+)
+
+// SavedSearchInput содержит параметры сохраненного поиска
+type SavedSearchInput struct {
+	Name string
+	// Query - поисковый запрос, для скользящих периодов используются даты вида created:this_quarter
+	Query   string
+	Filters types.SearchFilters
+	// Sort - одно из значений SearchSort*, пустое значение - порядок по умолчанию
+	Sort string
+}
+
+// SavedSearchService определяет интерфейс для работы с сохраненными поисками и умными папками
+// Поиск виден владельцу, а после публикации - всем пользователям компании
+type SavedSearchService interface {
+	// Create сохраняет поиск пользователя в компании, shared сразу публикует его для компании
+	Create(ctx context.Context, userID, companyID uuid.UUID, input SavedSearchInput, shared bool) (*ent.SavedSearch, error)
+
+	// GetByID получает сохраненный поиск, доступный пользователю
+	GetByID(ctx context.Context, userID, id uuid.UUID) (*ent.SavedSearch, error)
+
+	// List получает свои и опубликованные поиски компании
+	List(ctx context.Context, userID, companyID uuid.UUID) ([]*ent.SavedSearch, error)
+
+	// Update изменяет название, запрос, фильтры и сортировку поиска
+	Update(ctx context.Context, userID, id uuid.UUID, input SavedSearchInput) (*ent.SavedSearch, error)
+
+	// Delete удаляет сохраненный поиск
+	Delete(ctx context.Context, userID, id uuid.UUID) error
+
+	// Share публикует поиск для всех пользователей компании или снимает публикацию
+	Share(ctx context.Context, userID, id uuid.UUID, shared bool) (*ent.SavedSearch, error)
+
+	// Pin закрепляет поиск в дереве папок как умную папку внутри parentFolderID (nil - в корне)
+	// или открепляет его
+	Pin(ctx context.Context, userID, id uuid.UUID, pinned bool, parentFolderID *uuid.UUID) (*ent.SavedSearch, error)
+
+	// Run выполняет сохраненный поиск и возвращает актуальные результаты
+	Run(ctx context.Context, userID, id uuid.UUID, page, pageSize int, cursor string) (*DocumentSearchResult, error)
+
+	// GetSmartFolders получает умные папки компании, видимые пользователю
+	// Если all false, возвращает только умные папки внутри parentFolderID (nil - корневые)
+	GetSmartFolders(ctx context.Context, userID, companyID uuid.UUID, parentFolderID *uuid.UUID, all bool) ([]*ent.SavedSearch, error)
+}
+
+// Периоды группировки аналитики поиска
+const (
+	SearchAnalyticsPeriodDay   = "day"
+	SearchAnalyticsPeriodWeek  = "week"
+	SearchAnalyticsPeriodMonth = "month"
+)
+
+// Ошибки аналитики поиска
+var (
+	// ErrSearchLogNotFound - поиск не существует или выполнен другим пользователем
+	ErrSearchLogNotFound = errors.New("search not found")
+	// ErrInvalidAnalyticsPeriod - неизвестный период группировки или пустой интервал отчета
+	ErrInvalidAnalyticsPeriod = errors.New("invalid analytics period")
+)
+
+// SearchRecord описывает выполненный поиск для аналитики
+type SearchRecord struct {
+	CompanyID uuid.UUID
+	UserID    uuid.UUID
+	Query     string
+	Filters   types.SearchFilters
+	Mode      string
+	Sort      string
+	// ResultCount - общее число найденных документов
+	ResultCount int64
+	Latency     time.Duration
+}
+
+// SearchAnalyticsReportInput содержит параметры отчета по поиску
+type SearchAnalyticsReportInput struct {
+	// CompanyID - компания, nil - все компании
+	CompanyID *uuid.UUID
+	// From и To ограничивают интервал отчета, по умолчанию последние 30 дней
+	From *time.Time
+	To   *time.Time
+	// Period - группировка по времени, одно из значений SearchAnalyticsPeriod*, по умолчанию по дням
+	Period string
+	// Limit - число запросов в списках популярных и безрезультатных запросов
+	Limit int
+}
+
+// SearchAnalyticsStats содержит показатели поиска за интервал
+type SearchAnalyticsStats struct {
+	Searches           int64
+	ZeroResultSearches int64
+	// ClickedSearches - поиски, после которых пользователь открыл хотя бы один результат
+	ClickedSearches int64
+	AvgLatencyMs    float64
+}
+
+// SearchAnalyticsPeriod содержит показатели поиска за один период
+type SearchAnalyticsPeriod struct {
+	Start time.Time
+	SearchAnalyticsStats
+}
+
+// SearchQueryStats содержит показатели одного запроса
+// Запросы сравниваются без учета регистра, поиски без текста запроса не учитываются
+type SearchQueryStats struct {
+	Query              string
+	Searches           int64
+	ZeroResultSearches int64
+	ClickedSearches    int64
+	AvgResults         float64
+	LastSearchedAt     time.Time
+}
+
+// SearchAnalyticsReport - отчет по поиску за интервал
+type SearchAnalyticsReport struct {
+	From   time.Time
+	To     time.Time
+	Period string
+	SearchAnalyticsStats
+	Periods           []SearchAnalyticsPeriod
+	TopQueries        []SearchQueryStats
+	ZeroResultQueries []SearchQueryStats
+}
+
+// SearchAnalyticsService определяет интерфейс для аналитики поиска
+// Текст запросов не хранится для пользователей, отказавшихся от этого, и удаляется по истечении срока хранения
+type SearchAnalyticsService interface {
+	// RecordSearch записывает выполненный поиск и возвращает ID записи для учета переходов по результатам
+	RecordSearch(ctx context.Context, record SearchRecord) (uuid.UUID, error)
+
+	// RecordClick учитывает переход пользователя к документу на позиции position выдачи (с 0)
+	RecordClick(ctx context.Context, userID, searchID, documentID uuid.UUID, position int) error
+
+	// StoresQueries сообщает, сохраняется ли текст поисковых запросов пользователя
+	StoresQueries(ctx context.Context, userID uuid.UUID) (bool, error)
+
+	// SetStoreQueries включает или отключает хранение текста запросов пользователя
+	// При отключении удаляется и текст уже записанных запросов
+	SetStoreQueries(ctx context.Context, userID uuid.UUID, store bool) error
+
+	// Report строит отчет по популярным и безрезультатным запросам и времени ответа
+	Report(ctx context.Context, input SearchAnalyticsReportInput) (*SearchAnalyticsReport, error)
+
+	// Cleanup удаляет записи и текст запросов старше сроков хранения
+	Cleanup(ctx context.Context) error
+}
+
+// JobService определяет интерфейс для работы с фоновыми задачами
+type JobService interface {
+	// GetByID получает задачу по ID
+	GetByID(ctx context.Context, jobID uuid.UUID) (*ent.Job, error)
+
+	// GetByCompany получает список задач компании, начиная с последних
+	GetByCompany(ctx context.Context, companyID uuid.UUID) ([]*ent.Job, error)
+}
+
+// PDFMergeInput содержит данные для объединения документов в один PDF
+type PDFMergeInput struct {
+	DocumentIDs []uuid.UUID // Документы в порядке объединения
+	FolderID    *uuid.UUID  // Папка для результата
+	Name        string      // Имя итогового документа
+	UserID      uuid.UUID
+}
+
+// PDFSplitInput содержит данные для разделения PDF по диапазонам страниц
+type PDFSplitInput struct {
+	DocumentID uuid.UUID
+	Ranges     []string // Диапазоны страниц, например "1-3", "4", "5-10"
+	FolderID   *uuid.UUID
+	UserID     uuid.UUID
+}
+
+// PDFFlattenInput содержит данные для сглаживания форм в PDF
+type PDFFlattenInput struct {
+	DocumentIDs []uuid.UUID
+	FolderID    *uuid.UUID
+	UserID      uuid.UUID
+}
+
+// PDFEncryptInput содержит данные для создания защищенной паролем копии PDF
+type PDFEncryptInput struct {
+	DocumentID    uuid.UUID
+	UserPassword  string
+	OwnerPassword string
+	FolderID      *uuid.UUID
+	UserID        uuid.UUID
+}
+
+// PDFService определяет интерфейс для операций над PDF документами
+// Все операции выполняются в фоне, результаты сохраняются как новые документы
+type PDFService interface {
+	// Merge объединяет документы (при необходимости предварительно конвертируя их в PDF) в новый документ
+	Merge(ctx context.Context, input PDFMergeInput) (*ent.Job, error)
+
+	// Split разделяет PDF по диапазонам страниц на несколько новых документов
+	Split(ctx context.Context, input PDFSplitInput) (*ent.Job, error)
+
+	// Flatten сглаживает формы в PDF и сохраняет результат как новые документы
+	Flatten(ctx context.Context, input PDFFlattenInput) (*ent.Job, error)
+
+	// Encrypt создает защищенную паролем копию PDF
+	Encrypt(ctx context.Context, input PDFEncryptInput) (*ent.Job, error)
 }
@@ -0,0 +1,586 @@
+package tagrule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"techmind/internal/repo"
+	"techmind/internal/service"
+	"techmind/internal/tagging"
+	"techmind/schema/ent"
+	"techmind/schema/ent/documenttag"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// reapplyTimeout - максимальное время применения правил ко всем документам компании
+	reapplyTimeout = time.Hour
+	// reapplyProgressStep - через сколько документов обновляется прогресс задачи
+	reapplyProgressStep = 50
+)
+
+type tagRuleService struct {
+	tagRuleRepo         repo.TagRuleRepository
+	tagRepo             repo.TagRepository
+	tagGroupRepo        repo.TagGroupRepository
+	documentRepo        repo.DocumentRepository
+	documentTagRepo     repo.DocumentTagRepository
+	suppressionRepo     repo.DocumentTagSuppressionRepository
+	documentContentRepo repo.DocumentContentRepository
+	folderRepo          repo.FolderRepository
+	companyUserRepo     repo.CompanyUserRepository
+	jobRepo             repo.JobRepository
+}
+
+func NewService(
+	tagRuleRepo repo.TagRuleRepository,
+	tagRepo repo.TagRepository,
+	tagGroupRepo repo.TagGroupRepository,
+	documentRepo repo.DocumentRepository,
+	documentTagRepo repo.DocumentTagRepository,
+	suppressionRepo repo.DocumentTagSuppressionRepository,
+	documentContentRepo repo.DocumentContentRepository,
+	folderRepo repo.FolderRepository,
+	companyUserRepo repo.CompanyUserRepository,
+	jobRepo repo.JobRepository,
+) service.TagRuleService {
+	return &tagRuleService{
+		tagRuleRepo:         tagRuleRepo,
+		tagRepo:             tagRepo,
+		tagGroupRepo:        tagGroupRepo,
+		documentRepo:        documentRepo,
+		documentTagRepo:     documentTagRepo,
+		suppressionRepo:     suppressionRepo,
+		documentContentRepo: documentContentRepo,
+		folderRepo:          folderRepo,
+		companyUserRepo:     companyUserRepo,
+		jobRepo:             jobRepo,
+	}
+}
+
+func (s *tagRuleService) Create(ctx context.Context, userID uuid.UUID, input service.TagRuleInput) (*ent.TagRule, error) {
+	tag, err := s.checkInput(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.tagRuleRepo.Create(ctx, tag.CompanyID, tag.ID, strings.TrimSpace(input.Name), input.Conditions,
+		input.Priority, input.Stop, input.Enabled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tag rule: %w", err)
+	}
+	return rule, nil
+}
+
+func (s *tagRuleService) GetByID(ctx context.Context, userID, id uuid.UUID) (*ent.TagRule, error) {
+	rule, err := s.tagRuleRepo.GetByID(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, service.ErrTagRuleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag rule: %w", err)
+	}
+	if err := s.checkMember(ctx, userID, rule.CompanyID); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *tagRuleService) List(ctx context.Context, userID, companyID uuid.UUID) ([]*ent.TagRule, error) {
+	if err := s.checkMember(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.tagRuleRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag rules: %w", err)
+	}
+	return rules, nil
+}
+
+func (s *tagRuleService) Update(ctx context.Context, userID, id uuid.UUID, input service.TagRuleInput) (*ent.TagRule, error) {
+	rule, err := s.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	tag, err := s.checkInput(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+	if tag.CompanyID != rule.CompanyID {
+		return nil, fmt.Errorf("%w: tag belongs to another company", service.ErrInvalidTagRule)
+	}
+
+	rule, err = s.tagRuleRepo.Update(ctx, id, tag.ID, strings.TrimSpace(input.Name), input.Conditions,
+		input.Priority, input.Stop, input.Enabled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tag rule: %w", err)
+	}
+	return rule, nil
+}
+
+func (s *tagRuleService) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := s.GetByID(ctx, userID, id); err != nil {
+		return err
+	}
+	if err := s.tagRuleRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete tag rule: %w", err)
+	}
+	return nil
+}
+
+func (s *tagRuleService) DryRun(ctx context.Context, userID, documentID uuid.UUID) ([]service.TagRuleEvaluation, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+	if err := s.checkMember(ctx, userID, document.CompanyID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.loadRules(ctx, document.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	folders, err := s.folderParents(ctx, document.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.documentTagSources(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	suppressed, err := s.suppressedTagIDs(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.tagRepo.ListByCompany(ctx, document.CompanyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	tagsByID := make(map[uuid.UUID]*ent.Tag, len(tags))
+	for _, tag := range tags {
+		tagsByID[tag.ID] = tag
+	}
+	groups, err := s.exclusiveGroups(ctx, document.CompanyID, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := s.taggingDocument(ctx, document, folders)
+	if err != nil {
+		return nil, err
+	}
+
+	results := tagging.Evaluate(rules, input)
+	evaluations := make([]service.TagRuleEvaluation, 0, len(results))
+	manual := manualGroups(existing, groups)
+	taken := make(map[uuid.UUID]bool)
+	for _, result := range results {
+		tagID := result.Rule.TagID
+		_, alreadyTagged := existing[tagID]
+		evaluation := service.TagRuleEvaluation{
+			Rule:          result.Rule,
+			Tag:           tagsByID[tagID],
+			Matched:       result.Matched,
+			Skipped:       result.Skipped,
+			AlreadyTagged: alreadyTagged,
+			Suppressed:    suppressed[tagID],
+			Matches:       result.Matches,
+			Reason:        result.Reason,
+		}
+		if groupID, ok := groups[tagID]; ok && result.Matched && !evaluation.Suppressed {
+			evaluation.ManualInGroup = manual[groupID] && !alreadyTagged
+			evaluation.GroupTaken = taken[groupID]
+			taken[groupID] = true
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return evaluations, nil
+}
+
+func (s *tagRuleService) ApplyRules(ctx context.Context, documentID uuid.UUID) ([]*ent.Tag, error) {
+	document, err := s.documentRepo.GetByID(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
+	rules, err := s.loadRules(ctx, document.CompanyID)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	folders, err := s.folderParents(ctx, document.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := s.exclusiveGroups(ctx, document.CompanyID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs, blocked, err := s.apply(ctx, document, rules, folders, groups, uuid.New())
+	if err != nil {
+		return nil, err
+	}
+	if blocked > 0 {
+		fmt.Printf("Tag rules skipped %d tag(s) of document %s: exclusive group has a manually assigned tag\n", blocked, document.ID)
+	}
+
+	tags := make([]*ent.Tag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tag, err := s.tagRepo.GetByID(ctx, tagID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (s *tagRuleService) Reapply(ctx context.Context, userID, companyID uuid.UUID) (*ent.Job, error) {
+	if err := s.checkMember(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.loadRules(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobRepo.Create(ctx, &companyID, service.JobTypeTagRulesApply, map[string]interface{}{
+		"rules": len(rules),
+	}, &userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	go func() {
+		// Создаем новый контекст с таймаутом для фоновой задачи
+		jobCtx, cancel := context.WithTimeout(context.Background(), reapplyTimeout)
+		defer cancel()
+
+		tagged, blocked, err := s.reapply(jobCtx, job.ID, companyID, rules)
+		// Теги, не присвоенные из-за ручного тега исключающей группы, попадают в итог запуска
+		if blocked > 0 {
+			payload := map[string]interface{}{
+				"rules":                len(rules),
+				"skipped_manual_group": blocked,
+			}
+			if payloadErr := s.jobRepo.UpdatePayload(jobCtx, job.ID, payload); payloadErr != nil {
+				fmt.Printf("Failed to update payload of job %s: %v\n", job.ID, payloadErr)
+			}
+		}
+		if err != nil {
+			fmt.Printf("Job %s (%s) failed: %v\n", job.ID, service.JobTypeTagRulesApply, err)
+			if markErr := s.jobRepo.MarkFailed(jobCtx, job.ID, err.Error(), tagged); markErr != nil {
+				fmt.Printf("Failed to mark job %s as failed: %v\n", job.ID, markErr)
+			}
+			return
+		}
+
+		if err := s.jobRepo.MarkCompleted(jobCtx, job.ID, tagged); err != nil {
+			fmt.Printf("Failed to mark job %s as completed: %v\n", job.ID, err)
+		}
+	}()
+
+	return job, nil
+}
+
+func (s *tagRuleService) UndoRun(ctx context.Context, userID, runID uuid.UUID) (int, error) {
+	links, err := s.documentTagRepo.ListByRun(ctx, runID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get run tags: %w", err)
+	}
+	if len(links) == 0 {
+		return 0, service.ErrTagRuleRunNotFound
+	}
+
+	// Все документы запуска принадлежат одной компании
+	document, err := s.documentRepo.GetByID(ctx, links[0].DocumentID)
+	if err != nil {
+		return 0, fmt.Errorf("document not found: %w", err)
+	}
+	if err := s.checkMember(ctx, userID, document.CompanyID); err != nil {
+		return 0, err
+	}
+
+	removed, err := s.documentTagRepo.DeleteByRun(ctx, runID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to undo tag rule run: %w", err)
+	}
+	return removed, nil
+}
+
+// reapply применяет правила ко всем документам компании и возвращает документы, получившие новые теги,
+// и число тегов, не присвоенных из-за ручного тега исключающей группы
+// ID задачи служит ID запуска правил, по нему запуск можно отменить
+func (s *tagRuleService) reapply(ctx context.Context, jobID, companyID uuid.UUID, rules []*tagging.Rule) ([]uuid.UUID, int, error) {
+	documents, err := s.documentRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get documents: %w", err)
+	}
+	folders, err := s.folderParents(ctx, companyID)
+	if err != nil {
+		return nil, 0, err
+	}
+	groups, err := s.exclusiveGroups(ctx, companyID, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := s.jobRepo.MarkRunning(ctx, jobID, len(documents)); err != nil {
+		fmt.Printf("Failed to mark job %s as running: %v\n", jobID, err)
+	}
+
+	tagged := make([]uuid.UUID, 0)
+	blocked := 0
+	for i, document := range documents {
+		tagIDs, documentBlocked, err := s.apply(ctx, document, rules, folders, groups, jobID)
+		blocked += documentBlocked
+		if err != nil {
+			return tagged, blocked, fmt.Errorf("document %s: %w", document.ID, err)
+		}
+		if len(tagIDs) > 0 {
+			tagged = append(tagged, document.ID)
+		}
+
+		if done := i + 1; done%reapplyProgressStep == 0 || done == len(documents) {
+			if err := s.jobRepo.UpdateProgress(ctx, jobID, done); err != nil {
+				fmt.Printf("Failed to update progress of job %s: %v\n", jobID, err)
+			}
+		}
+	}
+	return tagged, blocked, nil
+}
+
+// apply присваивает документу теги сработавших правил, которых у него еще нет
+// Теги, снятые пользователем с документа, не присваиваются повторно.
+// Из исключающей группы присваивается только тег правила с наибольшим приоритетом, тег группы,
+// присвоенный вручную, правила не заменяют. Возвращает добавленные теги и число тегов,
+// не присвоенных из-за ручного тега группы
+func (s *tagRuleService) apply(ctx context.Context, document *ent.Document, rules []*tagging.Rule, folders map[uuid.UUID]*uuid.UUID, groups map[uuid.UUID]uuid.UUID, runID uuid.UUID) ([]uuid.UUID, int, error) {
+	input, err := s.taggingDocument(ctx, document, folders)
+	if err != nil {
+		return nil, 0, err
+	}
+	existing, err := s.documentTagSources(ctx, document.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+	suppressed, err := s.suppressedTagIDs(ctx, document.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	added := make([]uuid.UUID, 0)
+	blocked := 0
+	manual := manualGroups(existing, groups)
+	// taken - исключающие группы, тег которых уже выбран правилом с большим приоритетом
+	taken := make(map[uuid.UUID]bool)
+	for _, result := range tagging.Evaluate(rules, input) {
+		tagID := result.Rule.TagID
+		if !result.Matched || suppressed[tagID] {
+			continue
+		}
+		_, alreadyTagged := existing[tagID]
+		if groupID, ok := groups[tagID]; ok {
+			if taken[groupID] {
+				continue
+			}
+			taken[groupID] = true
+			if manual[groupID] && !alreadyTagged {
+				blocked++
+				continue
+			}
+		}
+		if alreadyTagged {
+			continue
+		}
+		_, err := s.documentTagRepo.Assign(ctx, document.ID, tagID, repo.TagAssignment{
+			Source: documenttag.SourceRule,
+			RuleID: &result.Rule.ID,
+			RunID:  &runID,
+		})
+		if errors.Is(err, repo.ErrManualTagInGroup) {
+			// Тег группы присвоен вручную после загрузки тегов документа
+			blocked++
+			continue
+		}
+		if err != nil {
+			return added, blocked, fmt.Errorf("failed to assign tag %s: %w", tagID, err)
+		}
+		// Тег присваивается первым сработавшим правилом
+		existing[tagID] = documenttag.SourceRule
+		added = append(added, tagID)
+	}
+
+	if len(added) > 0 {
+		fmt.Printf("Auto-assigned %d tag(s) to document %s by tag rules (run %s)\n", len(added), document.ID, runID)
+	}
+	return added, blocked, nil
+}
+
+// loadRules загружает правила компании и подготавливает включенные к проверке
+// Правило с некорректными условиями пропускается, чтобы не останавливать тегирование
+func (s *tagRuleService) loadRules(ctx context.Context, companyID uuid.UUID) ([]*tagging.Rule, error) {
+	rules, err := s.tagRuleRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag rules: %w", err)
+	}
+
+	compiled := make([]*tagging.Rule, 0, len(rules))
+	for _, rule := range rules {
+		c, err := tagging.Compile(rule)
+		if err != nil {
+			fmt.Printf("Skipping invalid tag rule %s: %v\n", rule.ID, err)
+			continue
+		}
+		compiled = append(compiled, c)
+	}
+	return compiled, nil
+}
+
+// taggingDocument собирает атрибуты, папки и извлеченный текст документа для проверки правил
+func (s *tagRuleService) taggingDocument(ctx context.Context, document *ent.Document, folders map[uuid.UUID]*uuid.UUID) (*tagging.Document, error) {
+	input := &tagging.Document{
+		Name:     document.Name,
+		MimeType: document.MimeType,
+		SenderID: document.SenderID,
+	}
+
+	// Папка документа и ее родители, ограничение защищает от циклов в дереве
+	for folderID := document.FolderID; folderID != nil && len(input.FolderIDs) <= len(folders); folderID = folders[*folderID] {
+		input.FolderIDs = append(input.FolderIDs, *folderID)
+	}
+
+	content, err := s.documentContentRepo.GetByDocumentID(ctx, document.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get document content: %w", err)
+	}
+	if content != nil {
+		input.Text = content.Text
+	}
+	return input, nil
+}
+
+// folderParents возвращает родительскую папку каждой папки компании
+func (s *tagRuleService) folderParents(ctx context.Context, companyID uuid.UUID) (map[uuid.UUID]*uuid.UUID, error) {
+	folders, err := s.folderRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get folders: %w", err)
+	}
+
+	parents := make(map[uuid.UUID]*uuid.UUID, len(folders))
+	for _, folder := range folders {
+		parents[folder.ID] = folder.ParentFolderID
+	}
+	return parents, nil
+}
+
+// exclusiveGroups возвращает исключающую группу каждого тега компании, который в нее входит
+// tags - уже загруженные теги компании, nil - загрузить
+func (s *tagRuleService) exclusiveGroups(ctx context.Context, companyID uuid.UUID, tags []*ent.Tag) (map[uuid.UUID]uuid.UUID, error) {
+	groups, err := s.tagGroupRepo.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag groups: %w", err)
+	}
+	exclusive := make(map[uuid.UUID]bool, len(groups))
+	for _, group := range groups {
+		if group.Exclusive {
+			exclusive[group.ID] = true
+		}
+	}
+	if len(exclusive) == 0 {
+		return map[uuid.UUID]uuid.UUID{}, nil
+	}
+
+	if tags == nil {
+		tags, err = s.tagRepo.ListByCompany(ctx, companyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tags: %w", err)
+		}
+	}
+	tagGroups := make(map[uuid.UUID]uuid.UUID)
+	for _, tag := range tags {
+		if tag.GroupID != nil && exclusive[*tag.GroupID] {
+			tagGroups[tag.ID] = *tag.GroupID
+		}
+	}
+	return tagGroups, nil
+}
+
+// documentTagSources возвращает теги документа и способ, которым каждый из них присвоен
+func (s *tagRuleService) documentTagSources(ctx context.Context, documentID uuid.UUID) (map[uuid.UUID]documenttag.Source, error) {
+	documentTags, err := s.documentTagRepo.ListByDocument(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get document tags: %w", err)
+	}
+
+	sources := make(map[uuid.UUID]documenttag.Source, len(documentTags))
+	for _, dt := range documentTags {
+		sources[dt.TagID] = dt.Source
+	}
+	return sources, nil
+}
+
+// manualGroups возвращает исключающие группы, тег которых присвоен документу вручную
+func manualGroups(sources map[uuid.UUID]documenttag.Source, groups map[uuid.UUID]uuid.UUID) map[uuid.UUID]bool {
+	manual := make(map[uuid.UUID]bool)
+	for tagID, source := range sources {
+		if groupID, ok := groups[tagID]; ok && source == documenttag.SourceManual {
+			manual[groupID] = true
+		}
+	}
+	return manual
+}
+
+// suppressedTagIDs возвращает теги, которые пользователь снял с документа
+func (s *tagRuleService) suppressedTagIDs(ctx context.Context, documentID uuid.UUID) (map[uuid.UUID]bool, error) {
+	suppressions, err := s.suppressionRepo.ListByDocument(ctx, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get removed document tags: %w", err)
+	}
+
+	tagIDs := make(map[uuid.UUID]bool, len(suppressions))
+	for _, suppression := range suppressions {
+		tagIDs[suppression.TagID] = true
+	}
+	return tagIDs, nil
+}
+
+// checkInput проверяет название и условия правила, доступ к тегу и возвращает тег
+func (s *tagRuleService) checkInput(ctx context.Context, userID uuid.UUID, input service.TagRuleInput) (*ent.Tag, error) {
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, fmt.Errorf("%w: name is required", service.ErrInvalidTagRule)
+	}
+	if err := tagging.Validate(input.Conditions); err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidTagRule, err)
+	}
+
+	tag, err := s.tagRepo.GetByID(ctx, input.TagID)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: tag not found", service.ErrInvalidTagRule)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag: %w", err)
+	}
+	if err := s.checkMember(ctx, userID, tag.CompanyID); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// checkMember проверяет, что пользователь состоит в компании
+func (s *tagRuleService) checkMember(ctx context.Context, userID, companyID uuid.UUID) error {
+	if _, err := s.companyUserRepo.GetByUserAndCompany(ctx, userID, companyID); err != nil {
+		if ent.IsNotFound(err) {
+			return service.ErrTagRuleForbidden
+		}
+		return fmt.Errorf("failed to check company membership: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+// Package tagging применяет правила автоматического тегирования к документам и строит дерево тегов компании
+package tagging
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"techmind/pkg/stemmer"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// Способы объединения ключевых слов правила
+const (
+	KeywordsModeAny = "any"
+	KeywordsModeAll = "all"
+)
+
+// Document - документ, к которому применяются правила
+type Document struct {
+	Name     string
+	MimeType string
+	SenderID *uuid.UUID
+	// FolderIDs - папка документа и все ее родительские папки
+	FolderIDs []uuid.UUID
+	Text      string
+
+	prepared bool
+	content  string
+	lower    string
+	words    sequence
+	stems    sequence
+}
+
+// sequence - слова текста с позициями каждого слова для поиска фраз
+type sequence struct {
+	words     []string
+	positions map[string][]int
+}
+
+func newSequence(words []string) sequence {
+	positions := make(map[string][]int)
+	for i, word := range words {
+		positions[word] = append(positions[word], i)
+	}
+	return sequence{words: words, positions: positions}
+}
+
+// contains проверяет, что слова фразы идут в тексте подряд
+func (s sequence) contains(phrase []string) bool {
+	if len(phrase) == 0 {
+		return false
+	}
+	for _, start := range s.positions[phrase[0]] {
+		if start+len(phrase) > len(s.words) {
+			break
+		}
+		matched := true
+		for i := 1; i < len(phrase); i++ {
+			if s.words[start+i] != phrase[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// prepare разбивает название и текст документа на слова один раз для всех правил
+func (d *Document) prepare() {
+	if d.prepared {
+		return
+	}
+	d.prepared = true
+
+	d.content = d.Name + "\n" + d.Text
+	d.lower = stemmer.Normalize(d.content)
+	words := stemmer.Words(d.content)
+	d.words = newSequence(words)
+	d.stems = newSequence(stemmer.StemAll(words))
+}
+
+// Rule - правило, подготовленное к проверке
+type Rule struct {
+	*ent.TagRule
+
+	match    string
+	keywords []keyword
+	patterns []*regexp.Regexp
+}
+
+type keyword struct {
+	text  string
+	lower string
+	words []string
+	stems []string
+}
+
+// Validate проверяет условия правила: хотя бы одно условие, известные режимы,
+// корректные регулярные выражения и шаблоны названий
+func Validate(conditions types.TagRuleConditions) error {
+	_, err := compile(conditions)
+	return err
+}
+
+// Compile подготавливает правило к проверке документов
+func Compile(rule *ent.TagRule) (*Rule, error) {
+	compiled, err := compile(rule.Conditions)
+	if err != nil {
+		return nil, err
+	}
+	compiled.TagRule = rule
+	return compiled, nil
+}
+
+func compile(conditions types.TagRuleConditions) (*Rule, error) {
+	rule := &Rule{match: conditions.Match}
+	if rule.match == "" {
+		rule.match = types.TagRuleMatchWord
+	}
+	switch rule.match {
+	case types.TagRuleMatchWord, types.TagRuleMatchStem, types.TagRuleMatchSubstring:
+	default:
+		return nil, fmt.Errorf("unknown match %q, expected word, stem or substring", conditions.Match)
+	}
+
+	switch conditions.KeywordsMode {
+	case "", KeywordsModeAny, KeywordsModeAll:
+	default:
+		return nil, fmt.Errorf("unknown keywords mode %q, expected any or all", conditions.KeywordsMode)
+	}
+
+	for _, text := range conditions.Keywords {
+		words := stemmer.Words(text)
+		if len(words) == 0 {
+			return nil, fmt.Errorf("keyword %q contains no words", text)
+		}
+		rule.keywords = append(rule.keywords, keyword{
+			text:  text,
+			lower: stemmer.Normalize(strings.TrimSpace(text)),
+			words: words,
+			stems: stemmer.StemAll(words),
+		})
+	}
+
+	for _, pattern := range conditions.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		rule.patterns = append(rule.patterns, re)
+	}
+
+	for _, pattern := range append(append([]string{}, conditions.MimeTypes...), conditions.FilenamePatterns...) {
+		if _, err := path.Match(strings.ToLower(pattern), ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	if len(conditions.Keywords) == 0 && len(conditions.Patterns) == 0 && len(conditions.SenderIDs) == 0 &&
+		len(conditions.MimeTypes) == 0 && len(conditions.FolderIDs) == 0 && len(conditions.FilenamePatterns) == 0 {
+		return nil, errors.New("rule must have at least one condition")
+	}
+
+	return rule, nil
+}
+
+// Result - результат проверки правила на документе
+type Result struct {
+	Rule *ent.TagRule
+	// Matched - все условия правила выполнены
+	Matched bool
+	// Skipped - правило не проверялось: оно отключено или раньше сработало правило с флагом stop
+	Skipped bool
+	// Matches - выполненные условия, например keyword "договор"
+	Matches []string
+	// Reason - первое невыполненное условие или причина пропуска
+	Reason string
+}
+
+// Evaluate проверяет правила по порядку на документе
+// Правила должны быть упорядочены по приоритету; после срабатывания правила с флагом stop
+// остальные правила пропускаются
+func Evaluate(rules []*Rule, document *Document) []Result {
+	document.prepare()
+
+	results := make([]Result, 0, len(rules))
+	stopped := ""
+	for _, rule := range rules {
+		result := Result{Rule: rule.TagRule}
+		switch {
+		case stopped != "":
+			result.Skipped = true
+			result.Reason = fmt.Sprintf("stopped by rule %q", stopped)
+		case !rule.Enabled:
+			result.Skipped = true
+			result.Reason = "rule is disabled"
+		default:
+			result.Matched, result.Matches, result.Reason = rule.evaluate(document)
+			if result.Matched && rule.Stop {
+				stopped = rule.Name
+			}
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// evaluate проверяет условия правила, сначала дешевые условия по атрибутам, затем по тексту
+func (r *Rule) evaluate(document *Document) (bool, []string, string) {
+	conditions := r.Conditions
+	var matches []string
+
+	if len(conditions.SenderIDs) > 0 {
+		if document.SenderID == nil || !containsID(conditions.SenderIDs, *document.SenderID) {
+			return false, nil, "sender does not match"
+		}
+		matches = append(matches, "sender")
+	}
+
+	if len(conditions.MimeTypes) > 0 {
+		pattern, ok := matchAny(conditions.MimeTypes, document.MimeType)
+		if !ok {
+			return false, nil, fmt.Sprintf("mime type %s does not match", document.MimeType)
+		}
+		matches = append(matches, fmt.Sprintf("mime type %q", pattern))
+	}
+
+	if len(conditions.FolderIDs) > 0 {
+		matched := false
+		for _, folderID := range document.FolderIDs {
+			if containsID(conditions.FolderIDs, folderID) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, nil, "folder does not match"
+		}
+		matches = append(matches, "folder")
+	}
+
+	if len(conditions.FilenamePatterns) > 0 {
+		pattern, ok := matchAny(conditions.FilenamePatterns, document.Name)
+		if !ok {
+			return false, nil, "filename does not match"
+		}
+		matches = append(matches, fmt.Sprintf("filename %q", pattern))
+	}
+
+	if len(r.keywords) > 0 {
+		found := 0
+		for _, kw := range r.keywords {
+			if r.matchKeyword(document, kw) {
+				found++
+				matches = append(matches, fmt.Sprintf("keyword %q", kw.text))
+			} else if conditions.KeywordsMode == KeywordsModeAll {
+				return false, nil, fmt.Sprintf("keyword %q not found", kw.text)
+			}
+		}
+		if found == 0 {
+			return false, nil, "no keyword found"
+		}
+	}
+
+	if len(r.patterns) > 0 {
+		matched := false
+		for _, re := range r.patterns {
+			if re.MatchString(document.content) {
+				matched = true
+				matches = append(matches, fmt.Sprintf("pattern %q", re.String()))
+				break
+			}
+		}
+		if !matched {
+			return false, nil, "no pattern matched"
+		}
+	}
+
+	return true, matches, ""
+}
+
+func (r *Rule) matchKeyword(document *Document, kw keyword) bool {
+	switch r.match {
+	case types.TagRuleMatchSubstring:
+		return strings.Contains(document.lower, kw.lower)
+	case types.TagRuleMatchStem:
+		return document.stems.contains(kw.stems)
+	default:
+		return document.words.contains(kw.words)
+	}
+}
+
+// matchAny возвращает первый шаблон, под который подходит значение без учета регистра
+func matchAny(patterns []string, value string) (string, bool) {
+	value = strings.ToLower(value)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), value); ok {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
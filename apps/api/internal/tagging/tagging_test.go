@@ -0,0 +1,259 @@
+package tagging_test
+
+import (
+	"reflect"
+	"testing"
+
+	"techmind/internal/tagging"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+func compileRule(t *testing.T, name string, conditions types.TagRuleConditions) *tagging.Rule {
+	t.Helper()
+	rule, err := tagging.Compile(&ent.TagRule{
+		ID:         uuid.New(),
+		TagID:      uuid.New(),
+		Name:       name,
+		Conditions: conditions,
+		Enabled:    true,
+	})
+	if err != nil {
+		t.Fatalf("Compile(%q) error: %v", name, err)
+	}
+	return rule
+}
+
+func TestEvaluate_Conditions(t *testing.T) {
+	sender := uuid.New()
+	otherSender := uuid.New()
+	folder := uuid.New()
+	parentFolder := uuid.New()
+
+	document := func() *tagging.Document {
+		return &tagging.Document{
+			Name:      "Счет №15 ООО Ромашка.pdf",
+			MimeType:  "application/pdf",
+			SenderID:  &sender,
+			FolderIDs: []uuid.UUID{folder, parentFolder},
+			Text:      "Счет на оплату по договору поставки №7. Акты сверки приложены. ИНН 7707083893",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		conditions types.TagRuleConditions
+		matched    bool
+		matches    []string
+		reason     string
+	}{
+		{
+			name:       "слово целиком",
+			conditions: types.TagRuleConditions{Keywords: []string{"Счет"}},
+			matched:    true,
+			matches:    []string{`keyword "Счет"`},
+		},
+		{
+			name:       "слово не находится внутри другого слова",
+			conditions: types.TagRuleConditions{Keywords: []string{"акт"}},
+			reason:     "no keyword found",
+		},
+		{
+			name:       "подстрока находится внутри слова",
+			conditions: types.TagRuleConditions{Keywords: []string{"акт"}, Match: types.TagRuleMatchSubstring},
+			matched:    true,
+			matches:    []string{`keyword "акт"`},
+		},
+		{
+			name:       "фраза в другой форме по основам",
+			conditions: types.TagRuleConditions{Keywords: []string{"акт сверки", "договоры поставка"}, Match: types.TagRuleMatchStem, KeywordsMode: tagging.KeywordsModeAll},
+			matched:    true,
+			matches:    []string{`keyword "акт сверки"`, `keyword "договоры поставка"`},
+		},
+		{
+			name:       "фраза без основ должна совпадать точно",
+			conditions: types.TagRuleConditions{Keywords: []string{"договор поставки"}},
+			reason:     "no keyword found",
+		},
+		{
+			name:       "слова фразы должны идти подряд",
+			conditions: types.TagRuleConditions{Keywords: []string{"счет поставки"}, Match: types.TagRuleMatchStem},
+			reason:     "no keyword found",
+		},
+		{
+			name:       "все ключевые слова",
+			conditions: types.TagRuleConditions{Keywords: []string{"счет", "накладная"}, KeywordsMode: tagging.KeywordsModeAll},
+			reason:     `keyword "накладная" not found`,
+		},
+		{
+			name:       "любое ключевое слово",
+			conditions: types.TagRuleConditions{Keywords: []string{"накладная", "оплату"}},
+			matched:    true,
+			matches:    []string{`keyword "оплату"`},
+		},
+		{
+			name:       "регулярное выражение",
+			conditions: types.TagRuleConditions{Patterns: []string{`КПП \d{9}`, `ИНН \d{10}`}},
+			matched:    true,
+			matches:    []string{`pattern "ИНН \\d{10}"`},
+		},
+		{
+			name:       "регулярное выражение не совпало",
+			conditions: types.TagRuleConditions{Patterns: []string{`КПП \d{9}`}},
+			reason:     "no pattern matched",
+		},
+		{
+			name:       "контрагент",
+			conditions: types.TagRuleConditions{SenderIDs: []uuid.UUID{otherSender, sender}},
+			matched:    true,
+			matches:    []string{"sender"},
+		},
+		{
+			name:       "другой контрагент",
+			conditions: types.TagRuleConditions{SenderIDs: []uuid.UUID{otherSender}},
+			reason:     "sender does not match",
+		},
+		{
+			name:       "MIME тип по шаблону",
+			conditions: types.TagRuleConditions{MimeTypes: []string{"image/*", "application/*"}},
+			matched:    true,
+			matches:    []string{`mime type "application/*"`},
+		},
+		{
+			name:       "другой MIME тип",
+			conditions: types.TagRuleConditions{MimeTypes: []string{"image/*"}},
+			reason:     "mime type application/pdf does not match",
+		},
+		{
+			name:       "родительская папка",
+			conditions: types.TagRuleConditions{FolderIDs: []uuid.UUID{parentFolder}},
+			matched:    true,
+			matches:    []string{"folder"},
+		},
+		{
+			name:       "другая папка",
+			conditions: types.TagRuleConditions{FolderIDs: []uuid.UUID{uuid.New()}},
+			reason:     "folder does not match",
+		},
+		{
+			name:       "название файла без учета регистра",
+			conditions: types.TagRuleConditions{FilenamePatterns: []string{"СЧЕТ*.PDF"}},
+			matched:    true,
+			matches:    []string{`filename "СЧЕТ*.PDF"`},
+		},
+		{
+			name:       "название файла не подходит",
+			conditions: types.TagRuleConditions{FilenamePatterns: []string{"*.docx"}},
+			reason:     "filename does not match",
+		},
+		{
+			name: "все условия вместе",
+			conditions: types.TagRuleConditions{
+				SenderIDs:        []uuid.UUID{sender},
+				MimeTypes:        []string{"application/pdf"},
+				FilenamePatterns: []string{"*ромашка*"},
+				Keywords:         []string{"договору"},
+			},
+			matched: true,
+			matches: []string{"sender", `mime type "application/pdf"`, `filename "*ромашка*"`, `keyword "договору"`},
+		},
+		{
+			name: "одно невыполненное условие отменяет правило",
+			conditions: types.TagRuleConditions{
+				Keywords:  []string{"счет"},
+				MimeTypes: []string{"image/*"},
+			},
+			reason: "mime type application/pdf does not match",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := compileRule(t, tt.name, tt.conditions)
+			results := tagging.Evaluate([]*tagging.Rule{rule}, document())
+			if len(results) != 1 {
+				t.Fatalf("got %d results, want 1", len(results))
+			}
+			result := results[0]
+			if result.Matched != tt.matched || result.Skipped || result.Reason != tt.reason {
+				t.Fatalf("result = matched %v, skipped %v, reason %q; want matched %v, reason %q",
+					result.Matched, result.Skipped, result.Reason, tt.matched, tt.reason)
+			}
+			if !reflect.DeepEqual(result.Matches, tt.matches) {
+				t.Fatalf("matches = %q, want %q", result.Matches, tt.matches)
+			}
+		})
+	}
+}
+
+func TestEvaluate_PriorityAndStop(t *testing.T) {
+	document := &tagging.Document{Name: "счет.pdf", Text: "Счет на оплату"}
+
+	first := compileRule(t, "первое", types.TagRuleConditions{Keywords: []string{"оплату"}})
+	notMatched := compileRule(t, "не сработало", types.TagRuleConditions{Keywords: []string{"акт"}})
+	stop := compileRule(t, "стоп", types.TagRuleConditions{Keywords: []string{"счет"}})
+	stop.Stop = true
+	disabled := compileRule(t, "отключено", types.TagRuleConditions{Keywords: []string{"счет"}})
+	disabled.Enabled = false
+	last := compileRule(t, "последнее", types.TagRuleConditions{Keywords: []string{"счет"}})
+
+	// Правила проверяются в переданном порядке приоритета, правило с флагом stop останавливает следующие
+	results := tagging.Evaluate([]*tagging.Rule{first, notMatched, disabled, stop, last}, document)
+
+	want := []struct {
+		name    string
+		matched bool
+		skipped bool
+		reason  string
+	}{
+		{"первое", true, false, ""},
+		{"не сработало", false, false, "no keyword found"},
+		{"отключено", false, true, "rule is disabled"},
+		{"стоп", true, false, ""},
+		{"последнее", false, true, `stopped by rule "стоп"`},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(results), len(want))
+	}
+	for i, w := range want {
+		r := results[i]
+		if r.Rule.Name != w.name || r.Matched != w.matched || r.Skipped != w.skipped || r.Reason != w.reason {
+			t.Errorf("result %d = %q matched %v skipped %v reason %q; want %q matched %v skipped %v reason %q",
+				i, r.Rule.Name, r.Matched, r.Skipped, r.Reason, w.name, w.matched, w.skipped, w.reason)
+		}
+	}
+
+	// Без правила с флагом stop проверяются все правила
+	results = tagging.Evaluate([]*tagging.Rule{last, first}, document)
+	if !results[0].Matched || !results[1].Matched {
+		t.Fatalf("results = %+v, want both rules matched", results)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions types.TagRuleConditions
+		wantErr    bool
+	}{
+		{"ключевое слово", types.TagRuleConditions{Keywords: []string{"счет"}}, false},
+		{"нет условий", types.TagRuleConditions{KeywordsMode: tagging.KeywordsModeAll}, true},
+		{"неизвестный способ сравнения", types.TagRuleConditions{Keywords: []string{"счет"}, Match: "regex"}, true},
+		{"неизвестный режим", types.TagRuleConditions{Keywords: []string{"счет"}, KeywordsMode: "some"}, true},
+		{"ключевое слово без слов", types.TagRuleConditions{Keywords: []string{" - "}}, true},
+		{"некорректное регулярное выражение", types.TagRuleConditions{Patterns: []string{"(счет"}}, true},
+		{"некорректный шаблон названия", types.TagRuleConditions{FilenamePatterns: []string{"[счет"}}, true},
+		{"некорректный шаблон MIME типа", types.TagRuleConditions{MimeTypes: []string{"image/["}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tagging.Validate(tt.conditions)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package tagging
+
+import (
+	"sort"
+	"strings"
+
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+// PathSeparator разделяет названия тегов в пути от корня дерева
+const PathSeparator = "/"
+
+// Tree - дерево тегов компании
+type Tree struct {
+	byID     map[uuid.UUID]*ent.Tag
+	children map[uuid.UUID][]*ent.Tag
+	roots    []*ent.Tag
+}
+
+// NewTree строит дерево из тегов компании.
+// Тег, родитель которого не найден среди тегов, считается корневым
+func NewTree(tags []*ent.Tag) *Tree {
+	t := &Tree{
+		byID:     make(map[uuid.UUID]*ent.Tag, len(tags)),
+		children: make(map[uuid.UUID][]*ent.Tag),
+	}
+	sorted := append([]*ent.Tag{}, tags...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Name) < strings.ToLower(sorted[j].Name)
+	})
+	for _, tag := range sorted {
+		t.byID[tag.ID] = tag
+	}
+	for _, tag := range sorted {
+		if tag.ParentID != nil && t.byID[*tag.ParentID] != nil {
+			t.children[*tag.ParentID] = append(t.children[*tag.ParentID], tag)
+		} else {
+			t.roots = append(t.roots, tag)
+		}
+	}
+	return t
+}
+
+// Get возвращает тег по ID, nil - тега нет в дереве
+func (t *Tree) Get(id uuid.UUID) *ent.Tag {
+	return t.byID[id]
+}
+
+// Roots возвращает корневые теги по алфавиту
+func (t *Tree) Roots() []*ent.Tag {
+	return t.roots
+}
+
+// Children возвращает дочерние теги по алфавиту
+func (t *Tree) Children(id uuid.UUID) []*ent.Tag {
+	return t.children[id]
+}
+
+// Subtree возвращает ID тега и всех его потомков, тег идет первым
+func (t *Tree) Subtree(id uuid.UUID) []uuid.UUID {
+	ids := []uuid.UUID{id}
+	visited := map[uuid.UUID]bool{id: true}
+	for i := 0; i < len(ids); i++ {
+		for _, child := range t.children[ids[i]] {
+			if !visited[child.ID] {
+				visited[child.ID] = true
+				ids = append(ids, child.ID)
+			}
+		}
+	}
+	return ids
+}
+
+// Expand заменяет каждый тег на его поддерево без повторов
+func (t *Tree) Expand(ids []uuid.UUID) []uuid.UUID {
+	if len(ids) == 0 {
+		return ids
+	}
+	seen := make(map[uuid.UUID]bool)
+	expanded := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		for _, subID := range t.Subtree(id) {
+			if !seen[subID] {
+				seen[subID] = true
+				expanded = append(expanded, subID)
+			}
+		}
+	}
+	return expanded
+}
+
+// IsDescendant проверяет, что тег id находится в поддереве ancestorID или совпадает с ним
+func (t *Tree) IsDescendant(id, ancestorID uuid.UUID) bool {
+	visited := make(map[uuid.UUID]bool)
+	for current := t.byID[id]; current != nil && !visited[current.ID]; {
+		if current.ID == ancestorID {
+			return true
+		}
+		visited[current.ID] = true
+		if current.ParentID == nil {
+			return false
+		}
+		current = t.byID[*current.ParentID]
+	}
+	return id == ancestorID
+}
+
+// Path возвращает путь тега от корня, например "Финансы/Счета/Оплачено"
+func (t *Tree) Path(id uuid.UUID) string {
+	var names []string
+	visited := make(map[uuid.UUID]bool)
+	for current := t.byID[id]; current != nil && !visited[current.ID]; {
+		visited[current.ID] = true
+		names = append(names, current.Name)
+		if current.ParentID == nil {
+			break
+		}
+		current = t.byID[*current.ParentID]
+	}
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+	return strings.Join(names, PathSeparator)
+}
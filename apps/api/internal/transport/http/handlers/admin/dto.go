@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"time"
+
+	"techmind/internal/service"
+)
+
+// SearchIndexStatusResponse представляет состояние поискового индекса
+type SearchIndexStatusResponse struct {
+	// Backend - поисковый движок: elasticsearch или postgres
+	Backend         string   `json:"backend" example:"elasticsearch"`
+	Alias           string   `json:"alias" example:"documents"`
+	MappingVersion  int      `json:"mapping_version" example:"1"`
+	AliasTargets    []string `json:"alias_targets" example:"documents_v1"`
+	Building        string   `json:"building,omitempty" example:"documents_v2"`
+	DocumentsCount  int64    `json:"documents_count" example:"1500"`
+	ReindexRequired bool     `json:"reindex_required" example:"false"`
+	// PendingTasks - изменения документов, еще не примененные к индексу
+	PendingTasks int `json:"pending_tasks" example:"0"`
+	// FailingTasks - изменения, ожидающие повтора после ошибки
+	FailingTasks int `json:"failing_tasks" example:"0"`
+}
+
+// SearchAnalyticsStats представляет показатели поиска за интервал
+type SearchAnalyticsStats struct {
+	Searches           int64 `json:"searches" example:"1200"`
+	ZeroResultSearches int64 `json:"zero_result_searches" example:"85"`
+	// ClickedSearches - поиски, после которых был открыт хотя бы один результат
+	ClickedSearches int64   `json:"clicked_searches" example:"640"`
+	AvgLatencyMs    float64 `json:"avg_latency_ms" example:"42.5"`
+}
+
+// SearchAnalyticsPeriodResponse представляет показатели поиска за один период
+type SearchAnalyticsPeriodResponse struct {
+	Start time.Time `json:"start"`
+	SearchAnalyticsStats
+}
+
+// SearchQueryStatsResponse представляет показатели одного запроса
+type SearchQueryStatsResponse struct {
+	Query              string    `json:"query" example:"договор поставки"`
+	Searches           int64     `json:"searches" example:"37"`
+	ZeroResultSearches int64     `json:"zero_result_searches" example:"0"`
+	ClickedSearches    int64     `json:"clicked_searches" example:"30"`
+	AvgResults         float64   `json:"avg_results" example:"12.4"`
+	LastSearchedAt     time.Time `json:"last_searched_at"`
+}
+
+// SearchAnalyticsResponse представляет отчет по поиску за интервал
+type SearchAnalyticsResponse struct {
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+	Period string    `json:"period" example:"day"`
+	SearchAnalyticsStats
+	Periods           []SearchAnalyticsPeriodResponse `json:"periods"`
+	TopQueries        []SearchQueryStatsResponse      `json:"top_queries"`
+	ZeroResultQueries []SearchQueryStatsResponse      `json:"zero_result_queries"`
+}
+
+func newSearchAnalyticsStats(stats service.SearchAnalyticsStats) SearchAnalyticsStats {
+	return SearchAnalyticsStats{
+		Searches:           stats.Searches,
+		ZeroResultSearches: stats.ZeroResultSearches,
+		ClickedSearches:    stats.ClickedSearches,
+		AvgLatencyMs:       stats.AvgLatencyMs,
+	}
+}
+
+func newSearchQueryStats(queries []service.SearchQueryStats) []SearchQueryStatsResponse {
+	result := make([]SearchQueryStatsResponse, 0, len(queries))
+	for _, q := range queries {
+		result = append(result, SearchQueryStatsResponse{
+			Query:              q.Query,
+			Searches:           q.Searches,
+			ZeroResultSearches: q.ZeroResultSearches,
+			ClickedSearches:    q.ClickedSearches,
+			AvgResults:         q.AvgResults,
+			LastSearchedAt:     q.LastSearchedAt,
+		})
+	}
+	return result
+}
+
+// NewSearchAnalyticsResponse создает ответ из отчета по поиску
+func NewSearchAnalyticsResponse(report *service.SearchAnalyticsReport) SearchAnalyticsResponse {
+	response := SearchAnalyticsResponse{
+		From:                 report.From,
+		To:                   report.To,
+		Period:               report.Period,
+		SearchAnalyticsStats: newSearchAnalyticsStats(report.SearchAnalyticsStats),
+		Periods:              make([]SearchAnalyticsPeriodResponse, 0, len(report.Periods)),
+		TopQueries:           newSearchQueryStats(report.TopQueries),
+		ZeroResultQueries:    newSearchQueryStats(report.ZeroResultQueries),
+	}
+	for _, p := range report.Periods {
+		response.Periods = append(response.Periods, SearchAnalyticsPeriodResponse{
+			Start:                p.Start,
+			SearchAnalyticsStats: newSearchAnalyticsStats(p.SearchAnalyticsStats),
+		})
+	}
+	return response
+}
@@ -0,0 +1,96 @@
+package admin
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetSearchAnalyticsHandler struct {
+	analyticsService service.SearchAnalyticsService
+}
+
+func NewGetSearchAnalyticsHandler(analyticsService service.SearchAnalyticsService) *GetSearchAnalyticsHandler {
+	return &GetSearchAnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// Handle godoc
+// @Summary      Аналитика поиска
+// @Description  Возвращает число поисков, безрезультатных поисков и поисков с переходом к документу,
+// @Description  среднее время ответа за интервал и по периодам, популярные и безрезультатные запросы
+// @Description  Запросы сравниваются без учета регистра; запросы пользователей, отказавшихся от их хранения,
+// @Description  учитываются только в общих показателях
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string false "ID компании, по умолчанию все компании" format:"uuid"
+// @Param        from query string false "Начало интервала (RFC 3339), по умолчанию 30 дней назад" format:"date-time"
+// @Param        to query string false "Конец интервала (RFC 3339), по умолчанию сейчас" format:"date-time"
+// @Param        period query string false "Группировка по времени: day (по умолчанию), week, month"
+// @Param        limit query int false "Число запросов в списках, по умолчанию 20, максимум 100"
+// @Success      200 {object} SearchAnalyticsResponse "Отчет по поиску"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат параметров"
+// @Failure      403 {object} handlers.ErrorResponse "Нет прав администратора"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/admin/search/analytics [get]
+func (h *GetSearchAnalyticsHandler) Handle(c fiber.Ctx) error {
+	input := service.SearchAnalyticsReportInput{
+		Period: c.Query("period"),
+	}
+
+	if companyParam := c.Query("company_id"); companyParam != "" {
+		companyID, err := uuid.Parse(companyParam)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid company id format",
+			})
+		}
+		input.CompanyID = &companyID
+	}
+
+	for param, target := range map[string]**time.Time{"from": &input.From, "to": &input.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid " + param + " format, expected RFC 3339",
+			})
+		}
+		*target = &t
+	}
+
+	if limitParam := c.Query("limit"); limitParam != "" {
+		limit, err := strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid limit",
+			})
+		}
+		input.Limit = limit
+	}
+
+	report, err := h.analyticsService.Report(c.Context(), input)
+	if errors.Is(err, service.ErrInvalidAnalyticsPeriod) {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(NewSearchAnalyticsResponse(report))
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type GetSearchStatusHandler struct {
+	searchIndexService service.SearchIndexService
+}
+
+func NewGetSearchStatusHandler(searchIndexService service.SearchIndexService) *GetSearchStatusHandler {
+	return &GetSearchStatusHandler{
+		searchIndexService: searchIndexService,
+	}
+}
+
+// Handle godoc
+// @Summary      Состояние поискового индекса
+// @Description  Возвращает версию маппинга, индексы за алиасом, строящийся индекс, число документов
+// @Description  и число изменений, ожидающих применения к индексу
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} SearchIndexStatusResponse "Состояние индекса"
+// @Failure      403 {object} handlers.ErrorResponse "Нет прав администратора"
+// @Failure      500 {object} handlers.ErrorResponse "Ошибка Elasticsearch"
+// @Router       /private/admin/search/status [get]
+func (h *GetSearchStatusHandler) Handle(c fiber.Ctx) error {
+	status, err := h.searchIndexService.Status(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(SearchIndexStatusResponse{
+		Backend:         status.Backend,
+		Alias:           status.Alias,
+		MappingVersion:  status.MappingVersion,
+		AliasTargets:    status.AliasTargets,
+		Building:        status.Building,
+		DocumentsCount:  status.DocumentsCount,
+		ReindexRequired: status.ReindexRequired,
+		PendingTasks:    status.PendingTasks,
+		FailingTasks:    status.FailingTasks,
+	})
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ReindexHandler struct {
+	searchIndexService service.SearchIndexService
+}
+
+func NewReindexHandler(searchIndexService service.SearchIndexService) *ReindexHandler {
+	return &ReindexHandler{
+		searchIndexService: searchIndexService,
+	}
+}
+
+// Handle godoc
+// @Summary      Перестройка поискового индекса
+// @Description  Создает индекс текущей версии маппинга, загружает в него документы из БД и атомарно переключает алиас
+// @Description  Поиск продолжает работать через старый индекс до переключения. Прогресс отслеживается через /jobs/{id}
+// @Tags         admin
+// @Produce      json
+// @Security     BearerAuth
+// @Success      202 {object} job.JobResponse "Задача перестройки создана"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Нет прав администратора"
+// @Failure      409 {object} handlers.ErrorResponse "Индекс уже перестраивается"
+// @Router       /private/admin/search/reindex [post]
+func (h *ReindexHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	reindexJob, err := h.searchIndexService.Reindex(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusConflict).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(reindexJob))
+}
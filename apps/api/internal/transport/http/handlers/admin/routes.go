@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует административные маршруты
+func RegisterRoutes(router fiber.Router, searchIndexService service.SearchIndexService, analyticsService service.SearchAnalyticsService) {
+	reindexHandler := NewReindexHandler(searchIndexService)
+	getSearchStatusHandler := NewGetSearchStatusHandler(searchIndexService)
+	getSearchAnalyticsHandler := NewGetSearchAnalyticsHandler(analyticsService)
+
+	router.Post("/search/reindex", reindexHandler.Handle)
+	router.Get("/search/status", getSearchStatusHandler.Handle)
+	router.Get("/search/analytics", getSearchAnalyticsHandler.Handle)
+}
@@ -0,0 +1,48 @@
+package company
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetSettingsHandler struct {
+	companyService service.CompanyService
+}
+
+func NewGetSettingsHandler(companyService service.CompanyService) *GetSettingsHandler {
+	return &GetSettingsHandler{
+		companyService: companyService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение настроек компании
+// @Description  Возвращает настройки компании, в том числе шаблоны водяного знака и реквизиты
+// @Tags         companies
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID компании" format:"uuid"
+// @Success      200 {object} types.CompanySettings "Настройки компании"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Компания не найдена"
+// @Router       /private/companies/{id}/settings [get]
+func (h *GetSettingsHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	settings, err := h.companyService.GetSettings(c.Context(), companyID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(settings)
+}
@@ -9,6 +9,12 @@ import (
 // RegisterRoutes регистрирует маршруты для работы с компаниями
 func RegisterRoutes(router fiber.Router, companyService service.CompanyService) {
 	createCompanyHandler := NewCreateCompanyHandler(companyService)
+	getSettingsHandler := NewGetSettingsHandler(companyService)
+	updateWatermarkSettingsHandler := NewUpdateWatermarkSettingsHandler(companyService)
+	updateRequisitesHandler := NewUpdateRequisitesHandler(companyService)
 
 	router.Post("/", createCompanyHandler.Handle)
+	router.Get("/:id/settings", getSettingsHandler.Handle)
+	router.Put("/:id/settings/watermark", updateWatermarkSettingsHandler.Handle)
+	router.Put("/:id/settings/requisites", updateRequisitesHandler.Handle)
 }
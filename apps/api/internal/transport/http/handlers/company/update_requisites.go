@@ -0,0 +1,58 @@
+package company
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/schema/types"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateRequisitesHandler struct {
+	companyService service.CompanyService
+}
+
+func NewUpdateRequisitesHandler(companyService service.CompanyService) *UpdateRequisitesHandler {
+	return &UpdateRequisitesHandler{
+		companyService: companyService,
+	}
+}
+
+// Handle godoc
+// @Summary      Обновление реквизитов компании
+// @Description  Сохраняет юридическое название, ИНН и КПП компании
+// @Description  Организация с этими реквизитами или названием компании не предлагается как новый контрагент при распознавании отправителя
+// @Tags         companies
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID компании" format:"uuid"
+// @Param        request body types.CompanyRequisites true "Реквизиты компании"
+// @Success      200 {object} types.CompanySettings "Обновленные настройки компании"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса, ИНН или КПП"
+// @Router       /private/companies/{id}/settings/requisites [put]
+func (h *UpdateRequisitesHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	var req types.CompanyRequisites
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	settings, err := h.companyService.UpdateRequisites(c.Context(), companyID, req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(settings)
+}
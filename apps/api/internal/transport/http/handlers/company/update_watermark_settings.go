@@ -0,0 +1,58 @@
+package company
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/schema/types"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateWatermarkSettingsHandler struct {
+	companyService service.CompanyService
+}
+
+func NewUpdateWatermarkSettingsHandler(companyService service.CompanyService) *UpdateWatermarkSettingsHandler {
+	return &UpdateWatermarkSettingsHandler{
+		companyService: companyService,
+	}
+}
+
+// Handle godoc
+// @Summary      Обновление водяного знака компании
+// @Description  Сохраняет шаблоны водяного знака и штампа, которые накладываются при скачивании документов
+// @Description  Поля шаблона: .UserEmail, .UserName, .DocumentID, .DocumentName, .CompanyName, .Timestamp; в штампе также %p и %P
+// @Tags         companies
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID компании" format:"uuid"
+// @Param        request body types.WatermarkSettings true "Настройки водяного знака"
+// @Success      200 {object} types.CompanySettings "Обновленные настройки компании"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или шаблона"
+// @Router       /private/companies/{id}/settings/watermark [put]
+func (h *UpdateWatermarkSettingsHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	var req types.WatermarkSettings
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	settings, err := h.companyService.UpdateWatermarkSettings(c.Context(), companyID, req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(settings)
+}
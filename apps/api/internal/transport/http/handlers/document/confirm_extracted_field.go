@@ -0,0 +1,59 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ConfirmExtractedFieldHandler struct {
+	documentService service.DocumentService
+}
+
+func NewConfirmExtractedFieldHandler(documentService service.DocumentService) *ConfirmExtractedFieldHandler {
+	return &ConfirmExtractedFieldHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Подтверждение извлеченного значения поля
+// @Description  Записывает найденное значение поля в документ, value в теле запроса заменяет найденное значение
+// @Tags         documents
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        key path string true "Ключ поля"
+// @Param        request body ConfirmExtractedFieldRequest false "Исправленное значение"
+// @Success      200 {object} types.FieldExtraction "Результат извлечения значений"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или значения"
+// @Failure      404 {object} handlers.ErrorResponse "Значение поля не найдено"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/field-extraction/{key}/confirm [post]
+func (h *ConfirmExtractedFieldHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	var req ConfirmExtractedFieldRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().JSON(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid request format",
+			})
+		}
+	}
+
+	extraction, err := h.documentService.ConfirmExtractedField(c.Context(), documentID, c.Params("key"), req.Value)
+	if err != nil {
+		return respondFieldExtractionError(c, err)
+	}
+
+	return c.JSON(extraction)
+}
@@ -0,0 +1,73 @@
+package document
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ConfirmSenderProposalHandler struct {
+	documentService service.DocumentService
+}
+
+func NewConfirmSenderProposalHandler(documentService service.DocumentService) *ConfirmSenderProposalHandler {
+	return &ConfirmSenderProposalHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Подтверждение предложенного контрагента
+// @Description  Создает контрагента из реквизитов, найденных в документе, и присваивает его документу без контрагента
+// @Description  Если в компании есть похожие карточки и force не задан, возвращает их с кодом 409
+// @Tags         documents
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        index path int true "Номер предложения, начиная с 0"
+// @Param        request body ConfirmSenderProposalRequest false "Параметры подтверждения"
+// @Success      201 {object} SenderData "Контрагент создан"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или реквизиты"
+// @Failure      404 {object} handlers.ErrorResponse "Предложение не найдено"
+// @Failure      409 {object} SenderDuplicatesResponse "В компании есть похожие контрагенты"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/sender-proposals/{index}/confirm [post]
+func (h *ConfirmSenderProposalHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	index, ok := parseProposalIndex(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid proposal index",
+		})
+	}
+
+	var req ConfirmSenderProposalRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().JSON(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid request format",
+			})
+		}
+	}
+
+	sender, duplicates, err := h.documentService.ConfirmSenderProposal(c.Context(), documentID, index, req.Force)
+	if errors.Is(err, service.ErrSenderDuplicate) {
+		return c.Status(fiber.StatusConflict).JSON(newSenderDuplicatesResponse(err, duplicates))
+	}
+	if err != nil {
+		return respondSenderDetectionError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(newSenderData(sender))
+}
@@ -0,0 +1,47 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DetectSenderHandler struct {
+	documentService service.DocumentService
+}
+
+func NewDetectSenderHandler(documentService service.DocumentService) *DetectSenderHandler {
+	return &DetectSenderHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Повторное определение контрагента
+// @Description  Заново ищет контрагентов в тексте и метаданных документа, например после пополнения справочника
+// @Description  Контрагент присваивается, только если у документа его нет
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.SenderDetection "Результат определения контрагента"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/sender-detection/run [post]
+func (h *DetectSenderHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	detection, err := h.documentService.DetectSender(c.Context(), documentID)
+	if err != nil {
+		return respondSenderDetectionError(c, err)
+	}
+
+	return c.JSON(detection)
+}
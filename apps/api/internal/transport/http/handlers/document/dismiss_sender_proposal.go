@@ -0,0 +1,54 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DismissSenderProposalHandler struct {
+	documentService service.DocumentService
+}
+
+func NewDismissSenderProposalHandler(documentService service.DocumentService) *DismissSenderProposalHandler {
+	return &DismissSenderProposalHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Отклонение предложенного контрагента
+// @Description  Убирает предложение нового контрагента из результата определения, контрагент не создается
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        index path int true "Номер предложения, начиная с 0"
+// @Success      204 "Предложение отклонено"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или номера предложения"
+// @Failure      404 {object} handlers.ErrorResponse "Предложение не найдено"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/sender-proposals/{index}/dismiss [post]
+func (h *DismissSenderProposalHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	index, ok := parseProposalIndex(c)
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid proposal index",
+		})
+	}
+
+	if err := h.documentService.DismissSenderProposal(c.Context(), documentID, index); err != nil {
+		return respondSenderDetectionError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -1,8 +1,13 @@
 package document
 
 import (
+	"encoding/json"
 	"time"
 
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
 	"github.com/google/uuid"
 )
 
@@ -12,35 +17,45 @@ type UploadRequest struct {
 	FolderID  *uuid.UUID `form:"folder_id,omitempty"`
 	Name      string     `form:"name" validate:"required"`
 	SenderID  *uuid.UUID `form:"sender_id,omitempty"`
+	// MapKeywordsToTags привязывает теги компании по ключевым словам из метаданных PDF
+	MapKeywordsToTags bool `form:"map_keywords_to_tags,omitempty"`
+	// TypeID - тип документа компании, Fields - JSON объект значений полей по схеме типа
+	TypeID *uuid.UUID `form:"type_id,omitempty"`
+	Fields string     `form:"fields,omitempty"`
 }
 
 // DocumentResponse представляет данные документа
 type DocumentResponse struct {
-	ID              uuid.UUID   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	CompanyID       uuid.UUID   `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	FolderID        *uuid.UUID  `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
-	SenderID        *uuid.UUID  `json:"sender_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
-	Sender          *SenderData `json:"sender,omitempty"`
-	Name            string      `json:"name" example:"document.pdf"`
-	FilePath        string      `json:"file_path" example:"documents/550e8400-e29b-41d4-a716-446655440000.pdf"`
-	PreviewFilePath *string     `json:"preview_file_path,omitempty" example:"previews/550e8400-e29b-41d4-a716-446655440000.jpg"`
-	FileSize        int64       `json:"file_size" example:"1024000"`
-	MimeType        string      `json:"mime_type" example:"application/pdf"`
-	Checksum        string      `json:"checksum" example:"abc123def456"`
-	CreatedBy       *uuid.UUID  `json:"created_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440004"`
-	UpdatedBy       *uuid.UUID  `json:"updated_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440005"`
-	CreatedAt       time.Time   `json:"created_at" example:"2024-11-28T15:04:05Z"`
-	UpdatedAt       time.Time   `json:"updated_at" example:"2024-11-28T15:04:05Z"`
-	Tags            []TagData   `json:"tags,omitempty"`
-	PreviewURL      string      `json:"preview_url,omitempty" example:"https://minio.example.com/bucket/preview.jpg?token=..."`
-	DownloadURL     string      `json:"download_url,omitempty" example:"https://minio.example.com/bucket/document.pdf?token=..."`
+	ID              uuid.UUID              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID       uuid.UUID              `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	FolderID        *uuid.UUID             `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	SenderID        *uuid.UUID             `json:"sender_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Sender          *SenderData            `json:"sender,omitempty"`
+	Name            string                 `json:"name" example:"document.pdf"`
+	FilePath        string                 `json:"file_path" example:"documents/550e8400-e29b-41d4-a716-446655440000.pdf"`
+	PreviewFilePath *string                `json:"preview_file_path,omitempty" example:"previews/550e8400-e29b-41d4-a716-446655440000.jpg"`
+	FileSize        int64                  `json:"file_size" example:"1024000"`
+	MimeType        string                 `json:"mime_type" example:"application/pdf"`
+	Checksum        string                 `json:"checksum" example:"abc123def456"`
+	CreatedBy       *uuid.UUID             `json:"created_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440004"`
+	UpdatedBy       *uuid.UUID             `json:"updated_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440005"`
+	CreatedAt       time.Time              `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt       time.Time              `json:"updated_at" example:"2024-11-28T15:04:05Z"`
+	Metadata        types.DocumentMetadata `json:"metadata"`
+	TypeID          *uuid.UUID             `json:"type_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440006"`
+	Fields          map[string]interface{} `json:"fields,omitempty"`
+	Tags            []TagData              `json:"tags,omitempty"`
+	PreviewURL      string                 `json:"preview_url,omitempty" example:"https://minio.example.com/bucket/preview.jpg?token=..."`
+	DownloadURL     string                 `json:"download_url,omitempty" example:"https://minio.example.com/bucket/document.pdf?token=..."`
 }
 
 // TagData представляет данные тега
 type TagData struct {
-	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	CompanyID uuid.UUID `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	Name      string    `json:"name" example:"Important"`
+	ID        uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID uuid.UUID  `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Name      string     `json:"name" example:"Important"`
+	Color     *string    `json:"color,omitempty" example:"#2E7D32"`
 }
 
 // SenderData представляет данные контрагента
@@ -56,16 +71,129 @@ type UpdateRequest struct {
 	Name     string     `json:"name,omitempty" validate:"omitempty,min=1" example:"new_name.pdf"`
 	FolderID *uuid.UUID `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
 	SenderID *uuid.UUID `json:"sender_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// TypeID меняет тип документа, совпадающие по ключу и типу значения полей сохраняются
+	TypeID *uuid.UUID `json:"type_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	// Fields заменяет значения перечисленных полей, null удаляет значение
+	Fields map[string]json.RawMessage `json:"fields,omitempty"`
+	// ClearType снимает с документа тип и значения полей
+	ClearType bool `json:"clear_type,omitempty"`
 }
 
 // SearchRequest представляет запрос на поиск документов
 type SearchRequest struct {
-	CompanyID uuid.UUID   `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Query     string      `json:"query,omitempty" example:"invoice"`
-	FolderID  *uuid.UUID  `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
-	TagIDs    []uuid.UUID `json:"tag_ids,omitempty"`
-	Page      int         `json:"page,omitempty" example:"1"`
-	PageSize  int         `json:"page_size,omitempty" example:"20"`
+	CompanyID uuid.UUID  `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Query     string     `json:"query,omitempty" example:"invoice"`
+	FolderID  *uuid.UUID `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// TagIDs - документ должен иметь все указанные теги, то же что tags.all
+	TagIDs    []uuid.UUID       `json:"tag_ids,omitempty"`
+	Tags      *TagFilterRequest `json:"tags,omitempty"`
+	MimeTypes []string          `json:"mime_types,omitempty" example:"application/pdf"`
+	SenderIDs []uuid.UUID       `json:"sender_ids,omitempty"`
+	CreatedBy []uuid.UUID       `json:"created_by,omitempty"`
+	// Диапазоны дат в формате RFC 3339, границы включаются
+	CreatedFrom *time.Time `json:"created_from,omitempty" example:"2024-01-01T00:00:00Z"`
+	CreatedTo   *time.Time `json:"created_to,omitempty" example:"2024-12-31T23:59:59Z"`
+	UpdatedFrom *time.Time `json:"updated_from,omitempty"`
+	UpdatedTo   *time.Time `json:"updated_to,omitempty"`
+	// Размер файла в байтах
+	SizeMin *int64 `json:"size_min,omitempty" example:"1024"`
+	SizeMax *int64 `json:"size_max,omitempty" example:"10485760"`
+	// TypeIDs - типы документов, Fields - условия по значениям полей типа
+	TypeIDs []uuid.UUID         `json:"type_ids,omitempty"`
+	Fields  []types.FieldFilter `json:"fields,omitempty"`
+	// Mode - keyword (по умолчанию), semantic или hybrid
+	Mode string `json:"mode,omitempty" example:"hybrid"`
+	// Sort - relevance, created_desc, created_asc, updated_desc, updated_asc, size_desc, size_asc
+	// или field:<ключ поля>:asc|desc
+	Sort     string `json:"sort,omitempty" example:"created_desc"`
+	Page     int    `json:"page,omitempty" example:"1"`
+	PageSize int    `json:"page_size,omitempty" example:"20"`
+	// Cursor - next_cursor из предыдущего ответа, используется если page не указан
+	Cursor string `json:"cursor,omitempty" example:"eyJzZWFyY2hfYWZ0ZXIiOlsxLjUsImFiYyJdfQ"`
+}
+
+// TagFilterRequest задает условия по тегам: all - И, any - ИЛИ, none - НЕ
+// Тег в условии охватывает и все его дочерние теги
+type TagFilterRequest struct {
+	All  []uuid.UUID `json:"all,omitempty"`
+	Any  []uuid.UUID `json:"any,omitempty"`
+	None []uuid.UUID `json:"none,omitempty"`
+}
+
+// QueryErrorResponse представляет ошибку разбора поискового запроса
+type QueryErrorResponse struct {
+	Error string `json:"error" example:"query syntax error at position 4: expected term after AND"`
+	// Position - позиция ошибки в query в символах, начиная с 0
+	Position int `json:"position" example:"4"`
+}
+
+// SearchResultResponse представляет найденный документ
+type SearchResultResponse struct {
+	DocumentResponse
+	Score        *float64            `json:"score,omitempty" example:"7.42"`
+	Highlights   map[string][]string `json:"highlights,omitempty"`
+	MatchedPages []PageMatchResponse `json:"matched_pages,omitempty"`
+}
+
+// PageMatchResponse представляет совпадение на странице документа
+type PageMatchResponse struct {
+	Number     int      `json:"number" example:"3"`
+	Highlights []string `json:"highlights,omitempty"`
+}
+
+// SearchResponse представляет страницу результатов поиска
+type SearchResponse struct {
+	Documents  []SearchResultResponse `json:"documents"`
+	Total      int64                  `json:"total" example:"125"`
+	Page       int                    `json:"page,omitempty" example:"1"`
+	PageSize   int                    `json:"page_size" example:"20"`
+	NextCursor string                 `json:"next_cursor,omitempty"`
+	// Facets - распределение найденных документов: tags, senders, mime_types, months
+	Facets map[string][]FacetBucketResponse `json:"facets"`
+	// DidYouMean - исправленный запрос, если по исходному ничего не найдено
+	DidYouMean string `json:"did_you_mean,omitempty" example:"договор поставки"`
+	// SearchID - запись поиска в аналитике, передается в /search/click при открытии результата
+	SearchID *uuid.UUID `json:"search_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// SearchClickRequest представляет переход пользователя к документу из выдачи
+type SearchClickRequest struct {
+	SearchID   uuid.UUID `json:"search_id" validate:"required"`
+	DocumentID uuid.UUID `json:"document_id" validate:"required"`
+	// Position - позиция документа в выдаче, начиная с 0
+	Position int `json:"position" example:"0"`
+}
+
+// SearchSettingsRequest представляет изменение настроек поиска пользователя
+type SearchSettingsRequest struct {
+	// StoreQueries - сохранять текст поисковых запросов в аналитике
+	StoreQueries bool `json:"store_queries" example:"false"`
+}
+
+// SearchSettingsResponse представляет настройки поиска пользователя
+type SearchSettingsResponse struct {
+	StoreQueries bool `json:"store_queries" example:"true"`
+}
+
+// SuggestResponse представляет подсказки для строки поиска
+type SuggestResponse struct {
+	Documents []DocumentSuggestionResponse `json:"documents"`
+	Tags      []TagData                    `json:"tags"`
+	Senders   []SenderData                 `json:"senders"`
+}
+
+// DocumentSuggestionResponse представляет подходящий под ввод документ
+type DocumentSuggestionResponse struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string    `json:"name" example:"Договор поставки №15.pdf"`
+	Highlight string    `json:"highlight" example:"<em>Дог</em>овор поставки №15.pdf"`
+}
+
+// FacetBucketResponse представляет значение фасета
+type FacetBucketResponse struct {
+	Key   string `json:"key" example:"application/pdf"`
+	Label string `json:"label" example:"application/pdf"`
+	Count int64  `json:"count" example:"42"`
 }
 
 // DocumentsListResponse представляет список документов
@@ -74,8 +202,226 @@ type DocumentsListResponse struct {
 	Total     int                `json:"total" example:"10"`
 }
 
+// TextResponse представляет извлеченный текст документа
+type TextResponse struct {
+	DocumentID    uuid.UUID `json:"document_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Text          string    `json:"text" example:"Договор поставки №15"`
+	Page          int       `json:"page,omitempty" example:"1"`
+	PagesCount    int       `json:"pages_count" example:"3"`
+	Language      string    `json:"language,omitempty" example:"ru"`
+	Engine        string    `json:"engine" example:"docconv"`
+	EngineVersion string    `json:"engine_version,omitempty" example:"v2.0.0"`
+}
+
+// WatermarkRequest переопределяет шаблоны водяного знака и штампа компании
+// Доступные поля шаблона: .UserEmail, .UserName, .DocumentID, .DocumentName, .CompanyName, .Timestamp
+type WatermarkRequest struct {
+	Template      string `json:"template,omitempty" example:"CONFIDENTIAL – {{.UserEmail}} – {{.Timestamp}}"`
+	StampTemplate string `json:"stamp_template,omitempty" example:"{{.DocumentID}} | %p / %P"`
+	StampPages    string `json:"stamp_pages,omitempty" example:"1-3"`
+}
+
 // URLResponse представляет ответ с URL
 type URLResponse struct {
 	URL       string    `json:"url" example:"https://minio.example.com/bucket/document.pdf?token=..."`
 	ExpiresAt time.Time `json:"expires_at" example:"2024-11-28T16:04:05Z"`
 }
+
+// ConfirmSenderProposalRequest представляет подтверждение предложенного контрагента
+type ConfirmSenderProposalRequest struct {
+	// Force создает контрагента, даже если в компании есть похожие карточки
+	Force bool `json:"force" example:"false"`
+}
+
+// SenderDuplicateData представляет похожего контрагента из справочника
+type SenderDuplicateData struct {
+	Sender SenderData `json:"sender"`
+	// Score - оценка сходства от 0 до 1
+	Score   float64  `json:"score" example:"0.9"`
+	Reasons []string `json:"reasons" example:"inn"`
+}
+
+// SenderDuplicatesResponse представляет похожих контрагентов, из-за которых предложение не подтверждено
+type SenderDuplicatesResponse struct {
+	Error      string                `json:"error" example:"possible duplicate senders found"`
+	Duplicates []SenderDuplicateData `json:"duplicates"`
+}
+
+// ConfirmExtractedFieldRequest представляет подтверждение найденного значения поля
+type ConfirmExtractedFieldRequest struct {
+	// Value заменяет найденное значение, формат как в fields при обновлении документа
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// FieldExtractionReviewResponse представляет страницу очереди проверки извлеченных значений
+type FieldExtractionReviewResponse struct {
+	Items []FieldExtractionReviewItemResponse `json:"items"`
+	// Total - число документов в очереди на всех страницах
+	Total    int `json:"total" example:"12"`
+	Page     int `json:"page" example:"1"`
+	PageSize int `json:"page_size" example:"20"`
+}
+
+// FieldExtractionReviewItemResponse представляет документ и значения, ожидающие проверки
+type FieldExtractionReviewItemResponse struct {
+	Document DocumentResponse       `json:"document"`
+	Fields   []types.ExtractedField `json:"fields"`
+}
+
+// newDocumentResponse преобразует документ в DTO
+func newDocumentResponse(document *ent.Document) DocumentResponse {
+	var senderData *SenderData
+	if document.Edges.Sender != nil {
+		senderData = &SenderData{
+			ID:        document.Edges.Sender.ID,
+			CompanyID: document.Edges.Sender.CompanyID,
+			Name:      document.Edges.Sender.Name,
+			Email:     document.Edges.Sender.Email,
+		}
+	}
+
+	return DocumentResponse{
+		ID:              document.ID,
+		CompanyID:       document.CompanyID,
+		FolderID:        document.FolderID,
+		SenderID:        document.SenderID,
+		Sender:          senderData,
+		Name:            document.Name,
+		FilePath:        document.FilePath,
+		PreviewFilePath: document.PreviewFilePath,
+		FileSize:        document.FileSize,
+		MimeType:        document.MimeType,
+		Checksum:        document.Checksum,
+		CreatedBy:       document.CreatedBy,
+		UpdatedBy:       document.UpdatedBy,
+		CreatedAt:       document.CreatedAt,
+		UpdatedAt:       document.UpdatedAt,
+		Metadata:        document.Metadata,
+		TypeID:          document.TypeID,
+		Fields:          newFieldsData(document.FieldValues),
+	}
+}
+
+// newFieldsData преобразует значения полей типа документа в вид API:
+// строка, число, дата 2006-01-02, сумма {amount, currency} или ID контрагента
+func newFieldsData(values map[string]types.FieldValue) map[string]interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	fields := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		fields[key] = value.Value()
+	}
+	return fields
+}
+
+// newFieldExtractionReviewResponse преобразует страницу очереди проверки в DTO
+func newFieldExtractionReviewResponse(review *service.FieldExtractionReview) FieldExtractionReviewResponse {
+	response := FieldExtractionReviewResponse{
+		Items:    make([]FieldExtractionReviewItemResponse, 0, len(review.Items)),
+		Total:    review.Total,
+		Page:     review.Page,
+		PageSize: review.PageSize,
+	}
+	for _, item := range review.Items {
+		response.Items = append(response.Items, FieldExtractionReviewItemResponse{
+			Document: newDocumentResponse(item.Document),
+			Fields:   item.Fields,
+		})
+	}
+	return response
+}
+
+// newTagData преобразует тег в DTO
+func newTagData(tag *ent.Tag) TagData {
+	return TagData{
+		ID:        tag.ID,
+		CompanyID: tag.CompanyID,
+		ParentID:  tag.ParentID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+	}
+}
+
+// newDocumentWithTagsResponse преобразует документ с тегами и ссылками в DTO
+func newDocumentWithTagsResponse(docWithTags *service.DocumentWithTags) DocumentResponse {
+	tags := make([]TagData, 0, len(docWithTags.Tags))
+	for _, tag := range docWithTags.Tags {
+		tags = append(tags, newTagData(tag))
+	}
+
+	response := newDocumentResponse(docWithTags.Document)
+	response.Tags = tags
+	response.PreviewURL = docWithTags.PreviewURL
+	response.DownloadURL = docWithTags.DownloadURL
+	return response
+}
+
+// NewSearchResponse преобразует результаты поиска в DTO
+func NewSearchResponse(result *service.DocumentSearchResult) SearchResponse {
+	response := SearchResponse{
+		Documents:  make([]SearchResultResponse, 0, len(result.Hits)),
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		NextCursor: result.NextCursor,
+		DidYouMean: result.DidYouMean,
+		SearchID:   result.SearchID,
+		Facets:     make(map[string][]FacetBucketResponse, len(result.Facets)),
+	}
+
+	for name, buckets := range result.Facets {
+		facet := make([]FacetBucketResponse, 0, len(buckets))
+		for _, bucket := range buckets {
+			facet = append(facet, FacetBucketResponse{
+				Key:   bucket.Key,
+				Label: bucket.Label,
+				Count: bucket.Count,
+			})
+		}
+		response.Facets[name] = facet
+	}
+
+	for _, hit := range result.Hits {
+		item := SearchResultResponse{
+			DocumentResponse: newDocumentWithTagsResponse(hit.DocumentWithTags),
+			Score:            hit.Score,
+			Highlights:       hit.Highlights,
+		}
+		for _, page := range hit.MatchedPages {
+			item.MatchedPages = append(item.MatchedPages, PageMatchResponse{
+				Number:     page.Number,
+				Highlights: page.Highlights,
+			})
+		}
+		response.Documents = append(response.Documents, item)
+	}
+
+	return response
+}
+
+// newSenderData преобразует контрагента в DTO
+func newSenderData(sender *ent.Sender) SenderData {
+	return SenderData{
+		ID:        sender.ID,
+		CompanyID: sender.CompanyID,
+		Name:      sender.Name,
+		Email:     sender.Email,
+	}
+}
+
+// newSenderDuplicatesResponse преобразует похожих контрагентов в DTO
+func newSenderDuplicatesResponse(err error, duplicates []service.SenderDuplicate) SenderDuplicatesResponse {
+	response := SenderDuplicatesResponse{
+		Error:      err.Error(),
+		Duplicates: make([]SenderDuplicateData, 0, len(duplicates)),
+	}
+	for _, duplicate := range duplicates {
+		response.Duplicates = append(response.Duplicates, SenderDuplicateData{
+			Sender:  newSenderData(duplicate.Sender),
+			Score:   duplicate.Score,
+			Reasons: duplicate.Reasons,
+		})
+	}
+	return response
+}
@@ -0,0 +1,46 @@
+package document
+
+import (
+	"errors"
+	"strconv"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondSenderDetectionError отвечает кодом, соответствующим ошибке определения контрагента документа
+func respondSenderDetectionError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidSender):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrSenderDetectionNotFound), errors.Is(err, service.ErrSenderProposalNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
+
+// respondFieldExtractionError отвечает кодом, соответствующим ошибке извлечения значений полей документа
+func respondFieldExtractionError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidFieldValues):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrFieldExtractionNotFound), errors.Is(err, service.ErrExtractedFieldNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrFieldExtractionNotConfigured):
+		return c.Status(fiber.StatusConflict).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
+
+// isFieldsError проверяет, что ошибка вызвана неверным типом документа или значениями его полей
+func isFieldsError(err error) bool {
+	return errors.Is(err, service.ErrDocumentTypeNotFound) || errors.Is(err, service.ErrInvalidFieldValues)
+}
+
+// parseProposalIndex читает номер предложения контрагента из пути
+func parseProposalIndex(c fiber.Ctx) (int, bool) {
+	index, err := strconv.Atoi(c.Params("index"))
+	return index, err == nil && index >= 0
+}
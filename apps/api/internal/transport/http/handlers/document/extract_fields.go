@@ -0,0 +1,48 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ExtractFieldsHandler struct {
+	documentService service.DocumentService
+}
+
+func NewExtractFieldsHandler(documentService service.DocumentService) *ExtractFieldsHandler {
+	return &ExtractFieldsHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Повторное извлечение значений полей
+// @Description  Заново ищет значения полей в тексте документа по шаблону его типа, например после изменения шаблона
+// @Description  Значения записываются только в пустые поля, подтвержденные и отклоненные значения не возвращаются в очередь проверки
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.FieldExtraction "Результат извлечения значений"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      409 {object} handlers.ErrorResponse "У документа нет типа или у типа нет шаблона извлечения"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/field-extraction/run [post]
+func (h *ExtractFieldsHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	extraction, err := h.documentService.ExtractFields(c.Context(), documentID)
+	if err != nil {
+		return respondFieldExtractionError(c, err)
+	}
+
+	return c.JSON(extraction)
+}
@@ -52,34 +52,7 @@ func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
 	}
 
 	for _, docWithTags := range docsWithTags {
-		tags := make([]TagData, 0, len(docWithTags.Tags))
-		for _, tag := range docWithTags.Tags {
-			tags = append(tags, TagData{
-				ID:        tag.ID,
-				CompanyID: tag.CompanyID,
-				Name:      tag.Name,
-			})
-		}
-
-		response.Documents = append(response.Documents, DocumentResponse{
-			ID:              docWithTags.Document.ID,
-			CompanyID:       docWithTags.Document.CompanyID,
-			FolderID:        docWithTags.Document.FolderID,
-			SenderID:        docWithTags.Document.SenderID,
-			Name:            docWithTags.Document.Name,
-			FilePath:        docWithTags.Document.FilePath,
-			PreviewFilePath: docWithTags.Document.PreviewFilePath,
-			FileSize:        docWithTags.Document.FileSize,
-			MimeType:        docWithTags.Document.MimeType,
-			Checksum:        docWithTags.Document.Checksum,
-			CreatedBy:       docWithTags.Document.CreatedBy,
-			UpdatedBy:       docWithTags.Document.UpdatedBy,
-			CreatedAt:       docWithTags.Document.CreatedAt,
-			UpdatedAt:       docWithTags.Document.UpdatedAt,
-			Tags:            tags,
-			PreviewURL:      docWithTags.PreviewURL,
-			DownloadURL:     docWithTags.DownloadURL,
-		})
+		response.Documents = append(response.Documents, newDocumentWithTagsResponse(docWithTags))
 	}
 
 	return c.JSON(response)
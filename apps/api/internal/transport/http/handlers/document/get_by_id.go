@@ -47,32 +47,5 @@ func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	tags := make([]TagData, 0, len(docWithTags.Tags))
-	for _, tag := range docWithTags.Tags {
-		tags = append(tags, TagData{
-			ID:        tag.ID,
-			CompanyID: tag.CompanyID,
-			Name:      tag.Name,
-		})
-	}
-
-	return c.JSON(DocumentResponse{
-		ID:              docWithTags.Document.ID,
-		CompanyID:       docWithTags.Document.CompanyID,
-		FolderID:        docWithTags.Document.FolderID,
-		SenderID:        docWithTags.Document.SenderID,
-		Name:            docWithTags.Document.Name,
-		FilePath:        docWithTags.Document.FilePath,
-		PreviewFilePath: docWithTags.Document.PreviewFilePath,
-		FileSize:        docWithTags.Document.FileSize,
-		MimeType:        docWithTags.Document.MimeType,
-		Checksum:        docWithTags.Document.Checksum,
-		CreatedBy:       docWithTags.Document.CreatedBy,
-		UpdatedBy:       docWithTags.Document.UpdatedBy,
-		CreatedAt:       docWithTags.Document.CreatedAt,
-		UpdatedAt:       docWithTags.Document.UpdatedAt,
-		Tags:            tags,
-		PreviewURL:      docWithTags.PreviewURL,
-		DownloadURL:     docWithTags.DownloadURL,
-	})
+	return c.JSON(newDocumentWithTagsResponse(docWithTags))
 }
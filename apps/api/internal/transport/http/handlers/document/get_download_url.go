@@ -22,11 +22,15 @@ func NewGetDownloadURLHandler(documentService service.DocumentService) *GetDownl
 // Handle godoc
 // @Summary      Получение ссылки на скачивание
 // @Description  Возвращает временную presigned URL для скачивания оригинала документа
+// @Description  С параметром with_metadata=true возвращает копию PDF с записанными названием, отправителем, тегами и ID документа
+// @Description  С параметром searchable=true возвращает PDF с текстовым слоем, построенный при распознавании скана
 // @Tags         documents
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "ID документа" format:"uuid"
+// @Param        with_metadata query bool false "Записать метаданные документа в PDF"
+// @Param        searchable query bool false "PDF с текстовым слоем после OCR"
 // @Success      200 {object} URLResponse "Ссылка для скачивания"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
 // @Failure      404 {object} handlers.ErrorResponse "Документ не найден"
@@ -41,7 +45,15 @@ func (h *GetDownloadURLHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	url, err := h.documentService.GetDownloadURL(c.Context(), documentID)
+	var url string
+	switch {
+	case c.Query("searchable") == "true":
+		url, err = h.documentService.GetSearchablePDFURL(c.Context(), documentID)
+	case c.Query("with_metadata") == "true":
+		url, err = h.documentService.GetDownloadURLWithMetadata(c.Context(), documentID)
+	default:
+		url, err = h.documentService.GetDownloadURL(c.Context(), documentID)
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
 			Error: err.Error(),
@@ -0,0 +1,48 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetFieldExtractionHandler struct {
+	documentService service.DocumentService
+}
+
+func NewGetFieldExtractionHandler(documentService service.DocumentService) *GetFieldExtractionHandler {
+	return &GetFieldExtractionHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение извлеченных значений полей
+// @Description  Возвращает значения полей, найденные в тексте документа по шаблону его типа, с уверенностью и статусом:
+// @Description  applied - записано в документ, pending - ждет проверки, confirmed и rejected - решение пользователя
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.FieldExtraction "Результат извлечения значений"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Значения для текущего типа документа еще не извлекались"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/field-extraction [get]
+func (h *GetFieldExtractionHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	extraction, err := h.documentService.GetFieldExtraction(c.Context(), documentID)
+	if err != nil {
+		return respondFieldExtractionError(c, err)
+	}
+
+	return c.JSON(extraction)
+}
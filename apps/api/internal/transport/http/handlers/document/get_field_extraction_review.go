@@ -0,0 +1,66 @@
+package document
+
+import (
+	"strconv"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetFieldExtractionReviewHandler struct {
+	documentService service.DocumentService
+}
+
+func NewGetFieldExtractionReviewHandler(documentService service.DocumentService) *GetFieldExtractionReviewHandler {
+	return &GetFieldExtractionReviewHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Очередь проверки извлеченных значений
+// @Description  Возвращает документы компании со значениями полей, найденными с уверенностью ниже порога шаблона типа, сначала новые
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Param        page query int false "Номер страницы, начиная с 1"
+// @Param        page_size query int false "Размер страницы, по умолчанию 20, не больше 100"
+// @Success      200 {object} FieldExtractionReviewResponse "Очередь проверки"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или параметров страницы"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/field-extraction/company/{company_id} [get]
+func (h *GetFieldExtractionReviewHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	var input service.FieldExtractionReviewInput
+	if pageParam := c.Query("page"); pageParam != "" {
+		if input.Page, err = strconv.Atoi(pageParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page",
+			})
+		}
+	}
+	if pageSizeParam := c.Query("page_size"); pageSizeParam != "" {
+		if input.PageSize, err = strconv.Atoi(pageSizeParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page_size",
+			})
+		}
+	}
+
+	review, err := h.documentService.GetFieldExtractionReview(c.Context(), companyID, input)
+	if err != nil {
+		return respondFieldExtractionError(c, err)
+	}
+
+	return c.JSON(newFieldExtractionReviewResponse(review))
+}
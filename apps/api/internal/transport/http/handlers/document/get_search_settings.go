@@ -0,0 +1,47 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetSearchSettingsHandler struct {
+	analyticsService service.SearchAnalyticsService
+}
+
+func NewGetSearchSettingsHandler(analyticsService service.SearchAnalyticsService) *GetSearchSettingsHandler {
+	return &GetSearchSettingsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// Handle godoc
+// @Summary      Настройки поиска пользователя
+// @Description  Возвращает, сохраняется ли текст поисковых запросов пользователя в аналитике поиска
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Success      200 {object} SearchSettingsResponse "Настройки поиска"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/search/settings [get]
+func (h *GetSearchSettingsHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	storeQueries, err := h.analyticsService.StoresQueries(c.Context(), userID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(SearchSettingsResponse{StoreQueries: storeQueries})
+}
@@ -0,0 +1,48 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetSenderDetectionHandler struct {
+	documentService service.DocumentService
+}
+
+func NewGetSenderDetectionHandler(documentService service.DocumentService) *GetSenderDetectionHandler {
+	return &GetSenderDetectionHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Результат определения контрагента
+// @Description  Возвращает контрагентов из справочника, найденных в тексте и метаданных документа,
+// @Description  присвоенного автоматически контрагента и предложения новых контрагентов
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.SenderDetection "Результат определения контрагента"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Контрагент документа еще не определялся"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/sender-detection [get]
+func (h *GetSenderDetectionHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	detection, err := h.documentService.GetSenderDetection(c.Context(), documentID)
+	if err != nil {
+		return respondSenderDetectionError(c, err)
+	}
+
+	return c.JSON(detection)
+}
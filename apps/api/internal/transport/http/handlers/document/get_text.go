@@ -0,0 +1,69 @@
+package document
+
+import (
+	"strconv"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetTextHandler struct {
+	documentService service.DocumentService
+}
+
+func NewGetTextHandler(documentService service.DocumentService) *GetTextHandler {
+	return &GetTextHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение текста документа
+// @Description  Возвращает извлеченный из документа текст целиком или текст одной страницы
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        page query int false "Номер страницы, начиная с 1"
+// @Success      200 {object} TextResponse "Текст документа"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или номера страницы"
+// @Failure      404 {object} handlers.ErrorResponse "Текст документа не найден"
+// @Router       /private/documents/{id}/text [get]
+func (h *GetTextHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	page := 0
+	if pageParam := c.Query("page"); pageParam != "" {
+		page, err = strconv.Atoi(pageParam)
+		if err != nil || page < 1 {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page number",
+			})
+		}
+	}
+
+	text, err := h.documentService.GetText(c.Context(), documentID, page)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(TextResponse{
+		DocumentID:    text.DocumentID,
+		Text:          text.Text,
+		Page:          text.Page,
+		PagesCount:    text.PagesCount,
+		Language:      text.Language,
+		Engine:        text.Engine,
+		EngineVersion: text.EngineVersion,
+	})
+}
@@ -0,0 +1,77 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetWatermarkedURLHandler struct {
+	documentService service.DocumentService
+}
+
+func NewGetWatermarkedURLHandler(documentService service.DocumentService) *GetWatermarkedURLHandler {
+	return &GetWatermarkedURLHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение ссылки на копию с водяным знаком
+// @Description  Возвращает временную presigned URL на PDF копию документа с водяным знаком и штампом
+// @Description  По умолчанию используются шаблоны компании, тело запроса позволяет их переопределить
+// @Tags         documents
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        request body WatermarkRequest false "Шаблоны водяного знака и штампа"
+// @Success      200 {object} URLResponse "Ссылка для скачивания"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/download/watermarked [post]
+func (h *GetWatermarkedURLHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	documentID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	var req WatermarkRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().JSON(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid request format",
+			})
+		}
+	}
+
+	url, err := h.documentService.GetWatermarkedDownloadURL(c.Context(), documentID, userID, service.WatermarkInput{
+		Template:      req.Template,
+		StampTemplate: req.StampTemplate,
+		StampPages:    req.StampPages,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(URLResponse{
+		URL:       url,
+		ExpiresAt: time.Now().Add(1 * time.Hour),
+	})
+}
@@ -0,0 +1,49 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type RejectExtractedFieldHandler struct {
+	documentService service.DocumentService
+}
+
+func NewRejectExtractedFieldHandler(documentService service.DocumentService) *RejectExtractedFieldHandler {
+	return &RejectExtractedFieldHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Отклонение извлеченного значения поля
+// @Description  Убирает значение из очереди проверки, автоматически записанное значение удаляется из необязательного поля,
+// @Description  если пользователь его не менял
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Param        key path string true "Ключ поля"
+// @Success      200 {object} types.FieldExtraction "Результат извлечения значений"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Значение поля не найдено"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/{id}/field-extraction/{key}/reject [post]
+func (h *RejectExtractedFieldHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	extraction, err := h.documentService.RejectExtractedField(c.Context(), documentID, c.Params("key"))
+	if err != nil {
+		return respondFieldExtractionError(c, err)
+	}
+
+	return c.JSON(extraction)
+}
@@ -7,7 +7,7 @@ import (
 )
 
 // RegisterRoutes регистрирует маршруты для работы с документами
-func RegisterRoutes(router fiber.Router, documentService service.DocumentService) {
+func RegisterRoutes(router fiber.Router, documentService service.DocumentService, analyticsService service.SearchAnalyticsService) {
 	uploadHandler := NewUploadHandler(documentService)
 	getByIDHandler := NewGetByIDHandler(documentService)
 	getByFolderHandler := NewGetByFolderHandler(documentService)
@@ -15,15 +15,45 @@ func RegisterRoutes(router fiber.Router, documentService service.DocumentService
 	updateHandler := NewUpdateHandler(documentService)
 	deleteHandler := NewDeleteHandler(documentService)
 	getDownloadURLHandler := NewGetDownloadURLHandler(documentService)
+	getWatermarkedURLHandler := NewGetWatermarkedURLHandler(documentService)
 	getPreviewURLHandler := NewGetPreviewURLHandler(documentService)
+	getTextHandler := NewGetTextHandler(documentService)
+	getSenderDetectionHandler := NewGetSenderDetectionHandler(documentService)
+	detectSenderHandler := NewDetectSenderHandler(documentService)
+	confirmSenderProposalHandler := NewConfirmSenderProposalHandler(documentService)
+	dismissSenderProposalHandler := NewDismissSenderProposalHandler(documentService)
+	getFieldExtractionHandler := NewGetFieldExtractionHandler(documentService)
+	extractFieldsHandler := NewExtractFieldsHandler(documentService)
+	confirmExtractedFieldHandler := NewConfirmExtractedFieldHandler(documentService)
+	rejectExtractedFieldHandler := NewRejectExtractedFieldHandler(documentService)
+	getFieldExtractionReviewHandler := NewGetFieldExtractionReviewHandler(documentService)
 	searchHandler := NewSearchHandler(documentService)
+	suggestHandler := NewSuggestHandler(documentService)
+	searchClickHandler := NewSearchClickHandler(analyticsService)
+	getSearchSettingsHandler := NewGetSearchSettingsHandler(analyticsService)
+	updateSearchSettingsHandler := NewUpdateSearchSettingsHandler(analyticsService)
 	router.Post("/", uploadHandler.Handle)
 	router.Get("/:id", getByIDHandler.Handle)
 	router.Put("/:id", updateHandler.Handle)
 	router.Delete("/:id", deleteHandler.Handle)
 	router.Get("/:id/download", getDownloadURLHandler.Handle)
+	router.Post("/:id/download/watermarked", getWatermarkedURLHandler.Handle)
 	router.Get("/:id/preview", getPreviewURLHandler.Handle)
+	router.Get("/:id/text", getTextHandler.Handle)
+	router.Get("/:id/sender-detection", getSenderDetectionHandler.Handle)
+	router.Post("/:id/sender-detection/run", detectSenderHandler.Handle)
+	router.Post("/:id/sender-proposals/:index/confirm", confirmSenderProposalHandler.Handle)
+	router.Post("/:id/sender-proposals/:index/dismiss", dismissSenderProposalHandler.Handle)
+	router.Get("/:id/field-extraction", getFieldExtractionHandler.Handle)
+	router.Post("/:id/field-extraction/run", extractFieldsHandler.Handle)
+	router.Post("/:id/field-extraction/:key/confirm", confirmExtractedFieldHandler.Handle)
+	router.Post("/:id/field-extraction/:key/reject", rejectExtractedFieldHandler.Handle)
+	router.Get("/field-extraction/company/:company_id", getFieldExtractionReviewHandler.Handle)
 	router.Get("/folder/:folder_id", getByFolderHandler.Handle)
 	router.Get("/company/:company_id", getByCompanyHandler.Handle)
 	router.Post("/search", searchHandler.Handle)
+	router.Get("/search/suggest", suggestHandler.Handle)
+	router.Post("/search/click", searchClickHandler.Handle)
+	router.Get("/search/settings", getSearchSettingsHandler.Handle)
+	router.Put("/search/settings", updateSearchSettingsHandler.Handle)
 }
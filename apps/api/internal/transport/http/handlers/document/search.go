@@ -1,10 +1,13 @@
 package document
 
 import (
+	"errors"
+
 	"techmind/internal/service"
 	"techmind/internal/transport/http/handlers"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 )
 
 type SearchHandler struct {
@@ -19,14 +22,28 @@ func NewSearchHandler(documentService service.DocumentService) *SearchHandler {
 
 // Handle godoc
 // @Summary      Поиск документов
-// @Description  Ищет документы по различным критериям: текстовый запрос, папка, теги
+// @Description  Ищет документы по различным критериям: текстовый запрос, папка, теги (all/any/none),
+// @Description  MIME тип, контрагент, автор, даты создания и изменения, размер файла, тип документа и значения его полей
+// @Description  Вместе со страницей возвращает фасеты по тегам, контрагентам, типам и месяцам создания
+// @Description  Возвращает страницу результатов с общим числом найденных документов, релевантностью,
+// @Description  подсветкой совпадений в name и text и номерами совпавших страниц
+// @Description  query поддерживает фразы в кавычках, AND/OR/NOT и -слово, скобки, шаблоны * и ?, нечеткий поиск слово~
+// @Description  и поля name:, text:, tag:, sender:, type:, created:, updated:, size:, например
+// @Description  "договор поставки" AND sender:Ромашка -tag:archived created:>2025-01-01 name:акт*
+// @Description  Если ничего не найдено, did_you_mean содержит запрос с исправленными опечатками
+// @Description  mode: keyword - по ключевым словам, semantic - по смыслу текста запроса (близость векторов),
+// @Description  hybrid - сумма оценок обоих способов; условия по полям в запросе обязательны во всех режимах
+// @Description  sort задает порядок выдачи: по релевантности, дате создания или изменения, размеру
+// @Description  или по значению поля типа документа, например field:amount:desc
+// @Description  Листать можно номером страницы (page) или курсором (cursor из next_cursor)
+// @Description  Первая страница записывается в аналитику поиска, search_id из ответа передается в /search/click
 // @Tags         documents
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body SearchRequest true "Параметры поиска"
-// @Success      200 {object} DocumentsListResponse "Результаты поиска"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Success      200 {object} SearchResponse "Результаты поиска"
+// @Failure      400 {object} QueryErrorResponse "Неверный формат запроса или синтаксическая ошибка в query"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/documents/search [post]
 func (h *SearchHandler) Handle(c fiber.Ctx) error {
@@ -37,59 +54,58 @@ func (h *SearchHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	docsWithTags, err := h.documentService.Search(c.Context(), req.CompanyID, req.Query, req.FolderID, req.TagIDs)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+	input := service.DocumentSearchInput{
+		CompanyID:   req.CompanyID,
+		Query:       req.Query,
+		FolderID:    req.FolderID,
+		TagIDs:      req.TagIDs,
+		MimeTypes:   req.MimeTypes,
+		SenderIDs:   req.SenderIDs,
+		CreatedBy:   req.CreatedBy,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		UpdatedFrom: req.UpdatedFrom,
+		UpdatedTo:   req.UpdatedTo,
+		SizeMin:     req.SizeMin,
+		SizeMax:     req.SizeMax,
+		TypeIDs:     req.TypeIDs,
+		Fields:      req.Fields,
+		Mode:        req.Mode,
+		Sort:        req.Sort,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		Cursor:      req.Cursor,
 	}
-
-	response := DocumentsListResponse{
-		Documents: make([]DocumentResponse, 0, len(docsWithTags)),
-		Total:     len(docsWithTags),
+	if userID, ok := c.Locals("user_id").(uuid.UUID); ok {
+		input.UserID = userID
 	}
-
-	for _, docWithTags := range docsWithTags {
-		tags := make([]TagData, 0, len(docWithTags.Tags))
-		for _, tag := range docWithTags.Tags {
-			tags = append(tags, TagData{
-				ID:        tag.ID,
-				CompanyID: tag.CompanyID,
-				Name:      tag.Name,
-			})
-		}
-
-		var senderData *SenderData
-		if docWithTags.Document.Edges.Sender != nil {
-			senderData = &SenderData{
-				ID:        docWithTags.Document.Edges.Sender.ID,
-				CompanyID: docWithTags.Document.Edges.Sender.CompanyID,
-				Name:      docWithTags.Document.Edges.Sender.Name,
-				Email:     docWithTags.Document.Edges.Sender.Email,
-			}
+	if req.Tags != nil {
+		input.Tags = service.TagFilter{
+			All:  req.Tags.All,
+			Any:  req.Tags.Any,
+			None: req.Tags.None,
 		}
+	}
 
-		response.Documents = append(response.Documents, DocumentResponse{
-			ID:              docWithTags.Document.ID,
-			CompanyID:       docWithTags.Document.CompanyID,
-			FolderID:        docWithTags.Document.FolderID,
-			SenderID:        docWithTags.Document.SenderID,
-			Sender:          senderData,
-			Name:            docWithTags.Document.Name,
-			FilePath:        docWithTags.Document.FilePath,
-			PreviewFilePath: docWithTags.Document.PreviewFilePath,
-			FileSize:        docWithTags.Document.FileSize,
-			MimeType:        docWithTags.Document.MimeType,
-			Checksum:        docWithTags.Document.Checksum,
-			CreatedBy:       docWithTags.Document.CreatedBy,
-			UpdatedBy:       docWithTags.Document.UpdatedBy,
-			CreatedAt:       docWithTags.Document.CreatedAt,
-			UpdatedAt:       docWithTags.Document.UpdatedAt,
-			Tags:            tags,
-			PreviewURL:      docWithTags.PreviewURL,
-			DownloadURL:     docWithTags.DownloadURL,
+	result, err := h.documentService.Search(c.Context(), input)
+	var syntaxErr *service.QuerySyntaxError
+	if errors.As(err, &syntaxErr) {
+		return c.Status(fiber.StatusBadRequest).JSON(QueryErrorResponse{
+			Error:    syntaxErr.Error(),
+			Position: syntaxErr.Position,
+		})
+	}
+	if errors.Is(err, service.ErrInvalidSearchSort) || errors.Is(err, service.ErrInvalidSearchMode) ||
+		errors.Is(err, service.ErrSemanticSearchDisabled) {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
 		})
 	}
 
-	return c.JSON(response)
+	return c.JSON(NewSearchResponse(result))
 }
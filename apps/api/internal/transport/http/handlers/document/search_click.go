@@ -0,0 +1,65 @@
+package document
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type SearchClickHandler struct {
+	analyticsService service.SearchAnalyticsService
+}
+
+func NewSearchClickHandler(analyticsService service.SearchAnalyticsService) *SearchClickHandler {
+	return &SearchClickHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// Handle godoc
+// @Summary      Переход к результату поиска
+// @Description  Учитывает в аналитике поиска открытие документа из выдачи
+// @Description  search_id берется из ответа /search, position - позиция документа в выдаче, начиная с 0
+// @Tags         documents
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body SearchClickRequest true "Открытый результат"
+// @Success      204 "Переход учтен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Router       /private/documents/search/click [post]
+func (h *SearchClickHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req SearchClickRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	err := h.analyticsService.RecordClick(c.Context(), userID, req.SearchID, req.DocumentID, req.Position)
+	if errors.Is(err, service.ErrSearchLogNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
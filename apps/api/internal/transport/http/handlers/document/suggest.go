@@ -0,0 +1,87 @@
+package document
+
+import (
+	"strconv"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type SuggestHandler struct {
+	documentService service.DocumentService
+}
+
+func NewSuggestHandler(documentService service.DocumentService) *SuggestHandler {
+	return &SuggestHandler{
+		documentService: documentService,
+	}
+}
+
+// Handle godoc
+// @Summary      Подсказки для строки поиска
+// @Description  Возвращает по мере ввода документы компании, слова названия которых начинаются с введенных слов,
+// @Description  а также теги и контрагентов, название которых содержит введенный текст
+// @Tags         documents
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id query string true "ID компании" format:"uuid"
+// @Param        q query string true "Введенный текст"
+// @Param        limit query int false "Число подсказок каждого вида, по умолчанию 5, максимум 20"
+// @Success      200 {object} SuggestResponse "Подсказки"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат параметров"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/search/suggest [get]
+func (h *SuggestHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Query("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	limit := 0
+	if limitParam := c.Query("limit"); limitParam != "" {
+		limit, err = strconv.Atoi(limitParam)
+		if err != nil || limit < 1 {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid limit",
+			})
+		}
+	}
+
+	suggestions, err := h.documentService.Suggest(c.Context(), companyID, c.Query("q"), limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	response := SuggestResponse{
+		Documents: make([]DocumentSuggestionResponse, 0, len(suggestions.Documents)),
+		Tags:      make([]TagData, 0, len(suggestions.Tags)),
+		Senders:   make([]SenderData, 0, len(suggestions.Senders)),
+	}
+	for _, document := range suggestions.Documents {
+		response.Documents = append(response.Documents, DocumentSuggestionResponse{
+			ID:        document.ID,
+			Name:      document.Name,
+			Highlight: document.Highlight,
+		})
+	}
+	for _, tag := range suggestions.Tags {
+		response.Tags = append(response.Tags, newTagData(tag))
+	}
+	for _, sender := range suggestions.Senders {
+		response.Senders = append(response.Senders, SenderData{
+			ID:        sender.ID,
+			CompanyID: sender.CompanyID,
+			Name:      sender.Name,
+			Email:     sender.Email,
+		})
+	}
+
+	return c.JSON(response)
+}
@@ -20,7 +20,7 @@ func NewUpdateHandler(documentService service.DocumentService) *UpdateHandler {
 
 // Handle godoc
 // @Summary      Обновление метаданных документа
-// @Description  Обновляет название, папку или отправителя документа
+// @Description  Обновляет название, папку или отправителя документа, его тип и значения полей типа
 // @Tags         documents
 // @Accept       json
 // @Produce      json
@@ -61,31 +61,25 @@ func (h *UpdateHandler) Handle(c fiber.Ctx) error {
 		FolderID: req.FolderID,
 		SenderID: req.SenderID,
 		UserID:   userID,
+
+		TypeID:    req.TypeID,
+		Fields:    req.Fields,
+		ClearType: req.ClearType,
 	}
 
 	// ...existing code...
 
 	document, err := h.documentService.Update(c.Context(), documentID, input)
 	if err != nil {
+		if isFieldsError(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
 			Error: err.Error(),
 		})
 	}
 
-	return c.JSON(DocumentResponse{
-		ID:              document.ID,
-		CompanyID:       document.CompanyID,
-		FolderID:        document.FolderID,
-		SenderID:        document.SenderID,
-		Name:            document.Name,
-		FilePath:        document.FilePath,
-		PreviewFilePath: document.PreviewFilePath,
-		FileSize:        document.FileSize,
-		MimeType:        document.MimeType,
-		Checksum:        document.Checksum,
-		CreatedBy:       document.CreatedBy,
-		UpdatedBy:       document.UpdatedBy,
-		CreatedAt:       document.CreatedAt,
-		UpdatedAt:       document.UpdatedAt,
-	})
+	return c.JSON(newDocumentResponse(document))
 }
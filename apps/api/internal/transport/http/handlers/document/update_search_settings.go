@@ -0,0 +1,57 @@
+package document
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateSearchSettingsHandler struct {
+	analyticsService service.SearchAnalyticsService
+}
+
+func NewUpdateSearchSettingsHandler(analyticsService service.SearchAnalyticsService) *UpdateSearchSettingsHandler {
+	return &UpdateSearchSettingsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// Handle godoc
+// @Summary      Изменение настроек поиска пользователя
+// @Description  Включает или отключает хранение текста поисковых запросов пользователя в аналитике поиска
+// @Description  При отключении текст уже записанных запросов удаляется, число поисков и их результаты остаются
+// @Tags         documents
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body SearchSettingsRequest true "Настройки поиска"
+// @Success      200 {object} SearchSettingsResponse "Настройки сохранены"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/documents/search/settings [put]
+func (h *UpdateSearchSettingsHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req SearchSettingsRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	if err := h.analyticsService.SetStoreQueries(c.Context(), userID, req.StoreQueries); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.JSON(SearchSettingsResponse{StoreQueries: req.StoreQueries})
+}
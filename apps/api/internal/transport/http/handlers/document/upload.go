@@ -1,6 +1,7 @@
 package document
 
 import (
+	"encoding/json"
 	"strings"
 
 	"techmind/internal/service"
@@ -31,6 +32,9 @@ func NewUploadHandler(documentService service.DocumentService) *UploadHandler {
 // @Param        name formData string true "Название документа"
 // @Param        folder_id formData string false "ID папки" format:"uuid"
 // @Param        sender_id formData string false "ID отправителя" format:"uuid"
+// @Param        map_keywords_to_tags formData bool false "Привязать теги по ключевым словам из метаданных PDF"
+// @Param        type_id formData string false "ID типа документа" format:"uuid"
+// @Param        fields formData string false "JSON объект значений полей по схеме типа документа"
 // @Param        file formData file true "Файл документа"
 // @Success      201 {object} DocumentResponse "Документ успешно загружен"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
@@ -76,12 +80,28 @@ func (h *UploadHandler) Handle(c fiber.Ctx) error {
 		MimeType:  file.Header.Get("Content-Type"),
 		SenderID:  req.SenderID,
 		UserID:    userID,
+
+		MapKeywordsToTags: req.MapKeywordsToTags,
+
+		TypeID: req.TypeID,
+	}
+	if req.Fields != "" {
+		if err := json.Unmarshal([]byte(req.Fields), &input.Fields); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid fields format",
+			})
+		}
 	}
 
 	// ...existing code...
 
 	document, err := h.documentService.Upload(c.Context(), input)
 	if err != nil {
+		if isFieldsError(err) {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
 		if strings.Contains(err.Error(), "not supported") {
 			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(handlers.ErrorResponse{
 				Error: err.Error(),
@@ -92,20 +112,5 @@ func (h *UploadHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(DocumentResponse{
-		ID:              document.ID,
-		CompanyID:       document.CompanyID,
-		FolderID:        document.FolderID,
-		SenderID:        document.SenderID,
-		Name:            document.Name,
-		FilePath:        document.FilePath,
-		PreviewFilePath: document.PreviewFilePath,
-		FileSize:        document.FileSize,
-		MimeType:        document.MimeType,
-		Checksum:        document.Checksum,
-		CreatedBy:       document.CreatedBy,
-		UpdatedBy:       document.UpdatedBy,
-		CreatedAt:       document.CreatedAt,
-		UpdatedAt:       document.UpdatedAt,
-	})
+	return c.Status(fiber.StatusCreated).JSON(newDocumentResponse(document))
 }
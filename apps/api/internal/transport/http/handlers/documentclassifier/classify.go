@@ -0,0 +1,48 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ClassifyHandler struct {
+	classifierService service.ClassifierService
+}
+
+func NewClassifyHandler(classifierService service.ClassifierService) *ClassifyHandler {
+	return &ClassifyHandler{
+		classifierService: classifierService,
+	}
+}
+
+// Handle godoc
+// @Summary      Классификация документа
+// @Description  Предсказывает тип документа по тексту моделью компании, например после переобучения модели
+// @Description  Документу без типа присваивается тип с уверенностью не ниже 0.8, выбранный пользователем тип не меняется
+// @Tags         classifier
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.DocumentClassification "Предсказание типа"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Модель не обучена или у документа нет текста"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/classifier/documents/{id}/run [post]
+func (h *ClassifyHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	classification, err := h.classifierService.Classify(c.Context(), documentID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(classification)
+}
@@ -0,0 +1,55 @@
+package documentclassifier
+
+import (
+	"time"
+
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// ClassifierResponse представляет обученную модель классификации документов компании
+type ClassifierResponse struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID uuid.UUID `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// Documents - число документов, на которых обучена модель
+	Documents int `json:"documents" example:"240"`
+	// Terms - размер словаря модели
+	Terms      int                        `json:"terms" example:"8500"`
+	Classes    []ClassifierClassResponse  `json:"classes"`
+	Evaluation types.ClassifierEvaluation `json:"evaluation"`
+	TrainedAt  time.Time                  `json:"trained_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// ClassifierClassResponse представляет тип документа, который различает модель
+type ClassifierClassResponse struct {
+	TypeID    uuid.UUID `json:"type_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Documents int       `json:"documents" example:"80"`
+}
+
+// ClassifierEvaluationResponse представляет качество модели на перекрестной проверке
+type ClassifierEvaluationResponse struct {
+	types.ClassifierEvaluation
+	TrainedAt time.Time `json:"trained_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// NewClassifierResponse преобразует модель в DTO
+func NewClassifierResponse(documentClassifier *ent.DocumentClassifier) ClassifierResponse {
+	response := ClassifierResponse{
+		ID:         documentClassifier.ID,
+		CompanyID:  documentClassifier.CompanyID,
+		Documents:  documentClassifier.Documents,
+		Terms:      len(documentClassifier.Model.Terms),
+		Classes:    make([]ClassifierClassResponse, 0, len(documentClassifier.Model.Classes)),
+		Evaluation: documentClassifier.Evaluation,
+		TrainedAt:  documentClassifier.TrainedAt,
+	}
+	for _, class := range documentClassifier.Model.Classes {
+		response.Classes = append(response.Classes, ClassifierClassResponse{
+			TypeID:    class.TypeID,
+			Documents: class.Documents,
+		})
+	}
+	return response
+}
@@ -0,0 +1,23 @@
+package documentclassifier
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondError отвечает кодом, соответствующим ошибке сервиса классификации документов
+func respondError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrClassifierNotFound),
+		errors.Is(err, service.ErrClassificationNotFound),
+		errors.Is(err, service.ErrDocumentTextNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrNotEnoughTrainingData):
+		return c.Status(fiber.StatusConflict).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,47 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByCompanyHandler struct {
+	classifierService service.ClassifierService
+}
+
+func NewGetByCompanyHandler(classifierService service.ClassifierService) *GetByCompanyHandler {
+	return &GetByCompanyHandler{
+		classifierService: classifierService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение классификатора документов компании
+// @Description  Возвращает типы, которые различает модель компании, число обучающих документов и качество модели
+// @Tags         classifier
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} ClassifierResponse "Модель компании"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Модель еще не обучалась"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/classifier/company/{company_id} [get]
+func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	documentClassifier, err := h.classifierService.GetByCompany(c.Context(), companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewClassifierResponse(documentClassifier))
+}
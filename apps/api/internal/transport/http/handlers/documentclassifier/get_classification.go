@@ -0,0 +1,47 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetClassificationHandler struct {
+	classifierService service.ClassifierService
+}
+
+func NewGetClassificationHandler(classifierService service.ClassifierService) *GetClassificationHandler {
+	return &GetClassificationHandler{
+		classifierService: classifierService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение предсказания типа документа
+// @Description  Возвращает последнее предсказание типа документа с уверенностью по каждому типу
+// @Tags         classifier
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID документа" format:"uuid"
+// @Success      200 {object} types.DocumentClassification "Предсказание типа"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Тип документа еще не предсказывался"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/classifier/documents/{id} [get]
+func (h *GetClassificationHandler) Handle(c fiber.Ctx) error {
+	documentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	classification, err := h.classifierService.GetClassification(c.Context(), documentID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(classification)
+}
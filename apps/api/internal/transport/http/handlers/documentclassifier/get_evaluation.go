@@ -0,0 +1,51 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetEvaluationHandler struct {
+	classifierService service.ClassifierService
+}
+
+func NewGetEvaluationHandler(classifierService service.ClassifierService) *GetEvaluationHandler {
+	return &GetEvaluationHandler{
+		classifierService: classifierService,
+	}
+}
+
+// Handle godoc
+// @Summary      Качество классификатора документов
+// @Description  Возвращает результат перекрестной проверки последней обученной модели: общую точность
+// @Description  и для каждого типа долю верно определенных документов (recall), precision и F1
+// @Tags         classifier
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} ClassifierEvaluationResponse "Качество модели"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Модель еще не обучалась"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/classifier/company/{company_id}/evaluation [get]
+func (h *GetEvaluationHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	documentClassifier, err := h.classifierService.GetByCompany(c.Context(), companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(ClassifierEvaluationResponse{
+		ClassifierEvaluation: documentClassifier.Evaluation,
+		TrainedAt:            documentClassifier.TrainedAt,
+	})
+}
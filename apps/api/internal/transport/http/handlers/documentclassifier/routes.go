@@ -0,0 +1,22 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для классификации документов по типам
+func RegisterRoutes(router fiber.Router, classifierService service.ClassifierService) {
+	trainHandler := NewTrainHandler(classifierService)
+	getByCompanyHandler := NewGetByCompanyHandler(classifierService)
+	getEvaluationHandler := NewGetEvaluationHandler(classifierService)
+	classifyHandler := NewClassifyHandler(classifierService)
+	getClassificationHandler := NewGetClassificationHandler(classifierService)
+
+	router.Post("/company/:company_id/train", trainHandler.Handle)
+	router.Get("/company/:company_id", getByCompanyHandler.Handle)
+	router.Get("/company/:company_id/evaluation", getEvaluationHandler.Handle)
+	router.Get("/documents/:id", getClassificationHandler.Handle)
+	router.Post("/documents/:id/run", classifyHandler.Handle)
+}
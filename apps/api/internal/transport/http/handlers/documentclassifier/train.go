@@ -0,0 +1,49 @@
+package documentclassifier
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type TrainHandler struct {
+	classifierService service.ClassifierService
+}
+
+func NewTrainHandler(classifierService service.ClassifierService) *TrainHandler {
+	return &TrainHandler{
+		classifierService: classifierService,
+	}
+}
+
+// Handle godoc
+// @Summary      Обучение классификатора документов
+// @Description  Обучает модель компании на документах с типом и документах без типа с тегом, совпадающим по названию с типом,
+// @Description  и заменяет прежнюю модель. Для обучения нужны хотя бы два типа по 3 документа с текстом
+// @Description  Качество модели оценивается перекрестной проверкой и возвращается в evaluation
+// @Tags         classifier
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} ClassifierResponse "Модель обучена"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      409 {object} handlers.ErrorResponse "Недостаточно документов для обучения"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/classifier/company/{company_id}/train [post]
+func (h *TrainHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	documentClassifier, err := h.classifierService.Train(c.Context(), companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewClassifierResponse(documentClassifier))
+}
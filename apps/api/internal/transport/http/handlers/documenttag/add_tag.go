@@ -5,6 +5,7 @@ import (
 	"techmind/internal/transport/http/handlers"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 )
 
 type AddTagHandler struct {
@@ -20,6 +21,8 @@ func NewAddTagHandler(documentTagService service.DocumentTagService) *AddTagHand
 // Handle godoc
 // @Summary      Добавление тега к документу
 // @Description  Связывает существующий тег с документом
+// @Description  Если тег входит в исключающую группу, остальные теги этой группы у документа снимаются
+// @Description  Тег, присвоенный правилом, становится ручным, правила снова могут присваивать тег документу
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
@@ -27,10 +30,18 @@ func NewAddTagHandler(documentTagService service.DocumentTagService) *AddTagHand
 // @Param        request body AddTagRequest true "ID документа и тега"
 // @Success      200 {object} SuccessResponse "Тег успешно добавлен"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
 // @Failure      404 {object} handlers.ErrorResponse "Документ или тег не найден"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/add [post]
 func (h *AddTagHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
 	var req AddTagRequest
 	if err := c.Bind().JSON(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
@@ -38,7 +49,7 @@ func (h *AddTagHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	if err := h.documentTagService.AddTagToDocument(c.Context(), req.DocumentID, req.TagID); err != nil {
+	if err := h.documentTagService.AddTagToDocument(c.Context(), userID, req.DocumentID, req.TagID); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
 			Error: err.Error(),
 		})
@@ -0,0 +1,75 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type BulkTagHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewBulkTagHandler(documentTagService service.DocumentTagService) *BulkTagHandler {
+	return &BulkTagHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Массовое изменение тегов
+// @Description  Добавляет теги add и снимает теги remove у документов из списка document_ids
+// @Description  или у всех документов, найденных поиском search (query и filters как в сохраненном поиске)
+// @Description  За один запрос обрабатывается не больше 5000 документов
+// @Description  Снятые теги правила тегирования больше не присваивают этим документам
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body BulkTagRequest true "Документы и теги"
+// @Success      200 {object} BulkTagResponse "Итог операции"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса, теги или поиск"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/bulk [post]
+func (h *BulkTagHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req BulkTagRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	input := service.BulkTagInput{
+		UserID:      userID,
+		CompanyID:   req.CompanyID,
+		DocumentIDs: req.DocumentIDs,
+		Add:         req.Add,
+		Remove:      req.Remove,
+	}
+	if req.Search != nil {
+		search := service.NewDocumentSearchInput(req.CompanyID, req.Search.Query, req.Search.Filters, "")
+		input.Search = &search
+	}
+
+	result, err := h.documentTagService.BulkTag(c.Context(), input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(BulkTagResponse{
+		Documents: result.Documents,
+		Skipped:   result.Skipped,
+		Added:     result.Added,
+		Removed:   result.Removed,
+	})
+}
@@ -0,0 +1,48 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type CreateGroupHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewCreateGroupHandler(documentTagService service.DocumentTagService) *CreateGroupHandler {
+	return &CreateGroupHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Создание группы тегов
+// @Description  Создает группу тегов в компании. В исключающей группе (exclusive) у документа может быть
+// @Description  только один тег группы: при присвоении тега остальные теги группы у документа снимаются
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body TagGroupRequest true "Данные группы"
+// @Success      201 {object} TagGroupResponse "Группа создана"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/groups [post]
+func (h *CreateGroupHandler) Handle(c fiber.Ctx) error {
+	var req TagGroupRequest
+	if err := c.Bind().JSON(&req); err != nil || req.CompanyID == uuid.Nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	group, err := h.documentTagService.CreateTagGroup(c.Context(), req.CompanyID, req.Name, req.Exclusive)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(NewTagGroupResponse(group))
+}
@@ -19,14 +19,17 @@ func NewCreateTagHandler(documentTagService service.DocumentTagService) *CreateT
 
 // Handle godoc
 // @Summary      Создание нового тега
-// @Description  Создает новый тег в компании
+// @Description  Создает новый тег в компании. parent_id делает тег дочерним (Финансы/Счета/Оплачено),
+// @Description  group_id включает тег в группу; в исключающей группе у документа остается только один тег группы
+// @Description  Если тег с таким названием без учета регистра уже есть, возвращается существующий тег
+// @Description  Вместе с тегом создается правило тегирования по названию тега в любой форме слова, его можно изменить или отключить
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body CreateTagRequest true "Данные для создания тега"
 // @Success      201 {object} TagResponse "Тег успешно создан"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса, цвет, родитель или группа"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/tags [post]
 func (h *CreateTagHandler) Handle(c fiber.Ctx) error {
@@ -37,16 +40,10 @@ func (h *CreateTagHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	tag, err := h.documentTagService.CreateTag(c.Context(), req.CompanyID, req.Name)
+	tag, err := h.documentTagService.CreateTag(c.Context(), req.CompanyID, newTagInput(req.TagRequest))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+		return respondError(c, err)
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(TagResponse{
-		ID:        tag.ID,
-		CompanyID: tag.CompanyID,
-		Name:      tag.Name,
-	})
+	return c.Status(fiber.StatusCreated).JSON(NewTagResponse(tag))
 }
@@ -0,0 +1,48 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DeleteGroupHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewDeleteGroupHandler(documentTagService service.DocumentTagService) *DeleteGroupHandler {
+	return &DeleteGroupHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Удаление группы тегов
+// @Description  Удаляет группу, теги группы остаются без группы
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID группы" format:"uuid"
+// @Success      204 "Группа удалена"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/groups/{id} [delete]
+func (h *DeleteGroupHandler) Handle(c fiber.Ctx) error {
+	groupID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag group id format",
+		})
+	}
+
+	if err := h.documentTagService.DeleteTagGroup(c.Context(), groupID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
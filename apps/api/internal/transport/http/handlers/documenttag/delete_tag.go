@@ -21,6 +21,7 @@ func NewDeleteTagHandler(documentTagService service.DocumentTagService) *DeleteT
 // Handle godoc
 // @Summary      Удаление тега
 // @Description  Удаляет тег из системы и все его связи с документами
+// @Description  Дочерние теги переходят к родителю удаленного тега
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
@@ -1,6 +1,12 @@
 package documenttag
 
 import (
+	"time"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
 	"github.com/google/uuid"
 )
 
@@ -14,24 +20,45 @@ type AddTagRequest struct {
 type RemoveTagRequest struct {
 	DocumentID uuid.UUID `json:"document_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	TagID      uuid.UUID `json:"tag_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440001"`
+	// Suppress - запретить правилам присваивать тег, даже если сейчас его у документа нет
+	Suppress bool `json:"suppress,omitempty" example:"false"`
 }
 
 // CreateTagRequest представляет запрос на создание тега
 type CreateTagRequest struct {
 	CompanyID uuid.UUID `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Name      string    `json:"name" validate:"required,min=1" example:"Important"`
+	TagRequest
 }
 
 // UpdateTagRequest представляет запрос на обновление тега
+// Поля заменяются целиком: отсутствующие parent_id, group_id, color, icon и description очищаются
 type UpdateTagRequest struct {
-	Name string `json:"name" validate:"required,min=1" example:"Very Important"`
+	TagRequest
+}
+
+// TagRequest содержит параметры тега
+type TagRequest struct {
+	// Name - название тега без "/", вложенность задается через parent_id
+	Name string `json:"name" validate:"required,min=1" example:"Оплачено"`
+	// ParentID - родительский тег, пусто - корневой тег
+	ParentID *uuid.UUID `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	// GroupID - группа тегов
+	GroupID     *uuid.UUID `json:"group_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Color       *string    `json:"color,omitempty" example:"#2E7D32"`
+	Icon        *string    `json:"icon,omitempty" example:"receipt"`
+	Description *string    `json:"description,omitempty" example:"Оплаченные счета"`
 }
 
 // TagResponse представляет данные тега
 type TagResponse struct {
-	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	CompanyID uuid.UUID `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
-	Name      string    `json:"name" example:"Important"`
+	ID          uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID   uuid.UUID  `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	GroupID     *uuid.UUID `json:"group_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Name        string     `json:"name" example:"Important"`
+	Color       *string    `json:"color,omitempty" example:"#2E7D32"`
+	Icon        *string    `json:"icon,omitempty" example:"receipt"`
+	Description *string    `json:"description,omitempty" example:"Оплаченные счета"`
 }
 
 // TagsListResponse представляет список тегов
@@ -40,6 +67,123 @@ type TagsListResponse struct {
 	Total int           `json:"total" example:"5"`
 }
 
+// DocumentTagResponse представляет тег документа и его происхождение
+type DocumentTagResponse struct {
+	TagResponse
+	// Source - кто присвоил тег: manual, rule, ml или import
+	Source string `json:"source" example:"rule"`
+	// RuleID и RunID - правило и запуск правил, присвоившие тег
+	RuleID    *uuid.UUID `json:"rule_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440004"`
+	RunID     *uuid.UUID `json:"run_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440005"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440006"`
+	CreatedAt time.Time  `json:"created_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// DocumentTagsListResponse представляет список тегов документа
+type DocumentTagsListResponse struct {
+	Tags  []DocumentTagResponse `json:"tags"`
+	Total int                   `json:"total" example:"5"`
+}
+
+// NewDocumentTagResponse создает ответ из тега документа
+func NewDocumentTagResponse(assigned service.AssignedTag) DocumentTagResponse {
+	return DocumentTagResponse{
+		TagResponse: NewTagResponse(assigned.Tag),
+		Source:      assigned.Link.Source.String(),
+		RuleID:      assigned.Link.RuleID,
+		RunID:       assigned.Link.RunID,
+		CreatedBy:   assigned.Link.CreatedBy,
+		CreatedAt:   assigned.Link.CreatedAt,
+	}
+}
+
+// TagTreeNodeResponse представляет тег в дереве тегов
+type TagTreeNodeResponse struct {
+	TagResponse
+	// Path - путь тега от корня, например "Финансы/Счета/Оплачено"
+	Path     string                `json:"path" example:"Финансы/Счета/Оплачено"`
+	Children []TagTreeNodeResponse `json:"children"`
+}
+
+// TagTreeResponse представляет дерево тегов компании
+type TagTreeResponse struct {
+	Tags  []TagTreeNodeResponse `json:"tags"`
+	Total int                   `json:"total" example:"5"`
+}
+
+// TagGroupRequest представляет запрос на создание или обновление группы тегов
+type TagGroupRequest struct {
+	// CompanyID обязателен только при создании группы
+	CompanyID uuid.UUID `json:"company_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string    `json:"name" validate:"required,min=1" example:"Статус"`
+	// Exclusive - у документа может быть только один тег группы
+	Exclusive bool `json:"exclusive" example:"true"`
+}
+
+// TagGroupResponse представляет данные группы тегов
+type TagGroupResponse struct {
+	ID        uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	CompanyID uuid.UUID `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name      string    `json:"name" example:"Статус"`
+	Exclusive bool      `json:"exclusive" example:"true"`
+	CreatedAt time.Time `json:"created_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// TagGroupsListResponse представляет список групп тегов
+type TagGroupsListResponse struct {
+	Groups []TagGroupResponse `json:"groups"`
+	Total  int                `json:"total" example:"2"`
+}
+
+// MergeTagsRequest представляет запрос на объединение тегов
+type MergeTagsRequest struct {
+	// TargetID - тег, в который переходят документы, правила и дочерние теги
+	TargetID uuid.UUID `json:"target_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
+
+// BulkTagRequest представляет запрос на массовое изменение тегов
+// Документы задаются списком document_ids или поиском search
+type BulkTagRequest struct {
+	CompanyID   uuid.UUID          `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DocumentIDs []uuid.UUID        `json:"document_ids,omitempty"`
+	Search      *BulkSearchRequest `json:"search,omitempty"`
+	// Add - теги, которые добавляются документам, Remove - теги, которые снимаются
+	Add    []uuid.UUID `json:"add,omitempty"`
+	Remove []uuid.UUID `json:"remove,omitempty"`
+}
+
+// BulkSearchRequest задает документы массовой операции поиском, как в сохраненном поиске
+type BulkSearchRequest struct {
+	Query   string              `json:"query,omitempty" example:"tag:счет sender:Ромашка created:this_quarter"`
+	Filters types.SearchFilters `json:"filters"`
+}
+
+// BulkTagResponse представляет итог массового изменения тегов
+type BulkTagResponse struct {
+	// Documents - обработанные документы
+	Documents int `json:"documents" example:"42"`
+	// Skipped - документы не найдены или принадлежат другой компании
+	Skipped int `json:"skipped" example:"0"`
+	// Added и Removed - число добавленных и снятых тегов
+	Added   int `json:"added" example:"40"`
+	Removed int `json:"removed" example:"3"`
+}
+
+// TagUsageResponse представляет статистику использования тега
+type TagUsageResponse struct {
+	TagResponse
+	Path          string `json:"path" example:"Финансы/Счета"`
+	DocumentCount int64  `json:"document_count" example:"128"`
+	// LastUsedAt - время последнего присвоения тега документу
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" example:"2024-11-28T15:04:05Z"`
+}
+
+// TagUsageListResponse представляет статистику использования тегов компании
+type TagUsageListResponse struct {
+	Tags  []TagUsageResponse `json:"tags"`
+	Total int                `json:"total" example:"5"`
+}
+
 // SuccessResponse представляет успешный ответ
 type SuccessResponse struct {
 	Message string `json:"message" example:"tag added successfully"`
@@ -49,3 +193,53 @@ type SuccessResponse struct {
 type ErrorResponse struct {
 	Error string `json:"error" example:"tag not found"`
 }
+
+// NewTagResponse преобразует тег в DTO
+func NewTagResponse(tag *ent.Tag) TagResponse {
+	return TagResponse{
+		ID:          tag.ID,
+		CompanyID:   tag.CompanyID,
+		ParentID:    tag.ParentID,
+		GroupID:     tag.GroupID,
+		Name:        tag.Name,
+		Color:       tag.Color,
+		Icon:        tag.Icon,
+		Description: tag.Description,
+	}
+}
+
+// NewTagTreeResponse преобразует дерево тегов в DTO
+func NewTagTreeResponse(nodes []*service.TagNode) []TagTreeNodeResponse {
+	response := make([]TagTreeNodeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		response = append(response, TagTreeNodeResponse{
+			TagResponse: NewTagResponse(node.Tag),
+			Path:        node.Path,
+			Children:    NewTagTreeResponse(node.Children),
+		})
+	}
+	return response
+}
+
+// NewTagGroupResponse преобразует группу тегов в DTO
+func NewTagGroupResponse(group *ent.TagGroup) TagGroupResponse {
+	return TagGroupResponse{
+		ID:        group.ID,
+		CompanyID: group.CompanyID,
+		Name:      group.Name,
+		Exclusive: group.Exclusive,
+		CreatedAt: group.CreatedAt,
+	}
+}
+
+// newTagInput преобразует параметры тега из запроса
+func newTagInput(req TagRequest) service.TagInput {
+	return service.TagInput{
+		Name:        req.Name,
+		ParentID:    req.ParentID,
+		GroupID:     req.GroupID,
+		Color:       req.Color,
+		Icon:        req.Icon,
+		Description: req.Description,
+	}
+}
@@ -0,0 +1,25 @@
+package documenttag
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondError отвечает кодом, соответствующим ошибке сервиса тегов
+func respondError(c fiber.Ctx, err error) error {
+	var syntaxErr *service.QuerySyntaxError
+	switch {
+	case errors.Is(err, service.ErrInvalidTag), errors.Is(err, service.ErrInvalidBulkTag),
+		errors.Is(err, service.ErrTooManyDocuments), errors.As(err, &syntaxErr),
+		errors.Is(err, service.ErrInvalidSearchSort), errors.Is(err, service.ErrInvalidSearchMode),
+		errors.Is(err, service.ErrSemanticSearchDisabled):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrTagExists):
+		return c.Status(fiber.StatusConflict).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
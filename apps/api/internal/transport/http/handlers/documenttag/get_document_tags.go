@@ -21,12 +21,13 @@ func NewGetDocumentTagsHandler(documentTagService service.DocumentTagService) *G
 // Handle godoc
 // @Summary      Получение тегов документа
 // @Description  Возвращает список всех тегов конкретного документа
+// @Description  Для каждого тега указано, кто его присвоил: пользователь, правило, классификатор или импорт
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        document_id path string true "ID документа" format:"uuid"
-// @Success      200 {object} TagsListResponse "Список тегов"
+// @Success      200 {object} DocumentTagsListResponse "Список тегов"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/document/{document_id} [get]
@@ -46,17 +47,13 @@ func (h *GetDocumentTagsHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	response := TagsListResponse{
-		Tags:  make([]TagResponse, 0, len(tags)),
+	response := DocumentTagsListResponse{
+		Tags:  make([]DocumentTagResponse, 0, len(tags)),
 		Total: len(tags),
 	}
 
 	for _, tag := range tags {
-		response.Tags = append(response.Tags, TagResponse{
-			ID:        tag.ID,
-			CompanyID: tag.CompanyID,
-			Name:      tag.Name,
-		})
+		response.Tags = append(response.Tags, NewDocumentTagResponse(tag))
 	}
 
 	return c.JSON(response)
@@ -0,0 +1,57 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetGroupsByCompanyHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewGetGroupsByCompanyHandler(documentTagService service.DocumentTagService) *GetGroupsByCompanyHandler {
+	return &GetGroupsByCompanyHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение групп тегов компании
+// @Description  Возвращает группы тегов компании по названию
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} TagGroupsListResponse "Список групп"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/groups/company/{company_id} [get]
+func (h *GetGroupsByCompanyHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	groups, err := h.documentTagService.GetTagGroupsByCompany(c.Context(), companyID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	response := TagGroupsListResponse{
+		Groups: make([]TagGroupResponse, 0, len(groups)),
+		Total:  len(groups),
+	}
+	for _, group := range groups {
+		response.Groups = append(response.Groups, NewTagGroupResponse(group))
+	}
+
+	return c.JSON(response)
+}
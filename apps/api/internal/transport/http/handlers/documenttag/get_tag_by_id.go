@@ -47,9 +47,5 @@ func (h *GetTagByIDHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	return c.JSON(TagResponse{
-		ID:        tag.ID,
-		CompanyID: tag.CompanyID,
-		Name:      tag.Name,
-	})
+	return c.JSON(NewTagResponse(tag))
 }
@@ -0,0 +1,63 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetTagUsageHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewGetTagUsageHandler(documentTagService service.DocumentTagService) *GetTagUsageHandler {
+	return &GetTagUsageHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Статистика использования тегов
+// @Description  Возвращает теги компании с числом документов и временем последнего присвоения,
+// @Description  сначала самые используемые. Неиспользуемые теги идут в конце с document_count = 0
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} TagUsageListResponse "Статистика тегов"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/usage/company/{company_id} [get]
+func (h *GetTagUsageHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	usage, err := h.documentTagService.GetTagUsage(c.Context(), companyID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	response := TagUsageListResponse{
+		Tags:  make([]TagUsageResponse, 0, len(usage)),
+		Total: len(usage),
+	}
+	for _, item := range usage {
+		response.Tags = append(response.Tags, TagUsageResponse{
+			TagResponse:   NewTagResponse(item.Tag),
+			Path:          item.Path,
+			DocumentCount: item.DocumentCount,
+			LastUsedAt:    item.LastUsedAt,
+		})
+	}
+
+	return c.JSON(response)
+}
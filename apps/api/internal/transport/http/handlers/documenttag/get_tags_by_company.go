@@ -21,12 +21,15 @@ func NewGetTagsByCompanyHandler(documentTagService service.DocumentTagService) *
 // Handle godoc
 // @Summary      Получение всех тегов компании
 // @Description  Возвращает список всех тегов компании
+// @Description  С tree=true возвращает дерево: корневые теги с вложенными children и путем path
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        company_id path string true "ID компании" format:"uuid"
+// @Param        tree query bool false "Вернуть дерево тегов"
 // @Success      200 {object} TagsListResponse "Список тегов"
+// @Success      200 {object} TagTreeResponse "Дерево тегов при tree=true"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/company/{company_id} [get]
@@ -39,6 +42,20 @@ func (h *GetTagsByCompanyHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
+	if c.Query("tree") == "true" {
+		nodes, err := h.documentTagService.GetTagTree(c.Context(), companyID)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
+
+		return c.JSON(TagTreeResponse{
+			Tags:  NewTagTreeResponse(nodes),
+			Total: countNodes(nodes),
+		})
+	}
+
 	tags, err := h.documentTagService.GetTagsByCompany(c.Context(), companyID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
@@ -52,12 +69,17 @@ func (h *GetTagsByCompanyHandler) Handle(c fiber.Ctx) error {
 	}
 
 	for _, tag := range tags {
-		response.Tags = append(response.Tags, TagResponse{
-			ID:        tag.ID,
-			CompanyID: tag.CompanyID,
-			Name:      tag.Name,
-		})
+		response.Tags = append(response.Tags, NewTagResponse(tag))
 	}
 
 	return c.JSON(response)
 }
+
+// countNodes считает теги во всех уровнях дерева
+func countNodes(nodes []*service.TagNode) int {
+	count := len(nodes)
+	for _, node := range nodes {
+		count += countNodes(node.Children)
+	}
+	return count
+}
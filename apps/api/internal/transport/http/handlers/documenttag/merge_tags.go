@@ -0,0 +1,58 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type MergeTagsHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewMergeTagsHandler(documentTagService service.DocumentTagService) *MergeTagsHandler {
+	return &MergeTagsHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Объединение тегов
+// @Description  Объединяет тег с тегом target_id той же компании в одной транзакции: документы, правила тегирования
+// @Description  и дочерние теги переходят к target_id, у документов с обоими тегами остается один. Исходный тег удаляется
+// @Description  Ссылки на исходный тег в фильтрах сохраненных поисков заменяются на target_id
+// @Description  Если target_id входит в исключающую группу, у документа остается один тег группы: ручной тег не заменяется автоматическим
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID объединяемого тега" format:"uuid"
+// @Param        request body MergeTagsRequest true "Целевой тег"
+// @Success      200 {object} TagResponse "Целевой тег"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или теги нельзя объединить"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/tags/{id}/merge [post]
+func (h *MergeTagsHandler) Handle(c fiber.Ctx) error {
+	sourceID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag id format",
+		})
+	}
+
+	var req MergeTagsRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	tag, err := h.documentTagService.MergeTags(c.Context(), sourceID, req.TargetID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewTagResponse(tag))
+}
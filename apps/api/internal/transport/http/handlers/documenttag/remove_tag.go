@@ -5,6 +5,7 @@ import (
 	"techmind/internal/transport/http/handlers"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 )
 
 type RemoveTagHandler struct {
@@ -20,6 +21,8 @@ func NewRemoveTagHandler(documentTagService service.DocumentTagService) *RemoveT
 // Handle godoc
 // @Summary      Удаление тега у документа
 // @Description  Удаляет связь между тегом и документом
+// @Description  Правила тегирования больше не присваивают этот тег документу, пока его не добавят вручную
+// @Description  Если тега у документа нет, запрет создается только при suppress: true
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
@@ -27,10 +30,18 @@ func NewRemoveTagHandler(documentTagService service.DocumentTagService) *RemoveT
 // @Param        request body RemoveTagRequest true "ID документа и тега"
 // @Success      200 {object} SuccessResponse "Тег успешно удален"
 // @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
 // @Failure      404 {object} handlers.ErrorResponse "Связь не найдена"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/remove [post]
 func (h *RemoveTagHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
 	var req RemoveTagRequest
 	if err := c.Bind().JSON(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
@@ -38,7 +49,7 @@ func (h *RemoveTagHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	if err := h.documentTagService.RemoveTagFromDocument(c.Context(), req.DocumentID, req.TagID); err != nil {
+	if err := h.documentTagService.RemoveTagFromDocument(c.Context(), userID, req.DocumentID, req.TagID, req.Suppress); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
 			Error: err.Error(),
 		})
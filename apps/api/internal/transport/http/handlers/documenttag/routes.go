@@ -16,16 +16,32 @@ func RegisterRoutes(router fiber.Router, documentTagService service.DocumentTagS
 	getTagsByCompanyHandler := NewGetTagsByCompanyHandler(documentTagService)
 	getTagByIDHandler := NewGetTagByIDHandler(documentTagService)
 	updateTagHandler := NewUpdateTagHandler(documentTagService)
+	createGroupHandler := NewCreateGroupHandler(documentTagService)
+	updateGroupHandler := NewUpdateGroupHandler(documentTagService)
+	deleteGroupHandler := NewDeleteGroupHandler(documentTagService)
+	getGroupsByCompanyHandler := NewGetGroupsByCompanyHandler(documentTagService)
+	mergeTagsHandler := NewMergeTagsHandler(documentTagService)
+	bulkTagHandler := NewBulkTagHandler(documentTagService)
+	getTagUsageHandler := NewGetTagUsageHandler(documentTagService)
 
 	// Операции с тегами документов
 	router.Get("/document/:document_id", getDocumentTagsHandler.Handle)
 	router.Post("/add", addTagHandler.Handle)
 	router.Post("/remove", removeTagHandler.Handle)
+	router.Post("/bulk", bulkTagHandler.Handle)
 
 	// Управление тегами
 	router.Post("/tags", createTagHandler.Handle)
 	router.Get("/tags/:id", getTagByIDHandler.Handle)
 	router.Put("/tags/:id", updateTagHandler.Handle)
 	router.Delete("/tags/:id", deleteTagHandler.Handle)
+	router.Post("/tags/:id/merge", mergeTagsHandler.Handle)
 	router.Get("/company/:company_id", getTagsByCompanyHandler.Handle)
+	router.Get("/usage/company/:company_id", getTagUsageHandler.Handle)
+
+	// Группы тегов
+	router.Post("/groups", createGroupHandler.Handle)
+	router.Get("/groups/company/:company_id", getGroupsByCompanyHandler.Handle)
+	router.Put("/groups/:id", updateGroupHandler.Handle)
+	router.Delete("/groups/:id", deleteGroupHandler.Handle)
 }
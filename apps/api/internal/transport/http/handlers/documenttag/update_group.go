@@ -0,0 +1,56 @@
+package documenttag
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateGroupHandler struct {
+	documentTagService service.DocumentTagService
+}
+
+func NewUpdateGroupHandler(documentTagService service.DocumentTagService) *UpdateGroupHandler {
+	return &UpdateGroupHandler{
+		documentTagService: documentTagService,
+	}
+}
+
+// Handle godoc
+// @Summary      Обновление группы тегов
+// @Description  Изменяет название группы и признак exclusive
+// @Description  Включение exclusive не снимает уже присвоенные теги, ограничение действует при следующих присвоениях
+// @Tags         document-tags
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID группы" format:"uuid"
+// @Param        request body TagGroupRequest true "Данные группы"
+// @Success      200 {object} TagGroupResponse "Группа обновлена"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-tags/groups/{id} [put]
+func (h *UpdateGroupHandler) Handle(c fiber.Ctx) error {
+	groupID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag group id format",
+		})
+	}
+
+	var req TagGroupRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	group, err := h.documentTagService.UpdateTagGroup(c.Context(), groupID, req.Name, req.Exclusive)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewTagGroupResponse(group))
+}
@@ -20,16 +20,19 @@ func NewUpdateTagHandler(documentTagService service.DocumentTagService) *UpdateT
 
 // Handle godoc
 // @Summary      Обновление тега
-// @Description  Изменяет название тега
+// @Description  Заменяет название, родителя, группу, цвет, иконку и описание тега
+// @Description  Тег нельзя сделать дочерним для самого себя или своих потомков
+// @Description  Название уникально в компании без учета регистра
 // @Tags         document-tags
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        id path string true "ID тега" format:"uuid"
-// @Param        request body UpdateTagRequest true "Новое название"
+// @Param        request body UpdateTagRequest true "Новые параметры тега"
 // @Success      200 {object} TagResponse "Тег успешно обновлен"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса, цвет, родитель или группа"
 // @Failure      404 {object} handlers.ErrorResponse "Тег не найден"
+// @Failure      409 {object} handlers.ErrorResponse "Тег с таким названием уже есть"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/document-tags/tags/{id} [put]
 func (h *UpdateTagHandler) Handle(c fiber.Ctx) error {
@@ -48,16 +51,10 @@ func (h *UpdateTagHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	tag, err := h.documentTagService.UpdateTag(c.Context(), tagID, req.Name)
+	tag, err := h.documentTagService.UpdateTag(c.Context(), tagID, newTagInput(req.TagRequest))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+		return respondError(c, err)
 	}
 
-	return c.JSON(TagResponse{
-		ID:        tag.ID,
-		CompanyID: tag.CompanyID,
-		Name:      tag.Name,
-	})
+	return c.JSON(NewTagResponse(tag))
 }
@@ -0,0 +1,49 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type CreateHandler struct {
+	documentTypeService service.DocumentTypeService
+}
+
+func NewCreateHandler(documentTypeService service.DocumentTypeService) *CreateHandler {
+	return &CreateHandler{
+		documentTypeService: documentTypeService,
+	}
+}
+
+// Handle godoc
+// @Summary      Создание типа документа
+// @Description  Создает тип документа компании со схемой полей, например счет с номером, суммой и сроком оплаты
+// @Description  Типы полей: string, number, date, enum (варианты в options), money (валюта по умолчанию в currency)
+// @Description  и sender - ссылка на контрагента компании
+// @Description  Шаблон extraction задает, где искать значения полей в тексте документа; значения с уверенностью ниже review_threshold ждут проверки
+// @Tags         document-types
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body CreateDocumentTypeRequest true "Данные типа документа"
+// @Success      201 {object} DocumentTypeResponse "Тип документа создан"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или схема полей"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-types [post]
+func (h *CreateHandler) Handle(c fiber.Ctx) error {
+	var req CreateDocumentTypeRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	documentType, err := h.documentTypeService.Create(c.Context(), req.CompanyID, newDocumentTypeInput(req.DocumentTypeRequest))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(NewDocumentTypeResponse(documentType))
+}
@@ -0,0 +1,47 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DeleteHandler struct {
+	documentTypeService service.DocumentTypeService
+}
+
+func NewDeleteHandler(documentTypeService service.DocumentTypeService) *DeleteHandler {
+	return &DeleteHandler{
+		documentTypeService: documentTypeService,
+	}
+}
+
+// Handle godoc
+// @Summary      Удаление типа документа
+// @Description  Удаляет тип документа, документы этого типа остаются без типа и значений полей
+// @Tags         document-types
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID типа документа" format:"uuid"
+// @Success      204 "Тип документа удален"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Тип документа не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-types/{id} [delete]
+func (h *DeleteHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document type id format",
+		})
+	}
+
+	if err := h.documentTypeService.Delete(c.Context(), id); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
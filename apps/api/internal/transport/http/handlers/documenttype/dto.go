@@ -0,0 +1,78 @@
+package documenttype
+
+import (
+	"time"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// DocumentTypeRequest представляет тип документа со схемой полей
+type DocumentTypeRequest struct {
+	Name        string  `json:"name" validate:"required,min=1" example:"Счет"`
+	Description *string `json:"description,omitempty" example:"Счета на оплату от поставщиков"`
+	// Fields - поля типа: key - латиница, цифры и _, type - string, number, date, enum, money или sender
+	Fields []types.DocumentField `json:"fields"`
+	// Extraction - шаблон извлечения значений полей из текста документа: regex с группами по полям
+	// и макросами (date), (amount), (number) или anchor - текст перед значением одного поля
+	Extraction *types.ExtractionTemplate `json:"extraction,omitempty"`
+}
+
+// CreateDocumentTypeRequest представляет запрос на создание типа документа
+type CreateDocumentTypeRequest struct {
+	CompanyID uuid.UUID `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DocumentTypeRequest
+}
+
+// DocumentTypeResponse представляет данные типа документа
+type DocumentTypeResponse struct {
+	ID          uuid.UUID                 `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID   uuid.UUID                 `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name        string                    `json:"name" example:"Счет"`
+	Description *string                   `json:"description,omitempty" example:"Счета на оплату от поставщиков"`
+	Fields      []types.DocumentField     `json:"fields"`
+	Extraction  *types.ExtractionTemplate `json:"extraction,omitempty"`
+	CreatedAt   time.Time                 `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt   time.Time                 `json:"updated_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// DocumentTypesListResponse представляет список типов документов
+type DocumentTypesListResponse struct {
+	DocumentTypes []DocumentTypeResponse `json:"document_types"`
+	Total         int                    `json:"total" example:"3"`
+}
+
+// newDocumentTypeInput преобразует запрос в параметры сервиса
+func newDocumentTypeInput(req DocumentTypeRequest) service.DocumentTypeInput {
+	return service.DocumentTypeInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Fields:      req.Fields,
+		Extraction:  req.Extraction,
+	}
+}
+
+// NewDocumentTypeResponse преобразует тип документа в DTO
+func NewDocumentTypeResponse(documentType *ent.DocumentType) DocumentTypeResponse {
+	fields := documentType.Fields
+	if fields == nil {
+		fields = []types.DocumentField{}
+	}
+	var extraction *types.ExtractionTemplate
+	if len(documentType.Extraction.Locators) > 0 {
+		extraction = &documentType.Extraction
+	}
+	return DocumentTypeResponse{
+		ID:          documentType.ID,
+		CompanyID:   documentType.CompanyID,
+		Name:        documentType.Name,
+		Description: documentType.Description,
+		Fields:      fields,
+		Extraction:  extraction,
+		CreatedAt:   documentType.CreatedAt,
+		UpdatedAt:   documentType.UpdatedAt,
+	}
+}
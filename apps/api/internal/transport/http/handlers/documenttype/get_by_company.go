@@ -0,0 +1,55 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByCompanyHandler struct {
+	documentTypeService service.DocumentTypeService
+}
+
+func NewGetByCompanyHandler(documentTypeService service.DocumentTypeService) *GetByCompanyHandler {
+	return &GetByCompanyHandler{
+		documentTypeService: documentTypeService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение типов документов компании
+// @Description  Возвращает типы документов компании по алфавиту
+// @Tags         document-types
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} DocumentTypesListResponse "Список типов документов"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-types/company/{company_id} [get]
+func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	documentTypes, err := h.documentTypeService.GetByCompany(c.Context(), companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	response := DocumentTypesListResponse{
+		DocumentTypes: make([]DocumentTypeResponse, 0, len(documentTypes)),
+		Total:         len(documentTypes),
+	}
+	for _, documentType := range documentTypes {
+		response.DocumentTypes = append(response.DocumentTypes, NewDocumentTypeResponse(documentType))
+	}
+
+	return c.JSON(response)
+}
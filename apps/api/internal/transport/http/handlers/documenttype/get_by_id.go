@@ -0,0 +1,48 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByIDHandler struct {
+	documentTypeService service.DocumentTypeService
+}
+
+func NewGetByIDHandler(documentTypeService service.DocumentTypeService) *GetByIDHandler {
+	return &GetByIDHandler{
+		documentTypeService: documentTypeService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение типа документа
+// @Description  Возвращает тип документа со схемой полей
+// @Tags         document-types
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID типа документа" format:"uuid"
+// @Success      200 {object} DocumentTypeResponse "Тип документа"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Тип документа не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-types/{id} [get]
+func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document type id format",
+		})
+	}
+
+	documentType, err := h.documentTypeService.GetByID(c.Context(), id)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewDocumentTypeResponse(documentType))
+}
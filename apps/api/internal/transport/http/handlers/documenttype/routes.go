@@ -0,0 +1,22 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для работы с типами документов
+func RegisterRoutes(router fiber.Router, documentTypeService service.DocumentTypeService) {
+	createHandler := NewCreateHandler(documentTypeService)
+	getByIDHandler := NewGetByIDHandler(documentTypeService)
+	getByCompanyHandler := NewGetByCompanyHandler(documentTypeService)
+	updateHandler := NewUpdateHandler(documentTypeService)
+	deleteHandler := NewDeleteHandler(documentTypeService)
+
+	router.Post("/", createHandler.Handle)
+	router.Get("/company/:company_id", getByCompanyHandler.Handle)
+	router.Get("/:id", getByIDHandler.Handle)
+	router.Put("/:id", updateHandler.Handle)
+	router.Delete("/:id", deleteHandler.Handle)
+}
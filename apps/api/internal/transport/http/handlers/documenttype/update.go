@@ -0,0 +1,58 @@
+package documenttype
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateHandler struct {
+	documentTypeService service.DocumentTypeService
+}
+
+func NewUpdateHandler(documentTypeService service.DocumentTypeService) *UpdateHandler {
+	return &UpdateHandler{
+		documentTypeService: documentTypeService,
+	}
+}
+
+// Handle godoc
+// @Summary      Обновление типа документа
+// @Description  Заменяет название, описание, схему полей и шаблон извлечения типа документа
+// @Description  Сохраненные значения полей документов не меняются и проверяются по новой схеме при следующем изменении документа
+// @Description  Шаблон extraction задает, где искать значения полей в тексте документа; значения с уверенностью ниже review_threshold ждут проверки
+// @Tags         document-types
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID типа документа" format:"uuid"
+// @Param        request body DocumentTypeRequest true "Данные типа документа"
+// @Success      200 {object} DocumentTypeResponse "Тип документа обновлен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или схема полей"
+// @Failure      404 {object} handlers.ErrorResponse "Тип документа не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/document-types/{id} [put]
+func (h *UpdateHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document type id format",
+		})
+	}
+
+	var req DocumentTypeRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	documentType, err := h.documentTypeService.Update(c.Context(), id, newDocumentTypeInput(req))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewDocumentTypeResponse(documentType))
+}
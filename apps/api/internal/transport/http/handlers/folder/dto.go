@@ -1,6 +1,10 @@
 package folder
 
-import "github.com/google/uuid"
+import (
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
 
 // CreateRequest представляет запрос на создание папки
 type CreateRequest struct {
@@ -30,8 +34,39 @@ type GetByParentRequest struct {
 	ParentID  *uuid.UUID `json:"parent_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
 }
 
+// SmartFolderResponse представляет умную папку - закрепленный сохраненный поиск
+// Содержимое умной папки возвращает /private/saved-searches/{id}/run
+type SmartFolderResponse struct {
+	ID             uuid.UUID  `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID      uuid.UUID  `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	ParentFolderID *uuid.UUID `json:"parent_folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Name           string     `json:"name" example:"Неоплаченные счета"`
+	Query          string     `json:"query" example:"tag:счет -tag:оплачен created:this_quarter"`
+	UserID         uuid.UUID  `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Shared         bool       `json:"shared" example:"true"`
+}
+
 // FoldersListResponse представляет список папок
 type FoldersListResponse struct {
 	Folders []FolderResponse `json:"folders"`
 	Total   int              `json:"total" example:"10"`
+	// SmartFolders - умные папки на том же уровне дерева, не входят в total
+	SmartFolders []SmartFolderResponse `json:"smart_folders"`
+}
+
+// newSmartFolderResponses преобразует закрепленные поиски в умные папки
+func newSmartFolderResponses(savedSearches []*ent.SavedSearch) []SmartFolderResponse {
+	smartFolders := make([]SmartFolderResponse, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		smartFolders = append(smartFolders, SmartFolderResponse{
+			ID:             savedSearch.ID,
+			CompanyID:      savedSearch.CompanyID,
+			ParentFolderID: savedSearch.ParentFolderID,
+			Name:           savedSearch.Name,
+			Query:          savedSearch.Query,
+			UserID:         savedSearch.UserID,
+			Shared:         savedSearch.Shared,
+		})
+	}
+	return smartFolders
 }
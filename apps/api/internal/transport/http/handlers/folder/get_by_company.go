@@ -9,18 +9,21 @@ import (
 )
 
 type GetByCompanyHandler struct {
-	folderService service.FolderService
+	folderService      service.FolderService
+	savedSearchService service.SavedSearchService
 }
 
-func NewGetByCompanyHandler(folderService service.FolderService) *GetByCompanyHandler {
+func NewGetByCompanyHandler(folderService service.FolderService, savedSearchService service.SavedSearchService) *GetByCompanyHandler {
 	return &GetByCompanyHandler{
-		folderService: folderService,
+		folderService:      folderService,
+		savedSearchService: savedSearchService,
 	}
 }
 
 // Handle godoc
 // @Summary      Получение всех папок компании
 // @Description  Возвращает список всех папок компании без учета иерархии
+// @Description  smart_folders содержит все умные папки (закрепленные сохраненные поиски), видимые пользователю
 // @Tags         folders
 // @Accept       json
 // @Produce      json
@@ -47,8 +50,9 @@ func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
 	}
 
 	response := FoldersListResponse{
-		Folders: make([]FolderResponse, 0, len(folders)),
-		Total:   len(folders),
+		Folders:      make([]FolderResponse, 0, len(folders)),
+		Total:        len(folders),
+		SmartFolders: []SmartFolderResponse{},
 	}
 
 	for _, folder := range folders {
@@ -62,5 +66,16 @@ func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
+	// Умные папки видны владельцу и, если поиск опубликован, всей компании
+	if userID, ok := c.Locals("user_id").(uuid.UUID); ok {
+		smartFolders, err := h.savedSearchService.GetSmartFolders(c.Context(), userID, companyID, nil, true)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
+		response.SmartFolders = newSmartFolderResponses(smartFolders)
+	}
+
 	return c.JSON(response)
 }
@@ -5,21 +5,25 @@ import (
 	"techmind/internal/transport/http/handlers"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 )
 
 type GetByParentHandler struct {
-	folderService service.FolderService
+	folderService      service.FolderService
+	savedSearchService service.SavedSearchService
 }
 
-func NewGetByParentHandler(folderService service.FolderService) *GetByParentHandler {
+func NewGetByParentHandler(folderService service.FolderService, savedSearchService service.SavedSearchService) *GetByParentHandler {
 	return &GetByParentHandler{
-		folderService: folderService,
+		folderService:      folderService,
+		savedSearchService: savedSearchService,
 	}
 }
 
 // Handle godoc
 // @Summary      Получение вложенных папок
 // @Description  Возвращает список папок по родительской папке. Если parent_id не указан, возвращает корневые папки
+// @Description  smart_folders содержит умные папки (закрепленные сохраненные поиски) того же уровня
 // @Tags         folders
 // @Accept       json
 // @Produce      json
@@ -45,8 +49,9 @@ func (h *GetByParentHandler) Handle(c fiber.Ctx) error {
 	}
 
 	response := FoldersListResponse{
-		Folders: make([]FolderResponse, 0, len(folders)),
-		Total:   len(folders),
+		Folders:      make([]FolderResponse, 0, len(folders)),
+		Total:        len(folders),
+		SmartFolders: []SmartFolderResponse{},
 	}
 
 	for _, folder := range folders {
@@ -60,5 +65,16 @@ func (h *GetByParentHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
+	// Умные папки видны владельцу и, если поиск опубликован, всей компании
+	if userID, ok := c.Locals("user_id").(uuid.UUID); ok {
+		smartFolders, err := h.savedSearchService.GetSmartFolders(c.Context(), userID, req.CompanyID, req.ParentID, false)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+				Error: err.Error(),
+			})
+		}
+		response.SmartFolders = newSmartFolderResponses(smartFolders)
+	}
+
 	return c.JSON(response)
 }
@@ -7,13 +7,13 @@ import (
 )
 
 // RegisterRoutes регистрирует маршруты для работы с папками
-func RegisterRoutes(router fiber.Router, folderService service.FolderService) {
+func RegisterRoutes(router fiber.Router, folderService service.FolderService, savedSearchService service.SavedSearchService) {
 	createHandler := NewCreateHandler(folderService)
 	deleteHandler := NewDeleteHandler(folderService)
 	renameHandler := NewRenameHandler(folderService)
 	getByIDHandler := NewGetByIDHandler(folderService)
-	getByCompanyHandler := NewGetByCompanyHandler(folderService)
-	getByParentHandler := NewGetByParentHandler(folderService)
+	getByCompanyHandler := NewGetByCompanyHandler(folderService, savedSearchService)
+	getByParentHandler := NewGetByParentHandler(folderService, savedSearchService)
 
 	router.Post("/", createHandler.Handle)
 	router.Get("/:id", getByIDHandler.Handle)
@@ -0,0 +1,51 @@
+package job
+
+import (
+	"time"
+
+	"techmind/schema/ent"
+
+	"github.com/google/uuid"
+)
+
+// JobResponse представляет данные фоновой задачи
+type JobResponse struct {
+	ID                uuid.UUID              `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID         *uuid.UUID             `json:"company_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Type              string                 `json:"type" example:"pdf_merge"`
+	Status            string                 `json:"status" example:"running"`
+	Payload           map[string]interface{} `json:"payload,omitempty"`
+	ResultDocumentIDs []uuid.UUID            `json:"result_document_ids,omitempty"`
+	Error             *string                `json:"error,omitempty" example:"failed to merge documents"`
+	Progress          int                    `json:"progress" example:"1"`
+	Total             int                    `json:"total" example:"3"`
+	CreatedBy         *uuid.UUID             `json:"created_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440002"`
+	CreatedAt         time.Time              `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt         time.Time              `json:"updated_at" example:"2024-11-28T15:04:05Z"`
+	FinishedAt        *time.Time             `json:"finished_at,omitempty" example:"2024-11-28T15:05:05Z"`
+}
+
+// JobsListResponse представляет список фоновых задач
+type JobsListResponse struct {
+	Jobs  []JobResponse `json:"jobs"`
+	Total int           `json:"total" example:"10"`
+}
+
+// NewJobResponse преобразует задачу в DTO
+func NewJobResponse(job *ent.Job) JobResponse {
+	return JobResponse{
+		ID:                job.ID,
+		CompanyID:         job.CompanyID,
+		Type:              job.Type,
+		Status:            job.Status.String(),
+		Payload:           job.Payload,
+		ResultDocumentIDs: job.ResultDocumentIds,
+		Error:             job.Error,
+		Progress:          job.Progress,
+		Total:             job.Total,
+		CreatedBy:         job.CreatedBy,
+		CreatedAt:         job.CreatedAt,
+		UpdatedAt:         job.UpdatedAt,
+		FinishedAt:        job.FinishedAt,
+	}
+}
@@ -0,0 +1,58 @@
+package job
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByCompanyHandler struct {
+	jobService service.JobService
+}
+
+func NewGetByCompanyHandler(jobService service.JobService) *GetByCompanyHandler {
+	return &GetByCompanyHandler{
+		jobService: jobService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение фоновых задач компании
+// @Description  Возвращает список фоновых задач компании, начиная с последних
+// @Tags         jobs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} JobsListResponse "Список задач"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/jobs/company/{company_id} [get]
+func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
+	companyIDParam := c.Params("company_id")
+	companyID, err := uuid.Parse(companyIDParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	jobs, err := h.jobService.GetByCompany(c.Context(), companyID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	response := JobsListResponse{
+		Jobs:  make([]JobResponse, 0, len(jobs)),
+		Total: len(jobs),
+	}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, NewJobResponse(job))
+	}
+
+	return c.JSON(response)
+}
@@ -0,0 +1,50 @@
+package job
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByIDHandler struct {
+	jobService service.JobService
+}
+
+func NewGetByIDHandler(jobService service.JobService) *GetByIDHandler {
+	return &GetByIDHandler{
+		jobService: jobService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение фоновой задачи по ID
+// @Description  Возвращает статус, прогресс и результат фоновой задачи
+// @Tags         jobs
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID задачи" format:"uuid"
+// @Success      200 {object} JobResponse "Данные задачи"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      404 {object} handlers.ErrorResponse "Задача не найдена"
+// @Router       /private/jobs/{id} [get]
+func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
+	idParam := c.Params("id")
+	jobID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid job id format",
+		})
+	}
+
+	job, err := h.jobService.GetByID(c.Context(), jobID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{
+			Error: "job not found",
+		})
+	}
+
+	return c.JSON(NewJobResponse(job))
+}
@@ -0,0 +1,16 @@
+package job
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для работы с фоновыми задачами
+func RegisterRoutes(router fiber.Router, jobService service.JobService) {
+	getByIDHandler := NewGetByIDHandler(jobService)
+	getByCompanyHandler := NewGetByCompanyHandler(jobService)
+
+	router.Get("/:id", getByIDHandler.Handle)
+	router.Get("/company/:company_id", getByCompanyHandler.Handle)
+}
@@ -0,0 +1,31 @@
+package pdf
+
+import "github.com/google/uuid"
+
+// MergeRequest представляет запрос на объединение документов в один PDF
+type MergeRequest struct {
+	DocumentIDs []uuid.UUID `json:"document_ids" validate:"required,min=2"`
+	FolderID    *uuid.UUID  `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name        string      `json:"name,omitempty" example:"merged.pdf"`
+}
+
+// SplitRequest представляет запрос на разделение PDF по диапазонам страниц
+type SplitRequest struct {
+	DocumentID uuid.UUID  `json:"document_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Ranges     []string   `json:"ranges" validate:"required,min=1" example:"1-3,4,5-10"`
+	FolderID   *uuid.UUID `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
+
+// FlattenRequest представляет запрос на сглаживание форм в PDF
+type FlattenRequest struct {
+	DocumentIDs []uuid.UUID `json:"document_ids" validate:"required,min=1"`
+	FolderID    *uuid.UUID  `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// EncryptRequest представляет запрос на создание защищенной паролем копии PDF
+type EncryptRequest struct {
+	DocumentID    uuid.UUID  `json:"document_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserPassword  string     `json:"user_password" validate:"required" example:"secret"`
+	OwnerPassword string     `json:"owner_password,omitempty" example:"owner-secret"`
+	FolderID      *uuid.UUID `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
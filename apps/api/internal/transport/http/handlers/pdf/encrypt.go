@@ -0,0 +1,62 @@
+package pdf
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type EncryptHandler struct {
+	pdfService service.PDFService
+}
+
+func NewEncryptHandler(pdfService service.PDFService) *EncryptHandler {
+	return &EncryptHandler{
+		pdfService: pdfService,
+	}
+}
+
+// Handle godoc
+// @Summary      Защита PDF паролем
+// @Description  Создает защищенную паролем копию документа
+// @Tags         pdf
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body EncryptRequest true "Параметры операции"
+// @Success      202 {object} job.JobResponse "Задача поставлена в очередь"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Неавторизированный доступ"
+// @Router       /private/pdf/encrypt [post]
+func (h *EncryptHandler) Handle(c fiber.Ctx) error {
+	userID, err := handlers.GetUserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	var req EncryptRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	pdfJob, err := h.pdfService.Encrypt(c.Context(), service.PDFEncryptInput{
+		DocumentID:    req.DocumentID,
+		UserPassword:  req.UserPassword,
+		OwnerPassword: req.OwnerPassword,
+		FolderID:      req.FolderID,
+		UserID:        userID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(pdfJob))
+}
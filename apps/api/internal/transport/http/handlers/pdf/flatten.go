@@ -0,0 +1,60 @@
+package pdf
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type FlattenHandler struct {
+	pdfService service.PDFService
+}
+
+func NewFlattenHandler(pdfService service.PDFService) *FlattenHandler {
+	return &FlattenHandler{
+		pdfService: pdfService,
+	}
+}
+
+// Handle godoc
+// @Summary      Сглаживание форм PDF
+// @Description  Создает копии документов с сглаженными полями форм
+// @Tags         pdf
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body FlattenRequest true "Параметры операции"
+// @Success      202 {object} job.JobResponse "Задача поставлена в очередь"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Неавторизированный доступ"
+// @Router       /private/pdf/flatten [post]
+func (h *FlattenHandler) Handle(c fiber.Ctx) error {
+	userID, err := handlers.GetUserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	var req FlattenRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	pdfJob, err := h.pdfService.Flatten(c.Context(), service.PDFFlattenInput{
+		DocumentIDs: req.DocumentIDs,
+		FolderID:    req.FolderID,
+		UserID:      userID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(pdfJob))
+}
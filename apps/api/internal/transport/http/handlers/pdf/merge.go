@@ -0,0 +1,61 @@
+package pdf
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type MergeHandler struct {
+	pdfService service.PDFService
+}
+
+func NewMergeHandler(pdfService service.PDFService) *MergeHandler {
+	return &MergeHandler{
+		pdfService: pdfService,
+	}
+}
+
+// Handle godoc
+// @Summary      Объединение документов в PDF
+// @Description  Объединяет документы в новый PDF документ. Не-PDF документы предварительно конвертируются
+// @Tags         pdf
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body MergeRequest true "Параметры операции"
+// @Success      202 {object} job.JobResponse "Задача поставлена в очередь"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Неавторизированный доступ"
+// @Router       /private/pdf/merge [post]
+func (h *MergeHandler) Handle(c fiber.Ctx) error {
+	userID, err := handlers.GetUserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	var req MergeRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	pdfJob, err := h.pdfService.Merge(c.Context(), service.PDFMergeInput{
+		DocumentIDs: req.DocumentIDs,
+		FolderID:    req.FolderID,
+		Name:        req.Name,
+		UserID:      userID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(pdfJob))
+}
@@ -0,0 +1,20 @@
+package pdf
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для операций над PDF документами
+func RegisterRoutes(router fiber.Router, pdfService service.PDFService) {
+	mergeHandler := NewMergeHandler(pdfService)
+	splitHandler := NewSplitHandler(pdfService)
+	flattenHandler := NewFlattenHandler(pdfService)
+	encryptHandler := NewEncryptHandler(pdfService)
+
+	router.Post("/merge", mergeHandler.Handle)
+	router.Post("/split", splitHandler.Handle)
+	router.Post("/flatten", flattenHandler.Handle)
+	router.Post("/encrypt", encryptHandler.Handle)
+}
@@ -0,0 +1,61 @@
+package pdf
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type SplitHandler struct {
+	pdfService service.PDFService
+}
+
+func NewSplitHandler(pdfService service.PDFService) *SplitHandler {
+	return &SplitHandler{
+		pdfService: pdfService,
+	}
+}
+
+// Handle godoc
+// @Summary      Разделение PDF по страницам
+// @Description  Создает новые документы из указанных диапазонов страниц
+// @Tags         pdf
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body SplitRequest true "Параметры операции"
+// @Success      202 {object} job.JobResponse "Задача поставлена в очередь"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Неавторизированный доступ"
+// @Router       /private/pdf/split [post]
+func (h *SplitHandler) Handle(c fiber.Ctx) error {
+	userID, err := handlers.GetUserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	var req SplitRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	pdfJob, err := h.pdfService.Split(c.Context(), service.PDFSplitInput{
+		DocumentID: req.DocumentID,
+		Ranges:     req.Ranges,
+		FolderID:   req.FolderID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(pdfJob))
+}
@@ -0,0 +1,69 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type CreateHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewCreateHandler(savedSearchService service.SavedSearchService) *CreateHandler {
+	return &CreateHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Сохранение поиска
+// @Description  Сохраняет запрос, фильтры и сортировку поиска документов под именем
+// @Description  Для скользящих периодов используйте запрос, например created:this_quarter
+// @Description  shared сразу делает поиск доступным всем пользователям компании
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body CreateSavedSearchRequest true "Параметры поиска"
+// @Success      201 {object} SavedSearchResponse "Поиск сохранен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches [post]
+func (h *CreateHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req CreateSavedSearchRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	if req.Name == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "name is required",
+		})
+	}
+
+	savedSearch, err := h.savedSearchService.Create(c.Context(), userID, req.CompanyID, service.SavedSearchInput{
+		Name:    req.Name,
+		Query:   req.Query,
+		Filters: req.Filters,
+		Sort:    req.Sort,
+	}, req.Shared)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(NewSavedSearchResponse(savedSearch))
+}
@@ -0,0 +1,57 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DeleteHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewDeleteHandler(savedSearchService service.SavedSearchService) *DeleteHandler {
+	return &DeleteHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Удаление сохраненного поиска
+// @Description  Удаляет сохраненный поиск и умную папку, если он был закреплен. Удалить поиск может только владелец
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Success      204 "Поиск удален"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не владелец поиска"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/{id} [delete]
+func (h *DeleteHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	if err := h.savedSearchService.Delete(c.Context(), userID, savedSearchID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package savedsearch
+
+import (
+	"time"
+
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// CreateSavedSearchRequest представляет запрос на сохранение поиска
+type CreateSavedSearchRequest struct {
+	CompanyID uuid.UUID `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string    `json:"name" validate:"required,min=1" example:"Неоплаченные счета Ромашки за квартал"`
+	// Query - поисковый запрос в синтаксисе поиска документов
+	Query   string              `json:"query,omitempty" example:"tag:счет -tag:оплачен sender:Ромашка created:this_quarter"`
+	Filters types.SearchFilters `json:"filters"`
+	// Sort - relevance, created_desc, created_asc, updated_desc, updated_asc, size_desc или size_asc
+	Sort   string `json:"sort,omitempty" example:"created_desc"`
+	Shared bool   `json:"shared,omitempty" example:"false"`
+}
+
+// UpdateSavedSearchRequest представляет запрос на изменение сохраненного поиска
+type UpdateSavedSearchRequest struct {
+	Name    string              `json:"name" validate:"required,min=1" example:"Неоплаченные счета"`
+	Query   string              `json:"query,omitempty" example:"tag:счет -tag:оплачен"`
+	Filters types.SearchFilters `json:"filters"`
+	Sort    string              `json:"sort,omitempty" example:"created_desc"`
+}
+
+// ShareRequest представляет запрос на публикацию поиска для компании
+type ShareRequest struct {
+	Shared bool `json:"shared" example:"true"`
+}
+
+// PinRequest представляет запрос на закрепление поиска в дереве папок
+type PinRequest struct {
+	Pinned bool `json:"pinned" example:"true"`
+	// ParentFolderID - папка, в которой показывается умная папка, без нее - в корне
+	ParentFolderID *uuid.UUID `json:"parent_folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+}
+
+// SavedSearchResponse представляет данные сохраненного поиска
+type SavedSearchResponse struct {
+	ID             uuid.UUID           `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID      uuid.UUID           `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	UserID         uuid.UUID           `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Name           string              `json:"name" example:"Неоплаченные счета Ромашки за квартал"`
+	Query          string              `json:"query" example:"tag:счет -tag:оплачен sender:Ромашка created:this_quarter"`
+	Filters        types.SearchFilters `json:"filters"`
+	Sort           string              `json:"sort" example:"created_desc"`
+	Shared         bool                `json:"shared" example:"false"`
+	Pinned         bool                `json:"pinned" example:"true"`
+	ParentFolderID *uuid.UUID          `json:"parent_folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440003"`
+	CreatedAt      time.Time           `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt      time.Time           `json:"updated_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// SavedSearchesListResponse представляет список сохраненных поисков
+type SavedSearchesListResponse struct {
+	SavedSearches []SavedSearchResponse `json:"saved_searches"`
+	Total         int                   `json:"total" example:"3"`
+}
+
+// NewSavedSearchResponse преобразует сохраненный поиск в DTO
+func NewSavedSearchResponse(savedSearch *ent.SavedSearch) SavedSearchResponse {
+	return SavedSearchResponse{
+		ID:             savedSearch.ID,
+		CompanyID:      savedSearch.CompanyID,
+		UserID:         savedSearch.UserID,
+		Name:           savedSearch.Name,
+		Query:          savedSearch.Query,
+		Filters:        savedSearch.Filters,
+		Sort:           savedSearch.Sort,
+		Shared:         savedSearch.Shared,
+		Pinned:         savedSearch.Pinned,
+		ParentFolderID: savedSearch.ParentFolderID,
+		CreatedAt:      savedSearch.CreatedAt,
+		UpdatedAt:      savedSearch.UpdatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package savedsearch
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/document"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondError отвечает кодом, соответствующим ошибке сервиса сохраненных поисков
+func respondError(c fiber.Ctx, err error) error {
+	var syntaxErr *service.QuerySyntaxError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return c.Status(fiber.StatusBadRequest).JSON(document.QueryErrorResponse{
+			Error:    syntaxErr.Error(),
+			Position: syntaxErr.Position,
+		})
+	case errors.Is(err, service.ErrInvalidSearchSort):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrSavedSearchNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrSavedSearchForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
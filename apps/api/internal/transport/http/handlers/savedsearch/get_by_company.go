@@ -0,0 +1,65 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByCompanyHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewGetByCompanyHandler(savedSearchService service.SavedSearchService) *GetByCompanyHandler {
+	return &GetByCompanyHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение сохраненных поисков компании
+// @Description  Возвращает свои сохраненные поиски пользователя и поиски, опубликованные для компании
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} SavedSearchesListResponse "Список сохраненных поисков"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/company/{company_id} [get]
+func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	companyIDParam := c.Params("company_id")
+	companyID, err := uuid.Parse(companyIDParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	savedSearches, err := h.savedSearchService.List(c.Context(), userID, companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	response := SavedSearchesListResponse{
+		SavedSearches: make([]SavedSearchResponse, 0, len(savedSearches)),
+		Total:         len(savedSearches),
+	}
+	for _, savedSearch := range savedSearches {
+		response.SavedSearches = append(response.SavedSearches, NewSavedSearchResponse(savedSearch))
+	}
+
+	return c.JSON(response)
+}
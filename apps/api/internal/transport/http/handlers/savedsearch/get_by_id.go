@@ -0,0 +1,56 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByIDHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewGetByIDHandler(savedSearchService service.SavedSearchService) *GetByIDHandler {
+	return &GetByIDHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение сохраненного поиска по ID
+// @Description  Возвращает свой или опубликованный для компании сохраненный поиск
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Success      200 {object} SavedSearchResponse "Сохраненный поиск"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Router       /private/saved-searches/{id} [get]
+func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	savedSearch, err := h.savedSearchService.GetByID(c.Context(), userID, savedSearchID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSavedSearchResponse(savedSearch))
+}
@@ -0,0 +1,67 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type PinHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewPinHandler(savedSearchService service.SavedSearchService) *PinHandler {
+	return &PinHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Закрепление поиска как умной папки
+// @Description  Закрепляет сохраненный поиск в дереве папок внутри parent_folder_id (без него - в корне)
+// @Description  Умная папка возвращается в smart_folders списков папок, ее содержимое - результаты /run
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Param        request body PinRequest true "Закрепление"
+// @Success      200 {object} SavedSearchResponse "Поиск изменен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не владелец поиска"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/{id}/pin [put]
+func (h *PinHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	var req PinRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	savedSearch, err := h.savedSearchService.Pin(c.Context(), userID, savedSearchID, req.Pinned, req.ParentFolderID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSavedSearchResponse(savedSearch))
+}
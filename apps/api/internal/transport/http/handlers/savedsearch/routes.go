@@ -0,0 +1,28 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для работы с сохраненными поисками
+func RegisterRoutes(router fiber.Router, savedSearchService service.SavedSearchService) {
+	createHandler := NewCreateHandler(savedSearchService)
+	getByIDHandler := NewGetByIDHandler(savedSearchService)
+	getByCompanyHandler := NewGetByCompanyHandler(savedSearchService)
+	updateHandler := NewUpdateHandler(savedSearchService)
+	deleteHandler := NewDeleteHandler(savedSearchService)
+	shareHandler := NewShareHandler(savedSearchService)
+	pinHandler := NewPinHandler(savedSearchService)
+	runHandler := NewRunHandler(savedSearchService)
+
+	router.Post("/", createHandler.Handle)
+	router.Get("/company/:company_id", getByCompanyHandler.Handle)
+	router.Get("/:id", getByIDHandler.Handle)
+	router.Put("/:id", updateHandler.Handle)
+	router.Delete("/:id", deleteHandler.Handle)
+	router.Put("/:id/share", shareHandler.Handle)
+	router.Put("/:id/pin", pinHandler.Handle)
+	router.Get("/:id/run", runHandler.Handle)
+}
@@ -0,0 +1,80 @@
+package savedsearch
+
+import (
+	"strconv"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/document"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type RunHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewRunHandler(savedSearchService service.SavedSearchService) *RunHandler {
+	return &RunHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Выполнение сохраненного поиска
+// @Description  Выполняет сохраненный поиск по текущему состоянию документов и возвращает страницу результатов
+// @Description  в том же формате, что и поиск документов. Используется и для содержимого умной папки
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Param        page query int false "Номер страницы, начиная с 1"
+// @Param        page_size query int false "Размер страницы"
+// @Param        cursor query string false "next_cursor из предыдущего ответа"
+// @Success      200 {object} document.SearchResponse "Результаты поиска"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/{id}/run [get]
+func (h *RunHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	var page, pageSize int
+	if pageParam := c.Query("page"); pageParam != "" {
+		if page, err = strconv.Atoi(pageParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page",
+			})
+		}
+	}
+	if pageSizeParam := c.Query("page_size"); pageSizeParam != "" {
+		if pageSize, err = strconv.Atoi(pageSizeParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page_size",
+			})
+		}
+	}
+
+	result, err := h.savedSearchService.Run(c.Context(), userID, savedSearchID, page, pageSize, c.Query("cursor"))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(document.NewSearchResponse(result))
+}
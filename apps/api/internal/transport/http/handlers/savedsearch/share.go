@@ -0,0 +1,67 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ShareHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewShareHandler(savedSearchService service.SavedSearchService) *ShareHandler {
+	return &ShareHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Публикация сохраненного поиска
+// @Description  Делает поиск доступным всем пользователям компании или снова личным
+// @Description  Опубликованный закрепленный поиск появляется в дереве папок у всей компании
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Param        request body ShareRequest true "Публикация"
+// @Success      200 {object} SavedSearchResponse "Поиск изменен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не владелец поиска"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/{id}/share [put]
+func (h *ShareHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	var req ShareRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	savedSearch, err := h.savedSearchService.Share(c.Context(), userID, savedSearchID, req.Shared)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSavedSearchResponse(savedSearch))
+}
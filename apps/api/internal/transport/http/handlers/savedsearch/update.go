@@ -0,0 +1,77 @@
+package savedsearch
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateHandler struct {
+	savedSearchService service.SavedSearchService
+}
+
+func NewUpdateHandler(savedSearchService service.SavedSearchService) *UpdateHandler {
+	return &UpdateHandler{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// Handle godoc
+// @Summary      Изменение сохраненного поиска
+// @Description  Заменяет название, запрос, фильтры и сортировку. Изменять поиск может только владелец
+// @Tags         saved-searches
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID сохраненного поиска" format:"uuid"
+// @Param        request body UpdateSavedSearchRequest true "Новые параметры поиска"
+// @Success      200 {object} SavedSearchResponse "Поиск изменен"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не владелец поиска"
+// @Failure      404 {object} handlers.ErrorResponse "Поиск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/saved-searches/{id} [put]
+func (h *UpdateHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	idParam := c.Params("id")
+	savedSearchID, err := uuid.Parse(idParam)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid saved search id format",
+		})
+	}
+
+	var req UpdateSavedSearchRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	if req.Name == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "name is required",
+		})
+	}
+
+	savedSearch, err := h.savedSearchService.Update(c.Context(), userID, savedSearchID, service.SavedSearchInput{
+		Name:    req.Name,
+		Query:   req.Query,
+		Filters: req.Filters,
+		Sort:    req.Sort,
+	})
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSavedSearchResponse(savedSearch))
+}
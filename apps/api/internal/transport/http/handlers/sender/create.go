@@ -1,6 +1,7 @@
 package sender
 
 import (
+	"errors"
 	"log"
 	"techmind/internal/service"
 	"techmind/internal/transport/http/handlers"
@@ -21,13 +22,16 @@ func NewCreateHandler(senderService service.SenderService) *CreateHandler {
 // Handle godoc
 // @Summary      Создание нового контрагента
 // @Description  Создает нового контрагента в компании
+// @Description  Если в компании есть похожие контрагенты (тот же ИНН, почта, телефон, сайт или похожее название),
+// @Description  контрагент не создается и возвращается 409 со списком похожих; force=true создает его без проверки
 // @Tags         senders
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        request body CreateSenderRequest true "Данные для создания контрагента"
 // @Success      201 {object} SenderResponse "Контрагент успешно создан"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или реквизиты"
+// @Failure      409 {object} SenderDuplicatesResponse "Найдены похожие контрагенты"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/senders [post]
 func (h *CreateHandler) Handle(c fiber.Ctx) error {
@@ -44,18 +48,16 @@ func (h *CreateHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	sender, err := h.senderService.Create(c.Context(), req.CompanyID, req.Name, req.Email)
+	sender, duplicates, err := h.senderService.Create(c.Context(), req.CompanyID, newSenderInput(req.SenderProfile), req.Force)
+	if errors.Is(err, service.ErrSenderDuplicate) {
+		response := NewSenderDuplicatesResponse(duplicates)
+		response.Error = err.Error()
+		return c.Status(fiber.StatusConflict).JSON(response)
+	}
 	if err != nil {
 		log.Printf("Failed to create sender: %v", err)
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+		return respondError(c, err)
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(SenderResponse{
-		ID:        sender.ID,
-		CompanyID: sender.CompanyID,
-		Name:      sender.Name,
-		Email:     sender.Email,
-	})
+	return c.Status(fiber.StatusCreated).JSON(NewSenderResponse(sender))
 }
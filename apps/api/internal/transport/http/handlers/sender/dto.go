@@ -1,34 +1,155 @@
 package sender
 
 import (
+	"time"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+
 	"github.com/google/uuid"
 )
 
+// monthLayout - формат месяца в ленте и сводке документов контрагента
+const monthLayout = "2006-01"
+
+// SenderProfile представляет карточку контрагента
+type SenderProfile struct {
+	Name string `json:"name" validate:"required,min=1" example:"Ромашка"`
+	// Email - основной адрес почты, Emails - дополнительные
+	Email     *string `json:"email,omitempty" example:"info@romashka.ru"`
+	LegalName *string `json:"legal_name,omitempty" example:"ООО «Ромашка»"`
+	// INN - ИНН из 10 или 12 цифр, KPP - КПП организации
+	INN       *string  `json:"inn,omitempty" example:"7707083893"`
+	KPP       *string  `json:"kpp,omitempty" example:"773601001"`
+	Emails    []string `json:"emails,omitempty" example:"buh@romashka.ru"`
+	Phones    []string `json:"phones,omitempty" example:"+7 495 123-45-67"`
+	Addresses []string `json:"addresses,omitempty" example:"г. Москва, ул. Ленина, д. 1"`
+	Website   *string  `json:"website,omitempty" example:"https://romashka.ru"`
+	Notes     *string  `json:"notes,omitempty" example:"Поставщик канцтоваров"`
+}
+
 // CreateSenderRequest представляет запрос на создание контрагента
 type CreateSenderRequest struct {
 	CompanyID uuid.UUID `json:"company_id" validate:"required"`
-	Name      string    `json:"name" validate:"required,min=1"`
-	Email     *string   `json:"email,omitempty"`
+	SenderProfile
+	// Force - создать контрагента, даже если в компании есть похожие карточки
+	Force bool `json:"force" example:"false"`
 }
 
 // UpdateSenderRequest представляет запрос на обновление контрагента
 type UpdateSenderRequest struct {
-	Name  string  `json:"name" validate:"required,min=1"`
-	Email *string `json:"email,omitempty"`
+	SenderProfile
+}
+
+// FindDuplicatesRequest представляет запрос на поиск похожих контрагентов
+type FindDuplicatesRequest struct {
+	CompanyID uuid.UUID `json:"company_id" validate:"required"`
+	SenderProfile
+}
+
+// MergeSendersRequest представляет запрос на объединение контрагентов
+type MergeSendersRequest struct {
+	// TargetID - контрагент, который остается после объединения
+	TargetID uuid.UUID `json:"target_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 }
 
 // SenderResponse представляет данные контрагента
 type SenderResponse struct {
 	ID        uuid.UUID `json:"id"`
 	CompanyID uuid.UUID `json:"company_id"`
-	Name      string    `json:"name"`
-	Email     *string   `json:"email,omitempty"`
+	SenderProfile
+	CreatedAt time.Time `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2024-11-28T15:04:05Z"`
 }
 
-// SendersListResponse представляет список контрагентов
+// SendersListResponse представляет страницу списка контрагентов
 type SendersListResponse struct {
 	Senders []SenderResponse `json:"senders"`
-	Total   int              `json:"total"`
+	// Total - число контрагентов, подходящих под запрос, на всех страницах
+	Total    int `json:"total"`
+	Page     int `json:"page" example:"1"`
+	PageSize int `json:"page_size" example:"50"`
+}
+
+// SenderDuplicateResponse представляет возможный дубль контрагента
+type SenderDuplicateResponse struct {
+	Sender SenderResponse `json:"sender"`
+	// Score - оценка сходства от 0 до 1
+	Score float64 `json:"score" example:"0.9"`
+	// Reasons - совпавшие признаки: inn, email, phone, website, name, similar name
+	Reasons []string `json:"reasons" example:"inn"`
+}
+
+// SenderDuplicatesResponse представляет найденные похожие контрагенты
+type SenderDuplicatesResponse struct {
+	// Error заполняется, когда контрагент не создан из-за похожих карточек
+	Error      string                    `json:"error,omitempty" example:"possible duplicate senders found"`
+	Duplicates []SenderDuplicateResponse `json:"duplicates"`
+}
+
+// SenderDocumentsResponse представляет страницу ленты документов контрагента
+type SenderDocumentsResponse struct {
+	// Months - документы страницы по месяцам создания в порядке ленты
+	Months   []SenderDocumentsMonthResponse `json:"months"`
+	Total    int                            `json:"total" example:"120"`
+	Page     int                            `json:"page" example:"1"`
+	PageSize int                            `json:"page_size" example:"50"`
+}
+
+// SenderDocumentsMonthResponse представляет документы страницы за один месяц
+type SenderDocumentsMonthResponse struct {
+	Month     string                   `json:"month" example:"2024-11"`
+	Documents []SenderDocumentResponse `json:"documents"`
+}
+
+// SenderDocumentResponse представляет документ в ленте контрагента
+type SenderDocumentResponse struct {
+	ID        uuid.UUID                   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FolderID  *uuid.UUID                  `json:"folder_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name      string                      `json:"name" example:"Счет №15.pdf"`
+	MimeType  string                      `json:"mime_type" example:"application/pdf"`
+	FileSize  int64                       `json:"file_size" example:"1024000"`
+	CreatedAt time.Time                   `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	Tags      []SenderDocumentTagResponse `json:"tags"`
+}
+
+// SenderDocumentTagResponse представляет тег документа
+type SenderDocumentTagResponse struct {
+	ID    uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name  string    `json:"name" example:"Счета"`
+	Color *string   `json:"color,omitempty" example:"#2E7D32"`
+}
+
+// SenderDocumentStatsResponse представляет сводку по документам контрагента
+type SenderDocumentStatsResponse struct {
+	Documents int64 `json:"documents" example:"120"`
+	// TotalSize - общий размер файлов в байтах
+	TotalSize int64      `json:"total_size" example:"52428800"`
+	FirstAt   *time.Time `json:"first_at,omitempty" example:"2023-02-01T10:00:00Z"`
+	LastAt    *time.Time `json:"last_at,omitempty" example:"2024-11-28T15:04:05Z"`
+	// Months - от старых месяцев к новым, месяцы без документов пропущены
+	Months    []SenderMonthStatsResponse    `json:"months"`
+	MimeTypes []SenderMimeTypeStatsResponse `json:"mime_types"`
+	Tags      []SenderTagStatsResponse      `json:"tags"`
+}
+
+// SenderMonthStatsResponse представляет документы контрагента за месяц
+type SenderMonthStatsResponse struct {
+	Month     string `json:"month" example:"2024-11"`
+	Documents int64  `json:"documents" example:"12"`
+	TotalSize int64  `json:"total_size" example:"5242880"`
+}
+
+// SenderMimeTypeStatsResponse представляет число документов контрагента одного типа файла
+type SenderMimeTypeStatsResponse struct {
+	MimeType  string `json:"mime_type" example:"application/pdf"`
+	Documents int64  `json:"documents" example:"100"`
+}
+
+// SenderTagStatsResponse представляет число документов контрагента с тегом
+type SenderTagStatsResponse struct {
+	Tag       SenderDocumentTagResponse `json:"tag"`
+	Documents int64                     `json:"documents" example:"40"`
 }
 
 // SuccessResponse представляет успешный ответ
@@ -40,3 +161,130 @@ type SuccessResponse struct {
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// NewSenderResponse преобразует контрагента в DTO
+func NewSenderResponse(sender *ent.Sender) SenderResponse {
+	return SenderResponse{
+		ID:        sender.ID,
+		CompanyID: sender.CompanyID,
+		SenderProfile: SenderProfile{
+			Name:      sender.Name,
+			Email:     sender.Email,
+			LegalName: sender.LegalName,
+			INN:       sender.Inn,
+			KPP:       sender.Kpp,
+			Emails:    sender.Emails,
+			Phones:    sender.Phones,
+			Addresses: sender.Addresses,
+			Website:   sender.Website,
+			Notes:     sender.Notes,
+		},
+		CreatedAt: sender.CreatedAt,
+		UpdatedAt: sender.UpdatedAt,
+	}
+}
+
+// NewSenderDocumentsResponse преобразует страницу ленты документов контрагента в DTO
+func NewSenderDocumentsResponse(documents *service.SenderDocuments) SenderDocumentsResponse {
+	response := SenderDocumentsResponse{
+		Months:   make([]SenderDocumentsMonthResponse, 0, len(documents.Months)),
+		Total:    documents.Total,
+		Page:     documents.Page,
+		PageSize: documents.PageSize,
+	}
+	for _, month := range documents.Months {
+		monthResponse := SenderDocumentsMonthResponse{
+			Month:     month.Month.Format(monthLayout),
+			Documents: make([]SenderDocumentResponse, 0, len(month.Documents)),
+		}
+		for _, item := range month.Documents {
+			document := SenderDocumentResponse{
+				ID:        item.Document.ID,
+				FolderID:  item.Document.FolderID,
+				Name:      item.Document.Name,
+				MimeType:  item.Document.MimeType,
+				FileSize:  item.Document.FileSize,
+				CreatedAt: item.Document.CreatedAt,
+				Tags:      make([]SenderDocumentTagResponse, 0, len(item.Tags)),
+			}
+			for _, tag := range item.Tags {
+				document.Tags = append(document.Tags, newSenderDocumentTagResponse(tag))
+			}
+			monthResponse.Documents = append(monthResponse.Documents, document)
+		}
+		response.Months = append(response.Months, monthResponse)
+	}
+	return response
+}
+
+// NewSenderDocumentStatsResponse преобразует сводку по документам контрагента в DTO
+func NewSenderDocumentStatsResponse(stats *service.SenderDocumentStats) SenderDocumentStatsResponse {
+	response := SenderDocumentStatsResponse{
+		Documents: stats.Documents,
+		TotalSize: stats.TotalSize,
+		FirstAt:   stats.FirstAt,
+		LastAt:    stats.LastAt,
+		Months:    make([]SenderMonthStatsResponse, 0, len(stats.Months)),
+		MimeTypes: make([]SenderMimeTypeStatsResponse, 0, len(stats.MimeTypes)),
+		Tags:      make([]SenderTagStatsResponse, 0, len(stats.Tags)),
+	}
+	for _, month := range stats.Months {
+		response.Months = append(response.Months, SenderMonthStatsResponse{
+			Month:     month.Month.Format(monthLayout),
+			Documents: month.Documents,
+			TotalSize: month.TotalSize,
+		})
+	}
+	for _, mimeType := range stats.MimeTypes {
+		response.MimeTypes = append(response.MimeTypes, SenderMimeTypeStatsResponse{
+			MimeType:  mimeType.MimeType,
+			Documents: mimeType.Documents,
+		})
+	}
+	for _, tag := range stats.Tags {
+		response.Tags = append(response.Tags, SenderTagStatsResponse{
+			Tag:       newSenderDocumentTagResponse(tag.Tag),
+			Documents: tag.Documents,
+		})
+	}
+	return response
+}
+
+func newSenderDocumentTagResponse(tag *ent.Tag) SenderDocumentTagResponse {
+	return SenderDocumentTagResponse{
+		ID:    tag.ID,
+		Name:  tag.Name,
+		Color: tag.Color,
+	}
+}
+
+// NewSenderDuplicatesResponse преобразует похожих контрагентов в DTO
+func NewSenderDuplicatesResponse(duplicates []service.SenderDuplicate) SenderDuplicatesResponse {
+	response := SenderDuplicatesResponse{
+		Duplicates: make([]SenderDuplicateResponse, 0, len(duplicates)),
+	}
+	for _, duplicate := range duplicates {
+		response.Duplicates = append(response.Duplicates, SenderDuplicateResponse{
+			Sender:  NewSenderResponse(duplicate.Sender),
+			Score:   duplicate.Score,
+			Reasons: duplicate.Reasons,
+		})
+	}
+	return response
+}
+
+// newSenderInput преобразует карточку из запроса в параметры сервиса
+func newSenderInput(profile SenderProfile) service.SenderInput {
+	return service.SenderInput{
+		Name:      profile.Name,
+		Email:     profile.Email,
+		LegalName: profile.LegalName,
+		INN:       profile.INN,
+		KPP:       profile.KPP,
+		Emails:    profile.Emails,
+		Phones:    profile.Phones,
+		Addresses: profile.Addresses,
+		Website:   profile.Website,
+		Notes:     profile.Notes,
+	}
+}
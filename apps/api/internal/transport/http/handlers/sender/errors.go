@@ -0,0 +1,21 @@
+package sender
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondError отвечает кодом, соответствующим ошибке сервиса контрагентов
+func respondError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidSender), errors.Is(err, service.ErrInvalidSenderDocumentsFilter):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrSenderNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
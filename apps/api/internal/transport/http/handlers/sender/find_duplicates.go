@@ -0,0 +1,47 @@
+package sender
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+type FindDuplicatesHandler struct {
+	senderService service.SenderService
+}
+
+func NewFindDuplicatesHandler(senderService service.SenderService) *FindDuplicatesHandler {
+	return &FindDuplicatesHandler{
+		senderService: senderService,
+	}
+}
+
+// Handle godoc
+// @Summary      Поиск похожих контрагентов
+// @Description  Сравнивает карточку с контрагентами компании по ИНН и КПП, почте, телефонам, сайту и названию
+// @Description  без учета организационно-правовой формы и возвращает похожие, сначала самые похожие
+// @Tags         senders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body FindDuplicatesRequest true "Карточка контрагента"
+// @Success      200 {object} SenderDuplicatesResponse "Похожие контрагенты"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или реквизиты"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/senders/duplicates [post]
+func (h *FindDuplicatesHandler) Handle(c fiber.Ctx) error {
+	var req FindDuplicatesRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	duplicates, err := h.senderService.FindDuplicates(c.Context(), req.CompanyID, newSenderInput(req.SenderProfile))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSenderDuplicatesResponse(duplicates))
+}
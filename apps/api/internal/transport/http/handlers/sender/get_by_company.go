@@ -1,6 +1,8 @@
 package sender
 
 import (
+	"strconv"
+
 	"techmind/internal/service"
 	"techmind/internal/transport/http/handlers"
 
@@ -19,15 +21,19 @@ func NewGetByCompanyHandler(senderService service.SenderService) *GetByCompanyHa
 }
 
 // Handle godoc
-// @Summary      Получение всех контрагентов компании
-// @Description  Возвращает список всех контрагентов компании
+// @Summary      Получение контрагентов компании
+// @Description  Возвращает страницу контрагентов компании по алфавиту
+// @Description  q ищет по части названия, юридического названия или основной почты и по началу ИНН
 // @Tags         senders
 // @Accept       json
 // @Produce      json
 // @Security     BearerAuth
 // @Param        company_id path string true "ID компании" format:"uuid"
+// @Param        q query string false "Поисковый запрос"
+// @Param        page query int false "Номер страницы, начиная с 1"
+// @Param        page_size query int false "Размер страницы, по умолчанию 50, не больше 200"
 // @Success      200 {object} SendersListResponse "Список контрагентов"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или параметров страницы"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/senders/company/{company_id} [get]
 func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
@@ -39,25 +45,36 @@ func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	senders, err := h.senderService.GetByCompany(c.Context(), companyID)
+	input := service.SenderListInput{Query: c.Query("q")}
+	if pageParam := c.Query("page"); pageParam != "" {
+		if input.Page, err = strconv.Atoi(pageParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page",
+			})
+		}
+	}
+	if pageSizeParam := c.Query("page_size"); pageSizeParam != "" {
+		if input.PageSize, err = strconv.Atoi(pageSizeParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page_size",
+			})
+		}
+	}
+
+	list, err := h.senderService.GetByCompany(c.Context(), companyID, input)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+		return respondError(c, err)
 	}
 
 	response := SendersListResponse{
-		Senders: make([]SenderResponse, 0, len(senders)),
-		Total:   len(senders),
+		Senders:  make([]SenderResponse, 0, len(list.Senders)),
+		Total:    list.Total,
+		Page:     list.Page,
+		PageSize: list.PageSize,
 	}
 
-	for _, sender := range senders {
-		response.Senders = append(response.Senders, SenderResponse{
-			ID:        sender.ID,
-			CompanyID: sender.CompanyID,
-			Name:      sender.Name,
-			Email:     sender.Email,
-		})
+	for _, sender := range list.Senders {
+		response.Senders = append(response.Senders, NewSenderResponse(sender))
 	}
 
 	return c.JSON(response)
@@ -42,15 +42,8 @@ func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
 
 	sender, err := h.senderService.GetByID(c.Context(), id)
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{
-			Error: "sender not found",
-		})
+		return respondError(c, err)
 	}
 
-	return c.JSON(SenderResponse{
-		ID:        sender.ID,
-		CompanyID: sender.CompanyID,
-		Name:      sender.Name,
-		Email:     sender.Email,
-	})
+	return c.JSON(NewSenderResponse(sender))
 }
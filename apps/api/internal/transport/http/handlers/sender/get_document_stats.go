@@ -0,0 +1,60 @@
+package sender
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetDocumentStatsHandler struct {
+	senderService service.SenderService
+}
+
+func NewGetDocumentStatsHandler(senderService service.SenderService) *GetDocumentStatsHandler {
+	return &GetDocumentStatsHandler{
+		senderService: senderService,
+	}
+}
+
+// Handle godoc
+// @Summary      Сводка по документам контрагента
+// @Description  Возвращает даты первого и последнего документа, общий объем и число документов
+// @Description  по месяцам, типам файлов и тегам; фильтры те же, что у ленты документов
+// @Tags         senders
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID контрагента" format:"uuid"
+// @Param        from query string false "Начало интервала (RFC 3339)" format:"date-time"
+// @Param        to query string false "Конец интервала (RFC 3339), не включается" format:"date-time"
+// @Param        mime_types query string false "MIME типы через запятую"
+// @Param        tag_ids query string false "ID тегов через запятую, документ должен иметь хотя бы один из них"
+// @Param        folder_id query string false "ID папки" format:"uuid"
+// @Success      200 {object} SenderDocumentStatsResponse "Сводка по документам"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или параметров"
+// @Failure      404 {object} handlers.ErrorResponse "Контрагент не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/senders/{id}/documents/stats [get]
+func (h *GetDocumentStatsHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid sender id format",
+		})
+	}
+
+	filter, message := parseDocumentsFilter(c)
+	if message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: message,
+		})
+	}
+
+	stats, err := h.senderService.GetDocumentStats(c.Context(), id, filter)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSenderDocumentStatsResponse(stats))
+}
@@ -0,0 +1,137 @@
+package sender
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetDocumentsHandler struct {
+	senderService service.SenderService
+}
+
+func NewGetDocumentsHandler(senderService service.SenderService) *GetDocumentsHandler {
+	return &GetDocumentsHandler{
+		senderService: senderService,
+	}
+}
+
+// Handle godoc
+// @Summary      Лента документов контрагента
+// @Description  Возвращает страницу документов контрагента по дате создания, сгруппированную по месяцам
+// @Tags         senders
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID контрагента" format:"uuid"
+// @Param        from query string false "Начало интервала (RFC 3339)" format:"date-time"
+// @Param        to query string false "Конец интервала (RFC 3339), не включается" format:"date-time"
+// @Param        mime_types query string false "MIME типы через запятую"
+// @Param        tag_ids query string false "ID тегов через запятую, документ должен иметь хотя бы один из них"
+// @Param        folder_id query string false "ID папки" format:"uuid"
+// @Param        order query string false "Порядок: desc (по умолчанию, сначала новые) или asc"
+// @Param        page query int false "Номер страницы, начиная с 1"
+// @Param        page_size query int false "Размер страницы, по умолчанию 50, не больше 200"
+// @Success      200 {object} SenderDocumentsResponse "Документы контрагента"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID или параметров"
+// @Failure      404 {object} handlers.ErrorResponse "Контрагент не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/senders/{id}/documents [get]
+func (h *GetDocumentsHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid sender id format",
+		})
+	}
+
+	filter, message := parseDocumentsFilter(c)
+	if message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: message,
+		})
+	}
+	input := service.SenderDocumentsInput{SenderDocumentsFilter: filter}
+
+	switch c.Query("order") {
+	case "", "desc":
+	case "asc":
+		input.Ascending = true
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid order, expected asc or desc",
+		})
+	}
+
+	if pageParam := c.Query("page"); pageParam != "" {
+		if input.Page, err = strconv.Atoi(pageParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page",
+			})
+		}
+	}
+	if pageSizeParam := c.Query("page_size"); pageSizeParam != "" {
+		if input.PageSize, err = strconv.Atoi(pageSizeParam); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+				Error: "invalid page_size",
+			})
+		}
+	}
+
+	documents, err := h.senderService.GetDocuments(c.Context(), id, input)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSenderDocumentsResponse(documents))
+}
+
+// parseDocumentsFilter читает фильтр документов контрагента из параметров запроса
+// и возвращает текст ошибки для неверного параметра
+func parseDocumentsFilter(c fiber.Ctx) (service.SenderDocumentsFilter, string) {
+	var filter service.SenderDocumentsFilter
+
+	for param, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return filter, "invalid " + param + " format, expected RFC 3339"
+		}
+		*target = &t
+	}
+
+	for _, mimeType := range strings.Split(c.Query("mime_types"), ",") {
+		if mimeType = strings.TrimSpace(mimeType); mimeType != "" {
+			filter.MimeTypes = append(filter.MimeTypes, mimeType)
+		}
+	}
+
+	for _, tagParam := range strings.Split(c.Query("tag_ids"), ",") {
+		if tagParam = strings.TrimSpace(tagParam); tagParam == "" {
+			continue
+		}
+		tagID, err := uuid.Parse(tagParam)
+		if err != nil {
+			return filter, "invalid tag id format"
+		}
+		filter.TagIDs = append(filter.TagIDs, tagID)
+	}
+
+	if folderParam := c.Query("folder_id"); folderParam != "" {
+		folderID, err := uuid.Parse(folderParam)
+		if err != nil {
+			return filter, "invalid folder id format"
+		}
+		filter.FolderID = &folderID
+	}
+
+	return filter, ""
+}
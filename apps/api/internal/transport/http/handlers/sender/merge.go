@@ -0,0 +1,58 @@
+package sender
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type MergeHandler struct {
+	senderService service.SenderService
+}
+
+func NewMergeHandler(senderService service.SenderService) *MergeHandler {
+	return &MergeHandler{
+		senderService: senderService,
+	}
+}
+
+// Handle godoc
+// @Summary      Объединение контрагентов
+// @Description  Переносит документы контрагента id на контрагента target_id той же компании и удаляет контрагента id
+// @Description  Пустые поля карточки target_id заполняются из карточки id, почта, телефоны и адреса объединяются
+// @Description  Ссылки на контрагента в правилах тегирования и сохраненных поисках заменяются на target_id
+// @Tags         senders
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID объединяемого контрагента" format:"uuid"
+// @Param        request body MergeSendersRequest true "Контрагент, который остается"
+// @Success      200 {object} SenderResponse "Контрагент после объединения"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или контрагенты разных компаний"
+// @Failure      404 {object} handlers.ErrorResponse "Контрагент не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/senders/{id}/merge [post]
+func (h *MergeHandler) Handle(c fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid sender id format",
+		})
+	}
+
+	var req MergeSendersRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	sender, err := h.senderService.Merge(c.Context(), id, req.TargetID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewSenderResponse(sender))
+}
@@ -13,11 +13,21 @@ func RegisterRoutes(router fiber.Router, senderService service.SenderService) {
 	updateHandler := NewUpdateHandler(senderService)
 	deleteHandler := NewDeleteHandler(senderService)
 	getByCompanyHandler := NewGetByCompanyHandler(senderService)
+	findDuplicatesHandler := NewFindDuplicatesHandler(senderService)
+	mergeHandler := NewMergeHandler(senderService)
+	getDocumentsHandler := NewGetDocumentsHandler(senderService)
+	getDocumentStatsHandler := NewGetDocumentStatsHandler(senderService)
 
 	// CRUD операции с контрагентами
 	router.Post("/", createHandler.Handle)
+	router.Post("/duplicates", findDuplicatesHandler.Handle)
 	router.Get("/company/:company_id", getByCompanyHandler.Handle)
 	router.Get("/:id", getByIDHandler.Handle)
 	router.Put("/:id", updateHandler.Handle)
 	router.Delete("/:id", deleteHandler.Handle)
+	router.Post("/:id/merge", mergeHandler.Handle)
+
+	// Документы контрагента
+	router.Get("/:id/documents", getDocumentsHandler.Handle)
+	router.Get("/:id/documents/stats", getDocumentStatsHandler.Handle)
 }
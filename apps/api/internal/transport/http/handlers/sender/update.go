@@ -20,7 +20,7 @@ func NewUpdateHandler(senderService service.SenderService) *UpdateHandler {
 
 // Handle godoc
 // @Summary      Обновление контрагента
-// @Description  Обновляет данные контрагента
+// @Description  Заменяет карточку контрагента, незаданные поля очищаются
 // @Tags         senders
 // @Accept       json
 // @Produce      json
@@ -28,7 +28,7 @@ func NewUpdateHandler(senderService service.SenderService) *UpdateHandler {
 // @Param        id path string true "ID контрагента" format:"uuid"
 // @Param        request body UpdateSenderRequest true "Данные для обновления"
 // @Success      200 {object} SenderResponse "Контрагент успешно обновлен"
-// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или реквизиты"
 // @Failure      404 {object} handlers.ErrorResponse "Контрагент не найден"
 // @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
 // @Router       /private/senders/{id} [put]
@@ -54,17 +54,10 @@ func (h *UpdateHandler) Handle(c fiber.Ctx) error {
 		})
 	}
 
-	sender, err := h.senderService.Update(c.Context(), id, req.Name, req.Email)
+	sender, err := h.senderService.Update(c.Context(), id, newSenderInput(req.SenderProfile))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{
-			Error: err.Error(),
-		})
+		return respondError(c, err)
 	}
 
-	return c.JSON(SenderResponse{
-		ID:        sender.ID,
-		CompanyID: sender.CompanyID,
-		Name:      sender.Name,
-		Email:     sender.Email,
-	})
+	return c.JSON(NewSenderResponse(sender))
 }
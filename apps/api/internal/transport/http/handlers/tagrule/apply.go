@@ -0,0 +1,61 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+	"techmind/internal/transport/http/handlers/job"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type ApplyHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewApplyHandler(tagRuleService service.TagRuleService) *ApplyHandler {
+	return &ApplyHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Применение правил к существующим документам
+// @Description  Запускает фоновую задачу, которая применяет включенные правила компании ко всем ее документам
+// @Description  Теги только добавляются. Прогресс отслеживается через /jobs/{id},
+// @Description  в result_document_ids завершенной задачи - документы, получившие новые теги
+// @Description  Тег исключающей группы, присвоенный вручную, правила не заменяют, число таких пропусков - skipped_manual_group в payload задачи
+// @Description  ID задачи служит ID запуска: присвоенные в нем теги снимаются через /tag-rules/runs/{run_id}/undo
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body ApplyRulesRequest true "Компания"
+// @Success      202 {object} job.JobResponse "Задача создана"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/apply [post]
+func (h *ApplyHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req ApplyRulesRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	applyJob, err := h.tagRuleService.Reapply(c.Context(), userID, req.CompanyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(job.NewJobResponse(applyJob))
+}
@@ -0,0 +1,59 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type CreateHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewCreateHandler(tagRuleService service.TagRuleService) *CreateHandler {
+	return &CreateHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Создание правила тегирования
+// @Description  Создает правило, которое присваивает тег документам компании, подходящим под условия:
+// @Description  ключевые слова (keywords, keywords_mode any/all, match word/stem/substring), регулярные выражения (patterns),
+// @Description  контрагенты, MIME типы (image/*), папки с подпапками и шаблоны названия файла (*счет*.pdf)
+// @Description  Правила проверяются по убыванию priority, stop прекращает проверку следующих правил
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        request body TagRuleRequest true "Параметры правила"
+// @Success      201 {object} TagRuleResponse "Правило создано"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или условия правила"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules [post]
+func (h *CreateHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	var req TagRuleRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	rule, err := h.tagRuleService.Create(c.Context(), userID, newTagRuleInput(req))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(NewTagRuleResponse(rule))
+}
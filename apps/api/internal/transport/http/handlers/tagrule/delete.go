@@ -0,0 +1,56 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DeleteHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewDeleteHandler(tagRuleService service.TagRuleService) *DeleteHandler {
+	return &DeleteHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Удаление правила тегирования
+// @Description  Удаляет правило, присвоенные им теги остаются у документов
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID правила" format:"uuid"
+// @Success      204 "Правило удалено"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      404 {object} handlers.ErrorResponse "Правило не найдено"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/{id} [delete]
+func (h *DeleteHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	ruleID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag rule id format",
+		})
+	}
+
+	if err := h.tagRuleService.Delete(c.Context(), userID, ruleID); err != nil {
+		return respondError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -0,0 +1,57 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type DryRunHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewDryRunHandler(tagRuleService service.TagRuleService) *DryRunHandler {
+	return &DryRunHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Пробное применение правил к документу
+// @Description  Проверяет все правила компании на документе и показывает, какие сработают и почему,
+// @Description  какие пропущены и какие теги будут добавлены. Теги документа не меняются
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        document_id path string true "ID документа" format:"uuid"
+// @Success      200 {object} DryRunResponse "Результаты проверки правил"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/dry-run/{document_id} [get]
+func (h *DryRunHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	documentID, err := uuid.Parse(c.Params("document_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid document id format",
+		})
+	}
+
+	evaluations, err := h.tagRuleService.DryRun(c.Context(), userID, documentID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewDryRunResponse(documentID, evaluations))
+}
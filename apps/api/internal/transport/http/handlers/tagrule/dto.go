@@ -0,0 +1,158 @@
+package tagrule
+
+import (
+	"time"
+
+	"techmind/internal/service"
+	"techmind/schema/ent"
+	"techmind/schema/types"
+
+	"github.com/google/uuid"
+)
+
+// TagRuleRequest представляет запрос на создание или изменение правила тегирования
+type TagRuleRequest struct {
+	TagID uuid.UUID `json:"tag_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name  string    `json:"name" validate:"required,min=1" example:"Договоры поставки"`
+	// Conditions - условия правила, правило срабатывает при выполнении всех заданных условий
+	Conditions types.TagRuleConditions `json:"conditions"`
+	// Priority - правила проверяются по убыванию приоритета
+	Priority int `json:"priority,omitempty" example:"10"`
+	// Stop - после срабатывания правила следующие правила не проверяются
+	Stop bool `json:"stop,omitempty" example:"false"`
+	// Enabled - правило применяется к документам, по умолчанию true
+	Enabled *bool `json:"enabled,omitempty" example:"true"`
+}
+
+// ApplyRulesRequest представляет запрос на применение правил к документам компании
+type ApplyRulesRequest struct {
+	CompanyID uuid.UUID `json:"company_id" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// TagRuleResponse представляет данные правила тегирования
+type TagRuleResponse struct {
+	ID         uuid.UUID               `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID  uuid.UUID               `json:"company_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TagID      uuid.UUID               `json:"tag_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Name       string                  `json:"name" example:"Договоры поставки"`
+	Conditions types.TagRuleConditions `json:"conditions"`
+	Priority   int                     `json:"priority" example:"10"`
+	Stop       bool                    `json:"stop" example:"false"`
+	Enabled    bool                    `json:"enabled" example:"true"`
+	CreatedAt  time.Time               `json:"created_at" example:"2024-11-28T15:04:05Z"`
+	UpdatedAt  time.Time               `json:"updated_at" example:"2024-11-28T15:04:05Z"`
+}
+
+// TagRulesListResponse представляет список правил тегирования
+type TagRulesListResponse struct {
+	Rules []TagRuleResponse `json:"rules"`
+	Total int               `json:"total" example:"3"`
+}
+
+// TagRuleEvaluationResponse представляет результат проверки правила на документе
+type TagRuleEvaluationResponse struct {
+	RuleID   uuid.UUID `json:"rule_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	RuleName string    `json:"rule_name" example:"Договоры поставки"`
+	TagID    uuid.UUID `json:"tag_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	TagName  string    `json:"tag_name" example:"договор"`
+	Priority int       `json:"priority" example:"10"`
+	// Matched - все условия выполнены, тег будет присвоен
+	Matched bool `json:"matched" example:"true"`
+	// Skipped - правило отключено или пропущено после правила с флагом stop
+	Skipped       bool `json:"skipped" example:"false"`
+	AlreadyTagged bool `json:"already_tagged" example:"false"`
+	// Suppressed - пользователь снял тег с документа, правило его не присвоит
+	Suppressed bool `json:"suppressed" example:"false"`
+	// GroupTaken - тег исключающей группы уже выбран правилом с большим приоритетом, правило его не присвоит
+	GroupTaken bool `json:"group_taken" example:"false"`
+	// ManualInGroup - у документа есть тег исключающей группы, присвоенный вручную, правило его не заменит
+	ManualInGroup bool `json:"manual_in_group" example:"false"`
+	// Matches - выполненные условия
+	Matches []string `json:"matches,omitempty" example:"keyword \"договор поставки\""`
+	// Reason - невыполненное условие или причина пропуска
+	Reason string `json:"reason,omitempty" example:"no keyword found"`
+}
+
+// DryRunResponse представляет результат пробного применения правил к документу
+type DryRunResponse struct {
+	DocumentID uuid.UUID                   `json:"document_id" example:"550e8400-e29b-41d4-a716-446655440003"`
+	Rules      []TagRuleEvaluationResponse `json:"rules"`
+	// NewTagIDs - теги, которые будут добавлены документу
+	NewTagIDs []uuid.UUID `json:"new_tag_ids"`
+}
+
+// UndoRunResponse представляет итог отмены запуска правил
+type UndoRunResponse struct {
+	RunID uuid.UUID `json:"run_id" example:"550e8400-e29b-41d4-a716-446655440004"`
+	// Removed - число снятых тегов
+	Removed int `json:"removed" example:"12"`
+}
+
+// NewTagRuleResponse преобразует правило тегирования в DTO
+func NewTagRuleResponse(rule *ent.TagRule) TagRuleResponse {
+	return TagRuleResponse{
+		ID:         rule.ID,
+		CompanyID:  rule.CompanyID,
+		TagID:      rule.TagID,
+		Name:       rule.Name,
+		Conditions: rule.Conditions,
+		Priority:   rule.Priority,
+		Stop:       rule.Stop,
+		Enabled:    rule.Enabled,
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
+
+// NewDryRunResponse преобразует результаты проверки правил в DTO
+func NewDryRunResponse(documentID uuid.UUID, evaluations []service.TagRuleEvaluation) DryRunResponse {
+	response := DryRunResponse{
+		DocumentID: documentID,
+		Rules:      make([]TagRuleEvaluationResponse, 0, len(evaluations)),
+		NewTagIDs:  make([]uuid.UUID, 0),
+	}
+
+	added := make(map[uuid.UUID]bool)
+	for _, evaluation := range evaluations {
+		item := TagRuleEvaluationResponse{
+			RuleID:        evaluation.Rule.ID,
+			RuleName:      evaluation.Rule.Name,
+			TagID:         evaluation.Rule.TagID,
+			Priority:      evaluation.Rule.Priority,
+			Matched:       evaluation.Matched,
+			Skipped:       evaluation.Skipped,
+			AlreadyTagged: evaluation.AlreadyTagged,
+			Suppressed:    evaluation.Suppressed,
+			GroupTaken:    evaluation.GroupTaken,
+			ManualInGroup: evaluation.ManualInGroup,
+			Matches:       evaluation.Matches,
+			Reason:        evaluation.Reason,
+		}
+		if evaluation.Tag != nil {
+			item.TagName = evaluation.Tag.Name
+		}
+		response.Rules = append(response.Rules, item)
+
+		if evaluation.Matched && !evaluation.AlreadyTagged && !evaluation.Suppressed && !evaluation.GroupTaken && !evaluation.ManualInGroup && !added[evaluation.Rule.TagID] {
+			added[evaluation.Rule.TagID] = true
+			response.NewTagIDs = append(response.NewTagIDs, evaluation.Rule.TagID)
+		}
+	}
+	return response
+}
+
+// newTagRuleInput преобразует запрос в параметры правила
+func newTagRuleInput(req TagRuleRequest) service.TagRuleInput {
+	enabled := true
+	if req.Enabled != nil {
+		enabled = *req.Enabled
+	}
+	return service.TagRuleInput{
+		TagID:      req.TagID,
+		Name:       req.Name,
+		Conditions: req.Conditions,
+		Priority:   req.Priority,
+		Stop:       req.Stop,
+		Enabled:    enabled,
+	}
+}
@@ -0,0 +1,23 @@
+package tagrule
+
+import (
+	"errors"
+
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// respondError отвечает кодом, соответствующим ошибке сервиса правил тегирования
+func respondError(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidTagRule):
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrTagRuleNotFound), errors.Is(err, service.ErrTagRuleRunNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(handlers.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, service.ErrTagRuleForbidden):
+		return c.Status(fiber.StatusForbidden).JSON(handlers.ErrorResponse{Error: err.Error()})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(handlers.ErrorResponse{Error: err.Error()})
+}
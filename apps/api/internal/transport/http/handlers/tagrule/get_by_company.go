@@ -0,0 +1,64 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByCompanyHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewGetByCompanyHandler(tagRuleService service.TagRuleService) *GetByCompanyHandler {
+	return &GetByCompanyHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение правил тегирования компании
+// @Description  Возвращает правила компании в порядке проверки: по убыванию приоритета, затем по дате создания
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        company_id path string true "ID компании" format:"uuid"
+// @Success      200 {object} TagRulesListResponse "Список правил"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/company/{company_id} [get]
+func (h *GetByCompanyHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	companyID, err := uuid.Parse(c.Params("company_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid company id format",
+		})
+	}
+
+	rules, err := h.tagRuleService.List(c.Context(), userID, companyID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	response := TagRulesListResponse{
+		Rules: make([]TagRuleResponse, 0, len(rules)),
+		Total: len(rules),
+	}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, NewTagRuleResponse(rule))
+	}
+
+	return c.JSON(response)
+}
@@ -0,0 +1,55 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type GetByIDHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewGetByIDHandler(tagRuleService service.TagRuleService) *GetByIDHandler {
+	return &GetByIDHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Получение правила тегирования
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID правила" format:"uuid"
+// @Success      200 {object} TagRuleResponse "Правило"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      404 {object} handlers.ErrorResponse "Правило не найдено"
+// @Router       /private/tag-rules/{id} [get]
+func (h *GetByIDHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	ruleID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag rule id format",
+		})
+	}
+
+	rule, err := h.tagRuleService.GetByID(c.Context(), userID, ruleID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewTagRuleResponse(rule))
+}
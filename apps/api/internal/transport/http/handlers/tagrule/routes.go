@@ -0,0 +1,28 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// RegisterRoutes регистрирует маршруты для работы с правилами автоматического тегирования
+func RegisterRoutes(router fiber.Router, tagRuleService service.TagRuleService) {
+	createHandler := NewCreateHandler(tagRuleService)
+	getByIDHandler := NewGetByIDHandler(tagRuleService)
+	getByCompanyHandler := NewGetByCompanyHandler(tagRuleService)
+	updateHandler := NewUpdateHandler(tagRuleService)
+	deleteHandler := NewDeleteHandler(tagRuleService)
+	dryRunHandler := NewDryRunHandler(tagRuleService)
+	applyHandler := NewApplyHandler(tagRuleService)
+	undoRunHandler := NewUndoRunHandler(tagRuleService)
+
+	router.Post("/", createHandler.Handle)
+	router.Post("/apply", applyHandler.Handle)
+	router.Post("/runs/:run_id/undo", undoRunHandler.Handle)
+	router.Get("/company/:company_id", getByCompanyHandler.Handle)
+	router.Get("/dry-run/:document_id", dryRunHandler.Handle)
+	router.Get("/:id", getByIDHandler.Handle)
+	router.Put("/:id", updateHandler.Handle)
+	router.Delete("/:id", deleteHandler.Handle)
+}
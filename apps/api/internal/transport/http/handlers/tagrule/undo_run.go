@@ -0,0 +1,61 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UndoRunHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewUndoRunHandler(tagRuleService service.TagRuleService) *UndoRunHandler {
+	return &UndoRunHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Отмена запуска правил
+// @Description  Снимает теги, присвоенные правилами в запуске run_id. ID запуска - ID задачи применения правил
+// @Description  Теги, которые пользователь после запуска добавил вручную, остаются
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        run_id path string true "ID запуска" format:"uuid"
+// @Success      200 {object} UndoRunResponse "Теги сняты"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат ID"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      404 {object} handlers.ErrorResponse "Запуск не найден"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/runs/{run_id}/undo [post]
+func (h *UndoRunHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	runID, err := uuid.Parse(c.Params("run_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid run id format",
+		})
+	}
+
+	removed, err := h.tagRuleService.UndoRun(c.Context(), userID, runID)
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(UndoRunResponse{
+		RunID:   runID,
+		Removed: removed,
+	})
+}
@@ -0,0 +1,66 @@
+package tagrule
+
+import (
+	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+type UpdateHandler struct {
+	tagRuleService service.TagRuleService
+}
+
+func NewUpdateHandler(tagRuleService service.TagRuleService) *UpdateHandler {
+	return &UpdateHandler{
+		tagRuleService: tagRuleService,
+	}
+}
+
+// Handle godoc
+// @Summary      Изменение правила тегирования
+// @Description  Заменяет тег, название, условия, приоритет и флаги правила
+// @Description  Уже присвоенные документам теги не меняются, для повторного применения используйте /tag-rules/apply
+// @Tags         tag-rules
+// @Accept       json
+// @Produce      json
+// @Security     BearerAuth
+// @Param        id path string true "ID правила" format:"uuid"
+// @Param        request body TagRuleRequest true "Параметры правила"
+// @Success      200 {object} TagRuleResponse "Правило изменено"
+// @Failure      400 {object} handlers.ErrorResponse "Неверный формат запроса или условия правила"
+// @Failure      401 {object} handlers.ErrorResponse "Не авторизован"
+// @Failure      403 {object} handlers.ErrorResponse "Пользователь не состоит в компании"
+// @Failure      404 {object} handlers.ErrorResponse "Правило не найдено"
+// @Failure      500 {object} handlers.ErrorResponse "Внутренняя ошибка сервера"
+// @Router       /private/tag-rules/{id} [put]
+func (h *UpdateHandler) Handle(c fiber.Ctx) error {
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(handlers.ErrorResponse{
+			Error: "unauthorized",
+		})
+	}
+
+	ruleID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid tag rule id format",
+		})
+	}
+
+	var req TagRuleRequest
+	if err := c.Bind().JSON(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(handlers.ErrorResponse{
+			Error: "invalid request format",
+		})
+	}
+
+	rule, err := h.tagRuleService.Update(c.Context(), userID, ruleID, newTagRuleInput(req))
+	if err != nil {
+		return respondError(c, err)
+	}
+
+	return c.JSON(NewTagRuleResponse(rule))
+}
@@ -52,6 +52,27 @@ func (s *Server) jwtMiddleware(c fiber.Ctx) error {
 	return c.Next()
 }
 
+// adminMiddleware пропускает только пользователей из списка администраторов в конфигурации
+// Должен подключаться после jwtMiddleware
+func (s *Server) adminMiddleware(c fiber.Ctx) error {
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "unauthorized",
+		})
+	}
+
+	for _, adminID := range s.deps.Config.Admin.UserIDs {
+		if strings.EqualFold(adminID, userID.String()) {
+			return c.Next()
+		}
+	}
+
+	return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"error": "admin access required",
+	})
+}
+
 func (s *Server) optsMiddleware(c fiber.Ctx) error {
 	err := c.Next()
 
@@ -2,13 +2,20 @@ package http
 
 import (
 	"techmind/internal/service"
+	"techmind/internal/transport/http/handlers/admin"
 	"techmind/internal/transport/http/handlers/auth"
 	"techmind/internal/transport/http/handlers/company"
 	"techmind/internal/transport/http/handlers/company_user"
 	"techmind/internal/transport/http/handlers/document"
+	"techmind/internal/transport/http/handlers/documentclassifier"
 	"techmind/internal/transport/http/handlers/documenttag"
+	"techmind/internal/transport/http/handlers/documenttype"
 	"techmind/internal/transport/http/handlers/folder"
+	"techmind/internal/transport/http/handlers/job"
+	"techmind/internal/transport/http/handlers/pdf"
+	"techmind/internal/transport/http/handlers/savedsearch"
 	"techmind/internal/transport/http/handlers/sender"
+	"techmind/internal/transport/http/handlers/tagrule"
 	"techmind/pkg/config"
 
 	"github.com/gofiber/fiber/v3"
@@ -16,14 +23,22 @@ import (
 )
 
 type ServerDeps struct {
-	AuthService        service.AuthService
-	FolderService      service.FolderService
-	DocumentService    service.DocumentService
-	DocumentTagService service.DocumentTagService
-	SenderService      service.SenderService
-	CompanyUserService service.CompanyUserService
-	CompanyService     service.CompanyService
-	Config             *config.Config
+	AuthService         service.AuthService
+	FolderService       service.FolderService
+	DocumentService     service.DocumentService
+	DocumentTagService  service.DocumentTagService
+	SenderService       service.SenderService
+	DocumentTypeService service.DocumentTypeService
+	ClassifierService   service.ClassifierService
+	CompanyUserService  service.CompanyUserService
+	CompanyService      service.CompanyService
+	JobService          service.JobService
+	PDFService          service.PDFService
+	SearchIndexService  service.SearchIndexService
+	SavedSearchService  service.SavedSearchService
+	AnalyticsService    service.SearchAnalyticsService
+	TagRuleService      service.TagRuleService
+	Config              *config.Config
 }
 
 type Server struct {
@@ -67,24 +82,53 @@ func (s *Server) setupRoutes() {
 
 	// Регистрация маршрутов для папок
 	foldersGroup := private.Group("/folders")
-	folder.RegisterRoutes(foldersGroup, s.deps.FolderService)
+	folder.RegisterRoutes(foldersGroup, s.deps.FolderService, s.deps.SavedSearchService)
 
 	// Регистрация маршрутов для документов
 	documentsGroup := private.Group("/documents")
-	document.RegisterRoutes(documentsGroup, s.deps.DocumentService)
+	document.RegisterRoutes(documentsGroup, s.deps.DocumentService, s.deps.AnalyticsService)
 
 	// Регистрация маршрутов для тегов документов
 	documentTagsGroup := private.Group("/document-tags")
 	documenttag.RegisterRoutes(documentTagsGroup, s.deps.DocumentTagService)
 
+	// Регистрация маршрутов для правил автоматического тегирования
+	tagRulesGroup := private.Group("/tag-rules")
+	tagrule.RegisterRoutes(tagRulesGroup, s.deps.TagRuleService)
+
 	// Регистрация маршрутов для контрагентов (отправителей)
 	sendersGroup := private.Group("/senders")
 	sender.RegisterRoutes(sendersGroup, s.deps.SenderService)
 
+	// Регистрация маршрутов для типов документов
+	documentTypesGroup := private.Group("/document-types")
+	documenttype.RegisterRoutes(documentTypesGroup, s.deps.DocumentTypeService)
+
+	// Регистрация маршрутов для классификации документов по типам
+	classifierGroup := private.Group("/classifier")
+	documentclassifier.RegisterRoutes(classifierGroup, s.deps.ClassifierService)
+
 	// Регистрация маршрутов для компаний
 	companiesGroup := private.Group("/companies")
 	company.RegisterRoutes(companiesGroup, s.deps.CompanyService)
 	company_user.RegisterRoutes(companiesGroup, s.deps.CompanyUserService)
+
+	// Регистрация маршрутов для операций над PDF
+	pdfGroup := private.Group("/pdf")
+	pdf.RegisterRoutes(pdfGroup, s.deps.PDFService)
+
+	// Регистрация маршрутов для сохраненных поисков и умных папок
+	savedSearchesGroup := private.Group("/saved-searches")
+	savedsearch.RegisterRoutes(savedSearchesGroup, s.deps.SavedSearchService)
+
+	// Регистрация маршрутов для фоновых задач
+	jobsGroup := private.Group("/jobs")
+	job.RegisterRoutes(jobsGroup, s.deps.JobService)
+
+	// Административные маршруты
+	adminGroup := private.Group("/admin")
+	adminGroup.Use(s.adminMiddleware)
+	admin.RegisterRoutes(adminGroup, s.deps.SearchIndexService, s.deps.AnalyticsService)
 }
 
 func (s *Server) Listen(addr string) error {
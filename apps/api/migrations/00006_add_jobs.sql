@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- jobs (фоновые задачи)
+-- ===========================
+CREATE TABLE jobs
+(
+    id                  UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id          UUID               DEFAULT NULL,
+    type                TEXT      NOT NULL,
+    status              TEXT      NOT NULL DEFAULT 'pending',
+    payload             JSONB              DEFAULT NULL,
+    result_document_ids JSONB              DEFAULT NULL,
+    error               TEXT               DEFAULT NULL,
+    progress            INT       NOT NULL DEFAULT 0,
+    total               INT       NOT NULL DEFAULT 0,
+    created_by          UUID               DEFAULT NULL,
+    created_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+    finished_at         TIMESTAMP          DEFAULT NULL,
+
+    CONSTRAINT fk_jobs_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE,
+    CONSTRAINT fk_jobs_created_by FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_jobs_company_id ON jobs (company_id);
+CREATE INDEX idx_jobs_status ON jobs (status);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS jobs;
+-- +goose StatementEnd
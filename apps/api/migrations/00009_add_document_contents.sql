@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_contents (извлеченный текст документов)
+-- ===========================
+CREATE TABLE document_contents
+(
+    id             UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    document_id    UUID      NOT NULL,
+    text           TEXT      NOT NULL,
+    pages          JSONB              DEFAULT NULL,
+    language       TEXT               DEFAULT NULL,
+    engine         TEXT      NOT NULL,
+    engine_version TEXT               DEFAULT NULL,
+    created_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_document_contents_document FOREIGN KEY (document_id) REFERENCES documents (id) ON DELETE CASCADE,
+    CONSTRAINT uq_document_contents_document UNIQUE (document_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS document_contents;
+-- +goose StatementEnd
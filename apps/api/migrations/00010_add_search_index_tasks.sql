@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- search_index_tasks (outbox изменений поискового индекса)
+-- ===========================
+CREATE TABLE search_index_tasks
+(
+    id              UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    document_id     UUID      NOT NULL,
+    operation       TEXT      NOT NULL,
+    attempts        INTEGER   NOT NULL DEFAULT 0,
+    last_error      TEXT               DEFAULT NULL,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_search_index_tasks_next_attempt_at ON search_index_tasks (next_attempt_at);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_index_tasks;
+-- +goose StatementEnd
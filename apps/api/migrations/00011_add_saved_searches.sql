@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- saved_searches (сохраненные поиски и умные папки)
+-- ===========================
+CREATE TABLE saved_searches
+(
+    id               UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id       UUID      NOT NULL,
+    user_id          UUID      NOT NULL,
+    name             TEXT      NOT NULL,
+    query            TEXT      NOT NULL DEFAULT '',
+    filters          JSONB              DEFAULT NULL,
+    sort             TEXT      NOT NULL DEFAULT '',
+    shared           BOOLEAN   NOT NULL DEFAULT FALSE,
+    pinned           BOOLEAN   NOT NULL DEFAULT FALSE,
+    parent_folder_id UUID               DEFAULT NULL,
+    created_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_saved_searches_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE,
+    CONSTRAINT fk_saved_searches_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE,
+    CONSTRAINT fk_saved_searches_parent_folder FOREIGN KEY (parent_folder_id) REFERENCES folders (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_saved_searches_company_id ON saved_searches (company_id);
+CREATE INDEX idx_saved_searches_user_id ON saved_searches (user_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_searches;
+-- +goose StatementEnd
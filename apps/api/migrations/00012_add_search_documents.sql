@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- search_documents (поисковый индекс для search.backend: postgres)
+-- ===========================
+-- Копия документов для полнотекстового поиска, заполняется из outbox как индекс Elasticsearch,
+-- поэтому внешних ключей нет: удаленный документ убирает обработчик outbox
+CREATE TABLE search_documents
+(
+    document_id UUID PRIMARY KEY,
+    company_id  UUID        NOT NULL,
+    folder_id   UUID                 DEFAULT NULL,
+    sender_id   UUID                 DEFAULT NULL,
+    created_by  UUID                 DEFAULT NULL,
+    name        TEXT        NOT NULL,
+    mime_type   TEXT        NOT NULL,
+    file_size   BIGINT      NOT NULL DEFAULT 0,
+    tag_ids     UUID[]      NOT NULL DEFAULT '{}',
+    text        TEXT        NOT NULL DEFAULT '',
+    pages       JSONB       NOT NULL DEFAULT '[]',
+    created_at  TIMESTAMPTZ NOT NULL,
+    updated_at  TIMESTAMPTZ NOT NULL,
+    indexed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Русская и английская конфигурации: слова каждого языка приводятся к своей основе
+    name_tsv    TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('russian', name) || to_tsvector('english', name)
+        ) STORED,
+    -- Размер tsvector ограничен 1 МБ, поэтому индексируется начало очень длинных текстов
+    text_tsv    TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('russian', left(text, 500000)) || to_tsvector('english', left(text, 500000))
+        ) STORED
+);
+
+CREATE INDEX idx_search_documents_company_id ON search_documents (company_id);
+CREATE INDEX idx_search_documents_tag_ids ON search_documents USING GIN (tag_ids);
+CREATE INDEX idx_search_documents_name_tsv ON search_documents USING GIN (name_tsv);
+CREATE INDEX idx_search_documents_text_tsv ON search_documents USING GIN (text_tsv);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS search_documents;
+-- +goose StatementEnd
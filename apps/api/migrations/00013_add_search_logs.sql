@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- search_logs (аналитика поиска)
+-- ===========================
+CREATE TABLE search_logs
+(
+    id                  UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id          UUID      NOT NULL,
+    user_id             UUID               DEFAULT NULL,
+    query               TEXT               DEFAULT NULL,
+    filters             JSONB              DEFAULT NULL,
+    mode                TEXT      NOT NULL DEFAULT '',
+    sort                TEXT      NOT NULL DEFAULT '',
+    result_count        BIGINT    NOT NULL DEFAULT 0,
+    latency_ms          BIGINT    NOT NULL DEFAULT 0,
+    clicked_document_id UUID               DEFAULT NULL,
+    clicked_position    INTEGER            DEFAULT NULL,
+    clicked_at          TIMESTAMP          DEFAULT NULL,
+    clicks              INTEGER   NOT NULL DEFAULT 0,
+    created_at          TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_search_logs_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE,
+    CONSTRAINT fk_search_logs_user FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT fk_search_logs_clicked_document FOREIGN KEY (clicked_document_id) REFERENCES documents (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_search_logs_company_id_created_at ON search_logs (company_id, created_at);
+CREATE INDEX idx_search_logs_created_at ON search_logs (created_at);
+
+-- Отказ пользователя от хранения текста поисковых запросов
+ALTER TABLE users
+    ADD COLUMN store_search_queries BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN IF EXISTS store_search_queries;
+DROP TABLE IF EXISTS search_logs;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- tag_rules (правила автоматического тегирования)
+-- ===========================
+CREATE TABLE tag_rules
+(
+    id         UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id UUID      NOT NULL,
+    tag_id     UUID      NOT NULL,
+    name       TEXT      NOT NULL,
+    conditions JSONB     NOT NULL DEFAULT '{}',
+    priority   INTEGER   NOT NULL DEFAULT 0,
+    stop       BOOLEAN   NOT NULL DEFAULT FALSE,
+    enabled    BOOLEAN   NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_tag_rules_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE,
+    CONSTRAINT fk_tag_rules_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_tag_rules_company_id ON tag_rules (company_id);
+CREATE INDEX idx_tag_rules_tag_id ON tag_rules (tag_id);
+
+-- Раньше тег присваивался, если его название встречалось в тексте как подстрока
+-- Каждый существующий тег получает правило с поиском названия в любой форме слова
+INSERT INTO tag_rules (company_id, tag_id, name, conditions)
+SELECT company_id,
+       id,
+       name,
+       jsonb_build_object('keywords', jsonb_build_array(name), 'match', 'stem')
+FROM tags;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tag_rules;
+-- +goose StatementEnd
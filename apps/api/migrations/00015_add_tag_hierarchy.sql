@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- tag_groups (группы тегов)
+-- ===========================
+CREATE TABLE tag_groups
+(
+    id         UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id UUID      NOT NULL,
+    name       TEXT      NOT NULL,
+    exclusive  BOOLEAN   NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_tag_groups_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_tag_groups_company_id ON tag_groups (company_id);
+
+-- ===========================
+-- tags: дерево, группа и оформление
+-- ===========================
+ALTER TABLE tags
+    ADD COLUMN parent_id   UUID,
+    ADD COLUMN group_id    UUID,
+    ADD COLUMN color       TEXT,
+    ADD COLUMN icon        TEXT,
+    ADD COLUMN description TEXT,
+    ADD CONSTRAINT fk_tags_parent FOREIGN KEY (parent_id) REFERENCES tags (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_tags_group FOREIGN KEY (group_id) REFERENCES tag_groups (id) ON DELETE SET NULL,
+    ADD CONSTRAINT chk_tags_not_own_parent CHECK (parent_id <> id);
+
+CREATE INDEX idx_tags_parent_id ON tags (parent_id);
+CREATE INDEX idx_tags_group_id ON tags (group_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tags
+    DROP CONSTRAINT IF EXISTS chk_tags_not_own_parent,
+    DROP CONSTRAINT IF EXISTS fk_tags_group,
+    DROP CONSTRAINT IF EXISTS fk_tags_parent,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS icon,
+    DROP COLUMN IF EXISTS color,
+    DROP COLUMN IF EXISTS group_id,
+    DROP COLUMN IF EXISTS parent_id;
+
+DROP TABLE IF EXISTS tag_groups;
+-- +goose StatementEnd
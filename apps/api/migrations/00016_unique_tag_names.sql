@@ -0,0 +1,108 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_tags: время присвоения тега
+-- ===========================
+ALTER TABLE document_tags
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- Для существующих связей время присвоения неизвестно, берем время загрузки документа
+UPDATE document_tags dt
+SET created_at = d.created_at
+FROM documents d
+WHERE d.id = dt.document_id;
+
+-- ===========================
+-- tags: название уникально в компании без учета регистра
+-- ===========================
+
+-- Из тегов с одинаковым названием остается тот, у которого больше документов
+CREATE TEMPORARY TABLE tag_duplicates ON COMMIT DROP AS
+SELECT id, keep_id
+FROM (SELECT t.id,
+             first_value(t.id) OVER (
+                 PARTITION BY t.company_id, lower(t.name)
+                 ORDER BY (SELECT count(*) FROM document_tags dt WHERE dt.tag_id = t.id) DESC, t.id
+                 ) AS keep_id
+      FROM tags t) ranked
+WHERE id <> keep_id;
+
+-- Связи дубликата переносятся на оставшийся тег, если у документа его еще нет
+UPDATE document_tags dt
+SET tag_id = dup.keep_id
+FROM tag_duplicates dup
+WHERE dt.tag_id = dup.id
+  AND NOT EXISTS (SELECT 1
+                  FROM document_tags existing
+                  WHERE existing.document_id = dt.document_id
+                    AND existing.tag_id = dup.keep_id);
+
+UPDATE tag_rules r
+SET tag_id = dup.keep_id
+FROM tag_duplicates dup
+WHERE r.tag_id = dup.id;
+
+UPDATE tags t
+SET parent_id = dup.keep_id
+FROM tag_duplicates dup
+WHERE t.parent_id = dup.id
+  AND t.id <> dup.keep_id;
+
+-- Ссылки на дубликат в фильтрах сохраненных поисков заменяются на оставшийся тег без повторов
+CREATE FUNCTION pg_temp.remap_tag_ids(ids JSONB) RETURNS JSONB AS
+$$
+SELECT CASE
+           WHEN jsonb_typeof(ids) <> 'array' THEN ids
+           ELSE (SELECT coalesce(jsonb_agg(to_jsonb(remapped.id) ORDER BY remapped.pos), '[]'::jsonb)
+                 FROM (SELECT coalesce(dup.keep_id::text, e.value) AS id, min(e.pos) AS pos
+                       FROM jsonb_array_elements_text(ids) WITH ORDINALITY e(value, pos)
+                                LEFT JOIN tag_duplicates dup ON dup.id::text = e.value
+                       GROUP BY 1) remapped)
+           END
+$$ LANGUAGE sql;
+
+UPDATE saved_searches
+SET filters = filters
+    || CASE WHEN filters ? 'tags_all' THEN jsonb_build_object('tags_all', pg_temp.remap_tag_ids(filters -> 'tags_all')) ELSE '{}' END
+    || CASE WHEN filters ? 'tags_any' THEN jsonb_build_object('tags_any', pg_temp.remap_tag_ids(filters -> 'tags_any')) ELSE '{}' END
+    || CASE WHEN filters ? 'tags_none' THEN jsonb_build_object('tags_none', pg_temp.remap_tag_ids(filters -> 'tags_none')) ELSE '{}' END
+WHERE filters ?| ARRAY ['tags_all', 'tags_any', 'tags_none'];
+
+DROP FUNCTION pg_temp.remap_tag_ids(JSONB);
+
+DELETE
+FROM tags t
+    USING tag_duplicates dup
+WHERE t.id = dup.id;
+
+ALTER TABLE tags
+    DROP CONSTRAINT IF EXISTS uq_tags_company_name;
+
+CREATE UNIQUE INDEX uq_tags_company_lower_name ON tags (company_id, lower(name));
+
+-- ===========================
+-- document_tags: тег присваивается документу один раз
+-- ===========================
+DELETE
+FROM document_tags dt
+    USING document_tags other
+WHERE dt.document_id = other.document_id
+  AND dt.tag_id = other.tag_id
+  AND dt.id > other.id;
+
+CREATE UNIQUE INDEX uq_document_tags_document_tag ON document_tags (document_id, tag_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_document_tags_document_tag;
+DROP INDEX IF EXISTS uq_tags_company_lower_name;
+
+ALTER TABLE tags
+    ADD CONSTRAINT uq_tags_company_name UNIQUE (company_id, name);
+
+ALTER TABLE document_tags
+    DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_tags: кто и как присвоил тег
+-- ===========================
+-- Раньше способ присвоения не сохранялся, существующие связи считаются ручными,
+-- чтобы отмена запуска правил их не затрагивала
+ALTER TABLE document_tags
+    ADD COLUMN source     TEXT NOT NULL DEFAULT 'manual',
+    ADD COLUMN rule_id    UUID,
+    ADD COLUMN run_id     UUID,
+    ADD COLUMN created_by UUID,
+    ADD CONSTRAINT chk_document_tags_source CHECK (source IN ('manual', 'rule', 'ml', 'import')),
+    ADD CONSTRAINT fk_document_tags_rule FOREIGN KEY (rule_id) REFERENCES tag_rules (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_document_tags_created_by FOREIGN KEY (created_by) REFERENCES users (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_document_tags_run_id ON document_tags (run_id);
+
+-- ===========================
+-- document_tag_suppressions (теги, снятые пользователем)
+-- ===========================
+CREATE TABLE document_tag_suppressions
+(
+    id          UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    document_id UUID      NOT NULL,
+    tag_id      UUID      NOT NULL,
+    removed_by  UUID,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_document_tag_suppressions_document FOREIGN KEY (document_id) REFERENCES documents (id) ON DELETE CASCADE,
+    CONSTRAINT fk_document_tag_suppressions_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE,
+    CONSTRAINT fk_document_tag_suppressions_removed_by FOREIGN KEY (removed_by) REFERENCES users (id) ON DELETE SET NULL,
+    CONSTRAINT uq_document_tag_suppressions_document_tag UNIQUE (document_id, tag_id)
+);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS document_tag_suppressions;
+
+DROP INDEX IF EXISTS idx_document_tags_run_id;
+
+ALTER TABLE document_tags
+    DROP CONSTRAINT IF EXISTS fk_document_tags_created_by,
+    DROP CONSTRAINT IF EXISTS fk_document_tags_rule,
+    DROP CONSTRAINT IF EXISTS chk_document_tags_source,
+    DROP COLUMN IF EXISTS created_by,
+    DROP COLUMN IF EXISTS run_id,
+    DROP COLUMN IF EXISTS rule_id,
+    DROP COLUMN IF EXISTS source;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- senders: карточка контрагента
+-- ===========================
+ALTER TABLE senders
+    ADD COLUMN legal_name TEXT,
+    ADD COLUMN inn        TEXT,
+    ADD COLUMN kpp        TEXT,
+    ADD COLUMN emails     JSONB,
+    ADD COLUMN phones     JSONB,
+    ADD COLUMN addresses  JSONB,
+    ADD COLUMN website    TEXT,
+    ADD COLUMN notes      TEXT,
+    ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD CONSTRAINT chk_senders_inn CHECK (inn ~ '^([0-9]{10}|[0-9]{12})$'),
+    ADD CONSTRAINT chk_senders_kpp CHECK (kpp ~ '^[0-9]{4}[0-9A-Z]{2}[0-9]{3}$');
+
+-- Поиск дублей по ИНН и поиск по названию в справочнике компании
+CREATE INDEX idx_senders_company_inn ON senders (company_id, inn);
+CREATE INDEX idx_senders_company_lower_name ON senders (company_id, lower(name));
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_senders_company_lower_name;
+DROP INDEX IF EXISTS idx_senders_company_inn;
+
+ALTER TABLE senders
+    DROP CONSTRAINT IF EXISTS chk_senders_kpp,
+    DROP CONSTRAINT IF EXISTS chk_senders_inn,
+    DROP COLUMN IF EXISTS updated_at,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS notes,
+    DROP COLUMN IF EXISTS website,
+    DROP COLUMN IF EXISTS addresses,
+    DROP COLUMN IF EXISTS phones,
+    DROP COLUMN IF EXISTS emails,
+    DROP COLUMN IF EXISTS kpp,
+    DROP COLUMN IF EXISTS inn,
+    DROP COLUMN IF EXISTS legal_name;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- documents: лента документов контрагента
+-- ===========================
+
+-- Индекс по контрагенту заменяется составным: лента выбирает документы контрагента по дате создания
+CREATE INDEX idx_documents_sender_created_at ON documents (sender_id, created_at);
+DROP INDEX IF EXISTS idx_documents_sender_id;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_documents_sender_id ON documents (sender_id);
+DROP INDEX IF EXISTS idx_documents_sender_created_at;
+-- +goose StatementEnd
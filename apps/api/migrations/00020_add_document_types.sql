@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_types: типы документов компании со схемой полей
+-- ===========================
+CREATE TABLE document_types
+(
+    id          UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id  UUID      NOT NULL,
+    name        TEXT      NOT NULL,
+    description TEXT               DEFAULT NULL,
+    fields      JSONB     NOT NULL DEFAULT '[]'::jsonb,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_document_types_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_document_types_company_id ON document_types (company_id);
+
+-- ===========================
+-- documents: тип документа и значения полей
+-- ===========================
+ALTER TABLE documents
+    ADD COLUMN type_id      UUID  DEFAULT NULL,
+    ADD COLUMN field_values JSONB DEFAULT NULL,
+    ADD CONSTRAINT fk_documents_type FOREIGN KEY (type_id) REFERENCES document_types (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_documents_type_id ON documents (type_id);
+
+-- ===========================
+-- search_documents: тип и значения полей для фильтрации и сортировки
+-- ===========================
+ALTER TABLE search_documents
+    ADD COLUMN type_id UUID  DEFAULT NULL,
+    ADD COLUMN fields  JSONB DEFAULT NULL;
+
+CREATE INDEX idx_search_documents_type_id ON search_documents (type_id);
+CREATE INDEX idx_search_documents_fields ON search_documents USING GIN (fields);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_search_documents_fields;
+DROP INDEX IF EXISTS idx_search_documents_type_id;
+
+ALTER TABLE search_documents
+    DROP COLUMN IF EXISTS fields,
+    DROP COLUMN IF EXISTS type_id;
+
+DROP INDEX IF EXISTS idx_documents_type_id;
+
+ALTER TABLE documents
+    DROP CONSTRAINT IF EXISTS fk_documents_type,
+    DROP COLUMN IF EXISTS field_values,
+    DROP COLUMN IF EXISTS type_id;
+
+DROP TABLE IF EXISTS document_types;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_types: шаблон извлечения значений полей из текста
+-- ===========================
+ALTER TABLE document_types
+    ADD COLUMN extraction JSONB DEFAULT NULL;
+
+-- ===========================
+-- documents: очередь проверки извлеченных значений с низкой уверенностью
+-- ===========================
+CREATE INDEX idx_documents_field_extraction_pending ON documents (company_id, created_at DESC)
+    WHERE (metadata -> 'field_extraction' ->> 'pending')::int > 0;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_documents_field_extraction_pending;
+
+ALTER TABLE document_types
+    DROP COLUMN IF EXISTS extraction;
+-- +goose StatementEnd
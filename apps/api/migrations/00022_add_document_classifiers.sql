@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- ===========================
+-- document_classifiers: модель классификации документов по типам, одна на компанию
+-- ===========================
+CREATE TABLE document_classifiers
+(
+    id         UUID PRIMARY KEY   DEFAULT uuid_generate_v4(),
+    company_id UUID      NOT NULL,
+    model      JSONB     NOT NULL,
+    evaluation JSONB     NOT NULL,
+    documents  INTEGER   NOT NULL DEFAULT 0,
+    trained_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_document_classifiers_company FOREIGN KEY (company_id) REFERENCES companies (id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_document_classifiers_company_id ON document_classifiers (company_id);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS document_classifiers;
+-- +goose StatementEnd
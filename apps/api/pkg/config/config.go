@@ -24,13 +24,52 @@ type Config struct {
 		Username string `yaml:"username" mapstructure:"username"`
 		Password string `yaml:"password" mapstructure:"password"`
 	} `yaml:"elasticsearch" mapstructure:"elasticsearch"`
+	Search struct {
+		Backend   string `yaml:"backend" mapstructure:"backend"` // elasticsearch (по умолчанию) или postgres
+		Analytics struct {
+			// RetentionDays - срок хранения записей о поисках в днях, 0 - 90 дней
+			RetentionDays int `yaml:"retention_days" mapstructure:"retention_days"`
+			// QueryRetentionDays - срок хранения текста запросов, после него остаются только показатели;
+			// 0 - как у записей
+			QueryRetentionDays int `yaml:"query_retention_days" mapstructure:"query_retention_days"`
+		} `yaml:"analytics" mapstructure:"analytics"`
+	} `yaml:"search" mapstructure:"search"`
 	Gotenberg struct {
 		URL     string `yaml:"url" mapstructure:"url"`
 		Enabled bool   `yaml:"enabled" mapstructure:"enabled"`
 		Timeout int    `yaml:"timeout" mapstructure:"timeout"` // in seconds
 	} `yaml:"gotenberg" mapstructure:"gotenberg"`
+	OCR struct {
+		Enabled       bool   `yaml:"enabled" mapstructure:"enabled"`
+		TesseractPath string `yaml:"tesseract_path" mapstructure:"tesseract_path"`
+		PdftoppmPath  string `yaml:"pdftoppm_path" mapstructure:"pdftoppm_path"`
+		Languages     string `yaml:"languages" mapstructure:"languages"` // например rus+eng
+		DPI           int    `yaml:"dpi" mapstructure:"dpi"`
+		SearchablePDF bool   `yaml:"searchable_pdf" mapstructure:"searchable_pdf"` // сохранять PDF с текстовым слоем
+	} `yaml:"ocr" mapstructure:"ocr"`
+	Embedding struct {
+		Enabled  bool   `yaml:"enabled" mapstructure:"enabled"`
+		Provider string `yaml:"provider" mapstructure:"provider"` // hashing или http
+		URL      string `yaml:"url" mapstructure:"url"`           // адрес сервера с OpenAI-совместимым /v1/embeddings
+		Model    string `yaml:"model" mapstructure:"model"`
+		APIKey   string `yaml:"api_key" mapstructure:"api_key"`
+		Timeout  int    `yaml:"timeout" mapstructure:"timeout"` // in seconds
+		// Dimensions - размерность векторов провайдера hashing
+		Dimensions int `yaml:"dimensions" mapstructure:"dimensions"`
+		// ChunkSize и ChunkOverlap - размер фрагмента текста и перекрытие соседних фрагментов в словах
+		ChunkSize    int `yaml:"chunk_size" mapstructure:"chunk_size"`
+		ChunkOverlap int `yaml:"chunk_overlap" mapstructure:"chunk_overlap"`
+		MaxChunks    int `yaml:"max_chunks" mapstructure:"max_chunks"` // ограничение числа фрагментов документа
+		// SemanticWeight - вес близости векторов относительно BM25 в гибридном поиске
+		SemanticWeight float64 `yaml:"semantic_weight" mapstructure:"semantic_weight"`
+	} `yaml:"embedding" mapstructure:"embedding"`
 	HTTPPort int `yaml:"http_port" mapstructure:"http_port"`
 
+	Admin struct {
+		// UserIDs - пользователи с доступом к административным маршрутам
+		UserIDs []string `yaml:"user_ids" mapstructure:"user_ids"`
+	} `yaml:"admin" mapstructure:"admin"`
+
 	JWT struct {
 		SecretKey            string `yaml:"secret_key" mapstructure:"secret_key"`
 		AccessTokenLifetime  string `yaml:"access_token_lifetime" mapstructure:"access_token_lifetime"`
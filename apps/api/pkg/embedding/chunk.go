@@ -0,0 +1,50 @@
+package embedding
+
+import "strings"
+
+// Section - часть текста документа, например страница
+type Section struct {
+	Number int
+	Text   string
+}
+
+// Chunk - фрагмент текста, для которого вычисляется один вектор
+type Chunk struct {
+	// Section - номер части текста, из которой взят фрагмент
+	Section int
+	Text    string
+}
+
+// Split делит части текста на фрагменты не длиннее size слов,
+// соседние фрагменты одной части пересекаются на overlap слов.
+// Фрагменты не переходят границу частей, чтобы совпадение указывало на конкретную страницу
+func Split(sections []Section, size, overlap, limit int) []Chunk {
+	if size <= 0 {
+		size = 200
+	}
+	if overlap < 0 || overlap >= size {
+		overlap = 0
+	}
+
+	var chunks []Chunk
+	for _, section := range sections {
+		words := strings.Fields(section.Text)
+		for start := 0; start < len(words); start += size - overlap {
+			if limit > 0 && len(chunks) == limit {
+				return chunks
+			}
+			end := start + size
+			if end > len(words) {
+				end = len(words)
+			}
+			chunks = append(chunks, Chunk{
+				Section: section.Number,
+				Text:    strings.Join(words[start:end], " "),
+			})
+			if end == len(words) {
+				break
+			}
+		}
+	}
+	return chunks
+}
@@ -0,0 +1,12 @@
+package embedding
+
+import "context"
+
+// Provider вычисляет векторные представления текста для семантического поиска
+type Provider interface {
+	// Name возвращает название провайдера и модели
+	Name() string
+	// Embed возвращает по вектору на каждый текст в том же порядке
+	// Векторы одного провайдера всегда имеют одинаковую размерность
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
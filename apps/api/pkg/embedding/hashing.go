@@ -0,0 +1,70 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Hashing строит векторы хешированием слов текста (feature hashing).
+// Не понимает смысл слов, зато детерминирован и не требует модели,
+// поэтому подходит для тестов и окружений без сервера эмбеддингов
+type Hashing struct {
+	dimensions int
+}
+
+// NewHashing создает провайдер с векторами заданной размерности, по умолчанию 256
+func NewHashing(dimensions int) *Hashing {
+	if dimensions <= 0 {
+		dimensions = 256
+	}
+	return &Hashing{dimensions: dimensions}
+}
+
+func (h *Hashing) Name() string {
+	return "hashing"
+}
+
+func (h *Hashing) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, h.vector(text))
+	}
+	return vectors, nil
+}
+
+// vector раскладывает слова текста по координатам с учетом знака и нормирует результат
+func (h *Hashing) vector(text string) []float32 {
+	vector := make([]float32, h.dimensions)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		hash := fnv.New64a()
+		hash.Write([]byte(word))
+		sum := hash.Sum64()
+
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(h.dimensions)] += sign
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	// Нулевой вектор нельзя сравнивать по косинусу, текст без слов получает единичный вектор
+	if norm == 0 {
+		vector[0] = 1
+		return vector
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] = float32(float64(vector[i]) / norm)
+	}
+	return vector
+}
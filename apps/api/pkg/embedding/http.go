@@ -0,0 +1,152 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTP вычисляет векторы на собственном сервере модели с OpenAI-совместимым API
+// POST {url}/v1/embeddings, такой API есть у text-embeddings-inference, Ollama, vLLM и LocalAI
+type HTTP struct {
+	url       string
+	model     string
+	apiKey    string
+	batchSize int
+	client    *http.Client
+}
+
+// HTTPOption настраивает HTTP провайдер
+type HTTPOption func(*HTTP)
+
+// WithModel задает название модели в запросе
+func WithModel(model string) HTTPOption {
+	return func(h *HTTP) {
+		if model != "" {
+			h.model = model
+		}
+	}
+}
+
+// WithAPIKey задает ключ, передаваемый в заголовке Authorization
+func WithAPIKey(apiKey string) HTTPOption {
+	return func(h *HTTP) {
+		h.apiKey = apiKey
+	}
+}
+
+// WithTimeout задает таймаут одного запроса
+func WithTimeout(timeout time.Duration) HTTPOption {
+	return func(h *HTTP) {
+		if timeout > 0 {
+			h.client.Timeout = timeout
+		}
+	}
+}
+
+// WithBatchSize задает максимальное число текстов в одном запросе
+func WithBatchSize(batchSize int) HTTPOption {
+	return func(h *HTTP) {
+		if batchSize > 0 {
+			h.batchSize = batchSize
+		}
+	}
+}
+
+// NewHTTP создает провайдер для сервера по адресу url
+func NewHTTP(url string, opts ...HTTPOption) *HTTP {
+	h := &HTTP{
+		url:       strings.TrimRight(url, "/"),
+		batchSize: 32,
+		client:    &http.Client{Timeout: 60 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *HTTP) Name() string {
+	if h.model == "" {
+		return "http"
+	}
+	return "http:" + h.model
+}
+
+func (h *HTTP) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += h.batchSize {
+		end := start + h.batchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		batch, err := h.embedBatch(ctx, texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, batch...)
+	}
+	return vectors, nil
+}
+
+// embedBatch отправляет один запрос к серверу модели
+func (h *HTTP) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	payload := map[string]interface{}{
+		"input": texts,
+	}
+	if h.model != "" {
+		payload["model"] = h.model
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal embedding request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url+"/v1/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embedding request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+h.apiKey)
+	}
+
+	res, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embedding request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		return nil, fmt.Errorf("embedding server returned %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	var response struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to parse embedding response: %w", err)
+	}
+	if len(response.Data) != len(texts) {
+		return nil, fmt.Errorf("embedding server returned %d vectors for %d texts", len(response.Data), len(texts))
+	}
+
+	// Сервер может вернуть векторы не по порядку, порядок задает index
+	vectors := make([][]float32, len(texts))
+	for _, item := range response.Data {
+		if item.Index < 0 || item.Index >= len(texts) || vectors[item.Index] != nil {
+			return nil, fmt.Errorf("embedding server returned invalid index %d", item.Index)
+		}
+		vectors[item.Index] = item.Embedding
+	}
+	return vectors, nil
+}
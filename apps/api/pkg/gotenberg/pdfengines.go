@@ -339,6 +339,89 @@ func (c *Client) EmbedFilesInPDF(ctx context.Context, pdfFiles []File, embedFile
 	return c.doRequest(ctx, "POST", "/forms/pdfengines/embed", body, writer.FormDataContentType(), headers)
 }
 
+// OverlayRequest представляет запрос на наложение водяного знака или штампа
+type OverlayRequest struct {
+	// Source - тип источника: "text", "image" или "pdf"
+	Source string
+	// Expression - текст либо имя файла из Asset для image/pdf
+	// В тексте поддерживаются подстановки pdfcpu: %p - номер страницы, %P - число страниц
+	Expression string
+	// Pages - диапазон страниц (например "1-3,5"), пусто - все страницы
+	Pages string
+	// Options - параметры pdfcpu (opacity, rotation, points, color, position, offset и т.д.)
+	Options map[string]interface{}
+	// Asset - изображение или PDF для source image/pdf
+	Asset *File
+
+	// Output Filename
+	OutputFilename string
+}
+
+// WatermarkPDFs накладывает водяной знак под содержимое страниц
+// POST /forms/pdfengines/watermark
+func (c *Client) WatermarkPDFs(ctx context.Context, files []File, req *OverlayRequest) (*Response, error) {
+	return c.overlayPDFs(ctx, "/forms/pdfengines/watermark", "watermark", files, req)
+}
+
+// StampPDFs накладывает штамп поверх содержимого страниц
+// POST /forms/pdfengines/stamp
+func (c *Client) StampPDFs(ctx context.Context, files []File, req *OverlayRequest) (*Response, error) {
+	return c.overlayPDFs(ctx, "/forms/pdfengines/stamp", "stamp", files, req)
+}
+
+// overlayPDFs выполняет запрос watermark/stamp, поля формы отличаются только префиксом
+func (c *Client) overlayPDFs(ctx context.Context, path, prefix string, files []File, req *OverlayRequest) (*Response, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("at least one PDF file is required")
+	}
+
+	if req == nil || req.Source == "" || req.Expression == "" {
+		return nil, fmt.Errorf("%s source and expression are required", prefix)
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	// Добавляем файлы
+	if err := addFilesToWriter(writer, files, "files"); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{
+		prefix + "Source":     req.Source,
+		prefix + "Expression": req.Expression,
+		prefix + "Pages":      req.Pages,
+	}
+	if len(req.Options) > 0 {
+		optionsJSON, err := marshalJSONField(req.Options)
+		if err != nil {
+			return nil, err
+		}
+		fields[prefix+"Options"] = optionsJSON
+	}
+	if err := addFieldsToWriter(writer, fields); err != nil {
+		return nil, err
+	}
+
+	// Изображение или PDF для наложения передается отдельным файлом
+	if req.Asset != nil {
+		if err := addFilesToWriter(writer, []File{*req.Asset}, prefix); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
+	}
+
+	headers := make(map[string]string)
+	if req.OutputFilename != "" {
+		headers["Gotenberg-Output-Filename"] = req.OutputFilename
+	}
+
+	return c.doRequest(ctx, "POST", path, body, writer.FormDataContentType(), headers)
+}
+
 // addPDFEnginesFields добавляет общие поля PDF Engines в multipart writer
 func addPDFEnginesFields(writer *multipart.Writer, req *PDFEnginesRequest) error {
 	if req == nil {
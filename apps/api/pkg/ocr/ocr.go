@@ -0,0 +1,57 @@
+package ocr
+
+import "context"
+
+// Engine распознает текст на отсканированных документах
+type Engine interface {
+	// Name возвращает название движка распознавания
+	Name() string
+	// Version возвращает версию движка
+	Version(ctx context.Context) (string, error)
+	// Recognize распознает текст изображения или PDF и возвращает его постранично
+	Recognize(ctx context.Context, input Input) (*Result, error)
+}
+
+// Input описывает файл для распознавания
+type Input struct {
+	Content  []byte
+	MimeType string
+	// SearchablePDF - дополнительно построить PDF с текстовым слоем
+	SearchablePDF bool
+}
+
+// Page содержит распознанный текст одной страницы
+type Page struct {
+	Number int
+	Text   string
+	// Confidence - средняя уверенность распознавания слов страницы, от 0 до 100
+	Confidence float64
+}
+
+// Result содержит результат распознавания
+type Result struct {
+	Pages []Page
+	// Confidence - средняя уверенность по всем словам документа, от 0 до 100
+	Confidence float64
+	Languages  string
+	// SearchablePDF - PDF с текстовым слоем, если он был запрошен
+	SearchablePDF []byte
+}
+
+// Text возвращает текст всех страниц, разделенный символом перевода страницы
+func (r *Result) Text() string {
+	texts := make([]string, 0, len(r.Pages))
+	for _, page := range r.Pages {
+		texts = append(texts, page.Text)
+	}
+	return joinPages(texts)
+}
+
+// IsSupported проверяет, может ли OCR обработать файл данного типа
+func IsSupported(mimeType string) bool {
+	switch mimeType {
+	case "application/pdf", "image/jpeg", "image/png", "image/tiff":
+		return true
+	}
+	return false
+}
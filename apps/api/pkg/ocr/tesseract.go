@@ -0,0 +1,191 @@
+package ocr
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Tesseract распознает текст локальным бинарником tesseract.
+// PDF предварительно растеризуется в PNG с помощью pdftoppm (poppler-utils)
+type Tesseract struct {
+	binary    string
+	pdftoppm  string
+	languages string
+	dpi       int
+}
+
+// TesseractOption настраивает Tesseract
+type TesseractOption func(*Tesseract)
+
+// WithBinary задает путь к бинарнику tesseract
+func WithBinary(path string) TesseractOption {
+	return func(t *Tesseract) {
+		if path != "" {
+			t.binary = path
+		}
+	}
+}
+
+// WithPdftoppm задает путь к бинарнику pdftoppm
+func WithPdftoppm(path string) TesseractOption {
+	return func(t *Tesseract) {
+		if path != "" {
+			t.pdftoppm = path
+		}
+	}
+}
+
+// WithLanguages задает языковые пакеты в формате tesseract, например "rus+eng"
+func WithLanguages(languages string) TesseractOption {
+	return func(t *Tesseract) {
+		if languages != "" {
+			t.languages = languages
+		}
+	}
+}
+
+// WithDPI задает разрешение растеризации PDF
+func WithDPI(dpi int) TesseractOption {
+	return func(t *Tesseract) {
+		if dpi > 0 {
+			t.dpi = dpi
+		}
+	}
+}
+
+// NewTesseract создает движок Tesseract, по умолчанию с русским и английским языками
+func NewTesseract(opts ...TesseractOption) *Tesseract {
+	t := &Tesseract{
+		binary:    "tesseract",
+		pdftoppm:  "pdftoppm",
+		languages: "rus+eng",
+		dpi:       300,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+func (t *Tesseract) Name() string {
+	return "tesseract"
+}
+
+// Version возвращает версию из первой строки tesseract --version
+func (t *Tesseract) Version(ctx context.Context) (string, error) {
+	out, err := exec.CommandContext(ctx, t.binary, "--version").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to get tesseract version: %w", err)
+	}
+	line := strings.SplitN(strings.TrimSpace(string(out)), "\n", 2)[0]
+	return strings.TrimSpace(strings.TrimPrefix(line, "tesseract")), nil
+}
+
+func (t *Tesseract) Recognize(ctx context.Context, input Input) (*Result, error) {
+	if !IsSupported(input.MimeType) {
+		return nil, fmt.Errorf("ocr is not supported for %s", input.MimeType)
+	}
+
+	dir, err := os.MkdirTemp("", "ocr-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	images, err := t.prepareImages(ctx, dir, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Список изображений позволяет распознать все страницы одним запуском,
+	// номера страниц в TSV соответствуют порядку строк списка
+	listPath := filepath.Join(dir, "pages.txt")
+	if err := os.WriteFile(listPath, []byte(strings.Join(images, "\n")+"\n"), 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write page list: %w", err)
+	}
+
+	outputBase := filepath.Join(dir, "output")
+	args := []string{listPath, outputBase, "-l", t.languages, "tsv"}
+	if input.SearchablePDF {
+		args = append(args, "pdf")
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.binary, args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("tesseract failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	tsv, err := os.ReadFile(outputBase + ".tsv")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tesseract output: %w", err)
+	}
+
+	result := parseTSV(tsv)
+	result.Languages = t.languages
+
+	if input.SearchablePDF {
+		result.SearchablePDF, err = os.ReadFile(outputBase + ".pdf")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read searchable pdf: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// prepareImages сохраняет входной файл во временную папку и возвращает пути изображений страниц
+func (t *Tesseract) prepareImages(ctx context.Context, dir string, input Input) ([]string, error) {
+	if input.MimeType != "application/pdf" {
+		// Изображения (в том числе многостраничный TIFF) tesseract читает напрямую
+		path := filepath.Join(dir, "input"+imageExtension(input.MimeType))
+		if err := os.WriteFile(path, input.Content, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to write image: %w", err)
+		}
+		return []string{path}, nil
+	}
+
+	pdfPath := filepath.Join(dir, "input.pdf")
+	if err := os.WriteFile(pdfPath, input.Content, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write pdf: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.pdftoppm, "-r", strconv.Itoa(t.dpi), "-png", pdfPath, filepath.Join(dir, "page"))
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pdftoppm failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	// pdftoppm дополняет номера страниц нулями до одинаковой ширины, поэтому сортировка по имени сохраняет порядок
+	images, err := filepath.Glob(filepath.Join(dir, "page-*.png"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rasterized pages: %w", err)
+	}
+	if len(images) == 0 {
+		return nil, fmt.Errorf("pdf has no pages")
+	}
+	sort.Strings(images)
+	return images, nil
+}
+
+// imageExtension возвращает расширение файла, по которому leptonica определит формат
+func imageExtension(mimeType string) string {
+	switch mimeType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/tiff":
+		return ".tif"
+	}
+	return ""
+}
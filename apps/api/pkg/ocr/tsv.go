@@ -0,0 +1,118 @@
+package ocr
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Колонки TSV вывода tesseract:
+// level page_num block_num par_num line_num word_num left top width height conf text
+const (
+	tsvLevel = iota
+	tsvPage
+	tsvBlock
+	tsvParagraph
+	tsvLine
+	tsvWord
+	tsvConfidence = 10
+	tsvText       = 11
+)
+
+// wordLevel - уровень строки TSV, соответствующий отдельному слову
+const wordLevel = 5
+
+// pageBreak разделяет страницы в общем тексте документа, как это делает pdftotext
+const pageBreak = "\f"
+
+// parseTSV собирает текст страниц из TSV вывода tesseract и считает среднюю уверенность
+func parseTSV(data []byte) *Result {
+	type pageState struct {
+		text       strings.Builder
+		confSum    float64
+		words      int
+		block, par int
+		line       int
+		started    bool
+	}
+
+	var pages []*pageState
+	var totalConf float64
+	var totalWords int
+
+	for i, row := range strings.Split(string(data), "\n") {
+		if i == 0 || row == "" {
+			continue // заголовок
+		}
+		cols := strings.Split(strings.TrimRight(row, "\r"), "\t")
+		if len(cols) <= tsvText {
+			continue
+		}
+		if level, _ := strconv.Atoi(cols[tsvLevel]); level != wordLevel {
+			continue
+		}
+		word := strings.TrimSpace(cols[tsvText])
+		if word == "" {
+			continue
+		}
+
+		number, _ := strconv.Atoi(cols[tsvPage])
+		for len(pages) < number {
+			pages = append(pages, &pageState{})
+		}
+		if number < 1 {
+			continue
+		}
+		page := pages[number-1]
+
+		block, _ := strconv.Atoi(cols[tsvBlock])
+		par, _ := strconv.Atoi(cols[tsvParagraph])
+		line, _ := strconv.Atoi(cols[tsvLine])
+		switch {
+		case !page.started:
+			page.started = true
+		case block != page.block || par != page.par:
+			page.text.WriteString("\n\n")
+		case line != page.line:
+			page.text.WriteString("\n")
+		default:
+			page.text.WriteString(" ")
+		}
+		page.block, page.par, page.line = block, par, line
+		page.text.WriteString(word)
+
+		// Отрицательная уверенность означает, что tesseract ее не посчитал
+		if conf, err := strconv.ParseFloat(cols[tsvConfidence], 64); err == nil && conf >= 0 {
+			page.confSum += conf
+			page.words++
+			totalConf += conf
+			totalWords++
+		}
+	}
+
+	result := &Result{Pages: make([]Page, 0, len(pages))}
+	for i, page := range pages {
+		p := Page{Number: i + 1, Text: page.text.String()}
+		if page.words > 0 {
+			p.Confidence = page.confSum / float64(page.words)
+		}
+		result.Pages = append(result.Pages, p)
+	}
+	if totalWords > 0 {
+		result.Confidence = totalConf / float64(totalWords)
+	}
+	return result
+}
+
+// joinPages объединяет тексты страниц через разделитель страниц
+func joinPages(pages []string) string {
+	return strings.Join(pages, pageBreak)
+}
+
+// SplitPages разбивает текст на страницы по символу перевода страницы
+func SplitPages(text string) []string {
+	pages := strings.Split(text, pageBreak)
+	for len(pages) > 0 && strings.TrimSpace(pages[len(pages)-1]) == "" {
+		pages = pages[:len(pages)-1]
+	}
+	return pages
+}
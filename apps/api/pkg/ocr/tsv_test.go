@@ -0,0 +1,61 @@
+package ocr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// tsvRows собирает TSV вывод tesseract из строк с колонками через пробел
+func tsvRows(rows ...string) []byte {
+	lines := []string{"level\tpage_num\tblock_num\tpar_num\tline_num\tword_num\tleft\ttop\twidth\theight\tconf\ttext"}
+	for _, row := range rows {
+		lines = append(lines, strings.ReplaceAll(row, " ", "\t"))
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\n")
+}
+
+func TestParseTSV(t *testing.T) {
+	data := tsvRows(
+		"1 1 0 0 0 0 0 0 2480 3508 -1 ",
+		"2 1 1 0 0 0 10 10 500 100 -1 ",
+		"5 1 1 1 1 1 10 10 50 20 90 Счет",
+		"5 1 1 1 1 2 70 10 50 20 80 №",
+		"5 1 1 1 1 3 130 10 50 20 70 123",
+		"5 1 1 1 2 1 10 40 50 20 60 от",
+		"5 1 1 1 2 2 70 40 50 20 -1 05.03.2024",
+		"5 1 1 2 1 1 10 80 50 20 100 Итого",
+		"5 1 1 2 1 2 10 80 50 20 95  ",
+		"5 3 1 1 1 1 10 10 50 20 50 Подпись",
+		"4 3 1 1 1 0 10 10 50 20 -1 строка",
+		"5 0 1 1 1 1 10 10 50 20 10 вне",
+	)
+
+	got := parseTSV(data)
+	want := &Result{
+		Pages: []Page{
+			{Number: 1, Text: "Счет № 123\nот 05.03.2024\n\nИтого", Confidence: 80},
+			{Number: 2},
+			{Number: 3, Text: "Подпись", Confidence: 50},
+		},
+		Confidence: 75,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseTSV() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseTSV_Empty(t *testing.T) {
+	got := parseTSV(tsvRows())
+	if len(got.Pages) != 0 || got.Confidence != 0 {
+		t.Fatalf("expected empty result, got %+v", got)
+	}
+}
+
+func TestSplitPages(t *testing.T) {
+	text := joinPages([]string{"первая", "", "третья", " \n"})
+	want := []string{"первая", "", "третья"}
+	if got := SplitPages(text); !reflect.DeepEqual(got, want) {
+		t.Fatalf("SplitPages() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,81 @@
+package stemmer
+
+import "strings"
+
+func isEnglishVowel(b byte) bool {
+	switch b {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	}
+	return false
+}
+
+func hasEnglishVowel(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if isEnglishVowel(s[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// stemEnglish реализует шаги 1a-1c алгоритма Porter2: множественное число, -ed, -ing и конечную y
+// Словообразовательные суффиксы (-ness, -ation...) не удаляются: для поиска по ключевым словам
+// достаточно совпадения форм одного слова
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+
+	// Шаг 1a: окончания множественного числа
+	switch {
+	case strings.HasSuffix(word, "sses"):
+		word = word[:len(word)-2]
+	case strings.HasSuffix(word, "ied"), strings.HasSuffix(word, "ies"):
+		if len(word) > 4 {
+			word = word[:len(word)-2]
+		} else {
+			word = word[:len(word)-1]
+		}
+	case strings.HasSuffix(word, "us"), strings.HasSuffix(word, "ss"):
+	case strings.HasSuffix(word, "s"):
+		if hasEnglishVowel(word[:len(word)-2]) {
+			word = word[:len(word)-1]
+		}
+	}
+
+	// Шаг 1b: -eed, -ed и -ing
+	switch {
+	case strings.HasSuffix(word, "eedly"):
+		word = word[:len(word)-3]
+	case strings.HasSuffix(word, "eed"):
+		if len(word) > 4 {
+			word = word[:len(word)-1]
+		}
+	default:
+		for _, suffix := range []string{"ingly", "edly", "ing", "ed"} {
+			if !strings.HasSuffix(word, suffix) {
+				continue
+			}
+			stem := word[:len(word)-len(suffix)]
+			if !hasEnglishVowel(stem) {
+				break
+			}
+			word = stem
+			switch {
+			case strings.HasSuffix(word, "at"), strings.HasSuffix(word, "bl"), strings.HasSuffix(word, "iz"):
+				word += "e"
+			case len(word) >= 2 && word[len(word)-1] == word[len(word)-2] && strings.IndexByte("bdfgmnprt", word[len(word)-1]) >= 0:
+				word = word[:len(word)-1]
+			}
+			break
+		}
+	}
+
+	// Шаг 1c: конечная y после согласной заменяется на i
+	if n := len(word); n > 2 && word[n-1] == 'y' && !isEnglishVowel(word[n-2]) {
+		word = word[:n-1] + "i"
+	}
+
+	return word
+}
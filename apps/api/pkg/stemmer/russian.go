@@ -0,0 +1,145 @@
+package stemmer
+
+// Окончания алгоритма Snowball для русского языка
+// Окончания первой группы удаляются, только если перед ними стоит а или я
+var (
+	perfectiveGerund1 = []string{"в", "вши", "вшись"}
+	perfectiveGerund2 = []string{"ив", "ивши", "ившись", "ыв", "ывши", "ывшись"}
+	adjective         = []string{
+		"ее", "ие", "ые", "ое", "ими", "ыми", "ей", "ий", "ый", "ой", "ем", "им", "ым", "ом",
+		"его", "ого", "ему", "ому", "их", "ых", "ую", "юю", "ая", "яя", "ою", "ею",
+	}
+	participle1 = []string{"ем", "нн", "вш", "ющ", "щ"}
+	participle2 = []string{"ивш", "ывш", "ующ"}
+	reflexive   = []string{"ся", "сь"}
+	verb1       = []string{
+		"ла", "на", "ете", "йте", "ли", "й", "л", "ем", "н", "ло", "но", "ет", "ют", "ны", "ть", "ешь", "нно",
+	}
+	verb2 = []string{
+		"ила", "ыла", "ена", "ейте", "уйте", "ите", "или", "ыли", "ей", "уй", "ил", "ыл", "им", "ым", "ен",
+		"ило", "ыло", "ено", "ят", "ует", "уют", "ит", "ыт", "ены", "ить", "ыть", "ишь", "ую", "ю",
+	}
+	noun = []string{
+		"а", "ев", "ов", "ие", "ье", "е", "иями", "ями", "ами", "еи", "ии", "и", "ией", "ей", "ой", "ий", "й",
+		"иям", "ям", "ием", "ем", "ам", "ом", "о", "у", "ах", "иях", "ях", "ы", "ь", "ию", "ью", "ю", "ия", "ья", "я",
+	}
+	superlative  = []string{"ейш", "ейше"}
+	derivational = []string{"ост", "ость"}
+)
+
+func isRussianVowel(r rune) bool {
+	switch r {
+	case 'а', 'е', 'и', 'о', 'у', 'ы', 'э', 'ю', 'я':
+		return true
+	}
+	return false
+}
+
+// russianRegions вычисляет начало областей RV (после первой гласной) и R2
+// (после второго сочетания гласной с согласной)
+func russianRegions(word []rune) (rv, r2 int) {
+	rv, r1, r2 := len(word), len(word), len(word)
+	for i, r := range word {
+		if isRussianVowel(r) {
+			rv = i + 1
+			break
+		}
+	}
+	for i := 1; i < len(word); i++ {
+		if !isRussianVowel(word[i]) && isRussianVowel(word[i-1]) {
+			r1 = i + 1
+			break
+		}
+	}
+	for i := r1 + 1; i < len(word); i++ {
+		if !isRussianVowel(word[i]) && isRussianVowel(word[i-1]) {
+			r2 = i + 1
+			break
+		}
+	}
+	return rv, r2
+}
+
+// stemRussian реализует алгоритм Snowball для русского языка
+func stemRussian(word []rune) []rune {
+	rv, r2 := russianRegions(word)
+
+	// Шаг 1: деепричастие, иначе возвратная частица и окончание прилагательного, глагола или существительного
+	if w, ok := removeEnding(word, rv, perfectiveGerund1, perfectiveGerund2); ok {
+		word = w
+	} else {
+		if w, ok := removeEnding(word, rv, nil, reflexive); ok {
+			word = w
+		}
+		if w, ok := removeAdjectival(word, rv); ok {
+			word = w
+		} else if w, ok := removeEnding(word, rv, verb1, verb2); ok {
+			word = w
+		} else if w, ok := removeEnding(word, rv, nil, noun); ok {
+			word = w
+		}
+	}
+
+	// Шаг 2: конечная и
+	if w, ok := removeEnding(word, rv, nil, []string{"и"}); ok {
+		word = w
+	}
+
+	// Шаг 3: словообразовательный суффикс в R2
+	if w, ok := removeEnding(word, r2, nil, derivational); ok {
+		word = w
+	}
+
+	// Шаг 4: двойная н, превосходная степень или мягкий знак
+	if w, ok := removeEnding(word, rv, nil, []string{"нн"}); ok {
+		word = append(w, 'н')
+	} else if w, ok := removeEnding(word, rv, nil, superlative); ok {
+		word = w
+		if w, ok := removeEnding(word, rv, nil, []string{"нн"}); ok {
+			word = append(w, 'н')
+		}
+	} else if w, ok := removeEnding(word, rv, nil, []string{"ь"}); ok {
+		word = w
+	}
+
+	return word
+}
+
+// removeAdjectival удаляет окончание прилагательного и предшествующий ему суффикс причастия
+func removeAdjectival(word []rune, rv int) ([]rune, bool) {
+	w, ok := removeEnding(word, rv, nil, adjective)
+	if !ok {
+		return word, false
+	}
+	if p, ok := removeEnding(w, rv, participle1, participle2); ok {
+		return p, true
+	}
+	return w, true
+}
+
+// removeEnding ищет самое длинное окончание в области, начинающейся с позиции from,
+// и удаляет его; окончания group1 удаляются, только если перед ними в области стоит а или я
+func removeEnding(word []rune, from int, group1, group2 []string) ([]rune, bool) {
+	best, bestGroup1 := -1, false
+	for _, group := range []struct {
+		endings []string
+		group1  bool
+	}{{group1, true}, {group2, false}} {
+		for _, ending := range group.endings {
+			start := len(word) - len([]rune(ending))
+			if start < from || (best >= 0 && start >= best) {
+				continue
+			}
+			if string(word[start:]) == ending {
+				best, bestGroup1 = start, group.group1
+			}
+		}
+	}
+	if best < 0 {
+		return word, false
+	}
+	if bestGroup1 && (best-1 < from || (word[best-1] != 'а' && word[best-1] != 'я')) {
+		return word, false
+	}
+	return word[:best:best], true
+}
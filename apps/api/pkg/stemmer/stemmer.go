@@ -0,0 +1,57 @@
+// Package stemmer приводит слова русского и английского текста к основе,
+// чтобы разные формы слова ("договор", "договоры", "договора") сравнивались как одно слово
+package stemmer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Words разбивает текст на слова в нижнем регистре, буква ё заменяется на е
+func Words(text string) []string {
+	return strings.FieldsFunc(Normalize(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Stem возвращает основу слова: кириллица обрабатывается алгоритмом Snowball для русского языка,
+// латиница - упрощенным алгоритмом Porter2 (окончания множественного числа, -ed и -ing)
+// Слова с цифрами и смешанным алфавитом возвращаются без изменений
+func Stem(word string) string {
+	word = Normalize(word)
+	runes := []rune(word)
+
+	cyrillic, latin := 0, 0
+	for _, r := range runes {
+		switch {
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case r >= 'a' && r <= 'z':
+			latin++
+		default:
+			return word
+		}
+	}
+
+	switch {
+	case cyrillic == len(runes):
+		return string(stemRussian(runes))
+	case latin == len(runes):
+		return stemEnglish(word)
+	}
+	return word
+}
+
+// StemAll возвращает основы всех слов
+func StemAll(words []string) []string {
+	stems := make([]string, 0, len(words))
+	for _, word := range words {
+		stems = append(stems, Stem(word))
+	}
+	return stems
+}
+
+// Normalize приводит текст к нижнему регистру и заменяет ё на е
+func Normalize(text string) string {
+	return strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+}
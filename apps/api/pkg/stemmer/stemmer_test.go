@@ -0,0 +1,104 @@
+package stemmer_test
+
+import (
+	"reflect"
+	"testing"
+
+	"techmind/pkg/stemmer"
+)
+
+// Слова и основы из эталонного словаря Snowball для русского языка (voc.txt и output.txt)
+func TestStem_RussianSnowballVocabulary(t *testing.T) {
+	vocabulary := map[string]string{
+		"в":            "в",
+		"вагон":        "вагон",
+		"вагона":       "вагон",
+		"вагоне":       "вагон",
+		"вагонов":      "вагон",
+		"вагоном":      "вагон",
+		"вагоны":       "вагон",
+		"важная":       "важн",
+		"важнее":       "важн",
+		"важнейшие":    "важн",
+		"важнейшими":   "важн",
+		"важничал":     "важнича",
+		"важно":        "важн",
+		"важного":      "важн",
+		"важному":      "важн",
+		"важную":       "важн",
+		"важных":       "важн",
+		"вазах":        "ваз",
+		"вакса":        "вакс",
+		"валандался":   "валанда",
+		"валентина":    "валентин",
+		"валил":        "вал",
+		"валился":      "вал",
+		"валится":      "вал",
+		"вальдшнепа":   "вальдшнеп",
+		"вальсишку":    "вальсишк",
+		"валяется":     "валя",
+		"валялись":     "валя",
+		"варенье":      "варен",
+		"вареньем":     "варен",
+		"василий":      "васил",
+		"васильевич":   "васильевич",
+		"вашего":       "ваш",
+		"вбежала":      "вбежа",
+		"вверх":        "вверх",
+		"введении":     "введен",
+		"вдали":        "вдал",
+		"ведь":         "вед",
+		"вежливо":      "вежлив",
+		"великолепный": "великолепн",
+		"веселость":    "весел",
+	}
+
+	for word, want := range vocabulary {
+		if got := stemmer.Stem(word); got != want {
+			t.Errorf("Stem(%q) = %q, want %q", word, got, want)
+		}
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		// Формы слов из документов сводятся к одной основе
+		{"Договоров", "договор"},
+		{"договорами", "договор"},
+		{"счетами", "счет"},
+		{"счётами", "счет"},
+		{"поставщиков", "поставщик"},
+		// Шаги 1a-1c Porter2
+		{"caresses", "caress"},
+		{"ponies", "poni"},
+		{"ties", "tie"},
+		{"cats", "cat"},
+		{"gas", "gas"},
+		{"this", "this"},
+		{"hopping", "hop"},
+		{"sizing", "size"},
+		{"signed", "sign"},
+		{"cried", "cri"},
+		{"Documents", "document"},
+		// Слова с цифрами и смешанным алфавитом не изменяются
+		{"abc123", "abc123"},
+		{"мixed", "мixed"},
+	}
+
+	for _, tt := range tests {
+		if got := stemmer.Stem(tt.word); got != tt.want {
+			t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	got := stemmer.Words(`Счёт №15 от 05.03.2024, ООО "Ромашка"`)
+	want := []string{"счет", "15", "от", "05", "03", "2024", "ооо", "ромашка"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Words = %q, want %q", got, want)
+	}
+}
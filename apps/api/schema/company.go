@@ -5,6 +5,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"techmind/schema/types"
 )
 
 // Company holds the schema definition for the Company entity.
@@ -20,6 +22,8 @@ func (Company) Fields() []ent.Field {
 			Immutable(),
 		field.String("name").
 			NotEmpty(),
+		field.JSON("settings", types.CompanySettings{}).
+			Optional(),
 	}
 }
 
@@ -30,6 +34,10 @@ func (Company) Edges() []ent.Edge {
 		edge.To("folders", Folder.Type),
 		edge.To("documents", Document.Type),
 		edge.To("tags", Tag.Type),
+		edge.To("tag_groups", TagGroup.Type),
 		edge.To("senders", Sender.Type),
+		edge.To("jobs", Job.Type),
+		edge.To("saved_searches", SavedSearch.Type),
+		edge.To("document_types", DocumentType.Type),
 	}
 }
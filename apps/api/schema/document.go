@@ -7,6 +7,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"techmind/schema/types"
 )
 
 // Document holds the schema definition for the Document entity.
@@ -45,6 +47,14 @@ func (Document) Fields() []ent.Field {
 		field.UUID("updated_by", uuid.UUID{}).
 			Optional().
 			Nillable(),
+		field.JSON("metadata", types.DocumentMetadata{}).
+			Optional(),
+		field.UUID("type_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// field_values - значения полей по схеме типа документа, ключ - DocumentField.Key
+		field.JSON("field_values", map[string]types.FieldValue{}).
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -70,6 +80,10 @@ func (Document) Edges() []ent.Edge {
 			Ref("documents").
 			Field("sender_id").
 			Unique(),
+		edge.From("document_type", DocumentType.Type).
+			Ref("documents").
+			Field("type_id").
+			Unique(),
 		edge.From("created_by_user", User.Type).
 			Ref("created_documents").
 			Field("created_by").
@@ -79,5 +93,7 @@ func (Document) Edges() []ent.Edge {
 			Field("updated_by").
 			Unique(),
 		edge.To("document_tags", DocumentTag.Type),
+		edge.To("content", DocumentContent.Type).
+			Unique(),
 	}
 }
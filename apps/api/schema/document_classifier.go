@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"techmind/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentClassifier holds the schema definition for the DocumentClassifier entity.
+// One trained document type classification model per company.
+type DocumentClassifier struct {
+	ent.Schema
+}
+
+// Fields of the DocumentClassifier.
+func (DocumentClassifier) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Immutable(),
+		field.JSON("model", types.ClassifierModel{}),
+		field.JSON("evaluation", types.ClassifierEvaluation{}),
+		// documents - число документов, на которых обучена модель
+		field.Int("documents").
+			Default(0).
+			NonNegative(),
+		field.Time("trained_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
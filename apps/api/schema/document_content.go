@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+
+	"techmind/schema/types"
+)
+
+// DocumentContent holds the schema definition for the DocumentContent entity.
+type DocumentContent struct {
+	ent.Schema
+}
+
+// Fields of the DocumentContent.
+func (DocumentContent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("document_id", uuid.UUID{}).
+			Unique(),
+		field.Text("text"),
+		field.JSON("pages", []types.PageOffset{}).
+			Optional(),
+		field.String("language").
+			Optional(),
+		field.String("engine").
+			NotEmpty(),
+		field.String("engine_version").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DocumentContent.
+func (DocumentContent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("document", Document.Type).
+			Ref("content").
+			Field("document_id").
+			Required().
+			Unique(),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -20,6 +22,23 @@ func (DocumentTag) Fields() []ent.Field {
 			Immutable(),
 		field.UUID("document_id", uuid.UUID{}),
 		field.UUID("tag_id", uuid.UUID{}),
+		// source - кто присвоил тег: пользователь, правило тегирования, классификатор или импорт метаданных
+		field.Enum("source").
+			Values("manual", "rule", "ml", "import").
+			Default("manual"),
+		// rule_id и run_id - правило и запуск правил, присвоившие тег
+		field.UUID("rule_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("run_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
 	}
 }
 
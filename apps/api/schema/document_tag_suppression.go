@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentTagSuppression holds the schema definition for the DocumentTagSuppression entity.
+// Тег, явно снятый пользователем, не присваивается документу повторно правилами тегирования
+type DocumentTagSuppression struct {
+	ent.Schema
+}
+
+// Fields of the DocumentTagSuppression.
+func (DocumentTagSuppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("document_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("tag_id", uuid.UUID{}),
+		field.UUID("removed_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
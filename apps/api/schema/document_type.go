@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"techmind/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentType holds the schema definition for the DocumentType entity.
+type DocumentType struct {
+	ent.Schema
+}
+
+// Fields of the DocumentType.
+func (DocumentType) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.String("description").
+			Optional().
+			Nillable(),
+		// fields - схема полей документов этого типа (номер счета, сумма, срок оплаты)
+		field.JSON("fields", []types.DocumentField{}),
+		// extraction - шаблон извлечения значений полей из текста документов
+		field.JSON("extraction", types.ExtractionTemplate{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DocumentType.
+func (DocumentType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("company", Company.Type).
+			Ref("document_types").
+			Field("company_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("documents", Document.Type),
+	}
+}
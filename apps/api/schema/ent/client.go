@@ -14,10 +14,20 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/ent/searchindextask"
+	"techmind/schema/ent/searchlog"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/ent/tagrule"
 	"techmind/schema/ent/user"
 
 	"entgo.io/ent"
@@ -40,14 +50,34 @@ type Client struct {
 	CompanyUser *CompanyUserClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// DocumentClassifier is the client for interacting with the DocumentClassifier builders.
+	DocumentClassifier *DocumentClassifierClient
+	// DocumentContent is the client for interacting with the DocumentContent builders.
+	DocumentContent *DocumentContentClient
 	// DocumentTag is the client for interacting with the DocumentTag builders.
 	DocumentTag *DocumentTagClient
+	// DocumentTagSuppression is the client for interacting with the DocumentTagSuppression builders.
+	DocumentTagSuppression *DocumentTagSuppressionClient
+	// DocumentType is the client for interacting with the DocumentType builders.
+	DocumentType *DocumentTypeClient
 	// Folder is the client for interacting with the Folder builders.
 	Folder *FolderClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
+	// SearchIndexTask is the client for interacting with the SearchIndexTask builders.
+	SearchIndexTask *SearchIndexTaskClient
+	// SearchLog is the client for interacting with the SearchLog builders.
+	SearchLog *SearchLogClient
 	// Sender is the client for interacting with the Sender builders.
 	Sender *SenderClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagGroup is the client for interacting with the TagGroup builders.
+	TagGroup *TagGroupClient
+	// TagRule is the client for interacting with the TagRule builders.
+	TagRule *TagRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -64,10 +94,20 @@ func (c *Client) init() {
 	c.Company = NewCompanyClient(c.config)
 	c.CompanyUser = NewCompanyUserClient(c.config)
 	c.Document = NewDocumentClient(c.config)
+	c.DocumentClassifier = NewDocumentClassifierClient(c.config)
+	c.DocumentContent = NewDocumentContentClient(c.config)
 	c.DocumentTag = NewDocumentTagClient(c.config)
+	c.DocumentTagSuppression = NewDocumentTagSuppressionClient(c.config)
+	c.DocumentType = NewDocumentTypeClient(c.config)
 	c.Folder = NewFolderClient(c.config)
+	c.Job = NewJobClient(c.config)
+	c.SavedSearch = NewSavedSearchClient(c.config)
+	c.SearchIndexTask = NewSearchIndexTaskClient(c.config)
+	c.SearchLog = NewSearchLogClient(c.config)
 	c.Sender = NewSenderClient(c.config)
 	c.Tag = NewTagClient(c.config)
+	c.TagGroup = NewTagGroupClient(c.config)
+	c.TagRule = NewTagRuleClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -159,16 +199,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Company:     NewCompanyClient(cfg),
-		CompanyUser: NewCompanyUserClient(cfg),
-		Document:    NewDocumentClient(cfg),
-		DocumentTag: NewDocumentTagClient(cfg),
-		Folder:      NewFolderClient(cfg),
-		Sender:      NewSenderClient(cfg),
-		Tag:         NewTagClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Company:                NewCompanyClient(cfg),
+		CompanyUser:            NewCompanyUserClient(cfg),
+		Document:               NewDocumentClient(cfg),
+		DocumentClassifier:     NewDocumentClassifierClient(cfg),
+		DocumentContent:        NewDocumentContentClient(cfg),
+		DocumentTag:            NewDocumentTagClient(cfg),
+		DocumentTagSuppression: NewDocumentTagSuppressionClient(cfg),
+		DocumentType:           NewDocumentTypeClient(cfg),
+		Folder:                 NewFolderClient(cfg),
+		Job:                    NewJobClient(cfg),
+		SavedSearch:            NewSavedSearchClient(cfg),
+		SearchIndexTask:        NewSearchIndexTaskClient(cfg),
+		SearchLog:              NewSearchLogClient(cfg),
+		Sender:                 NewSenderClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		TagGroup:               NewTagGroupClient(cfg),
+		TagRule:                NewTagRuleClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -186,16 +236,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		Company:     NewCompanyClient(cfg),
-		CompanyUser: NewCompanyUserClient(cfg),
-		Document:    NewDocumentClient(cfg),
-		DocumentTag: NewDocumentTagClient(cfg),
-		Folder:      NewFolderClient(cfg),
-		Sender:      NewSenderClient(cfg),
-		Tag:         NewTagClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		Company:                NewCompanyClient(cfg),
+		CompanyUser:            NewCompanyUserClient(cfg),
+		Document:               NewDocumentClient(cfg),
+		DocumentClassifier:     NewDocumentClassifierClient(cfg),
+		DocumentContent:        NewDocumentContentClient(cfg),
+		DocumentTag:            NewDocumentTagClient(cfg),
+		DocumentTagSuppression: NewDocumentTagSuppressionClient(cfg),
+		DocumentType:           NewDocumentTypeClient(cfg),
+		Folder:                 NewFolderClient(cfg),
+		Job:                    NewJobClient(cfg),
+		SavedSearch:            NewSavedSearchClient(cfg),
+		SearchIndexTask:        NewSearchIndexTaskClient(cfg),
+		SearchLog:              NewSearchLogClient(cfg),
+		Sender:                 NewSenderClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		TagGroup:               NewTagGroupClient(cfg),
+		TagRule:                NewTagRuleClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -225,8 +285,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Company, c.CompanyUser, c.Document, c.DocumentTag, c.Folder, c.Sender, c.Tag,
-		c.User,
+		c.Company, c.CompanyUser, c.Document, c.DocumentClassifier, c.DocumentContent,
+		c.DocumentTag, c.DocumentTagSuppression, c.DocumentType, c.Folder, c.Job,
+		c.SavedSearch, c.SearchIndexTask, c.SearchLog, c.Sender, c.Tag, c.TagGroup,
+		c.TagRule, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -236,8 +298,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Company, c.CompanyUser, c.Document, c.DocumentTag, c.Folder, c.Sender, c.Tag,
-		c.User,
+		c.Company, c.CompanyUser, c.Document, c.DocumentClassifier, c.DocumentContent,
+		c.DocumentTag, c.DocumentTagSuppression, c.DocumentType, c.Folder, c.Job,
+		c.SavedSearch, c.SearchIndexTask, c.SearchLog, c.Sender, c.Tag, c.TagGroup,
+		c.TagRule, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -252,14 +316,34 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CompanyUser.mutate(ctx, m)
 	case *DocumentMutation:
 		return c.Document.mutate(ctx, m)
+	case *DocumentClassifierMutation:
+		return c.DocumentClassifier.mutate(ctx, m)
+	case *DocumentContentMutation:
+		return c.DocumentContent.mutate(ctx, m)
 	case *DocumentTagMutation:
 		return c.DocumentTag.mutate(ctx, m)
+	case *DocumentTagSuppressionMutation:
+		return c.DocumentTagSuppression.mutate(ctx, m)
+	case *DocumentTypeMutation:
+		return c.DocumentType.mutate(ctx, m)
 	case *FolderMutation:
 		return c.Folder.mutate(ctx, m)
+	case *JobMutation:
+		return c.Job.mutate(ctx, m)
+	case *SavedSearchMutation:
+		return c.SavedSearch.mutate(ctx, m)
+	case *SearchIndexTaskMutation:
+		return c.SearchIndexTask.mutate(ctx, m)
+	case *SearchLogMutation:
+		return c.SearchLog.mutate(ctx, m)
 	case *SenderMutation:
 		return c.Sender.mutate(ctx, m)
 	case *TagMutation:
 		return c.Tag.mutate(ctx, m)
+	case *TagGroupMutation:
+		return c.TagGroup.mutate(ctx, m)
+	case *TagRuleMutation:
+		return c.TagRule.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -439,6 +523,22 @@ func (c *CompanyClient) QueryTags(_m *Company) *TagQuery {
 	return query
 }
 
+// QueryTagGroups queries the tag_groups edge of a Company.
+func (c *CompanyClient) QueryTagGroups(_m *Company) *TagGroupQuery {
+	query := (&TagGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(taggroup.Table, taggroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.TagGroupsTable, company.TagGroupsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QuerySenders queries the senders edge of a Company.
 func (c *CompanyClient) QuerySenders(_m *Company) *SenderQuery {
 	query := (&SenderClient{config: c.config}).Query()
@@ -455,6 +555,54 @@ func (c *CompanyClient) QuerySenders(_m *Company) *SenderQuery {
 	return query
 }
 
+// QueryJobs queries the jobs edge of a Company.
+func (c *CompanyClient) QueryJobs(_m *Company) *JobQuery {
+	query := (&JobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.JobsTable, company.JobsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySavedSearches queries the saved_searches edge of a Company.
+func (c *CompanyClient) QuerySavedSearches(_m *Company) *SavedSearchQuery {
+	query := (&SavedSearchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.SavedSearchesTable, company.SavedSearchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocumentTypes queries the document_types edge of a Company.
+func (c *CompanyClient) QueryDocumentTypes(_m *Company) *DocumentTypeQuery {
+	query := (&DocumentTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, id),
+			sqlgraph.To(documenttype.Table, documenttype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.DocumentTypesTable, company.DocumentTypesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CompanyClient) Hooks() []Hook {
 	return c.hooks.Company
@@ -801,6 +949,22 @@ func (c *DocumentClient) QuerySender(_m *Document) *SenderQuery {
 	return query
 }
 
+// QueryDocumentType queries the document_type edge of a Document.
+func (c *DocumentClient) QueryDocumentType(_m *Document) *DocumentTypeQuery {
+	query := (&DocumentTypeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(documenttype.Table, documenttype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, document.DocumentTypeTable, document.DocumentTypeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryCreatedByUser queries the created_by_user edge of a Document.
 func (c *DocumentClient) QueryCreatedByUser(_m *Document) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
@@ -849,6 +1013,22 @@ func (c *DocumentClient) QueryDocumentTags(_m *Document) *DocumentTagQuery {
 	return query
 }
 
+// QueryContent queries the content edge of a Document.
+func (c *DocumentClient) QueryContent(_m *Document) *DocumentContentQuery {
+	query := (&DocumentContentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, id),
+			sqlgraph.To(documentcontent.Table, documentcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, document.ContentTable, document.ContentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *DocumentClient) Hooks() []Hook {
 	return c.hooks.Document
@@ -874,6 +1054,288 @@ func (c *DocumentClient) mutate(ctx context.Context, m *DocumentMutation) (Value
 	}
 }
 
+// DocumentClassifierClient is a client for the DocumentClassifier schema.
+type DocumentClassifierClient struct {
+	config
+}
+
+// NewDocumentClassifierClient returns a client for the DocumentClassifier from the given config.
+func NewDocumentClassifierClient(c config) *DocumentClassifierClient {
+	return &DocumentClassifierClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documentclassifier.Hooks(f(g(h())))`.
+func (c *DocumentClassifierClient) Use(hooks ...Hook) {
+	c.hooks.DocumentClassifier = append(c.hooks.DocumentClassifier, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documentclassifier.Intercept(f(g(h())))`.
+func (c *DocumentClassifierClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentClassifier = append(c.inters.DocumentClassifier, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentClassifier entity.
+func (c *DocumentClassifierClient) Create() *DocumentClassifierCreate {
+	mutation := newDocumentClassifierMutation(c.config, OpCreate)
+	return &DocumentClassifierCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentClassifier entities.
+func (c *DocumentClassifierClient) CreateBulk(builders ...*DocumentClassifierCreate) *DocumentClassifierCreateBulk {
+	return &DocumentClassifierCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentClassifierClient) MapCreateBulk(slice any, setFunc func(*DocumentClassifierCreate, int)) *DocumentClassifierCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentClassifierCreateBulk{err: fmt.Errorf("calling to DocumentClassifierClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentClassifierCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentClassifierCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentClassifier.
+func (c *DocumentClassifierClient) Update() *DocumentClassifierUpdate {
+	mutation := newDocumentClassifierMutation(c.config, OpUpdate)
+	return &DocumentClassifierUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentClassifierClient) UpdateOne(_m *DocumentClassifier) *DocumentClassifierUpdateOne {
+	mutation := newDocumentClassifierMutation(c.config, OpUpdateOne, withDocumentClassifier(_m))
+	return &DocumentClassifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentClassifierClient) UpdateOneID(id uuid.UUID) *DocumentClassifierUpdateOne {
+	mutation := newDocumentClassifierMutation(c.config, OpUpdateOne, withDocumentClassifierID(id))
+	return &DocumentClassifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentClassifier.
+func (c *DocumentClassifierClient) Delete() *DocumentClassifierDelete {
+	mutation := newDocumentClassifierMutation(c.config, OpDelete)
+	return &DocumentClassifierDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentClassifierClient) DeleteOne(_m *DocumentClassifier) *DocumentClassifierDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentClassifierClient) DeleteOneID(id uuid.UUID) *DocumentClassifierDeleteOne {
+	builder := c.Delete().Where(documentclassifier.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentClassifierDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentClassifier.
+func (c *DocumentClassifierClient) Query() *DocumentClassifierQuery {
+	return &DocumentClassifierQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentClassifier},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentClassifier entity by its id.
+func (c *DocumentClassifierClient) Get(ctx context.Context, id uuid.UUID) (*DocumentClassifier, error) {
+	return c.Query().Where(documentclassifier.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentClassifierClient) GetX(ctx context.Context, id uuid.UUID) *DocumentClassifier {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentClassifierClient) Hooks() []Hook {
+	return c.hooks.DocumentClassifier
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentClassifierClient) Interceptors() []Interceptor {
+	return c.inters.DocumentClassifier
+}
+
+func (c *DocumentClassifierClient) mutate(ctx context.Context, m *DocumentClassifierMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentClassifierCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentClassifierUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentClassifierUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentClassifierDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentClassifier mutation op: %q", m.Op())
+	}
+}
+
+// DocumentContentClient is a client for the DocumentContent schema.
+type DocumentContentClient struct {
+	config
+}
+
+// NewDocumentContentClient returns a client for the DocumentContent from the given config.
+func NewDocumentContentClient(c config) *DocumentContentClient {
+	return &DocumentContentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documentcontent.Hooks(f(g(h())))`.
+func (c *DocumentContentClient) Use(hooks ...Hook) {
+	c.hooks.DocumentContent = append(c.hooks.DocumentContent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documentcontent.Intercept(f(g(h())))`.
+func (c *DocumentContentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentContent = append(c.inters.DocumentContent, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentContent entity.
+func (c *DocumentContentClient) Create() *DocumentContentCreate {
+	mutation := newDocumentContentMutation(c.config, OpCreate)
+	return &DocumentContentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentContent entities.
+func (c *DocumentContentClient) CreateBulk(builders ...*DocumentContentCreate) *DocumentContentCreateBulk {
+	return &DocumentContentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentContentClient) MapCreateBulk(slice any, setFunc func(*DocumentContentCreate, int)) *DocumentContentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentContentCreateBulk{err: fmt.Errorf("calling to DocumentContentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentContentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentContentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentContent.
+func (c *DocumentContentClient) Update() *DocumentContentUpdate {
+	mutation := newDocumentContentMutation(c.config, OpUpdate)
+	return &DocumentContentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentContentClient) UpdateOne(_m *DocumentContent) *DocumentContentUpdateOne {
+	mutation := newDocumentContentMutation(c.config, OpUpdateOne, withDocumentContent(_m))
+	return &DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentContentClient) UpdateOneID(id uuid.UUID) *DocumentContentUpdateOne {
+	mutation := newDocumentContentMutation(c.config, OpUpdateOne, withDocumentContentID(id))
+	return &DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentContent.
+func (c *DocumentContentClient) Delete() *DocumentContentDelete {
+	mutation := newDocumentContentMutation(c.config, OpDelete)
+	return &DocumentContentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentContentClient) DeleteOne(_m *DocumentContent) *DocumentContentDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentContentClient) DeleteOneID(id uuid.UUID) *DocumentContentDeleteOne {
+	builder := c.Delete().Where(documentcontent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentContentDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentContent.
+func (c *DocumentContentClient) Query() *DocumentContentQuery {
+	return &DocumentContentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentContent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentContent entity by its id.
+func (c *DocumentContentClient) Get(ctx context.Context, id uuid.UUID) (*DocumentContent, error) {
+	return c.Query().Where(documentcontent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentContentClient) GetX(ctx context.Context, id uuid.UUID) *DocumentContent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDocument queries the document edge of a DocumentContent.
+func (c *DocumentContentClient) QueryDocument(_m *DocumentContent) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documentcontent.Table, documentcontent.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, documentcontent.DocumentTable, documentcontent.DocumentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentContentClient) Hooks() []Hook {
+	return c.hooks.DocumentContent
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentContentClient) Interceptors() []Interceptor {
+	return c.inters.DocumentContent
+}
+
+func (c *DocumentContentClient) mutate(ctx context.Context, m *DocumentContentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentContentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentContentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentContentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentContentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentContent mutation op: %q", m.Op())
+	}
+}
+
 // DocumentTagClient is a client for the DocumentTag schema.
 type DocumentTagClient struct {
 	config
@@ -1039,63 +1501,361 @@ func (c *DocumentTagClient) mutate(ctx context.Context, m *DocumentTagMutation)
 	}
 }
 
-// FolderClient is a client for the Folder schema.
-type FolderClient struct {
+// DocumentTagSuppressionClient is a client for the DocumentTagSuppression schema.
+type DocumentTagSuppressionClient struct {
 	config
 }
 
-// NewFolderClient returns a client for the Folder from the given config.
-func NewFolderClient(c config) *FolderClient {
-	return &FolderClient{config: c}
+// NewDocumentTagSuppressionClient returns a client for the DocumentTagSuppression from the given config.
+func NewDocumentTagSuppressionClient(c config) *DocumentTagSuppressionClient {
+	return &DocumentTagSuppressionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
-func (c *FolderClient) Use(hooks ...Hook) {
-	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+// A call to `Use(f, g, h)` equals to `documenttagsuppression.Hooks(f(g(h())))`.
+func (c *DocumentTagSuppressionClient) Use(hooks ...Hook) {
+	c.hooks.DocumentTagSuppression = append(c.hooks.DocumentTagSuppression, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
-func (c *FolderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Folder = append(c.inters.Folder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `documenttagsuppression.Intercept(f(g(h())))`.
+func (c *DocumentTagSuppressionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentTagSuppression = append(c.inters.DocumentTagSuppression, interceptors...)
 }
 
-// Create returns a builder for creating a Folder entity.
-func (c *FolderClient) Create() *FolderCreate {
-	mutation := newFolderMutation(c.config, OpCreate)
-	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DocumentTagSuppression entity.
+func (c *DocumentTagSuppressionClient) Create() *DocumentTagSuppressionCreate {
+	mutation := newDocumentTagSuppressionMutation(c.config, OpCreate)
+	return &DocumentTagSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Folder entities.
-func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
-	return &FolderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DocumentTagSuppression entities.
+func (c *DocumentTagSuppressionClient) CreateBulk(builders ...*DocumentTagSuppressionCreate) *DocumentTagSuppressionCreateBulk {
+	return &DocumentTagSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+func (c *DocumentTagSuppressionClient) MapCreateBulk(slice any, setFunc func(*DocumentTagSuppressionCreate, int)) *DocumentTagSuppressionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DocumentTagSuppressionCreateBulk{err: fmt.Errorf("calling to DocumentTagSuppressionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FolderCreate, rv.Len())
+	builders := make([]*DocumentTagSuppressionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FolderCreateBulk{config: c.config, builders: builders}
+	return &DocumentTagSuppressionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Folder.
-func (c *FolderClient) Update() *FolderUpdate {
-	mutation := newFolderMutation(c.config, OpUpdate)
-	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DocumentTagSuppression.
+func (c *DocumentTagSuppressionClient) Update() *DocumentTagSuppressionUpdate {
+	mutation := newDocumentTagSuppressionMutation(c.config, OpUpdate)
+	return &DocumentTagSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FolderClient) UpdateOne(_m *Folder) *FolderUpdateOne {
-	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(_m))
+func (c *DocumentTagSuppressionClient) UpdateOne(_m *DocumentTagSuppression) *DocumentTagSuppressionUpdateOne {
+	mutation := newDocumentTagSuppressionMutation(c.config, OpUpdateOne, withDocumentTagSuppression(_m))
+	return &DocumentTagSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentTagSuppressionClient) UpdateOneID(id uuid.UUID) *DocumentTagSuppressionUpdateOne {
+	mutation := newDocumentTagSuppressionMutation(c.config, OpUpdateOne, withDocumentTagSuppressionID(id))
+	return &DocumentTagSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentTagSuppression.
+func (c *DocumentTagSuppressionClient) Delete() *DocumentTagSuppressionDelete {
+	mutation := newDocumentTagSuppressionMutation(c.config, OpDelete)
+	return &DocumentTagSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentTagSuppressionClient) DeleteOne(_m *DocumentTagSuppression) *DocumentTagSuppressionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentTagSuppressionClient) DeleteOneID(id uuid.UUID) *DocumentTagSuppressionDeleteOne {
+	builder := c.Delete().Where(documenttagsuppression.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentTagSuppressionDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentTagSuppression.
+func (c *DocumentTagSuppressionClient) Query() *DocumentTagSuppressionQuery {
+	return &DocumentTagSuppressionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentTagSuppression},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentTagSuppression entity by its id.
+func (c *DocumentTagSuppressionClient) Get(ctx context.Context, id uuid.UUID) (*DocumentTagSuppression, error) {
+	return c.Query().Where(documenttagsuppression.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentTagSuppressionClient) GetX(ctx context.Context, id uuid.UUID) *DocumentTagSuppression {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentTagSuppressionClient) Hooks() []Hook {
+	return c.hooks.DocumentTagSuppression
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentTagSuppressionClient) Interceptors() []Interceptor {
+	return c.inters.DocumentTagSuppression
+}
+
+func (c *DocumentTagSuppressionClient) mutate(ctx context.Context, m *DocumentTagSuppressionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentTagSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentTagSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentTagSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentTagSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentTagSuppression mutation op: %q", m.Op())
+	}
+}
+
+// DocumentTypeClient is a client for the DocumentType schema.
+type DocumentTypeClient struct {
+	config
+}
+
+// NewDocumentTypeClient returns a client for the DocumentType from the given config.
+func NewDocumentTypeClient(c config) *DocumentTypeClient {
+	return &DocumentTypeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `documenttype.Hooks(f(g(h())))`.
+func (c *DocumentTypeClient) Use(hooks ...Hook) {
+	c.hooks.DocumentType = append(c.hooks.DocumentType, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `documenttype.Intercept(f(g(h())))`.
+func (c *DocumentTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DocumentType = append(c.inters.DocumentType, interceptors...)
+}
+
+// Create returns a builder for creating a DocumentType entity.
+func (c *DocumentTypeClient) Create() *DocumentTypeCreate {
+	mutation := newDocumentTypeMutation(c.config, OpCreate)
+	return &DocumentTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DocumentType entities.
+func (c *DocumentTypeClient) CreateBulk(builders ...*DocumentTypeCreate) *DocumentTypeCreateBulk {
+	return &DocumentTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DocumentTypeClient) MapCreateBulk(slice any, setFunc func(*DocumentTypeCreate, int)) *DocumentTypeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DocumentTypeCreateBulk{err: fmt.Errorf("calling to DocumentTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DocumentTypeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DocumentTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DocumentType.
+func (c *DocumentTypeClient) Update() *DocumentTypeUpdate {
+	mutation := newDocumentTypeMutation(c.config, OpUpdate)
+	return &DocumentTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DocumentTypeClient) UpdateOne(_m *DocumentType) *DocumentTypeUpdateOne {
+	mutation := newDocumentTypeMutation(c.config, OpUpdateOne, withDocumentType(_m))
+	return &DocumentTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DocumentTypeClient) UpdateOneID(id uuid.UUID) *DocumentTypeUpdateOne {
+	mutation := newDocumentTypeMutation(c.config, OpUpdateOne, withDocumentTypeID(id))
+	return &DocumentTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DocumentType.
+func (c *DocumentTypeClient) Delete() *DocumentTypeDelete {
+	mutation := newDocumentTypeMutation(c.config, OpDelete)
+	return &DocumentTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DocumentTypeClient) DeleteOne(_m *DocumentType) *DocumentTypeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DocumentTypeClient) DeleteOneID(id uuid.UUID) *DocumentTypeDeleteOne {
+	builder := c.Delete().Where(documenttype.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DocumentTypeDeleteOne{builder}
+}
+
+// Query returns a query builder for DocumentType.
+func (c *DocumentTypeClient) Query() *DocumentTypeQuery {
+	return &DocumentTypeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDocumentType},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DocumentType entity by its id.
+func (c *DocumentTypeClient) Get(ctx context.Context, id uuid.UUID) (*DocumentType, error) {
+	return c.Query().Where(documenttype.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DocumentTypeClient) GetX(ctx context.Context, id uuid.UUID) *DocumentType {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCompany queries the company edge of a DocumentType.
+func (c *DocumentTypeClient) QueryCompany(_m *DocumentType) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documenttype.Table, documenttype.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, documenttype.CompanyTable, documenttype.CompanyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDocuments queries the documents edge of a DocumentType.
+func (c *DocumentTypeClient) QueryDocuments(_m *DocumentType) *DocumentQuery {
+	query := (&DocumentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(documenttype.Table, documenttype.FieldID, id),
+			sqlgraph.To(document.Table, document.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, documenttype.DocumentsTable, documenttype.DocumentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DocumentTypeClient) Hooks() []Hook {
+	return c.hooks.DocumentType
+}
+
+// Interceptors returns the client interceptors.
+func (c *DocumentTypeClient) Interceptors() []Interceptor {
+	return c.inters.DocumentType
+}
+
+func (c *DocumentTypeClient) mutate(ctx context.Context, m *DocumentTypeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DocumentTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DocumentTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DocumentTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DocumentTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DocumentType mutation op: %q", m.Op())
+	}
+}
+
+// FolderClient is a client for the Folder schema.
+type FolderClient struct {
+	config
+}
+
+// NewFolderClient returns a client for the Folder from the given config.
+func NewFolderClient(c config) *FolderClient {
+	return &FolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
+func (c *FolderClient) Use(hooks ...Hook) {
+	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `folder.Intercept(f(g(h())))`.
+func (c *FolderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Folder = append(c.inters.Folder, interceptors...)
+}
+
+// Create returns a builder for creating a Folder entity.
+func (c *FolderClient) Create() *FolderCreate {
+	mutation := newFolderMutation(c.config, OpCreate)
+	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Folder entities.
+func (c *FolderClient) CreateBulk(builders ...*FolderCreate) *FolderCreateBulk {
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FolderClient) MapCreateBulk(slice any, setFunc func(*FolderCreate, int)) *FolderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FolderCreateBulk{err: fmt.Errorf("calling to FolderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FolderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FolderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Folder.
+func (c *FolderClient) Update() *FolderUpdate {
+	mutation := newFolderMutation(c.config, OpUpdate)
+	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderClient) UpdateOne(_m *Folder) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne, withFolder(_m))
 	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -1208,31 +1968,595 @@ func (c *FolderClient) QueryDocuments(_m *Folder) *DocumentQuery {
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FolderClient) Hooks() []Hook {
+	return c.hooks.Folder
+}
+
+// Interceptors returns the client interceptors.
+func (c *FolderClient) Interceptors() []Interceptor {
+	return c.inters.Folder
+}
+
+func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Folder mutation op: %q", m.Op())
+	}
+}
+
+// JobClient is a client for the Job schema.
+type JobClient struct {
+	config
+}
+
+// NewJobClient returns a client for the Job from the given config.
+func NewJobClient(c config) *JobClient {
+	return &JobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `job.Hooks(f(g(h())))`.
+func (c *JobClient) Use(hooks ...Hook) {
+	c.hooks.Job = append(c.hooks.Job, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `job.Intercept(f(g(h())))`.
+func (c *JobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Job = append(c.inters.Job, interceptors...)
+}
+
+// Create returns a builder for creating a Job entity.
+func (c *JobClient) Create() *JobCreate {
+	mutation := newJobMutation(c.config, OpCreate)
+	return &JobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Job entities.
+func (c *JobClient) CreateBulk(builders ...*JobCreate) *JobCreateBulk {
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *JobClient) MapCreateBulk(slice any, setFunc func(*JobCreate, int)) *JobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &JobCreateBulk{err: fmt.Errorf("calling to JobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*JobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &JobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Job.
+func (c *JobClient) Update() *JobUpdate {
+	mutation := newJobMutation(c.config, OpUpdate)
+	return &JobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *JobClient) UpdateOne(_m *Job) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJob(_m))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *JobClient) UpdateOneID(id uuid.UUID) *JobUpdateOne {
+	mutation := newJobMutation(c.config, OpUpdateOne, withJobID(id))
+	return &JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Job.
+func (c *JobClient) Delete() *JobDelete {
+	mutation := newJobMutation(c.config, OpDelete)
+	return &JobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *JobClient) DeleteOne(_m *Job) *JobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *JobClient) DeleteOneID(id uuid.UUID) *JobDeleteOne {
+	builder := c.Delete().Where(job.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &JobDeleteOne{builder}
+}
+
+// Query returns a query builder for Job.
+func (c *JobClient) Query() *JobQuery {
+	return &JobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Job entity by its id.
+func (c *JobClient) Get(ctx context.Context, id uuid.UUID) (*Job, error) {
+	return c.Query().Where(job.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *JobClient) GetX(ctx context.Context, id uuid.UUID) *Job {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCompany queries the company edge of a Job.
+func (c *JobClient) QueryCompany(_m *Job) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(job.Table, job.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, job.CompanyTable, job.CompanyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *JobClient) Hooks() []Hook {
+	return c.hooks.Job
+}
+
+// Interceptors returns the client interceptors.
+func (c *JobClient) Interceptors() []Interceptor {
+	return c.inters.Job
+}
+
+func (c *JobClient) mutate(ctx context.Context, m *JobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&JobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&JobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&JobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&JobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Job mutation op: %q", m.Op())
+	}
+}
+
+// SavedSearchClient is a client for the SavedSearch schema.
+type SavedSearchClient struct {
+	config
+}
+
+// NewSavedSearchClient returns a client for the SavedSearch from the given config.
+func NewSavedSearchClient(c config) *SavedSearchClient {
+	return &SavedSearchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `savedsearch.Hooks(f(g(h())))`.
+func (c *SavedSearchClient) Use(hooks ...Hook) {
+	c.hooks.SavedSearch = append(c.hooks.SavedSearch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `savedsearch.Intercept(f(g(h())))`.
+func (c *SavedSearchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SavedSearch = append(c.inters.SavedSearch, interceptors...)
+}
+
+// Create returns a builder for creating a SavedSearch entity.
+func (c *SavedSearchClient) Create() *SavedSearchCreate {
+	mutation := newSavedSearchMutation(c.config, OpCreate)
+	return &SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SavedSearch entities.
+func (c *SavedSearchClient) CreateBulk(builders ...*SavedSearchCreate) *SavedSearchCreateBulk {
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SavedSearchClient) MapCreateBulk(slice any, setFunc func(*SavedSearchCreate, int)) *SavedSearchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SavedSearchCreateBulk{err: fmt.Errorf("calling to SavedSearchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SavedSearchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SavedSearchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SavedSearch.
+func (c *SavedSearchClient) Update() *SavedSearchUpdate {
+	mutation := newSavedSearchMutation(c.config, OpUpdate)
+	return &SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SavedSearchClient) UpdateOne(_m *SavedSearch) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearch(_m))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SavedSearchClient) UpdateOneID(id uuid.UUID) *SavedSearchUpdateOne {
+	mutation := newSavedSearchMutation(c.config, OpUpdateOne, withSavedSearchID(id))
+	return &SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SavedSearch.
+func (c *SavedSearchClient) Delete() *SavedSearchDelete {
+	mutation := newSavedSearchMutation(c.config, OpDelete)
+	return &SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SavedSearchClient) DeleteOne(_m *SavedSearch) *SavedSearchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SavedSearchClient) DeleteOneID(id uuid.UUID) *SavedSearchDeleteOne {
+	builder := c.Delete().Where(savedsearch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SavedSearchDeleteOne{builder}
+}
+
+// Query returns a query builder for SavedSearch.
+func (c *SavedSearchClient) Query() *SavedSearchQuery {
+	return &SavedSearchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSavedSearch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SavedSearch entity by its id.
+func (c *SavedSearchClient) Get(ctx context.Context, id uuid.UUID) (*SavedSearch, error) {
+	return c.Query().Where(savedsearch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SavedSearchClient) GetX(ctx context.Context, id uuid.UUID) *SavedSearch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCompany queries the company edge of a SavedSearch.
+func (c *SavedSearchClient) QueryCompany(_m *SavedSearch) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(savedsearch.Table, savedsearch.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, savedsearch.CompanyTable, savedsearch.CompanyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SavedSearchClient) Hooks() []Hook {
+	return c.hooks.SavedSearch
+}
+
+// Interceptors returns the client interceptors.
+func (c *SavedSearchClient) Interceptors() []Interceptor {
+	return c.inters.SavedSearch
+}
+
+func (c *SavedSearchClient) mutate(ctx context.Context, m *SavedSearchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SavedSearchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SavedSearchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SavedSearchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SavedSearchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SavedSearch mutation op: %q", m.Op())
+	}
+}
+
+// SearchIndexTaskClient is a client for the SearchIndexTask schema.
+type SearchIndexTaskClient struct {
+	config
+}
+
+// NewSearchIndexTaskClient returns a client for the SearchIndexTask from the given config.
+func NewSearchIndexTaskClient(c config) *SearchIndexTaskClient {
+	return &SearchIndexTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchindextask.Hooks(f(g(h())))`.
+func (c *SearchIndexTaskClient) Use(hooks ...Hook) {
+	c.hooks.SearchIndexTask = append(c.hooks.SearchIndexTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchindextask.Intercept(f(g(h())))`.
+func (c *SearchIndexTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchIndexTask = append(c.inters.SearchIndexTask, interceptors...)
+}
+
+// Create returns a builder for creating a SearchIndexTask entity.
+func (c *SearchIndexTaskClient) Create() *SearchIndexTaskCreate {
+	mutation := newSearchIndexTaskMutation(c.config, OpCreate)
+	return &SearchIndexTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchIndexTask entities.
+func (c *SearchIndexTaskClient) CreateBulk(builders ...*SearchIndexTaskCreate) *SearchIndexTaskCreateBulk {
+	return &SearchIndexTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchIndexTaskClient) MapCreateBulk(slice any, setFunc func(*SearchIndexTaskCreate, int)) *SearchIndexTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchIndexTaskCreateBulk{err: fmt.Errorf("calling to SearchIndexTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchIndexTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchIndexTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchIndexTask.
+func (c *SearchIndexTaskClient) Update() *SearchIndexTaskUpdate {
+	mutation := newSearchIndexTaskMutation(c.config, OpUpdate)
+	return &SearchIndexTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchIndexTaskClient) UpdateOne(_m *SearchIndexTask) *SearchIndexTaskUpdateOne {
+	mutation := newSearchIndexTaskMutation(c.config, OpUpdateOne, withSearchIndexTask(_m))
+	return &SearchIndexTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchIndexTaskClient) UpdateOneID(id uuid.UUID) *SearchIndexTaskUpdateOne {
+	mutation := newSearchIndexTaskMutation(c.config, OpUpdateOne, withSearchIndexTaskID(id))
+	return &SearchIndexTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchIndexTask.
+func (c *SearchIndexTaskClient) Delete() *SearchIndexTaskDelete {
+	mutation := newSearchIndexTaskMutation(c.config, OpDelete)
+	return &SearchIndexTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchIndexTaskClient) DeleteOne(_m *SearchIndexTask) *SearchIndexTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchIndexTaskClient) DeleteOneID(id uuid.UUID) *SearchIndexTaskDeleteOne {
+	builder := c.Delete().Where(searchindextask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchIndexTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchIndexTask.
+func (c *SearchIndexTaskClient) Query() *SearchIndexTaskQuery {
+	return &SearchIndexTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchIndexTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchIndexTask entity by its id.
+func (c *SearchIndexTaskClient) Get(ctx context.Context, id uuid.UUID) (*SearchIndexTask, error) {
+	return c.Query().Where(searchindextask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchIndexTaskClient) GetX(ctx context.Context, id uuid.UUID) *SearchIndexTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SearchIndexTaskClient) Hooks() []Hook {
+	return c.hooks.SearchIndexTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *SearchIndexTaskClient) Interceptors() []Interceptor {
+	return c.inters.SearchIndexTask
+}
+
+func (c *SearchIndexTaskClient) mutate(ctx context.Context, m *SearchIndexTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SearchIndexTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SearchIndexTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SearchIndexTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SearchIndexTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SearchIndexTask mutation op: %q", m.Op())
+	}
+}
+
+// SearchLogClient is a client for the SearchLog schema.
+type SearchLogClient struct {
+	config
+}
+
+// NewSearchLogClient returns a client for the SearchLog from the given config.
+func NewSearchLogClient(c config) *SearchLogClient {
+	return &SearchLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `searchlog.Hooks(f(g(h())))`.
+func (c *SearchLogClient) Use(hooks ...Hook) {
+	c.hooks.SearchLog = append(c.hooks.SearchLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `searchlog.Intercept(f(g(h())))`.
+func (c *SearchLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SearchLog = append(c.inters.SearchLog, interceptors...)
+}
+
+// Create returns a builder for creating a SearchLog entity.
+func (c *SearchLogClient) Create() *SearchLogCreate {
+	mutation := newSearchLogMutation(c.config, OpCreate)
+	return &SearchLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SearchLog entities.
+func (c *SearchLogClient) CreateBulk(builders ...*SearchLogCreate) *SearchLogCreateBulk {
+	return &SearchLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SearchLogClient) MapCreateBulk(slice any, setFunc func(*SearchLogCreate, int)) *SearchLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SearchLogCreateBulk{err: fmt.Errorf("calling to SearchLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SearchLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SearchLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SearchLog.
+func (c *SearchLogClient) Update() *SearchLogUpdate {
+	mutation := newSearchLogMutation(c.config, OpUpdate)
+	return &SearchLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SearchLogClient) UpdateOne(_m *SearchLog) *SearchLogUpdateOne {
+	mutation := newSearchLogMutation(c.config, OpUpdateOne, withSearchLog(_m))
+	return &SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SearchLogClient) UpdateOneID(id uuid.UUID) *SearchLogUpdateOne {
+	mutation := newSearchLogMutation(c.config, OpUpdateOne, withSearchLogID(id))
+	return &SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SearchLog.
+func (c *SearchLogClient) Delete() *SearchLogDelete {
+	mutation := newSearchLogMutation(c.config, OpDelete)
+	return &SearchLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SearchLogClient) DeleteOne(_m *SearchLog) *SearchLogDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SearchLogClient) DeleteOneID(id uuid.UUID) *SearchLogDeleteOne {
+	builder := c.Delete().Where(searchlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SearchLogDeleteOne{builder}
+}
+
+// Query returns a query builder for SearchLog.
+func (c *SearchLogClient) Query() *SearchLogQuery {
+	return &SearchLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSearchLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SearchLog entity by its id.
+func (c *SearchLogClient) Get(ctx context.Context, id uuid.UUID) (*SearchLog, error) {
+	return c.Query().Where(searchlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SearchLogClient) GetX(ctx context.Context, id uuid.UUID) *SearchLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *FolderClient) Hooks() []Hook {
-	return c.hooks.Folder
+func (c *SearchLogClient) Hooks() []Hook {
+	return c.hooks.SearchLog
 }
 
 // Interceptors returns the client interceptors.
-func (c *FolderClient) Interceptors() []Interceptor {
-	return c.inters.Folder
+func (c *SearchLogClient) Interceptors() []Interceptor {
+	return c.inters.SearchLog
 }
 
-func (c *FolderClient) mutate(ctx context.Context, m *FolderMutation) (Value, error) {
+func (c *SearchLogClient) mutate(ctx context.Context, m *SearchLogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&FolderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SearchLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SearchLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SearchLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&FolderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SearchLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Folder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown SearchLog mutation op: %q", m.Op())
 	}
 }
 
@@ -1525,6 +2849,54 @@ func (c *TagClient) QueryCompany(_m *Tag) *CompanyQuery {
 	return query
 }
 
+// QueryParent queries the parent edge of a Tag.
+func (c *TagClient) QueryParent(_m *Tag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.ParentTable, tag.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Tag.
+func (c *TagClient) QueryChildren(_m *Tag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.ChildrenTable, tag.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGroup queries the group edge of a Tag.
+func (c *TagClient) QueryGroup(_m *Tag) *TagGroupQuery {
+	query := (&TagGroupClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(taggroup.Table, taggroup.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.GroupTable, tag.GroupColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryDocumentTags queries the document_tags edge of a Tag.
 func (c *TagClient) QueryDocumentTags(_m *Tag) *DocumentTagQuery {
 	query := (&DocumentTagClient{config: c.config}).Query()
@@ -1541,6 +2913,22 @@ func (c *TagClient) QueryDocumentTags(_m *Tag) *DocumentTagQuery {
 	return query
 }
 
+// QueryRules queries the rules edge of a Tag.
+func (c *TagClient) QueryRules(_m *Tag) *TagRuleQuery {
+	query := (&TagRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(tagrule.Table, tagrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tag.RulesTable, tag.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TagClient) Hooks() []Hook {
 	return c.hooks.Tag
@@ -1566,6 +2954,320 @@ func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	}
 }
 
+// TagGroupClient is a client for the TagGroup schema.
+type TagGroupClient struct {
+	config
+}
+
+// NewTagGroupClient returns a client for the TagGroup from the given config.
+func NewTagGroupClient(c config) *TagGroupClient {
+	return &TagGroupClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taggroup.Hooks(f(g(h())))`.
+func (c *TagGroupClient) Use(hooks ...Hook) {
+	c.hooks.TagGroup = append(c.hooks.TagGroup, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taggroup.Intercept(f(g(h())))`.
+func (c *TagGroupClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagGroup = append(c.inters.TagGroup, interceptors...)
+}
+
+// Create returns a builder for creating a TagGroup entity.
+func (c *TagGroupClient) Create() *TagGroupCreate {
+	mutation := newTagGroupMutation(c.config, OpCreate)
+	return &TagGroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagGroup entities.
+func (c *TagGroupClient) CreateBulk(builders ...*TagGroupCreate) *TagGroupCreateBulk {
+	return &TagGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagGroupClient) MapCreateBulk(slice any, setFunc func(*TagGroupCreate, int)) *TagGroupCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagGroupCreateBulk{err: fmt.Errorf("calling to TagGroupClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagGroupCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagGroupCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagGroup.
+func (c *TagGroupClient) Update() *TagGroupUpdate {
+	mutation := newTagGroupMutation(c.config, OpUpdate)
+	return &TagGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagGroupClient) UpdateOne(_m *TagGroup) *TagGroupUpdateOne {
+	mutation := newTagGroupMutation(c.config, OpUpdateOne, withTagGroup(_m))
+	return &TagGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagGroupClient) UpdateOneID(id uuid.UUID) *TagGroupUpdateOne {
+	mutation := newTagGroupMutation(c.config, OpUpdateOne, withTagGroupID(id))
+	return &TagGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagGroup.
+func (c *TagGroupClient) Delete() *TagGroupDelete {
+	mutation := newTagGroupMutation(c.config, OpDelete)
+	return &TagGroupDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagGroupClient) DeleteOne(_m *TagGroup) *TagGroupDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagGroupClient) DeleteOneID(id uuid.UUID) *TagGroupDeleteOne {
+	builder := c.Delete().Where(taggroup.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagGroupDeleteOne{builder}
+}
+
+// Query returns a query builder for TagGroup.
+func (c *TagGroupClient) Query() *TagGroupQuery {
+	return &TagGroupQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagGroup},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagGroup entity by its id.
+func (c *TagGroupClient) Get(ctx context.Context, id uuid.UUID) (*TagGroup, error) {
+	return c.Query().Where(taggroup.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagGroupClient) GetX(ctx context.Context, id uuid.UUID) *TagGroup {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCompany queries the company edge of a TagGroup.
+func (c *TagGroupClient) QueryCompany(_m *TagGroup) *CompanyQuery {
+	query := (&CompanyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taggroup.Table, taggroup.FieldID, id),
+			sqlgraph.To(company.Table, company.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, taggroup.CompanyTable, taggroup.CompanyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a TagGroup.
+func (c *TagGroupClient) QueryTags(_m *TagGroup) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(taggroup.Table, taggroup.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, taggroup.TagsTable, taggroup.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagGroupClient) Hooks() []Hook {
+	return c.hooks.TagGroup
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagGroupClient) Interceptors() []Interceptor {
+	return c.inters.TagGroup
+}
+
+func (c *TagGroupClient) mutate(ctx context.Context, m *TagGroupMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagGroupCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagGroupUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagGroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagGroupDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagGroup mutation op: %q", m.Op())
+	}
+}
+
+// TagRuleClient is a client for the TagRule schema.
+type TagRuleClient struct {
+	config
+}
+
+// NewTagRuleClient returns a client for the TagRule from the given config.
+func NewTagRuleClient(c config) *TagRuleClient {
+	return &TagRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tagrule.Hooks(f(g(h())))`.
+func (c *TagRuleClient) Use(hooks ...Hook) {
+	c.hooks.TagRule = append(c.hooks.TagRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tagrule.Intercept(f(g(h())))`.
+func (c *TagRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TagRule = append(c.inters.TagRule, interceptors...)
+}
+
+// Create returns a builder for creating a TagRule entity.
+func (c *TagRuleClient) Create() *TagRuleCreate {
+	mutation := newTagRuleMutation(c.config, OpCreate)
+	return &TagRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TagRule entities.
+func (c *TagRuleClient) CreateBulk(builders ...*TagRuleCreate) *TagRuleCreateBulk {
+	return &TagRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagRuleClient) MapCreateBulk(slice any, setFunc func(*TagRuleCreate, int)) *TagRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagRuleCreateBulk{err: fmt.Errorf("calling to TagRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TagRule.
+func (c *TagRuleClient) Update() *TagRuleUpdate {
+	mutation := newTagRuleMutation(c.config, OpUpdate)
+	return &TagRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagRuleClient) UpdateOne(_m *TagRule) *TagRuleUpdateOne {
+	mutation := newTagRuleMutation(c.config, OpUpdateOne, withTagRule(_m))
+	return &TagRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagRuleClient) UpdateOneID(id uuid.UUID) *TagRuleUpdateOne {
+	mutation := newTagRuleMutation(c.config, OpUpdateOne, withTagRuleID(id))
+	return &TagRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TagRule.
+func (c *TagRuleClient) Delete() *TagRuleDelete {
+	mutation := newTagRuleMutation(c.config, OpDelete)
+	return &TagRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagRuleClient) DeleteOne(_m *TagRule) *TagRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagRuleClient) DeleteOneID(id uuid.UUID) *TagRuleDeleteOne {
+	builder := c.Delete().Where(tagrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for TagRule.
+func (c *TagRuleClient) Query() *TagRuleQuery {
+	return &TagRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTagRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TagRule entity by its id.
+func (c *TagRuleClient) Get(ctx context.Context, id uuid.UUID) (*TagRule, error) {
+	return c.Query().Where(tagrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagRuleClient) GetX(ctx context.Context, id uuid.UUID) *TagRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTag queries the tag edge of a TagRule.
+func (c *TagRuleClient) QueryTag(_m *TagRule) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tagrule.Table, tagrule.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tagrule.TagTable, tagrule.TagColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagRuleClient) Hooks() []Hook {
+	return c.hooks.TagRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagRuleClient) Interceptors() []Interceptor {
+	return c.inters.TagRule
+}
+
+func (c *TagRuleClient) mutate(ctx context.Context, m *TagRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TagRule mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1750,11 +3452,14 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Company, CompanyUser, Document, DocumentTag, Folder, Sender, Tag,
-		User []ent.Hook
+		Company, CompanyUser, Document, DocumentClassifier, DocumentContent,
+		DocumentTag, DocumentTagSuppression, DocumentType, Folder, Job, SavedSearch,
+		SearchIndexTask, SearchLog, Sender, Tag, TagGroup, TagRule, User []ent.Hook
 	}
 	inters struct {
-		Company, CompanyUser, Document, DocumentTag, Folder, Sender, Tag,
+		Company, CompanyUser, Document, DocumentClassifier, DocumentContent,
+		DocumentTag, DocumentTagSuppression, DocumentType, Folder, Job, SavedSearch,
+		SearchIndexTask, SearchLog, Sender, Tag, TagGroup, TagRule,
 		User []ent.Interceptor
 	}
 )
@@ -3,9 +3,11 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"techmind/schema/ent/company"
+	"techmind/schema/types"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -19,6 +21,8 @@ type Company struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Settings holds the value of the "settings" field.
+	Settings types.CompanySettings `json:"settings,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CompanyQuery when eager-loading is set.
 	Edges        CompanyEdges `json:"edges"`
@@ -35,11 +39,19 @@ type CompanyEdges struct {
 	Documents []*Document `json:"documents,omitempty"`
 	// Tags holds the value of the tags edge.
 	Tags []*Tag `json:"tags,omitempty"`
+	// TagGroups holds the value of the tag_groups edge.
+	TagGroups []*TagGroup `json:"tag_groups,omitempty"`
 	// Senders holds the value of the senders edge.
 	Senders []*Sender `json:"senders,omitempty"`
+	// Jobs holds the value of the jobs edge.
+	Jobs []*Job `json:"jobs,omitempty"`
+	// SavedSearches holds the value of the saved_searches edge.
+	SavedSearches []*SavedSearch `json:"saved_searches,omitempty"`
+	// DocumentTypes holds the value of the document_types edge.
+	DocumentTypes []*DocumentType `json:"document_types,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [9]bool
 }
 
 // CompanyUsersOrErr returns the CompanyUsers value or an error if the edge
@@ -78,20 +90,58 @@ func (e CompanyEdges) TagsOrErr() ([]*Tag, error) {
 	return nil, &NotLoadedError{edge: "tags"}
 }
 
+// TagGroupsOrErr returns the TagGroups value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) TagGroupsOrErr() ([]*TagGroup, error) {
+	if e.loadedTypes[4] {
+		return e.TagGroups, nil
+	}
+	return nil, &NotLoadedError{edge: "tag_groups"}
+}
+
 // SendersOrErr returns the Senders value or an error if the edge
 // was not loaded in eager-loading.
 func (e CompanyEdges) SendersOrErr() ([]*Sender, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Senders, nil
 	}
 	return nil, &NotLoadedError{edge: "senders"}
 }
 
+// JobsOrErr returns the Jobs value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) JobsOrErr() ([]*Job, error) {
+	if e.loadedTypes[6] {
+		return e.Jobs, nil
+	}
+	return nil, &NotLoadedError{edge: "jobs"}
+}
+
+// SavedSearchesOrErr returns the SavedSearches value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) SavedSearchesOrErr() ([]*SavedSearch, error) {
+	if e.loadedTypes[7] {
+		return e.SavedSearches, nil
+	}
+	return nil, &NotLoadedError{edge: "saved_searches"}
+}
+
+// DocumentTypesOrErr returns the DocumentTypes value or an error if the edge
+// was not loaded in eager-loading.
+func (e CompanyEdges) DocumentTypesOrErr() ([]*DocumentType, error) {
+	if e.loadedTypes[8] {
+		return e.DocumentTypes, nil
+	}
+	return nil, &NotLoadedError{edge: "document_types"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Company) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case company.FieldSettings:
+			values[i] = new([]byte)
 		case company.FieldName:
 			values[i] = new(sql.NullString)
 		case company.FieldID:
@@ -123,6 +173,14 @@ func (_m *Company) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case company.FieldSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Settings); err != nil {
+					return fmt.Errorf("unmarshal field settings: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,11 +214,31 @@ func (_m *Company) QueryTags() *TagQuery {
 	return NewCompanyClient(_m.config).QueryTags(_m)
 }
 
+// QueryTagGroups queries the "tag_groups" edge of the Company entity.
+func (_m *Company) QueryTagGroups() *TagGroupQuery {
+	return NewCompanyClient(_m.config).QueryTagGroups(_m)
+}
+
 // QuerySenders queries the "senders" edge of the Company entity.
 func (_m *Company) QuerySenders() *SenderQuery {
 	return NewCompanyClient(_m.config).QuerySenders(_m)
 }
 
+// QueryJobs queries the "jobs" edge of the Company entity.
+func (_m *Company) QueryJobs() *JobQuery {
+	return NewCompanyClient(_m.config).QueryJobs(_m)
+}
+
+// QuerySavedSearches queries the "saved_searches" edge of the Company entity.
+func (_m *Company) QuerySavedSearches() *SavedSearchQuery {
+	return NewCompanyClient(_m.config).QuerySavedSearches(_m)
+}
+
+// QueryDocumentTypes queries the "document_types" edge of the Company entity.
+func (_m *Company) QueryDocumentTypes() *DocumentTypeQuery {
+	return NewCompanyClient(_m.config).QueryDocumentTypes(_m)
+}
+
 // Update returns a builder for updating this Company.
 // Note that you need to call Company.Unwrap() before calling this method if this Company
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -186,6 +264,9 @@ func (_m *Company) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("settings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Settings))
 	builder.WriteByte(')')
 	return builder.String()
 }
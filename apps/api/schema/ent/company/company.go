@@ -15,6 +15,8 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldSettings holds the string denoting the settings field in the database.
+	FieldSettings = "settings"
 	// EdgeCompanyUsers holds the string denoting the company_users edge name in mutations.
 	EdgeCompanyUsers = "company_users"
 	// EdgeFolders holds the string denoting the folders edge name in mutations.
@@ -23,8 +25,16 @@ const (
 	EdgeDocuments = "documents"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
+	// EdgeTagGroups holds the string denoting the tag_groups edge name in mutations.
+	EdgeTagGroups = "tag_groups"
 	// EdgeSenders holds the string denoting the senders edge name in mutations.
 	EdgeSenders = "senders"
+	// EdgeJobs holds the string denoting the jobs edge name in mutations.
+	EdgeJobs = "jobs"
+	// EdgeSavedSearches holds the string denoting the saved_searches edge name in mutations.
+	EdgeSavedSearches = "saved_searches"
+	// EdgeDocumentTypes holds the string denoting the document_types edge name in mutations.
+	EdgeDocumentTypes = "document_types"
 	// Table holds the table name of the company in the database.
 	Table = "companies"
 	// CompanyUsersTable is the table that holds the company_users relation/edge.
@@ -55,6 +65,13 @@ const (
 	TagsInverseTable = "tags"
 	// TagsColumn is the table column denoting the tags relation/edge.
 	TagsColumn = "company_id"
+	// TagGroupsTable is the table that holds the tag_groups relation/edge.
+	TagGroupsTable = "tag_groups"
+	// TagGroupsInverseTable is the table name for the TagGroup entity.
+	// It exists in this package in order to avoid circular dependency with the "taggroup" package.
+	TagGroupsInverseTable = "tag_groups"
+	// TagGroupsColumn is the table column denoting the tag_groups relation/edge.
+	TagGroupsColumn = "company_id"
 	// SendersTable is the table that holds the senders relation/edge.
 	SendersTable = "senders"
 	// SendersInverseTable is the table name for the Sender entity.
@@ -62,12 +79,34 @@ const (
 	SendersInverseTable = "senders"
 	// SendersColumn is the table column denoting the senders relation/edge.
 	SendersColumn = "company_id"
+	// JobsTable is the table that holds the jobs relation/edge.
+	JobsTable = "jobs"
+	// JobsInverseTable is the table name for the Job entity.
+	// It exists in this package in order to avoid circular dependency with the "job" package.
+	JobsInverseTable = "jobs"
+	// JobsColumn is the table column denoting the jobs relation/edge.
+	JobsColumn = "company_id"
+	// SavedSearchesTable is the table that holds the saved_searches relation/edge.
+	SavedSearchesTable = "saved_searches"
+	// SavedSearchesInverseTable is the table name for the SavedSearch entity.
+	// It exists in this package in order to avoid circular dependency with the "savedsearch" package.
+	SavedSearchesInverseTable = "saved_searches"
+	// SavedSearchesColumn is the table column denoting the saved_searches relation/edge.
+	SavedSearchesColumn = "company_id"
+	// DocumentTypesTable is the table that holds the document_types relation/edge.
+	DocumentTypesTable = "document_types"
+	// DocumentTypesInverseTable is the table name for the DocumentType entity.
+	// It exists in this package in order to avoid circular dependency with the "documenttype" package.
+	DocumentTypesInverseTable = "document_types"
+	// DocumentTypesColumn is the table column denoting the document_types relation/edge.
+	DocumentTypesColumn = "company_id"
 )
 
 // Columns holds all SQL columns for company fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldSettings,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -156,6 +195,20 @@ func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByTagGroupsCount orders the results by tag_groups count.
+func ByTagGroupsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagGroupsStep(), opts...)
+	}
+}
+
+// ByTagGroups orders the results by tag_groups terms.
+func ByTagGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // BySendersCount orders the results by senders count.
 func BySendersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -169,6 +222,48 @@ func BySenders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSendersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByJobsCount orders the results by jobs count.
+func ByJobsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newJobsStep(), opts...)
+	}
+}
+
+// ByJobs orders the results by jobs terms.
+func ByJobs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newJobsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySavedSearchesCount orders the results by saved_searches count.
+func BySavedSearchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavedSearchesStep(), opts...)
+	}
+}
+
+// BySavedSearches orders the results by saved_searches terms.
+func BySavedSearches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavedSearchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDocumentTypesCount orders the results by document_types count.
+func ByDocumentTypesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDocumentTypesStep(), opts...)
+	}
+}
+
+// ByDocumentTypes orders the results by document_types terms.
+func ByDocumentTypes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentTypesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCompanyUsersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -197,6 +292,13 @@ func newTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
 	)
 }
+func newTagGroupsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagGroupsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagGroupsTable, TagGroupsColumn),
+	)
+}
 func newSendersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -204,3 +306,24 @@ func newSendersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SendersTable, SendersColumn),
 	)
 }
+func newJobsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(JobsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, JobsTable, JobsColumn),
+	)
+}
+func newSavedSearchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavedSearchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavedSearchesTable, SavedSearchesColumn),
+	)
+}
+func newDocumentTypesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentTypesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DocumentTypesTable, DocumentTypesColumn),
+	)
+}
@@ -9,9 +9,14 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
+	"techmind/schema/ent/savedsearch"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/types"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -31,6 +36,20 @@ func (_c *CompanyCreate) SetName(v string) *CompanyCreate {
 	return _c
 }
 
+// SetSettings sets the "settings" field.
+func (_c *CompanyCreate) SetSettings(v types.CompanySettings) *CompanyCreate {
+	_c.mutation.SetSettings(v)
+	return _c
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_c *CompanyCreate) SetNillableSettings(v *types.CompanySettings) *CompanyCreate {
+	if v != nil {
+		_c.SetSettings(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CompanyCreate) SetID(v uuid.UUID) *CompanyCreate {
 	_c.mutation.SetID(v)
@@ -105,6 +124,21 @@ func (_c *CompanyCreate) AddTags(v ...*Tag) *CompanyCreate {
 	return _c.AddTagIDs(ids...)
 }
 
+// AddTagGroupIDs adds the "tag_groups" edge to the TagGroup entity by IDs.
+func (_c *CompanyCreate) AddTagGroupIDs(ids ...uuid.UUID) *CompanyCreate {
+	_c.mutation.AddTagGroupIDs(ids...)
+	return _c
+}
+
+// AddTagGroups adds the "tag_groups" edges to the TagGroup entity.
+func (_c *CompanyCreate) AddTagGroups(v ...*TagGroup) *CompanyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagGroupIDs(ids...)
+}
+
 // AddSenderIDs adds the "senders" edge to the Sender entity by IDs.
 func (_c *CompanyCreate) AddSenderIDs(ids ...uuid.UUID) *CompanyCreate {
 	_c.mutation.AddSenderIDs(ids...)
@@ -120,6 +154,51 @@ func (_c *CompanyCreate) AddSenders(v ...*Sender) *CompanyCreate {
 	return _c.AddSenderIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_c *CompanyCreate) AddJobIDs(ids ...uuid.UUID) *CompanyCreate {
+	_c.mutation.AddJobIDs(ids...)
+	return _c
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_c *CompanyCreate) AddJobs(v ...*Job) *CompanyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddJobIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_c *CompanyCreate) AddSavedSearchIDs(ids ...uuid.UUID) *CompanyCreate {
+	_c.mutation.AddSavedSearchIDs(ids...)
+	return _c
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_c *CompanyCreate) AddSavedSearches(v ...*SavedSearch) *CompanyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSavedSearchIDs(ids...)
+}
+
+// AddDocumentTypeIDs adds the "document_types" edge to the DocumentType entity by IDs.
+func (_c *CompanyCreate) AddDocumentTypeIDs(ids ...uuid.UUID) *CompanyCreate {
+	_c.mutation.AddDocumentTypeIDs(ids...)
+	return _c
+}
+
+// AddDocumentTypes adds the "document_types" edges to the DocumentType entity.
+func (_c *CompanyCreate) AddDocumentTypes(v ...*DocumentType) *CompanyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDocumentTypeIDs(ids...)
+}
+
 // Mutation returns the CompanyMutation object of the builder.
 func (_c *CompanyCreate) Mutation() *CompanyMutation {
 	return _c.mutation
@@ -210,6 +289,10 @@ func (_c *CompanyCreate) createSpec() (*Company, *sqlgraph.CreateSpec) {
 		_spec.SetField(company.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Settings(); ok {
+		_spec.SetField(company.FieldSettings, field.TypeJSON, value)
+		_node.Settings = value
+	}
 	if nodes := _c.mutation.CompanyUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -274,6 +357,22 @@ func (_c *CompanyCreate) createSpec() (*Company, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TagGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.SendersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -290,6 +389,54 @@ func (_c *CompanyCreate) createSpec() (*Company, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DocumentTypesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
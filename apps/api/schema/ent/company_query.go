@@ -10,10 +10,14 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
 	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/savedsearch"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -25,16 +29,20 @@ import (
 // CompanyQuery is the builder for querying Company entities.
 type CompanyQuery struct {
 	config
-	ctx              *QueryContext
-	order            []company.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Company
-	withCompanyUsers *CompanyUserQuery
-	withFolders      *FolderQuery
-	withDocuments    *DocumentQuery
-	withTags         *TagQuery
-	withSenders      *SenderQuery
-	modifiers        []func(*sql.Selector)
+	ctx               *QueryContext
+	order             []company.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.Company
+	withCompanyUsers  *CompanyUserQuery
+	withFolders       *FolderQuery
+	withDocuments     *DocumentQuery
+	withTags          *TagQuery
+	withTagGroups     *TagGroupQuery
+	withSenders       *SenderQuery
+	withJobs          *JobQuery
+	withSavedSearches *SavedSearchQuery
+	withDocumentTypes *DocumentTypeQuery
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -159,6 +167,28 @@ func (_q *CompanyQuery) QueryTags() *TagQuery {
 	return query
 }
 
+// QueryTagGroups chains the current query on the "tag_groups" edge.
+func (_q *CompanyQuery) QueryTagGroups() *TagGroupQuery {
+	query := (&TagGroupClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(taggroup.Table, taggroup.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.TagGroupsTable, company.TagGroupsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QuerySenders chains the current query on the "senders" edge.
 func (_q *CompanyQuery) QuerySenders() *SenderQuery {
 	query := (&SenderClient{config: _q.config}).Query()
@@ -181,6 +211,72 @@ func (_q *CompanyQuery) QuerySenders() *SenderQuery {
 	return query
 }
 
+// QueryJobs chains the current query on the "jobs" edge.
+func (_q *CompanyQuery) QueryJobs() *JobQuery {
+	query := (&JobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(job.Table, job.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.JobsTable, company.JobsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySavedSearches chains the current query on the "saved_searches" edge.
+func (_q *CompanyQuery) QuerySavedSearches() *SavedSearchQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(savedsearch.Table, savedsearch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.SavedSearchesTable, company.SavedSearchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDocumentTypes chains the current query on the "document_types" edge.
+func (_q *CompanyQuery) QueryDocumentTypes() *DocumentTypeQuery {
+	query := (&DocumentTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(company.Table, company.FieldID, selector),
+			sqlgraph.To(documenttype.Table, documenttype.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, company.DocumentTypesTable, company.DocumentTypesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Company entity from the query.
 // Returns a *NotFoundError when no Company was found.
 func (_q *CompanyQuery) First(ctx context.Context) (*Company, error) {
@@ -368,16 +464,20 @@ func (_q *CompanyQuery) Clone() *CompanyQuery {
 		return nil
 	}
 	return &CompanyQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]company.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Company{}, _q.predicates...),
-		withCompanyUsers: _q.withCompanyUsers.Clone(),
-		withFolders:      _q.withFolders.Clone(),
-		withDocuments:    _q.withDocuments.Clone(),
-		withTags:         _q.withTags.Clone(),
-		withSenders:      _q.withSenders.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]company.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.Company{}, _q.predicates...),
+		withCompanyUsers:  _q.withCompanyUsers.Clone(),
+		withFolders:       _q.withFolders.Clone(),
+		withDocuments:     _q.withDocuments.Clone(),
+		withTags:          _q.withTags.Clone(),
+		withTagGroups:     _q.withTagGroups.Clone(),
+		withSenders:       _q.withSenders.Clone(),
+		withJobs:          _q.withJobs.Clone(),
+		withSavedSearches: _q.withSavedSearches.Clone(),
+		withDocumentTypes: _q.withDocumentTypes.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -429,6 +529,17 @@ func (_q *CompanyQuery) WithTags(opts ...func(*TagQuery)) *CompanyQuery {
 	return _q
 }
 
+// WithTagGroups tells the query-builder to eager-load the nodes that are connected to
+// the "tag_groups" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CompanyQuery) WithTagGroups(opts ...func(*TagGroupQuery)) *CompanyQuery {
+	query := (&TagGroupClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTagGroups = query
+	return _q
+}
+
 // WithSenders tells the query-builder to eager-load the nodes that are connected to
 // the "senders" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *CompanyQuery) WithSenders(opts ...func(*SenderQuery)) *CompanyQuery {
@@ -440,6 +551,39 @@ func (_q *CompanyQuery) WithSenders(opts ...func(*SenderQuery)) *CompanyQuery {
 	return _q
 }
 
+// WithJobs tells the query-builder to eager-load the nodes that are connected to
+// the "jobs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CompanyQuery) WithJobs(opts ...func(*JobQuery)) *CompanyQuery {
+	query := (&JobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withJobs = query
+	return _q
+}
+
+// WithSavedSearches tells the query-builder to eager-load the nodes that are connected to
+// the "saved_searches" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CompanyQuery) WithSavedSearches(opts ...func(*SavedSearchQuery)) *CompanyQuery {
+	query := (&SavedSearchClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSavedSearches = query
+	return _q
+}
+
+// WithDocumentTypes tells the query-builder to eager-load the nodes that are connected to
+// the "document_types" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *CompanyQuery) WithDocumentTypes(opts ...func(*DocumentTypeQuery)) *CompanyQuery {
+	query := (&DocumentTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDocumentTypes = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -518,12 +662,16 @@ func (_q *CompanyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comp
 	var (
 		nodes       = []*Company{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [9]bool{
 			_q.withCompanyUsers != nil,
 			_q.withFolders != nil,
 			_q.withDocuments != nil,
 			_q.withTags != nil,
+			_q.withTagGroups != nil,
 			_q.withSenders != nil,
+			_q.withJobs != nil,
+			_q.withSavedSearches != nil,
+			_q.withDocumentTypes != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -575,6 +723,13 @@ func (_q *CompanyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comp
 			return nil, err
 		}
 	}
+	if query := _q.withTagGroups; query != nil {
+		if err := _q.loadTagGroups(ctx, query, nodes,
+			func(n *Company) { n.Edges.TagGroups = []*TagGroup{} },
+			func(n *Company, e *TagGroup) { n.Edges.TagGroups = append(n.Edges.TagGroups, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withSenders; query != nil {
 		if err := _q.loadSenders(ctx, query, nodes,
 			func(n *Company) { n.Edges.Senders = []*Sender{} },
@@ -582,6 +737,27 @@ func (_q *CompanyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Comp
 			return nil, err
 		}
 	}
+	if query := _q.withJobs; query != nil {
+		if err := _q.loadJobs(ctx, query, nodes,
+			func(n *Company) { n.Edges.Jobs = []*Job{} },
+			func(n *Company, e *Job) { n.Edges.Jobs = append(n.Edges.Jobs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSavedSearches; query != nil {
+		if err := _q.loadSavedSearches(ctx, query, nodes,
+			func(n *Company) { n.Edges.SavedSearches = []*SavedSearch{} },
+			func(n *Company, e *SavedSearch) { n.Edges.SavedSearches = append(n.Edges.SavedSearches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDocumentTypes; query != nil {
+		if err := _q.loadDocumentTypes(ctx, query, nodes,
+			func(n *Company) { n.Edges.DocumentTypes = []*DocumentType{} },
+			func(n *Company, e *DocumentType) { n.Edges.DocumentTypes = append(n.Edges.DocumentTypes, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -705,6 +881,36 @@ func (_q *CompanyQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*
 	}
 	return nil
 }
+func (_q *CompanyQuery) loadTagGroups(ctx context.Context, query *TagGroupQuery, nodes []*Company, init func(*Company), assign func(*Company, *TagGroup)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Company)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(taggroup.FieldCompanyID)
+	}
+	query.Where(predicate.TagGroup(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(company.TagGroupsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CompanyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "company_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *CompanyQuery) loadSenders(ctx context.Context, query *SenderQuery, nodes []*Company, init func(*Company), assign func(*Company, *Sender)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Company)
@@ -735,6 +941,99 @@ func (_q *CompanyQuery) loadSenders(ctx context.Context, query *SenderQuery, nod
 	}
 	return nil
 }
+func (_q *CompanyQuery) loadJobs(ctx context.Context, query *JobQuery, nodes []*Company, init func(*Company), assign func(*Company, *Job)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Company)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(job.FieldCompanyID)
+	}
+	query.Where(predicate.Job(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(company.JobsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CompanyID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "company_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "company_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CompanyQuery) loadSavedSearches(ctx context.Context, query *SavedSearchQuery, nodes []*Company, init func(*Company), assign func(*Company, *SavedSearch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Company)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(savedsearch.FieldCompanyID)
+	}
+	query.Where(predicate.SavedSearch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(company.SavedSearchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CompanyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "company_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *CompanyQuery) loadDocumentTypes(ctx context.Context, query *DocumentTypeQuery, nodes []*Company, init func(*Company), assign func(*Company, *DocumentType)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Company)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(documenttype.FieldCompanyID)
+	}
+	query.Where(predicate.DocumentType(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(company.DocumentTypesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.CompanyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "company_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *CompanyQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
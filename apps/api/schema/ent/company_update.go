@@ -9,10 +9,15 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
 	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/savedsearch"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/types"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -48,6 +53,26 @@ func (_u *CompanyUpdate) SetNillableName(v *string) *CompanyUpdate {
 	return _u
 }
 
+// SetSettings sets the "settings" field.
+func (_u *CompanyUpdate) SetSettings(v types.CompanySettings) *CompanyUpdate {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_u *CompanyUpdate) SetNillableSettings(v *types.CompanySettings) *CompanyUpdate {
+	if v != nil {
+		_u.SetSettings(*v)
+	}
+	return _u
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (_u *CompanyUpdate) ClearSettings() *CompanyUpdate {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by IDs.
 func (_u *CompanyUpdate) AddCompanyUserIDs(ids ...uuid.UUID) *CompanyUpdate {
 	_u.mutation.AddCompanyUserIDs(ids...)
@@ -108,6 +133,21 @@ func (_u *CompanyUpdate) AddTags(v ...*Tag) *CompanyUpdate {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddTagGroupIDs adds the "tag_groups" edge to the TagGroup entity by IDs.
+func (_u *CompanyUpdate) AddTagGroupIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.AddTagGroupIDs(ids...)
+	return _u
+}
+
+// AddTagGroups adds the "tag_groups" edges to the TagGroup entity.
+func (_u *CompanyUpdate) AddTagGroups(v ...*TagGroup) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagGroupIDs(ids...)
+}
+
 // AddSenderIDs adds the "senders" edge to the Sender entity by IDs.
 func (_u *CompanyUpdate) AddSenderIDs(ids ...uuid.UUID) *CompanyUpdate {
 	_u.mutation.AddSenderIDs(ids...)
@@ -123,6 +163,51 @@ func (_u *CompanyUpdate) AddSenders(v ...*Sender) *CompanyUpdate {
 	return _u.AddSenderIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_u *CompanyUpdate) AddJobIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_u *CompanyUpdate) AddJobs(v ...*Job) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *CompanyUpdate) AddSavedSearchIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *CompanyUpdate) AddSavedSearches(v ...*SavedSearch) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddDocumentTypeIDs adds the "document_types" edge to the DocumentType entity by IDs.
+func (_u *CompanyUpdate) AddDocumentTypeIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.AddDocumentTypeIDs(ids...)
+	return _u
+}
+
+// AddDocumentTypes adds the "document_types" edges to the DocumentType entity.
+func (_u *CompanyUpdate) AddDocumentTypes(v ...*DocumentType) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentTypeIDs(ids...)
+}
+
 // Mutation returns the CompanyMutation object of the builder.
 func (_u *CompanyUpdate) Mutation() *CompanyMutation {
 	return _u.mutation
@@ -212,6 +297,27 @@ func (_u *CompanyUpdate) RemoveTags(v ...*Tag) *CompanyUpdate {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearTagGroups clears all "tag_groups" edges to the TagGroup entity.
+func (_u *CompanyUpdate) ClearTagGroups() *CompanyUpdate {
+	_u.mutation.ClearTagGroups()
+	return _u
+}
+
+// RemoveTagGroupIDs removes the "tag_groups" edge to TagGroup entities by IDs.
+func (_u *CompanyUpdate) RemoveTagGroupIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.RemoveTagGroupIDs(ids...)
+	return _u
+}
+
+// RemoveTagGroups removes "tag_groups" edges to TagGroup entities.
+func (_u *CompanyUpdate) RemoveTagGroups(v ...*TagGroup) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagGroupIDs(ids...)
+}
+
 // ClearSenders clears all "senders" edges to the Sender entity.
 func (_u *CompanyUpdate) ClearSenders() *CompanyUpdate {
 	_u.mutation.ClearSenders()
@@ -233,6 +339,69 @@ func (_u *CompanyUpdate) RemoveSenders(v ...*Sender) *CompanyUpdate {
 	return _u.RemoveSenderIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (_u *CompanyUpdate) ClearJobs() *CompanyUpdate {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (_u *CompanyUpdate) RemoveJobIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (_u *CompanyUpdate) RemoveJobs(v ...*Job) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *CompanyUpdate) ClearSavedSearches() *CompanyUpdate {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *CompanyUpdate) RemoveSavedSearchIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *CompanyUpdate) RemoveSavedSearches(v ...*SavedSearch) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearDocumentTypes clears all "document_types" edges to the DocumentType entity.
+func (_u *CompanyUpdate) ClearDocumentTypes() *CompanyUpdate {
+	_u.mutation.ClearDocumentTypes()
+	return _u
+}
+
+// RemoveDocumentTypeIDs removes the "document_types" edge to DocumentType entities by IDs.
+func (_u *CompanyUpdate) RemoveDocumentTypeIDs(ids ...uuid.UUID) *CompanyUpdate {
+	_u.mutation.RemoveDocumentTypeIDs(ids...)
+	return _u
+}
+
+// RemoveDocumentTypes removes "document_types" edges to DocumentType entities.
+func (_u *CompanyUpdate) RemoveDocumentTypes(v ...*DocumentType) *CompanyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentTypeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CompanyUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -291,6 +460,12 @@ func (_u *CompanyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(company.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(company.FieldSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.SettingsCleared() {
+		_spec.ClearField(company.FieldSettings, field.TypeJSON)
+	}
 	if _u.mutation.CompanyUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -471,6 +646,51 @@ func (_u *CompanyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TagGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagGroupsIDs(); len(nodes) > 0 && !_u.mutation.TagGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.SendersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -516,6 +736,141 @@ func (_u *CompanyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentTypesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentTypesIDs(); len(nodes) > 0 && !_u.mutation.DocumentTypesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTypesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -552,6 +907,26 @@ func (_u *CompanyUpdateOne) SetNillableName(v *string) *CompanyUpdateOne {
 	return _u
 }
 
+// SetSettings sets the "settings" field.
+func (_u *CompanyUpdateOne) SetSettings(v types.CompanySettings) *CompanyUpdateOne {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetNillableSettings sets the "settings" field if the given value is not nil.
+func (_u *CompanyUpdateOne) SetNillableSettings(v *types.CompanySettings) *CompanyUpdateOne {
+	if v != nil {
+		_u.SetSettings(*v)
+	}
+	return _u
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (_u *CompanyUpdateOne) ClearSettings() *CompanyUpdateOne {
+	_u.mutation.ClearSettings()
+	return _u
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by IDs.
 func (_u *CompanyUpdateOne) AddCompanyUserIDs(ids ...uuid.UUID) *CompanyUpdateOne {
 	_u.mutation.AddCompanyUserIDs(ids...)
@@ -612,6 +987,21 @@ func (_u *CompanyUpdateOne) AddTags(v ...*Tag) *CompanyUpdateOne {
 	return _u.AddTagIDs(ids...)
 }
 
+// AddTagGroupIDs adds the "tag_groups" edge to the TagGroup entity by IDs.
+func (_u *CompanyUpdateOne) AddTagGroupIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.AddTagGroupIDs(ids...)
+	return _u
+}
+
+// AddTagGroups adds the "tag_groups" edges to the TagGroup entity.
+func (_u *CompanyUpdateOne) AddTagGroups(v ...*TagGroup) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagGroupIDs(ids...)
+}
+
 // AddSenderIDs adds the "senders" edge to the Sender entity by IDs.
 func (_u *CompanyUpdateOne) AddSenderIDs(ids ...uuid.UUID) *CompanyUpdateOne {
 	_u.mutation.AddSenderIDs(ids...)
@@ -627,6 +1017,51 @@ func (_u *CompanyUpdateOne) AddSenders(v ...*Sender) *CompanyUpdateOne {
 	return _u.AddSenderIDs(ids...)
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by IDs.
+func (_u *CompanyUpdateOne) AddJobIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.AddJobIDs(ids...)
+	return _u
+}
+
+// AddJobs adds the "jobs" edges to the Job entity.
+func (_u *CompanyUpdateOne) AddJobs(v ...*Job) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddJobIDs(ids...)
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by IDs.
+func (_u *CompanyUpdateOne) AddSavedSearchIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.AddSavedSearchIDs(ids...)
+	return _u
+}
+
+// AddSavedSearches adds the "saved_searches" edges to the SavedSearch entity.
+func (_u *CompanyUpdateOne) AddSavedSearches(v ...*SavedSearch) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavedSearchIDs(ids...)
+}
+
+// AddDocumentTypeIDs adds the "document_types" edge to the DocumentType entity by IDs.
+func (_u *CompanyUpdateOne) AddDocumentTypeIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.AddDocumentTypeIDs(ids...)
+	return _u
+}
+
+// AddDocumentTypes adds the "document_types" edges to the DocumentType entity.
+func (_u *CompanyUpdateOne) AddDocumentTypes(v ...*DocumentType) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentTypeIDs(ids...)
+}
+
 // Mutation returns the CompanyMutation object of the builder.
 func (_u *CompanyUpdateOne) Mutation() *CompanyMutation {
 	return _u.mutation
@@ -716,6 +1151,27 @@ func (_u *CompanyUpdateOne) RemoveTags(v ...*Tag) *CompanyUpdateOne {
 	return _u.RemoveTagIDs(ids...)
 }
 
+// ClearTagGroups clears all "tag_groups" edges to the TagGroup entity.
+func (_u *CompanyUpdateOne) ClearTagGroups() *CompanyUpdateOne {
+	_u.mutation.ClearTagGroups()
+	return _u
+}
+
+// RemoveTagGroupIDs removes the "tag_groups" edge to TagGroup entities by IDs.
+func (_u *CompanyUpdateOne) RemoveTagGroupIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.RemoveTagGroupIDs(ids...)
+	return _u
+}
+
+// RemoveTagGroups removes "tag_groups" edges to TagGroup entities.
+func (_u *CompanyUpdateOne) RemoveTagGroups(v ...*TagGroup) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagGroupIDs(ids...)
+}
+
 // ClearSenders clears all "senders" edges to the Sender entity.
 func (_u *CompanyUpdateOne) ClearSenders() *CompanyUpdateOne {
 	_u.mutation.ClearSenders()
@@ -737,6 +1193,69 @@ func (_u *CompanyUpdateOne) RemoveSenders(v ...*Sender) *CompanyUpdateOne {
 	return _u.RemoveSenderIDs(ids...)
 }
 
+// ClearJobs clears all "jobs" edges to the Job entity.
+func (_u *CompanyUpdateOne) ClearJobs() *CompanyUpdateOne {
+	_u.mutation.ClearJobs()
+	return _u
+}
+
+// RemoveJobIDs removes the "jobs" edge to Job entities by IDs.
+func (_u *CompanyUpdateOne) RemoveJobIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.RemoveJobIDs(ids...)
+	return _u
+}
+
+// RemoveJobs removes "jobs" edges to Job entities.
+func (_u *CompanyUpdateOne) RemoveJobs(v ...*Job) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveJobIDs(ids...)
+}
+
+// ClearSavedSearches clears all "saved_searches" edges to the SavedSearch entity.
+func (_u *CompanyUpdateOne) ClearSavedSearches() *CompanyUpdateOne {
+	_u.mutation.ClearSavedSearches()
+	return _u
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to SavedSearch entities by IDs.
+func (_u *CompanyUpdateOne) RemoveSavedSearchIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.RemoveSavedSearchIDs(ids...)
+	return _u
+}
+
+// RemoveSavedSearches removes "saved_searches" edges to SavedSearch entities.
+func (_u *CompanyUpdateOne) RemoveSavedSearches(v ...*SavedSearch) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavedSearchIDs(ids...)
+}
+
+// ClearDocumentTypes clears all "document_types" edges to the DocumentType entity.
+func (_u *CompanyUpdateOne) ClearDocumentTypes() *CompanyUpdateOne {
+	_u.mutation.ClearDocumentTypes()
+	return _u
+}
+
+// RemoveDocumentTypeIDs removes the "document_types" edge to DocumentType entities by IDs.
+func (_u *CompanyUpdateOne) RemoveDocumentTypeIDs(ids ...uuid.UUID) *CompanyUpdateOne {
+	_u.mutation.RemoveDocumentTypeIDs(ids...)
+	return _u
+}
+
+// RemoveDocumentTypes removes "document_types" edges to DocumentType entities.
+func (_u *CompanyUpdateOne) RemoveDocumentTypes(v ...*DocumentType) *CompanyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentTypeIDs(ids...)
+}
+
 // Where appends a list predicates to the CompanyUpdate builder.
 func (_u *CompanyUpdateOne) Where(ps ...predicate.Company) *CompanyUpdateOne {
 	_u.mutation.Where(ps...)
@@ -825,6 +1344,12 @@ func (_u *CompanyUpdateOne) sqlSave(ctx context.Context) (_node *Company, err er
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(company.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(company.FieldSettings, field.TypeJSON, value)
+	}
+	if _u.mutation.SettingsCleared() {
+		_spec.ClearField(company.FieldSettings, field.TypeJSON)
+	}
 	if _u.mutation.CompanyUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1005,6 +1530,51 @@ func (_u *CompanyUpdateOne) sqlSave(ctx context.Context) (_node *Company, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TagGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagGroupsIDs(); len(nodes) > 0 && !_u.mutation.TagGroupsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagGroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.TagGroupsTable,
+			Columns: []string{company.TagGroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(taggroup.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.SendersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1050,6 +1620,141 @@ func (_u *CompanyUpdateOne) sqlSave(ctx context.Context) (_node *Company, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedJobsIDs(); len(nodes) > 0 && !_u.mutation.JobsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.JobsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.JobsTable,
+			Columns: []string{company.JobsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(job.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavedSearchesIDs(); len(nodes) > 0 && !_u.mutation.SavedSearchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavedSearchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.SavedSearchesTable,
+			Columns: []string{company.SavedSearchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DocumentTypesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentTypesIDs(); len(nodes) > 0 && !_u.mutation.DocumentTypesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTypesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   company.DocumentTypesTable,
+			Columns: []string{company.DocumentTypesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Company{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -3,13 +3,17 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/user"
+	"techmind/schema/types"
 	"time"
 
 	"entgo.io/ent"
@@ -44,6 +48,12 @@ type Document struct {
 	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
 	// UpdatedBy holds the value of the "updated_by" field.
 	UpdatedBy *uuid.UUID `json:"updated_by,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata types.DocumentMetadata `json:"metadata,omitempty"`
+	// TypeID holds the value of the "type_id" field.
+	TypeID *uuid.UUID `json:"type_id,omitempty"`
+	// FieldValues holds the value of the "field_values" field.
+	FieldValues map[string]types.FieldValue `json:"field_values,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -62,15 +72,19 @@ type DocumentEdges struct {
 	Folder *Folder `json:"folder,omitempty"`
 	// Sender holds the value of the sender edge.
 	Sender *Sender `json:"sender,omitempty"`
+	// DocumentType holds the value of the document_type edge.
+	DocumentType *DocumentType `json:"document_type,omitempty"`
 	// CreatedByUser holds the value of the created_by_user edge.
 	CreatedByUser *User `json:"created_by_user,omitempty"`
 	// UpdatedByUser holds the value of the updated_by_user edge.
 	UpdatedByUser *User `json:"updated_by_user,omitempty"`
 	// DocumentTags holds the value of the document_tags edge.
 	DocumentTags []*DocumentTag `json:"document_tags,omitempty"`
+	// Content holds the value of the content edge.
+	Content *DocumentContent `json:"content,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [8]bool
 }
 
 // CompanyOrErr returns the Company value or an error if the edge
@@ -106,12 +120,23 @@ func (e DocumentEdges) SenderOrErr() (*Sender, error) {
 	return nil, &NotLoadedError{edge: "sender"}
 }
 
+// DocumentTypeOrErr returns the DocumentType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentEdges) DocumentTypeOrErr() (*DocumentType, error) {
+	if e.DocumentType != nil {
+		return e.DocumentType, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: documenttype.Label}
+	}
+	return nil, &NotLoadedError{edge: "document_type"}
+}
+
 // CreatedByUserOrErr returns the CreatedByUser value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e DocumentEdges) CreatedByUserOrErr() (*User, error) {
 	if e.CreatedByUser != nil {
 		return e.CreatedByUser, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "created_by_user"}
@@ -122,7 +147,7 @@ func (e DocumentEdges) CreatedByUserOrErr() (*User, error) {
 func (e DocumentEdges) UpdatedByUserOrErr() (*User, error) {
 	if e.UpdatedByUser != nil {
 		return e.UpdatedByUser, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "updated_by_user"}
@@ -131,19 +156,32 @@ func (e DocumentEdges) UpdatedByUserOrErr() (*User, error) {
 // DocumentTagsOrErr returns the DocumentTags value or an error if the edge
 // was not loaded in eager-loading.
 func (e DocumentEdges) DocumentTagsOrErr() ([]*DocumentTag, error) {
-	if e.loadedTypes[5] {
+	if e.loadedTypes[6] {
 		return e.DocumentTags, nil
 	}
 	return nil, &NotLoadedError{edge: "document_tags"}
 }
 
+// ContentOrErr returns the Content value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentEdges) ContentOrErr() (*DocumentContent, error) {
+	if e.Content != nil {
+		return e.Content, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: documentcontent.Label}
+	}
+	return nil, &NotLoadedError{edge: "content"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Document) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case document.FieldFolderID, document.FieldSenderID, document.FieldCreatedBy, document.FieldUpdatedBy:
+		case document.FieldFolderID, document.FieldSenderID, document.FieldCreatedBy, document.FieldUpdatedBy, document.FieldTypeID:
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case document.FieldMetadata, document.FieldFieldValues:
+			values[i] = new([]byte)
 		case document.FieldFileSize:
 			values[i] = new(sql.NullInt64)
 		case document.FieldName, document.FieldFilePath, document.FieldPreviewFilePath, document.FieldMimeType, document.FieldChecksum:
@@ -244,6 +282,29 @@ func (_m *Document) assignValues(columns []string, values []any) error {
 				_m.UpdatedBy = new(uuid.UUID)
 				*_m.UpdatedBy = *value.S.(*uuid.UUID)
 			}
+		case document.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case document.FieldTypeID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field type_id", values[i])
+			} else if value.Valid {
+				_m.TypeID = new(uuid.UUID)
+				*_m.TypeID = *value.S.(*uuid.UUID)
+			}
+		case document.FieldFieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field field_values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FieldValues); err != nil {
+					return fmt.Errorf("unmarshal field field_values: %w", err)
+				}
+			}
 		case document.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -284,6 +345,11 @@ func (_m *Document) QuerySender() *SenderQuery {
 	return NewDocumentClient(_m.config).QuerySender(_m)
 }
 
+// QueryDocumentType queries the "document_type" edge of the Document entity.
+func (_m *Document) QueryDocumentType() *DocumentTypeQuery {
+	return NewDocumentClient(_m.config).QueryDocumentType(_m)
+}
+
 // QueryCreatedByUser queries the "created_by_user" edge of the Document entity.
 func (_m *Document) QueryCreatedByUser() *UserQuery {
 	return NewDocumentClient(_m.config).QueryCreatedByUser(_m)
@@ -299,6 +365,11 @@ func (_m *Document) QueryDocumentTags() *DocumentTagQuery {
 	return NewDocumentClient(_m.config).QueryDocumentTags(_m)
 }
 
+// QueryContent queries the "content" edge of the Document entity.
+func (_m *Document) QueryContent() *DocumentContentQuery {
+	return NewDocumentClient(_m.config).QueryContent(_m)
+}
+
 // Update returns a builder for updating this Document.
 // Note that you need to call Document.Unwrap() before calling this method if this Document
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -365,6 +436,17 @@ func (_m *Document) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
+	builder.WriteString(", ")
+	if v := _m.TypeID; v != nil {
+		builder.WriteString("type_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("field_values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FieldValues))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -37,6 +37,12 @@ const (
 	FieldCreatedBy = "created_by"
 	// FieldUpdatedBy holds the string denoting the updated_by field in the database.
 	FieldUpdatedBy = "updated_by"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldTypeID holds the string denoting the type_id field in the database.
+	FieldTypeID = "type_id"
+	// FieldFieldValues holds the string denoting the field_values field in the database.
+	FieldFieldValues = "field_values"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -47,12 +53,16 @@ const (
 	EdgeFolder = "folder"
 	// EdgeSender holds the string denoting the sender edge name in mutations.
 	EdgeSender = "sender"
+	// EdgeDocumentType holds the string denoting the document_type edge name in mutations.
+	EdgeDocumentType = "document_type"
 	// EdgeCreatedByUser holds the string denoting the created_by_user edge name in mutations.
 	EdgeCreatedByUser = "created_by_user"
 	// EdgeUpdatedByUser holds the string denoting the updated_by_user edge name in mutations.
 	EdgeUpdatedByUser = "updated_by_user"
 	// EdgeDocumentTags holds the string denoting the document_tags edge name in mutations.
 	EdgeDocumentTags = "document_tags"
+	// EdgeContent holds the string denoting the content edge name in mutations.
+	EdgeContent = "content"
 	// Table holds the table name of the document in the database.
 	Table = "documents"
 	// CompanyTable is the table that holds the company relation/edge.
@@ -76,6 +86,13 @@ const (
 	SenderInverseTable = "senders"
 	// SenderColumn is the table column denoting the sender relation/edge.
 	SenderColumn = "sender_id"
+	// DocumentTypeTable is the table that holds the document_type relation/edge.
+	DocumentTypeTable = "documents"
+	// DocumentTypeInverseTable is the table name for the DocumentType entity.
+	// It exists in this package in order to avoid circular dependency with the "documenttype" package.
+	DocumentTypeInverseTable = "document_types"
+	// DocumentTypeColumn is the table column denoting the document_type relation/edge.
+	DocumentTypeColumn = "type_id"
 	// CreatedByUserTable is the table that holds the created_by_user relation/edge.
 	CreatedByUserTable = "documents"
 	// CreatedByUserInverseTable is the table name for the User entity.
@@ -95,6 +112,13 @@ const (
 	// DocumentTagsInverseTable is the table name for the DocumentTag entity.
 	// It exists in this package in order to avoid circular dependency with the "documenttag" package.
 	DocumentTagsInverseTable = "document_tags"
+	// ContentTable is the table that holds the content relation/edge.
+	ContentTable = "document_contents"
+	// ContentInverseTable is the table name for the DocumentContent entity.
+	// It exists in this package in order to avoid circular dependency with the "documentcontent" package.
+	ContentInverseTable = "document_contents"
+	// ContentColumn is the table column denoting the content relation/edge.
+	ContentColumn = "document_id"
 )
 
 // Columns holds all SQL columns for document fields.
@@ -111,6 +135,9 @@ var Columns = []string{
 	FieldSenderID,
 	FieldCreatedBy,
 	FieldUpdatedBy,
+	FieldMetadata,
+	FieldTypeID,
+	FieldFieldValues,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -213,6 +240,11 @@ func ByUpdatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedBy, opts...).ToFunc()
 }
 
+// ByTypeID orders the results by the type_id field.
+func ByTypeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTypeID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -244,6 +276,13 @@ func BySenderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByDocumentTypeField orders the results by document_type field.
+func ByDocumentTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDocumentTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByCreatedByUserField orders the results by created_by_user field.
 func ByCreatedByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -271,6 +310,13 @@ func ByDocumentTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newDocumentTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByContentField orders the results by content field.
+func ByContentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContentStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCompanyStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -292,6 +338,13 @@ func newSenderStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, SenderTable, SenderColumn),
 	)
 }
+func newDocumentTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DocumentTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DocumentTypeTable, DocumentTypeColumn),
+	)
+}
 func newCreatedByUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -313,3 +366,10 @@ func newDocumentTagsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, false, DocumentTagsTable, DocumentTagsPrimaryKey...),
 	)
 }
+func newContentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
+	)
+}
@@ -111,6 +111,11 @@ func UpdatedBy(v uuid.UUID) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldUpdatedBy, v))
 }
 
+// TypeID applies equality check predicate on the "type_id" field. It's identical to TypeIDEQ.
+func TypeID(v uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldTypeID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldCreatedAt, v))
@@ -636,6 +641,56 @@ func UpdatedByNotNil() predicate.Document {
 	return predicate.Document(sql.FieldNotNull(FieldUpdatedBy))
 }
 
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldMetadata))
+}
+
+// TypeIDEQ applies the EQ predicate on the "type_id" field.
+func TypeIDEQ(v uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldEQ(FieldTypeID, v))
+}
+
+// TypeIDNEQ applies the NEQ predicate on the "type_id" field.
+func TypeIDNEQ(v uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldNEQ(FieldTypeID, v))
+}
+
+// TypeIDIn applies the In predicate on the "type_id" field.
+func TypeIDIn(vs ...uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldIn(FieldTypeID, vs...))
+}
+
+// TypeIDNotIn applies the NotIn predicate on the "type_id" field.
+func TypeIDNotIn(vs ...uuid.UUID) predicate.Document {
+	return predicate.Document(sql.FieldNotIn(FieldTypeID, vs...))
+}
+
+// TypeIDIsNil applies the IsNil predicate on the "type_id" field.
+func TypeIDIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldTypeID))
+}
+
+// TypeIDNotNil applies the NotNil predicate on the "type_id" field.
+func TypeIDNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldTypeID))
+}
+
+// FieldValuesIsNil applies the IsNil predicate on the "field_values" field.
+func FieldValuesIsNil() predicate.Document {
+	return predicate.Document(sql.FieldIsNull(FieldFieldValues))
+}
+
+// FieldValuesNotNil applies the NotNil predicate on the "field_values" field.
+func FieldValuesNotNil() predicate.Document {
+	return predicate.Document(sql.FieldNotNull(FieldFieldValues))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Document {
 	return predicate.Document(sql.FieldEQ(FieldCreatedAt, v))
@@ -785,6 +840,29 @@ func HasSenderWith(preds ...predicate.Sender) predicate.Document {
 	})
 }
 
+// HasDocumentType applies the HasEdge predicate on the "document_type" edge.
+func HasDocumentType() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DocumentTypeTable, DocumentTypeColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentTypeWith applies the HasEdge predicate on the "document_type" edge with a given conditions (other predicates).
+func HasDocumentTypeWith(preds ...predicate.DocumentType) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newDocumentTypeStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCreatedByUser applies the HasEdge predicate on the "created_by_user" edge.
 func HasCreatedByUser() predicate.Document {
 	return predicate.Document(func(s *sql.Selector) {
@@ -854,6 +932,29 @@ func HasDocumentTagsWith(preds ...predicate.DocumentTag) predicate.Document {
 	})
 }
 
+// HasContent applies the HasEdge predicate on the "content" edge.
+func HasContent() predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ContentTable, ContentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContentWith applies the HasEdge predicate on the "content" edge with a given conditions (other predicates).
+func HasContentWith(preds ...predicate.DocumentContent) predicate.Document {
+	return predicate.Document(func(s *sql.Selector) {
+		step := newContentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Document) predicate.Document {
 	return predicate.Document(sql.AndPredicates(predicates...))
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/user"
+	"techmind/schema/types"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -132,6 +135,40 @@ func (_c *DocumentCreate) SetNillableUpdatedBy(v *uuid.UUID) *DocumentCreate {
 	return _c
 }
 
+// SetMetadata sets the "metadata" field.
+func (_c *DocumentCreate) SetMetadata(v types.DocumentMetadata) *DocumentCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableMetadata(v *types.DocumentMetadata) *DocumentCreate {
+	if v != nil {
+		_c.SetMetadata(*v)
+	}
+	return _c
+}
+
+// SetTypeID sets the "type_id" field.
+func (_c *DocumentCreate) SetTypeID(v uuid.UUID) *DocumentCreate {
+	_c.mutation.SetTypeID(v)
+	return _c
+}
+
+// SetNillableTypeID sets the "type_id" field if the given value is not nil.
+func (_c *DocumentCreate) SetNillableTypeID(v *uuid.UUID) *DocumentCreate {
+	if v != nil {
+		_c.SetTypeID(*v)
+	}
+	return _c
+}
+
+// SetFieldValues sets the "field_values" field.
+func (_c *DocumentCreate) SetFieldValues(v map[string]types.FieldValue) *DocumentCreate {
+	_c.mutation.SetFieldValues(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *DocumentCreate) SetCreatedAt(v time.Time) *DocumentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -189,6 +226,25 @@ func (_c *DocumentCreate) SetSender(v *Sender) *DocumentCreate {
 	return _c.SetSenderID(v.ID)
 }
 
+// SetDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID.
+func (_c *DocumentCreate) SetDocumentTypeID(id uuid.UUID) *DocumentCreate {
+	_c.mutation.SetDocumentTypeID(id)
+	return _c
+}
+
+// SetNillableDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID if the given value is not nil.
+func (_c *DocumentCreate) SetNillableDocumentTypeID(id *uuid.UUID) *DocumentCreate {
+	if id != nil {
+		_c = _c.SetDocumentTypeID(*id)
+	}
+	return _c
+}
+
+// SetDocumentType sets the "document_type" edge to the DocumentType entity.
+func (_c *DocumentCreate) SetDocumentType(v *DocumentType) *DocumentCreate {
+	return _c.SetDocumentTypeID(v.ID)
+}
+
 // SetCreatedByUserID sets the "created_by_user" edge to the User entity by ID.
 func (_c *DocumentCreate) SetCreatedByUserID(id uuid.UUID) *DocumentCreate {
 	_c.mutation.SetCreatedByUserID(id)
@@ -242,6 +298,25 @@ func (_c *DocumentCreate) AddDocumentTags(v ...*DocumentTag) *DocumentCreate {
 	return _c.AddDocumentTagIDs(ids...)
 }
 
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_c *DocumentCreate) SetContentID(id uuid.UUID) *DocumentCreate {
+	_c.mutation.SetContentID(id)
+	return _c
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_c *DocumentCreate) SetNillableContentID(id *uuid.UUID) *DocumentCreate {
+	if id != nil {
+		_c = _c.SetContentID(*id)
+	}
+	return _c
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_c *DocumentCreate) SetContent(v *DocumentContent) *DocumentCreate {
+	return _c.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_c *DocumentCreate) Mutation() *DocumentMutation {
 	return _c.mutation
@@ -399,6 +474,14 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_spec.SetField(document.FieldChecksum, field.TypeString, value)
 		_node.Checksum = value
 	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(document.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if value, ok := _c.mutation.FieldValues(); ok {
+		_spec.SetField(document.FieldFieldValues, field.TypeJSON, value)
+		_node.FieldValues = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(document.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -458,6 +541,23 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		_node.SenderID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DocumentTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.DocumentTypeTable,
+			Columns: []string{document.DocumentTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TypeID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.CreatedByUserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -508,6 +608,22 @@ func (_c *DocumentCreate) createSpec() (*Document, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,7 +9,9 @@ import (
 	"math"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
 	"techmind/schema/ent/predicate"
 	"techmind/schema/ent/sender"
@@ -32,9 +34,11 @@ type DocumentQuery struct {
 	withCompany       *CompanyQuery
 	withFolder        *FolderQuery
 	withSender        *SenderQuery
+	withDocumentType  *DocumentTypeQuery
 	withCreatedByUser *UserQuery
 	withUpdatedByUser *UserQuery
 	withDocumentTags  *DocumentTagQuery
+	withContent       *DocumentContentQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -138,6 +142,28 @@ func (_q *DocumentQuery) QuerySender() *SenderQuery {
 	return query
 }
 
+// QueryDocumentType chains the current query on the "document_type" edge.
+func (_q *DocumentQuery) QueryDocumentType() *DocumentTypeQuery {
+	query := (&DocumentTypeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(documenttype.Table, documenttype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, document.DocumentTypeTable, document.DocumentTypeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCreatedByUser chains the current query on the "created_by_user" edge.
 func (_q *DocumentQuery) QueryCreatedByUser() *UserQuery {
 	query := (&UserClient{config: _q.config}).Query()
@@ -204,6 +230,28 @@ func (_q *DocumentQuery) QueryDocumentTags() *DocumentTagQuery {
 	return query
 }
 
+// QueryContent chains the current query on the "content" edge.
+func (_q *DocumentQuery) QueryContent() *DocumentContentQuery {
+	query := (&DocumentContentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(document.Table, document.FieldID, selector),
+			sqlgraph.To(documentcontent.Table, documentcontent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, document.ContentTable, document.ContentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Document entity from the query.
 // Returns a *NotFoundError when no Document was found.
 func (_q *DocumentQuery) First(ctx context.Context) (*Document, error) {
@@ -399,9 +447,11 @@ func (_q *DocumentQuery) Clone() *DocumentQuery {
 		withCompany:       _q.withCompany.Clone(),
 		withFolder:        _q.withFolder.Clone(),
 		withSender:        _q.withSender.Clone(),
+		withDocumentType:  _q.withDocumentType.Clone(),
 		withCreatedByUser: _q.withCreatedByUser.Clone(),
 		withUpdatedByUser: _q.withUpdatedByUser.Clone(),
 		withDocumentTags:  _q.withDocumentTags.Clone(),
+		withContent:       _q.withContent.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -442,6 +492,17 @@ func (_q *DocumentQuery) WithSender(opts ...func(*SenderQuery)) *DocumentQuery {
 	return _q
 }
 
+// WithDocumentType tells the query-builder to eager-load the nodes that are connected to
+// the "document_type" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithDocumentType(opts ...func(*DocumentTypeQuery)) *DocumentQuery {
+	query := (&DocumentTypeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDocumentType = query
+	return _q
+}
+
 // WithCreatedByUser tells the query-builder to eager-load the nodes that are connected to
 // the "created_by_user" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *DocumentQuery) WithCreatedByUser(opts ...func(*UserQuery)) *DocumentQuery {
@@ -475,6 +536,17 @@ func (_q *DocumentQuery) WithDocumentTags(opts ...func(*DocumentTagQuery)) *Docu
 	return _q
 }
 
+// WithContent tells the query-builder to eager-load the nodes that are connected to
+// the "content" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DocumentQuery) WithContent(opts ...func(*DocumentContentQuery)) *DocumentQuery {
+	query := (&DocumentContentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContent = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -553,13 +625,15 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 	var (
 		nodes       = []*Document{}
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [8]bool{
 			_q.withCompany != nil,
 			_q.withFolder != nil,
 			_q.withSender != nil,
+			_q.withDocumentType != nil,
 			_q.withCreatedByUser != nil,
 			_q.withUpdatedByUser != nil,
 			_q.withDocumentTags != nil,
+			_q.withContent != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -601,6 +675,12 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 			return nil, err
 		}
 	}
+	if query := _q.withDocumentType; query != nil {
+		if err := _q.loadDocumentType(ctx, query, nodes, nil,
+			func(n *Document, e *DocumentType) { n.Edges.DocumentType = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCreatedByUser; query != nil {
 		if err := _q.loadCreatedByUser(ctx, query, nodes, nil,
 			func(n *Document, e *User) { n.Edges.CreatedByUser = e }); err != nil {
@@ -620,6 +700,12 @@ func (_q *DocumentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Doc
 			return nil, err
 		}
 	}
+	if query := _q.withContent; query != nil {
+		if err := _q.loadContent(ctx, query, nodes, nil,
+			func(n *Document, e *DocumentContent) { n.Edges.Content = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -716,6 +802,38 @@ func (_q *DocumentQuery) loadSender(ctx context.Context, query *SenderQuery, nod
 	}
 	return nil
 }
+func (_q *DocumentQuery) loadDocumentType(ctx context.Context, query *DocumentTypeQuery, nodes []*Document, init func(*Document), assign func(*Document, *DocumentType)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Document)
+	for i := range nodes {
+		if nodes[i].TypeID == nil {
+			continue
+		}
+		fk := *nodes[i].TypeID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(documenttype.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "type_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *DocumentQuery) loadCreatedByUser(ctx context.Context, query *UserQuery, nodes []*Document, init func(*Document), assign func(*Document, *User)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Document)
@@ -841,6 +959,33 @@ func (_q *DocumentQuery) loadDocumentTags(ctx context.Context, query *DocumentTa
 	}
 	return nil
 }
+func (_q *DocumentQuery) loadContent(ctx context.Context, query *DocumentContentQuery, nodes []*Document, init func(*Document), assign func(*Document, *DocumentContent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Document)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(documentcontent.FieldDocumentID)
+	}
+	query.Where(predicate.DocumentContent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(document.ContentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.DocumentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "document_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DocumentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -879,6 +1024,9 @@ func (_q *DocumentQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withSender != nil {
 			_spec.Node.AddColumnOnce(document.FieldSenderID)
 		}
+		if _q.withDocumentType != nil {
+			_spec.Node.AddColumnOnce(document.FieldTypeID)
+		}
 		if _q.withCreatedByUser != nil {
 			_spec.Node.AddColumnOnce(document.FieldCreatedBy)
 		}
@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
 	"techmind/schema/ent/predicate"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/user"
+	"techmind/schema/types"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -226,6 +229,58 @@ func (_u *DocumentUpdate) ClearUpdatedBy() *DocumentUpdate {
 	return _u
 }
 
+// SetMetadata sets the "metadata" field.
+func (_u *DocumentUpdate) SetMetadata(v types.DocumentMetadata) *DocumentUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableMetadata(v *types.DocumentMetadata) *DocumentUpdate {
+	if v != nil {
+		_u.SetMetadata(*v)
+	}
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *DocumentUpdate) ClearMetadata() *DocumentUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetTypeID sets the "type_id" field.
+func (_u *DocumentUpdate) SetTypeID(v uuid.UUID) *DocumentUpdate {
+	_u.mutation.SetTypeID(v)
+	return _u
+}
+
+// SetNillableTypeID sets the "type_id" field if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableTypeID(v *uuid.UUID) *DocumentUpdate {
+	if v != nil {
+		_u.SetTypeID(*v)
+	}
+	return _u
+}
+
+// ClearTypeID clears the value of the "type_id" field.
+func (_u *DocumentUpdate) ClearTypeID() *DocumentUpdate {
+	_u.mutation.ClearTypeID()
+	return _u
+}
+
+// SetFieldValues sets the "field_values" field.
+func (_u *DocumentUpdate) SetFieldValues(v map[string]types.FieldValue) *DocumentUpdate {
+	_u.mutation.SetFieldValues(v)
+	return _u
+}
+
+// ClearFieldValues clears the value of the "field_values" field.
+func (_u *DocumentUpdate) ClearFieldValues() *DocumentUpdate {
+	_u.mutation.ClearFieldValues()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *DocumentUpdate) SetUpdatedAt(v time.Time) *DocumentUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -247,6 +302,25 @@ func (_u *DocumentUpdate) SetSender(v *Sender) *DocumentUpdate {
 	return _u.SetSenderID(v.ID)
 }
 
+// SetDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID.
+func (_u *DocumentUpdate) SetDocumentTypeID(id uuid.UUID) *DocumentUpdate {
+	_u.mutation.SetDocumentTypeID(id)
+	return _u
+}
+
+// SetNillableDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableDocumentTypeID(id *uuid.UUID) *DocumentUpdate {
+	if id != nil {
+		_u = _u.SetDocumentTypeID(*id)
+	}
+	return _u
+}
+
+// SetDocumentType sets the "document_type" edge to the DocumentType entity.
+func (_u *DocumentUpdate) SetDocumentType(v *DocumentType) *DocumentUpdate {
+	return _u.SetDocumentTypeID(v.ID)
+}
+
 // SetCreatedByUserID sets the "created_by_user" edge to the User entity by ID.
 func (_u *DocumentUpdate) SetCreatedByUserID(id uuid.UUID) *DocumentUpdate {
 	_u.mutation.SetCreatedByUserID(id)
@@ -300,6 +374,25 @@ func (_u *DocumentUpdate) AddDocumentTags(v ...*DocumentTag) *DocumentUpdate {
 	return _u.AddDocumentTagIDs(ids...)
 }
 
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_u *DocumentUpdate) SetContentID(id uuid.UUID) *DocumentUpdate {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_u *DocumentUpdate) SetNillableContentID(id *uuid.UUID) *DocumentUpdate {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdate) SetContent(v *DocumentContent) *DocumentUpdate {
+	return _u.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdate) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -323,6 +416,12 @@ func (_u *DocumentUpdate) ClearSender() *DocumentUpdate {
 	return _u
 }
 
+// ClearDocumentType clears the "document_type" edge to the DocumentType entity.
+func (_u *DocumentUpdate) ClearDocumentType() *DocumentUpdate {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
 // ClearCreatedByUser clears the "created_by_user" edge to the User entity.
 func (_u *DocumentUpdate) ClearCreatedByUser() *DocumentUpdate {
 	_u.mutation.ClearCreatedByUser()
@@ -356,6 +455,12 @@ func (_u *DocumentUpdate) RemoveDocumentTags(v ...*DocumentTag) *DocumentUpdate
 	return _u.RemoveDocumentTagIDs(ids...)
 }
 
+// ClearContent clears the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdate) ClearContent() *DocumentUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DocumentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -462,6 +567,18 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Checksum(); ok {
 		_spec.SetField(document.FieldChecksum, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(document.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(document.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FieldValues(); ok {
+		_spec.SetField(document.FieldFieldValues, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldValuesCleared() {
+		_spec.ClearField(document.FieldFieldValues, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -552,6 +669,35 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DocumentTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.DocumentTypeTable,
+			Columns: []string{document.DocumentTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.DocumentTypeTable,
+			Columns: []string{document.DocumentTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CreatedByUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -655,6 +801,35 @@ func (_u *DocumentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -868,6 +1043,58 @@ func (_u *DocumentUpdateOne) ClearUpdatedBy() *DocumentUpdateOne {
 	return _u
 }
 
+// SetMetadata sets the "metadata" field.
+func (_u *DocumentUpdateOne) SetMetadata(v types.DocumentMetadata) *DocumentUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// SetNillableMetadata sets the "metadata" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableMetadata(v *types.DocumentMetadata) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetMetadata(*v)
+	}
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *DocumentUpdateOne) ClearMetadata() *DocumentUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
+// SetTypeID sets the "type_id" field.
+func (_u *DocumentUpdateOne) SetTypeID(v uuid.UUID) *DocumentUpdateOne {
+	_u.mutation.SetTypeID(v)
+	return _u
+}
+
+// SetNillableTypeID sets the "type_id" field if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableTypeID(v *uuid.UUID) *DocumentUpdateOne {
+	if v != nil {
+		_u.SetTypeID(*v)
+	}
+	return _u
+}
+
+// ClearTypeID clears the value of the "type_id" field.
+func (_u *DocumentUpdateOne) ClearTypeID() *DocumentUpdateOne {
+	_u.mutation.ClearTypeID()
+	return _u
+}
+
+// SetFieldValues sets the "field_values" field.
+func (_u *DocumentUpdateOne) SetFieldValues(v map[string]types.FieldValue) *DocumentUpdateOne {
+	_u.mutation.SetFieldValues(v)
+	return _u
+}
+
+// ClearFieldValues clears the value of the "field_values" field.
+func (_u *DocumentUpdateOne) ClearFieldValues() *DocumentUpdateOne {
+	_u.mutation.ClearFieldValues()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *DocumentUpdateOne) SetUpdatedAt(v time.Time) *DocumentUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -889,6 +1116,25 @@ func (_u *DocumentUpdateOne) SetSender(v *Sender) *DocumentUpdateOne {
 	return _u.SetSenderID(v.ID)
 }
 
+// SetDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID.
+func (_u *DocumentUpdateOne) SetDocumentTypeID(id uuid.UUID) *DocumentUpdateOne {
+	_u.mutation.SetDocumentTypeID(id)
+	return _u
+}
+
+// SetNillableDocumentTypeID sets the "document_type" edge to the DocumentType entity by ID if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableDocumentTypeID(id *uuid.UUID) *DocumentUpdateOne {
+	if id != nil {
+		_u = _u.SetDocumentTypeID(*id)
+	}
+	return _u
+}
+
+// SetDocumentType sets the "document_type" edge to the DocumentType entity.
+func (_u *DocumentUpdateOne) SetDocumentType(v *DocumentType) *DocumentUpdateOne {
+	return _u.SetDocumentTypeID(v.ID)
+}
+
 // SetCreatedByUserID sets the "created_by_user" edge to the User entity by ID.
 func (_u *DocumentUpdateOne) SetCreatedByUserID(id uuid.UUID) *DocumentUpdateOne {
 	_u.mutation.SetCreatedByUserID(id)
@@ -942,6 +1188,25 @@ func (_u *DocumentUpdateOne) AddDocumentTags(v ...*DocumentTag) *DocumentUpdateO
 	return _u.AddDocumentTagIDs(ids...)
 }
 
+// SetContentID sets the "content" edge to the DocumentContent entity by ID.
+func (_u *DocumentUpdateOne) SetContentID(id uuid.UUID) *DocumentUpdateOne {
+	_u.mutation.SetContentID(id)
+	return _u
+}
+
+// SetNillableContentID sets the "content" edge to the DocumentContent entity by ID if the given value is not nil.
+func (_u *DocumentUpdateOne) SetNillableContentID(id *uuid.UUID) *DocumentUpdateOne {
+	if id != nil {
+		_u = _u.SetContentID(*id)
+	}
+	return _u
+}
+
+// SetContent sets the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdateOne) SetContent(v *DocumentContent) *DocumentUpdateOne {
+	return _u.SetContentID(v.ID)
+}
+
 // Mutation returns the DocumentMutation object of the builder.
 func (_u *DocumentUpdateOne) Mutation() *DocumentMutation {
 	return _u.mutation
@@ -965,6 +1230,12 @@ func (_u *DocumentUpdateOne) ClearSender() *DocumentUpdateOne {
 	return _u
 }
 
+// ClearDocumentType clears the "document_type" edge to the DocumentType entity.
+func (_u *DocumentUpdateOne) ClearDocumentType() *DocumentUpdateOne {
+	_u.mutation.ClearDocumentType()
+	return _u
+}
+
 // ClearCreatedByUser clears the "created_by_user" edge to the User entity.
 func (_u *DocumentUpdateOne) ClearCreatedByUser() *DocumentUpdateOne {
 	_u.mutation.ClearCreatedByUser()
@@ -998,6 +1269,12 @@ func (_u *DocumentUpdateOne) RemoveDocumentTags(v ...*DocumentTag) *DocumentUpda
 	return _u.RemoveDocumentTagIDs(ids...)
 }
 
+// ClearContent clears the "content" edge to the DocumentContent entity.
+func (_u *DocumentUpdateOne) ClearContent() *DocumentUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
 // Where appends a list predicates to the DocumentUpdate builder.
 func (_u *DocumentUpdateOne) Where(ps ...predicate.Document) *DocumentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1134,6 +1411,18 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 	if value, ok := _u.mutation.Checksum(); ok {
 		_spec.SetField(document.FieldChecksum, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(document.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(document.FieldMetadata, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.FieldValues(); ok {
+		_spec.SetField(document.FieldFieldValues, field.TypeJSON, value)
+	}
+	if _u.mutation.FieldValuesCleared() {
+		_spec.ClearField(document.FieldFieldValues, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(document.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1224,6 +1513,35 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DocumentTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.DocumentTypeTable,
+			Columns: []string{document.DocumentTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   document.DocumentTypeTable,
+			Columns: []string{document.DocumentTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CreatedByUserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1327,6 +1645,35 @@ func (_u *DocumentUpdateOne) sqlSave(ctx context.Context) (_node *Document, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ContentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   document.ContentTable,
+			Columns: []string{document.ContentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Document{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DocumentClassifier is the model entity for the DocumentClassifier schema.
+type DocumentClassifier struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CompanyID holds the value of the "company_id" field.
+	CompanyID uuid.UUID `json:"company_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model types.ClassifierModel `json:"model,omitempty"`
+	// Evaluation holds the value of the "evaluation" field.
+	Evaluation types.ClassifierEvaluation `json:"evaluation,omitempty"`
+	// Documents holds the value of the "documents" field.
+	Documents int `json:"documents,omitempty"`
+	// TrainedAt holds the value of the "trained_at" field.
+	TrainedAt    time.Time `json:"trained_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentClassifier) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documentclassifier.FieldModel, documentclassifier.FieldEvaluation:
+			values[i] = new([]byte)
+		case documentclassifier.FieldDocuments:
+			values[i] = new(sql.NullInt64)
+		case documentclassifier.FieldTrainedAt:
+			values[i] = new(sql.NullTime)
+		case documentclassifier.FieldID, documentclassifier.FieldCompanyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentClassifier fields.
+func (_m *DocumentClassifier) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documentclassifier.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documentclassifier.FieldCompanyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field company_id", values[i])
+			} else if value != nil {
+				_m.CompanyID = *value
+			}
+		case documentclassifier.FieldModel:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Model); err != nil {
+					return fmt.Errorf("unmarshal field model: %w", err)
+				}
+			}
+		case documentclassifier.FieldEvaluation:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field evaluation", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Evaluation); err != nil {
+					return fmt.Errorf("unmarshal field evaluation: %w", err)
+				}
+			}
+		case documentclassifier.FieldDocuments:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field documents", values[i])
+			} else if value.Valid {
+				_m.Documents = int(value.Int64)
+			}
+		case documentclassifier.FieldTrainedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field trained_at", values[i])
+			} else if value.Valid {
+				_m.TrainedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentClassifier.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentClassifier) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DocumentClassifier.
+// Note that you need to call DocumentClassifier.Unwrap() before calling this method if this DocumentClassifier
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentClassifier) Update() *DocumentClassifierUpdateOne {
+	return NewDocumentClassifierClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentClassifier entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentClassifier) Unwrap() *DocumentClassifier {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentClassifier is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentClassifier) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentClassifier(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("company_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompanyID))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Model))
+	builder.WriteString(", ")
+	builder.WriteString("evaluation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Evaluation))
+	builder.WriteString(", ")
+	builder.WriteString("documents=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Documents))
+	builder.WriteString(", ")
+	builder.WriteString("trained_at=")
+	builder.WriteString(_m.TrainedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentClassifiers is a parsable slice of DocumentClassifier.
+type DocumentClassifiers []*DocumentClassifier
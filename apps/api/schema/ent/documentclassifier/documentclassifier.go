@@ -0,0 +1,83 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentclassifier
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the documentclassifier type in the database.
+	Label = "document_classifier"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCompanyID holds the string denoting the company_id field in the database.
+	FieldCompanyID = "company_id"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldEvaluation holds the string denoting the evaluation field in the database.
+	FieldEvaluation = "evaluation"
+	// FieldDocuments holds the string denoting the documents field in the database.
+	FieldDocuments = "documents"
+	// FieldTrainedAt holds the string denoting the trained_at field in the database.
+	FieldTrainedAt = "trained_at"
+	// Table holds the table name of the documentclassifier in the database.
+	Table = "document_classifiers"
+)
+
+// Columns holds all SQL columns for documentclassifier fields.
+var Columns = []string{
+	FieldID,
+	FieldCompanyID,
+	FieldModel,
+	FieldEvaluation,
+	FieldDocuments,
+	FieldTrainedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDocuments holds the default value on creation for the "documents" field.
+	DefaultDocuments int
+	// DocumentsValidator is a validator for the "documents" field. It is called by the builders before save.
+	DocumentsValidator func(int) error
+	// DefaultTrainedAt holds the default value on creation for the "trained_at" field.
+	DefaultTrainedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the DocumentClassifier queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCompanyID orders the results by the company_id field.
+func ByCompanyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompanyID, opts...).ToFunc()
+}
+
+// ByDocuments orders the results by the documents field.
+func ByDocuments(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDocuments, opts...).ToFunc()
+}
+
+// ByTrainedAt orders the results by the trained_at field.
+func ByTrainedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrainedAt, opts...).ToFunc()
+}
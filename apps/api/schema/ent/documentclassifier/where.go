@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentclassifier
+
+import (
+	"techmind/schema/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLTE(FieldID, id))
+}
+
+// CompanyID applies equality check predicate on the "company_id" field. It's identical to CompanyIDEQ.
+func CompanyID(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldCompanyID, v))
+}
+
+// Documents applies equality check predicate on the "documents" field. It's identical to DocumentsEQ.
+func Documents(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldDocuments, v))
+}
+
+// TrainedAt applies equality check predicate on the "trained_at" field. It's identical to TrainedAtEQ.
+func TrainedAt(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldTrainedAt, v))
+}
+
+// CompanyIDEQ applies the EQ predicate on the "company_id" field.
+func CompanyIDEQ(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldCompanyID, v))
+}
+
+// CompanyIDNEQ applies the NEQ predicate on the "company_id" field.
+func CompanyIDNEQ(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNEQ(FieldCompanyID, v))
+}
+
+// CompanyIDIn applies the In predicate on the "company_id" field.
+func CompanyIDIn(vs ...uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldIn(FieldCompanyID, vs...))
+}
+
+// CompanyIDNotIn applies the NotIn predicate on the "company_id" field.
+func CompanyIDNotIn(vs ...uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNotIn(FieldCompanyID, vs...))
+}
+
+// CompanyIDGT applies the GT predicate on the "company_id" field.
+func CompanyIDGT(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGT(FieldCompanyID, v))
+}
+
+// CompanyIDGTE applies the GTE predicate on the "company_id" field.
+func CompanyIDGTE(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGTE(FieldCompanyID, v))
+}
+
+// CompanyIDLT applies the LT predicate on the "company_id" field.
+func CompanyIDLT(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLT(FieldCompanyID, v))
+}
+
+// CompanyIDLTE applies the LTE predicate on the "company_id" field.
+func CompanyIDLTE(v uuid.UUID) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLTE(FieldCompanyID, v))
+}
+
+// DocumentsEQ applies the EQ predicate on the "documents" field.
+func DocumentsEQ(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldDocuments, v))
+}
+
+// DocumentsNEQ applies the NEQ predicate on the "documents" field.
+func DocumentsNEQ(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNEQ(FieldDocuments, v))
+}
+
+// DocumentsIn applies the In predicate on the "documents" field.
+func DocumentsIn(vs ...int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldIn(FieldDocuments, vs...))
+}
+
+// DocumentsNotIn applies the NotIn predicate on the "documents" field.
+func DocumentsNotIn(vs ...int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNotIn(FieldDocuments, vs...))
+}
+
+// DocumentsGT applies the GT predicate on the "documents" field.
+func DocumentsGT(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGT(FieldDocuments, v))
+}
+
+// DocumentsGTE applies the GTE predicate on the "documents" field.
+func DocumentsGTE(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGTE(FieldDocuments, v))
+}
+
+// DocumentsLT applies the LT predicate on the "documents" field.
+func DocumentsLT(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLT(FieldDocuments, v))
+}
+
+// DocumentsLTE applies the LTE predicate on the "documents" field.
+func DocumentsLTE(v int) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLTE(FieldDocuments, v))
+}
+
+// TrainedAtEQ applies the EQ predicate on the "trained_at" field.
+func TrainedAtEQ(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldEQ(FieldTrainedAt, v))
+}
+
+// TrainedAtNEQ applies the NEQ predicate on the "trained_at" field.
+func TrainedAtNEQ(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNEQ(FieldTrainedAt, v))
+}
+
+// TrainedAtIn applies the In predicate on the "trained_at" field.
+func TrainedAtIn(vs ...time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldIn(FieldTrainedAt, vs...))
+}
+
+// TrainedAtNotIn applies the NotIn predicate on the "trained_at" field.
+func TrainedAtNotIn(vs ...time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldNotIn(FieldTrainedAt, vs...))
+}
+
+// TrainedAtGT applies the GT predicate on the "trained_at" field.
+func TrainedAtGT(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGT(FieldTrainedAt, v))
+}
+
+// TrainedAtGTE applies the GTE predicate on the "trained_at" field.
+func TrainedAtGTE(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldGTE(FieldTrainedAt, v))
+}
+
+// TrainedAtLT applies the LT predicate on the "trained_at" field.
+func TrainedAtLT(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLT(FieldTrainedAt, v))
+}
+
+// TrainedAtLTE applies the LTE predicate on the "trained_at" field.
+func TrainedAtLTE(v time.Time) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.FieldLTE(FieldTrainedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DocumentClassifier) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DocumentClassifier) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DocumentClassifier) predicate.DocumentClassifier {
+	return predicate.DocumentClassifier(sql.NotPredicates(p))
+}
@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentClassifierCreate is the builder for creating a DocumentClassifier entity.
+type DocumentClassifierCreate struct {
+	config
+	mutation *DocumentClassifierMutation
+	hooks    []Hook
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_c *DocumentClassifierCreate) SetCompanyID(v uuid.UUID) *DocumentClassifierCreate {
+	_c.mutation.SetCompanyID(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *DocumentClassifierCreate) SetModel(v types.ClassifierModel) *DocumentClassifierCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetEvaluation sets the "evaluation" field.
+func (_c *DocumentClassifierCreate) SetEvaluation(v types.ClassifierEvaluation) *DocumentClassifierCreate {
+	_c.mutation.SetEvaluation(v)
+	return _c
+}
+
+// SetDocuments sets the "documents" field.
+func (_c *DocumentClassifierCreate) SetDocuments(v int) *DocumentClassifierCreate {
+	_c.mutation.SetDocuments(v)
+	return _c
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (_c *DocumentClassifierCreate) SetNillableDocuments(v *int) *DocumentClassifierCreate {
+	if v != nil {
+		_c.SetDocuments(*v)
+	}
+	return _c
+}
+
+// SetTrainedAt sets the "trained_at" field.
+func (_c *DocumentClassifierCreate) SetTrainedAt(v time.Time) *DocumentClassifierCreate {
+	_c.mutation.SetTrainedAt(v)
+	return _c
+}
+
+// SetNillableTrainedAt sets the "trained_at" field if the given value is not nil.
+func (_c *DocumentClassifierCreate) SetNillableTrainedAt(v *time.Time) *DocumentClassifierCreate {
+	if v != nil {
+		_c.SetTrainedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentClassifierCreate) SetID(v uuid.UUID) *DocumentClassifierCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DocumentClassifierCreate) SetNillableID(v *uuid.UUID) *DocumentClassifierCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DocumentClassifierMutation object of the builder.
+func (_c *DocumentClassifierCreate) Mutation() *DocumentClassifierMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentClassifier in the database.
+func (_c *DocumentClassifierCreate) Save(ctx context.Context) (*DocumentClassifier, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentClassifierCreate) SaveX(ctx context.Context) *DocumentClassifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentClassifierCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentClassifierCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentClassifierCreate) defaults() {
+	if _, ok := _c.mutation.Documents(); !ok {
+		v := documentclassifier.DefaultDocuments
+		_c.mutation.SetDocuments(v)
+	}
+	if _, ok := _c.mutation.TrainedAt(); !ok {
+		v := documentclassifier.DefaultTrainedAt()
+		_c.mutation.SetTrainedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := documentclassifier.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentClassifierCreate) check() error {
+	if _, ok := _c.mutation.CompanyID(); !ok {
+		return &ValidationError{Name: "company_id", err: errors.New(`ent: missing required field "DocumentClassifier.company_id"`)}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "DocumentClassifier.model"`)}
+	}
+	if _, ok := _c.mutation.Evaluation(); !ok {
+		return &ValidationError{Name: "evaluation", err: errors.New(`ent: missing required field "DocumentClassifier.evaluation"`)}
+	}
+	if _, ok := _c.mutation.Documents(); !ok {
+		return &ValidationError{Name: "documents", err: errors.New(`ent: missing required field "DocumentClassifier.documents"`)}
+	}
+	if v, ok := _c.mutation.Documents(); ok {
+		if err := documentclassifier.DocumentsValidator(v); err != nil {
+			return &ValidationError{Name: "documents", err: fmt.Errorf(`ent: validator failed for field "DocumentClassifier.documents": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TrainedAt(); !ok {
+		return &ValidationError{Name: "trained_at", err: errors.New(`ent: missing required field "DocumentClassifier.trained_at"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentClassifierCreate) sqlSave(ctx context.Context) (*DocumentClassifier, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentClassifierCreate) createSpec() (*DocumentClassifier, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentClassifier{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documentclassifier.Table, sqlgraph.NewFieldSpec(documentclassifier.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CompanyID(); ok {
+		_spec.SetField(documentclassifier.FieldCompanyID, field.TypeUUID, value)
+		_node.CompanyID = value
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(documentclassifier.FieldModel, field.TypeJSON, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Evaluation(); ok {
+		_spec.SetField(documentclassifier.FieldEvaluation, field.TypeJSON, value)
+		_node.Evaluation = value
+	}
+	if value, ok := _c.mutation.Documents(); ok {
+		_spec.SetField(documentclassifier.FieldDocuments, field.TypeInt, value)
+		_node.Documents = value
+	}
+	if value, ok := _c.mutation.TrainedAt(); ok {
+		_spec.SetField(documentclassifier.FieldTrainedAt, field.TypeTime, value)
+		_node.TrainedAt = value
+	}
+	return _node, _spec
+}
+
+// DocumentClassifierCreateBulk is the builder for creating many DocumentClassifier entities in bulk.
+type DocumentClassifierCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentClassifierCreate
+}
+
+// Save creates the DocumentClassifier entities in the database.
+func (_c *DocumentClassifierCreateBulk) Save(ctx context.Context) ([]*DocumentClassifier, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentClassifier, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentClassifierMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentClassifierCreateBulk) SaveX(ctx context.Context) []*DocumentClassifier {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentClassifierCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentClassifierCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DocumentClassifierDelete is the builder for deleting a DocumentClassifier entity.
+type DocumentClassifierDelete struct {
+	config
+	hooks    []Hook
+	mutation *DocumentClassifierMutation
+}
+
+// Where appends a list predicates to the DocumentClassifierDelete builder.
+func (_d *DocumentClassifierDelete) Where(ps ...predicate.DocumentClassifier) *DocumentClassifierDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DocumentClassifierDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentClassifierDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DocumentClassifierDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(documentclassifier.Table, sqlgraph.NewFieldSpec(documentclassifier.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DocumentClassifierDeleteOne is the builder for deleting a single DocumentClassifier entity.
+type DocumentClassifierDeleteOne struct {
+	_d *DocumentClassifierDelete
+}
+
+// Where appends a list predicates to the DocumentClassifierDelete builder.
+func (_d *DocumentClassifierDeleteOne) Where(ps ...predicate.DocumentClassifier) *DocumentClassifierDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DocumentClassifierDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{documentclassifier.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentClassifierDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
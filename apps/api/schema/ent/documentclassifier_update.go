@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/types"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DocumentClassifierUpdate is the builder for updating DocumentClassifier entities.
+type DocumentClassifierUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentClassifierMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentClassifierUpdate builder.
+func (_u *DocumentClassifierUpdate) Where(ps ...predicate.DocumentClassifier) *DocumentClassifierUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *DocumentClassifierUpdate) SetModel(v types.ClassifierModel) *DocumentClassifierUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *DocumentClassifierUpdate) SetNillableModel(v *types.ClassifierModel) *DocumentClassifierUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetEvaluation sets the "evaluation" field.
+func (_u *DocumentClassifierUpdate) SetEvaluation(v types.ClassifierEvaluation) *DocumentClassifierUpdate {
+	_u.mutation.SetEvaluation(v)
+	return _u
+}
+
+// SetNillableEvaluation sets the "evaluation" field if the given value is not nil.
+func (_u *DocumentClassifierUpdate) SetNillableEvaluation(v *types.ClassifierEvaluation) *DocumentClassifierUpdate {
+	if v != nil {
+		_u.SetEvaluation(*v)
+	}
+	return _u
+}
+
+// SetDocuments sets the "documents" field.
+func (_u *DocumentClassifierUpdate) SetDocuments(v int) *DocumentClassifierUpdate {
+	_u.mutation.ResetDocuments()
+	_u.mutation.SetDocuments(v)
+	return _u
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (_u *DocumentClassifierUpdate) SetNillableDocuments(v *int) *DocumentClassifierUpdate {
+	if v != nil {
+		_u.SetDocuments(*v)
+	}
+	return _u
+}
+
+// AddDocuments adds value to the "documents" field.
+func (_u *DocumentClassifierUpdate) AddDocuments(v int) *DocumentClassifierUpdate {
+	_u.mutation.AddDocuments(v)
+	return _u
+}
+
+// Mutation returns the DocumentClassifierMutation object of the builder.
+func (_u *DocumentClassifierUpdate) Mutation() *DocumentClassifierMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentClassifierUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentClassifierUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentClassifierUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentClassifierUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentClassifierUpdate) check() error {
+	if v, ok := _u.mutation.Documents(); ok {
+		if err := documentclassifier.DocumentsValidator(v); err != nil {
+			return &ValidationError{Name: "documents", err: fmt.Errorf(`ent: validator failed for field "DocumentClassifier.documents": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentClassifierUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentClassifierUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentClassifierUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentclassifier.Table, documentclassifier.Columns, sqlgraph.NewFieldSpec(documentclassifier.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(documentclassifier.FieldModel, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Evaluation(); ok {
+		_spec.SetField(documentclassifier.FieldEvaluation, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Documents(); ok {
+		_spec.SetField(documentclassifier.FieldDocuments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocuments(); ok {
+		_spec.AddField(documentclassifier.FieldDocuments, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentclassifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentClassifierUpdateOne is the builder for updating a single DocumentClassifier entity.
+type DocumentClassifierUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentClassifierMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModel sets the "model" field.
+func (_u *DocumentClassifierUpdateOne) SetModel(v types.ClassifierModel) *DocumentClassifierUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *DocumentClassifierUpdateOne) SetNillableModel(v *types.ClassifierModel) *DocumentClassifierUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetEvaluation sets the "evaluation" field.
+func (_u *DocumentClassifierUpdateOne) SetEvaluation(v types.ClassifierEvaluation) *DocumentClassifierUpdateOne {
+	_u.mutation.SetEvaluation(v)
+	return _u
+}
+
+// SetNillableEvaluation sets the "evaluation" field if the given value is not nil.
+func (_u *DocumentClassifierUpdateOne) SetNillableEvaluation(v *types.ClassifierEvaluation) *DocumentClassifierUpdateOne {
+	if v != nil {
+		_u.SetEvaluation(*v)
+	}
+	return _u
+}
+
+// SetDocuments sets the "documents" field.
+func (_u *DocumentClassifierUpdateOne) SetDocuments(v int) *DocumentClassifierUpdateOne {
+	_u.mutation.ResetDocuments()
+	_u.mutation.SetDocuments(v)
+	return _u
+}
+
+// SetNillableDocuments sets the "documents" field if the given value is not nil.
+func (_u *DocumentClassifierUpdateOne) SetNillableDocuments(v *int) *DocumentClassifierUpdateOne {
+	if v != nil {
+		_u.SetDocuments(*v)
+	}
+	return _u
+}
+
+// AddDocuments adds value to the "documents" field.
+func (_u *DocumentClassifierUpdateOne) AddDocuments(v int) *DocumentClassifierUpdateOne {
+	_u.mutation.AddDocuments(v)
+	return _u
+}
+
+// Mutation returns the DocumentClassifierMutation object of the builder.
+func (_u *DocumentClassifierUpdateOne) Mutation() *DocumentClassifierMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DocumentClassifierUpdate builder.
+func (_u *DocumentClassifierUpdateOne) Where(ps ...predicate.DocumentClassifier) *DocumentClassifierUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentClassifierUpdateOne) Select(field string, fields ...string) *DocumentClassifierUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentClassifier entity.
+func (_u *DocumentClassifierUpdateOne) Save(ctx context.Context) (*DocumentClassifier, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentClassifierUpdateOne) SaveX(ctx context.Context) *DocumentClassifier {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentClassifierUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentClassifierUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentClassifierUpdateOne) check() error {
+	if v, ok := _u.mutation.Documents(); ok {
+		if err := documentclassifier.DocumentsValidator(v); err != nil {
+			return &ValidationError{Name: "documents", err: fmt.Errorf(`ent: validator failed for field "DocumentClassifier.documents": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentClassifierUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentClassifierUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentClassifierUpdateOne) sqlSave(ctx context.Context) (_node *DocumentClassifier, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentclassifier.Table, documentclassifier.Columns, sqlgraph.NewFieldSpec(documentclassifier.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentClassifier.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documentclassifier.FieldID)
+		for _, f := range fields {
+			if !documentclassifier.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documentclassifier.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(documentclassifier.FieldModel, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Evaluation(); ok {
+		_spec.SetField(documentclassifier.FieldEvaluation, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Documents(); ok {
+		_spec.SetField(documentclassifier.FieldDocuments, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDocuments(); ok {
+		_spec.AddField(documentclassifier.FieldDocuments, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentClassifier{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentclassifier.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DocumentContent is the model entity for the DocumentContent schema.
+type DocumentContent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Pages holds the value of the "pages" field.
+	Pages []types.PageOffset `json:"pages,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Engine holds the value of the "engine" field.
+	Engine string `json:"engine,omitempty"`
+	// EngineVersion holds the value of the "engine_version" field.
+	EngineVersion string `json:"engine_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DocumentContentQuery when eager-loading is set.
+	Edges        DocumentContentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DocumentContentEdges holds the relations/edges for other nodes in the graph.
+type DocumentContentEdges struct {
+	// Document holds the value of the document edge.
+	Document *Document `json:"document,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DocumentOrErr returns the Document value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentContentEdges) DocumentOrErr() (*Document, error) {
+	if e.Document != nil {
+		return e.Document, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: document.Label}
+	}
+	return nil, &NotLoadedError{edge: "document"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentContent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documentcontent.FieldPages:
+			values[i] = new([]byte)
+		case documentcontent.FieldText, documentcontent.FieldLanguage, documentcontent.FieldEngine, documentcontent.FieldEngineVersion:
+			values[i] = new(sql.NullString)
+		case documentcontent.FieldCreatedAt, documentcontent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case documentcontent.FieldID, documentcontent.FieldDocumentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentContent fields.
+func (_m *DocumentContent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documentcontent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documentcontent.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				_m.DocumentID = *value
+			}
+		case documentcontent.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case documentcontent.FieldPages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Pages); err != nil {
+					return fmt.Errorf("unmarshal field pages: %w", err)
+				}
+			}
+		case documentcontent.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				_m.Language = value.String
+			}
+		case documentcontent.FieldEngine:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine", values[i])
+			} else if value.Valid {
+				_m.Engine = value.String
+			}
+		case documentcontent.FieldEngineVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field engine_version", values[i])
+			} else if value.Valid {
+				_m.EngineVersion = value.String
+			}
+		case documentcontent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case documentcontent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentContent.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentContent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDocument queries the "document" edge of the DocumentContent entity.
+func (_m *DocumentContent) QueryDocument() *DocumentQuery {
+	return NewDocumentContentClient(_m.config).QueryDocument(_m)
+}
+
+// Update returns a builder for updating this DocumentContent.
+// Note that you need to call DocumentContent.Unwrap() before calling this method if this DocumentContent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentContent) Update() *DocumentContentUpdateOne {
+	return NewDocumentContentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentContent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentContent) Unwrap() *DocumentContent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentContent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentContent) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentContent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("pages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pages))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(_m.Language)
+	builder.WriteString(", ")
+	builder.WriteString("engine=")
+	builder.WriteString(_m.Engine)
+	builder.WriteString(", ")
+	builder.WriteString("engine_version=")
+	builder.WriteString(_m.EngineVersion)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentContents is a parsable slice of DocumentContent.
+type DocumentContents []*DocumentContent
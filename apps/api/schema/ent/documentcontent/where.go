@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documentcontent
+
+import (
+	"techmind/schema/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldID, id))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldText, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldLanguage, v))
+}
+
+// Engine applies equality check predicate on the "engine" field. It's identical to EngineEQ.
+func Engine(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldEngine, v))
+}
+
+// EngineVersion applies equality check predicate on the "engine_version" field. It's identical to EngineVersionEQ.
+func EngineVersion(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...uuid.UUID) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldText, v))
+}
+
+// PagesIsNil applies the IsNil predicate on the "pages" field.
+func PagesIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldPages))
+}
+
+// PagesNotNil applies the NotNil predicate on the "pages" field.
+func PagesNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldPages))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// EngineEQ applies the EQ predicate on the "engine" field.
+func EngineEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldEngine, v))
+}
+
+// EngineNEQ applies the NEQ predicate on the "engine" field.
+func EngineNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldEngine, v))
+}
+
+// EngineIn applies the In predicate on the "engine" field.
+func EngineIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldEngine, vs...))
+}
+
+// EngineNotIn applies the NotIn predicate on the "engine" field.
+func EngineNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldEngine, vs...))
+}
+
+// EngineGT applies the GT predicate on the "engine" field.
+func EngineGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldEngine, v))
+}
+
+// EngineGTE applies the GTE predicate on the "engine" field.
+func EngineGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldEngine, v))
+}
+
+// EngineLT applies the LT predicate on the "engine" field.
+func EngineLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldEngine, v))
+}
+
+// EngineLTE applies the LTE predicate on the "engine" field.
+func EngineLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldEngine, v))
+}
+
+// EngineContains applies the Contains predicate on the "engine" field.
+func EngineContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldEngine, v))
+}
+
+// EngineHasPrefix applies the HasPrefix predicate on the "engine" field.
+func EngineHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldEngine, v))
+}
+
+// EngineHasSuffix applies the HasSuffix predicate on the "engine" field.
+func EngineHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldEngine, v))
+}
+
+// EngineEqualFold applies the EqualFold predicate on the "engine" field.
+func EngineEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldEngine, v))
+}
+
+// EngineContainsFold applies the ContainsFold predicate on the "engine" field.
+func EngineContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldEngine, v))
+}
+
+// EngineVersionEQ applies the EQ predicate on the "engine_version" field.
+func EngineVersionEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionNEQ applies the NEQ predicate on the "engine_version" field.
+func EngineVersionNEQ(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldEngineVersion, v))
+}
+
+// EngineVersionIn applies the In predicate on the "engine_version" field.
+func EngineVersionIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionNotIn applies the NotIn predicate on the "engine_version" field.
+func EngineVersionNotIn(vs ...string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldEngineVersion, vs...))
+}
+
+// EngineVersionGT applies the GT predicate on the "engine_version" field.
+func EngineVersionGT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldEngineVersion, v))
+}
+
+// EngineVersionGTE applies the GTE predicate on the "engine_version" field.
+func EngineVersionGTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldEngineVersion, v))
+}
+
+// EngineVersionLT applies the LT predicate on the "engine_version" field.
+func EngineVersionLT(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldEngineVersion, v))
+}
+
+// EngineVersionLTE applies the LTE predicate on the "engine_version" field.
+func EngineVersionLTE(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldEngineVersion, v))
+}
+
+// EngineVersionContains applies the Contains predicate on the "engine_version" field.
+func EngineVersionContains(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContains(FieldEngineVersion, v))
+}
+
+// EngineVersionHasPrefix applies the HasPrefix predicate on the "engine_version" field.
+func EngineVersionHasPrefix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasPrefix(FieldEngineVersion, v))
+}
+
+// EngineVersionHasSuffix applies the HasSuffix predicate on the "engine_version" field.
+func EngineVersionHasSuffix(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldHasSuffix(FieldEngineVersion, v))
+}
+
+// EngineVersionIsNil applies the IsNil predicate on the "engine_version" field.
+func EngineVersionIsNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIsNull(FieldEngineVersion))
+}
+
+// EngineVersionNotNil applies the NotNil predicate on the "engine_version" field.
+func EngineVersionNotNil() predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotNull(FieldEngineVersion))
+}
+
+// EngineVersionEqualFold applies the EqualFold predicate on the "engine_version" field.
+func EngineVersionEqualFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEqualFold(FieldEngineVersion, v))
+}
+
+// EngineVersionContainsFold applies the ContainsFold predicate on the "engine_version" field.
+func EngineVersionContainsFold(v string) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldContainsFold(FieldEngineVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasDocument applies the HasEdge predicate on the "document" edge.
+func HasDocument() predicate.DocumentContent {
+	return predicate.DocumentContent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, DocumentTable, DocumentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDocumentWith applies the HasEdge predicate on the "document" edge with a given conditions (other predicates).
+func HasDocumentWith(preds ...predicate.Document) predicate.DocumentContent {
+	return predicate.DocumentContent(func(s *sql.Selector) {
+		step := newDocumentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DocumentContent) predicate.DocumentContent {
+	return predicate.DocumentContent(sql.NotPredicates(p))
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentContentCreate is the builder for creating a DocumentContent entity.
+type DocumentContentCreate struct {
+	config
+	mutation *DocumentContentMutation
+	hooks    []Hook
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentContentCreate) SetDocumentID(v uuid.UUID) *DocumentContentCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *DocumentContentCreate) SetText(v string) *DocumentContentCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetPages sets the "pages" field.
+func (_c *DocumentContentCreate) SetPages(v []types.PageOffset) *DocumentContentCreate {
+	_c.mutation.SetPages(v)
+	return _c
+}
+
+// SetLanguage sets the "language" field.
+func (_c *DocumentContentCreate) SetLanguage(v string) *DocumentContentCreate {
+	_c.mutation.SetLanguage(v)
+	return _c
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableLanguage(v *string) *DocumentContentCreate {
+	if v != nil {
+		_c.SetLanguage(*v)
+	}
+	return _c
+}
+
+// SetEngine sets the "engine" field.
+func (_c *DocumentContentCreate) SetEngine(v string) *DocumentContentCreate {
+	_c.mutation.SetEngine(v)
+	return _c
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_c *DocumentContentCreate) SetEngineVersion(v string) *DocumentContentCreate {
+	_c.mutation.SetEngineVersion(v)
+	return _c
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableEngineVersion(v *string) *DocumentContentCreate {
+	if v != nil {
+		_c.SetEngineVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DocumentContentCreate) SetCreatedAt(v time.Time) *DocumentContentCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableCreatedAt(v *time.Time) *DocumentContentCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DocumentContentCreate) SetUpdatedAt(v time.Time) *DocumentContentCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableUpdatedAt(v *time.Time) *DocumentContentCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentContentCreate) SetID(v uuid.UUID) *DocumentContentCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DocumentContentCreate) SetNillableID(v *uuid.UUID) *DocumentContentCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_c *DocumentContentCreate) SetDocument(v *Document) *DocumentContentCreate {
+	return _c.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_c *DocumentContentCreate) Mutation() *DocumentContentMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentContent in the database.
+func (_c *DocumentContentCreate) Save(ctx context.Context) (*DocumentContent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentContentCreate) SaveX(ctx context.Context) *DocumentContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentContentCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentContentCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentContentCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := documentcontent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := documentcontent.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := documentcontent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentContentCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentContent.document_id"`)}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "DocumentContent.text"`)}
+	}
+	if _, ok := _c.mutation.Engine(); !ok {
+		return &ValidationError{Name: "engine", err: errors.New(`ent: missing required field "DocumentContent.engine"`)}
+	}
+	if v, ok := _c.mutation.Engine(); ok {
+		if err := documentcontent.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.engine": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DocumentContent.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DocumentContent.updated_at"`)}
+	}
+	if len(_c.mutation.DocumentIDs()) == 0 {
+		return &ValidationError{Name: "document", err: errors.New(`ent: missing required edge "DocumentContent.document"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentContentCreate) sqlSave(ctx context.Context) (*DocumentContent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentContentCreate) createSpec() (*DocumentContent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentContent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documentcontent.Table, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(documentcontent.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Pages(); ok {
+		_spec.SetField(documentcontent.FieldPages, field.TypeJSON, value)
+		_node.Pages = value
+	}
+	if value, ok := _c.mutation.Language(); ok {
+		_spec.SetField(documentcontent.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := _c.mutation.Engine(); ok {
+		_spec.SetField(documentcontent.FieldEngine, field.TypeString, value)
+		_node.Engine = value
+	}
+	if value, ok := _c.mutation.EngineVersion(); ok {
+		_spec.SetField(documentcontent.FieldEngineVersion, field.TypeString, value)
+		_node.EngineVersion = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(documentcontent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentcontent.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DocumentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DocumentContentCreateBulk is the builder for creating many DocumentContent entities in bulk.
+type DocumentContentCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentContentCreate
+}
+
+// Save creates the DocumentContent entities in the database.
+func (_c *DocumentContentCreateBulk) Save(ctx context.Context) ([]*DocumentContent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentContent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentContentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentContentCreateBulk) SaveX(ctx context.Context) []*DocumentContent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentContentCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentContentCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
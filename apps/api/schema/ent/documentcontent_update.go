@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentcontent"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentContentUpdate is the builder for updating DocumentContent entities.
+type DocumentContentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentContentUpdate builder.
+func (_u *DocumentContentUpdate) Where(ps ...predicate.DocumentContent) *DocumentContentUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentContentUpdate) SetDocumentID(v uuid.UUID) *DocumentContentUpdate {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableDocumentID(v *uuid.UUID) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *DocumentContentUpdate) SetText(v string) *DocumentContentUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableText(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetPages sets the "pages" field.
+func (_u *DocumentContentUpdate) SetPages(v []types.PageOffset) *DocumentContentUpdate {
+	_u.mutation.SetPages(v)
+	return _u
+}
+
+// AppendPages appends value to the "pages" field.
+func (_u *DocumentContentUpdate) AppendPages(v []types.PageOffset) *DocumentContentUpdate {
+	_u.mutation.AppendPages(v)
+	return _u
+}
+
+// ClearPages clears the value of the "pages" field.
+func (_u *DocumentContentUpdate) ClearPages() *DocumentContentUpdate {
+	_u.mutation.ClearPages()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *DocumentContentUpdate) SetLanguage(v string) *DocumentContentUpdate {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableLanguage(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *DocumentContentUpdate) ClearLanguage() *DocumentContentUpdate {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetEngine sets the "engine" field.
+func (_u *DocumentContentUpdate) SetEngine(v string) *DocumentContentUpdate {
+	_u.mutation.SetEngine(v)
+	return _u
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableEngine(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetEngine(*v)
+	}
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *DocumentContentUpdate) SetEngineVersion(v string) *DocumentContentUpdate {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *DocumentContentUpdate) SetNillableEngineVersion(v *string) *DocumentContentUpdate {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (_u *DocumentContentUpdate) ClearEngineVersion() *DocumentContentUpdate {
+	_u.mutation.ClearEngineVersion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentContentUpdate) SetUpdatedAt(v time.Time) *DocumentContentUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *DocumentContentUpdate) SetDocument(v *Document) *DocumentContentUpdate {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_u *DocumentContentUpdate) Mutation() *DocumentContentMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *DocumentContentUpdate) ClearDocument() *DocumentContentUpdate {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentContentUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentContentUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentContentUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentContentUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentContentUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documentcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentContentUpdate) check() error {
+	if v, ok := _u.mutation.Engine(); ok {
+		if err := documentcontent.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.engine": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentContent.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentContentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentContentUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentContentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentcontent.Table, documentcontent.Columns, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(documentcontent.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pages(); ok {
+		_spec.SetField(documentcontent.FieldPages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documentcontent.FieldPages, value)
+		})
+	}
+	if _u.mutation.PagesCleared() {
+		_spec.ClearField(documentcontent.FieldPages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(documentcontent.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(documentcontent.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Engine(); ok {
+		_spec.SetField(documentcontent.FieldEngine, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(documentcontent.FieldEngineVersion, field.TypeString, value)
+	}
+	if _u.mutation.EngineVersionCleared() {
+		_spec.ClearField(documentcontent.FieldEngineVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentContentUpdateOne is the builder for updating a single DocumentContent entity.
+type DocumentContentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentContentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_u *DocumentContentUpdateOne) SetDocumentID(v uuid.UUID) *DocumentContentUpdateOne {
+	_u.mutation.SetDocumentID(v)
+	return _u
+}
+
+// SetNillableDocumentID sets the "document_id" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableDocumentID(v *uuid.UUID) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetDocumentID(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *DocumentContentUpdateOne) SetText(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableText(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetPages sets the "pages" field.
+func (_u *DocumentContentUpdateOne) SetPages(v []types.PageOffset) *DocumentContentUpdateOne {
+	_u.mutation.SetPages(v)
+	return _u
+}
+
+// AppendPages appends value to the "pages" field.
+func (_u *DocumentContentUpdateOne) AppendPages(v []types.PageOffset) *DocumentContentUpdateOne {
+	_u.mutation.AppendPages(v)
+	return _u
+}
+
+// ClearPages clears the value of the "pages" field.
+func (_u *DocumentContentUpdateOne) ClearPages() *DocumentContentUpdateOne {
+	_u.mutation.ClearPages()
+	return _u
+}
+
+// SetLanguage sets the "language" field.
+func (_u *DocumentContentUpdateOne) SetLanguage(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetLanguage(v)
+	return _u
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableLanguage(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetLanguage(*v)
+	}
+	return _u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (_u *DocumentContentUpdateOne) ClearLanguage() *DocumentContentUpdateOne {
+	_u.mutation.ClearLanguage()
+	return _u
+}
+
+// SetEngine sets the "engine" field.
+func (_u *DocumentContentUpdateOne) SetEngine(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetEngine(v)
+	return _u
+}
+
+// SetNillableEngine sets the "engine" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableEngine(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetEngine(*v)
+	}
+	return _u
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (_u *DocumentContentUpdateOne) SetEngineVersion(v string) *DocumentContentUpdateOne {
+	_u.mutation.SetEngineVersion(v)
+	return _u
+}
+
+// SetNillableEngineVersion sets the "engine_version" field if the given value is not nil.
+func (_u *DocumentContentUpdateOne) SetNillableEngineVersion(v *string) *DocumentContentUpdateOne {
+	if v != nil {
+		_u.SetEngineVersion(*v)
+	}
+	return _u
+}
+
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (_u *DocumentContentUpdateOne) ClearEngineVersion() *DocumentContentUpdateOne {
+	_u.mutation.ClearEngineVersion()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentContentUpdateOne) SetUpdatedAt(v time.Time) *DocumentContentUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetDocument sets the "document" edge to the Document entity.
+func (_u *DocumentContentUpdateOne) SetDocument(v *Document) *DocumentContentUpdateOne {
+	return _u.SetDocumentID(v.ID)
+}
+
+// Mutation returns the DocumentContentMutation object of the builder.
+func (_u *DocumentContentUpdateOne) Mutation() *DocumentContentMutation {
+	return _u.mutation
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (_u *DocumentContentUpdateOne) ClearDocument() *DocumentContentUpdateOne {
+	_u.mutation.ClearDocument()
+	return _u
+}
+
+// Where appends a list predicates to the DocumentContentUpdate builder.
+func (_u *DocumentContentUpdateOne) Where(ps ...predicate.DocumentContent) *DocumentContentUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentContentUpdateOne) Select(field string, fields ...string) *DocumentContentUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentContent entity.
+func (_u *DocumentContentUpdateOne) Save(ctx context.Context) (*DocumentContent, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentContentUpdateOne) SaveX(ctx context.Context) *DocumentContent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentContentUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentContentUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentContentUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documentcontent.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentContentUpdateOne) check() error {
+	if v, ok := _u.mutation.Engine(); ok {
+		if err := documentcontent.EngineValidator(v); err != nil {
+			return &ValidationError{Name: "engine", err: fmt.Errorf(`ent: validator failed for field "DocumentContent.engine": %w`, err)}
+		}
+	}
+	if _u.mutation.DocumentCleared() && len(_u.mutation.DocumentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentContent.document"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentContentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentContentUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentContentUpdateOne) sqlSave(ctx context.Context) (_node *DocumentContent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documentcontent.Table, documentcontent.Columns, sqlgraph.NewFieldSpec(documentcontent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentContent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documentcontent.FieldID)
+		for _, f := range fields {
+			if !documentcontent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documentcontent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(documentcontent.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Pages(); ok {
+		_spec.SetField(documentcontent.FieldPages, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documentcontent.FieldPages, value)
+		})
+	}
+	if _u.mutation.PagesCleared() {
+		_spec.ClearField(documentcontent.FieldPages, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Language(); ok {
+		_spec.SetField(documentcontent.FieldLanguage, field.TypeString, value)
+	}
+	if _u.mutation.LanguageCleared() {
+		_spec.ClearField(documentcontent.FieldLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Engine(); ok {
+		_spec.SetField(documentcontent.FieldEngine, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EngineVersion(); ok {
+		_spec.SetField(documentcontent.FieldEngineVersion, field.TypeString, value)
+	}
+	if _u.mutation.EngineVersionCleared() {
+		_spec.ClearField(documentcontent.FieldEngineVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documentcontent.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DocumentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   documentcontent.DocumentTable,
+			Columns: []string{documentcontent.DocumentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentContent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documentcontent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
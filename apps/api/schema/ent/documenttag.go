@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"techmind/schema/ent/documenttag"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +22,16 @@ type DocumentTag struct {
 	DocumentID uuid.UUID `json:"document_id,omitempty"`
 	// TagID holds the value of the "tag_id" field.
 	TagID uuid.UUID `json:"tag_id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source documenttag.Source `json:"source,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID *uuid.UUID `json:"rule_id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID *uuid.UUID `json:"run_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DocumentTagQuery when eager-loading is set.
 	Edges        DocumentTagEdges `json:"edges"`
@@ -61,6 +72,12 @@ func (*DocumentTag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case documenttag.FieldRuleID, documenttag.FieldRunID, documenttag.FieldCreatedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case documenttag.FieldSource:
+			values[i] = new(sql.NullString)
+		case documenttag.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
 		case documenttag.FieldID, documenttag.FieldDocumentID, documenttag.FieldTagID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -96,6 +113,39 @@ func (_m *DocumentTag) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.TagID = *value
 			}
+		case documenttag.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = documenttag.Source(value.String)
+			}
+		case documenttag.FieldRuleID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				_m.RuleID = new(uuid.UUID)
+				*_m.RuleID = *value.S.(*uuid.UUID)
+			}
+		case documenttag.FieldRunID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				_m.RunID = new(uuid.UUID)
+				*_m.RunID = *value.S.(*uuid.UUID)
+			}
+		case documenttag.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = new(uuid.UUID)
+				*_m.CreatedBy = *value.S.(*uuid.UUID)
+			}
+		case documenttag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -147,6 +197,27 @@ func (_m *DocumentTag) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("tag_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.TagID))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Source))
+	builder.WriteString(", ")
+	if v := _m.RuleID; v != nil {
+		builder.WriteString("rule_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RunID; v != nil {
+		builder.WriteString("run_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -9,6 +9,7 @@ import (
 	"techmind/schema/ent/document"
 	"techmind/schema/ent/documenttag"
 	"techmind/schema/ent/tag"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -34,6 +35,76 @@ func (_c *DocumentTagCreate) SetTagID(v uuid.UUID) *DocumentTagCreate {
 	return _c
 }
 
+// SetSource sets the "source" field.
+func (_c *DocumentTagCreate) SetSource(v documenttag.Source) *DocumentTagCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableSource(v *documenttag.Source) *DocumentTagCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_c *DocumentTagCreate) SetRuleID(v uuid.UUID) *DocumentTagCreate {
+	_c.mutation.SetRuleID(v)
+	return _c
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableRuleID(v *uuid.UUID) *DocumentTagCreate {
+	if v != nil {
+		_c.SetRuleID(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *DocumentTagCreate) SetRunID(v uuid.UUID) *DocumentTagCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableRunID(v *uuid.UUID) *DocumentTagCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *DocumentTagCreate) SetCreatedBy(v uuid.UUID) *DocumentTagCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableCreatedBy(v *uuid.UUID) *DocumentTagCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DocumentTagCreate) SetCreatedAt(v time.Time) *DocumentTagCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DocumentTagCreate) SetNillableCreatedAt(v *time.Time) *DocumentTagCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DocumentTagCreate) SetID(v uuid.UUID) *DocumentTagCreate {
 	_c.mutation.SetID(v)
@@ -113,6 +184,14 @@ func (_c *DocumentTagCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *DocumentTagCreate) defaults() {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := documenttag.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := documenttag.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := documenttag.DefaultID()
 		_c.mutation.SetID(v)
@@ -127,6 +206,17 @@ func (_c *DocumentTagCreate) check() error {
 	if _, ok := _c.mutation.TagID(); !ok {
 		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "DocumentTag.tag_id"`)}
 	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "DocumentTag.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := documenttag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DocumentTag.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DocumentTag.created_at"`)}
+	}
 	return nil
 }
 
@@ -170,6 +260,26 @@ func (_c *DocumentTagCreate) createSpec() (*DocumentTag, *sqlgraph.CreateSpec) {
 		_spec.SetField(documenttag.FieldTagID, field.TypeUUID, value)
 		_node.TagID = value
 	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(documenttag.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.RuleID(); ok {
+		_spec.SetField(documenttag.FieldRuleID, field.TypeUUID, value)
+		_node.RuleID = &value
+	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(documenttag.FieldRunID, field.TypeUUID, value)
+		_node.RunID = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(documenttag.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(documenttag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
 	if nodes := _c.mutation.DocumentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -59,6 +59,80 @@ func (_u *DocumentTagUpdate) SetNillableTagID(v *uuid.UUID) *DocumentTagUpdate {
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *DocumentTagUpdate) SetSource(v documenttag.Source) *DocumentTagUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *DocumentTagUpdate) SetNillableSource(v *documenttag.Source) *DocumentTagUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *DocumentTagUpdate) SetRuleID(v uuid.UUID) *DocumentTagUpdate {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *DocumentTagUpdate) SetNillableRuleID(v *uuid.UUID) *DocumentTagUpdate {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *DocumentTagUpdate) ClearRuleID() *DocumentTagUpdate {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *DocumentTagUpdate) SetRunID(v uuid.UUID) *DocumentTagUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *DocumentTagUpdate) SetNillableRunID(v *uuid.UUID) *DocumentTagUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *DocumentTagUpdate) ClearRunID() *DocumentTagUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *DocumentTagUpdate) SetCreatedBy(v uuid.UUID) *DocumentTagUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *DocumentTagUpdate) SetNillableCreatedBy(v *uuid.UUID) *DocumentTagUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *DocumentTagUpdate) ClearCreatedBy() *DocumentTagUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
 // AddDocumentIDs adds the "document" edge to the Document entity by IDs.
 func (_u *DocumentTagUpdate) AddDocumentIDs(ids ...uuid.UUID) *DocumentTagUpdate {
 	_u.mutation.AddDocumentIDs(ids...)
@@ -163,6 +237,16 @@ func (_u *DocumentTagUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentTagUpdate) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := documenttag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DocumentTag.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *DocumentTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTagUpdate {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -170,6 +254,9 @@ func (_u *DocumentTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *Do
 }
 
 func (_u *DocumentTagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(documenttag.Table, documenttag.Columns, sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUUID))
 	if ps := _u.mutation.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -184,6 +271,27 @@ func (_u *DocumentTagUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.TagID(); ok {
 		_spec.SetField(documenttag.FieldTagID, field.TypeUUID, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(documenttag.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(documenttag.FieldRuleID, field.TypeUUID, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(documenttag.FieldRuleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(documenttag.FieldRunID, field.TypeUUID, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(documenttag.FieldRunID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(documenttag.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(documenttag.FieldCreatedBy, field.TypeUUID)
+	}
 	if _u.mutation.DocumentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -324,6 +432,80 @@ func (_u *DocumentTagUpdateOne) SetNillableTagID(v *uuid.UUID) *DocumentTagUpdat
 	return _u
 }
 
+// SetSource sets the "source" field.
+func (_u *DocumentTagUpdateOne) SetSource(v documenttag.Source) *DocumentTagUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *DocumentTagUpdateOne) SetNillableSource(v *documenttag.Source) *DocumentTagUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (_u *DocumentTagUpdateOne) SetRuleID(v uuid.UUID) *DocumentTagUpdateOne {
+	_u.mutation.SetRuleID(v)
+	return _u
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (_u *DocumentTagUpdateOne) SetNillableRuleID(v *uuid.UUID) *DocumentTagUpdateOne {
+	if v != nil {
+		_u.SetRuleID(*v)
+	}
+	return _u
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (_u *DocumentTagUpdateOne) ClearRuleID() *DocumentTagUpdateOne {
+	_u.mutation.ClearRuleID()
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *DocumentTagUpdateOne) SetRunID(v uuid.UUID) *DocumentTagUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *DocumentTagUpdateOne) SetNillableRunID(v *uuid.UUID) *DocumentTagUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *DocumentTagUpdateOne) ClearRunID() *DocumentTagUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *DocumentTagUpdateOne) SetCreatedBy(v uuid.UUID) *DocumentTagUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *DocumentTagUpdateOne) SetNillableCreatedBy(v *uuid.UUID) *DocumentTagUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *DocumentTagUpdateOne) ClearCreatedBy() *DocumentTagUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
 // AddDocumentIDs adds the "document" edge to the Document entity by IDs.
 func (_u *DocumentTagUpdateOne) AddDocumentIDs(ids ...uuid.UUID) *DocumentTagUpdateOne {
 	_u.mutation.AddDocumentIDs(ids...)
@@ -441,6 +623,16 @@ func (_u *DocumentTagUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentTagUpdateOne) check() error {
+	if v, ok := _u.mutation.Source(); ok {
+		if err := documenttag.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "DocumentTag.source": %w`, err)}
+		}
+	}
+	return nil
+}
+
 // Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
 func (_u *DocumentTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTagUpdateOne {
 	_u.modifiers = append(_u.modifiers, modifiers...)
@@ -448,6 +640,9 @@ func (_u *DocumentTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder))
 }
 
 func (_u *DocumentTagUpdateOne) sqlSave(ctx context.Context) (_node *DocumentTag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
 	_spec := sqlgraph.NewUpdateSpec(documenttag.Table, documenttag.Columns, sqlgraph.NewFieldSpec(documenttag.FieldID, field.TypeUUID))
 	id, ok := _u.mutation.ID()
 	if !ok {
@@ -479,6 +674,27 @@ func (_u *DocumentTagUpdateOne) sqlSave(ctx context.Context) (_node *DocumentTag
 	if value, ok := _u.mutation.TagID(); ok {
 		_spec.SetField(documenttag.FieldTagID, field.TypeUUID, value)
 	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(documenttag.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RuleID(); ok {
+		_spec.SetField(documenttag.FieldRuleID, field.TypeUUID, value)
+	}
+	if _u.mutation.RuleIDCleared() {
+		_spec.ClearField(documenttag.FieldRuleID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(documenttag.FieldRunID, field.TypeUUID, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(documenttag.FieldRunID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(documenttag.FieldCreatedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(documenttag.FieldCreatedBy, field.TypeUUID)
+	}
 	if _u.mutation.DocumentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -0,0 +1,143 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"techmind/schema/ent/documenttagsuppression"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DocumentTagSuppression is the model entity for the DocumentTagSuppression schema.
+type DocumentTagSuppression struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// DocumentID holds the value of the "document_id" field.
+	DocumentID uuid.UUID `json:"document_id,omitempty"`
+	// TagID holds the value of the "tag_id" field.
+	TagID uuid.UUID `json:"tag_id,omitempty"`
+	// RemovedBy holds the value of the "removed_by" field.
+	RemovedBy *uuid.UUID `json:"removed_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentTagSuppression) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documenttagsuppression.FieldRemovedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case documenttagsuppression.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case documenttagsuppression.FieldID, documenttagsuppression.FieldDocumentID, documenttagsuppression.FieldTagID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentTagSuppression fields.
+func (_m *DocumentTagSuppression) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documenttagsuppression.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documenttagsuppression.FieldDocumentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field document_id", values[i])
+			} else if value != nil {
+				_m.DocumentID = *value
+			}
+		case documenttagsuppression.FieldTagID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field tag_id", values[i])
+			} else if value != nil {
+				_m.TagID = *value
+			}
+		case documenttagsuppression.FieldRemovedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field removed_by", values[i])
+			} else if value.Valid {
+				_m.RemovedBy = new(uuid.UUID)
+				*_m.RemovedBy = *value.S.(*uuid.UUID)
+			}
+		case documenttagsuppression.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentTagSuppression.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentTagSuppression) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DocumentTagSuppression.
+// Note that you need to call DocumentTagSuppression.Unwrap() before calling this method if this DocumentTagSuppression
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentTagSuppression) Update() *DocumentTagSuppressionUpdateOne {
+	return NewDocumentTagSuppressionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentTagSuppression entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentTagSuppression) Unwrap() *DocumentTagSuppression {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentTagSuppression is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentTagSuppression) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentTagSuppression(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("document_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DocumentID))
+	builder.WriteString(", ")
+	builder.WriteString("tag_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TagID))
+	builder.WriteString(", ")
+	if v := _m.RemovedBy; v != nil {
+		builder.WriteString("removed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentTagSuppressions is a parsable slice of DocumentTagSuppression.
+type DocumentTagSuppressions []*DocumentTagSuppression
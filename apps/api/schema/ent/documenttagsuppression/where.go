@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package documenttagsuppression
+
+import (
+	"techmind/schema/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLTE(FieldID, id))
+}
+
+// DocumentID applies equality check predicate on the "document_id" field. It's identical to DocumentIDEQ.
+func DocumentID(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// TagID applies equality check predicate on the "tag_id" field. It's identical to TagIDEQ.
+func TagID(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldTagID, v))
+}
+
+// RemovedBy applies equality check predicate on the "removed_by" field. It's identical to RemovedByEQ.
+func RemovedBy(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldRemovedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DocumentIDEQ applies the EQ predicate on the "document_id" field.
+func DocumentIDEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldDocumentID, v))
+}
+
+// DocumentIDNEQ applies the NEQ predicate on the "document_id" field.
+func DocumentIDNEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNEQ(FieldDocumentID, v))
+}
+
+// DocumentIDIn applies the In predicate on the "document_id" field.
+func DocumentIDIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDNotIn applies the NotIn predicate on the "document_id" field.
+func DocumentIDNotIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotIn(FieldDocumentID, vs...))
+}
+
+// DocumentIDGT applies the GT predicate on the "document_id" field.
+func DocumentIDGT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGT(FieldDocumentID, v))
+}
+
+// DocumentIDGTE applies the GTE predicate on the "document_id" field.
+func DocumentIDGTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGTE(FieldDocumentID, v))
+}
+
+// DocumentIDLT applies the LT predicate on the "document_id" field.
+func DocumentIDLT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLT(FieldDocumentID, v))
+}
+
+// DocumentIDLTE applies the LTE predicate on the "document_id" field.
+func DocumentIDLTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLTE(FieldDocumentID, v))
+}
+
+// TagIDEQ applies the EQ predicate on the "tag_id" field.
+func TagIDEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldTagID, v))
+}
+
+// TagIDNEQ applies the NEQ predicate on the "tag_id" field.
+func TagIDNEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNEQ(FieldTagID, v))
+}
+
+// TagIDIn applies the In predicate on the "tag_id" field.
+func TagIDIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIn(FieldTagID, vs...))
+}
+
+// TagIDNotIn applies the NotIn predicate on the "tag_id" field.
+func TagIDNotIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotIn(FieldTagID, vs...))
+}
+
+// TagIDGT applies the GT predicate on the "tag_id" field.
+func TagIDGT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGT(FieldTagID, v))
+}
+
+// TagIDGTE applies the GTE predicate on the "tag_id" field.
+func TagIDGTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGTE(FieldTagID, v))
+}
+
+// TagIDLT applies the LT predicate on the "tag_id" field.
+func TagIDLT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLT(FieldTagID, v))
+}
+
+// TagIDLTE applies the LTE predicate on the "tag_id" field.
+func TagIDLTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLTE(FieldTagID, v))
+}
+
+// RemovedByEQ applies the EQ predicate on the "removed_by" field.
+func RemovedByEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldRemovedBy, v))
+}
+
+// RemovedByNEQ applies the NEQ predicate on the "removed_by" field.
+func RemovedByNEQ(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNEQ(FieldRemovedBy, v))
+}
+
+// RemovedByIn applies the In predicate on the "removed_by" field.
+func RemovedByIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIn(FieldRemovedBy, vs...))
+}
+
+// RemovedByNotIn applies the NotIn predicate on the "removed_by" field.
+func RemovedByNotIn(vs ...uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotIn(FieldRemovedBy, vs...))
+}
+
+// RemovedByGT applies the GT predicate on the "removed_by" field.
+func RemovedByGT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGT(FieldRemovedBy, v))
+}
+
+// RemovedByGTE applies the GTE predicate on the "removed_by" field.
+func RemovedByGTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGTE(FieldRemovedBy, v))
+}
+
+// RemovedByLT applies the LT predicate on the "removed_by" field.
+func RemovedByLT(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLT(FieldRemovedBy, v))
+}
+
+// RemovedByLTE applies the LTE predicate on the "removed_by" field.
+func RemovedByLTE(v uuid.UUID) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLTE(FieldRemovedBy, v))
+}
+
+// RemovedByIsNil applies the IsNil predicate on the "removed_by" field.
+func RemovedByIsNil() predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIsNull(FieldRemovedBy))
+}
+
+// RemovedByNotNil applies the NotNil predicate on the "removed_by" field.
+func RemovedByNotNil() predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotNull(FieldRemovedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DocumentTagSuppression) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DocumentTagSuppression) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DocumentTagSuppression) predicate.DocumentTagSuppression {
+	return predicate.DocumentTagSuppression(sql.NotPredicates(p))
+}
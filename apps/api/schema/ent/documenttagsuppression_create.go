@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/documenttagsuppression"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentTagSuppressionCreate is the builder for creating a DocumentTagSuppression entity.
+type DocumentTagSuppressionCreate struct {
+	config
+	mutation *DocumentTagSuppressionMutation
+	hooks    []Hook
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *DocumentTagSuppressionCreate) SetDocumentID(v uuid.UUID) *DocumentTagSuppressionCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *DocumentTagSuppressionCreate) SetTagID(v uuid.UUID) *DocumentTagSuppressionCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetRemovedBy sets the "removed_by" field.
+func (_c *DocumentTagSuppressionCreate) SetRemovedBy(v uuid.UUID) *DocumentTagSuppressionCreate {
+	_c.mutation.SetRemovedBy(v)
+	return _c
+}
+
+// SetNillableRemovedBy sets the "removed_by" field if the given value is not nil.
+func (_c *DocumentTagSuppressionCreate) SetNillableRemovedBy(v *uuid.UUID) *DocumentTagSuppressionCreate {
+	if v != nil {
+		_c.SetRemovedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DocumentTagSuppressionCreate) SetCreatedAt(v time.Time) *DocumentTagSuppressionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DocumentTagSuppressionCreate) SetNillableCreatedAt(v *time.Time) *DocumentTagSuppressionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DocumentTagSuppressionCreate) SetID(v uuid.UUID) *DocumentTagSuppressionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DocumentTagSuppressionCreate) SetNillableID(v *uuid.UUID) *DocumentTagSuppressionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DocumentTagSuppressionMutation object of the builder.
+func (_c *DocumentTagSuppressionCreate) Mutation() *DocumentTagSuppressionMutation {
+	return _c.mutation
+}
+
+// Save creates the DocumentTagSuppression in the database.
+func (_c *DocumentTagSuppressionCreate) Save(ctx context.Context) (*DocumentTagSuppression, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DocumentTagSuppressionCreate) SaveX(ctx context.Context) *DocumentTagSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentTagSuppressionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentTagSuppressionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DocumentTagSuppressionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := documenttagsuppression.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := documenttagsuppression.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DocumentTagSuppressionCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "DocumentTagSuppression.document_id"`)}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "DocumentTagSuppression.tag_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DocumentTagSuppression.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *DocumentTagSuppressionCreate) sqlSave(ctx context.Context) (*DocumentTagSuppression, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DocumentTagSuppressionCreate) createSpec() (*DocumentTagSuppression, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DocumentTagSuppression{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(documenttagsuppression.Table, sqlgraph.NewFieldSpec(documenttagsuppression.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(documenttagsuppression.FieldDocumentID, field.TypeUUID, value)
+		_node.DocumentID = value
+	}
+	if value, ok := _c.mutation.TagID(); ok {
+		_spec.SetField(documenttagsuppression.FieldTagID, field.TypeUUID, value)
+		_node.TagID = value
+	}
+	if value, ok := _c.mutation.RemovedBy(); ok {
+		_spec.SetField(documenttagsuppression.FieldRemovedBy, field.TypeUUID, value)
+		_node.RemovedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(documenttagsuppression.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// DocumentTagSuppressionCreateBulk is the builder for creating many DocumentTagSuppression entities in bulk.
+type DocumentTagSuppressionCreateBulk struct {
+	config
+	err      error
+	builders []*DocumentTagSuppressionCreate
+}
+
+// Save creates the DocumentTagSuppression entities in the database.
+func (_c *DocumentTagSuppressionCreateBulk) Save(ctx context.Context) ([]*DocumentTagSuppression, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DocumentTagSuppression, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DocumentTagSuppressionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DocumentTagSuppressionCreateBulk) SaveX(ctx context.Context) []*DocumentTagSuppression {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DocumentTagSuppressionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DocumentTagSuppressionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
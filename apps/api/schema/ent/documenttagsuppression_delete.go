@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DocumentTagSuppressionDelete is the builder for deleting a DocumentTagSuppression entity.
+type DocumentTagSuppressionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DocumentTagSuppressionMutation
+}
+
+// Where appends a list predicates to the DocumentTagSuppressionDelete builder.
+func (_d *DocumentTagSuppressionDelete) Where(ps ...predicate.DocumentTagSuppression) *DocumentTagSuppressionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DocumentTagSuppressionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentTagSuppressionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DocumentTagSuppressionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(documenttagsuppression.Table, sqlgraph.NewFieldSpec(documenttagsuppression.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DocumentTagSuppressionDeleteOne is the builder for deleting a single DocumentTagSuppression entity.
+type DocumentTagSuppressionDeleteOne struct {
+	_d *DocumentTagSuppressionDelete
+}
+
+// Where appends a list predicates to the DocumentTagSuppressionDelete builder.
+func (_d *DocumentTagSuppressionDeleteOne) Where(ps ...predicate.DocumentTagSuppression) *DocumentTagSuppressionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DocumentTagSuppressionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{documenttagsuppression.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DocumentTagSuppressionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentTagSuppressionQuery is the builder for querying DocumentTagSuppression entities.
+type DocumentTagSuppressionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []documenttagsuppression.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DocumentTagSuppression
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DocumentTagSuppressionQuery builder.
+func (_q *DocumentTagSuppressionQuery) Where(ps ...predicate.DocumentTagSuppression) *DocumentTagSuppressionQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DocumentTagSuppressionQuery) Limit(limit int) *DocumentTagSuppressionQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DocumentTagSuppressionQuery) Offset(offset int) *DocumentTagSuppressionQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DocumentTagSuppressionQuery) Unique(unique bool) *DocumentTagSuppressionQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DocumentTagSuppressionQuery) Order(o ...documenttagsuppression.OrderOption) *DocumentTagSuppressionQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// First returns the first DocumentTagSuppression entity from the query.
+// Returns a *NotFoundError when no DocumentTagSuppression was found.
+func (_q *DocumentTagSuppressionQuery) First(ctx context.Context) (*DocumentTagSuppression, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{documenttagsuppression.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) FirstX(ctx context.Context) *DocumentTagSuppression {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DocumentTagSuppression ID from the query.
+// Returns a *NotFoundError when no DocumentTagSuppression ID was found.
+func (_q *DocumentTagSuppressionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{documenttagsuppression.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DocumentTagSuppression entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DocumentTagSuppression entity is found.
+// Returns a *NotFoundError when no DocumentTagSuppression entities are found.
+func (_q *DocumentTagSuppressionQuery) Only(ctx context.Context) (*DocumentTagSuppression, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{documenttagsuppression.Label}
+	default:
+		return nil, &NotSingularError{documenttagsuppression.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) OnlyX(ctx context.Context) *DocumentTagSuppression {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DocumentTagSuppression ID in the query.
+// Returns a *NotSingularError when more than one DocumentTagSuppression ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DocumentTagSuppressionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{documenttagsuppression.Label}
+	default:
+		err = &NotSingularError{documenttagsuppression.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DocumentTagSuppressions.
+func (_q *DocumentTagSuppressionQuery) All(ctx context.Context) ([]*DocumentTagSuppression, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DocumentTagSuppression, *DocumentTagSuppressionQuery]()
+	return withInterceptors[[]*DocumentTagSuppression](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) AllX(ctx context.Context) []*DocumentTagSuppression {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DocumentTagSuppression IDs.
+func (_q *DocumentTagSuppressionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(documenttagsuppression.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DocumentTagSuppressionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DocumentTagSuppressionQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DocumentTagSuppressionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DocumentTagSuppressionQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DocumentTagSuppressionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DocumentTagSuppressionQuery) Clone() *DocumentTagSuppressionQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DocumentTagSuppressionQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]documenttagsuppression.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.DocumentTagSuppression{}, _q.predicates...),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DocumentID uuid.UUID `json:"document_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DocumentTagSuppression.Query().
+//		GroupBy(documenttagsuppression.FieldDocumentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *DocumentTagSuppressionQuery) GroupBy(field string, fields ...string) *DocumentTagSuppressionGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DocumentTagSuppressionGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = documenttagsuppression.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DocumentID uuid.UUID `json:"document_id,omitempty"`
+//	}
+//
+//	client.DocumentTagSuppression.Query().
+//		Select(documenttagsuppression.FieldDocumentID).
+//		Scan(ctx, &v)
+func (_q *DocumentTagSuppressionQuery) Select(fields ...string) *DocumentTagSuppressionSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DocumentTagSuppressionSelect{DocumentTagSuppressionQuery: _q}
+	sbuild.label = documenttagsuppression.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DocumentTagSuppressionSelect configured with the given aggregations.
+func (_q *DocumentTagSuppressionQuery) Aggregate(fns ...AggregateFunc) *DocumentTagSuppressionSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DocumentTagSuppressionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !documenttagsuppression.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *DocumentTagSuppressionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DocumentTagSuppression, error) {
+	var (
+		nodes = []*DocumentTagSuppression{}
+		_spec = _q.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DocumentTagSuppression).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DocumentTagSuppression{config: _q.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (_q *DocumentTagSuppressionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DocumentTagSuppressionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(documenttagsuppression.Table, documenttagsuppression.Columns, sqlgraph.NewFieldSpec(documenttagsuppression.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documenttagsuppression.FieldID)
+		for i := range fields {
+			if fields[i] != documenttagsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DocumentTagSuppressionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(documenttagsuppression.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = documenttagsuppression.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *DocumentTagSuppressionQuery) Modify(modifiers ...func(s *sql.Selector)) *DocumentTagSuppressionSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// DocumentTagSuppressionGroupBy is the group-by builder for DocumentTagSuppression entities.
+type DocumentTagSuppressionGroupBy struct {
+	selector
+	build *DocumentTagSuppressionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DocumentTagSuppressionGroupBy) Aggregate(fns ...AggregateFunc) *DocumentTagSuppressionGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DocumentTagSuppressionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DocumentTagSuppressionQuery, *DocumentTagSuppressionGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DocumentTagSuppressionGroupBy) sqlScan(ctx context.Context, root *DocumentTagSuppressionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DocumentTagSuppressionSelect is the builder for selecting fields of DocumentTagSuppression entities.
+type DocumentTagSuppressionSelect struct {
+	*DocumentTagSuppressionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DocumentTagSuppressionSelect) Aggregate(fns ...AggregateFunc) *DocumentTagSuppressionSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DocumentTagSuppressionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DocumentTagSuppressionQuery, *DocumentTagSuppressionSelect](ctx, _s.DocumentTagSuppressionQuery, _s, _s.inters, v)
+}
+
+func (_s *DocumentTagSuppressionSelect) sqlScan(ctx context.Context, root *DocumentTagSuppressionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *DocumentTagSuppressionSelect) Modify(modifiers ...func(s *sql.Selector)) *DocumentTagSuppressionSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
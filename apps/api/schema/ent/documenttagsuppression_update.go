@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentTagSuppressionUpdate is the builder for updating DocumentTagSuppression entities.
+type DocumentTagSuppressionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentTagSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentTagSuppressionUpdate builder.
+func (_u *DocumentTagSuppressionUpdate) Where(ps ...predicate.DocumentTagSuppression) *DocumentTagSuppressionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *DocumentTagSuppressionUpdate) SetTagID(v uuid.UUID) *DocumentTagSuppressionUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdate) SetNillableTagID(v *uuid.UUID) *DocumentTagSuppressionUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetRemovedBy sets the "removed_by" field.
+func (_u *DocumentTagSuppressionUpdate) SetRemovedBy(v uuid.UUID) *DocumentTagSuppressionUpdate {
+	_u.mutation.SetRemovedBy(v)
+	return _u
+}
+
+// SetNillableRemovedBy sets the "removed_by" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdate) SetNillableRemovedBy(v *uuid.UUID) *DocumentTagSuppressionUpdate {
+	if v != nil {
+		_u.SetRemovedBy(*v)
+	}
+	return _u
+}
+
+// ClearRemovedBy clears the value of the "removed_by" field.
+func (_u *DocumentTagSuppressionUpdate) ClearRemovedBy() *DocumentTagSuppressionUpdate {
+	_u.mutation.ClearRemovedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DocumentTagSuppressionUpdate) SetCreatedAt(v time.Time) *DocumentTagSuppressionUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdate) SetNillableCreatedAt(v *time.Time) *DocumentTagSuppressionUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DocumentTagSuppressionMutation object of the builder.
+func (_u *DocumentTagSuppressionUpdate) Mutation() *DocumentTagSuppressionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentTagSuppressionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentTagSuppressionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentTagSuppressionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentTagSuppressionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentTagSuppressionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTagSuppressionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentTagSuppressionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(documenttagsuppression.Table, documenttagsuppression.Columns, sqlgraph.NewFieldSpec(documenttagsuppression.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(documenttagsuppression.FieldTagID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.RemovedBy(); ok {
+		_spec.SetField(documenttagsuppression.FieldRemovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RemovedByCleared() {
+		_spec.ClearField(documenttagsuppression.FieldRemovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(documenttagsuppression.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenttagsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentTagSuppressionUpdateOne is the builder for updating a single DocumentTagSuppression entity.
+type DocumentTagSuppressionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentTagSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *DocumentTagSuppressionUpdateOne) SetTagID(v uuid.UUID) *DocumentTagSuppressionUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdateOne) SetNillableTagID(v *uuid.UUID) *DocumentTagSuppressionUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetRemovedBy sets the "removed_by" field.
+func (_u *DocumentTagSuppressionUpdateOne) SetRemovedBy(v uuid.UUID) *DocumentTagSuppressionUpdateOne {
+	_u.mutation.SetRemovedBy(v)
+	return _u
+}
+
+// SetNillableRemovedBy sets the "removed_by" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdateOne) SetNillableRemovedBy(v *uuid.UUID) *DocumentTagSuppressionUpdateOne {
+	if v != nil {
+		_u.SetRemovedBy(*v)
+	}
+	return _u
+}
+
+// ClearRemovedBy clears the value of the "removed_by" field.
+func (_u *DocumentTagSuppressionUpdateOne) ClearRemovedBy() *DocumentTagSuppressionUpdateOne {
+	_u.mutation.ClearRemovedBy()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *DocumentTagSuppressionUpdateOne) SetCreatedAt(v time.Time) *DocumentTagSuppressionUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *DocumentTagSuppressionUpdateOne) SetNillableCreatedAt(v *time.Time) *DocumentTagSuppressionUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DocumentTagSuppressionMutation object of the builder.
+func (_u *DocumentTagSuppressionUpdateOne) Mutation() *DocumentTagSuppressionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DocumentTagSuppressionUpdate builder.
+func (_u *DocumentTagSuppressionUpdateOne) Where(ps ...predicate.DocumentTagSuppression) *DocumentTagSuppressionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentTagSuppressionUpdateOne) Select(field string, fields ...string) *DocumentTagSuppressionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentTagSuppression entity.
+func (_u *DocumentTagSuppressionUpdateOne) Save(ctx context.Context) (*DocumentTagSuppression, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentTagSuppressionUpdateOne) SaveX(ctx context.Context) *DocumentTagSuppression {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentTagSuppressionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentTagSuppressionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentTagSuppressionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTagSuppressionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentTagSuppressionUpdateOne) sqlSave(ctx context.Context) (_node *DocumentTagSuppression, err error) {
+	_spec := sqlgraph.NewUpdateSpec(documenttagsuppression.Table, documenttagsuppression.Columns, sqlgraph.NewFieldSpec(documenttagsuppression.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentTagSuppression.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documenttagsuppression.FieldID)
+		for _, f := range fields {
+			if !documenttagsuppression.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documenttagsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TagID(); ok {
+		_spec.SetField(documenttagsuppression.FieldTagID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.RemovedBy(); ok {
+		_spec.SetField(documenttagsuppression.FieldRemovedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RemovedByCleared() {
+		_spec.ClearField(documenttagsuppression.FieldRemovedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(documenttagsuppression.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentTagSuppression{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenttagsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"techmind/schema/ent/company"
+	"techmind/schema/ent/documenttype"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DocumentType is the model entity for the DocumentType schema.
+type DocumentType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CompanyID holds the value of the "company_id" field.
+	CompanyID uuid.UUID `json:"company_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
+	// Fields holds the value of the "fields" field.
+	Fields []types.DocumentField `json:"fields,omitempty"`
+	// Extraction holds the value of the "extraction" field.
+	Extraction types.ExtractionTemplate `json:"extraction,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DocumentTypeQuery when eager-loading is set.
+	Edges        DocumentTypeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DocumentTypeEdges holds the relations/edges for other nodes in the graph.
+type DocumentTypeEdges struct {
+	// Company holds the value of the company edge.
+	Company *Company `json:"company,omitempty"`
+	// Documents holds the value of the documents edge.
+	Documents []*Document `json:"documents,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CompanyOrErr returns the Company value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DocumentTypeEdges) CompanyOrErr() (*Company, error) {
+	if e.Company != nil {
+		return e.Company, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: company.Label}
+	}
+	return nil, &NotLoadedError{edge: "company"}
+}
+
+// DocumentsOrErr returns the Documents value or an error if the edge
+// was not loaded in eager-loading.
+func (e DocumentTypeEdges) DocumentsOrErr() ([]*Document, error) {
+	if e.loadedTypes[1] {
+		return e.Documents, nil
+	}
+	return nil, &NotLoadedError{edge: "documents"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DocumentType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case documenttype.FieldFields, documenttype.FieldExtraction:
+			values[i] = new([]byte)
+		case documenttype.FieldName, documenttype.FieldDescription:
+			values[i] = new(sql.NullString)
+		case documenttype.FieldCreatedAt, documenttype.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case documenttype.FieldID, documenttype.FieldCompanyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DocumentType fields.
+func (_m *DocumentType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case documenttype.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case documenttype.FieldCompanyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field company_id", values[i])
+			} else if value != nil {
+				_m.CompanyID = *value
+			}
+		case documenttype.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case documenttype.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
+		case documenttype.FieldFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Fields); err != nil {
+					return fmt.Errorf("unmarshal field fields: %w", err)
+				}
+			}
+		case documenttype.FieldExtraction:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field extraction", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Extraction); err != nil {
+					return fmt.Errorf("unmarshal field extraction: %w", err)
+				}
+			}
+		case documenttype.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case documenttype.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DocumentType.
+// This includes values selected through modifiers, order, etc.
+func (_m *DocumentType) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCompany queries the "company" edge of the DocumentType entity.
+func (_m *DocumentType) QueryCompany() *CompanyQuery {
+	return NewDocumentTypeClient(_m.config).QueryCompany(_m)
+}
+
+// QueryDocuments queries the "documents" edge of the DocumentType entity.
+func (_m *DocumentType) QueryDocuments() *DocumentQuery {
+	return NewDocumentTypeClient(_m.config).QueryDocuments(_m)
+}
+
+// Update returns a builder for updating this DocumentType.
+// Note that you need to call DocumentType.Unwrap() before calling this method if this DocumentType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DocumentType) Update() *DocumentTypeUpdateOne {
+	return NewDocumentTypeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DocumentType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DocumentType) Unwrap() *DocumentType {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DocumentType is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DocumentType) String() string {
+	var builder strings.Builder
+	builder.WriteString("DocumentType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("company_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompanyID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fields=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fields))
+	builder.WriteString(", ")
+	builder.WriteString("extraction=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Extraction))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DocumentTypes is a parsable slice of DocumentType.
+type DocumentTypes []*DocumentType
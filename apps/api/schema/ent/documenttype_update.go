@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/document"
+	"techmind/schema/ent/documenttype"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DocumentTypeUpdate is the builder for updating DocumentType entities.
+type DocumentTypeUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DocumentTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DocumentTypeUpdate builder.
+func (_u *DocumentTypeUpdate) Where(ps ...predicate.DocumentType) *DocumentTypeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DocumentTypeUpdate) SetName(v string) *DocumentTypeUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DocumentTypeUpdate) SetNillableName(v *string) *DocumentTypeUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DocumentTypeUpdate) SetDescription(v string) *DocumentTypeUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DocumentTypeUpdate) SetNillableDescription(v *string) *DocumentTypeUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DocumentTypeUpdate) ClearDescription() *DocumentTypeUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFields sets the "fields" field.
+func (_u *DocumentTypeUpdate) SetFields(v []types.DocumentField) *DocumentTypeUpdate {
+	_u.mutation.SetFields(v)
+	return _u
+}
+
+// AppendFields appends value to the "fields" field.
+func (_u *DocumentTypeUpdate) AppendFields(v []types.DocumentField) *DocumentTypeUpdate {
+	_u.mutation.AppendFields(v)
+	return _u
+}
+
+// SetExtraction sets the "extraction" field.
+func (_u *DocumentTypeUpdate) SetExtraction(v types.ExtractionTemplate) *DocumentTypeUpdate {
+	_u.mutation.SetExtraction(v)
+	return _u
+}
+
+// SetNillableExtraction sets the "extraction" field if the given value is not nil.
+func (_u *DocumentTypeUpdate) SetNillableExtraction(v *types.ExtractionTemplate) *DocumentTypeUpdate {
+	if v != nil {
+		_u.SetExtraction(*v)
+	}
+	return _u
+}
+
+// ClearExtraction clears the value of the "extraction" field.
+func (_u *DocumentTypeUpdate) ClearExtraction() *DocumentTypeUpdate {
+	_u.mutation.ClearExtraction()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentTypeUpdate) SetUpdatedAt(v time.Time) *DocumentTypeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (_u *DocumentTypeUpdate) AddDocumentIDs(ids ...uuid.UUID) *DocumentTypeUpdate {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (_u *DocumentTypeUpdate) AddDocuments(v ...*Document) *DocumentTypeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the DocumentTypeMutation object of the builder.
+func (_u *DocumentTypeUpdate) Mutation() *DocumentTypeMutation {
+	return _u.mutation
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (_u *DocumentTypeUpdate) ClearDocuments() *DocumentTypeUpdate {
+	_u.mutation.ClearDocuments()
+	return _u
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (_u *DocumentTypeUpdate) RemoveDocumentIDs(ids ...uuid.UUID) *DocumentTypeUpdate {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (_u *DocumentTypeUpdate) RemoveDocuments(v ...*Document) *DocumentTypeUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DocumentTypeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentTypeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DocumentTypeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentTypeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentTypeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documenttype.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentTypeUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := documenttype.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DocumentType.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CompanyCleared() && len(_u.mutation.CompanyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentType.company"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentTypeUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTypeUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documenttype.Table, documenttype.Columns, sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(documenttype.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(documenttype.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(documenttype.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetFields(); ok {
+		_spec.SetField(documenttype.FieldFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documenttype.FieldFields, value)
+		})
+	}
+	if value, ok := _u.mutation.Extraction(); ok {
+		_spec.SetField(documenttype.FieldExtraction, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtractionCleared() {
+		_spec.ClearField(documenttype.FieldExtraction, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documenttype.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenttype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DocumentTypeUpdateOne is the builder for updating a single DocumentType entity.
+type DocumentTypeUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DocumentTypeMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (_u *DocumentTypeUpdateOne) SetName(v string) *DocumentTypeUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DocumentTypeUpdateOne) SetNillableName(v *string) *DocumentTypeUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DocumentTypeUpdateOne) SetDescription(v string) *DocumentTypeUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DocumentTypeUpdateOne) SetNillableDescription(v *string) *DocumentTypeUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DocumentTypeUpdateOne) ClearDescription() *DocumentTypeUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetFields sets the "fields" field.
+func (_u *DocumentTypeUpdateOne) SetFields(v []types.DocumentField) *DocumentTypeUpdateOne {
+	_u.mutation.SetFields(v)
+	return _u
+}
+
+// AppendFields appends value to the "fields" field.
+func (_u *DocumentTypeUpdateOne) AppendFields(v []types.DocumentField) *DocumentTypeUpdateOne {
+	_u.mutation.AppendFields(v)
+	return _u
+}
+
+// SetExtraction sets the "extraction" field.
+func (_u *DocumentTypeUpdateOne) SetExtraction(v types.ExtractionTemplate) *DocumentTypeUpdateOne {
+	_u.mutation.SetExtraction(v)
+	return _u
+}
+
+// SetNillableExtraction sets the "extraction" field if the given value is not nil.
+func (_u *DocumentTypeUpdateOne) SetNillableExtraction(v *types.ExtractionTemplate) *DocumentTypeUpdateOne {
+	if v != nil {
+		_u.SetExtraction(*v)
+	}
+	return _u
+}
+
+// ClearExtraction clears the value of the "extraction" field.
+func (_u *DocumentTypeUpdateOne) ClearExtraction() *DocumentTypeUpdateOne {
+	_u.mutation.ClearExtraction()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DocumentTypeUpdateOne) SetUpdatedAt(v time.Time) *DocumentTypeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by IDs.
+func (_u *DocumentTypeUpdateOne) AddDocumentIDs(ids ...uuid.UUID) *DocumentTypeUpdateOne {
+	_u.mutation.AddDocumentIDs(ids...)
+	return _u
+}
+
+// AddDocuments adds the "documents" edges to the Document entity.
+func (_u *DocumentTypeUpdateOne) AddDocuments(v ...*Document) *DocumentTypeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDocumentIDs(ids...)
+}
+
+// Mutation returns the DocumentTypeMutation object of the builder.
+func (_u *DocumentTypeUpdateOne) Mutation() *DocumentTypeMutation {
+	return _u.mutation
+}
+
+// ClearDocuments clears all "documents" edges to the Document entity.
+func (_u *DocumentTypeUpdateOne) ClearDocuments() *DocumentTypeUpdateOne {
+	_u.mutation.ClearDocuments()
+	return _u
+}
+
+// RemoveDocumentIDs removes the "documents" edge to Document entities by IDs.
+func (_u *DocumentTypeUpdateOne) RemoveDocumentIDs(ids ...uuid.UUID) *DocumentTypeUpdateOne {
+	_u.mutation.RemoveDocumentIDs(ids...)
+	return _u
+}
+
+// RemoveDocuments removes "documents" edges to Document entities.
+func (_u *DocumentTypeUpdateOne) RemoveDocuments(v ...*Document) *DocumentTypeUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDocumentIDs(ids...)
+}
+
+// Where appends a list predicates to the DocumentTypeUpdate builder.
+func (_u *DocumentTypeUpdateOne) Where(ps ...predicate.DocumentType) *DocumentTypeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DocumentTypeUpdateOne) Select(field string, fields ...string) *DocumentTypeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DocumentType entity.
+func (_u *DocumentTypeUpdateOne) Save(ctx context.Context) (*DocumentType, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DocumentTypeUpdateOne) SaveX(ctx context.Context) *DocumentType {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DocumentTypeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DocumentTypeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DocumentTypeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := documenttype.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DocumentTypeUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := documenttype.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DocumentType.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CompanyCleared() && len(_u.mutation.CompanyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DocumentType.company"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DocumentTypeUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DocumentTypeUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DocumentTypeUpdateOne) sqlSave(ctx context.Context) (_node *DocumentType, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(documenttype.Table, documenttype.Columns, sqlgraph.NewFieldSpec(documenttype.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DocumentType.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, documenttype.FieldID)
+		for _, f := range fields {
+			if !documenttype.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != documenttype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(documenttype.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(documenttype.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(documenttype.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetFields(); ok {
+		_spec.SetField(documenttype.FieldFields, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFields(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, documenttype.FieldFields, value)
+		})
+	}
+	if value, ok := _u.mutation.Extraction(); ok {
+		_spec.SetField(documenttype.FieldExtraction, field.TypeJSON, value)
+	}
+	if _u.mutation.ExtractionCleared() {
+		_spec.ClearField(documenttype.FieldExtraction, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(documenttype.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDocumentsIDs(); len(nodes) > 0 && !_u.mutation.DocumentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DocumentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   documenttype.DocumentsTable,
+			Columns: []string{documenttype.DocumentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(document.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DocumentType{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{documenttype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,10 +11,20 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/ent/searchindextask"
+	"techmind/schema/ent/searchlog"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/ent/tagrule"
 	"techmind/schema/ent/user"
 
 	"entgo.io/ent"
@@ -80,14 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			company.Table:     company.ValidColumn,
-			companyuser.Table: companyuser.ValidColumn,
-			document.Table:    document.ValidColumn,
-			documenttag.Table: documenttag.ValidColumn,
-			folder.Table:      folder.ValidColumn,
-			sender.Table:      sender.ValidColumn,
-			tag.Table:         tag.ValidColumn,
-			user.Table:        user.ValidColumn,
+			company.Table:                company.ValidColumn,
+			companyuser.Table:            companyuser.ValidColumn,
+			document.Table:               document.ValidColumn,
+			documentclassifier.Table:     documentclassifier.ValidColumn,
+			documentcontent.Table:        documentcontent.ValidColumn,
+			documenttag.Table:            documenttag.ValidColumn,
+			documenttagsuppression.Table: documenttagsuppression.ValidColumn,
+			documenttype.Table:           documenttype.ValidColumn,
+			folder.Table:                 folder.ValidColumn,
+			job.Table:                    job.ValidColumn,
+			savedsearch.Table:            savedsearch.ValidColumn,
+			searchindextask.Table:        searchindextask.ValidColumn,
+			searchlog.Table:              searchlog.ValidColumn,
+			sender.Table:                 sender.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			taggroup.Table:               taggroup.ValidColumn,
+			tagrule.Table:                tagrule.ValidColumn,
+			user.Table:                   user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
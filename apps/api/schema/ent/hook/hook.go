@@ -44,6 +44,30 @@ func (f DocumentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentMutation", m)
 }
 
+// The DocumentClassifierFunc type is an adapter to allow the use of ordinary
+// function as DocumentClassifier mutator.
+type DocumentClassifierFunc func(context.Context, *ent.DocumentClassifierMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentClassifierFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentClassifierMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentClassifierMutation", m)
+}
+
+// The DocumentContentFunc type is an adapter to allow the use of ordinary
+// function as DocumentContent mutator.
+type DocumentContentFunc func(context.Context, *ent.DocumentContentMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentContentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentContentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentContentMutation", m)
+}
+
 // The DocumentTagFunc type is an adapter to allow the use of ordinary
 // function as DocumentTag mutator.
 type DocumentTagFunc func(context.Context, *ent.DocumentTagMutation) (ent.Value, error)
@@ -56,6 +80,30 @@ func (f DocumentTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentTagMutation", m)
 }
 
+// The DocumentTagSuppressionFunc type is an adapter to allow the use of ordinary
+// function as DocumentTagSuppression mutator.
+type DocumentTagSuppressionFunc func(context.Context, *ent.DocumentTagSuppressionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentTagSuppressionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentTagSuppressionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentTagSuppressionMutation", m)
+}
+
+// The DocumentTypeFunc type is an adapter to allow the use of ordinary
+// function as DocumentType mutator.
+type DocumentTypeFunc func(context.Context, *ent.DocumentTypeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DocumentTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DocumentTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DocumentTypeMutation", m)
+}
+
 // The FolderFunc type is an adapter to allow the use of ordinary
 // function as Folder mutator.
 type FolderFunc func(context.Context, *ent.FolderMutation) (ent.Value, error)
@@ -68,6 +116,54 @@ func (f FolderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FolderMutation", m)
 }
 
+// The JobFunc type is an adapter to allow the use of ordinary
+// function as Job mutator.
+type JobFunc func(context.Context, *ent.JobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f JobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.JobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.JobMutation", m)
+}
+
+// The SavedSearchFunc type is an adapter to allow the use of ordinary
+// function as SavedSearch mutator.
+type SavedSearchFunc func(context.Context, *ent.SavedSearchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SavedSearchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SavedSearchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SavedSearchMutation", m)
+}
+
+// The SearchIndexTaskFunc type is an adapter to allow the use of ordinary
+// function as SearchIndexTask mutator.
+type SearchIndexTaskFunc func(context.Context, *ent.SearchIndexTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchIndexTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchIndexTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchIndexTaskMutation", m)
+}
+
+// The SearchLogFunc type is an adapter to allow the use of ordinary
+// function as SearchLog mutator.
+type SearchLogFunc func(context.Context, *ent.SearchLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SearchLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SearchLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchLogMutation", m)
+}
+
 // The SenderFunc type is an adapter to allow the use of ordinary
 // function as Sender mutator.
 type SenderFunc func(context.Context, *ent.SenderMutation) (ent.Value, error)
@@ -92,6 +188,30 @@ func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
 }
 
+// The TagGroupFunc type is an adapter to allow the use of ordinary
+// function as TagGroup mutator.
+type TagGroupFunc func(context.Context, *ent.TagGroupMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagGroupFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagGroupMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagGroupMutation", m)
+}
+
+// The TagRuleFunc type is an adapter to allow the use of ordinary
+// function as TagRule mutator.
+type TagRuleFunc func(context.Context, *ent.TagRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagRuleMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
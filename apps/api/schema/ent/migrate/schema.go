@@ -12,6 +12,7 @@ var (
 	CompaniesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "settings", Type: field.TypeJSON, Nullable: true},
 	}
 	// CompaniesTable holds the schema information for the "companies" table.
 	CompaniesTable = &schema.Table{
@@ -56,9 +57,12 @@ var (
 		{Name: "file_size", Type: field.TypeInt64},
 		{Name: "mime_type", Type: field.TypeString},
 		{Name: "checksum", Type: field.TypeString},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "field_values", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "company_id", Type: field.TypeUUID},
+		{Name: "type_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "folder_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "sender_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
@@ -72,41 +76,93 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "documents_companies_documents",
-				Columns:    []*schema.Column{DocumentsColumns[9]},
+				Columns:    []*schema.Column{DocumentsColumns[11]},
 				RefColumns: []*schema.Column{CompaniesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "documents_document_types_documents",
+				Columns:    []*schema.Column{DocumentsColumns[12]},
+				RefColumns: []*schema.Column{DocumentTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "documents_folders_documents",
-				Columns:    []*schema.Column{DocumentsColumns[10]},
+				Columns:    []*schema.Column{DocumentsColumns[13]},
 				RefColumns: []*schema.Column{FoldersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "documents_senders_documents",
-				Columns:    []*schema.Column{DocumentsColumns[11]},
+				Columns:    []*schema.Column{DocumentsColumns[14]},
 				RefColumns: []*schema.Column{SendersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "documents_users_created_documents",
-				Columns:    []*schema.Column{DocumentsColumns[12]},
+				Columns:    []*schema.Column{DocumentsColumns[15]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "documents_users_updated_documents",
-				Columns:    []*schema.Column{DocumentsColumns[13]},
+				Columns:    []*schema.Column{DocumentsColumns[16]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// DocumentClassifiersColumns holds the columns for the "document_classifiers" table.
+	DocumentClassifiersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "company_id", Type: field.TypeUUID},
+		{Name: "model", Type: field.TypeJSON},
+		{Name: "evaluation", Type: field.TypeJSON},
+		{Name: "documents", Type: field.TypeInt, Default: 0},
+		{Name: "trained_at", Type: field.TypeTime},
+	}
+	// DocumentClassifiersTable holds the schema information for the "document_classifiers" table.
+	DocumentClassifiersTable = &schema.Table{
+		Name:       "document_classifiers",
+		Columns:    DocumentClassifiersColumns,
+		PrimaryKey: []*schema.Column{DocumentClassifiersColumns[0]},
+	}
+	// DocumentContentsColumns holds the columns for the "document_contents" table.
+	DocumentContentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "pages", Type: field.TypeJSON, Nullable: true},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "engine", Type: field.TypeString},
+		{Name: "engine_version", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "document_id", Type: field.TypeUUID, Unique: true},
+	}
+	// DocumentContentsTable holds the schema information for the "document_contents" table.
+	DocumentContentsTable = &schema.Table{
+		Name:       "document_contents",
+		Columns:    DocumentContentsColumns,
+		PrimaryKey: []*schema.Column{DocumentContentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "document_contents_documents_content",
+				Columns:    []*schema.Column{DocumentContentsColumns[8]},
+				RefColumns: []*schema.Column{DocumentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// DocumentTagsColumns holds the columns for the "document_tags" table.
 	DocumentTagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "document_id", Type: field.TypeUUID},
 		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"manual", "rule", "ml", "import"}, Default: "manual"},
+		{Name: "rule_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "run_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
 	}
 	// DocumentTagsTable holds the schema information for the "document_tags" table.
 	DocumentTagsTable = &schema.Table{
@@ -114,6 +170,45 @@ var (
 		Columns:    DocumentTagsColumns,
 		PrimaryKey: []*schema.Column{DocumentTagsColumns[0]},
 	}
+	// DocumentTagSuppressionsColumns holds the columns for the "document_tag_suppressions" table.
+	DocumentTagSuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "document_id", Type: field.TypeUUID},
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "removed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DocumentTagSuppressionsTable holds the schema information for the "document_tag_suppressions" table.
+	DocumentTagSuppressionsTable = &schema.Table{
+		Name:       "document_tag_suppressions",
+		Columns:    DocumentTagSuppressionsColumns,
+		PrimaryKey: []*schema.Column{DocumentTagSuppressionsColumns[0]},
+	}
+	// DocumentTypesColumns holds the columns for the "document_types" table.
+	DocumentTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "fields", Type: field.TypeJSON},
+		{Name: "extraction", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "company_id", Type: field.TypeUUID},
+	}
+	// DocumentTypesTable holds the schema information for the "document_types" table.
+	DocumentTypesTable = &schema.Table{
+		Name:       "document_types",
+		Columns:    DocumentTypesColumns,
+		PrimaryKey: []*schema.Column{DocumentTypesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "document_types_companies_document_types",
+				Columns:    []*schema.Column{DocumentTypesColumns[7]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// FoldersColumns holds the columns for the "folders" table.
 	FoldersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -143,11 +238,119 @@ var (
 			},
 		},
 	}
+	// JobsColumns holds the columns for the "jobs" table.
+	JobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "type", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "result_document_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "progress", Type: field.TypeInt, Default: 0},
+		{Name: "total", Type: field.TypeInt, Default: 0},
+		{Name: "created_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "company_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// JobsTable holds the schema information for the "jobs" table.
+	JobsTable = &schema.Table{
+		Name:       "jobs",
+		Columns:    JobsColumns,
+		PrimaryKey: []*schema.Column{JobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "jobs_companies_jobs",
+				Columns:    []*schema.Column{JobsColumns[12]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SavedSearchesColumns holds the columns for the "saved_searches" table.
+	SavedSearchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "query", Type: field.TypeString, Default: ""},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "sort", Type: field.TypeString, Default: ""},
+		{Name: "shared", Type: field.TypeBool, Default: false},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
+		{Name: "parent_folder_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "company_id", Type: field.TypeUUID},
+	}
+	// SavedSearchesTable holds the schema information for the "saved_searches" table.
+	SavedSearchesTable = &schema.Table{
+		Name:       "saved_searches",
+		Columns:    SavedSearchesColumns,
+		PrimaryKey: []*schema.Column{SavedSearchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "saved_searches_companies_saved_searches",
+				Columns:    []*schema.Column{SavedSearchesColumns[11]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SearchIndexTasksColumns holds the columns for the "search_index_tasks" table.
+	SearchIndexTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "document_id", Type: field.TypeUUID},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"index", "update", "delete"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SearchIndexTasksTable holds the schema information for the "search_index_tasks" table.
+	SearchIndexTasksTable = &schema.Table{
+		Name:       "search_index_tasks",
+		Columns:    SearchIndexTasksColumns,
+		PrimaryKey: []*schema.Column{SearchIndexTasksColumns[0]},
+	}
+	// SearchLogsColumns holds the columns for the "search_logs" table.
+	SearchLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "company_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "query", Type: field.TypeString, Nullable: true},
+		{Name: "filters", Type: field.TypeJSON, Nullable: true},
+		{Name: "mode", Type: field.TypeString, Default: ""},
+		{Name: "sort", Type: field.TypeString, Default: ""},
+		{Name: "result_count", Type: field.TypeInt64, Default: 0},
+		{Name: "latency_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "clicked_document_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "clicked_position", Type: field.TypeInt, Nullable: true},
+		{Name: "clicked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "clicks", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SearchLogsTable holds the schema information for the "search_logs" table.
+	SearchLogsTable = &schema.Table{
+		Name:       "search_logs",
+		Columns:    SearchLogsColumns,
+		PrimaryKey: []*schema.Column{SearchLogsColumns[0]},
+	}
 	// SendersColumns holds the columns for the "senders" table.
 	SendersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Nullable: true},
+		{Name: "legal_name", Type: field.TypeString, Nullable: true},
+		{Name: "inn", Type: field.TypeString, Nullable: true},
+		{Name: "kpp", Type: field.TypeString, Nullable: true},
+		{Name: "emails", Type: field.TypeJSON, Nullable: true},
+		{Name: "phones", Type: field.TypeJSON, Nullable: true},
+		{Name: "addresses", Type: field.TypeJSON, Nullable: true},
+		{Name: "website", Type: field.TypeString, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "company_id", Type: field.TypeUUID},
 	}
 	// SendersTable holds the schema information for the "senders" table.
@@ -158,7 +361,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "senders_companies_senders",
-				Columns:    []*schema.Column{SendersColumns[3]},
+				Columns:    []*schema.Column{SendersColumns[13]},
 				RefColumns: []*schema.Column{CompaniesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -168,7 +371,12 @@ var (
 	TagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "icon", Type: field.TypeString, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "company_id", Type: field.TypeUUID},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TagsTable holds the schema information for the "tags" table.
 	TagsTable = &schema.Table{
@@ -178,18 +386,80 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tags_companies_tags",
-				Columns:    []*schema.Column{TagsColumns[2]},
+				Columns:    []*schema.Column{TagsColumns[5]},
+				RefColumns: []*schema.Column{CompaniesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "tags_tags_children",
+				Columns:    []*schema.Column{TagsColumns[6]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "tags_tag_groups_tags",
+				Columns:    []*schema.Column{TagsColumns[7]},
+				RefColumns: []*schema.Column{TagGroupsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// TagGroupsColumns holds the columns for the "tag_groups" table.
+	TagGroupsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "exclusive", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "company_id", Type: field.TypeUUID},
+	}
+	// TagGroupsTable holds the schema information for the "tag_groups" table.
+	TagGroupsTable = &schema.Table{
+		Name:       "tag_groups",
+		Columns:    TagGroupsColumns,
+		PrimaryKey: []*schema.Column{TagGroupsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_groups_companies_tag_groups",
+				Columns:    []*schema.Column{TagGroupsColumns[4]},
 				RefColumns: []*schema.Column{CompaniesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// TagRulesColumns holds the columns for the "tag_rules" table.
+	TagRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "company_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "conditions", Type: field.TypeJSON},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "stop", Type: field.TypeBool, Default: false},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "tag_id", Type: field.TypeUUID},
+	}
+	// TagRulesTable holds the schema information for the "tag_rules" table.
+	TagRulesTable = &schema.Table{
+		Name:       "tag_rules",
+		Columns:    TagRulesColumns,
+		PrimaryKey: []*schema.Column{TagRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_rules_tags_rules",
+				Columns:    []*schema.Column{TagRulesColumns[9]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "store_search_queries", Type: field.TypeBool, Default: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -252,10 +522,20 @@ var (
 		CompaniesTable,
 		CompanyUsersTable,
 		DocumentsTable,
+		DocumentClassifiersTable,
+		DocumentContentsTable,
 		DocumentTagsTable,
+		DocumentTagSuppressionsTable,
+		DocumentTypesTable,
 		FoldersTable,
+		JobsTable,
+		SavedSearchesTable,
+		SearchIndexTasksTable,
+		SearchLogsTable,
 		SendersTable,
 		TagsTable,
+		TagGroupsTable,
+		TagRulesTable,
 		UsersTable,
 		DocumentDocumentTagsTable,
 		TagDocumentTagsTable,
@@ -266,14 +546,23 @@ func init() {
 	CompanyUsersTable.ForeignKeys[0].RefTable = CompaniesTable
 	CompanyUsersTable.ForeignKeys[1].RefTable = UsersTable
 	DocumentsTable.ForeignKeys[0].RefTable = CompaniesTable
-	DocumentsTable.ForeignKeys[1].RefTable = FoldersTable
-	DocumentsTable.ForeignKeys[2].RefTable = SendersTable
-	DocumentsTable.ForeignKeys[3].RefTable = UsersTable
+	DocumentsTable.ForeignKeys[1].RefTable = DocumentTypesTable
+	DocumentsTable.ForeignKeys[2].RefTable = FoldersTable
+	DocumentsTable.ForeignKeys[3].RefTable = SendersTable
 	DocumentsTable.ForeignKeys[4].RefTable = UsersTable
+	DocumentsTable.ForeignKeys[5].RefTable = UsersTable
+	DocumentContentsTable.ForeignKeys[0].RefTable = DocumentsTable
+	DocumentTypesTable.ForeignKeys[0].RefTable = CompaniesTable
 	FoldersTable.ForeignKeys[0].RefTable = CompaniesTable
 	FoldersTable.ForeignKeys[1].RefTable = FoldersTable
+	JobsTable.ForeignKeys[0].RefTable = CompaniesTable
+	SavedSearchesTable.ForeignKeys[0].RefTable = CompaniesTable
 	SendersTable.ForeignKeys[0].RefTable = CompaniesTable
 	TagsTable.ForeignKeys[0].RefTable = CompaniesTable
+	TagsTable.ForeignKeys[1].RefTable = TagsTable
+	TagsTable.ForeignKeys[2].RefTable = TagGroupsTable
+	TagGroupsTable.ForeignKeys[0].RefTable = CompaniesTable
+	TagRulesTable.ForeignKeys[0].RefTable = TagsTable
 	DocumentDocumentTagsTable.ForeignKeys[0].RefTable = DocumentsTable
 	DocumentDocumentTagsTable.ForeignKeys[1].RefTable = DocumentTagsTable
 	TagDocumentTagsTable.ForeignKeys[0].RefTable = TagsTable
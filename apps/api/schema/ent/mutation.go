@@ -10,12 +10,23 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
 	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/ent/searchindextask"
+	"techmind/schema/ent/searchlog"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/ent/tagrule"
 	"techmind/schema/ent/user"
+	"techmind/schema/types"
 	"time"
 
 	"entgo.io/ent"
@@ -32,42 +43,65 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCompany     = "Company"
-	TypeCompanyUser = "CompanyUser"
-	TypeDocument    = "Document"
-	TypeDocumentTag = "DocumentTag"
-	TypeFolder      = "Folder"
-	TypeSender      = "Sender"
-	TypeTag         = "Tag"
-	TypeUser        = "User"
+	TypeCompany                = "Company"
+	TypeCompanyUser            = "CompanyUser"
+	TypeDocument               = "Document"
+	TypeDocumentClassifier     = "DocumentClassifier"
+	TypeDocumentContent        = "DocumentContent"
+	TypeDocumentTag            = "DocumentTag"
+	TypeDocumentTagSuppression = "DocumentTagSuppression"
+	TypeDocumentType           = "DocumentType"
+	TypeFolder                 = "Folder"
+	TypeJob                    = "Job"
+	TypeSavedSearch            = "SavedSearch"
+	TypeSearchIndexTask        = "SearchIndexTask"
+	TypeSearchLog              = "SearchLog"
+	TypeSender                 = "Sender"
+	TypeTag                    = "Tag"
+	TypeTagGroup               = "TagGroup"
+	TypeTagRule                = "TagRule"
+	TypeUser                   = "User"
 )
 
 // CompanyMutation represents an operation that mutates the Company nodes in the graph.
 type CompanyMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	name                 *string
-	clearedFields        map[string]struct{}
-	company_users        map[uuid.UUID]struct{}
-	removedcompany_users map[uuid.UUID]struct{}
-	clearedcompany_users bool
-	folders              map[uuid.UUID]struct{}
-	removedfolders       map[uuid.UUID]struct{}
-	clearedfolders       bool
-	documents            map[uuid.UUID]struct{}
-	removeddocuments     map[uuid.UUID]struct{}
-	cleareddocuments     bool
-	tags                 map[uuid.UUID]struct{}
-	removedtags          map[uuid.UUID]struct{}
-	clearedtags          bool
-	senders              map[uuid.UUID]struct{}
-	removedsenders       map[uuid.UUID]struct{}
-	clearedsenders       bool
-	done                 bool
-	oldValue             func(context.Context) (*Company, error)
-	predicates           []predicate.Company
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	name                  *string
+	settings              *types.CompanySettings
+	clearedFields         map[string]struct{}
+	company_users         map[uuid.UUID]struct{}
+	removedcompany_users  map[uuid.UUID]struct{}
+	clearedcompany_users  bool
+	folders               map[uuid.UUID]struct{}
+	removedfolders        map[uuid.UUID]struct{}
+	clearedfolders        bool
+	documents             map[uuid.UUID]struct{}
+	removeddocuments      map[uuid.UUID]struct{}
+	cleareddocuments      bool
+	tags                  map[uuid.UUID]struct{}
+	removedtags           map[uuid.UUID]struct{}
+	clearedtags           bool
+	tag_groups            map[uuid.UUID]struct{}
+	removedtag_groups     map[uuid.UUID]struct{}
+	clearedtag_groups     bool
+	senders               map[uuid.UUID]struct{}
+	removedsenders        map[uuid.UUID]struct{}
+	clearedsenders        bool
+	jobs                  map[uuid.UUID]struct{}
+	removedjobs           map[uuid.UUID]struct{}
+	clearedjobs           bool
+	saved_searches        map[uuid.UUID]struct{}
+	removedsaved_searches map[uuid.UUID]struct{}
+	clearedsaved_searches bool
+	document_types        map[uuid.UUID]struct{}
+	removeddocument_types map[uuid.UUID]struct{}
+	cleareddocument_types bool
+	done                  bool
+	oldValue              func(context.Context) (*Company, error)
+	predicates            []predicate.Company
 }
 
 var _ ent.Mutation = (*CompanyMutation)(nil)
@@ -210,6 +244,55 @@ func (m *CompanyMutation) ResetName() {
 	m.name = nil
 }
 
+// SetSettings sets the "settings" field.
+func (m *CompanyMutation) SetSettings(ts types.CompanySettings) {
+	m.settings = &ts
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *CompanyMutation) Settings() (r types.CompanySettings, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the Company entity.
+// If the Company object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CompanyMutation) OldSettings(ctx context.Context) (v types.CompanySettings, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ClearSettings clears the value of the "settings" field.
+func (m *CompanyMutation) ClearSettings() {
+	m.settings = nil
+	m.clearedFields[company.FieldSettings] = struct{}{}
+}
+
+// SettingsCleared returns if the "settings" field was cleared in this mutation.
+func (m *CompanyMutation) SettingsCleared() bool {
+	_, ok := m.clearedFields[company.FieldSettings]
+	return ok
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *CompanyMutation) ResetSettings() {
+	m.settings = nil
+	delete(m.clearedFields, company.FieldSettings)
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by ids.
 func (m *CompanyMutation) AddCompanyUserIDs(ids ...uuid.UUID) {
 	if m.company_users == nil {
@@ -426,6 +509,60 @@ func (m *CompanyMutation) ResetTags() {
 	m.removedtags = nil
 }
 
+// AddTagGroupIDs adds the "tag_groups" edge to the TagGroup entity by ids.
+func (m *CompanyMutation) AddTagGroupIDs(ids ...uuid.UUID) {
+	if m.tag_groups == nil {
+		m.tag_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tag_groups[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTagGroups clears the "tag_groups" edge to the TagGroup entity.
+func (m *CompanyMutation) ClearTagGroups() {
+	m.clearedtag_groups = true
+}
+
+// TagGroupsCleared reports if the "tag_groups" edge to the TagGroup entity was cleared.
+func (m *CompanyMutation) TagGroupsCleared() bool {
+	return m.clearedtag_groups
+}
+
+// RemoveTagGroupIDs removes the "tag_groups" edge to the TagGroup entity by IDs.
+func (m *CompanyMutation) RemoveTagGroupIDs(ids ...uuid.UUID) {
+	if m.removedtag_groups == nil {
+		m.removedtag_groups = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tag_groups, ids[i])
+		m.removedtag_groups[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTagGroups returns the removed IDs of the "tag_groups" edge to the TagGroup entity.
+func (m *CompanyMutation) RemovedTagGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtag_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagGroupsIDs returns the "tag_groups" edge IDs in the mutation.
+func (m *CompanyMutation) TagGroupsIDs() (ids []uuid.UUID) {
+	for id := range m.tag_groups {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTagGroups resets all changes to the "tag_groups" edge.
+func (m *CompanyMutation) ResetTagGroups() {
+	m.tag_groups = nil
+	m.clearedtag_groups = false
+	m.removedtag_groups = nil
+}
+
 // AddSenderIDs adds the "senders" edge to the Sender entity by ids.
 func (m *CompanyMutation) AddSenderIDs(ids ...uuid.UUID) {
 	if m.senders == nil {
@@ -480,6 +617,168 @@ func (m *CompanyMutation) ResetSenders() {
 	m.removedsenders = nil
 }
 
+// AddJobIDs adds the "jobs" edge to the Job entity by ids.
+func (m *CompanyMutation) AddJobIDs(ids ...uuid.UUID) {
+	if m.jobs == nil {
+		m.jobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.jobs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearJobs clears the "jobs" edge to the Job entity.
+func (m *CompanyMutation) ClearJobs() {
+	m.clearedjobs = true
+}
+
+// JobsCleared reports if the "jobs" edge to the Job entity was cleared.
+func (m *CompanyMutation) JobsCleared() bool {
+	return m.clearedjobs
+}
+
+// RemoveJobIDs removes the "jobs" edge to the Job entity by IDs.
+func (m *CompanyMutation) RemoveJobIDs(ids ...uuid.UUID) {
+	if m.removedjobs == nil {
+		m.removedjobs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.jobs, ids[i])
+		m.removedjobs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedJobs returns the removed IDs of the "jobs" edge to the Job entity.
+func (m *CompanyMutation) RemovedJobsIDs() (ids []uuid.UUID) {
+	for id := range m.removedjobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// JobsIDs returns the "jobs" edge IDs in the mutation.
+func (m *CompanyMutation) JobsIDs() (ids []uuid.UUID) {
+	for id := range m.jobs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetJobs resets all changes to the "jobs" edge.
+func (m *CompanyMutation) ResetJobs() {
+	m.jobs = nil
+	m.clearedjobs = false
+	m.removedjobs = nil
+}
+
+// AddSavedSearchIDs adds the "saved_searches" edge to the SavedSearch entity by ids.
+func (m *CompanyMutation) AddSavedSearchIDs(ids ...uuid.UUID) {
+	if m.saved_searches == nil {
+		m.saved_searches = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.saved_searches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavedSearches clears the "saved_searches" edge to the SavedSearch entity.
+func (m *CompanyMutation) ClearSavedSearches() {
+	m.clearedsaved_searches = true
+}
+
+// SavedSearchesCleared reports if the "saved_searches" edge to the SavedSearch entity was cleared.
+func (m *CompanyMutation) SavedSearchesCleared() bool {
+	return m.clearedsaved_searches
+}
+
+// RemoveSavedSearchIDs removes the "saved_searches" edge to the SavedSearch entity by IDs.
+func (m *CompanyMutation) RemoveSavedSearchIDs(ids ...uuid.UUID) {
+	if m.removedsaved_searches == nil {
+		m.removedsaved_searches = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.saved_searches, ids[i])
+		m.removedsaved_searches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavedSearches returns the removed IDs of the "saved_searches" edge to the SavedSearch entity.
+func (m *CompanyMutation) RemovedSavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsaved_searches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavedSearchesIDs returns the "saved_searches" edge IDs in the mutation.
+func (m *CompanyMutation) SavedSearchesIDs() (ids []uuid.UUID) {
+	for id := range m.saved_searches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavedSearches resets all changes to the "saved_searches" edge.
+func (m *CompanyMutation) ResetSavedSearches() {
+	m.saved_searches = nil
+	m.clearedsaved_searches = false
+	m.removedsaved_searches = nil
+}
+
+// AddDocumentTypeIDs adds the "document_types" edge to the DocumentType entity by ids.
+func (m *CompanyMutation) AddDocumentTypeIDs(ids ...uuid.UUID) {
+	if m.document_types == nil {
+		m.document_types = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.document_types[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocumentTypes clears the "document_types" edge to the DocumentType entity.
+func (m *CompanyMutation) ClearDocumentTypes() {
+	m.cleareddocument_types = true
+}
+
+// DocumentTypesCleared reports if the "document_types" edge to the DocumentType entity was cleared.
+func (m *CompanyMutation) DocumentTypesCleared() bool {
+	return m.cleareddocument_types
+}
+
+// RemoveDocumentTypeIDs removes the "document_types" edge to the DocumentType entity by IDs.
+func (m *CompanyMutation) RemoveDocumentTypeIDs(ids ...uuid.UUID) {
+	if m.removeddocument_types == nil {
+		m.removeddocument_types = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.document_types, ids[i])
+		m.removeddocument_types[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocumentTypes returns the removed IDs of the "document_types" edge to the DocumentType entity.
+func (m *CompanyMutation) RemovedDocumentTypesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocument_types {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentTypesIDs returns the "document_types" edge IDs in the mutation.
+func (m *CompanyMutation) DocumentTypesIDs() (ids []uuid.UUID) {
+	for id := range m.document_types {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocumentTypes resets all changes to the "document_types" edge.
+func (m *CompanyMutation) ResetDocumentTypes() {
+	m.document_types = nil
+	m.cleareddocument_types = false
+	m.removeddocument_types = nil
+}
+
 // Where appends a list predicates to the CompanyMutation builder.
 func (m *CompanyMutation) Where(ps ...predicate.Company) {
 	m.predicates = append(m.predicates, ps...)
@@ -514,10 +813,13 @@ func (m *CompanyMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CompanyMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+	fields := make([]string, 0, 2)
 	if m.name != nil {
 		fields = append(fields, company.FieldName)
 	}
+	if m.settings != nil {
+		fields = append(fields, company.FieldSettings)
+	}
 	return fields
 }
 
@@ -528,6 +830,8 @@ func (m *CompanyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case company.FieldName:
 		return m.Name()
+	case company.FieldSettings:
+		return m.Settings()
 	}
 	return nil, false
 }
@@ -539,6 +843,8 @@ func (m *CompanyMutation) OldField(ctx context.Context, name string) (ent.Value,
 	switch name {
 	case company.FieldName:
 		return m.OldName(ctx)
+	case company.FieldSettings:
+		return m.OldSettings(ctx)
 	}
 	return nil, fmt.Errorf("unknown Company field %s", name)
 }
@@ -555,6 +861,13 @@ func (m *CompanyMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case company.FieldSettings:
+		v, ok := value.(types.CompanySettings)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Company field %s", name)
 }
@@ -584,7 +897,11 @@ func (m *CompanyMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *CompanyMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(company.FieldSettings) {
+		fields = append(fields, company.FieldSettings)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -597,6 +914,11 @@ func (m *CompanyMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *CompanyMutation) ClearField(name string) error {
+	switch name {
+	case company.FieldSettings:
+		m.ClearSettings()
+		return nil
+	}
 	return fmt.Errorf("unknown Company nullable field %s", name)
 }
 
@@ -607,13 +929,16 @@ func (m *CompanyMutation) ResetField(name string) error {
 	case company.FieldName:
 		m.ResetName()
 		return nil
+	case company.FieldSettings:
+		m.ResetSettings()
+		return nil
 	}
 	return fmt.Errorf("unknown Company field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *CompanyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 9)
 	if m.company_users != nil {
 		edges = append(edges, company.EdgeCompanyUsers)
 	}
@@ -626,9 +951,21 @@ func (m *CompanyMutation) AddedEdges() []string {
 	if m.tags != nil {
 		edges = append(edges, company.EdgeTags)
 	}
+	if m.tag_groups != nil {
+		edges = append(edges, company.EdgeTagGroups)
+	}
 	if m.senders != nil {
 		edges = append(edges, company.EdgeSenders)
 	}
+	if m.jobs != nil {
+		edges = append(edges, company.EdgeJobs)
+	}
+	if m.saved_searches != nil {
+		edges = append(edges, company.EdgeSavedSearches)
+	}
+	if m.document_types != nil {
+		edges = append(edges, company.EdgeDocumentTypes)
+	}
 	return edges
 }
 
@@ -660,19 +997,43 @@ func (m *CompanyMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case company.EdgeTagGroups:
+		ids := make([]ent.Value, 0, len(m.tag_groups))
+		for id := range m.tag_groups {
+			ids = append(ids, id)
+		}
+		return ids
 	case company.EdgeSenders:
 		ids := make([]ent.Value, 0, len(m.senders))
 		for id := range m.senders {
 			ids = append(ids, id)
 		}
 		return ids
+	case company.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.jobs))
+		for id := range m.jobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.saved_searches))
+		for id := range m.saved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeDocumentTypes:
+		ids := make([]ent.Value, 0, len(m.document_types))
+		for id := range m.document_types {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *CompanyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 9)
 	if m.removedcompany_users != nil {
 		edges = append(edges, company.EdgeCompanyUsers)
 	}
@@ -685,9 +1046,21 @@ func (m *CompanyMutation) RemovedEdges() []string {
 	if m.removedtags != nil {
 		edges = append(edges, company.EdgeTags)
 	}
+	if m.removedtag_groups != nil {
+		edges = append(edges, company.EdgeTagGroups)
+	}
 	if m.removedsenders != nil {
 		edges = append(edges, company.EdgeSenders)
 	}
+	if m.removedjobs != nil {
+		edges = append(edges, company.EdgeJobs)
+	}
+	if m.removedsaved_searches != nil {
+		edges = append(edges, company.EdgeSavedSearches)
+	}
+	if m.removeddocument_types != nil {
+		edges = append(edges, company.EdgeDocumentTypes)
+	}
 	return edges
 }
 
@@ -719,19 +1092,43 @@ func (m *CompanyMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case company.EdgeTagGroups:
+		ids := make([]ent.Value, 0, len(m.removedtag_groups))
+		for id := range m.removedtag_groups {
+			ids = append(ids, id)
+		}
+		return ids
 	case company.EdgeSenders:
 		ids := make([]ent.Value, 0, len(m.removedsenders))
 		for id := range m.removedsenders {
 			ids = append(ids, id)
 		}
 		return ids
+	case company.EdgeJobs:
+		ids := make([]ent.Value, 0, len(m.removedjobs))
+		for id := range m.removedjobs {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeSavedSearches:
+		ids := make([]ent.Value, 0, len(m.removedsaved_searches))
+		for id := range m.removedsaved_searches {
+			ids = append(ids, id)
+		}
+		return ids
+	case company.EdgeDocumentTypes:
+		ids := make([]ent.Value, 0, len(m.removeddocument_types))
+		for id := range m.removeddocument_types {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *CompanyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
+	edges := make([]string, 0, 9)
 	if m.clearedcompany_users {
 		edges = append(edges, company.EdgeCompanyUsers)
 	}
@@ -744,9 +1141,21 @@ func (m *CompanyMutation) ClearedEdges() []string {
 	if m.clearedtags {
 		edges = append(edges, company.EdgeTags)
 	}
+	if m.clearedtag_groups {
+		edges = append(edges, company.EdgeTagGroups)
+	}
 	if m.clearedsenders {
 		edges = append(edges, company.EdgeSenders)
 	}
+	if m.clearedjobs {
+		edges = append(edges, company.EdgeJobs)
+	}
+	if m.clearedsaved_searches {
+		edges = append(edges, company.EdgeSavedSearches)
+	}
+	if m.cleareddocument_types {
+		edges = append(edges, company.EdgeDocumentTypes)
+	}
 	return edges
 }
 
@@ -762,8 +1171,16 @@ func (m *CompanyMutation) EdgeCleared(name string) bool {
 		return m.cleareddocuments
 	case company.EdgeTags:
 		return m.clearedtags
+	case company.EdgeTagGroups:
+		return m.clearedtag_groups
 	case company.EdgeSenders:
 		return m.clearedsenders
+	case company.EdgeJobs:
+		return m.clearedjobs
+	case company.EdgeSavedSearches:
+		return m.clearedsaved_searches
+	case company.EdgeDocumentTypes:
+		return m.cleareddocument_types
 	}
 	return false
 }
@@ -792,9 +1209,21 @@ func (m *CompanyMutation) ResetEdge(name string) error {
 	case company.EdgeTags:
 		m.ResetTags()
 		return nil
+	case company.EdgeTagGroups:
+		m.ResetTagGroups()
+		return nil
 	case company.EdgeSenders:
 		m.ResetSenders()
 		return nil
+	case company.EdgeJobs:
+		m.ResetJobs()
+		return nil
+	case company.EdgeSavedSearches:
+		m.ResetSavedSearches()
+		return nil
+	case company.EdgeDocumentTypes:
+		m.ResetDocumentTypes()
+		return nil
 	}
 	return fmt.Errorf("unknown Company edge %s", name)
 }
@@ -1442,6 +1871,8 @@ type DocumentMutation struct {
 	addfile_size           *int64
 	mime_type              *string
 	checksum               *string
+	metadata               *types.DocumentMetadata
+	field_values           *map[string]types.FieldValue
 	created_at             *time.Time
 	updated_at             *time.Time
 	clearedFields          map[string]struct{}
@@ -1451,6 +1882,8 @@ type DocumentMutation struct {
 	clearedfolder          bool
 	sender                 *uuid.UUID
 	clearedsender          bool
+	document_type          *uuid.UUID
+	cleareddocument_type   bool
 	created_by_user        *uuid.UUID
 	clearedcreated_by_user bool
 	updated_by_user        *uuid.UUID
@@ -1458,6 +1891,8 @@ type DocumentMutation struct {
 	document_tags          map[uuid.UUID]struct{}
 	removeddocument_tags   map[uuid.UUID]struct{}
 	cleareddocument_tags   bool
+	content                *uuid.UUID
+	clearedcontent         bool
 	done                   bool
 	oldValue               func(context.Context) (*Document, error)
 	predicates             []predicate.Document
@@ -2048,112 +2483,259 @@ func (m *DocumentMutation) ResetUpdatedBy() {
 	delete(m.clearedFields, document.FieldUpdatedBy)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DocumentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetMetadata sets the "metadata" field.
+func (m *DocumentMutation) SetMetadata(tm types.DocumentMetadata) {
+	m.metadata = &tm
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *DocumentMutation) Metadata() (r types.DocumentMetadata, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// OldMetadata returns the old "metadata" field's value of the Document entity.
 // If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldMetadata(ctx context.Context) (v types.DocumentMetadata, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DocumentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *DocumentMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[document.FieldMetadata] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *DocumentMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[document.FieldMetadata]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *DocumentMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, document.FieldMetadata)
+}
+
+// SetTypeID sets the "type_id" field.
+func (m *DocumentMutation) SetTypeID(u uuid.UUID) {
+	m.document_type = &u
+}
+
+// TypeID returns the value of the "type_id" field in the mutation.
+func (m *DocumentMutation) TypeID() (r uuid.UUID, exists bool) {
+	v := m.document_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// OldTypeID returns the old "type_id" field's value of the Document entity.
 // If the Document object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DocumentMutation) OldTypeID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTypeID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTypeID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTypeID: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *DocumentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.TypeID, nil
 }
 
-// ClearCompany clears the "company" edge to the Company entity.
-func (m *DocumentMutation) ClearCompany() {
-	m.clearedcompany = true
-	m.clearedFields[document.FieldCompanyID] = struct{}{}
+// ClearTypeID clears the value of the "type_id" field.
+func (m *DocumentMutation) ClearTypeID() {
+	m.document_type = nil
+	m.clearedFields[document.FieldTypeID] = struct{}{}
 }
 
-// CompanyCleared reports if the "company" edge to the Company entity was cleared.
-func (m *DocumentMutation) CompanyCleared() bool {
-	return m.clearedcompany
+// TypeIDCleared returns if the "type_id" field was cleared in this mutation.
+func (m *DocumentMutation) TypeIDCleared() bool {
+	_, ok := m.clearedFields[document.FieldTypeID]
+	return ok
 }
 
-// CompanyIDs returns the "company" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CompanyID instead. It exists only for internal usage by the builders.
-func (m *DocumentMutation) CompanyIDs() (ids []uuid.UUID) {
-	if id := m.company; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetTypeID resets all changes to the "type_id" field.
+func (m *DocumentMutation) ResetTypeID() {
+	m.document_type = nil
+	delete(m.clearedFields, document.FieldTypeID)
 }
 
-// ResetCompany resets all changes to the "company" edge.
-func (m *DocumentMutation) ResetCompany() {
-	m.company = nil
-	m.clearedcompany = false
+// SetFieldValues sets the "field_values" field.
+func (m *DocumentMutation) SetFieldValues(mv map[string]types.FieldValue) {
+	m.field_values = &mv
 }
 
-// ClearFolder clears the "folder" edge to the Folder entity.
-func (m *DocumentMutation) ClearFolder() {
-	m.clearedfolder = true
-	m.clearedFields[document.FieldFolderID] = struct{}{}
+// FieldValues returns the value of the "field_values" field in the mutation.
+func (m *DocumentMutation) FieldValues() (r map[string]types.FieldValue, exists bool) {
+	v := m.field_values
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
+// OldFieldValues returns the old "field_values" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldFieldValues(ctx context.Context) (v map[string]types.FieldValue, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFieldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFieldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFieldValues: %w", err)
+	}
+	return oldValue.FieldValues, nil
+}
+
+// ClearFieldValues clears the value of the "field_values" field.
+func (m *DocumentMutation) ClearFieldValues() {
+	m.field_values = nil
+	m.clearedFields[document.FieldFieldValues] = struct{}{}
+}
+
+// FieldValuesCleared returns if the "field_values" field was cleared in this mutation.
+func (m *DocumentMutation) FieldValuesCleared() bool {
+	_, ok := m.clearedFields[document.FieldFieldValues]
+	return ok
+}
+
+// ResetFieldValues resets all changes to the "field_values" field.
+func (m *DocumentMutation) ResetFieldValues() {
+	m.field_values = nil
+	delete(m.clearedFields, document.FieldFieldValues)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Document entity.
+// If the Document object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *DocumentMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[document.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *DocumentMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *DocumentMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// ClearFolder clears the "folder" edge to the Folder entity.
+func (m *DocumentMutation) ClearFolder() {
+	m.clearedfolder = true
+	m.clearedFields[document.FieldFolderID] = struct{}{}
+}
+
+// FolderCleared reports if the "folder" edge to the Folder entity was cleared.
 func (m *DocumentMutation) FolderCleared() bool {
 	return m.FolderIDCleared() || m.clearedfolder
 }
@@ -2201,6 +2783,46 @@ func (m *DocumentMutation) ResetSender() {
 	m.clearedsender = false
 }
 
+// SetDocumentTypeID sets the "document_type" edge to the DocumentType entity by id.
+func (m *DocumentMutation) SetDocumentTypeID(id uuid.UUID) {
+	m.document_type = &id
+}
+
+// ClearDocumentType clears the "document_type" edge to the DocumentType entity.
+func (m *DocumentMutation) ClearDocumentType() {
+	m.cleareddocument_type = true
+	m.clearedFields[document.FieldTypeID] = struct{}{}
+}
+
+// DocumentTypeCleared reports if the "document_type" edge to the DocumentType entity was cleared.
+func (m *DocumentMutation) DocumentTypeCleared() bool {
+	return m.TypeIDCleared() || m.cleareddocument_type
+}
+
+// DocumentTypeID returns the "document_type" edge ID in the mutation.
+func (m *DocumentMutation) DocumentTypeID() (id uuid.UUID, exists bool) {
+	if m.document_type != nil {
+		return *m.document_type, true
+	}
+	return
+}
+
+// DocumentTypeIDs returns the "document_type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DocumentTypeID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) DocumentTypeIDs() (ids []uuid.UUID) {
+	if id := m.document_type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDocumentType resets all changes to the "document_type" edge.
+func (m *DocumentMutation) ResetDocumentType() {
+	m.document_type = nil
+	m.cleareddocument_type = false
+}
+
 // SetCreatedByUserID sets the "created_by_user" edge to the User entity by id.
 func (m *DocumentMutation) SetCreatedByUserID(id uuid.UUID) {
 	m.created_by_user = &id
@@ -2335,6 +2957,45 @@ func (m *DocumentMutation) ResetDocumentTags() {
 	m.removeddocument_tags = nil
 }
 
+// SetContentID sets the "content" edge to the DocumentContent entity by id.
+func (m *DocumentMutation) SetContentID(id uuid.UUID) {
+	m.content = &id
+}
+
+// ClearContent clears the "content" edge to the DocumentContent entity.
+func (m *DocumentMutation) ClearContent() {
+	m.clearedcontent = true
+}
+
+// ContentCleared reports if the "content" edge to the DocumentContent entity was cleared.
+func (m *DocumentMutation) ContentCleared() bool {
+	return m.clearedcontent
+}
+
+// ContentID returns the "content" edge ID in the mutation.
+func (m *DocumentMutation) ContentID() (id uuid.UUID, exists bool) {
+	if m.content != nil {
+		return *m.content, true
+	}
+	return
+}
+
+// ContentIDs returns the "content" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ContentID instead. It exists only for internal usage by the builders.
+func (m *DocumentMutation) ContentIDs() (ids []uuid.UUID) {
+	if id := m.content; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetContent resets all changes to the "content" edge.
+func (m *DocumentMutation) ResetContent() {
+	m.content = nil
+	m.clearedcontent = false
+}
+
 // Where appends a list predicates to the DocumentMutation builder.
 func (m *DocumentMutation) Where(ps ...predicate.Document) {
 	m.predicates = append(m.predicates, ps...)
@@ -2369,7 +3030,7 @@ func (m *DocumentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DocumentMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 16)
 	if m.company != nil {
 		fields = append(fields, document.FieldCompanyID)
 	}
@@ -2403,6 +3064,15 @@ func (m *DocumentMutation) Fields() []string {
 	if m.updated_by_user != nil {
 		fields = append(fields, document.FieldUpdatedBy)
 	}
+	if m.metadata != nil {
+		fields = append(fields, document.FieldMetadata)
+	}
+	if m.document_type != nil {
+		fields = append(fields, document.FieldTypeID)
+	}
+	if m.field_values != nil {
+		fields = append(fields, document.FieldFieldValues)
+	}
 	if m.created_at != nil {
 		fields = append(fields, document.FieldCreatedAt)
 	}
@@ -2439,6 +3109,12 @@ func (m *DocumentMutation) Field(name string) (ent.Value, bool) {
 		return m.CreatedBy()
 	case document.FieldUpdatedBy:
 		return m.UpdatedBy()
+	case document.FieldMetadata:
+		return m.Metadata()
+	case document.FieldTypeID:
+		return m.TypeID()
+	case document.FieldFieldValues:
+		return m.FieldValues()
 	case document.FieldCreatedAt:
 		return m.CreatedAt()
 	case document.FieldUpdatedAt:
@@ -2474,6 +3150,12 @@ func (m *DocumentMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCreatedBy(ctx)
 	case document.FieldUpdatedBy:
 		return m.OldUpdatedBy(ctx)
+	case document.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case document.FieldTypeID:
+		return m.OldTypeID(ctx)
+	case document.FieldFieldValues:
+		return m.OldFieldValues(ctx)
 	case document.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case document.FieldUpdatedAt:
@@ -2564,6 +3246,27 @@ func (m *DocumentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedBy(v)
 		return nil
+	case document.FieldMetadata:
+		v, ok := value.(types.DocumentMetadata)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case document.FieldTypeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeID(v)
+		return nil
+	case document.FieldFieldValues:
+		v, ok := value.(map[string]types.FieldValue)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFieldValues(v)
+		return nil
 	case document.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -2638,6 +3341,15 @@ func (m *DocumentMutation) ClearedFields() []string {
 	if m.FieldCleared(document.FieldUpdatedBy) {
 		fields = append(fields, document.FieldUpdatedBy)
 	}
+	if m.FieldCleared(document.FieldMetadata) {
+		fields = append(fields, document.FieldMetadata)
+	}
+	if m.FieldCleared(document.FieldTypeID) {
+		fields = append(fields, document.FieldTypeID)
+	}
+	if m.FieldCleared(document.FieldFieldValues) {
+		fields = append(fields, document.FieldFieldValues)
+	}
 	return fields
 }
 
@@ -2667,6 +3379,15 @@ func (m *DocumentMutation) ClearField(name string) error {
 	case document.FieldUpdatedBy:
 		m.ClearUpdatedBy()
 		return nil
+	case document.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case document.FieldTypeID:
+		m.ClearTypeID()
+		return nil
+	case document.FieldFieldValues:
+		m.ClearFieldValues()
+		return nil
 	}
 	return fmt.Errorf("unknown Document nullable field %s", name)
 }
@@ -2708,6 +3429,15 @@ func (m *DocumentMutation) ResetField(name string) error {
 	case document.FieldUpdatedBy:
 		m.ResetUpdatedBy()
 		return nil
+	case document.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case document.FieldTypeID:
+		m.ResetTypeID()
+		return nil
+	case document.FieldFieldValues:
+		m.ResetFieldValues()
+		return nil
 	case document.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -2720,7 +3450,7 @@ func (m *DocumentMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *DocumentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.company != nil {
 		edges = append(edges, document.EdgeCompany)
 	}
@@ -2730,6 +3460,9 @@ func (m *DocumentMutation) AddedEdges() []string {
 	if m.sender != nil {
 		edges = append(edges, document.EdgeSender)
 	}
+	if m.document_type != nil {
+		edges = append(edges, document.EdgeDocumentType)
+	}
 	if m.created_by_user != nil {
 		edges = append(edges, document.EdgeCreatedByUser)
 	}
@@ -2739,6 +3472,9 @@ func (m *DocumentMutation) AddedEdges() []string {
 	if m.document_tags != nil {
 		edges = append(edges, document.EdgeDocumentTags)
 	}
+	if m.content != nil {
+		edges = append(edges, document.EdgeContent)
+	}
 	return edges
 }
 
@@ -2758,6 +3494,10 @@ func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 		if id := m.sender; id != nil {
 			return []ent.Value{*id}
 		}
+	case document.EdgeDocumentType:
+		if id := m.document_type; id != nil {
+			return []ent.Value{*id}
+		}
 	case document.EdgeCreatedByUser:
 		if id := m.created_by_user; id != nil {
 			return []ent.Value{*id}
@@ -2772,13 +3512,17 @@ func (m *DocumentMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case document.EdgeContent:
+		if id := m.content; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *DocumentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.removeddocument_tags != nil {
 		edges = append(edges, document.EdgeDocumentTags)
 	}
@@ -2801,7 +3545,7 @@ func (m *DocumentMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *DocumentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 8)
 	if m.clearedcompany {
 		edges = append(edges, document.EdgeCompany)
 	}
@@ -2811,6 +3555,9 @@ func (m *DocumentMutation) ClearedEdges() []string {
 	if m.clearedsender {
 		edges = append(edges, document.EdgeSender)
 	}
+	if m.cleareddocument_type {
+		edges = append(edges, document.EdgeDocumentType)
+	}
 	if m.clearedcreated_by_user {
 		edges = append(edges, document.EdgeCreatedByUser)
 	}
@@ -2820,6 +3567,9 @@ func (m *DocumentMutation) ClearedEdges() []string {
 	if m.cleareddocument_tags {
 		edges = append(edges, document.EdgeDocumentTags)
 	}
+	if m.clearedcontent {
+		edges = append(edges, document.EdgeContent)
+	}
 	return edges
 }
 
@@ -2833,12 +3583,16 @@ func (m *DocumentMutation) EdgeCleared(name string) bool {
 		return m.clearedfolder
 	case document.EdgeSender:
 		return m.clearedsender
+	case document.EdgeDocumentType:
+		return m.cleareddocument_type
 	case document.EdgeCreatedByUser:
 		return m.clearedcreated_by_user
 	case document.EdgeUpdatedByUser:
 		return m.clearedupdated_by_user
 	case document.EdgeDocumentTags:
 		return m.cleareddocument_tags
+	case document.EdgeContent:
+		return m.clearedcontent
 	}
 	return false
 }
@@ -2856,12 +3610,18 @@ func (m *DocumentMutation) ClearEdge(name string) error {
 	case document.EdgeSender:
 		m.ClearSender()
 		return nil
+	case document.EdgeDocumentType:
+		m.ClearDocumentType()
+		return nil
 	case document.EdgeCreatedByUser:
 		m.ClearCreatedByUser()
 		return nil
 	case document.EdgeUpdatedByUser:
 		m.ClearUpdatedByUser()
 		return nil
+	case document.EdgeContent:
+		m.ClearContent()
+		return nil
 	}
 	return fmt.Errorf("unknown Document unique edge %s", name)
 }
@@ -2879,6 +3639,9 @@ func (m *DocumentMutation) ResetEdge(name string) error {
 	case document.EdgeSender:
 		m.ResetSender()
 		return nil
+	case document.EdgeDocumentType:
+		m.ResetDocumentType()
+		return nil
 	case document.EdgeCreatedByUser:
 		m.ResetCreatedByUser()
 		return nil
@@ -2888,41 +3651,42 @@ func (m *DocumentMutation) ResetEdge(name string) error {
 	case document.EdgeDocumentTags:
 		m.ResetDocumentTags()
 		return nil
+	case document.EdgeContent:
+		m.ResetContent()
+		return nil
 	}
 	return fmt.Errorf("unknown Document edge %s", name)
 }
 
-// DocumentTagMutation represents an operation that mutates the DocumentTag nodes in the graph.
-type DocumentTagMutation struct {
+// DocumentClassifierMutation represents an operation that mutates the DocumentClassifier nodes in the graph.
+type DocumentClassifierMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	document_id     *uuid.UUID
-	tag_id          *uuid.UUID
-	clearedFields   map[string]struct{}
-	document        map[uuid.UUID]struct{}
-	removeddocument map[uuid.UUID]struct{}
-	cleareddocument bool
-	tag             map[uuid.UUID]struct{}
-	removedtag      map[uuid.UUID]struct{}
-	clearedtag      bool
-	done            bool
-	oldValue        func(context.Context) (*DocumentTag, error)
-	predicates      []predicate.DocumentTag
-}
-
-var _ ent.Mutation = (*DocumentTagMutation)(nil)
-
-// documenttagOption allows management of the mutation configuration using functional options.
-type documenttagOption func(*DocumentTagMutation)
-
-// newDocumentTagMutation creates new mutation for the DocumentTag entity.
-func newDocumentTagMutation(c config, op Op, opts ...documenttagOption) *DocumentTagMutation {
-	m := &DocumentTagMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	company_id    *uuid.UUID
+	model         *types.ClassifierModel
+	evaluation    *types.ClassifierEvaluation
+	documents     *int
+	adddocuments  *int
+	trained_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DocumentClassifier, error)
+	predicates    []predicate.DocumentClassifier
+}
+
+var _ ent.Mutation = (*DocumentClassifierMutation)(nil)
+
+// documentclassifierOption allows management of the mutation configuration using functional options.
+type documentclassifierOption func(*DocumentClassifierMutation)
+
+// newDocumentClassifierMutation creates new mutation for the DocumentClassifier entity.
+func newDocumentClassifierMutation(c config, op Op, opts ...documentclassifierOption) *DocumentClassifierMutation {
+	m := &DocumentClassifierMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDocumentTag,
+		typ:           TypeDocumentClassifier,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2931,20 +3695,20 @@ func newDocumentTagMutation(c config, op Op, opts ...documenttagOption) *Documen
 	return m
 }
 
-// withDocumentTagID sets the ID field of the mutation.
-func withDocumentTagID(id uuid.UUID) documenttagOption {
-	return func(m *DocumentTagMutation) {
+// withDocumentClassifierID sets the ID field of the mutation.
+func withDocumentClassifierID(id uuid.UUID) documentclassifierOption {
+	return func(m *DocumentClassifierMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DocumentTag
+			value *DocumentClassifier
 		)
-		m.oldValue = func(ctx context.Context) (*DocumentTag, error) {
+		m.oldValue = func(ctx context.Context) (*DocumentClassifier, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DocumentTag.Get(ctx, id)
+					value, err = m.Client().DocumentClassifier.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2953,10 +3717,10 @@ func withDocumentTagID(id uuid.UUID) documenttagOption {
 	}
 }
 
-// withDocumentTag sets the old DocumentTag of the mutation.
-func withDocumentTag(node *DocumentTag) documenttagOption {
-	return func(m *DocumentTagMutation) {
-		m.oldValue = func(context.Context) (*DocumentTag, error) {
+// withDocumentClassifier sets the old DocumentClassifier of the mutation.
+func withDocumentClassifier(node *DocumentClassifier) documentclassifierOption {
+	return func(m *DocumentClassifierMutation) {
+		m.oldValue = func(context.Context) (*DocumentClassifier, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2965,7 +3729,7 @@ func withDocumentTag(node *DocumentTag) documenttagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DocumentTagMutation) Client() *Client {
+func (m DocumentClassifierMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2973,7 +3737,7 @@ func (m DocumentTagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DocumentTagMutation) Tx() (*Tx, error) {
+func (m DocumentClassifierMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2983,14 +3747,14 @@ func (m DocumentTagMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DocumentTag entities.
-func (m *DocumentTagMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DocumentClassifier entities.
+func (m *DocumentClassifierMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DocumentTagMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DocumentClassifierMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3001,7 +3765,7 @@ func (m *DocumentTagMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DocumentTagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DocumentClassifierMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3010,201 +3774,221 @@ func (m *DocumentTagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DocumentTag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DocumentClassifier.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetDocumentID sets the "document_id" field.
-func (m *DocumentTagMutation) SetDocumentID(u uuid.UUID) {
-	m.document_id = &u
+// SetCompanyID sets the "company_id" field.
+func (m *DocumentClassifierMutation) SetCompanyID(u uuid.UUID) {
+	m.company_id = &u
 }
 
-// DocumentID returns the value of the "document_id" field in the mutation.
-func (m *DocumentTagMutation) DocumentID() (r uuid.UUID, exists bool) {
-	v := m.document_id
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *DocumentClassifierMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDocumentID returns the old "document_id" field's value of the DocumentTag entity.
-// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// OldCompanyID returns the old "company_id" field's value of the DocumentClassifier entity.
+// If the DocumentClassifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentTagMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DocumentClassifierMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
 	}
-	return oldValue.DocumentID, nil
+	return oldValue.CompanyID, nil
 }
 
-// ResetDocumentID resets all changes to the "document_id" field.
-func (m *DocumentTagMutation) ResetDocumentID() {
-	m.document_id = nil
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *DocumentClassifierMutation) ResetCompanyID() {
+	m.company_id = nil
 }
 
-// SetTagID sets the "tag_id" field.
-func (m *DocumentTagMutation) SetTagID(u uuid.UUID) {
-	m.tag_id = &u
+// SetModel sets the "model" field.
+func (m *DocumentClassifierMutation) SetModel(tm types.ClassifierModel) {
+	m.model = &tm
 }
 
-// TagID returns the value of the "tag_id" field in the mutation.
-func (m *DocumentTagMutation) TagID() (r uuid.UUID, exists bool) {
-	v := m.tag_id
+// Model returns the value of the "model" field in the mutation.
+func (m *DocumentClassifierMutation) Model() (r types.ClassifierModel, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTagID returns the old "tag_id" field's value of the DocumentTag entity.
-// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the DocumentClassifier entity.
+// If the DocumentClassifier object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DocumentTagMutation) OldTagID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DocumentClassifierMutation) OldModel(ctx context.Context) (v types.ClassifierModel, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTagID requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.TagID, nil
+	return oldValue.Model, nil
 }
 
-// ResetTagID resets all changes to the "tag_id" field.
-func (m *DocumentTagMutation) ResetTagID() {
-	m.tag_id = nil
+// ResetModel resets all changes to the "model" field.
+func (m *DocumentClassifierMutation) ResetModel() {
+	m.model = nil
 }
 
-// AddDocumentIDs adds the "document" edge to the Document entity by ids.
-func (m *DocumentTagMutation) AddDocumentIDs(ids ...uuid.UUID) {
-	if m.document == nil {
-		m.document = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.document[ids[i]] = struct{}{}
+// SetEvaluation sets the "evaluation" field.
+func (m *DocumentClassifierMutation) SetEvaluation(te types.ClassifierEvaluation) {
+	m.evaluation = &te
+}
+
+// Evaluation returns the value of the "evaluation" field in the mutation.
+func (m *DocumentClassifierMutation) Evaluation() (r types.ClassifierEvaluation, exists bool) {
+	v := m.evaluation
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearDocument clears the "document" edge to the Document entity.
-func (m *DocumentTagMutation) ClearDocument() {
-	m.cleareddocument = true
+// OldEvaluation returns the old "evaluation" field's value of the DocumentClassifier entity.
+// If the DocumentClassifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentClassifierMutation) OldEvaluation(ctx context.Context) (v types.ClassifierEvaluation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEvaluation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEvaluation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEvaluation: %w", err)
+	}
+	return oldValue.Evaluation, nil
 }
 
-// DocumentCleared reports if the "document" edge to the Document entity was cleared.
-func (m *DocumentTagMutation) DocumentCleared() bool {
-	return m.cleareddocument
+// ResetEvaluation resets all changes to the "evaluation" field.
+func (m *DocumentClassifierMutation) ResetEvaluation() {
+	m.evaluation = nil
 }
 
-// RemoveDocumentIDs removes the "document" edge to the Document entity by IDs.
-func (m *DocumentTagMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
-	if m.removeddocument == nil {
-		m.removeddocument = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.document, ids[i])
-		m.removeddocument[ids[i]] = struct{}{}
-	}
+// SetDocuments sets the "documents" field.
+func (m *DocumentClassifierMutation) SetDocuments(i int) {
+	m.documents = &i
+	m.adddocuments = nil
 }
 
-// RemovedDocument returns the removed IDs of the "document" edge to the Document entity.
-func (m *DocumentTagMutation) RemovedDocumentIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocument {
-		ids = append(ids, id)
+// Documents returns the value of the "documents" field in the mutation.
+func (m *DocumentClassifierMutation) Documents() (r int, exists bool) {
+	v := m.documents
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// DocumentIDs returns the "document" edge IDs in the mutation.
-func (m *DocumentTagMutation) DocumentIDs() (ids []uuid.UUID) {
-	for id := range m.document {
-		ids = append(ids, id)
+// OldDocuments returns the old "documents" field's value of the DocumentClassifier entity.
+// If the DocumentClassifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentClassifierMutation) OldDocuments(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocuments is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocuments requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocuments: %w", err)
+	}
+	return oldValue.Documents, nil
 }
 
-// ResetDocument resets all changes to the "document" edge.
-func (m *DocumentTagMutation) ResetDocument() {
-	m.document = nil
-	m.cleareddocument = false
-	m.removeddocument = nil
+// AddDocuments adds i to the "documents" field.
+func (m *DocumentClassifierMutation) AddDocuments(i int) {
+	if m.adddocuments != nil {
+		*m.adddocuments += i
+	} else {
+		m.adddocuments = &i
+	}
 }
 
-// AddTagIDs adds the "tag" edge to the Tag entity by ids.
-func (m *DocumentTagMutation) AddTagIDs(ids ...uuid.UUID) {
-	if m.tag == nil {
-		m.tag = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.tag[ids[i]] = struct{}{}
+// AddedDocuments returns the value that was added to the "documents" field in this mutation.
+func (m *DocumentClassifierMutation) AddedDocuments() (r int, exists bool) {
+	v := m.adddocuments
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearTag clears the "tag" edge to the Tag entity.
-func (m *DocumentTagMutation) ClearTag() {
-	m.clearedtag = true
+// ResetDocuments resets all changes to the "documents" field.
+func (m *DocumentClassifierMutation) ResetDocuments() {
+	m.documents = nil
+	m.adddocuments = nil
 }
 
-// TagCleared reports if the "tag" edge to the Tag entity was cleared.
-func (m *DocumentTagMutation) TagCleared() bool {
-	return m.clearedtag
+// SetTrainedAt sets the "trained_at" field.
+func (m *DocumentClassifierMutation) SetTrainedAt(t time.Time) {
+	m.trained_at = &t
 }
 
-// RemoveTagIDs removes the "tag" edge to the Tag entity by IDs.
-func (m *DocumentTagMutation) RemoveTagIDs(ids ...uuid.UUID) {
-	if m.removedtag == nil {
-		m.removedtag = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.tag, ids[i])
-		m.removedtag[ids[i]] = struct{}{}
+// TrainedAt returns the value of the "trained_at" field in the mutation.
+func (m *DocumentClassifierMutation) TrainedAt() (r time.Time, exists bool) {
+	v := m.trained_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTag returns the removed IDs of the "tag" edge to the Tag entity.
-func (m *DocumentTagMutation) RemovedTagIDs() (ids []uuid.UUID) {
-	for id := range m.removedtag {
-		ids = append(ids, id)
+// OldTrainedAt returns the old "trained_at" field's value of the DocumentClassifier entity.
+// If the DocumentClassifier object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentClassifierMutation) OldTrainedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrainedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// TagIDs returns the "tag" edge IDs in the mutation.
-func (m *DocumentTagMutation) TagIDs() (ids []uuid.UUID) {
-	for id := range m.tag {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrainedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrainedAt: %w", err)
+	}
+	return oldValue.TrainedAt, nil
 }
 
-// ResetTag resets all changes to the "tag" edge.
-func (m *DocumentTagMutation) ResetTag() {
-	m.tag = nil
-	m.clearedtag = false
-	m.removedtag = nil
+// ResetTrainedAt resets all changes to the "trained_at" field.
+func (m *DocumentClassifierMutation) ResetTrainedAt() {
+	m.trained_at = nil
 }
 
-// Where appends a list predicates to the DocumentTagMutation builder.
-func (m *DocumentTagMutation) Where(ps ...predicate.DocumentTag) {
+// Where appends a list predicates to the DocumentClassifierMutation builder.
+func (m *DocumentClassifierMutation) Where(ps ...predicate.DocumentClassifier) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DocumentTagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DocumentClassifierMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DocumentTagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DocumentTag, len(ps))
+func (m *DocumentClassifierMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentClassifier, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3212,30 +3996,39 @@ func (m *DocumentTagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DocumentTagMutation) Op() Op {
+func (m *DocumentClassifierMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DocumentTagMutation) SetOp(op Op) {
+func (m *DocumentClassifierMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DocumentTag).
-func (m *DocumentTagMutation) Type() string {
+// Type returns the node type of this mutation (DocumentClassifier).
+func (m *DocumentClassifierMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DocumentTagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.document_id != nil {
-		fields = append(fields, documenttag.FieldDocumentID)
+func (m *DocumentClassifierMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.company_id != nil {
+		fields = append(fields, documentclassifier.FieldCompanyID)
 	}
-	if m.tag_id != nil {
-		fields = append(fields, documenttag.FieldTagID)
+	if m.model != nil {
+		fields = append(fields, documentclassifier.FieldModel)
+	}
+	if m.evaluation != nil {
+		fields = append(fields, documentclassifier.FieldEvaluation)
+	}
+	if m.documents != nil {
+		fields = append(fields, documentclassifier.FieldDocuments)
+	}
+	if m.trained_at != nil {
+		fields = append(fields, documentclassifier.FieldTrainedAt)
 	}
 	return fields
 }
@@ -3243,12 +4036,18 @@ func (m *DocumentTagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DocumentTagMutation) Field(name string) (ent.Value, bool) {
+func (m *DocumentClassifierMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case documenttag.FieldDocumentID:
-		return m.DocumentID()
-	case documenttag.FieldTagID:
-		return m.TagID()
+	case documentclassifier.FieldCompanyID:
+		return m.CompanyID()
+	case documentclassifier.FieldModel:
+		return m.Model()
+	case documentclassifier.FieldEvaluation:
+		return m.Evaluation()
+	case documentclassifier.FieldDocuments:
+		return m.Documents()
+	case documentclassifier.FieldTrainedAt:
+		return m.TrainedAt()
 	}
 	return nil, false
 }
@@ -3256,151 +4055,9545 @@ func (m *DocumentTagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DocumentTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DocumentClassifierMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case documenttag.FieldDocumentID:
-		return m.OldDocumentID(ctx)
-	case documenttag.FieldTagID:
-		return m.OldTagID(ctx)
+	case documentclassifier.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case documentclassifier.FieldModel:
+		return m.OldModel(ctx)
+	case documentclassifier.FieldEvaluation:
+		return m.OldEvaluation(ctx)
+	case documentclassifier.FieldDocuments:
+		return m.OldDocuments(ctx)
+	case documentclassifier.FieldTrainedAt:
+		return m.OldTrainedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DocumentTag field %s", name)
+	return nil, fmt.Errorf("unknown DocumentClassifier field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentTagMutation) SetField(name string, value ent.Value) error {
+func (m *DocumentClassifierMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case documenttag.FieldDocumentID:
+	case documentclassifier.FieldCompanyID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDocumentID(v)
+		m.SetCompanyID(v)
 		return nil
-	case documenttag.FieldTagID:
-		v, ok := value.(uuid.UUID)
+	case documentclassifier.FieldModel:
+		v, ok := value.(types.ClassifierModel)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTagID(v)
+		m.SetModel(v)
+		return nil
+	case documentclassifier.FieldEvaluation:
+		v, ok := value.(types.ClassifierEvaluation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvaluation(v)
+		return nil
+	case documentclassifier.FieldDocuments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocuments(v)
+		return nil
+	case documentclassifier.FieldTrainedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrainedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DocumentTag field %s", name)
+	return fmt.Errorf("unknown DocumentClassifier field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DocumentTagMutation) AddedFields() []string {
-	return nil
+func (m *DocumentClassifierMutation) AddedFields() []string {
+	var fields []string
+	if m.adddocuments != nil {
+		fields = append(fields, documentclassifier.FieldDocuments)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DocumentTagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DocumentClassifierMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case documentclassifier.FieldDocuments:
+		return m.AddedDocuments()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DocumentTagMutation) AddField(name string, value ent.Value) error {
+func (m *DocumentClassifierMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case documentclassifier.FieldDocuments:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDocuments(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DocumentTag numeric field %s", name)
+	return fmt.Errorf("unknown DocumentClassifier numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DocumentTagMutation) ClearedFields() []string {
+func (m *DocumentClassifierMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DocumentTagMutation) FieldCleared(name string) bool {
+func (m *DocumentClassifierMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DocumentTagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DocumentTag nullable field %s", name)
+func (m *DocumentClassifierMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DocumentClassifier nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DocumentTagMutation) ResetField(name string) error {
+func (m *DocumentClassifierMutation) ResetField(name string) error {
 	switch name {
-	case documenttag.FieldDocumentID:
-		m.ResetDocumentID()
+	case documentclassifier.FieldCompanyID:
+		m.ResetCompanyID()
 		return nil
-	case documenttag.FieldTagID:
-		m.ResetTagID()
+	case documentclassifier.FieldModel:
+		m.ResetModel()
+		return nil
+	case documentclassifier.FieldEvaluation:
+		m.ResetEvaluation()
+		return nil
+	case documentclassifier.FieldDocuments:
+		m.ResetDocuments()
+		return nil
+	case documentclassifier.FieldTrainedAt:
+		m.ResetTrainedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DocumentTag field %s", name)
+	return fmt.Errorf("unknown DocumentClassifier field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DocumentTagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.document != nil {
-		edges = append(edges, documenttag.EdgeDocument)
-	}
-	if m.tag != nil {
-		edges = append(edges, documenttag.EdgeTag)
-	}
+func (m *DocumentClassifierMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DocumentTagMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case documenttag.EdgeDocument:
-		ids := make([]ent.Value, 0, len(m.document))
-		for id := range m.document {
-			ids = append(ids, id)
-		}
-		return ids
-	case documenttag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.tag))
-		for id := range m.tag {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DocumentClassifierMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DocumentTagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeddocument != nil {
-		edges = append(edges, documenttag.EdgeDocument)
-	}
-	if m.removedtag != nil {
+func (m *DocumentClassifierMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentClassifierMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentClassifierMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentClassifierMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentClassifierMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DocumentClassifier unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentClassifierMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DocumentClassifier edge %s", name)
+}
+
+// DocumentContentMutation represents an operation that mutates the DocumentContent nodes in the graph.
+type DocumentContentMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	text            *string
+	pages           *[]types.PageOffset
+	appendpages     []types.PageOffset
+	language        *string
+	engine          *string
+	engine_version  *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	document        *uuid.UUID
+	cleareddocument bool
+	done            bool
+	oldValue        func(context.Context) (*DocumentContent, error)
+	predicates      []predicate.DocumentContent
+}
+
+var _ ent.Mutation = (*DocumentContentMutation)(nil)
+
+// documentcontentOption allows management of the mutation configuration using functional options.
+type documentcontentOption func(*DocumentContentMutation)
+
+// newDocumentContentMutation creates new mutation for the DocumentContent entity.
+func newDocumentContentMutation(c config, op Op, opts ...documentcontentOption) *DocumentContentMutation {
+	m := &DocumentContentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentContent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentContentID sets the ID field of the mutation.
+func withDocumentContentID(id uuid.UUID) documentcontentOption {
+	return func(m *DocumentContentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentContent
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentContent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentContent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentContent sets the old DocumentContent of the mutation.
+func withDocumentContent(node *DocumentContent) documentcontentOption {
+	return func(m *DocumentContentMutation) {
+		m.oldValue = func(context.Context) (*DocumentContent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentContentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentContentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentContent entities.
+func (m *DocumentContentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentContentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentContentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentContent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *DocumentContentMutation) SetDocumentID(u uuid.UUID) {
+	m.document = &u
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *DocumentContentMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *DocumentContentMutation) ResetDocumentID() {
+	m.document = nil
+}
+
+// SetText sets the "text" field.
+func (m *DocumentContentMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *DocumentContentMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *DocumentContentMutation) ResetText() {
+	m.text = nil
+}
+
+// SetPages sets the "pages" field.
+func (m *DocumentContentMutation) SetPages(to []types.PageOffset) {
+	m.pages = &to
+	m.appendpages = nil
+}
+
+// Pages returns the value of the "pages" field in the mutation.
+func (m *DocumentContentMutation) Pages() (r []types.PageOffset, exists bool) {
+	v := m.pages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPages returns the old "pages" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldPages(ctx context.Context) (v []types.PageOffset, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPages: %w", err)
+	}
+	return oldValue.Pages, nil
+}
+
+// AppendPages adds to to the "pages" field.
+func (m *DocumentContentMutation) AppendPages(to []types.PageOffset) {
+	m.appendpages = append(m.appendpages, to...)
+}
+
+// AppendedPages returns the list of values that were appended to the "pages" field in this mutation.
+func (m *DocumentContentMutation) AppendedPages() ([]types.PageOffset, bool) {
+	if len(m.appendpages) == 0 {
+		return nil, false
+	}
+	return m.appendpages, true
+}
+
+// ClearPages clears the value of the "pages" field.
+func (m *DocumentContentMutation) ClearPages() {
+	m.pages = nil
+	m.appendpages = nil
+	m.clearedFields[documentcontent.FieldPages] = struct{}{}
+}
+
+// PagesCleared returns if the "pages" field was cleared in this mutation.
+func (m *DocumentContentMutation) PagesCleared() bool {
+	_, ok := m.clearedFields[documentcontent.FieldPages]
+	return ok
+}
+
+// ResetPages resets all changes to the "pages" field.
+func (m *DocumentContentMutation) ResetPages() {
+	m.pages = nil
+	m.appendpages = nil
+	delete(m.clearedFields, documentcontent.FieldPages)
+}
+
+// SetLanguage sets the "language" field.
+func (m *DocumentContentMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *DocumentContentMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldLanguage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *DocumentContentMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[documentcontent.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *DocumentContentMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[documentcontent.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *DocumentContentMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, documentcontent.FieldLanguage)
+}
+
+// SetEngine sets the "engine" field.
+func (m *DocumentContentMutation) SetEngine(s string) {
+	m.engine = &s
+}
+
+// Engine returns the value of the "engine" field in the mutation.
+func (m *DocumentContentMutation) Engine() (r string, exists bool) {
+	v := m.engine
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngine returns the old "engine" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldEngine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngine: %w", err)
+	}
+	return oldValue.Engine, nil
+}
+
+// ResetEngine resets all changes to the "engine" field.
+func (m *DocumentContentMutation) ResetEngine() {
+	m.engine = nil
+}
+
+// SetEngineVersion sets the "engine_version" field.
+func (m *DocumentContentMutation) SetEngineVersion(s string) {
+	m.engine_version = &s
+}
+
+// EngineVersion returns the value of the "engine_version" field in the mutation.
+func (m *DocumentContentMutation) EngineVersion() (r string, exists bool) {
+	v := m.engine_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEngineVersion returns the old "engine_version" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldEngineVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEngineVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEngineVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEngineVersion: %w", err)
+	}
+	return oldValue.EngineVersion, nil
+}
+
+// ClearEngineVersion clears the value of the "engine_version" field.
+func (m *DocumentContentMutation) ClearEngineVersion() {
+	m.engine_version = nil
+	m.clearedFields[documentcontent.FieldEngineVersion] = struct{}{}
+}
+
+// EngineVersionCleared returns if the "engine_version" field was cleared in this mutation.
+func (m *DocumentContentMutation) EngineVersionCleared() bool {
+	_, ok := m.clearedFields[documentcontent.FieldEngineVersion]
+	return ok
+}
+
+// ResetEngineVersion resets all changes to the "engine_version" field.
+func (m *DocumentContentMutation) ResetEngineVersion() {
+	m.engine_version = nil
+	delete(m.clearedFields, documentcontent.FieldEngineVersion)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentContentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentContentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentContentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentContentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentContentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DocumentContent entity.
+// If the DocumentContent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentContentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentContentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (m *DocumentContentMutation) ClearDocument() {
+	m.cleareddocument = true
+	m.clearedFields[documentcontent.FieldDocumentID] = struct{}{}
+}
+
+// DocumentCleared reports if the "document" edge to the Document entity was cleared.
+func (m *DocumentContentMutation) DocumentCleared() bool {
+	return m.cleareddocument
+}
+
+// DocumentIDs returns the "document" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DocumentID instead. It exists only for internal usage by the builders.
+func (m *DocumentContentMutation) DocumentIDs() (ids []uuid.UUID) {
+	if id := m.document; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDocument resets all changes to the "document" edge.
+func (m *DocumentContentMutation) ResetDocument() {
+	m.document = nil
+	m.cleareddocument = false
+}
+
+// Where appends a list predicates to the DocumentContentMutation builder.
+func (m *DocumentContentMutation) Where(ps ...predicate.DocumentContent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentContentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentContentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentContent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentContentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentContentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentContent).
+func (m *DocumentContentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentContentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.document != nil {
+		fields = append(fields, documentcontent.FieldDocumentID)
+	}
+	if m.text != nil {
+		fields = append(fields, documentcontent.FieldText)
+	}
+	if m.pages != nil {
+		fields = append(fields, documentcontent.FieldPages)
+	}
+	if m.language != nil {
+		fields = append(fields, documentcontent.FieldLanguage)
+	}
+	if m.engine != nil {
+		fields = append(fields, documentcontent.FieldEngine)
+	}
+	if m.engine_version != nil {
+		fields = append(fields, documentcontent.FieldEngineVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, documentcontent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, documentcontent.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentContentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documentcontent.FieldDocumentID:
+		return m.DocumentID()
+	case documentcontent.FieldText:
+		return m.Text()
+	case documentcontent.FieldPages:
+		return m.Pages()
+	case documentcontent.FieldLanguage:
+		return m.Language()
+	case documentcontent.FieldEngine:
+		return m.Engine()
+	case documentcontent.FieldEngineVersion:
+		return m.EngineVersion()
+	case documentcontent.FieldCreatedAt:
+		return m.CreatedAt()
+	case documentcontent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentContentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documentcontent.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case documentcontent.FieldText:
+		return m.OldText(ctx)
+	case documentcontent.FieldPages:
+		return m.OldPages(ctx)
+	case documentcontent.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case documentcontent.FieldEngine:
+		return m.OldEngine(ctx)
+	case documentcontent.FieldEngineVersion:
+		return m.OldEngineVersion(ctx)
+	case documentcontent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case documentcontent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentContent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentContentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documentcontent.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case documentcontent.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case documentcontent.FieldPages:
+		v, ok := value.([]types.PageOffset)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPages(v)
+		return nil
+	case documentcontent.FieldLanguage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguage(v)
+		return nil
+	case documentcontent.FieldEngine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngine(v)
+		return nil
+	case documentcontent.FieldEngineVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEngineVersion(v)
+		return nil
+	case documentcontent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case documentcontent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentContent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentContentMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentContentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentContentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentContent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentContentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documentcontent.FieldPages) {
+		fields = append(fields, documentcontent.FieldPages)
+	}
+	if m.FieldCleared(documentcontent.FieldLanguage) {
+		fields = append(fields, documentcontent.FieldLanguage)
+	}
+	if m.FieldCleared(documentcontent.FieldEngineVersion) {
+		fields = append(fields, documentcontent.FieldEngineVersion)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentContentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentContentMutation) ClearField(name string) error {
+	switch name {
+	case documentcontent.FieldPages:
+		m.ClearPages()
+		return nil
+	case documentcontent.FieldLanguage:
+		m.ClearLanguage()
+		return nil
+	case documentcontent.FieldEngineVersion:
+		m.ClearEngineVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentContent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentContentMutation) ResetField(name string) error {
+	switch name {
+	case documentcontent.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case documentcontent.FieldText:
+		m.ResetText()
+		return nil
+	case documentcontent.FieldPages:
+		m.ResetPages()
+		return nil
+	case documentcontent.FieldLanguage:
+		m.ResetLanguage()
+		return nil
+	case documentcontent.FieldEngine:
+		m.ResetEngine()
+		return nil
+	case documentcontent.FieldEngineVersion:
+		m.ResetEngineVersion()
+		return nil
+	case documentcontent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case documentcontent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentContent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentContentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.document != nil {
+		edges = append(edges, documentcontent.EdgeDocument)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentContentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case documentcontent.EdgeDocument:
+		if id := m.document; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentContentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentContentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentContentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddocument {
+		edges = append(edges, documentcontent.EdgeDocument)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentContentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case documentcontent.EdgeDocument:
+		return m.cleareddocument
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentContentMutation) ClearEdge(name string) error {
+	switch name {
+	case documentcontent.EdgeDocument:
+		m.ClearDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentContent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentContentMutation) ResetEdge(name string) error {
+	switch name {
+	case documentcontent.EdgeDocument:
+		m.ResetDocument()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentContent edge %s", name)
+}
+
+// DocumentTagMutation represents an operation that mutates the DocumentTag nodes in the graph.
+type DocumentTagMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	document_id     *uuid.UUID
+	tag_id          *uuid.UUID
+	source          *documenttag.Source
+	rule_id         *uuid.UUID
+	run_id          *uuid.UUID
+	created_by      *uuid.UUID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	document        map[uuid.UUID]struct{}
+	removeddocument map[uuid.UUID]struct{}
+	cleareddocument bool
+	tag             map[uuid.UUID]struct{}
+	removedtag      map[uuid.UUID]struct{}
+	clearedtag      bool
+	done            bool
+	oldValue        func(context.Context) (*DocumentTag, error)
+	predicates      []predicate.DocumentTag
+}
+
+var _ ent.Mutation = (*DocumentTagMutation)(nil)
+
+// documenttagOption allows management of the mutation configuration using functional options.
+type documenttagOption func(*DocumentTagMutation)
+
+// newDocumentTagMutation creates new mutation for the DocumentTag entity.
+func newDocumentTagMutation(c config, op Op, opts ...documenttagOption) *DocumentTagMutation {
+	m := &DocumentTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentTagID sets the ID field of the mutation.
+func withDocumentTagID(id uuid.UUID) documenttagOption {
+	return func(m *DocumentTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentTag
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentTag sets the old DocumentTag of the mutation.
+func withDocumentTag(node *DocumentTag) documenttagOption {
+	return func(m *DocumentTagMutation) {
+		m.oldValue = func(context.Context) (*DocumentTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentTag entities.
+func (m *DocumentTagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentTagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentTagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *DocumentTagMutation) SetDocumentID(u uuid.UUID) {
+	m.document_id = &u
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *DocumentTagMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *DocumentTagMutation) ResetDocumentID() {
+	m.document_id = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *DocumentTagMutation) SetTagID(u uuid.UUID) {
+	m.tag_id = &u
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *DocumentTagMutation) TagID() (r uuid.UUID, exists bool) {
+	v := m.tag_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldTagID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *DocumentTagMutation) ResetTagID() {
+	m.tag_id = nil
+}
+
+// SetSource sets the "source" field.
+func (m *DocumentTagMutation) SetSource(d documenttag.Source) {
+	m.source = &d
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *DocumentTagMutation) Source() (r documenttag.Source, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldSource(ctx context.Context) (v documenttag.Source, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *DocumentTagMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *DocumentTagMutation) SetRuleID(u uuid.UUID) {
+	m.rule_id = &u
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *DocumentTagMutation) RuleID() (r uuid.UUID, exists bool) {
+	v := m.rule_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldRuleID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// ClearRuleID clears the value of the "rule_id" field.
+func (m *DocumentTagMutation) ClearRuleID() {
+	m.rule_id = nil
+	m.clearedFields[documenttag.FieldRuleID] = struct{}{}
+}
+
+// RuleIDCleared returns if the "rule_id" field was cleared in this mutation.
+func (m *DocumentTagMutation) RuleIDCleared() bool {
+	_, ok := m.clearedFields[documenttag.FieldRuleID]
+	return ok
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *DocumentTagMutation) ResetRuleID() {
+	m.rule_id = nil
+	delete(m.clearedFields, documenttag.FieldRuleID)
+}
+
+// SetRunID sets the "run_id" field.
+func (m *DocumentTagMutation) SetRunID(u uuid.UUID) {
+	m.run_id = &u
+}
+
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DocumentTagMutation) RunID() (r uuid.UUID, exists bool) {
+	v := m.run_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRunID returns the old "run_id" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldRunID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
+	}
+	return oldValue.RunID, nil
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (m *DocumentTagMutation) ClearRunID() {
+	m.run_id = nil
+	m.clearedFields[documenttag.FieldRunID] = struct{}{}
+}
+
+// RunIDCleared returns if the "run_id" field was cleared in this mutation.
+func (m *DocumentTagMutation) RunIDCleared() bool {
+	_, ok := m.clearedFields[documenttag.FieldRunID]
+	return ok
+}
+
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DocumentTagMutation) ResetRunID() {
+	m.run_id = nil
+	delete(m.clearedFields, documenttag.FieldRunID)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *DocumentTagMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *DocumentTagMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *DocumentTagMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[documenttag.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *DocumentTagMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[documenttag.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *DocumentTagMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, documenttag.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocumentTag entity.
+// If the DocumentTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddDocumentIDs adds the "document" edge to the Document entity by ids.
+func (m *DocumentTagMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.document == nil {
+		m.document = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.document[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocument clears the "document" edge to the Document entity.
+func (m *DocumentTagMutation) ClearDocument() {
+	m.cleareddocument = true
+}
+
+// DocumentCleared reports if the "document" edge to the Document entity was cleared.
+func (m *DocumentTagMutation) DocumentCleared() bool {
+	return m.cleareddocument
+}
+
+// RemoveDocumentIDs removes the "document" edge to the Document entity by IDs.
+func (m *DocumentTagMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocument == nil {
+		m.removeddocument = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.document, ids[i])
+		m.removeddocument[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocument returns the removed IDs of the "document" edge to the Document entity.
+func (m *DocumentTagMutation) RemovedDocumentIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocument {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentIDs returns the "document" edge IDs in the mutation.
+func (m *DocumentTagMutation) DocumentIDs() (ids []uuid.UUID) {
+	for id := range m.document {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocument resets all changes to the "document" edge.
+func (m *DocumentTagMutation) ResetDocument() {
+	m.document = nil
+	m.cleareddocument = false
+	m.removeddocument = nil
+}
+
+// AddTagIDs adds the "tag" edge to the Tag entity by ids.
+func (m *DocumentTagMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tag == nil {
+		m.tag = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tag[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *DocumentTagMutation) ClearTag() {
+	m.clearedtag = true
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *DocumentTagMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// RemoveTagIDs removes the "tag" edge to the Tag entity by IDs.
+func (m *DocumentTagMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtag == nil {
+		m.removedtag = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tag, ids[i])
+		m.removedtag[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTag returns the removed IDs of the "tag" edge to the Tag entity.
+func (m *DocumentTagMutation) RemovedTagIDs() (ids []uuid.UUID) {
+	for id := range m.removedtag {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+func (m *DocumentTagMutation) TagIDs() (ids []uuid.UUID) {
+	for id := range m.tag {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTag resets all changes to the "tag" edge.
+func (m *DocumentTagMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
+	m.removedtag = nil
+}
+
+// Where appends a list predicates to the DocumentTagMutation builder.
+func (m *DocumentTagMutation) Where(ps ...predicate.DocumentTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentTag).
+func (m *DocumentTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentTagMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.document_id != nil {
+		fields = append(fields, documenttag.FieldDocumentID)
+	}
+	if m.tag_id != nil {
+		fields = append(fields, documenttag.FieldTagID)
+	}
+	if m.source != nil {
+		fields = append(fields, documenttag.FieldSource)
+	}
+	if m.rule_id != nil {
+		fields = append(fields, documenttag.FieldRuleID)
+	}
+	if m.run_id != nil {
+		fields = append(fields, documenttag.FieldRunID)
+	}
+	if m.created_by != nil {
+		fields = append(fields, documenttag.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, documenttag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documenttag.FieldDocumentID:
+		return m.DocumentID()
+	case documenttag.FieldTagID:
+		return m.TagID()
+	case documenttag.FieldSource:
+		return m.Source()
+	case documenttag.FieldRuleID:
+		return m.RuleID()
+	case documenttag.FieldRunID:
+		return m.RunID()
+	case documenttag.FieldCreatedBy:
+		return m.CreatedBy()
+	case documenttag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documenttag.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case documenttag.FieldTagID:
+		return m.OldTagID(ctx)
+	case documenttag.FieldSource:
+		return m.OldSource(ctx)
+	case documenttag.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case documenttag.FieldRunID:
+		return m.OldRunID(ctx)
+	case documenttag.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case documenttag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documenttag.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case documenttag.FieldTagID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case documenttag.FieldSource:
+		v, ok := value.(documenttag.Source)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case documenttag.FieldRuleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleID(v)
+		return nil
+	case documenttag.FieldRunID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case documenttag.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case documenttag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentTagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documenttag.FieldRuleID) {
+		fields = append(fields, documenttag.FieldRuleID)
+	}
+	if m.FieldCleared(documenttag.FieldRunID) {
+		fields = append(fields, documenttag.FieldRunID)
+	}
+	if m.FieldCleared(documenttag.FieldCreatedBy) {
+		fields = append(fields, documenttag.FieldCreatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentTagMutation) ClearField(name string) error {
+	switch name {
+	case documenttag.FieldRuleID:
+		m.ClearRuleID()
+		return nil
+	case documenttag.FieldRunID:
+		m.ClearRunID()
+		return nil
+	case documenttag.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentTagMutation) ResetField(name string) error {
+	switch name {
+	case documenttag.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case documenttag.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case documenttag.FieldSource:
+		m.ResetSource()
+		return nil
+	case documenttag.FieldRuleID:
+		m.ResetRuleID()
+		return nil
+	case documenttag.FieldRunID:
+		m.ResetRunID()
+		return nil
+	case documenttag.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case documenttag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.document != nil {
+		edges = append(edges, documenttag.EdgeDocument)
+	}
+	if m.tag != nil {
+		edges = append(edges, documenttag.EdgeTag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case documenttag.EdgeDocument:
+		ids := make([]ent.Value, 0, len(m.document))
+		for id := range m.document {
+			ids = append(ids, id)
+		}
+		return ids
+	case documenttag.EdgeTag:
+		ids := make([]ent.Value, 0, len(m.tag))
+		for id := range m.tag {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddocument != nil {
+		edges = append(edges, documenttag.EdgeDocument)
+	}
+	if m.removedtag != nil {
+		edges = append(edges, documenttag.EdgeTag)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case documenttag.EdgeDocument:
+		ids := make([]ent.Value, 0, len(m.removeddocument))
+		for id := range m.removeddocument {
+			ids = append(ids, id)
+		}
+		return ids
+	case documenttag.EdgeTag:
+		ids := make([]ent.Value, 0, len(m.removedtag))
+		for id := range m.removedtag {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddocument {
+		edges = append(edges, documenttag.EdgeDocument)
+	}
+	if m.clearedtag {
 		edges = append(edges, documenttag.EdgeTag)
 	}
 	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *DocumentTagMutation) RemovedIDs(name string) []ent.Value {
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case documenttag.EdgeDocument:
+		return m.cleareddocument
+	case documenttag.EdgeTag:
+		return m.clearedtag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentTagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentTagMutation) ResetEdge(name string) error {
+	switch name {
+	case documenttag.EdgeDocument:
+		m.ResetDocument()
+		return nil
+	case documenttag.EdgeTag:
+		m.ResetTag()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTag edge %s", name)
+}
+
+// DocumentTagSuppressionMutation represents an operation that mutates the DocumentTagSuppression nodes in the graph.
+type DocumentTagSuppressionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	document_id   *uuid.UUID
+	tag_id        *uuid.UUID
+	removed_by    *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DocumentTagSuppression, error)
+	predicates    []predicate.DocumentTagSuppression
+}
+
+var _ ent.Mutation = (*DocumentTagSuppressionMutation)(nil)
+
+// documenttagsuppressionOption allows management of the mutation configuration using functional options.
+type documenttagsuppressionOption func(*DocumentTagSuppressionMutation)
+
+// newDocumentTagSuppressionMutation creates new mutation for the DocumentTagSuppression entity.
+func newDocumentTagSuppressionMutation(c config, op Op, opts ...documenttagsuppressionOption) *DocumentTagSuppressionMutation {
+	m := &DocumentTagSuppressionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentTagSuppression,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentTagSuppressionID sets the ID field of the mutation.
+func withDocumentTagSuppressionID(id uuid.UUID) documenttagsuppressionOption {
+	return func(m *DocumentTagSuppressionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentTagSuppression
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentTagSuppression, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentTagSuppression.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentTagSuppression sets the old DocumentTagSuppression of the mutation.
+func withDocumentTagSuppression(node *DocumentTagSuppression) documenttagsuppressionOption {
+	return func(m *DocumentTagSuppressionMutation) {
+		m.oldValue = func(context.Context) (*DocumentTagSuppression, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentTagSuppressionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentTagSuppressionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentTagSuppression entities.
+func (m *DocumentTagSuppressionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentTagSuppressionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentTagSuppressionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentTagSuppression.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *DocumentTagSuppressionMutation) SetDocumentID(u uuid.UUID) {
+	m.document_id = &u
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *DocumentTagSuppressionMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the DocumentTagSuppression entity.
+// If the DocumentTagSuppression object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagSuppressionMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *DocumentTagSuppressionMutation) ResetDocumentID() {
+	m.document_id = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *DocumentTagSuppressionMutation) SetTagID(u uuid.UUID) {
+	m.tag_id = &u
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *DocumentTagSuppressionMutation) TagID() (r uuid.UUID, exists bool) {
+	v := m.tag_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the DocumentTagSuppression entity.
+// If the DocumentTagSuppression object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagSuppressionMutation) OldTagID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *DocumentTagSuppressionMutation) ResetTagID() {
+	m.tag_id = nil
+}
+
+// SetRemovedBy sets the "removed_by" field.
+func (m *DocumentTagSuppressionMutation) SetRemovedBy(u uuid.UUID) {
+	m.removed_by = &u
+}
+
+// RemovedBy returns the value of the "removed_by" field in the mutation.
+func (m *DocumentTagSuppressionMutation) RemovedBy() (r uuid.UUID, exists bool) {
+	v := m.removed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemovedBy returns the old "removed_by" field's value of the DocumentTagSuppression entity.
+// If the DocumentTagSuppression object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagSuppressionMutation) OldRemovedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemovedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemovedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemovedBy: %w", err)
+	}
+	return oldValue.RemovedBy, nil
+}
+
+// ClearRemovedBy clears the value of the "removed_by" field.
+func (m *DocumentTagSuppressionMutation) ClearRemovedBy() {
+	m.removed_by = nil
+	m.clearedFields[documenttagsuppression.FieldRemovedBy] = struct{}{}
+}
+
+// RemovedByCleared returns if the "removed_by" field was cleared in this mutation.
+func (m *DocumentTagSuppressionMutation) RemovedByCleared() bool {
+	_, ok := m.clearedFields[documenttagsuppression.FieldRemovedBy]
+	return ok
+}
+
+// ResetRemovedBy resets all changes to the "removed_by" field.
+func (m *DocumentTagSuppressionMutation) ResetRemovedBy() {
+	m.removed_by = nil
+	delete(m.clearedFields, documenttagsuppression.FieldRemovedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentTagSuppressionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentTagSuppressionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocumentTagSuppression entity.
+// If the DocumentTagSuppression object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTagSuppressionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentTagSuppressionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the DocumentTagSuppressionMutation builder.
+func (m *DocumentTagSuppressionMutation) Where(ps ...predicate.DocumentTagSuppression) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentTagSuppressionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentTagSuppressionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentTagSuppression, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentTagSuppressionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentTagSuppressionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentTagSuppression).
+func (m *DocumentTagSuppressionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentTagSuppressionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.document_id != nil {
+		fields = append(fields, documenttagsuppression.FieldDocumentID)
+	}
+	if m.tag_id != nil {
+		fields = append(fields, documenttagsuppression.FieldTagID)
+	}
+	if m.removed_by != nil {
+		fields = append(fields, documenttagsuppression.FieldRemovedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, documenttagsuppression.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentTagSuppressionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documenttagsuppression.FieldDocumentID:
+		return m.DocumentID()
+	case documenttagsuppression.FieldTagID:
+		return m.TagID()
+	case documenttagsuppression.FieldRemovedBy:
+		return m.RemovedBy()
+	case documenttagsuppression.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentTagSuppressionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documenttagsuppression.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case documenttagsuppression.FieldTagID:
+		return m.OldTagID(ctx)
+	case documenttagsuppression.FieldRemovedBy:
+		return m.OldRemovedBy(ctx)
+	case documenttagsuppression.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentTagSuppression field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTagSuppressionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documenttagsuppression.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case documenttagsuppression.FieldTagID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case documenttagsuppression.FieldRemovedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemovedBy(v)
+		return nil
+	case documenttagsuppression.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTagSuppression field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentTagSuppressionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentTagSuppressionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTagSuppressionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentTagSuppression numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentTagSuppressionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documenttagsuppression.FieldRemovedBy) {
+		fields = append(fields, documenttagsuppression.FieldRemovedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentTagSuppressionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentTagSuppressionMutation) ClearField(name string) error {
+	switch name {
+	case documenttagsuppression.FieldRemovedBy:
+		m.ClearRemovedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTagSuppression nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentTagSuppressionMutation) ResetField(name string) error {
+	switch name {
+	case documenttagsuppression.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case documenttagsuppression.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case documenttagsuppression.FieldRemovedBy:
+		m.ResetRemovedBy()
+		return nil
+	case documenttagsuppression.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentTagSuppression field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentTagSuppressionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentTagSuppressionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentTagSuppressionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentTagSuppressionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentTagSuppressionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentTagSuppressionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentTagSuppressionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DocumentTagSuppression unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentTagSuppressionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DocumentTagSuppression edge %s", name)
+}
+
+// DocumentTypeMutation represents an operation that mutates the DocumentType nodes in the graph.
+type DocumentTypeMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	description      *string
+	fields           *[]types.DocumentField
+	appendfields     []types.DocumentField
+	extraction       *types.ExtractionTemplate
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	company          *uuid.UUID
+	clearedcompany   bool
+	documents        map[uuid.UUID]struct{}
+	removeddocuments map[uuid.UUID]struct{}
+	cleareddocuments bool
+	done             bool
+	oldValue         func(context.Context) (*DocumentType, error)
+	predicates       []predicate.DocumentType
+}
+
+var _ ent.Mutation = (*DocumentTypeMutation)(nil)
+
+// documenttypeOption allows management of the mutation configuration using functional options.
+type documenttypeOption func(*DocumentTypeMutation)
+
+// newDocumentTypeMutation creates new mutation for the DocumentType entity.
+func newDocumentTypeMutation(c config, op Op, opts ...documenttypeOption) *DocumentTypeMutation {
+	m := &DocumentTypeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDocumentType,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDocumentTypeID sets the ID field of the mutation.
+func withDocumentTypeID(id uuid.UUID) documenttypeOption {
+	return func(m *DocumentTypeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DocumentType
+		)
+		m.oldValue = func(ctx context.Context) (*DocumentType, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DocumentType.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDocumentType sets the old DocumentType of the mutation.
+func withDocumentType(node *DocumentType) documenttypeOption {
+	return func(m *DocumentTypeMutation) {
+		m.oldValue = func(context.Context) (*DocumentType, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DocumentTypeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DocumentTypeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DocumentType entities.
+func (m *DocumentTypeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DocumentTypeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DocumentTypeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DocumentType.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *DocumentTypeMutation) SetCompanyID(u uuid.UUID) {
+	m.company = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *DocumentTypeMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *DocumentTypeMutation) ResetCompanyID() {
+	m.company = nil
+}
+
+// SetName sets the "name" field.
+func (m *DocumentTypeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DocumentTypeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DocumentTypeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *DocumentTypeMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DocumentTypeMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DocumentTypeMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[documenttype.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DocumentTypeMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[documenttype.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DocumentTypeMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, documenttype.FieldDescription)
+}
+
+// SetFields sets the "fields" field.
+func (m *DocumentTypeMutation) SetFields(tf []types.DocumentField) {
+	m.fields = &tf
+	m.appendfields = nil
+}
+
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *DocumentTypeMutation) GetFields() (r []types.DocumentField, exists bool) {
+	v := m.fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFields returns the old "fields" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldFields(ctx context.Context) (v []types.DocumentField, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
+	}
+	return oldValue.Fields, nil
+}
+
+// AppendFields adds tf to the "fields" field.
+func (m *DocumentTypeMutation) AppendFields(tf []types.DocumentField) {
+	m.appendfields = append(m.appendfields, tf...)
+}
+
+// AppendedFields returns the list of values that were appended to the "fields" field in this mutation.
+func (m *DocumentTypeMutation) AppendedFields() ([]types.DocumentField, bool) {
+	if len(m.appendfields) == 0 {
+		return nil, false
+	}
+	return m.appendfields, true
+}
+
+// ResetFields resets all changes to the "fields" field.
+func (m *DocumentTypeMutation) ResetFields() {
+	m.fields = nil
+	m.appendfields = nil
+}
+
+// SetExtraction sets the "extraction" field.
+func (m *DocumentTypeMutation) SetExtraction(tt types.ExtractionTemplate) {
+	m.extraction = &tt
+}
+
+// Extraction returns the value of the "extraction" field in the mutation.
+func (m *DocumentTypeMutation) Extraction() (r types.ExtractionTemplate, exists bool) {
+	v := m.extraction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExtraction returns the old "extraction" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldExtraction(ctx context.Context) (v types.ExtractionTemplate, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExtraction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExtraction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExtraction: %w", err)
+	}
+	return oldValue.Extraction, nil
+}
+
+// ClearExtraction clears the value of the "extraction" field.
+func (m *DocumentTypeMutation) ClearExtraction() {
+	m.extraction = nil
+	m.clearedFields[documenttype.FieldExtraction] = struct{}{}
+}
+
+// ExtractionCleared returns if the "extraction" field was cleared in this mutation.
+func (m *DocumentTypeMutation) ExtractionCleared() bool {
+	_, ok := m.clearedFields[documenttype.FieldExtraction]
+	return ok
+}
+
+// ResetExtraction resets all changes to the "extraction" field.
+func (m *DocumentTypeMutation) ResetExtraction() {
+	m.extraction = nil
+	delete(m.clearedFields, documenttype.FieldExtraction)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DocumentTypeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DocumentTypeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DocumentTypeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DocumentTypeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DocumentTypeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the DocumentType entity.
+// If the DocumentType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DocumentTypeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DocumentTypeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *DocumentTypeMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[documenttype.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *DocumentTypeMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *DocumentTypeMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *DocumentTypeMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *DocumentTypeMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *DocumentTypeMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *DocumentTypeMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *DocumentTypeMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *DocumentTypeMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *DocumentTypeMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *DocumentTypeMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// Where appends a list predicates to the DocumentTypeMutation builder.
+func (m *DocumentTypeMutation) Where(ps ...predicate.DocumentType) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DocumentTypeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DocumentTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DocumentType, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DocumentTypeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DocumentTypeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DocumentType).
+func (m *DocumentTypeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DocumentTypeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.company != nil {
+		fields = append(fields, documenttype.FieldCompanyID)
+	}
+	if m.name != nil {
+		fields = append(fields, documenttype.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, documenttype.FieldDescription)
+	}
+	if m.fields != nil {
+		fields = append(fields, documenttype.FieldFields)
+	}
+	if m.extraction != nil {
+		fields = append(fields, documenttype.FieldExtraction)
+	}
+	if m.created_at != nil {
+		fields = append(fields, documenttype.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, documenttype.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DocumentTypeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case documenttype.FieldCompanyID:
+		return m.CompanyID()
+	case documenttype.FieldName:
+		return m.Name()
+	case documenttype.FieldDescription:
+		return m.Description()
+	case documenttype.FieldFields:
+		return m.GetFields()
+	case documenttype.FieldExtraction:
+		return m.Extraction()
+	case documenttype.FieldCreatedAt:
+		return m.CreatedAt()
+	case documenttype.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DocumentTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case documenttype.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case documenttype.FieldName:
+		return m.OldName(ctx)
+	case documenttype.FieldDescription:
+		return m.OldDescription(ctx)
+	case documenttype.FieldFields:
+		return m.OldFields(ctx)
+	case documenttype.FieldExtraction:
+		return m.OldExtraction(ctx)
+	case documenttype.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case documenttype.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DocumentType field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTypeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case documenttype.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case documenttype.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case documenttype.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case documenttype.FieldFields:
+		v, ok := value.([]types.DocumentField)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFields(v)
+		return nil
+	case documenttype.FieldExtraction:
+		v, ok := value.(types.ExtractionTemplate)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExtraction(v)
+		return nil
+	case documenttype.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case documenttype.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentType field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DocumentTypeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DocumentTypeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DocumentTypeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DocumentType numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DocumentTypeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(documenttype.FieldDescription) {
+		fields = append(fields, documenttype.FieldDescription)
+	}
+	if m.FieldCleared(documenttype.FieldExtraction) {
+		fields = append(fields, documenttype.FieldExtraction)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DocumentTypeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DocumentTypeMutation) ClearField(name string) error {
+	switch name {
+	case documenttype.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case documenttype.FieldExtraction:
+		m.ClearExtraction()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentType nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DocumentTypeMutation) ResetField(name string) error {
+	switch name {
+	case documenttype.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case documenttype.FieldName:
+		m.ResetName()
+		return nil
+	case documenttype.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case documenttype.FieldFields:
+		m.ResetFields()
+		return nil
+	case documenttype.FieldExtraction:
+		m.ResetExtraction()
+		return nil
+	case documenttype.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case documenttype.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentType field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DocumentTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.company != nil {
+		edges = append(edges, documenttype.EdgeCompany)
+	}
+	if m.documents != nil {
+		edges = append(edges, documenttype.EdgeDocuments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DocumentTypeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case documenttype.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	case documenttype.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DocumentTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddocuments != nil {
+		edges = append(edges, documenttype.EdgeDocuments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DocumentTypeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case documenttype.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DocumentTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcompany {
+		edges = append(edges, documenttype.EdgeCompany)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, documenttype.EdgeDocuments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DocumentTypeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case documenttype.EdgeCompany:
+		return m.clearedcompany
+	case documenttype.EdgeDocuments:
+		return m.cleareddocuments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DocumentTypeMutation) ClearEdge(name string) error {
+	switch name {
+	case documenttype.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentType unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DocumentTypeMutation) ResetEdge(name string) error {
+	switch name {
+	case documenttype.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	case documenttype.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	}
+	return fmt.Errorf("unknown DocumentType edge %s", name)
+}
+
+// FolderMutation represents an operation that mutates the Folder nodes in the graph.
+type FolderMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	size             *int64
+	addsize          *int64
+	count            *int
+	addcount         *int
+	clearedFields    map[string]struct{}
+	company          *uuid.UUID
+	clearedcompany   bool
+	parent           *uuid.UUID
+	clearedparent    bool
+	children         map[uuid.UUID]struct{}
+	removedchildren  map[uuid.UUID]struct{}
+	clearedchildren  bool
+	documents        map[uuid.UUID]struct{}
+	removeddocuments map[uuid.UUID]struct{}
+	cleareddocuments bool
+	done             bool
+	oldValue         func(context.Context) (*Folder, error)
+	predicates       []predicate.Folder
+}
+
+var _ ent.Mutation = (*FolderMutation)(nil)
+
+// folderOption allows management of the mutation configuration using functional options.
+type folderOption func(*FolderMutation)
+
+// newFolderMutation creates new mutation for the Folder entity.
+func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
+	m := &FolderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFolder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFolderID sets the ID field of the mutation.
+func withFolderID(id uuid.UUID) folderOption {
+	return func(m *FolderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Folder
+		)
+		m.oldValue = func(ctx context.Context) (*Folder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Folder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFolder sets the old Folder of the mutation.
+func withFolder(node *Folder) folderOption {
+	return func(m *FolderMutation) {
+		m.oldValue = func(context.Context) (*Folder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FolderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FolderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Folder entities.
+func (m *FolderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *FolderMutation) SetCompanyID(u uuid.UUID) {
+	m.company = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *FolderMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *FolderMutation) ResetCompanyID() {
+	m.company = nil
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (m *FolderMutation) SetParentFolderID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentFolderID returns the value of the "parent_folder_id" field in the mutation.
+func (m *FolderMutation) ParentFolderID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentFolderID returns the old "parent_folder_id" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldParentFolderID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentFolderID: %w", err)
+	}
+	return oldValue.ParentFolderID, nil
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (m *FolderMutation) ClearParentFolderID() {
+	m.parent = nil
+	m.clearedFields[folder.FieldParentFolderID] = struct{}{}
+}
+
+// ParentFolderIDCleared returns if the "parent_folder_id" field was cleared in this mutation.
+func (m *FolderMutation) ParentFolderIDCleared() bool {
+	_, ok := m.clearedFields[folder.FieldParentFolderID]
+	return ok
+}
+
+// ResetParentFolderID resets all changes to the "parent_folder_id" field.
+func (m *FolderMutation) ResetParentFolderID() {
+	m.parent = nil
+	delete(m.clearedFields, folder.FieldParentFolderID)
+}
+
+// SetName sets the "name" field.
+func (m *FolderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FolderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FolderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSize sets the "size" field.
+func (m *FolderMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *FolderMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *FolderMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *FolderMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *FolderMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetCount sets the "count" field.
+func (m *FolderMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *FolderMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the Folder entity.
+// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FolderMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *FolderMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *FolderMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *FolderMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *FolderMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[folder.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *FolderMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *FolderMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// SetParentID sets the "parent" edge to the Folder entity by id.
+func (m *FolderMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Folder entity.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[folder.FieldParentFolderID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.ParentFolderIDCleared() || m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *FolderMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Folder entity by ids.
+func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Folder entity.
+func (m *FolderMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
+func (m *FolderMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
+func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *FolderMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *FolderMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *FolderMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *FolderMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *FolderMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *FolderMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *FolderMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// Where appends a list predicates to the FolderMutation builder.
+func (m *FolderMutation) Where(ps ...predicate.Folder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Folder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FolderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FolderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.company != nil {
+		fields = append(fields, folder.FieldCompanyID)
+	}
+	if m.parent != nil {
+		fields = append(fields, folder.FieldParentFolderID)
+	}
+	if m.name != nil {
+		fields = append(fields, folder.FieldName)
+	}
+	if m.size != nil {
+		fields = append(fields, folder.FieldSize)
+	}
+	if m.count != nil {
+		fields = append(fields, folder.FieldCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldCompanyID:
+		return m.CompanyID()
+	case folder.FieldParentFolderID:
+		return m.ParentFolderID()
+	case folder.FieldName:
+		return m.Name()
+	case folder.FieldSize:
+		return m.Size()
+	case folder.FieldCount:
+		return m.Count()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case folder.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case folder.FieldParentFolderID:
+		return m.OldParentFolderID(ctx)
+	case folder.FieldName:
+		return m.OldName(ctx)
+	case folder.FieldSize:
+		return m.OldSize(ctx)
+	case folder.FieldCount:
+		return m.OldCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Folder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case folder.FieldParentFolderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentFolderID(v)
+		return nil
+	case folder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case folder.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case folder.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FolderMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, folder.FieldSize)
+	}
+	if m.addcount != nil {
+		fields = append(fields, folder.FieldCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldSize:
+		return m.AddedSize()
+	case folder.FieldCount:
+		return m.AddedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case folder.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FolderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(folder.FieldParentFolderID) {
+		fields = append(fields, folder.FieldParentFolderID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderMutation) ClearField(name string) error {
+	switch name {
+	case folder.FieldParentFolderID:
+		m.ClearParentFolderID()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FolderMutation) ResetField(name string) error {
+	switch name {
+	case folder.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case folder.FieldParentFolderID:
+		m.ResetParentFolderID()
+		return nil
+	case folder.FieldName:
+		m.ResetName()
+		return nil
+	case folder.FieldSize:
+		m.ResetSize()
+		return nil
+	case folder.FieldCount:
+		m.ResetCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.company != nil {
+		edges = append(edges, folder.EdgeCompany)
+	}
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.documents != nil {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.removeddocuments != nil {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedcompany {
+		edges = append(edges, folder.EdgeCompany)
+	}
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.cleareddocuments {
+		edges = append(edges, folder.EdgeDocuments)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FolderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case folder.EdgeCompany:
+		return m.clearedcompany
+	case folder.EdgeParent:
+		return m.clearedparent
+	case folder.EdgeChildren:
+		return m.clearedchildren
+	case folder.EdgeDocuments:
+		return m.cleareddocuments
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FolderMutation) ClearEdge(name string) error {
+	switch name {
+	case folder.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FolderMutation) ResetEdge(name string) error {
+	switch name {
+	case folder.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeDocuments:
+		m.ResetDocuments()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	_type                     *string
+	status                    *job.Status
+	payload                   *map[string]interface{}
+	result_document_ids       *[]uuid.UUID
+	appendresult_document_ids []uuid.UUID
+	error                     *string
+	progress                  *int
+	addprogress               *int
+	total                     *int
+	addtotal                  *int
+	created_by                *uuid.UUID
+	created_at                *time.Time
+	updated_at                *time.Time
+	finished_at               *time.Time
+	clearedFields             map[string]struct{}
+	company                   *uuid.UUID
+	clearedcompany            bool
+	done                      bool
+	oldValue                  func(context.Context) (*Job, error)
+	predicates                []predicate.Job
+}
+
+var _ ent.Mutation = (*JobMutation)(nil)
+
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
+
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeJob,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withJobID sets the ID field of the mutation.
+func withJobID(id uuid.UUID) jobOption {
+	return func(m *JobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Job
+		)
+		m.oldValue = func(ctx context.Context) (*Job, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Job.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m JobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m JobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *JobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *JobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *JobMutation) SetCompanyID(u uuid.UUID) {
+	m.company = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *JobMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCompanyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ClearCompanyID clears the value of the "company_id" field.
+func (m *JobMutation) ClearCompanyID() {
+	m.company = nil
+	m.clearedFields[job.FieldCompanyID] = struct{}{}
+}
+
+// CompanyIDCleared returns if the "company_id" field was cleared in this mutation.
+func (m *JobMutation) CompanyIDCleared() bool {
+	_, ok := m.clearedFields[job.FieldCompanyID]
+	return ok
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *JobMutation) ResetCompanyID() {
+	m.company = nil
+	delete(m.clearedFields, job.FieldCompanyID)
+}
+
+// SetType sets the "type" field.
+func (m *JobMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *JobMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *JobMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *JobMutation) SetStatus(j job.Status) {
+	m.status = &j
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *JobMutation) Status() (r job.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldStatus(ctx context.Context) (v job.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *JobMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ClearPayload clears the value of the "payload" field.
+func (m *JobMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[job.FieldPayload] = struct{}{}
+}
+
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *JobMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[job.FieldPayload]
+	return ok
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, job.FieldPayload)
+}
+
+// SetResultDocumentIds sets the "result_document_ids" field.
+func (m *JobMutation) SetResultDocumentIds(u []uuid.UUID) {
+	m.result_document_ids = &u
+	m.appendresult_document_ids = nil
+}
+
+// ResultDocumentIds returns the value of the "result_document_ids" field in the mutation.
+func (m *JobMutation) ResultDocumentIds() (r []uuid.UUID, exists bool) {
+	v := m.result_document_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultDocumentIds returns the old "result_document_ids" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldResultDocumentIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultDocumentIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultDocumentIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultDocumentIds: %w", err)
+	}
+	return oldValue.ResultDocumentIds, nil
+}
+
+// AppendResultDocumentIds adds u to the "result_document_ids" field.
+func (m *JobMutation) AppendResultDocumentIds(u []uuid.UUID) {
+	m.appendresult_document_ids = append(m.appendresult_document_ids, u...)
+}
+
+// AppendedResultDocumentIds returns the list of values that were appended to the "result_document_ids" field in this mutation.
+func (m *JobMutation) AppendedResultDocumentIds() ([]uuid.UUID, bool) {
+	if len(m.appendresult_document_ids) == 0 {
+		return nil, false
+	}
+	return m.appendresult_document_ids, true
+}
+
+// ClearResultDocumentIds clears the value of the "result_document_ids" field.
+func (m *JobMutation) ClearResultDocumentIds() {
+	m.result_document_ids = nil
+	m.appendresult_document_ids = nil
+	m.clearedFields[job.FieldResultDocumentIds] = struct{}{}
+}
+
+// ResultDocumentIdsCleared returns if the "result_document_ids" field was cleared in this mutation.
+func (m *JobMutation) ResultDocumentIdsCleared() bool {
+	_, ok := m.clearedFields[job.FieldResultDocumentIds]
+	return ok
+}
+
+// ResetResultDocumentIds resets all changes to the "result_document_ids" field.
+func (m *JobMutation) ResetResultDocumentIds() {
+	m.result_document_ids = nil
+	m.appendresult_document_ids = nil
+	delete(m.clearedFields, job.FieldResultDocumentIds)
+}
+
+// SetError sets the "error" field.
+func (m *JobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *JobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *JobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[job.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *JobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[job.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *JobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, job.FieldError)
+}
+
+// SetProgress sets the "progress" field.
+func (m *JobMutation) SetProgress(i int) {
+	m.progress = &i
+	m.addprogress = nil
+}
+
+// Progress returns the value of the "progress" field in the mutation.
+func (m *JobMutation) Progress() (r int, exists bool) {
+	v := m.progress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProgress returns the old "progress" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldProgress(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProgress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProgress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProgress: %w", err)
+	}
+	return oldValue.Progress, nil
+}
+
+// AddProgress adds i to the "progress" field.
+func (m *JobMutation) AddProgress(i int) {
+	if m.addprogress != nil {
+		*m.addprogress += i
+	} else {
+		m.addprogress = &i
+	}
+}
+
+// AddedProgress returns the value that was added to the "progress" field in this mutation.
+func (m *JobMutation) AddedProgress() (r int, exists bool) {
+	v := m.addprogress
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProgress resets all changes to the "progress" field.
+func (m *JobMutation) ResetProgress() {
+	m.progress = nil
+	m.addprogress = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *JobMutation) SetTotal(i int) {
+	m.total = &i
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *JobMutation) Total() (r int, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldTotal(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds i to the "total" field.
+func (m *JobMutation) AddTotal(i int) {
+	if m.addtotal != nil {
+		*m.addtotal += i
+	} else {
+		m.addtotal = &i
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *JobMutation) AddedTotal() (r int, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *JobMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *JobMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *JobMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *JobMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[job.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *JobMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[job.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *JobMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, job.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *JobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *JobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *JobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[job.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *JobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[job.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *JobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, job.FieldFinishedAt)
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *JobMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[job.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *JobMutation) CompanyCleared() bool {
+	return m.CompanyIDCleared() || m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *JobMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *JobMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.company != nil {
+		fields = append(fields, job.FieldCompanyID)
+	}
+	if m._type != nil {
+		fields = append(fields, job.FieldType)
+	}
+	if m.status != nil {
+		fields = append(fields, job.FieldStatus)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.result_document_ids != nil {
+		fields = append(fields, job.FieldResultDocumentIds)
+	}
+	if m.error != nil {
+		fields = append(fields, job.FieldError)
+	}
+	if m.progress != nil {
+		fields = append(fields, job.FieldProgress)
+	}
+	if m.total != nil {
+		fields = append(fields, job.FieldTotal)
+	}
+	if m.created_by != nil {
+		fields = append(fields, job.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, job.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldCompanyID:
+		return m.CompanyID()
+	case job.FieldType:
+		return m.GetType()
+	case job.FieldStatus:
+		return m.Status()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldResultDocumentIds:
+		return m.ResultDocumentIds()
+	case job.FieldError:
+		return m.Error()
+	case job.FieldProgress:
+		return m.Progress()
+	case job.FieldTotal:
+		return m.Total()
+	case job.FieldCreatedBy:
+		return m.CreatedBy()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	case job.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case job.FieldFinishedAt:
+		return m.FinishedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case job.FieldType:
+		return m.OldType(ctx)
+	case job.FieldStatus:
+		return m.OldStatus(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldResultDocumentIds:
+		return m.OldResultDocumentIds(ctx)
+	case job.FieldError:
+		return m.OldError(ctx)
+	case job.FieldProgress:
+		return m.OldProgress(ctx)
+	case job.FieldTotal:
+		return m.OldTotal(ctx)
+	case job.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case job.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case job.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case job.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case job.FieldStatus:
+		v, ok := value.(job.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldResultDocumentIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultDocumentIds(v)
+		return nil
+	case job.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case job.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProgress(v)
+		return nil
+	case job.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case job.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case job.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case job.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addprogress != nil {
+		fields = append(fields, job.FieldProgress)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, job.FieldTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldProgress:
+		return m.AddedProgress()
+	case job.FieldTotal:
+		return m.AddedTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldProgress:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddProgress(v)
+		return nil
+	case job.FieldTotal:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(job.FieldCompanyID) {
+		fields = append(fields, job.FieldCompanyID)
+	}
+	if m.FieldCleared(job.FieldPayload) {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.FieldCleared(job.FieldResultDocumentIds) {
+		fields = append(fields, job.FieldResultDocumentIds)
+	}
+	if m.FieldCleared(job.FieldError) {
+		fields = append(fields, job.FieldError)
+	}
+	if m.FieldCleared(job.FieldCreatedBy) {
+		fields = append(fields, job.FieldCreatedBy)
+	}
+	if m.FieldCleared(job.FieldFinishedAt) {
+		fields = append(fields, job.FieldFinishedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	switch name {
+	case job.FieldCompanyID:
+		m.ClearCompanyID()
+		return nil
+	case job.FieldPayload:
+		m.ClearPayload()
+		return nil
+	case job.FieldResultDocumentIds:
+		m.ClearResultDocumentIds()
+		return nil
+	case job.FieldError:
+		m.ClearError()
+		return nil
+	case job.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	case job.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case job.FieldType:
+		m.ResetType()
+		return nil
+	case job.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldResultDocumentIds:
+		m.ResetResultDocumentIds()
+		return nil
+	case job.FieldError:
+		m.ResetError()
+		return nil
+	case job.FieldProgress:
+		m.ResetProgress()
+		return nil
+	case job.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case job.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case job.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case job.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.company != nil {
+		edges = append(edges, job.EdgeCompany)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case job.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcompany {
+		edges = append(edges, job.EdgeCompany)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	switch name {
+	case job.EdgeCompany:
+		return m.clearedcompany
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	switch name {
+	case job.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	switch name {
+	case job.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// SavedSearchMutation represents an operation that mutates the SavedSearch nodes in the graph.
+type SavedSearchMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *uuid.UUID
+	name             *string
+	query            *string
+	filters          *types.SearchFilters
+	sort             *string
+	shared           *bool
+	pinned           *bool
+	parent_folder_id *uuid.UUID
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	company          *uuid.UUID
+	clearedcompany   bool
+	done             bool
+	oldValue         func(context.Context) (*SavedSearch, error)
+	predicates       []predicate.SavedSearch
+}
+
+var _ ent.Mutation = (*SavedSearchMutation)(nil)
+
+// savedsearchOption allows management of the mutation configuration using functional options.
+type savedsearchOption func(*SavedSearchMutation)
+
+// newSavedSearchMutation creates new mutation for the SavedSearch entity.
+func newSavedSearchMutation(c config, op Op, opts ...savedsearchOption) *SavedSearchMutation {
+	m := &SavedSearchMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavedSearch,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavedSearchID sets the ID field of the mutation.
+func withSavedSearchID(id uuid.UUID) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavedSearch
+		)
+		m.oldValue = func(ctx context.Context) (*SavedSearch, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavedSearch.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavedSearch sets the old SavedSearch of the mutation.
+func withSavedSearch(node *SavedSearch) savedsearchOption {
+	return func(m *SavedSearchMutation) {
+		m.oldValue = func(context.Context) (*SavedSearch, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavedSearchMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavedSearchMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavedSearch entities.
+func (m *SavedSearchMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavedSearchMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavedSearchMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavedSearch.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *SavedSearchMutation) SetCompanyID(u uuid.UUID) {
+	m.company = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *SavedSearchMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *SavedSearchMutation) ResetCompanyID() {
+	m.company = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SavedSearchMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SavedSearchMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SavedSearchMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *SavedSearchMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SavedSearchMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SavedSearchMutation) ResetName() {
+	m.name = nil
+}
+
+// SetQuery sets the "query" field.
+func (m *SavedSearchMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *SavedSearchMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *SavedSearchMutation) ResetQuery() {
+	m.query = nil
+}
+
+// SetFilters sets the "filters" field.
+func (m *SavedSearchMutation) SetFilters(tf types.SearchFilters) {
+	m.filters = &tf
+}
+
+// Filters returns the value of the "filters" field in the mutation.
+func (m *SavedSearchMutation) Filters() (r types.SearchFilters, exists bool) {
+	v := m.filters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilters returns the old "filters" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldFilters(ctx context.Context) (v types.SearchFilters, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilters: %w", err)
+	}
+	return oldValue.Filters, nil
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (m *SavedSearchMutation) ClearFilters() {
+	m.filters = nil
+	m.clearedFields[savedsearch.FieldFilters] = struct{}{}
+}
+
+// FiltersCleared returns if the "filters" field was cleared in this mutation.
+func (m *SavedSearchMutation) FiltersCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldFilters]
+	return ok
+}
+
+// ResetFilters resets all changes to the "filters" field.
+func (m *SavedSearchMutation) ResetFilters() {
+	m.filters = nil
+	delete(m.clearedFields, savedsearch.FieldFilters)
+}
+
+// SetSort sets the "sort" field.
+func (m *SavedSearchMutation) SetSort(s string) {
+	m.sort = &s
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SavedSearchMutation) Sort() (r string, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldSort(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SavedSearchMutation) ResetSort() {
+	m.sort = nil
+}
+
+// SetShared sets the "shared" field.
+func (m *SavedSearchMutation) SetShared(b bool) {
+	m.shared = &b
+}
+
+// Shared returns the value of the "shared" field in the mutation.
+func (m *SavedSearchMutation) Shared() (r bool, exists bool) {
+	v := m.shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShared returns the old "shared" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldShared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShared: %w", err)
+	}
+	return oldValue.Shared, nil
+}
+
+// ResetShared resets all changes to the "shared" field.
+func (m *SavedSearchMutation) ResetShared() {
+	m.shared = nil
+}
+
+// SetPinned sets the "pinned" field.
+func (m *SavedSearchMutation) SetPinned(b bool) {
+	m.pinned = &b
+}
+
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *SavedSearchMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPinned returns the old "pinned" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldPinned(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPinned requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
+	}
+	return oldValue.Pinned, nil
+}
+
+// ResetPinned resets all changes to the "pinned" field.
+func (m *SavedSearchMutation) ResetPinned() {
+	m.pinned = nil
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (m *SavedSearchMutation) SetParentFolderID(u uuid.UUID) {
+	m.parent_folder_id = &u
+}
+
+// ParentFolderID returns the value of the "parent_folder_id" field in the mutation.
+func (m *SavedSearchMutation) ParentFolderID() (r uuid.UUID, exists bool) {
+	v := m.parent_folder_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentFolderID returns the old "parent_folder_id" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldParentFolderID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentFolderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentFolderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentFolderID: %w", err)
+	}
+	return oldValue.ParentFolderID, nil
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (m *SavedSearchMutation) ClearParentFolderID() {
+	m.parent_folder_id = nil
+	m.clearedFields[savedsearch.FieldParentFolderID] = struct{}{}
+}
+
+// ParentFolderIDCleared returns if the "parent_folder_id" field was cleared in this mutation.
+func (m *SavedSearchMutation) ParentFolderIDCleared() bool {
+	_, ok := m.clearedFields[savedsearch.FieldParentFolderID]
+	return ok
+}
+
+// ResetParentFolderID resets all changes to the "parent_folder_id" field.
+func (m *SavedSearchMutation) ResetParentFolderID() {
+	m.parent_folder_id = nil
+	delete(m.clearedFields, savedsearch.FieldParentFolderID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavedSearchMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavedSearchMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavedSearchMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SavedSearchMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SavedSearchMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SavedSearch entity.
+// If the SavedSearch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavedSearchMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SavedSearchMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *SavedSearchMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[savedsearch.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *SavedSearchMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *SavedSearchMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *SavedSearchMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// Where appends a list predicates to the SavedSearchMutation builder.
+func (m *SavedSearchMutation) Where(ps ...predicate.SavedSearch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavedSearchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavedSearchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavedSearch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavedSearchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavedSearchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavedSearch).
+func (m *SavedSearchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavedSearchMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.company != nil {
+		fields = append(fields, savedsearch.FieldCompanyID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, savedsearch.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, savedsearch.FieldName)
+	}
+	if m.query != nil {
+		fields = append(fields, savedsearch.FieldQuery)
+	}
+	if m.filters != nil {
+		fields = append(fields, savedsearch.FieldFilters)
+	}
+	if m.sort != nil {
+		fields = append(fields, savedsearch.FieldSort)
+	}
+	if m.shared != nil {
+		fields = append(fields, savedsearch.FieldShared)
+	}
+	if m.pinned != nil {
+		fields = append(fields, savedsearch.FieldPinned)
+	}
+	if m.parent_folder_id != nil {
+		fields = append(fields, savedsearch.FieldParentFolderID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savedsearch.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, savedsearch.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavedSearchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savedsearch.FieldCompanyID:
+		return m.CompanyID()
+	case savedsearch.FieldUserID:
+		return m.UserID()
+	case savedsearch.FieldName:
+		return m.Name()
+	case savedsearch.FieldQuery:
+		return m.Query()
+	case savedsearch.FieldFilters:
+		return m.Filters()
+	case savedsearch.FieldSort:
+		return m.Sort()
+	case savedsearch.FieldShared:
+		return m.Shared()
+	case savedsearch.FieldPinned:
+		return m.Pinned()
+	case savedsearch.FieldParentFolderID:
+		return m.ParentFolderID()
+	case savedsearch.FieldCreatedAt:
+		return m.CreatedAt()
+	case savedsearch.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavedSearchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savedsearch.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case savedsearch.FieldUserID:
+		return m.OldUserID(ctx)
+	case savedsearch.FieldName:
+		return m.OldName(ctx)
+	case savedsearch.FieldQuery:
+		return m.OldQuery(ctx)
+	case savedsearch.FieldFilters:
+		return m.OldFilters(ctx)
+	case savedsearch.FieldSort:
+		return m.OldSort(ctx)
+	case savedsearch.FieldShared:
+		return m.OldShared(ctx)
+	case savedsearch.FieldPinned:
+		return m.OldPinned(ctx)
+	case savedsearch.FieldParentFolderID:
+		return m.OldParentFolderID(ctx)
+	case savedsearch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case savedsearch.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savedsearch.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case savedsearch.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case savedsearch.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case savedsearch.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case savedsearch.FieldFilters:
+		v, ok := value.(types.SearchFilters)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilters(v)
+		return nil
+	case savedsearch.FieldSort:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case savedsearch.FieldShared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShared(v)
+		return nil
+	case savedsearch.FieldPinned:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinned(v)
+		return nil
+	case savedsearch.FieldParentFolderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentFolderID(v)
+		return nil
+	case savedsearch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case savedsearch.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavedSearchMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavedSearchMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavedSearchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SavedSearch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavedSearchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savedsearch.FieldFilters) {
+		fields = append(fields, savedsearch.FieldFilters)
+	}
+	if m.FieldCleared(savedsearch.FieldParentFolderID) {
+		fields = append(fields, savedsearch.FieldParentFolderID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavedSearchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ClearField(name string) error {
+	switch name {
+	case savedsearch.FieldFilters:
+		m.ClearFilters()
+		return nil
+	case savedsearch.FieldParentFolderID:
+		m.ClearParentFolderID()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavedSearchMutation) ResetField(name string) error {
+	switch name {
+	case savedsearch.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case savedsearch.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case savedsearch.FieldName:
+		m.ResetName()
+		return nil
+	case savedsearch.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case savedsearch.FieldFilters:
+		m.ResetFilters()
+		return nil
+	case savedsearch.FieldSort:
+		m.ResetSort()
+		return nil
+	case savedsearch.FieldShared:
+		m.ResetShared()
+		return nil
+	case savedsearch.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case savedsearch.FieldParentFolderID:
+		m.ResetParentFolderID()
+		return nil
+	case savedsearch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case savedsearch.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavedSearchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.company != nil {
+		edges = append(edges, savedsearch.EdgeCompany)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavedSearchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savedsearch.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavedSearchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavedSearchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavedSearchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcompany {
+		edges = append(edges, savedsearch.EdgeCompany)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavedSearchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savedsearch.EdgeCompany:
+		return m.clearedcompany
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavedSearchMutation) ClearEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeCompany:
+		m.ClearCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavedSearchMutation) ResetEdge(name string) error {
+	switch name {
+	case savedsearch.EdgeCompany:
+		m.ResetCompany()
+		return nil
+	}
+	return fmt.Errorf("unknown SavedSearch edge %s", name)
+}
+
+// SearchIndexTaskMutation represents an operation that mutates the SearchIndexTask nodes in the graph.
+type SearchIndexTaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	document_id     *uuid.UUID
+	operation       *searchindextask.Operation
+	attempts        *int
+	addattempts     *int
+	last_error      *string
+	next_attempt_at *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*SearchIndexTask, error)
+	predicates      []predicate.SearchIndexTask
+}
+
+var _ ent.Mutation = (*SearchIndexTaskMutation)(nil)
+
+// searchindextaskOption allows management of the mutation configuration using functional options.
+type searchindextaskOption func(*SearchIndexTaskMutation)
+
+// newSearchIndexTaskMutation creates new mutation for the SearchIndexTask entity.
+func newSearchIndexTaskMutation(c config, op Op, opts ...searchindextaskOption) *SearchIndexTaskMutation {
+	m := &SearchIndexTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchIndexTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSearchIndexTaskID sets the ID field of the mutation.
+func withSearchIndexTaskID(id uuid.UUID) searchindextaskOption {
+	return func(m *SearchIndexTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchIndexTask
+		)
+		m.oldValue = func(ctx context.Context) (*SearchIndexTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchIndexTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSearchIndexTask sets the old SearchIndexTask of the mutation.
+func withSearchIndexTask(node *SearchIndexTask) searchindextaskOption {
+	return func(m *SearchIndexTaskMutation) {
+		m.oldValue = func(context.Context) (*SearchIndexTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchIndexTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchIndexTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SearchIndexTask entities.
+func (m *SearchIndexTaskMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchIndexTaskMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchIndexTaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchIndexTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDocumentID sets the "document_id" field.
+func (m *SearchIndexTaskMutation) SetDocumentID(u uuid.UUID) {
+	m.document_id = &u
+}
+
+// DocumentID returns the value of the "document_id" field in the mutation.
+func (m *SearchIndexTaskMutation) DocumentID() (r uuid.UUID, exists bool) {
+	v := m.document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDocumentID returns the old "document_id" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldDocumentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDocumentID: %w", err)
+	}
+	return oldValue.DocumentID, nil
+}
+
+// ResetDocumentID resets all changes to the "document_id" field.
+func (m *SearchIndexTaskMutation) ResetDocumentID() {
+	m.document_id = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *SearchIndexTaskMutation) SetOperation(s searchindextask.Operation) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *SearchIndexTaskMutation) Operation() (r searchindextask.Operation, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldOperation(ctx context.Context) (v searchindextask.Operation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *SearchIndexTaskMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *SearchIndexTaskMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *SearchIndexTaskMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *SearchIndexTaskMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *SearchIndexTaskMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *SearchIndexTaskMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *SearchIndexTaskMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *SearchIndexTaskMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldLastError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *SearchIndexTaskMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[searchindextask.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *SearchIndexTaskMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[searchindextask.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *SearchIndexTaskMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, searchindextask.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *SearchIndexTaskMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *SearchIndexTaskMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *SearchIndexTaskMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SearchIndexTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SearchIndexTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SearchIndexTask entity.
+// If the SearchIndexTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchIndexTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SearchIndexTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SearchIndexTaskMutation builder.
+func (m *SearchIndexTaskMutation) Where(ps ...predicate.SearchIndexTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SearchIndexTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SearchIndexTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchIndexTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SearchIndexTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SearchIndexTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SearchIndexTask).
+func (m *SearchIndexTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SearchIndexTaskMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.document_id != nil {
+		fields = append(fields, searchindextask.FieldDocumentID)
+	}
+	if m.operation != nil {
+		fields = append(fields, searchindextask.FieldOperation)
+	}
+	if m.attempts != nil {
+		fields = append(fields, searchindextask.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, searchindextask.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, searchindextask.FieldNextAttemptAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, searchindextask.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SearchIndexTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case searchindextask.FieldDocumentID:
+		return m.DocumentID()
+	case searchindextask.FieldOperation:
+		return m.Operation()
+	case searchindextask.FieldAttempts:
+		return m.Attempts()
+	case searchindextask.FieldLastError:
+		return m.LastError()
+	case searchindextask.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case searchindextask.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SearchIndexTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case searchindextask.FieldDocumentID:
+		return m.OldDocumentID(ctx)
+	case searchindextask.FieldOperation:
+		return m.OldOperation(ctx)
+	case searchindextask.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case searchindextask.FieldLastError:
+		return m.OldLastError(ctx)
+	case searchindextask.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case searchindextask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SearchIndexTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchIndexTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case searchindextask.FieldDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocumentID(v)
+		return nil
+	case searchindextask.FieldOperation:
+		v, ok := value.(searchindextask.Operation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case searchindextask.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case searchindextask.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case searchindextask.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case searchindextask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchIndexTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SearchIndexTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, searchindextask.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SearchIndexTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchindextask.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchIndexTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case searchindextask.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchIndexTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SearchIndexTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(searchindextask.FieldLastError) {
+		fields = append(fields, searchindextask.FieldLastError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SearchIndexTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SearchIndexTaskMutation) ClearField(name string) error {
+	switch name {
+	case searchindextask.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchIndexTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SearchIndexTaskMutation) ResetField(name string) error {
+	switch name {
+	case searchindextask.FieldDocumentID:
+		m.ResetDocumentID()
+		return nil
+	case searchindextask.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case searchindextask.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case searchindextask.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case searchindextask.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case searchindextask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchIndexTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchIndexTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchIndexTaskMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SearchIndexTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SearchIndexTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SearchIndexTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SearchIndexTaskMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SearchIndexTaskMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchIndexTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SearchIndexTaskMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchIndexTask edge %s", name)
+}
+
+// SearchLogMutation represents an operation that mutates the SearchLog nodes in the graph.
+type SearchLogMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	company_id          *uuid.UUID
+	user_id             *uuid.UUID
+	query               *string
+	filters             *types.SearchFilters
+	mode                *string
+	sort                *string
+	result_count        *int64
+	addresult_count     *int64
+	latency_ms          *int64
+	addlatency_ms       *int64
+	clicked_document_id *uuid.UUID
+	clicked_position    *int
+	addclicked_position *int
+	clicked_at          *time.Time
+	clicks              *int
+	addclicks           *int
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*SearchLog, error)
+	predicates          []predicate.SearchLog
+}
+
+var _ ent.Mutation = (*SearchLogMutation)(nil)
+
+// searchlogOption allows management of the mutation configuration using functional options.
+type searchlogOption func(*SearchLogMutation)
+
+// newSearchLogMutation creates new mutation for the SearchLog entity.
+func newSearchLogMutation(c config, op Op, opts ...searchlogOption) *SearchLogMutation {
+	m := &SearchLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSearchLogID sets the ID field of the mutation.
+func withSearchLogID(id uuid.UUID) searchlogOption {
+	return func(m *SearchLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchLog
+		)
+		m.oldValue = func(ctx context.Context) (*SearchLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSearchLog sets the old SearchLog of the mutation.
+func withSearchLog(node *SearchLog) searchlogOption {
+	return func(m *SearchLogMutation) {
+		m.oldValue = func(context.Context) (*SearchLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SearchLog entities.
+func (m *SearchLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *SearchLogMutation) SetCompanyID(u uuid.UUID) {
+	m.company_id = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *SearchLogMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *SearchLogMutation) ResetCompanyID() {
+	m.company_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SearchLogMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SearchLogMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SearchLogMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[searchlog.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SearchLogMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SearchLogMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, searchlog.FieldUserID)
+}
+
+// SetQuery sets the "query" field.
+func (m *SearchLogMutation) SetQuery(s string) {
+	m.query = &s
+}
+
+// Query returns the value of the "query" field in the mutation.
+func (m *SearchLogMutation) Query() (r string, exists bool) {
+	v := m.query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuery returns the old "query" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldQuery(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuery: %w", err)
+	}
+	return oldValue.Query, nil
+}
+
+// ClearQuery clears the value of the "query" field.
+func (m *SearchLogMutation) ClearQuery() {
+	m.query = nil
+	m.clearedFields[searchlog.FieldQuery] = struct{}{}
+}
+
+// QueryCleared returns if the "query" field was cleared in this mutation.
+func (m *SearchLogMutation) QueryCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldQuery]
+	return ok
+}
+
+// ResetQuery resets all changes to the "query" field.
+func (m *SearchLogMutation) ResetQuery() {
+	m.query = nil
+	delete(m.clearedFields, searchlog.FieldQuery)
+}
+
+// SetFilters sets the "filters" field.
+func (m *SearchLogMutation) SetFilters(tf types.SearchFilters) {
+	m.filters = &tf
+}
+
+// Filters returns the value of the "filters" field in the mutation.
+func (m *SearchLogMutation) Filters() (r types.SearchFilters, exists bool) {
+	v := m.filters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilters returns the old "filters" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldFilters(ctx context.Context) (v types.SearchFilters, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilters: %w", err)
+	}
+	return oldValue.Filters, nil
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (m *SearchLogMutation) ClearFilters() {
+	m.filters = nil
+	m.clearedFields[searchlog.FieldFilters] = struct{}{}
+}
+
+// FiltersCleared returns if the "filters" field was cleared in this mutation.
+func (m *SearchLogMutation) FiltersCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldFilters]
+	return ok
+}
+
+// ResetFilters resets all changes to the "filters" field.
+func (m *SearchLogMutation) ResetFilters() {
+	m.filters = nil
+	delete(m.clearedFields, searchlog.FieldFilters)
+}
+
+// SetMode sets the "mode" field.
+func (m *SearchLogMutation) SetMode(s string) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *SearchLogMutation) Mode() (r string, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *SearchLogMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetSort sets the "sort" field.
+func (m *SearchLogMutation) SetSort(s string) {
+	m.sort = &s
+}
+
+// Sort returns the value of the "sort" field in the mutation.
+func (m *SearchLogMutation) Sort() (r string, exists bool) {
+	v := m.sort
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSort returns the old "sort" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldSort(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSort: %w", err)
+	}
+	return oldValue.Sort, nil
+}
+
+// ResetSort resets all changes to the "sort" field.
+func (m *SearchLogMutation) ResetSort() {
+	m.sort = nil
+}
+
+// SetResultCount sets the "result_count" field.
+func (m *SearchLogMutation) SetResultCount(i int64) {
+	m.result_count = &i
+	m.addresult_count = nil
+}
+
+// ResultCount returns the value of the "result_count" field in the mutation.
+func (m *SearchLogMutation) ResultCount() (r int64, exists bool) {
+	v := m.result_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultCount returns the old "result_count" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldResultCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultCount: %w", err)
+	}
+	return oldValue.ResultCount, nil
+}
+
+// AddResultCount adds i to the "result_count" field.
+func (m *SearchLogMutation) AddResultCount(i int64) {
+	if m.addresult_count != nil {
+		*m.addresult_count += i
+	} else {
+		m.addresult_count = &i
+	}
+}
+
+// AddedResultCount returns the value that was added to the "result_count" field in this mutation.
+func (m *SearchLogMutation) AddedResultCount() (r int64, exists bool) {
+	v := m.addresult_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResultCount resets all changes to the "result_count" field.
+func (m *SearchLogMutation) ResetResultCount() {
+	m.result_count = nil
+	m.addresult_count = nil
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *SearchLogMutation) SetLatencyMs(i int64) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *SearchLogMutation) LatencyMs() (r int64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldLatencyMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *SearchLogMutation) AddLatencyMs(i int64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *SearchLogMutation) AddedLatencyMs() (r int64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *SearchLogMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetClickedDocumentID sets the "clicked_document_id" field.
+func (m *SearchLogMutation) SetClickedDocumentID(u uuid.UUID) {
+	m.clicked_document_id = &u
+}
+
+// ClickedDocumentID returns the value of the "clicked_document_id" field in the mutation.
+func (m *SearchLogMutation) ClickedDocumentID() (r uuid.UUID, exists bool) {
+	v := m.clicked_document_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickedDocumentID returns the old "clicked_document_id" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldClickedDocumentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickedDocumentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickedDocumentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickedDocumentID: %w", err)
+	}
+	return oldValue.ClickedDocumentID, nil
+}
+
+// ClearClickedDocumentID clears the value of the "clicked_document_id" field.
+func (m *SearchLogMutation) ClearClickedDocumentID() {
+	m.clicked_document_id = nil
+	m.clearedFields[searchlog.FieldClickedDocumentID] = struct{}{}
+}
+
+// ClickedDocumentIDCleared returns if the "clicked_document_id" field was cleared in this mutation.
+func (m *SearchLogMutation) ClickedDocumentIDCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldClickedDocumentID]
+	return ok
+}
+
+// ResetClickedDocumentID resets all changes to the "clicked_document_id" field.
+func (m *SearchLogMutation) ResetClickedDocumentID() {
+	m.clicked_document_id = nil
+	delete(m.clearedFields, searchlog.FieldClickedDocumentID)
+}
+
+// SetClickedPosition sets the "clicked_position" field.
+func (m *SearchLogMutation) SetClickedPosition(i int) {
+	m.clicked_position = &i
+	m.addclicked_position = nil
+}
+
+// ClickedPosition returns the value of the "clicked_position" field in the mutation.
+func (m *SearchLogMutation) ClickedPosition() (r int, exists bool) {
+	v := m.clicked_position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickedPosition returns the old "clicked_position" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldClickedPosition(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickedPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickedPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickedPosition: %w", err)
+	}
+	return oldValue.ClickedPosition, nil
+}
+
+// AddClickedPosition adds i to the "clicked_position" field.
+func (m *SearchLogMutation) AddClickedPosition(i int) {
+	if m.addclicked_position != nil {
+		*m.addclicked_position += i
+	} else {
+		m.addclicked_position = &i
+	}
+}
+
+// AddedClickedPosition returns the value that was added to the "clicked_position" field in this mutation.
+func (m *SearchLogMutation) AddedClickedPosition() (r int, exists bool) {
+	v := m.addclicked_position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearClickedPosition clears the value of the "clicked_position" field.
+func (m *SearchLogMutation) ClearClickedPosition() {
+	m.clicked_position = nil
+	m.addclicked_position = nil
+	m.clearedFields[searchlog.FieldClickedPosition] = struct{}{}
+}
+
+// ClickedPositionCleared returns if the "clicked_position" field was cleared in this mutation.
+func (m *SearchLogMutation) ClickedPositionCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldClickedPosition]
+	return ok
+}
+
+// ResetClickedPosition resets all changes to the "clicked_position" field.
+func (m *SearchLogMutation) ResetClickedPosition() {
+	m.clicked_position = nil
+	m.addclicked_position = nil
+	delete(m.clearedFields, searchlog.FieldClickedPosition)
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (m *SearchLogMutation) SetClickedAt(t time.Time) {
+	m.clicked_at = &t
+}
+
+// ClickedAt returns the value of the "clicked_at" field in the mutation.
+func (m *SearchLogMutation) ClickedAt() (r time.Time, exists bool) {
+	v := m.clicked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickedAt returns the old "clicked_at" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldClickedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickedAt: %w", err)
+	}
+	return oldValue.ClickedAt, nil
+}
+
+// ClearClickedAt clears the value of the "clicked_at" field.
+func (m *SearchLogMutation) ClearClickedAt() {
+	m.clicked_at = nil
+	m.clearedFields[searchlog.FieldClickedAt] = struct{}{}
+}
+
+// ClickedAtCleared returns if the "clicked_at" field was cleared in this mutation.
+func (m *SearchLogMutation) ClickedAtCleared() bool {
+	_, ok := m.clearedFields[searchlog.FieldClickedAt]
+	return ok
+}
+
+// ResetClickedAt resets all changes to the "clicked_at" field.
+func (m *SearchLogMutation) ResetClickedAt() {
+	m.clicked_at = nil
+	delete(m.clearedFields, searchlog.FieldClickedAt)
+}
+
+// SetClicks sets the "clicks" field.
+func (m *SearchLogMutation) SetClicks(i int) {
+	m.clicks = &i
+	m.addclicks = nil
+}
+
+// Clicks returns the value of the "clicks" field in the mutation.
+func (m *SearchLogMutation) Clicks() (r int, exists bool) {
+	v := m.clicks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClicks returns the old "clicks" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldClicks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClicks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClicks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClicks: %w", err)
+	}
+	return oldValue.Clicks, nil
+}
+
+// AddClicks adds i to the "clicks" field.
+func (m *SearchLogMutation) AddClicks(i int) {
+	if m.addclicks != nil {
+		*m.addclicks += i
+	} else {
+		m.addclicks = &i
+	}
+}
+
+// AddedClicks returns the value that was added to the "clicks" field in this mutation.
+func (m *SearchLogMutation) AddedClicks() (r int, exists bool) {
+	v := m.addclicks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClicks resets all changes to the "clicks" field.
+func (m *SearchLogMutation) ResetClicks() {
+	m.clicks = nil
+	m.addclicks = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SearchLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SearchLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SearchLog entity.
+// If the SearchLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SearchLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the SearchLogMutation builder.
+func (m *SearchLogMutation) Where(ps ...predicate.SearchLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SearchLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SearchLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SearchLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SearchLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SearchLog).
+func (m *SearchLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SearchLogMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.company_id != nil {
+		fields = append(fields, searchlog.FieldCompanyID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, searchlog.FieldUserID)
+	}
+	if m.query != nil {
+		fields = append(fields, searchlog.FieldQuery)
+	}
+	if m.filters != nil {
+		fields = append(fields, searchlog.FieldFilters)
+	}
+	if m.mode != nil {
+		fields = append(fields, searchlog.FieldMode)
+	}
+	if m.sort != nil {
+		fields = append(fields, searchlog.FieldSort)
+	}
+	if m.result_count != nil {
+		fields = append(fields, searchlog.FieldResultCount)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, searchlog.FieldLatencyMs)
+	}
+	if m.clicked_document_id != nil {
+		fields = append(fields, searchlog.FieldClickedDocumentID)
+	}
+	if m.clicked_position != nil {
+		fields = append(fields, searchlog.FieldClickedPosition)
+	}
+	if m.clicked_at != nil {
+		fields = append(fields, searchlog.FieldClickedAt)
+	}
+	if m.clicks != nil {
+		fields = append(fields, searchlog.FieldClicks)
+	}
+	if m.created_at != nil {
+		fields = append(fields, searchlog.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SearchLogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case searchlog.FieldCompanyID:
+		return m.CompanyID()
+	case searchlog.FieldUserID:
+		return m.UserID()
+	case searchlog.FieldQuery:
+		return m.Query()
+	case searchlog.FieldFilters:
+		return m.Filters()
+	case searchlog.FieldMode:
+		return m.Mode()
+	case searchlog.FieldSort:
+		return m.Sort()
+	case searchlog.FieldResultCount:
+		return m.ResultCount()
+	case searchlog.FieldLatencyMs:
+		return m.LatencyMs()
+	case searchlog.FieldClickedDocumentID:
+		return m.ClickedDocumentID()
+	case searchlog.FieldClickedPosition:
+		return m.ClickedPosition()
+	case searchlog.FieldClickedAt:
+		return m.ClickedAt()
+	case searchlog.FieldClicks:
+		return m.Clicks()
+	case searchlog.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SearchLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case searchlog.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case searchlog.FieldUserID:
+		return m.OldUserID(ctx)
+	case searchlog.FieldQuery:
+		return m.OldQuery(ctx)
+	case searchlog.FieldFilters:
+		return m.OldFilters(ctx)
+	case searchlog.FieldMode:
+		return m.OldMode(ctx)
+	case searchlog.FieldSort:
+		return m.OldSort(ctx)
+	case searchlog.FieldResultCount:
+		return m.OldResultCount(ctx)
+	case searchlog.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case searchlog.FieldClickedDocumentID:
+		return m.OldClickedDocumentID(ctx)
+	case searchlog.FieldClickedPosition:
+		return m.OldClickedPosition(ctx)
+	case searchlog.FieldClickedAt:
+		return m.OldClickedAt(ctx)
+	case searchlog.FieldClicks:
+		return m.OldClicks(ctx)
+	case searchlog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SearchLog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchLogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case searchlog.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case searchlog.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case searchlog.FieldQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuery(v)
+		return nil
+	case searchlog.FieldFilters:
+		v, ok := value.(types.SearchFilters)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilters(v)
+		return nil
+	case searchlog.FieldMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case searchlog.FieldSort:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSort(v)
+		return nil
+	case searchlog.FieldResultCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultCount(v)
+		return nil
+	case searchlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case searchlog.FieldClickedDocumentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickedDocumentID(v)
+		return nil
+	case searchlog.FieldClickedPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickedPosition(v)
+		return nil
+	case searchlog.FieldClickedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickedAt(v)
+		return nil
+	case searchlog.FieldClicks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClicks(v)
+		return nil
+	case searchlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchLog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SearchLogMutation) AddedFields() []string {
+	var fields []string
+	if m.addresult_count != nil {
+		fields = append(fields, searchlog.FieldResultCount)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, searchlog.FieldLatencyMs)
+	}
+	if m.addclicked_position != nil {
+		fields = append(fields, searchlog.FieldClickedPosition)
+	}
+	if m.addclicks != nil {
+		fields = append(fields, searchlog.FieldClicks)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SearchLogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchlog.FieldResultCount:
+		return m.AddedResultCount()
+	case searchlog.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case searchlog.FieldClickedPosition:
+		return m.AddedClickedPosition()
+	case searchlog.FieldClicks:
+		return m.AddedClicks()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case searchlog.FieldResultCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultCount(v)
+		return nil
+	case searchlog.FieldLatencyMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case searchlog.FieldClickedPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClickedPosition(v)
+		return nil
+	case searchlog.FieldClicks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClicks(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SearchLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(searchlog.FieldUserID) {
+		fields = append(fields, searchlog.FieldUserID)
+	}
+	if m.FieldCleared(searchlog.FieldQuery) {
+		fields = append(fields, searchlog.FieldQuery)
+	}
+	if m.FieldCleared(searchlog.FieldFilters) {
+		fields = append(fields, searchlog.FieldFilters)
+	}
+	if m.FieldCleared(searchlog.FieldClickedDocumentID) {
+		fields = append(fields, searchlog.FieldClickedDocumentID)
+	}
+	if m.FieldCleared(searchlog.FieldClickedPosition) {
+		fields = append(fields, searchlog.FieldClickedPosition)
+	}
+	if m.FieldCleared(searchlog.FieldClickedAt) {
+		fields = append(fields, searchlog.FieldClickedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SearchLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SearchLogMutation) ClearField(name string) error {
+	switch name {
+	case searchlog.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case searchlog.FieldQuery:
+		m.ClearQuery()
+		return nil
+	case searchlog.FieldFilters:
+		m.ClearFilters()
+		return nil
+	case searchlog.FieldClickedDocumentID:
+		m.ClearClickedDocumentID()
+		return nil
+	case searchlog.FieldClickedPosition:
+		m.ClearClickedPosition()
+		return nil
+	case searchlog.FieldClickedAt:
+		m.ClearClickedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SearchLogMutation) ResetField(name string) error {
+	switch name {
+	case searchlog.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case searchlog.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case searchlog.FieldQuery:
+		m.ResetQuery()
+		return nil
+	case searchlog.FieldFilters:
+		m.ResetFilters()
+		return nil
+	case searchlog.FieldMode:
+		m.ResetMode()
+		return nil
+	case searchlog.FieldSort:
+		m.ResetSort()
+		return nil
+	case searchlog.FieldResultCount:
+		m.ResetResultCount()
+		return nil
+	case searchlog.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case searchlog.FieldClickedDocumentID:
+		m.ResetClickedDocumentID()
+		return nil
+	case searchlog.FieldClickedPosition:
+		m.ResetClickedPosition()
+		return nil
+	case searchlog.FieldClickedAt:
+		m.ResetClickedAt()
+		return nil
+	case searchlog.FieldClicks:
+		m.ResetClicks()
+		return nil
+	case searchlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SearchLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SearchLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SearchLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SearchLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SearchLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SearchLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SearchLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SearchLog edge %s", name)
+}
+
+// SenderMutation represents an operation that mutates the Sender nodes in the graph.
+type SenderMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	email            *string
+	legal_name       *string
+	inn              *string
+	kpp              *string
+	emails           *[]string
+	appendemails     []string
+	phones           *[]string
+	appendphones     []string
+	addresses        *[]string
+	appendaddresses  []string
+	website          *string
+	notes            *string
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	company          *uuid.UUID
+	clearedcompany   bool
+	documents        map[uuid.UUID]struct{}
+	removeddocuments map[uuid.UUID]struct{}
+	cleareddocuments bool
+	done             bool
+	oldValue         func(context.Context) (*Sender, error)
+	predicates       []predicate.Sender
+}
+
+var _ ent.Mutation = (*SenderMutation)(nil)
+
+// senderOption allows management of the mutation configuration using functional options.
+type senderOption func(*SenderMutation)
+
+// newSenderMutation creates new mutation for the Sender entity.
+func newSenderMutation(c config, op Op, opts ...senderOption) *SenderMutation {
+	m := &SenderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSender,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSenderID sets the ID field of the mutation.
+func withSenderID(id uuid.UUID) senderOption {
+	return func(m *SenderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Sender
+		)
+		m.oldValue = func(ctx context.Context) (*Sender, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Sender.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSender sets the old Sender of the mutation.
+func withSender(node *Sender) senderOption {
+	return func(m *SenderMutation) {
+		m.oldValue = func(context.Context) (*Sender, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SenderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SenderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Sender entities.
+func (m *SenderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SenderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SenderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Sender.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCompanyID sets the "company_id" field.
+func (m *SenderMutation) SetCompanyID(u uuid.UUID) {
+	m.company = &u
+}
+
+// CompanyID returns the value of the "company_id" field in the mutation.
+func (m *SenderMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompanyID returns the old "company_id" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *SenderMutation) ResetCompanyID() {
+	m.company = nil
+}
+
+// SetName sets the "name" field.
+func (m *SenderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SenderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SenderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *SenderMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *SenderMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *SenderMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[sender.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *SenderMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[sender.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *SenderMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, sender.FieldEmail)
+}
+
+// SetLegalName sets the "legal_name" field.
+func (m *SenderMutation) SetLegalName(s string) {
+	m.legal_name = &s
+}
+
+// LegalName returns the value of the "legal_name" field in the mutation.
+func (m *SenderMutation) LegalName() (r string, exists bool) {
+	v := m.legal_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLegalName returns the old "legal_name" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldLegalName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLegalName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLegalName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLegalName: %w", err)
+	}
+	return oldValue.LegalName, nil
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (m *SenderMutation) ClearLegalName() {
+	m.legal_name = nil
+	m.clearedFields[sender.FieldLegalName] = struct{}{}
+}
+
+// LegalNameCleared returns if the "legal_name" field was cleared in this mutation.
+func (m *SenderMutation) LegalNameCleared() bool {
+	_, ok := m.clearedFields[sender.FieldLegalName]
+	return ok
+}
+
+// ResetLegalName resets all changes to the "legal_name" field.
+func (m *SenderMutation) ResetLegalName() {
+	m.legal_name = nil
+	delete(m.clearedFields, sender.FieldLegalName)
+}
+
+// SetInn sets the "inn" field.
+func (m *SenderMutation) SetInn(s string) {
+	m.inn = &s
+}
+
+// Inn returns the value of the "inn" field in the mutation.
+func (m *SenderMutation) Inn() (r string, exists bool) {
+	v := m.inn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInn returns the old "inn" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldInn(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInn: %w", err)
+	}
+	return oldValue.Inn, nil
+}
+
+// ClearInn clears the value of the "inn" field.
+func (m *SenderMutation) ClearInn() {
+	m.inn = nil
+	m.clearedFields[sender.FieldInn] = struct{}{}
+}
+
+// InnCleared returns if the "inn" field was cleared in this mutation.
+func (m *SenderMutation) InnCleared() bool {
+	_, ok := m.clearedFields[sender.FieldInn]
+	return ok
+}
+
+// ResetInn resets all changes to the "inn" field.
+func (m *SenderMutation) ResetInn() {
+	m.inn = nil
+	delete(m.clearedFields, sender.FieldInn)
+}
+
+// SetKpp sets the "kpp" field.
+func (m *SenderMutation) SetKpp(s string) {
+	m.kpp = &s
+}
+
+// Kpp returns the value of the "kpp" field in the mutation.
+func (m *SenderMutation) Kpp() (r string, exists bool) {
+	v := m.kpp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKpp returns the old "kpp" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldKpp(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKpp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKpp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKpp: %w", err)
+	}
+	return oldValue.Kpp, nil
+}
+
+// ClearKpp clears the value of the "kpp" field.
+func (m *SenderMutation) ClearKpp() {
+	m.kpp = nil
+	m.clearedFields[sender.FieldKpp] = struct{}{}
+}
+
+// KppCleared returns if the "kpp" field was cleared in this mutation.
+func (m *SenderMutation) KppCleared() bool {
+	_, ok := m.clearedFields[sender.FieldKpp]
+	return ok
+}
+
+// ResetKpp resets all changes to the "kpp" field.
+func (m *SenderMutation) ResetKpp() {
+	m.kpp = nil
+	delete(m.clearedFields, sender.FieldKpp)
+}
+
+// SetEmails sets the "emails" field.
+func (m *SenderMutation) SetEmails(s []string) {
+	m.emails = &s
+	m.appendemails = nil
+}
+
+// Emails returns the value of the "emails" field in the mutation.
+func (m *SenderMutation) Emails() (r []string, exists bool) {
+	v := m.emails
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmails returns the old "emails" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldEmails(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmails: %w", err)
+	}
+	return oldValue.Emails, nil
+}
+
+// AppendEmails adds s to the "emails" field.
+func (m *SenderMutation) AppendEmails(s []string) {
+	m.appendemails = append(m.appendemails, s...)
+}
+
+// AppendedEmails returns the list of values that were appended to the "emails" field in this mutation.
+func (m *SenderMutation) AppendedEmails() ([]string, bool) {
+	if len(m.appendemails) == 0 {
+		return nil, false
+	}
+	return m.appendemails, true
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (m *SenderMutation) ClearEmails() {
+	m.emails = nil
+	m.appendemails = nil
+	m.clearedFields[sender.FieldEmails] = struct{}{}
+}
+
+// EmailsCleared returns if the "emails" field was cleared in this mutation.
+func (m *SenderMutation) EmailsCleared() bool {
+	_, ok := m.clearedFields[sender.FieldEmails]
+	return ok
+}
+
+// ResetEmails resets all changes to the "emails" field.
+func (m *SenderMutation) ResetEmails() {
+	m.emails = nil
+	m.appendemails = nil
+	delete(m.clearedFields, sender.FieldEmails)
+}
+
+// SetPhones sets the "phones" field.
+func (m *SenderMutation) SetPhones(s []string) {
+	m.phones = &s
+	m.appendphones = nil
+}
+
+// Phones returns the value of the "phones" field in the mutation.
+func (m *SenderMutation) Phones() (r []string, exists bool) {
+	v := m.phones
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhones returns the old "phones" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldPhones(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhones is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhones requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhones: %w", err)
+	}
+	return oldValue.Phones, nil
+}
+
+// AppendPhones adds s to the "phones" field.
+func (m *SenderMutation) AppendPhones(s []string) {
+	m.appendphones = append(m.appendphones, s...)
+}
+
+// AppendedPhones returns the list of values that were appended to the "phones" field in this mutation.
+func (m *SenderMutation) AppendedPhones() ([]string, bool) {
+	if len(m.appendphones) == 0 {
+		return nil, false
+	}
+	return m.appendphones, true
+}
+
+// ClearPhones clears the value of the "phones" field.
+func (m *SenderMutation) ClearPhones() {
+	m.phones = nil
+	m.appendphones = nil
+	m.clearedFields[sender.FieldPhones] = struct{}{}
+}
+
+// PhonesCleared returns if the "phones" field was cleared in this mutation.
+func (m *SenderMutation) PhonesCleared() bool {
+	_, ok := m.clearedFields[sender.FieldPhones]
+	return ok
+}
+
+// ResetPhones resets all changes to the "phones" field.
+func (m *SenderMutation) ResetPhones() {
+	m.phones = nil
+	m.appendphones = nil
+	delete(m.clearedFields, sender.FieldPhones)
+}
+
+// SetAddresses sets the "addresses" field.
+func (m *SenderMutation) SetAddresses(s []string) {
+	m.addresses = &s
+	m.appendaddresses = nil
+}
+
+// Addresses returns the value of the "addresses" field in the mutation.
+func (m *SenderMutation) Addresses() (r []string, exists bool) {
+	v := m.addresses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddresses returns the old "addresses" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldAddresses(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddresses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddresses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddresses: %w", err)
+	}
+	return oldValue.Addresses, nil
+}
+
+// AppendAddresses adds s to the "addresses" field.
+func (m *SenderMutation) AppendAddresses(s []string) {
+	m.appendaddresses = append(m.appendaddresses, s...)
+}
+
+// AppendedAddresses returns the list of values that were appended to the "addresses" field in this mutation.
+func (m *SenderMutation) AppendedAddresses() ([]string, bool) {
+	if len(m.appendaddresses) == 0 {
+		return nil, false
+	}
+	return m.appendaddresses, true
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (m *SenderMutation) ClearAddresses() {
+	m.addresses = nil
+	m.appendaddresses = nil
+	m.clearedFields[sender.FieldAddresses] = struct{}{}
+}
+
+// AddressesCleared returns if the "addresses" field was cleared in this mutation.
+func (m *SenderMutation) AddressesCleared() bool {
+	_, ok := m.clearedFields[sender.FieldAddresses]
+	return ok
+}
+
+// ResetAddresses resets all changes to the "addresses" field.
+func (m *SenderMutation) ResetAddresses() {
+	m.addresses = nil
+	m.appendaddresses = nil
+	delete(m.clearedFields, sender.FieldAddresses)
+}
+
+// SetWebsite sets the "website" field.
+func (m *SenderMutation) SetWebsite(s string) {
+	m.website = &s
+}
+
+// Website returns the value of the "website" field in the mutation.
+func (m *SenderMutation) Website() (r string, exists bool) {
+	v := m.website
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWebsite returns the old "website" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldWebsite(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWebsite is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWebsite requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWebsite: %w", err)
+	}
+	return oldValue.Website, nil
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (m *SenderMutation) ClearWebsite() {
+	m.website = nil
+	m.clearedFields[sender.FieldWebsite] = struct{}{}
+}
+
+// WebsiteCleared returns if the "website" field was cleared in this mutation.
+func (m *SenderMutation) WebsiteCleared() bool {
+	_, ok := m.clearedFields[sender.FieldWebsite]
+	return ok
+}
+
+// ResetWebsite resets all changes to the "website" field.
+func (m *SenderMutation) ResetWebsite() {
+	m.website = nil
+	delete(m.clearedFields, sender.FieldWebsite)
+}
+
+// SetNotes sets the "notes" field.
+func (m *SenderMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *SenderMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldNotes(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *SenderMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[sender.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *SenderMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[sender.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *SenderMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, sender.FieldNotes)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SenderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SenderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SenderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SenderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SenderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Sender entity.
+// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SenderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SenderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (m *SenderMutation) ClearCompany() {
+	m.clearedcompany = true
+	m.clearedFields[sender.FieldCompanyID] = struct{}{}
+}
+
+// CompanyCleared reports if the "company" edge to the Company entity was cleared.
+func (m *SenderMutation) CompanyCleared() bool {
+	return m.clearedcompany
+}
+
+// CompanyIDs returns the "company" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CompanyID instead. It exists only for internal usage by the builders.
+func (m *SenderMutation) CompanyIDs() (ids []uuid.UUID) {
+	if id := m.company; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCompany resets all changes to the "company" edge.
+func (m *SenderMutation) ResetCompany() {
+	m.company = nil
+	m.clearedcompany = false
+}
+
+// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
+func (m *SenderMutation) AddDocumentIDs(ids ...uuid.UUID) {
+	if m.documents == nil {
+		m.documents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.documents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDocuments clears the "documents" edge to the Document entity.
+func (m *SenderMutation) ClearDocuments() {
+	m.cleareddocuments = true
+}
+
+// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
+func (m *SenderMutation) DocumentsCleared() bool {
+	return m.cleareddocuments
+}
+
+// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
+func (m *SenderMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
+	if m.removeddocuments == nil {
+		m.removeddocuments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.documents, ids[i])
+		m.removeddocuments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
+func (m *SenderMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocuments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentsIDs returns the "documents" edge IDs in the mutation.
+func (m *SenderMutation) DocumentsIDs() (ids []uuid.UUID) {
+	for id := range m.documents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocuments resets all changes to the "documents" edge.
+func (m *SenderMutation) ResetDocuments() {
+	m.documents = nil
+	m.cleareddocuments = false
+	m.removeddocuments = nil
+}
+
+// Where appends a list predicates to the SenderMutation builder.
+func (m *SenderMutation) Where(ps ...predicate.Sender) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SenderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SenderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sender, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SenderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SenderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Sender).
+func (m *SenderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SenderMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.company != nil {
+		fields = append(fields, sender.FieldCompanyID)
+	}
+	if m.name != nil {
+		fields = append(fields, sender.FieldName)
+	}
+	if m.email != nil {
+		fields = append(fields, sender.FieldEmail)
+	}
+	if m.legal_name != nil {
+		fields = append(fields, sender.FieldLegalName)
+	}
+	if m.inn != nil {
+		fields = append(fields, sender.FieldInn)
+	}
+	if m.kpp != nil {
+		fields = append(fields, sender.FieldKpp)
+	}
+	if m.emails != nil {
+		fields = append(fields, sender.FieldEmails)
+	}
+	if m.phones != nil {
+		fields = append(fields, sender.FieldPhones)
+	}
+	if m.addresses != nil {
+		fields = append(fields, sender.FieldAddresses)
+	}
+	if m.website != nil {
+		fields = append(fields, sender.FieldWebsite)
+	}
+	if m.notes != nil {
+		fields = append(fields, sender.FieldNotes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, sender.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, sender.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SenderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sender.FieldCompanyID:
+		return m.CompanyID()
+	case sender.FieldName:
+		return m.Name()
+	case sender.FieldEmail:
+		return m.Email()
+	case sender.FieldLegalName:
+		return m.LegalName()
+	case sender.FieldInn:
+		return m.Inn()
+	case sender.FieldKpp:
+		return m.Kpp()
+	case sender.FieldEmails:
+		return m.Emails()
+	case sender.FieldPhones:
+		return m.Phones()
+	case sender.FieldAddresses:
+		return m.Addresses()
+	case sender.FieldWebsite:
+		return m.Website()
+	case sender.FieldNotes:
+		return m.Notes()
+	case sender.FieldCreatedAt:
+		return m.CreatedAt()
+	case sender.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SenderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sender.FieldCompanyID:
+		return m.OldCompanyID(ctx)
+	case sender.FieldName:
+		return m.OldName(ctx)
+	case sender.FieldEmail:
+		return m.OldEmail(ctx)
+	case sender.FieldLegalName:
+		return m.OldLegalName(ctx)
+	case sender.FieldInn:
+		return m.OldInn(ctx)
+	case sender.FieldKpp:
+		return m.OldKpp(ctx)
+	case sender.FieldEmails:
+		return m.OldEmails(ctx)
+	case sender.FieldPhones:
+		return m.OldPhones(ctx)
+	case sender.FieldAddresses:
+		return m.OldAddresses(ctx)
+	case sender.FieldWebsite:
+		return m.OldWebsite(ctx)
+	case sender.FieldNotes:
+		return m.OldNotes(ctx)
+	case sender.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case sender.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Sender field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sender.FieldCompanyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompanyID(v)
+		return nil
+	case sender.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sender.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case sender.FieldLegalName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLegalName(v)
+		return nil
+	case sender.FieldInn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInn(v)
+		return nil
+	case sender.FieldKpp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKpp(v)
+		return nil
+	case sender.FieldEmails:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmails(v)
+		return nil
+	case sender.FieldPhones:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhones(v)
+		return nil
+	case sender.FieldAddresses:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddresses(v)
+		return nil
+	case sender.FieldWebsite:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebsite(v)
+		return nil
+	case sender.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case sender.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case sender.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sender field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SenderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SenderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SenderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Sender numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SenderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(sender.FieldEmail) {
+		fields = append(fields, sender.FieldEmail)
+	}
+	if m.FieldCleared(sender.FieldLegalName) {
+		fields = append(fields, sender.FieldLegalName)
+	}
+	if m.FieldCleared(sender.FieldInn) {
+		fields = append(fields, sender.FieldInn)
+	}
+	if m.FieldCleared(sender.FieldKpp) {
+		fields = append(fields, sender.FieldKpp)
+	}
+	if m.FieldCleared(sender.FieldEmails) {
+		fields = append(fields, sender.FieldEmails)
+	}
+	if m.FieldCleared(sender.FieldPhones) {
+		fields = append(fields, sender.FieldPhones)
+	}
+	if m.FieldCleared(sender.FieldAddresses) {
+		fields = append(fields, sender.FieldAddresses)
+	}
+	if m.FieldCleared(sender.FieldWebsite) {
+		fields = append(fields, sender.FieldWebsite)
+	}
+	if m.FieldCleared(sender.FieldNotes) {
+		fields = append(fields, sender.FieldNotes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SenderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SenderMutation) ClearField(name string) error {
+	switch name {
+	case sender.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case sender.FieldLegalName:
+		m.ClearLegalName()
+		return nil
+	case sender.FieldInn:
+		m.ClearInn()
+		return nil
+	case sender.FieldKpp:
+		m.ClearKpp()
+		return nil
+	case sender.FieldEmails:
+		m.ClearEmails()
+		return nil
+	case sender.FieldPhones:
+		m.ClearPhones()
+		return nil
+	case sender.FieldAddresses:
+		m.ClearAddresses()
+		return nil
+	case sender.FieldWebsite:
+		m.ClearWebsite()
+		return nil
+	case sender.FieldNotes:
+		m.ClearNotes()
+		return nil
+	}
+	return fmt.Errorf("unknown Sender nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SenderMutation) ResetField(name string) error {
+	switch name {
+	case sender.FieldCompanyID:
+		m.ResetCompanyID()
+		return nil
+	case sender.FieldName:
+		m.ResetName()
+		return nil
+	case sender.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case sender.FieldLegalName:
+		m.ResetLegalName()
+		return nil
+	case sender.FieldInn:
+		m.ResetInn()
+		return nil
+	case sender.FieldKpp:
+		m.ResetKpp()
+		return nil
+	case sender.FieldEmails:
+		m.ResetEmails()
+		return nil
+	case sender.FieldPhones:
+		m.ResetPhones()
+		return nil
+	case sender.FieldAddresses:
+		m.ResetAddresses()
+		return nil
+	case sender.FieldWebsite:
+		m.ResetWebsite()
+		return nil
+	case sender.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case sender.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case sender.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Sender field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SenderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.company != nil {
+		edges = append(edges, sender.EdgeCompany)
+	}
+	if m.documents != nil {
+		edges = append(edges, sender.EdgeDocuments)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SenderMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case documenttag.EdgeDocument:
-		ids := make([]ent.Value, 0, len(m.removeddocument))
-		for id := range m.removeddocument {
+	case sender.EdgeCompany:
+		if id := m.company; id != nil {
+			return []ent.Value{*id}
+		}
+	case sender.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.documents))
+		for id := range m.documents {
 			ids = append(ids, id)
 		}
 		return ids
-	case documenttag.EdgeTag:
-		ids := make([]ent.Value, 0, len(m.removedtag))
-		for id := range m.removedtag {
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SenderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddocuments != nil {
+		edges = append(edges, sender.EdgeDocuments)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SenderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case sender.EdgeDocuments:
+		ids := make([]ent.Value, 0, len(m.removeddocuments))
+		for id := range m.removeddocuments {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3409,89 +13602,96 @@ func (m *DocumentTagMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DocumentTagMutation) ClearedEdges() []string {
+func (m *SenderMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareddocument {
-		edges = append(edges, documenttag.EdgeDocument)
+	if m.clearedcompany {
+		edges = append(edges, sender.EdgeCompany)
 	}
-	if m.clearedtag {
-		edges = append(edges, documenttag.EdgeTag)
+	if m.cleareddocuments {
+		edges = append(edges, sender.EdgeDocuments)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DocumentTagMutation) EdgeCleared(name string) bool {
+func (m *SenderMutation) EdgeCleared(name string) bool {
 	switch name {
-	case documenttag.EdgeDocument:
-		return m.cleareddocument
-	case documenttag.EdgeTag:
-		return m.clearedtag
+	case sender.EdgeCompany:
+		return m.clearedcompany
+	case sender.EdgeDocuments:
+		return m.cleareddocuments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DocumentTagMutation) ClearEdge(name string) error {
+func (m *SenderMutation) ClearEdge(name string) error {
 	switch name {
+	case sender.EdgeCompany:
+		m.ClearCompany()
+		return nil
 	}
-	return fmt.Errorf("unknown DocumentTag unique edge %s", name)
+	return fmt.Errorf("unknown Sender unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DocumentTagMutation) ResetEdge(name string) error {
+func (m *SenderMutation) ResetEdge(name string) error {
 	switch name {
-	case documenttag.EdgeDocument:
-		m.ResetDocument()
+	case sender.EdgeCompany:
+		m.ResetCompany()
 		return nil
-	case documenttag.EdgeTag:
-		m.ResetTag()
+	case sender.EdgeDocuments:
+		m.ResetDocuments()
 		return nil
 	}
-	return fmt.Errorf("unknown DocumentTag edge %s", name)
+	return fmt.Errorf("unknown Sender edge %s", name)
 }
 
-// FolderMutation represents an operation that mutates the Folder nodes in the graph.
-type FolderMutation struct {
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	size             *int64
-	addsize          *int64
-	count            *int
-	addcount         *int
-	clearedFields    map[string]struct{}
-	company          *uuid.UUID
-	clearedcompany   bool
-	parent           *uuid.UUID
-	clearedparent    bool
-	children         map[uuid.UUID]struct{}
-	removedchildren  map[uuid.UUID]struct{}
-	clearedchildren  bool
-	documents        map[uuid.UUID]struct{}
-	removeddocuments map[uuid.UUID]struct{}
-	cleareddocuments bool
-	done             bool
-	oldValue         func(context.Context) (*Folder, error)
-	predicates       []predicate.Folder
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	name                 *string
+	color                *string
+	icon                 *string
+	description          *string
+	clearedFields        map[string]struct{}
+	company              *uuid.UUID
+	clearedcompany       bool
+	parent               *uuid.UUID
+	clearedparent        bool
+	children             map[uuid.UUID]struct{}
+	removedchildren      map[uuid.UUID]struct{}
+	clearedchildren      bool
+	group                *uuid.UUID
+	clearedgroup         bool
+	document_tags        map[uuid.UUID]struct{}
+	removeddocument_tags map[uuid.UUID]struct{}
+	cleareddocument_tags bool
+	rules                map[uuid.UUID]struct{}
+	removedrules         map[uuid.UUID]struct{}
+	clearedrules         bool
+	done                 bool
+	oldValue             func(context.Context) (*Tag, error)
+	predicates           []predicate.Tag
 }
 
-var _ ent.Mutation = (*FolderMutation)(nil)
+var _ ent.Mutation = (*TagMutation)(nil)
 
-// folderOption allows management of the mutation configuration using functional options.
-type folderOption func(*FolderMutation)
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
 
-// newFolderMutation creates new mutation for the Folder entity.
-func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
-	m := &FolderMutation{
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFolder,
+		typ:           TypeTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3500,20 +13700,20 @@ func newFolderMutation(c config, op Op, opts ...folderOption) *FolderMutation {
 	return m
 }
 
-// withFolderID sets the ID field of the mutation.
-func withFolderID(id uuid.UUID) folderOption {
-	return func(m *FolderMutation) {
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Folder
+			value *Tag
 		)
-		m.oldValue = func(ctx context.Context) (*Folder, error) {
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Folder.Get(ctx, id)
+					value, err = m.Client().Tag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3522,10 +13722,10 @@ func withFolderID(id uuid.UUID) folderOption {
 	}
 }
 
-// withFolder sets the old Folder of the mutation.
-func withFolder(node *Folder) folderOption {
-	return func(m *FolderMutation) {
-		m.oldValue = func(context.Context) (*Folder, error) {
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3534,7 +13734,7 @@ func withFolder(node *Folder) folderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FolderMutation) Client() *Client {
+func (m TagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3542,7 +13742,7 @@ func (m FolderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FolderMutation) Tx() (*Tx, error) {
+func (m TagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3552,14 +13752,14 @@ func (m FolderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Folder entities.
-func (m *FolderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3570,7 +13770,7 @@ func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3579,19 +13779,19 @@ func (m *FolderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Folder.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCompanyID sets the "company_id" field.
-func (m *FolderMutation) SetCompanyID(u uuid.UUID) {
+func (m *TagMutation) SetCompanyID(u uuid.UUID) {
 	m.company = &u
 }
 
 // CompanyID returns the value of the "company_id" field in the mutation.
-func (m *FolderMutation) CompanyID() (r uuid.UUID, exists bool) {
+func (m *TagMutation) CompanyID() (r uuid.UUID, exists bool) {
 	v := m.company
 	if v == nil {
 		return
@@ -3599,84 +13799,133 @@ func (m *FolderMutation) CompanyID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldCompanyID returns the old "company_id" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldCompanyID returns the old "company_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TagMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+	}
+	return oldValue.CompanyID, nil
+}
+
+// ResetCompanyID resets all changes to the "company_id" field.
+func (m *TagMutation) ResetCompanyID() {
+	m.company = nil
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *TagMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *TagMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompanyID requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompanyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.CompanyID, nil
+	return oldValue.ParentID, nil
 }
 
-// ResetCompanyID resets all changes to the "company_id" field.
-func (m *FolderMutation) ResetCompanyID() {
-	m.company = nil
+// ClearParentID clears the value of the "parent_id" field.
+func (m *TagMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[tag.FieldParentID] = struct{}{}
 }
 
-// SetParentFolderID sets the "parent_folder_id" field.
-func (m *FolderMutation) SetParentFolderID(u uuid.UUID) {
-	m.parent = &u
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *TagMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[tag.FieldParentID]
+	return ok
 }
 
-// ParentFolderID returns the value of the "parent_folder_id" field in the mutation.
-func (m *FolderMutation) ParentFolderID() (r uuid.UUID, exists bool) {
-	v := m.parent
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *TagMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, tag.FieldParentID)
+}
+
+// SetGroupID sets the "group_id" field.
+func (m *TagMutation) SetGroupID(u uuid.UUID) {
+	m.group = &u
+}
+
+// GroupID returns the value of the "group_id" field in the mutation.
+func (m *TagMutation) GroupID() (r uuid.UUID, exists bool) {
+	v := m.group
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParentFolderID returns the old "parent_folder_id" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldGroupID returns the old "group_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldParentFolderID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *TagMutation) OldGroupID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParentFolderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGroupID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParentFolderID requires an ID field in the mutation")
+		return v, errors.New("OldGroupID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParentFolderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGroupID: %w", err)
 	}
-	return oldValue.ParentFolderID, nil
+	return oldValue.GroupID, nil
 }
 
-// ClearParentFolderID clears the value of the "parent_folder_id" field.
-func (m *FolderMutation) ClearParentFolderID() {
-	m.parent = nil
-	m.clearedFields[folder.FieldParentFolderID] = struct{}{}
+// ClearGroupID clears the value of the "group_id" field.
+func (m *TagMutation) ClearGroupID() {
+	m.group = nil
+	m.clearedFields[tag.FieldGroupID] = struct{}{}
 }
 
-// ParentFolderIDCleared returns if the "parent_folder_id" field was cleared in this mutation.
-func (m *FolderMutation) ParentFolderIDCleared() bool {
-	_, ok := m.clearedFields[folder.FieldParentFolderID]
+// GroupIDCleared returns if the "group_id" field was cleared in this mutation.
+func (m *TagMutation) GroupIDCleared() bool {
+	_, ok := m.clearedFields[tag.FieldGroupID]
 	return ok
 }
 
-// ResetParentFolderID resets all changes to the "parent_folder_id" field.
-func (m *FolderMutation) ResetParentFolderID() {
-	m.parent = nil
-	delete(m.clearedFields, folder.FieldParentFolderID)
+// ResetGroupID resets all changes to the "group_id" field.
+func (m *TagMutation) ResetGroupID() {
+	m.group = nil
+	delete(m.clearedFields, tag.FieldGroupID)
 }
 
 // SetName sets the "name" field.
-func (m *FolderMutation) SetName(s string) {
+func (m *TagMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *FolderMutation) Name() (r string, exists bool) {
+func (m *TagMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3684,10 +13933,10 @@ func (m *FolderMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3702,137 +13951,172 @@ func (m *FolderMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *FolderMutation) ResetName() {
+func (m *TagMutation) ResetName() {
 	m.name = nil
 }
 
-// SetSize sets the "size" field.
-func (m *FolderMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetColor sets the "color" field.
+func (m *TagMutation) SetColor(s string) {
+	m.color = &s
 }
 
-// Size returns the value of the "size" field in the mutation.
-func (m *FolderMutation) Size() (r int64, exists bool) {
-	v := m.size
+// Color returns the value of the "color" field in the mutation.
+func (m *TagMutation) Color() (r string, exists bool) {
+	v := m.color
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSize returns the old "size" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldColor returns the old "color" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldSize(ctx context.Context) (v int64, err error) {
+func (m *TagMutation) OldColor(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSize requires an ID field in the mutation")
+		return v, errors.New("OldColor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
 	}
-	return oldValue.Size, nil
+	return oldValue.Color, nil
 }
 
-// AddSize adds i to the "size" field.
-func (m *FolderMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
+// ClearColor clears the value of the "color" field.
+func (m *TagMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[tag.FieldColor] = struct{}{}
 }
 
-// AddedSize returns the value that was added to the "size" field in this mutation.
-func (m *FolderMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
-	if v == nil {
-		return
-	}
-	return *v, true
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *TagMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[tag.FieldColor]
+	return ok
 }
 
-// ResetSize resets all changes to the "size" field.
-func (m *FolderMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ResetColor resets all changes to the "color" field.
+func (m *TagMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, tag.FieldColor)
 }
 
-// SetCount sets the "count" field.
-func (m *FolderMutation) SetCount(i int) {
-	m.count = &i
-	m.addcount = nil
+// SetIcon sets the "icon" field.
+func (m *TagMutation) SetIcon(s string) {
+	m.icon = &s
 }
 
-// Count returns the value of the "count" field in the mutation.
-func (m *FolderMutation) Count() (r int, exists bool) {
-	v := m.count
+// Icon returns the value of the "icon" field in the mutation.
+func (m *TagMutation) Icon() (r string, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCount returns the old "count" field's value of the Folder entity.
-// If the Folder object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FolderMutation) OldCount(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldIcon(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCount requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.Count, nil
+	return oldValue.Icon, nil
 }
 
-// AddCount adds i to the "count" field.
-func (m *FolderMutation) AddCount(i int) {
-	if m.addcount != nil {
-		*m.addcount += i
-	} else {
-		m.addcount = &i
-	}
+// ClearIcon clears the value of the "icon" field.
+func (m *TagMutation) ClearIcon() {
+	m.icon = nil
+	m.clearedFields[tag.FieldIcon] = struct{}{}
 }
 
-// AddedCount returns the value that was added to the "count" field in this mutation.
-func (m *FolderMutation) AddedCount() (r int, exists bool) {
-	v := m.addcount
+// IconCleared returns if the "icon" field was cleared in this mutation.
+func (m *TagMutation) IconCleared() bool {
+	_, ok := m.clearedFields[tag.FieldIcon]
+	return ok
+}
+
+// ResetIcon resets all changes to the "icon" field.
+func (m *TagMutation) ResetIcon() {
+	m.icon = nil
+	delete(m.clearedFields, tag.FieldIcon)
+}
+
+// SetDescription sets the "description" field.
+func (m *TagMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TagMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetCount resets all changes to the "count" field.
-func (m *FolderMutation) ResetCount() {
-	m.count = nil
-	m.addcount = nil
+// OldDescription returns the old "description" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TagMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[tag.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TagMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[tag.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TagMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, tag.FieldDescription)
 }
 
 // ClearCompany clears the "company" edge to the Company entity.
-func (m *FolderMutation) ClearCompany() {
+func (m *TagMutation) ClearCompany() {
 	m.clearedcompany = true
-	m.clearedFields[folder.FieldCompanyID] = struct{}{}
+	m.clearedFields[tag.FieldCompanyID] = struct{}{}
 }
 
 // CompanyCleared reports if the "company" edge to the Company entity was cleared.
-func (m *FolderMutation) CompanyCleared() bool {
+func (m *TagMutation) CompanyCleared() bool {
 	return m.clearedcompany
 }
 
 // CompanyIDs returns the "company" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // CompanyID instead. It exists only for internal usage by the builders.
-func (m *FolderMutation) CompanyIDs() (ids []uuid.UUID) {
+func (m *TagMutation) CompanyIDs() (ids []uuid.UUID) {
 	if id := m.company; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3840,39 +14124,26 @@ func (m *FolderMutation) CompanyIDs() (ids []uuid.UUID) {
 }
 
 // ResetCompany resets all changes to the "company" edge.
-func (m *FolderMutation) ResetCompany() {
+func (m *TagMutation) ResetCompany() {
 	m.company = nil
 	m.clearedcompany = false
 }
 
-// SetParentID sets the "parent" edge to the Folder entity by id.
-func (m *FolderMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
-}
-
-// ClearParent clears the "parent" edge to the Folder entity.
-func (m *FolderMutation) ClearParent() {
+// ClearParent clears the "parent" edge to the Tag entity.
+func (m *TagMutation) ClearParent() {
 	m.clearedparent = true
-	m.clearedFields[folder.FieldParentFolderID] = struct{}{}
-}
-
-// ParentCleared reports if the "parent" edge to the Folder entity was cleared.
-func (m *FolderMutation) ParentCleared() bool {
-	return m.ParentFolderIDCleared() || m.clearedparent
+	m.clearedFields[tag.FieldParentID] = struct{}{}
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *FolderMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
-	}
-	return
+// ParentCleared reports if the "parent" edge to the Tag entity was cleared.
+func (m *TagMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
 }
 
 // ParentIDs returns the "parent" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
-func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
+func (m *TagMutation) ParentIDs() (ids []uuid.UUID) {
 	if id := m.parent; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3880,13 +14151,13 @@ func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
 }
 
 // ResetParent resets all changes to the "parent" edge.
-func (m *FolderMutation) ResetParent() {
+func (m *TagMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
 }
 
-// AddChildIDs adds the "children" edge to the Folder entity by ids.
-func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
+// AddChildIDs adds the "children" edge to the Tag entity by ids.
+func (m *TagMutation) AddChildIDs(ids ...uuid.UUID) {
 	if m.children == nil {
 		m.children = make(map[uuid.UUID]struct{})
 	}
@@ -3895,18 +14166,18 @@ func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
 	}
 }
 
-// ClearChildren clears the "children" edge to the Folder entity.
-func (m *FolderMutation) ClearChildren() {
+// ClearChildren clears the "children" edge to the Tag entity.
+func (m *TagMutation) ClearChildren() {
 	m.clearedchildren = true
 }
 
-// ChildrenCleared reports if the "children" edge to the Folder entity was cleared.
-func (m *FolderMutation) ChildrenCleared() bool {
+// ChildrenCleared reports if the "children" edge to the Tag entity was cleared.
+func (m *TagMutation) ChildrenCleared() bool {
 	return m.clearedchildren
 }
 
-// RemoveChildIDs removes the "children" edge to the Folder entity by IDs.
-func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
+// RemoveChildIDs removes the "children" edge to the Tag entity by IDs.
+func (m *TagMutation) RemoveChildIDs(ids ...uuid.UUID) {
 	if m.removedchildren == nil {
 		m.removedchildren = make(map[uuid.UUID]struct{})
 	}
@@ -3916,8 +14187,8 @@ func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
 	}
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the Folder entity.
-func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+// RemovedChildren returns the removed IDs of the "children" edge to the Tag entity.
+func (m *TagMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
 	for id := range m.removedchildren {
 		ids = append(ids, id)
 	}
@@ -3925,7 +14196,7 @@ func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
 }
 
 // ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
+func (m *TagMutation) ChildrenIDs() (ids []uuid.UUID) {
 	for id := range m.children {
 		ids = append(ids, id)
 	}
@@ -3933,75 +14204,156 @@ func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
 }
 
 // ResetChildren resets all changes to the "children" edge.
-func (m *FolderMutation) ResetChildren() {
+func (m *TagMutation) ResetChildren() {
 	m.children = nil
 	m.clearedchildren = false
 	m.removedchildren = nil
 }
 
-// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
-func (m *FolderMutation) AddDocumentIDs(ids ...uuid.UUID) {
-	if m.documents == nil {
-		m.documents = make(map[uuid.UUID]struct{})
+// ClearGroup clears the "group" edge to the TagGroup entity.
+func (m *TagMutation) ClearGroup() {
+	m.clearedgroup = true
+	m.clearedFields[tag.FieldGroupID] = struct{}{}
+}
+
+// GroupCleared reports if the "group" edge to the TagGroup entity was cleared.
+func (m *TagMutation) GroupCleared() bool {
+	return m.GroupIDCleared() || m.clearedgroup
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *TagMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// AddDocumentTagIDs adds the "document_tags" edge to the DocumentTag entity by ids.
+func (m *TagMutation) AddDocumentTagIDs(ids ...uuid.UUID) {
+	if m.document_tags == nil {
+		m.document_tags = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.documents[ids[i]] = struct{}{}
+		m.document_tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearDocuments clears the "documents" edge to the Document entity.
-func (m *FolderMutation) ClearDocuments() {
-	m.cleareddocuments = true
+// ClearDocumentTags clears the "document_tags" edge to the DocumentTag entity.
+func (m *TagMutation) ClearDocumentTags() {
+	m.cleareddocument_tags = true
 }
 
-// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
-func (m *FolderMutation) DocumentsCleared() bool {
-	return m.cleareddocuments
+// DocumentTagsCleared reports if the "document_tags" edge to the DocumentTag entity was cleared.
+func (m *TagMutation) DocumentTagsCleared() bool {
+	return m.cleareddocument_tags
+}
+
+// RemoveDocumentTagIDs removes the "document_tags" edge to the DocumentTag entity by IDs.
+func (m *TagMutation) RemoveDocumentTagIDs(ids ...uuid.UUID) {
+	if m.removeddocument_tags == nil {
+		m.removeddocument_tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.document_tags, ids[i])
+		m.removeddocument_tags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDocumentTags returns the removed IDs of the "document_tags" edge to the DocumentTag entity.
+func (m *TagMutation) RemovedDocumentTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removeddocument_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DocumentTagsIDs returns the "document_tags" edge IDs in the mutation.
+func (m *TagMutation) DocumentTagsIDs() (ids []uuid.UUID) {
+	for id := range m.document_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDocumentTags resets all changes to the "document_tags" edge.
+func (m *TagMutation) ResetDocumentTags() {
+	m.document_tags = nil
+	m.cleareddocument_tags = false
+	m.removeddocument_tags = nil
+}
+
+// AddRuleIDs adds the "rules" edge to the TagRule entity by ids.
+func (m *TagMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRules clears the "rules" edge to the TagRule entity.
+func (m *TagMutation) ClearRules() {
+	m.clearedrules = true
+}
+
+// RulesCleared reports if the "rules" edge to the TagRule entity was cleared.
+func (m *TagMutation) RulesCleared() bool {
+	return m.clearedrules
 }
 
-// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
-func (m *FolderMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
-	if m.removeddocuments == nil {
-		m.removeddocuments = make(map[uuid.UUID]struct{})
+// RemoveRuleIDs removes the "rules" edge to the TagRule entity by IDs.
+func (m *TagMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.documents, ids[i])
-		m.removeddocuments[ids[i]] = struct{}{}
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
-func (m *FolderMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocuments {
+// RemovedRules returns the removed IDs of the "rules" edge to the TagRule entity.
+func (m *TagMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// DocumentsIDs returns the "documents" edge IDs in the mutation.
-func (m *FolderMutation) DocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.documents {
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *TagMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDocuments resets all changes to the "documents" edge.
-func (m *FolderMutation) ResetDocuments() {
-	m.documents = nil
-	m.cleareddocuments = false
-	m.removeddocuments = nil
+// ResetRules resets all changes to the "rules" edge.
+func (m *TagMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
 }
 
-// Where appends a list predicates to the FolderMutation builder.
-func (m *FolderMutation) Where(ps ...predicate.Folder) {
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FolderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Folder, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4009,39 +14361,45 @@ func (m *FolderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FolderMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FolderMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Folder).
-func (m *FolderMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FolderMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.company != nil {
-		fields = append(fields, folder.FieldCompanyID)
+		fields = append(fields, tag.FieldCompanyID)
 	}
 	if m.parent != nil {
-		fields = append(fields, folder.FieldParentFolderID)
+		fields = append(fields, tag.FieldParentID)
+	}
+	if m.group != nil {
+		fields = append(fields, tag.FieldGroupID)
 	}
 	if m.name != nil {
-		fields = append(fields, folder.FieldName)
+		fields = append(fields, tag.FieldName)
 	}
-	if m.size != nil {
-		fields = append(fields, folder.FieldSize)
+	if m.color != nil {
+		fields = append(fields, tag.FieldColor)
 	}
-	if m.count != nil {
-		fields = append(fields, folder.FieldCount)
+	if m.icon != nil {
+		fields = append(fields, tag.FieldIcon)
+	}
+	if m.description != nil {
+		fields = append(fields, tag.FieldDescription)
 	}
 	return fields
 }
@@ -4049,18 +14407,22 @@ func (m *FolderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case folder.FieldCompanyID:
+	case tag.FieldCompanyID:
 		return m.CompanyID()
-	case folder.FieldParentFolderID:
-		return m.ParentFolderID()
-	case folder.FieldName:
+	case tag.FieldParentID:
+		return m.ParentID()
+	case tag.FieldGroupID:
+		return m.GroupID()
+	case tag.FieldName:
 		return m.Name()
-	case folder.FieldSize:
-		return m.Size()
-	case folder.FieldCount:
-		return m.Count()
+	case tag.FieldColor:
+		return m.Color()
+	case tag.FieldIcon:
+		return m.Icon()
+	case tag.FieldDescription:
+		return m.Description()
 	}
 	return nil, false
 }
@@ -4068,205 +14430,242 @@ func (m *FolderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FolderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case folder.FieldCompanyID:
+	case tag.FieldCompanyID:
 		return m.OldCompanyID(ctx)
-	case folder.FieldParentFolderID:
-		return m.OldParentFolderID(ctx)
-	case folder.FieldName:
+	case tag.FieldParentID:
+		return m.OldParentID(ctx)
+	case tag.FieldGroupID:
+		return m.OldGroupID(ctx)
+	case tag.FieldName:
 		return m.OldName(ctx)
-	case folder.FieldSize:
-		return m.OldSize(ctx)
-	case folder.FieldCount:
-		return m.OldCount(ctx)
+	case tag.FieldColor:
+		return m.OldColor(ctx)
+	case tag.FieldIcon:
+		return m.OldIcon(ctx)
+	case tag.FieldDescription:
+		return m.OldDescription(ctx)
 	}
-	return nil, fmt.Errorf("unknown Folder field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FolderMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case folder.FieldCompanyID:
+	case tag.FieldCompanyID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCompanyID(v)
 		return nil
-	case folder.FieldParentFolderID:
+	case tag.FieldParentID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParentFolderID(v)
+		m.SetParentID(v)
 		return nil
-	case folder.FieldName:
+	case tag.FieldGroupID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroupID(v)
+		return nil
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case folder.FieldSize:
-		v, ok := value.(int64)
+	case tag.FieldColor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetColor(v)
 		return nil
-	case folder.FieldCount:
-		v, ok := value.(int)
+	case tag.FieldIcon:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCount(v)
+		m.SetIcon(v)
+		return nil
+	case tag.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Folder field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FolderMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, folder.FieldSize)
-	}
-	if m.addcount != nil {
-		fields = append(fields, folder.FieldCount)
-	}
-	return fields
+func (m *TagMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case folder.FieldSize:
-		return m.AddedSize()
-	case folder.FieldCount:
-		return m.AddedCount()
-	}
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FolderMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case folder.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
-	case folder.FieldCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Folder numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FolderMutation) ClearedFields() []string {
+func (m *TagMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(folder.FieldParentFolderID) {
-		fields = append(fields, folder.FieldParentFolderID)
+	if m.FieldCleared(tag.FieldParentID) {
+		fields = append(fields, tag.FieldParentID)
+	}
+	if m.FieldCleared(tag.FieldGroupID) {
+		fields = append(fields, tag.FieldGroupID)
+	}
+	if m.FieldCleared(tag.FieldColor) {
+		fields = append(fields, tag.FieldColor)
+	}
+	if m.FieldCleared(tag.FieldIcon) {
+		fields = append(fields, tag.FieldIcon)
+	}
+	if m.FieldCleared(tag.FieldDescription) {
+		fields = append(fields, tag.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FolderMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FolderMutation) ClearField(name string) error {
+func (m *TagMutation) ClearField(name string) error {
 	switch name {
-	case folder.FieldParentFolderID:
-		m.ClearParentFolderID()
+	case tag.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case tag.FieldGroupID:
+		m.ClearGroupID()
+		return nil
+	case tag.FieldColor:
+		m.ClearColor()
+		return nil
+	case tag.FieldIcon:
+		m.ClearIcon()
+		return nil
+	case tag.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Folder nullable field %s", name)
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FolderMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case folder.FieldCompanyID:
+	case tag.FieldCompanyID:
 		m.ResetCompanyID()
 		return nil
-	case folder.FieldParentFolderID:
-		m.ResetParentFolderID()
+	case tag.FieldParentID:
+		m.ResetParentID()
 		return nil
-	case folder.FieldName:
+	case tag.FieldGroupID:
+		m.ResetGroupID()
+		return nil
+	case tag.FieldName:
 		m.ResetName()
 		return nil
-	case folder.FieldSize:
-		m.ResetSize()
+	case tag.FieldColor:
+		m.ResetColor()
 		return nil
-	case folder.FieldCount:
-		m.ResetCount()
+	case tag.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case tag.FieldDescription:
+		m.ResetDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown Folder field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FolderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
 	if m.company != nil {
-		edges = append(edges, folder.EdgeCompany)
+		edges = append(edges, tag.EdgeCompany)
 	}
 	if m.parent != nil {
-		edges = append(edges, folder.EdgeParent)
+		edges = append(edges, tag.EdgeParent)
 	}
 	if m.children != nil {
-		edges = append(edges, folder.EdgeChildren)
+		edges = append(edges, tag.EdgeChildren)
 	}
-	if m.documents != nil {
-		edges = append(edges, folder.EdgeDocuments)
+	if m.group != nil {
+		edges = append(edges, tag.EdgeGroup)
+	}
+	if m.document_tags != nil {
+		edges = append(edges, tag.EdgeDocumentTags)
+	}
+	if m.rules != nil {
+		edges = append(edges, tag.EdgeRules)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case folder.EdgeCompany:
+	case tag.EdgeCompany:
 		if id := m.company; id != nil {
 			return []ent.Value{*id}
 		}
-	case folder.EdgeParent:
+	case tag.EdgeParent:
 		if id := m.parent; id != nil {
 			return []ent.Value{*id}
 		}
-	case folder.EdgeChildren:
+	case tag.EdgeChildren:
 		ids := make([]ent.Value, 0, len(m.children))
 		for id := range m.children {
 			ids = append(ids, id)
 		}
 		return ids
-	case folder.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.documents))
-		for id := range m.documents {
+	case tag.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeDocumentTags:
+		ids := make([]ent.Value, 0, len(m.document_tags))
+		for id := range m.document_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4275,30 +14674,39 @@ func (m *FolderMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FolderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
 	if m.removedchildren != nil {
-		edges = append(edges, folder.EdgeChildren)
+		edges = append(edges, tag.EdgeChildren)
 	}
-	if m.removeddocuments != nil {
-		edges = append(edges, folder.EdgeDocuments)
+	if m.removeddocument_tags != nil {
+		edges = append(edges, tag.EdgeDocumentTags)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, tag.EdgeRules)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case folder.EdgeChildren:
+	case tag.EdgeChildren:
 		ids := make([]ent.Value, 0, len(m.removedchildren))
 		for id := range m.removedchildren {
 			ids = append(ids, id)
 		}
 		return ids
-	case folder.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.removeddocuments))
-		for id := range m.removeddocuments {
+	case tag.EdgeDocumentTags:
+		ids := make([]ent.Value, 0, len(m.removeddocument_tags))
+		for id := range m.removeddocument_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case tag.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4307,103 +14715,123 @@ func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FolderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
 	if m.clearedcompany {
-		edges = append(edges, folder.EdgeCompany)
+		edges = append(edges, tag.EdgeCompany)
 	}
 	if m.clearedparent {
-		edges = append(edges, folder.EdgeParent)
+		edges = append(edges, tag.EdgeParent)
 	}
 	if m.clearedchildren {
-		edges = append(edges, folder.EdgeChildren)
+		edges = append(edges, tag.EdgeChildren)
 	}
-	if m.cleareddocuments {
-		edges = append(edges, folder.EdgeDocuments)
+	if m.clearedgroup {
+		edges = append(edges, tag.EdgeGroup)
+	}
+	if m.cleareddocument_tags {
+		edges = append(edges, tag.EdgeDocumentTags)
+	}
+	if m.clearedrules {
+		edges = append(edges, tag.EdgeRules)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FolderMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case folder.EdgeCompany:
+	case tag.EdgeCompany:
 		return m.clearedcompany
-	case folder.EdgeParent:
+	case tag.EdgeParent:
 		return m.clearedparent
-	case folder.EdgeChildren:
+	case tag.EdgeChildren:
 		return m.clearedchildren
-	case folder.EdgeDocuments:
-		return m.cleareddocuments
+	case tag.EdgeGroup:
+		return m.clearedgroup
+	case tag.EdgeDocumentTags:
+		return m.cleareddocument_tags
+	case tag.EdgeRules:
+		return m.clearedrules
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FolderMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
-	case folder.EdgeCompany:
+	case tag.EdgeCompany:
 		m.ClearCompany()
 		return nil
-	case folder.EdgeParent:
+	case tag.EdgeParent:
 		m.ClearParent()
 		return nil
+	case tag.EdgeGroup:
+		m.ClearGroup()
+		return nil
 	}
-	return fmt.Errorf("unknown Folder unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FolderMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case folder.EdgeCompany:
+	case tag.EdgeCompany:
 		m.ResetCompany()
 		return nil
-	case folder.EdgeParent:
+	case tag.EdgeParent:
 		m.ResetParent()
 		return nil
-	case folder.EdgeChildren:
+	case tag.EdgeChildren:
 		m.ResetChildren()
 		return nil
-	case folder.EdgeDocuments:
-		m.ResetDocuments()
+	case tag.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	case tag.EdgeDocumentTags:
+		m.ResetDocumentTags()
+		return nil
+	case tag.EdgeRules:
+		m.ResetRules()
 		return nil
 	}
-	return fmt.Errorf("unknown Folder edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
-// SenderMutation represents an operation that mutates the Sender nodes in the graph.
-type SenderMutation struct {
+// TagGroupMutation represents an operation that mutates the TagGroup nodes in the graph.
+type TagGroupMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	email            *string
-	clearedFields    map[string]struct{}
-	company          *uuid.UUID
-	clearedcompany   bool
-	documents        map[uuid.UUID]struct{}
-	removeddocuments map[uuid.UUID]struct{}
-	cleareddocuments bool
-	done             bool
-	oldValue         func(context.Context) (*Sender, error)
-	predicates       []predicate.Sender
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	exclusive      *bool
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	company        *uuid.UUID
+	clearedcompany bool
+	tags           map[uuid.UUID]struct{}
+	removedtags    map[uuid.UUID]struct{}
+	clearedtags    bool
+	done           bool
+	oldValue       func(context.Context) (*TagGroup, error)
+	predicates     []predicate.TagGroup
 }
 
-var _ ent.Mutation = (*SenderMutation)(nil)
+var _ ent.Mutation = (*TagGroupMutation)(nil)
 
-// senderOption allows management of the mutation configuration using functional options.
-type senderOption func(*SenderMutation)
+// taggroupOption allows management of the mutation configuration using functional options.
+type taggroupOption func(*TagGroupMutation)
 
-// newSenderMutation creates new mutation for the Sender entity.
-func newSenderMutation(c config, op Op, opts ...senderOption) *SenderMutation {
-	m := &SenderMutation{
+// newTagGroupMutation creates new mutation for the TagGroup entity.
+func newTagGroupMutation(c config, op Op, opts ...taggroupOption) *TagGroupMutation {
+	m := &TagGroupMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSender,
+		typ:           TypeTagGroup,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4412,20 +14840,20 @@ func newSenderMutation(c config, op Op, opts ...senderOption) *SenderMutation {
 	return m
 }
 
-// withSenderID sets the ID field of the mutation.
-func withSenderID(id uuid.UUID) senderOption {
-	return func(m *SenderMutation) {
+// withTagGroupID sets the ID field of the mutation.
+func withTagGroupID(id uuid.UUID) taggroupOption {
+	return func(m *TagGroupMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Sender
+			value *TagGroup
 		)
-		m.oldValue = func(ctx context.Context) (*Sender, error) {
+		m.oldValue = func(ctx context.Context) (*TagGroup, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Sender.Get(ctx, id)
+					value, err = m.Client().TagGroup.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4434,10 +14862,10 @@ func withSenderID(id uuid.UUID) senderOption {
 	}
 }
 
-// withSender sets the old Sender of the mutation.
-func withSender(node *Sender) senderOption {
-	return func(m *SenderMutation) {
-		m.oldValue = func(context.Context) (*Sender, error) {
+// withTagGroup sets the old TagGroup of the mutation.
+func withTagGroup(node *TagGroup) taggroupOption {
+	return func(m *TagGroupMutation) {
+		m.oldValue = func(context.Context) (*TagGroup, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4446,7 +14874,7 @@ func withSender(node *Sender) senderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SenderMutation) Client() *Client {
+func (m TagGroupMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4454,7 +14882,7 @@ func (m SenderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SenderMutation) Tx() (*Tx, error) {
+func (m TagGroupMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4464,14 +14892,14 @@ func (m SenderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Sender entities.
-func (m *SenderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TagGroup entities.
+func (m *TagGroupMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SenderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TagGroupMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4482,7 +14910,7 @@ func (m *SenderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SenderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TagGroupMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4491,19 +14919,19 @@ func (m *SenderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Sender.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TagGroup.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCompanyID sets the "company_id" field.
-func (m *SenderMutation) SetCompanyID(u uuid.UUID) {
+func (m *TagGroupMutation) SetCompanyID(u uuid.UUID) {
 	m.company = &u
 }
 
 // CompanyID returns the value of the "company_id" field in the mutation.
-func (m *SenderMutation) CompanyID() (r uuid.UUID, exists bool) {
+func (m *TagGroupMutation) CompanyID() (r uuid.UUID, exists bool) {
 	v := m.company
 	if v == nil {
 		return
@@ -4511,10 +14939,10 @@ func (m *SenderMutation) CompanyID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldCompanyID returns the old "company_id" field's value of the Sender entity.
-// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// OldCompanyID returns the old "company_id" field's value of the TagGroup entity.
+// If the TagGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SenderMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TagGroupMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
 	}
@@ -4529,17 +14957,17 @@ func (m *SenderMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err err
 }
 
 // ResetCompanyID resets all changes to the "company_id" field.
-func (m *SenderMutation) ResetCompanyID() {
+func (m *TagGroupMutation) ResetCompanyID() {
 	m.company = nil
 }
 
 // SetName sets the "name" field.
-func (m *SenderMutation) SetName(s string) {
+func (m *TagGroupMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *SenderMutation) Name() (r string, exists bool) {
+func (m *TagGroupMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4547,10 +14975,10 @@ func (m *SenderMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Sender entity.
-// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TagGroup entity.
+// If the TagGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SenderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TagGroupMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4565,74 +14993,97 @@ func (m *SenderMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *SenderMutation) ResetName() {
+func (m *TagGroupMutation) ResetName() {
 	m.name = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *SenderMutation) SetEmail(s string) {
-	m.email = &s
+// SetExclusive sets the "exclusive" field.
+func (m *TagGroupMutation) SetExclusive(b bool) {
+	m.exclusive = &b
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *SenderMutation) Email() (r string, exists bool) {
-	v := m.email
+// Exclusive returns the value of the "exclusive" field in the mutation.
+func (m *TagGroupMutation) Exclusive() (r bool, exists bool) {
+	v := m.exclusive
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the Sender entity.
-// If the Sender object wasn't provided to the builder, the object is fetched from the database.
+// OldExclusive returns the old "exclusive" field's value of the TagGroup entity.
+// If the TagGroup object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SenderMutation) OldEmail(ctx context.Context) (v *string, err error) {
+func (m *TagGroupMutation) OldExclusive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldExclusive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldExclusive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldExclusive: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Exclusive, nil
 }
 
-// ClearEmail clears the value of the "email" field.
-func (m *SenderMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[sender.FieldEmail] = struct{}{}
+// ResetExclusive resets all changes to the "exclusive" field.
+func (m *TagGroupMutation) ResetExclusive() {
+	m.exclusive = nil
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *SenderMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[sender.FieldEmail]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *TagGroupMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *SenderMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, sender.FieldEmail)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagGroupMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TagGroup entity.
+// If the TagGroup object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagGroupMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagGroupMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // ClearCompany clears the "company" edge to the Company entity.
-func (m *SenderMutation) ClearCompany() {
+func (m *TagGroupMutation) ClearCompany() {
 	m.clearedcompany = true
-	m.clearedFields[sender.FieldCompanyID] = struct{}{}
+	m.clearedFields[taggroup.FieldCompanyID] = struct{}{}
 }
 
 // CompanyCleared reports if the "company" edge to the Company entity was cleared.
-func (m *SenderMutation) CompanyCleared() bool {
+func (m *TagGroupMutation) CompanyCleared() bool {
 	return m.clearedcompany
 }
 
 // CompanyIDs returns the "company" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // CompanyID instead. It exists only for internal usage by the builders.
-func (m *SenderMutation) CompanyIDs() (ids []uuid.UUID) {
+func (m *TagGroupMutation) CompanyIDs() (ids []uuid.UUID) {
 	if id := m.company; id != nil {
 		ids = append(ids, *id)
 	}
@@ -4640,74 +15091,74 @@ func (m *SenderMutation) CompanyIDs() (ids []uuid.UUID) {
 }
 
 // ResetCompany resets all changes to the "company" edge.
-func (m *SenderMutation) ResetCompany() {
+func (m *TagGroupMutation) ResetCompany() {
 	m.company = nil
 	m.clearedcompany = false
 }
 
-// AddDocumentIDs adds the "documents" edge to the Document entity by ids.
-func (m *SenderMutation) AddDocumentIDs(ids ...uuid.UUID) {
-	if m.documents == nil {
-		m.documents = make(map[uuid.UUID]struct{})
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *TagGroupMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.documents[ids[i]] = struct{}{}
+		m.tags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearDocuments clears the "documents" edge to the Document entity.
-func (m *SenderMutation) ClearDocuments() {
-	m.cleareddocuments = true
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *TagGroupMutation) ClearTags() {
+	m.clearedtags = true
 }
 
-// DocumentsCleared reports if the "documents" edge to the Document entity was cleared.
-func (m *SenderMutation) DocumentsCleared() bool {
-	return m.cleareddocuments
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *TagGroupMutation) TagsCleared() bool {
+	return m.clearedtags
 }
 
-// RemoveDocumentIDs removes the "documents" edge to the Document entity by IDs.
-func (m *SenderMutation) RemoveDocumentIDs(ids ...uuid.UUID) {
-	if m.removeddocuments == nil {
-		m.removeddocuments = make(map[uuid.UUID]struct{})
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *TagGroupMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.documents, ids[i])
-		m.removeddocuments[ids[i]] = struct{}{}
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedDocuments returns the removed IDs of the "documents" edge to the Document entity.
-func (m *SenderMutation) RemovedDocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocuments {
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *TagGroupMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// DocumentsIDs returns the "documents" edge IDs in the mutation.
-func (m *SenderMutation) DocumentsIDs() (ids []uuid.UUID) {
-	for id := range m.documents {
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *TagGroupMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetDocuments resets all changes to the "documents" edge.
-func (m *SenderMutation) ResetDocuments() {
-	m.documents = nil
-	m.cleareddocuments = false
-	m.removeddocuments = nil
+// ResetTags resets all changes to the "tags" edge.
+func (m *TagGroupMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// Where appends a list predicates to the SenderMutation builder.
-func (m *SenderMutation) Where(ps ...predicate.Sender) {
+// Where appends a list predicates to the TagGroupMutation builder.
+func (m *TagGroupMutation) Where(ps ...predicate.TagGroup) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SenderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagGroupMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SenderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Sender, len(ps))
+func (m *TagGroupMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagGroup, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4715,33 +15166,36 @@ func (m *SenderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SenderMutation) Op() Op {
+func (m *TagGroupMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SenderMutation) SetOp(op Op) {
+func (m *TagGroupMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Sender).
-func (m *SenderMutation) Type() string {
+// Type returns the node type of this mutation (TagGroup).
+func (m *TagGroupMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SenderMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *TagGroupMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.company != nil {
-		fields = append(fields, sender.FieldCompanyID)
+		fields = append(fields, taggroup.FieldCompanyID)
 	}
 	if m.name != nil {
-		fields = append(fields, sender.FieldName)
+		fields = append(fields, taggroup.FieldName)
 	}
-	if m.email != nil {
-		fields = append(fields, sender.FieldEmail)
+	if m.exclusive != nil {
+		fields = append(fields, taggroup.FieldExclusive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, taggroup.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4749,14 +15203,16 @@ func (m *SenderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SenderMutation) Field(name string) (ent.Value, bool) {
+func (m *TagGroupMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case sender.FieldCompanyID:
+	case taggroup.FieldCompanyID:
 		return m.CompanyID()
-	case sender.FieldName:
+	case taggroup.FieldName:
 		return m.Name()
-	case sender.FieldEmail:
-		return m.Email()
+	case taggroup.FieldExclusive:
+		return m.Exclusive()
+	case taggroup.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -4764,138 +15220,141 @@ func (m *SenderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SenderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagGroupMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case sender.FieldCompanyID:
+	case taggroup.FieldCompanyID:
 		return m.OldCompanyID(ctx)
-	case sender.FieldName:
+	case taggroup.FieldName:
 		return m.OldName(ctx)
-	case sender.FieldEmail:
-		return m.OldEmail(ctx)
+	case taggroup.FieldExclusive:
+		return m.OldExclusive(ctx)
+	case taggroup.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Sender field %s", name)
+	return nil, fmt.Errorf("unknown TagGroup field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SenderMutation) SetField(name string, value ent.Value) error {
+func (m *TagGroupMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case sender.FieldCompanyID:
+	case taggroup.FieldCompanyID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCompanyID(v)
 		return nil
-	case sender.FieldName:
+	case taggroup.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case sender.FieldEmail:
-		v, ok := value.(string)
+	case taggroup.FieldExclusive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetExclusive(v)
+		return nil
+	case taggroup.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Sender field %s", name)
+	return fmt.Errorf("unknown TagGroup field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SenderMutation) AddedFields() []string {
+func (m *TagGroupMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SenderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagGroupMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SenderMutation) AddField(name string, value ent.Value) error {
+func (m *TagGroupMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Sender numeric field %s", name)
+	return fmt.Errorf("unknown TagGroup numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SenderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(sender.FieldEmail) {
-		fields = append(fields, sender.FieldEmail)
-	}
-	return fields
+func (m *TagGroupMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SenderMutation) FieldCleared(name string) bool {
+func (m *TagGroupMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SenderMutation) ClearField(name string) error {
-	switch name {
-	case sender.FieldEmail:
-		m.ClearEmail()
-		return nil
-	}
-	return fmt.Errorf("unknown Sender nullable field %s", name)
+func (m *TagGroupMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TagGroup nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SenderMutation) ResetField(name string) error {
+func (m *TagGroupMutation) ResetField(name string) error {
 	switch name {
-	case sender.FieldCompanyID:
+	case taggroup.FieldCompanyID:
 		m.ResetCompanyID()
 		return nil
-	case sender.FieldName:
+	case taggroup.FieldName:
 		m.ResetName()
 		return nil
-	case sender.FieldEmail:
-		m.ResetEmail()
+	case taggroup.FieldExclusive:
+		m.ResetExclusive()
+		return nil
+	case taggroup.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Sender field %s", name)
+	return fmt.Errorf("unknown TagGroup field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SenderMutation) AddedEdges() []string {
+func (m *TagGroupMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.company != nil {
-		edges = append(edges, sender.EdgeCompany)
+		edges = append(edges, taggroup.EdgeCompany)
 	}
-	if m.documents != nil {
-		edges = append(edges, sender.EdgeDocuments)
+	if m.tags != nil {
+		edges = append(edges, taggroup.EdgeTags)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SenderMutation) AddedIDs(name string) []ent.Value {
+func (m *TagGroupMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case sender.EdgeCompany:
+	case taggroup.EdgeCompany:
 		if id := m.company; id != nil {
 			return []ent.Value{*id}
 		}
-	case sender.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.documents))
-		for id := range m.documents {
+	case taggroup.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4904,21 +15363,21 @@ func (m *SenderMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SenderMutation) RemovedEdges() []string {
+func (m *TagGroupMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removeddocuments != nil {
-		edges = append(edges, sender.EdgeDocuments)
+	if m.removedtags != nil {
+		edges = append(edges, taggroup.EdgeTags)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SenderMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagGroupMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case sender.EdgeDocuments:
-		ids := make([]ent.Value, 0, len(m.removeddocuments))
-		for id := range m.removeddocuments {
+	case taggroup.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4927,83 +15386,88 @@ func (m *SenderMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SenderMutation) ClearedEdges() []string {
+func (m *TagGroupMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedcompany {
-		edges = append(edges, sender.EdgeCompany)
+		edges = append(edges, taggroup.EdgeCompany)
 	}
-	if m.cleareddocuments {
-		edges = append(edges, sender.EdgeDocuments)
+	if m.clearedtags {
+		edges = append(edges, taggroup.EdgeTags)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SenderMutation) EdgeCleared(name string) bool {
+func (m *TagGroupMutation) EdgeCleared(name string) bool {
 	switch name {
-	case sender.EdgeCompany:
+	case taggroup.EdgeCompany:
 		return m.clearedcompany
-	case sender.EdgeDocuments:
-		return m.cleareddocuments
+	case taggroup.EdgeTags:
+		return m.clearedtags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SenderMutation) ClearEdge(name string) error {
+func (m *TagGroupMutation) ClearEdge(name string) error {
 	switch name {
-	case sender.EdgeCompany:
+	case taggroup.EdgeCompany:
 		m.ClearCompany()
 		return nil
 	}
-	return fmt.Errorf("unknown Sender unique edge %s", name)
+	return fmt.Errorf("unknown TagGroup unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SenderMutation) ResetEdge(name string) error {
+func (m *TagGroupMutation) ResetEdge(name string) error {
 	switch name {
-	case sender.EdgeCompany:
+	case taggroup.EdgeCompany:
 		m.ResetCompany()
 		return nil
-	case sender.EdgeDocuments:
-		m.ResetDocuments()
+	case taggroup.EdgeTags:
+		m.ResetTags()
 		return nil
 	}
-	return fmt.Errorf("unknown Sender edge %s", name)
+	return fmt.Errorf("unknown TagGroup edge %s", name)
 }
 
-// TagMutation represents an operation that mutates the Tag nodes in the graph.
-type TagMutation struct {
+// TagRuleMutation represents an operation that mutates the TagRule nodes in the graph.
+type TagRuleMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	name                 *string
-	clearedFields        map[string]struct{}
-	company              *uuid.UUID
-	clearedcompany       bool
-	document_tags        map[uuid.UUID]struct{}
-	removeddocument_tags map[uuid.UUID]struct{}
-	cleareddocument_tags bool
-	done                 bool
-	oldValue             func(context.Context) (*Tag, error)
-	predicates           []predicate.Tag
-}
-
-var _ ent.Mutation = (*TagMutation)(nil)
-
-// tagOption allows management of the mutation configuration using functional options.
-type tagOption func(*TagMutation)
-
-// newTagMutation creates new mutation for the Tag entity.
-func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
-	m := &TagMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	company_id    *uuid.UUID
+	name          *string
+	conditions    *types.TagRuleConditions
+	priority      *int
+	addpriority   *int
+	stop          *bool
+	enabled       *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	tag           *uuid.UUID
+	clearedtag    bool
+	done          bool
+	oldValue      func(context.Context) (*TagRule, error)
+	predicates    []predicate.TagRule
+}
+
+var _ ent.Mutation = (*TagRuleMutation)(nil)
+
+// tagruleOption allows management of the mutation configuration using functional options.
+type tagruleOption func(*TagRuleMutation)
+
+// newTagRuleMutation creates new mutation for the TagRule entity.
+func newTagRuleMutation(c config, op Op, opts ...tagruleOption) *TagRuleMutation {
+	m := &TagRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTag,
+		typ:           TypeTagRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5012,20 +15476,20 @@ func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
 	return m
 }
 
-// withTagID sets the ID field of the mutation.
-func withTagID(id uuid.UUID) tagOption {
-	return func(m *TagMutation) {
+// withTagRuleID sets the ID field of the mutation.
+func withTagRuleID(id uuid.UUID) tagruleOption {
+	return func(m *TagRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tag
+			value *TagRule
 		)
-		m.oldValue = func(ctx context.Context) (*Tag, error) {
+		m.oldValue = func(ctx context.Context) (*TagRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tag.Get(ctx, id)
+					value, err = m.Client().TagRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5034,10 +15498,10 @@ func withTagID(id uuid.UUID) tagOption {
 	}
 }
 
-// withTag sets the old Tag of the mutation.
-func withTag(node *Tag) tagOption {
-	return func(m *TagMutation) {
-		m.oldValue = func(context.Context) (*Tag, error) {
+// withTagRule sets the old TagRule of the mutation.
+func withTagRule(node *TagRule) tagruleOption {
+	return func(m *TagRuleMutation) {
+		m.oldValue = func(context.Context) (*TagRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5046,7 +15510,7 @@ func withTag(node *Tag) tagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TagMutation) Client() *Client {
+func (m TagRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5054,7 +15518,7 @@ func (m TagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TagMutation) Tx() (*Tx, error) {
+func (m TagRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5064,14 +15528,14 @@ func (m TagMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tag entities.
-func (m *TagMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TagRule entities.
+func (m *TagRuleMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TagRuleMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5082,7 +15546,7 @@ func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TagRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5091,30 +15555,30 @@ func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TagRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCompanyID sets the "company_id" field.
-func (m *TagMutation) SetCompanyID(u uuid.UUID) {
-	m.company = &u
+func (m *TagRuleMutation) SetCompanyID(u uuid.UUID) {
+	m.company_id = &u
 }
 
 // CompanyID returns the value of the "company_id" field in the mutation.
-func (m *TagMutation) CompanyID() (r uuid.UUID, exists bool) {
-	v := m.company
+func (m *TagRuleMutation) CompanyID() (r uuid.UUID, exists bool) {
+	v := m.company_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompanyID returns the old "company_id" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldCompanyID returns the old "company_id" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TagRuleMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCompanyID is only allowed on UpdateOne operations")
 	}
@@ -5129,17 +15593,53 @@ func (m *TagMutation) OldCompanyID(ctx context.Context) (v uuid.UUID, err error)
 }
 
 // ResetCompanyID resets all changes to the "company_id" field.
-func (m *TagMutation) ResetCompanyID() {
-	m.company = nil
+func (m *TagRuleMutation) ResetCompanyID() {
+	m.company_id = nil
+}
+
+// SetTagID sets the "tag_id" field.
+func (m *TagRuleMutation) SetTagID(u uuid.UUID) {
+	m.tag = &u
+}
+
+// TagID returns the value of the "tag_id" field in the mutation.
+func (m *TagRuleMutation) TagID() (r uuid.UUID, exists bool) {
+	v := m.tag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTagID returns the old "tag_id" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldTagID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTagID: %w", err)
+	}
+	return oldValue.TagID, nil
+}
+
+// ResetTagID resets all changes to the "tag_id" field.
+func (m *TagRuleMutation) ResetTagID() {
+	m.tag = nil
 }
 
 // SetName sets the "name" field.
-func (m *TagMutation) SetName(s string) {
+func (m *TagRuleMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *TagMutation) Name() (r string, exists bool) {
+func (m *TagRuleMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -5147,10 +15647,10 @@ func (m *TagMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Tag entity.
-// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TagRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -5165,100 +15665,282 @@ func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *TagMutation) ResetName() {
+func (m *TagRuleMutation) ResetName() {
 	m.name = nil
 }
 
-// ClearCompany clears the "company" edge to the Company entity.
-func (m *TagMutation) ClearCompany() {
-	m.clearedcompany = true
-	m.clearedFields[tag.FieldCompanyID] = struct{}{}
+// SetConditions sets the "conditions" field.
+func (m *TagRuleMutation) SetConditions(trc types.TagRuleConditions) {
+	m.conditions = &trc
 }
 
-// CompanyCleared reports if the "company" edge to the Company entity was cleared.
-func (m *TagMutation) CompanyCleared() bool {
-	return m.clearedcompany
+// Conditions returns the value of the "conditions" field in the mutation.
+func (m *TagRuleMutation) Conditions() (r types.TagRuleConditions, exists bool) {
+	v := m.conditions
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CompanyIDs returns the "company" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CompanyID instead. It exists only for internal usage by the builders.
-func (m *TagMutation) CompanyIDs() (ids []uuid.UUID) {
-	if id := m.company; id != nil {
-		ids = append(ids, *id)
+// OldConditions returns the old "conditions" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldConditions(ctx context.Context) (v types.TagRuleConditions, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConditions is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConditions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConditions: %w", err)
+	}
+	return oldValue.Conditions, nil
 }
 
-// ResetCompany resets all changes to the "company" edge.
-func (m *TagMutation) ResetCompany() {
-	m.company = nil
-	m.clearedcompany = false
+// ResetConditions resets all changes to the "conditions" field.
+func (m *TagRuleMutation) ResetConditions() {
+	m.conditions = nil
 }
 
-// AddDocumentTagIDs adds the "document_tags" edge to the DocumentTag entity by ids.
-func (m *TagMutation) AddDocumentTagIDs(ids ...uuid.UUID) {
-	if m.document_tags == nil {
-		m.document_tags = make(map[uuid.UUID]struct{})
+// SetPriority sets the "priority" field.
+func (m *TagRuleMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *TagRuleMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.document_tags[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *TagRuleMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *TagRuleMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *TagRuleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetStop sets the "stop" field.
+func (m *TagRuleMutation) SetStop(b bool) {
+	m.stop = &b
+}
+
+// Stop returns the value of the "stop" field in the mutation.
+func (m *TagRuleMutation) Stop() (r bool, exists bool) {
+	v := m.stop
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStop returns the old "stop" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldStop(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStop is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStop requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStop: %w", err)
+	}
+	return oldValue.Stop, nil
+}
+
+// ResetStop resets all changes to the "stop" field.
+func (m *TagRuleMutation) ResetStop() {
+	m.stop = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *TagRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *TagRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *TagRuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearDocumentTags clears the "document_tags" edge to the DocumentTag entity.
-func (m *TagMutation) ClearDocumentTags() {
-	m.cleareddocument_tags = true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TagRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DocumentTagsCleared reports if the "document_tags" edge to the DocumentTag entity was cleared.
-func (m *TagMutation) DocumentTagsCleared() bool {
-	return m.cleareddocument_tags
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TagRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveDocumentTagIDs removes the "document_tags" edge to the DocumentTag entity by IDs.
-func (m *TagMutation) RemoveDocumentTagIDs(ids ...uuid.UUID) {
-	if m.removeddocument_tags == nil {
-		m.removeddocument_tags = make(map[uuid.UUID]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the TagRule entity.
+// If the TagRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.document_tags, ids[i])
-		m.removeddocument_tags[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// RemovedDocumentTags returns the removed IDs of the "document_tags" edge to the DocumentTag entity.
-func (m *TagMutation) RemovedDocumentTagsIDs() (ids []uuid.UUID) {
-	for id := range m.removeddocument_tags {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TagRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DocumentTagsIDs returns the "document_tags" edge IDs in the mutation.
-func (m *TagMutation) DocumentTagsIDs() (ids []uuid.UUID) {
-	for id := range m.document_tags {
-		ids = append(ids, id)
+// ClearTag clears the "tag" edge to the Tag entity.
+func (m *TagRuleMutation) ClearTag() {
+	m.clearedtag = true
+	m.clearedFields[tagrule.FieldTagID] = struct{}{}
+}
+
+// TagCleared reports if the "tag" edge to the Tag entity was cleared.
+func (m *TagRuleMutation) TagCleared() bool {
+	return m.clearedtag
+}
+
+// TagIDs returns the "tag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TagID instead. It exists only for internal usage by the builders.
+func (m *TagRuleMutation) TagIDs() (ids []uuid.UUID) {
+	if id := m.tag; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetDocumentTags resets all changes to the "document_tags" edge.
-func (m *TagMutation) ResetDocumentTags() {
-	m.document_tags = nil
-	m.cleareddocument_tags = false
-	m.removeddocument_tags = nil
+// ResetTag resets all changes to the "tag" edge.
+func (m *TagRuleMutation) ResetTag() {
+	m.tag = nil
+	m.clearedtag = false
 }
 
-// Where appends a list predicates to the TagMutation builder.
-func (m *TagMutation) Where(ps ...predicate.Tag) {
+// Where appends a list predicates to the TagRuleMutation builder.
+func (m *TagRuleMutation) Where(ps ...predicate.TagRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tag, len(ps))
+func (m *TagRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TagRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5266,30 +15948,51 @@ func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TagMutation) Op() Op {
+func (m *TagRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TagMutation) SetOp(op Op) {
+func (m *TagRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tag).
-func (m *TagMutation) Type() string {
+// Type returns the node type of this mutation (TagRule).
+func (m *TagRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TagMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.company != nil {
-		fields = append(fields, tag.FieldCompanyID)
+func (m *TagRuleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.company_id != nil {
+		fields = append(fields, tagrule.FieldCompanyID)
+	}
+	if m.tag != nil {
+		fields = append(fields, tagrule.FieldTagID)
 	}
 	if m.name != nil {
-		fields = append(fields, tag.FieldName)
+		fields = append(fields, tagrule.FieldName)
+	}
+	if m.conditions != nil {
+		fields = append(fields, tagrule.FieldConditions)
+	}
+	if m.priority != nil {
+		fields = append(fields, tagrule.FieldPriority)
+	}
+	if m.stop != nil {
+		fields = append(fields, tagrule.FieldStop)
+	}
+	if m.enabled != nil {
+		fields = append(fields, tagrule.FieldEnabled)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tagrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tagrule.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5297,12 +16000,26 @@ func (m *TagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TagMutation) Field(name string) (ent.Value, bool) {
+func (m *TagRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tag.FieldCompanyID:
+	case tagrule.FieldCompanyID:
 		return m.CompanyID()
-	case tag.FieldName:
+	case tagrule.FieldTagID:
+		return m.TagID()
+	case tagrule.FieldName:
 		return m.Name()
+	case tagrule.FieldConditions:
+		return m.Conditions()
+	case tagrule.FieldPriority:
+		return m.Priority()
+	case tagrule.FieldStop:
+		return m.Stop()
+	case tagrule.FieldEnabled:
+		return m.Enabled()
+	case tagrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case tagrule.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5310,194 +16027,265 @@ func (m *TagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tag.FieldCompanyID:
+	case tagrule.FieldCompanyID:
 		return m.OldCompanyID(ctx)
-	case tag.FieldName:
+	case tagrule.FieldTagID:
+		return m.OldTagID(ctx)
+	case tagrule.FieldName:
 		return m.OldName(ctx)
+	case tagrule.FieldConditions:
+		return m.OldConditions(ctx)
+	case tagrule.FieldPriority:
+		return m.OldPriority(ctx)
+	case tagrule.FieldStop:
+		return m.OldStop(ctx)
+	case tagrule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case tagrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tagrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tag field %s", name)
+	return nil, fmt.Errorf("unknown TagRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) SetField(name string, value ent.Value) error {
+func (m *TagRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tag.FieldCompanyID:
+	case tagrule.FieldCompanyID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCompanyID(v)
 		return nil
-	case tag.FieldName:
+	case tagrule.FieldTagID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTagID(v)
+		return nil
+	case tagrule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
+	case tagrule.FieldConditions:
+		v, ok := value.(types.TagRuleConditions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditions(v)
+		return nil
+	case tagrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case tagrule.FieldStop:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStop(v)
+		return nil
+	case tagrule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case tagrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tagrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown TagRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TagMutation) AddedFields() []string {
-	return nil
+func (m *TagRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, tagrule.FieldPriority)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tagrule.FieldPriority:
+		return m.AddedPriority()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TagMutation) AddField(name string, value ent.Value) error {
+func (m *TagRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tagrule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tag numeric field %s", name)
+	return fmt.Errorf("unknown TagRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TagMutation) ClearedFields() []string {
+func (m *TagRuleMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TagMutation) FieldCleared(name string) bool {
+func (m *TagRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TagMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tag nullable field %s", name)
+func (m *TagRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TagRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TagMutation) ResetField(name string) error {
+func (m *TagRuleMutation) ResetField(name string) error {
 	switch name {
-	case tag.FieldCompanyID:
+	case tagrule.FieldCompanyID:
 		m.ResetCompanyID()
 		return nil
-	case tag.FieldName:
+	case tagrule.FieldTagID:
+		m.ResetTagID()
+		return nil
+	case tagrule.FieldName:
 		m.ResetName()
 		return nil
+	case tagrule.FieldConditions:
+		m.ResetConditions()
+		return nil
+	case tagrule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case tagrule.FieldStop:
+		m.ResetStop()
+		return nil
+	case tagrule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case tagrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tagrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Tag field %s", name)
+	return fmt.Errorf("unknown TagRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.company != nil {
-		edges = append(edges, tag.EdgeCompany)
-	}
-	if m.document_tags != nil {
-		edges = append(edges, tag.EdgeDocumentTags)
+func (m *TagRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.tag != nil {
+		edges = append(edges, tagrule.EdgeTag)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TagMutation) AddedIDs(name string) []ent.Value {
+func (m *TagRuleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tag.EdgeCompany:
-		if id := m.company; id != nil {
+	case tagrule.EdgeTag:
+		if id := m.tag; id != nil {
 			return []ent.Value{*id}
 		}
-	case tag.EdgeDocumentTags:
-		ids := make([]ent.Value, 0, len(m.document_tags))
-		for id := range m.document_tags {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removeddocument_tags != nil {
-		edges = append(edges, tag.EdgeDocumentTags)
-	}
+func (m *TagRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TagMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tag.EdgeDocumentTags:
-		ids := make([]ent.Value, 0, len(m.removeddocument_tags))
-		for id := range m.removeddocument_tags {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TagRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcompany {
-		edges = append(edges, tag.EdgeCompany)
-	}
-	if m.cleareddocument_tags {
-		edges = append(edges, tag.EdgeDocumentTags)
+func (m *TagRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtag {
+		edges = append(edges, tagrule.EdgeTag)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TagMutation) EdgeCleared(name string) bool {
+func (m *TagRuleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tag.EdgeCompany:
-		return m.clearedcompany
-	case tag.EdgeDocumentTags:
-		return m.cleareddocument_tags
+	case tagrule.EdgeTag:
+		return m.clearedtag
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TagMutation) ClearEdge(name string) error {
+func (m *TagRuleMutation) ClearEdge(name string) error {
 	switch name {
-	case tag.EdgeCompany:
-		m.ClearCompany()
+	case tagrule.EdgeTag:
+		m.ClearTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag unique edge %s", name)
+	return fmt.Errorf("unknown TagRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TagMutation) ResetEdge(name string) error {
+func (m *TagRuleMutation) ResetEdge(name string) error {
 	switch name {
-	case tag.EdgeCompany:
-		m.ResetCompany()
-		return nil
-	case tag.EdgeDocumentTags:
-		m.ResetDocumentTags()
+	case tagrule.EdgeTag:
+		m.ResetTag()
 		return nil
 	}
-	return fmt.Errorf("unknown Tag edge %s", name)
+	return fmt.Errorf("unknown TagRule edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -5509,6 +16297,7 @@ type UserMutation struct {
 	name                     *string
 	email                    *string
 	password                 *string
+	store_search_queries     *bool
 	clearedFields            map[string]struct{}
 	company_users            map[uuid.UUID]struct{}
 	removedcompany_users     map[uuid.UUID]struct{}
@@ -5736,6 +16525,42 @@ func (m *UserMutation) ResetPassword() {
 	m.password = nil
 }
 
+// SetStoreSearchQueries sets the "store_search_queries" field.
+func (m *UserMutation) SetStoreSearchQueries(b bool) {
+	m.store_search_queries = &b
+}
+
+// StoreSearchQueries returns the value of the "store_search_queries" field in the mutation.
+func (m *UserMutation) StoreSearchQueries() (r bool, exists bool) {
+	v := m.store_search_queries
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoreSearchQueries returns the old "store_search_queries" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldStoreSearchQueries(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoreSearchQueries is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoreSearchQueries requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoreSearchQueries: %w", err)
+	}
+	return oldValue.StoreSearchQueries, nil
+}
+
+// ResetStoreSearchQueries resets all changes to the "store_search_queries" field.
+func (m *UserMutation) ResetStoreSearchQueries() {
+	m.store_search_queries = nil
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by ids.
 func (m *UserMutation) AddCompanyUserIDs(ids ...uuid.UUID) {
 	if m.company_users == nil {
@@ -5932,7 +16757,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+	fields := make([]string, 0, 4)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -5942,6 +16767,9 @@ func (m *UserMutation) Fields() []string {
 	if m.password != nil {
 		fields = append(fields, user.FieldPassword)
 	}
+	if m.store_search_queries != nil {
+		fields = append(fields, user.FieldStoreSearchQueries)
+	}
 	return fields
 }
 
@@ -5956,6 +16784,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Email()
 	case user.FieldPassword:
 		return m.Password()
+	case user.FieldStoreSearchQueries:
+		return m.StoreSearchQueries()
 	}
 	return nil, false
 }
@@ -5971,6 +16801,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldEmail(ctx)
 	case user.FieldPassword:
 		return m.OldPassword(ctx)
+	case user.FieldStoreSearchQueries:
+		return m.OldStoreSearchQueries(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -6001,6 +16833,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPassword(v)
 		return nil
+	case user.FieldStoreSearchQueries:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoreSearchQueries(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -6059,6 +16898,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldPassword:
 		m.ResetPassword()
 		return nil
+	case user.FieldStoreSearchQueries:
+		m.ResetStoreSearchQueries()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
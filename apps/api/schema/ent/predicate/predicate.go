@@ -15,17 +15,47 @@ type CompanyUser func(*sql.Selector)
 // Document is the predicate function for document builders.
 type Document func(*sql.Selector)
 
+// DocumentClassifier is the predicate function for documentclassifier builders.
+type DocumentClassifier func(*sql.Selector)
+
+// DocumentContent is the predicate function for documentcontent builders.
+type DocumentContent func(*sql.Selector)
+
 // DocumentTag is the predicate function for documenttag builders.
 type DocumentTag func(*sql.Selector)
 
+// DocumentTagSuppression is the predicate function for documenttagsuppression builders.
+type DocumentTagSuppression func(*sql.Selector)
+
+// DocumentType is the predicate function for documenttype builders.
+type DocumentType func(*sql.Selector)
+
 // Folder is the predicate function for folder builders.
 type Folder func(*sql.Selector)
 
+// Job is the predicate function for job builders.
+type Job func(*sql.Selector)
+
+// SavedSearch is the predicate function for savedsearch builders.
+type SavedSearch func(*sql.Selector)
+
+// SearchIndexTask is the predicate function for searchindextask builders.
+type SearchIndexTask func(*sql.Selector)
+
+// SearchLog is the predicate function for searchlog builders.
+type SearchLog func(*sql.Selector)
+
 // Sender is the predicate function for sender builders.
 type Sender func(*sql.Selector)
 
 // Tag is the predicate function for tag builders.
 type Tag func(*sql.Selector)
 
+// TagGroup is the predicate function for taggroup builders.
+type TagGroup func(*sql.Selector)
+
+// TagRule is the predicate function for tagrule builders.
+type TagRule func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -7,10 +7,20 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/companyuser"
 	"techmind/schema/ent/document"
+	"techmind/schema/ent/documentclassifier"
+	"techmind/schema/ent/documentcontent"
 	"techmind/schema/ent/documenttag"
+	"techmind/schema/ent/documenttagsuppression"
+	"techmind/schema/ent/documenttype"
 	"techmind/schema/ent/folder"
+	"techmind/schema/ent/job"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/ent/searchindextask"
+	"techmind/schema/ent/searchlog"
 	"techmind/schema/ent/sender"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
+	"techmind/schema/ent/tagrule"
 	"techmind/schema/ent/user"
 	"time"
 
@@ -60,11 +70,11 @@ func init() {
 	// document.ChecksumValidator is a validator for the "checksum" field. It is called by the builders before save.
 	document.ChecksumValidator = documentDescChecksum.Validators[0].(func(string) error)
 	// documentDescCreatedAt is the schema descriptor for created_at field.
-	documentDescCreatedAt := documentFields[12].Descriptor()
+	documentDescCreatedAt := documentFields[15].Descriptor()
 	// document.DefaultCreatedAt holds the default value on creation for the created_at field.
 	document.DefaultCreatedAt = documentDescCreatedAt.Default.(func() time.Time)
 	// documentDescUpdatedAt is the schema descriptor for updated_at field.
-	documentDescUpdatedAt := documentFields[13].Descriptor()
+	documentDescUpdatedAt := documentFields[16].Descriptor()
 	// document.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	document.DefaultUpdatedAt = documentDescUpdatedAt.Default.(func() time.Time)
 	// document.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -73,12 +83,82 @@ func init() {
 	documentDescID := documentFields[0].Descriptor()
 	// document.DefaultID holds the default value on creation for the id field.
 	document.DefaultID = documentDescID.Default.(func() uuid.UUID)
+	documentclassifierFields := schema.DocumentClassifier{}.Fields()
+	_ = documentclassifierFields
+	// documentclassifierDescDocuments is the schema descriptor for documents field.
+	documentclassifierDescDocuments := documentclassifierFields[4].Descriptor()
+	// documentclassifier.DefaultDocuments holds the default value on creation for the documents field.
+	documentclassifier.DefaultDocuments = documentclassifierDescDocuments.Default.(int)
+	// documentclassifier.DocumentsValidator is a validator for the "documents" field. It is called by the builders before save.
+	documentclassifier.DocumentsValidator = documentclassifierDescDocuments.Validators[0].(func(int) error)
+	// documentclassifierDescTrainedAt is the schema descriptor for trained_at field.
+	documentclassifierDescTrainedAt := documentclassifierFields[5].Descriptor()
+	// documentclassifier.DefaultTrainedAt holds the default value on creation for the trained_at field.
+	documentclassifier.DefaultTrainedAt = documentclassifierDescTrainedAt.Default.(func() time.Time)
+	// documentclassifierDescID is the schema descriptor for id field.
+	documentclassifierDescID := documentclassifierFields[0].Descriptor()
+	// documentclassifier.DefaultID holds the default value on creation for the id field.
+	documentclassifier.DefaultID = documentclassifierDescID.Default.(func() uuid.UUID)
+	documentcontentFields := schema.DocumentContent{}.Fields()
+	_ = documentcontentFields
+	// documentcontentDescEngine is the schema descriptor for engine field.
+	documentcontentDescEngine := documentcontentFields[5].Descriptor()
+	// documentcontent.EngineValidator is a validator for the "engine" field. It is called by the builders before save.
+	documentcontent.EngineValidator = documentcontentDescEngine.Validators[0].(func(string) error)
+	// documentcontentDescCreatedAt is the schema descriptor for created_at field.
+	documentcontentDescCreatedAt := documentcontentFields[7].Descriptor()
+	// documentcontent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	documentcontent.DefaultCreatedAt = documentcontentDescCreatedAt.Default.(func() time.Time)
+	// documentcontentDescUpdatedAt is the schema descriptor for updated_at field.
+	documentcontentDescUpdatedAt := documentcontentFields[8].Descriptor()
+	// documentcontent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	documentcontent.DefaultUpdatedAt = documentcontentDescUpdatedAt.Default.(func() time.Time)
+	// documentcontent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	documentcontent.UpdateDefaultUpdatedAt = documentcontentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// documentcontentDescID is the schema descriptor for id field.
+	documentcontentDescID := documentcontentFields[0].Descriptor()
+	// documentcontent.DefaultID holds the default value on creation for the id field.
+	documentcontent.DefaultID = documentcontentDescID.Default.(func() uuid.UUID)
 	documenttagFields := schema.DocumentTag{}.Fields()
 	_ = documenttagFields
+	// documenttagDescCreatedAt is the schema descriptor for created_at field.
+	documenttagDescCreatedAt := documenttagFields[7].Descriptor()
+	// documenttag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	documenttag.DefaultCreatedAt = documenttagDescCreatedAt.Default.(func() time.Time)
 	// documenttagDescID is the schema descriptor for id field.
 	documenttagDescID := documenttagFields[0].Descriptor()
 	// documenttag.DefaultID holds the default value on creation for the id field.
 	documenttag.DefaultID = documenttagDescID.Default.(func() uuid.UUID)
+	documenttagsuppressionFields := schema.DocumentTagSuppression{}.Fields()
+	_ = documenttagsuppressionFields
+	// documenttagsuppressionDescCreatedAt is the schema descriptor for created_at field.
+	documenttagsuppressionDescCreatedAt := documenttagsuppressionFields[4].Descriptor()
+	// documenttagsuppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	documenttagsuppression.DefaultCreatedAt = documenttagsuppressionDescCreatedAt.Default.(func() time.Time)
+	// documenttagsuppressionDescID is the schema descriptor for id field.
+	documenttagsuppressionDescID := documenttagsuppressionFields[0].Descriptor()
+	// documenttagsuppression.DefaultID holds the default value on creation for the id field.
+	documenttagsuppression.DefaultID = documenttagsuppressionDescID.Default.(func() uuid.UUID)
+	documenttypeFields := schema.DocumentType{}.Fields()
+	_ = documenttypeFields
+	// documenttypeDescName is the schema descriptor for name field.
+	documenttypeDescName := documenttypeFields[2].Descriptor()
+	// documenttype.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	documenttype.NameValidator = documenttypeDescName.Validators[0].(func(string) error)
+	// documenttypeDescCreatedAt is the schema descriptor for created_at field.
+	documenttypeDescCreatedAt := documenttypeFields[6].Descriptor()
+	// documenttype.DefaultCreatedAt holds the default value on creation for the created_at field.
+	documenttype.DefaultCreatedAt = documenttypeDescCreatedAt.Default.(func() time.Time)
+	// documenttypeDescUpdatedAt is the schema descriptor for updated_at field.
+	documenttypeDescUpdatedAt := documenttypeFields[7].Descriptor()
+	// documenttype.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	documenttype.DefaultUpdatedAt = documenttypeDescUpdatedAt.Default.(func() time.Time)
+	// documenttype.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	documenttype.UpdateDefaultUpdatedAt = documenttypeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// documenttypeDescID is the schema descriptor for id field.
+	documenttypeDescID := documenttypeFields[0].Descriptor()
+	// documenttype.DefaultID holds the default value on creation for the id field.
+	documenttype.DefaultID = documenttypeDescID.Default.(func() uuid.UUID)
 	folderFields := schema.Folder{}.Fields()
 	_ = folderFields
 	// folderDescName is the schema descriptor for name field.
@@ -101,12 +181,146 @@ func init() {
 	folderDescID := folderFields[0].Descriptor()
 	// folder.DefaultID holds the default value on creation for the id field.
 	folder.DefaultID = folderDescID.Default.(func() uuid.UUID)
+	jobFields := schema.Job{}.Fields()
+	_ = jobFields
+	// jobDescType is the schema descriptor for type field.
+	jobDescType := jobFields[2].Descriptor()
+	// job.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	job.TypeValidator = jobDescType.Validators[0].(func(string) error)
+	// jobDescProgress is the schema descriptor for progress field.
+	jobDescProgress := jobFields[7].Descriptor()
+	// job.DefaultProgress holds the default value on creation for the progress field.
+	job.DefaultProgress = jobDescProgress.Default.(int)
+	// job.ProgressValidator is a validator for the "progress" field. It is called by the builders before save.
+	job.ProgressValidator = jobDescProgress.Validators[0].(func(int) error)
+	// jobDescTotal is the schema descriptor for total field.
+	jobDescTotal := jobFields[8].Descriptor()
+	// job.DefaultTotal holds the default value on creation for the total field.
+	job.DefaultTotal = jobDescTotal.Default.(int)
+	// job.TotalValidator is a validator for the "total" field. It is called by the builders before save.
+	job.TotalValidator = jobDescTotal.Validators[0].(func(int) error)
+	// jobDescCreatedAt is the schema descriptor for created_at field.
+	jobDescCreatedAt := jobFields[10].Descriptor()
+	// job.DefaultCreatedAt holds the default value on creation for the created_at field.
+	job.DefaultCreatedAt = jobDescCreatedAt.Default.(func() time.Time)
+	// jobDescUpdatedAt is the schema descriptor for updated_at field.
+	jobDescUpdatedAt := jobFields[11].Descriptor()
+	// job.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	job.DefaultUpdatedAt = jobDescUpdatedAt.Default.(func() time.Time)
+	// job.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	job.UpdateDefaultUpdatedAt = jobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// jobDescID is the schema descriptor for id field.
+	jobDescID := jobFields[0].Descriptor()
+	// job.DefaultID holds the default value on creation for the id field.
+	job.DefaultID = jobDescID.Default.(func() uuid.UUID)
+	savedsearchFields := schema.SavedSearch{}.Fields()
+	_ = savedsearchFields
+	// savedsearchDescName is the schema descriptor for name field.
+	savedsearchDescName := savedsearchFields[3].Descriptor()
+	// savedsearch.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	savedsearch.NameValidator = savedsearchDescName.Validators[0].(func(string) error)
+	// savedsearchDescQuery is the schema descriptor for query field.
+	savedsearchDescQuery := savedsearchFields[4].Descriptor()
+	// savedsearch.DefaultQuery holds the default value on creation for the query field.
+	savedsearch.DefaultQuery = savedsearchDescQuery.Default.(string)
+	// savedsearchDescSort is the schema descriptor for sort field.
+	savedsearchDescSort := savedsearchFields[6].Descriptor()
+	// savedsearch.DefaultSort holds the default value on creation for the sort field.
+	savedsearch.DefaultSort = savedsearchDescSort.Default.(string)
+	// savedsearchDescShared is the schema descriptor for shared field.
+	savedsearchDescShared := savedsearchFields[7].Descriptor()
+	// savedsearch.DefaultShared holds the default value on creation for the shared field.
+	savedsearch.DefaultShared = savedsearchDescShared.Default.(bool)
+	// savedsearchDescPinned is the schema descriptor for pinned field.
+	savedsearchDescPinned := savedsearchFields[8].Descriptor()
+	// savedsearch.DefaultPinned holds the default value on creation for the pinned field.
+	savedsearch.DefaultPinned = savedsearchDescPinned.Default.(bool)
+	// savedsearchDescCreatedAt is the schema descriptor for created_at field.
+	savedsearchDescCreatedAt := savedsearchFields[10].Descriptor()
+	// savedsearch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savedsearch.DefaultCreatedAt = savedsearchDescCreatedAt.Default.(func() time.Time)
+	// savedsearchDescUpdatedAt is the schema descriptor for updated_at field.
+	savedsearchDescUpdatedAt := savedsearchFields[11].Descriptor()
+	// savedsearch.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	savedsearch.DefaultUpdatedAt = savedsearchDescUpdatedAt.Default.(func() time.Time)
+	// savedsearch.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	savedsearch.UpdateDefaultUpdatedAt = savedsearchDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// savedsearchDescID is the schema descriptor for id field.
+	savedsearchDescID := savedsearchFields[0].Descriptor()
+	// savedsearch.DefaultID holds the default value on creation for the id field.
+	savedsearch.DefaultID = savedsearchDescID.Default.(func() uuid.UUID)
+	searchindextaskFields := schema.SearchIndexTask{}.Fields()
+	_ = searchindextaskFields
+	// searchindextaskDescAttempts is the schema descriptor for attempts field.
+	searchindextaskDescAttempts := searchindextaskFields[3].Descriptor()
+	// searchindextask.DefaultAttempts holds the default value on creation for the attempts field.
+	searchindextask.DefaultAttempts = searchindextaskDescAttempts.Default.(int)
+	// searchindextask.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	searchindextask.AttemptsValidator = searchindextaskDescAttempts.Validators[0].(func(int) error)
+	// searchindextaskDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	searchindextaskDescNextAttemptAt := searchindextaskFields[5].Descriptor()
+	// searchindextask.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	searchindextask.DefaultNextAttemptAt = searchindextaskDescNextAttemptAt.Default.(func() time.Time)
+	// searchindextaskDescCreatedAt is the schema descriptor for created_at field.
+	searchindextaskDescCreatedAt := searchindextaskFields[6].Descriptor()
+	// searchindextask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	searchindextask.DefaultCreatedAt = searchindextaskDescCreatedAt.Default.(func() time.Time)
+	// searchindextaskDescID is the schema descriptor for id field.
+	searchindextaskDescID := searchindextaskFields[0].Descriptor()
+	// searchindextask.DefaultID holds the default value on creation for the id field.
+	searchindextask.DefaultID = searchindextaskDescID.Default.(func() uuid.UUID)
+	searchlogFields := schema.SearchLog{}.Fields()
+	_ = searchlogFields
+	// searchlogDescMode is the schema descriptor for mode field.
+	searchlogDescMode := searchlogFields[5].Descriptor()
+	// searchlog.DefaultMode holds the default value on creation for the mode field.
+	searchlog.DefaultMode = searchlogDescMode.Default.(string)
+	// searchlogDescSort is the schema descriptor for sort field.
+	searchlogDescSort := searchlogFields[6].Descriptor()
+	// searchlog.DefaultSort holds the default value on creation for the sort field.
+	searchlog.DefaultSort = searchlogDescSort.Default.(string)
+	// searchlogDescResultCount is the schema descriptor for result_count field.
+	searchlogDescResultCount := searchlogFields[7].Descriptor()
+	// searchlog.DefaultResultCount holds the default value on creation for the result_count field.
+	searchlog.DefaultResultCount = searchlogDescResultCount.Default.(int64)
+	// searchlog.ResultCountValidator is a validator for the "result_count" field. It is called by the builders before save.
+	searchlog.ResultCountValidator = searchlogDescResultCount.Validators[0].(func(int64) error)
+	// searchlogDescLatencyMs is the schema descriptor for latency_ms field.
+	searchlogDescLatencyMs := searchlogFields[8].Descriptor()
+	// searchlog.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	searchlog.DefaultLatencyMs = searchlogDescLatencyMs.Default.(int64)
+	// searchlog.LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	searchlog.LatencyMsValidator = searchlogDescLatencyMs.Validators[0].(func(int64) error)
+	// searchlogDescClicks is the schema descriptor for clicks field.
+	searchlogDescClicks := searchlogFields[12].Descriptor()
+	// searchlog.DefaultClicks holds the default value on creation for the clicks field.
+	searchlog.DefaultClicks = searchlogDescClicks.Default.(int)
+	// searchlog.ClicksValidator is a validator for the "clicks" field. It is called by the builders before save.
+	searchlog.ClicksValidator = searchlogDescClicks.Validators[0].(func(int) error)
+	// searchlogDescCreatedAt is the schema descriptor for created_at field.
+	searchlogDescCreatedAt := searchlogFields[13].Descriptor()
+	// searchlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	searchlog.DefaultCreatedAt = searchlogDescCreatedAt.Default.(func() time.Time)
+	// searchlogDescID is the schema descriptor for id field.
+	searchlogDescID := searchlogFields[0].Descriptor()
+	// searchlog.DefaultID holds the default value on creation for the id field.
+	searchlog.DefaultID = searchlogDescID.Default.(func() uuid.UUID)
 	senderFields := schema.Sender{}.Fields()
 	_ = senderFields
 	// senderDescName is the schema descriptor for name field.
 	senderDescName := senderFields[2].Descriptor()
 	// sender.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	sender.NameValidator = senderDescName.Validators[0].(func(string) error)
+	// senderDescCreatedAt is the schema descriptor for created_at field.
+	senderDescCreatedAt := senderFields[12].Descriptor()
+	// sender.DefaultCreatedAt holds the default value on creation for the created_at field.
+	sender.DefaultCreatedAt = senderDescCreatedAt.Default.(func() time.Time)
+	// senderDescUpdatedAt is the schema descriptor for updated_at field.
+	senderDescUpdatedAt := senderFields[13].Descriptor()
+	// sender.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	sender.DefaultUpdatedAt = senderDescUpdatedAt.Default.(func() time.Time)
+	// sender.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	sender.UpdateDefaultUpdatedAt = senderDescUpdatedAt.UpdateDefault.(func() time.Time)
 	// senderDescID is the schema descriptor for id field.
 	senderDescID := senderFields[0].Descriptor()
 	// sender.DefaultID holds the default value on creation for the id field.
@@ -114,13 +328,63 @@ func init() {
 	tagFields := schema.Tag{}.Fields()
 	_ = tagFields
 	// tagDescName is the schema descriptor for name field.
-	tagDescName := tagFields[2].Descriptor()
+	tagDescName := tagFields[4].Descriptor()
 	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
 	// tagDescID is the schema descriptor for id field.
 	tagDescID := tagFields[0].Descriptor()
 	// tag.DefaultID holds the default value on creation for the id field.
 	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
+	taggroupFields := schema.TagGroup{}.Fields()
+	_ = taggroupFields
+	// taggroupDescName is the schema descriptor for name field.
+	taggroupDescName := taggroupFields[2].Descriptor()
+	// taggroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	taggroup.NameValidator = taggroupDescName.Validators[0].(func(string) error)
+	// taggroupDescExclusive is the schema descriptor for exclusive field.
+	taggroupDescExclusive := taggroupFields[3].Descriptor()
+	// taggroup.DefaultExclusive holds the default value on creation for the exclusive field.
+	taggroup.DefaultExclusive = taggroupDescExclusive.Default.(bool)
+	// taggroupDescCreatedAt is the schema descriptor for created_at field.
+	taggroupDescCreatedAt := taggroupFields[4].Descriptor()
+	// taggroup.DefaultCreatedAt holds the default value on creation for the created_at field.
+	taggroup.DefaultCreatedAt = taggroupDescCreatedAt.Default.(func() time.Time)
+	// taggroupDescID is the schema descriptor for id field.
+	taggroupDescID := taggroupFields[0].Descriptor()
+	// taggroup.DefaultID holds the default value on creation for the id field.
+	taggroup.DefaultID = taggroupDescID.Default.(func() uuid.UUID)
+	tagruleFields := schema.TagRule{}.Fields()
+	_ = tagruleFields
+	// tagruleDescName is the schema descriptor for name field.
+	tagruleDescName := tagruleFields[3].Descriptor()
+	// tagrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tagrule.NameValidator = tagruleDescName.Validators[0].(func(string) error)
+	// tagruleDescPriority is the schema descriptor for priority field.
+	tagruleDescPriority := tagruleFields[5].Descriptor()
+	// tagrule.DefaultPriority holds the default value on creation for the priority field.
+	tagrule.DefaultPriority = tagruleDescPriority.Default.(int)
+	// tagruleDescStop is the schema descriptor for stop field.
+	tagruleDescStop := tagruleFields[6].Descriptor()
+	// tagrule.DefaultStop holds the default value on creation for the stop field.
+	tagrule.DefaultStop = tagruleDescStop.Default.(bool)
+	// tagruleDescEnabled is the schema descriptor for enabled field.
+	tagruleDescEnabled := tagruleFields[7].Descriptor()
+	// tagrule.DefaultEnabled holds the default value on creation for the enabled field.
+	tagrule.DefaultEnabled = tagruleDescEnabled.Default.(bool)
+	// tagruleDescCreatedAt is the schema descriptor for created_at field.
+	tagruleDescCreatedAt := tagruleFields[8].Descriptor()
+	// tagrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tagrule.DefaultCreatedAt = tagruleDescCreatedAt.Default.(func() time.Time)
+	// tagruleDescUpdatedAt is the schema descriptor for updated_at field.
+	tagruleDescUpdatedAt := tagruleFields[9].Descriptor()
+	// tagrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tagrule.DefaultUpdatedAt = tagruleDescUpdatedAt.Default.(func() time.Time)
+	// tagrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tagrule.UpdateDefaultUpdatedAt = tagruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tagruleDescID is the schema descriptor for id field.
+	tagruleDescID := tagruleFields[0].Descriptor()
+	// tagrule.DefaultID holds the default value on creation for the id field.
+	tagrule.DefaultID = tagruleDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -135,6 +399,10 @@ func init() {
 	userDescPassword := userFields[3].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescStoreSearchQueries is the schema descriptor for store_search_queries field.
+	userDescStoreSearchQueries := userFields[4].Descriptor()
+	// user.DefaultStoreSearchQueries holds the default value on creation for the store_search_queries field.
+	user.DefaultStoreSearchQueries = userDescStoreSearchQueries.Default.(bool)
 	// userDescID is the schema descriptor for id field.
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/company"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SavedSearchCreate is the builder for creating a SavedSearch entity.
+type SavedSearchCreate struct {
+	config
+	mutation *SavedSearchMutation
+	hooks    []Hook
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_c *SavedSearchCreate) SetCompanyID(v uuid.UUID) *SavedSearchCreate {
+	_c.mutation.SetCompanyID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SavedSearchCreate) SetUserID(v uuid.UUID) *SavedSearchCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SavedSearchCreate) SetName(v string) *SavedSearchCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *SavedSearchCreate) SetQuery(v string) *SavedSearchCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableQuery(v *string) *SavedSearchCreate {
+	if v != nil {
+		_c.SetQuery(*v)
+	}
+	return _c
+}
+
+// SetFilters sets the "filters" field.
+func (_c *SavedSearchCreate) SetFilters(v types.SearchFilters) *SavedSearchCreate {
+	_c.mutation.SetFilters(v)
+	return _c
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableFilters(v *types.SearchFilters) *SavedSearchCreate {
+	if v != nil {
+		_c.SetFilters(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *SavedSearchCreate) SetSort(v string) *SavedSearchCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableSort(v *string) *SavedSearchCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetShared sets the "shared" field.
+func (_c *SavedSearchCreate) SetShared(v bool) *SavedSearchCreate {
+	_c.mutation.SetShared(v)
+	return _c
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableShared(v *bool) *SavedSearchCreate {
+	if v != nil {
+		_c.SetShared(*v)
+	}
+	return _c
+}
+
+// SetPinned sets the "pinned" field.
+func (_c *SavedSearchCreate) SetPinned(v bool) *SavedSearchCreate {
+	_c.mutation.SetPinned(v)
+	return _c
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillablePinned(v *bool) *SavedSearchCreate {
+	if v != nil {
+		_c.SetPinned(*v)
+	}
+	return _c
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (_c *SavedSearchCreate) SetParentFolderID(v uuid.UUID) *SavedSearchCreate {
+	_c.mutation.SetParentFolderID(v)
+	return _c
+}
+
+// SetNillableParentFolderID sets the "parent_folder_id" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableParentFolderID(v *uuid.UUID) *SavedSearchCreate {
+	if v != nil {
+		_c.SetParentFolderID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SavedSearchCreate) SetCreatedAt(v time.Time) *SavedSearchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableCreatedAt(v *time.Time) *SavedSearchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SavedSearchCreate) SetUpdatedAt(v time.Time) *SavedSearchCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableUpdatedAt(v *time.Time) *SavedSearchCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SavedSearchCreate) SetID(v uuid.UUID) *SavedSearchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SavedSearchCreate) SetNillableID(v *uuid.UUID) *SavedSearchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (_c *SavedSearchCreate) SetCompany(v *Company) *SavedSearchCreate {
+	return _c.SetCompanyID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_c *SavedSearchCreate) Mutation() *SavedSearchMutation {
+	return _c.mutation
+}
+
+// Save creates the SavedSearch in the database.
+func (_c *SavedSearchCreate) Save(ctx context.Context) (*SavedSearch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SavedSearchCreate) SaveX(ctx context.Context) *SavedSearch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SavedSearchCreate) defaults() {
+	if _, ok := _c.mutation.Query(); !ok {
+		v := savedsearch.DefaultQuery
+		_c.mutation.SetQuery(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := savedsearch.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		v := savedsearch.DefaultShared
+		_c.mutation.SetShared(v)
+	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		v := savedsearch.DefaultPinned
+		_c.mutation.SetPinned(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := savedsearch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := savedsearch.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := savedsearch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SavedSearchCreate) check() error {
+	if _, ok := _c.mutation.CompanyID(); !ok {
+		return &ValidationError{Name: "company_id", err: errors.New(`ent: missing required field "SavedSearch.company_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "SavedSearch.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "SavedSearch.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Query(); !ok {
+		return &ValidationError{Name: "query", err: errors.New(`ent: missing required field "SavedSearch.query"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "SavedSearch.sort"`)}
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		return &ValidationError{Name: "shared", err: errors.New(`ent: missing required field "SavedSearch.shared"`)}
+	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "SavedSearch.pinned"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SavedSearch.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SavedSearch.updated_at"`)}
+	}
+	if len(_c.mutation.CompanyIDs()) == 0 {
+		return &ValidationError{Name: "company", err: errors.New(`ent: missing required edge "SavedSearch.company"`)}
+	}
+	return nil
+}
+
+func (_c *SavedSearchCreate) sqlSave(ctx context.Context) (*SavedSearch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SavedSearchCreate) createSpec() (*SavedSearch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SavedSearch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(savedsearch.Table, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(savedsearch.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+		_node.Query = value
+	}
+	if value, ok := _c.mutation.Filters(); ok {
+		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeString, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.Shared(); ok {
+		_spec.SetField(savedsearch.FieldShared, field.TypeBool, value)
+		_node.Shared = value
+	}
+	if value, ok := _c.mutation.Pinned(); ok {
+		_spec.SetField(savedsearch.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
+	if value, ok := _c.mutation.ParentFolderID(); ok {
+		_spec.SetField(savedsearch.FieldParentFolderID, field.TypeUUID, value)
+		_node.ParentFolderID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(savedsearch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.CompanyTable,
+			Columns: []string{savedsearch.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CompanyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SavedSearchCreateBulk is the builder for creating many SavedSearch entities in bulk.
+type SavedSearchCreateBulk struct {
+	config
+	err      error
+	builders []*SavedSearchCreate
+}
+
+// Save creates the SavedSearch entities in the database.
+func (_c *SavedSearchCreateBulk) Save(ctx context.Context) ([]*SavedSearch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SavedSearch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SavedSearchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SavedSearchCreateBulk) SaveX(ctx context.Context) []*SavedSearch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SavedSearchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SavedSearchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
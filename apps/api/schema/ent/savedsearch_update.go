@@ -0,0 +1,645 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/company"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/savedsearch"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SavedSearchUpdate is the builder for updating SavedSearch entities.
+type SavedSearchUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (_u *SavedSearchUpdate) Where(ps ...predicate.SavedSearch) *SavedSearchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_u *SavedSearchUpdate) SetCompanyID(v uuid.UUID) *SavedSearchUpdate {
+	_u.mutation.SetCompanyID(v)
+	return _u
+}
+
+// SetNillableCompanyID sets the "company_id" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableCompanyID(v *uuid.UUID) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetCompanyID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SavedSearchUpdate) SetName(v string) *SavedSearchUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableName(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *SavedSearchUpdate) SetQuery(v string) *SavedSearchUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableQuery(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *SavedSearchUpdate) SetFilters(v types.SearchFilters) *SavedSearchUpdate {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableFilters(v *types.SearchFilters) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetFilters(*v)
+	}
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *SavedSearchUpdate) ClearFilters() *SavedSearchUpdate {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SavedSearchUpdate) SetSort(v string) *SavedSearchUpdate {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableSort(v *string) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *SavedSearchUpdate) SetShared(v bool) *SavedSearchUpdate {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableShared(v *bool) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *SavedSearchUpdate) SetPinned(v bool) *SavedSearchUpdate {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillablePinned(v *bool) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (_u *SavedSearchUpdate) SetParentFolderID(v uuid.UUID) *SavedSearchUpdate {
+	_u.mutation.SetParentFolderID(v)
+	return _u
+}
+
+// SetNillableParentFolderID sets the "parent_folder_id" field if the given value is not nil.
+func (_u *SavedSearchUpdate) SetNillableParentFolderID(v *uuid.UUID) *SavedSearchUpdate {
+	if v != nil {
+		_u.SetParentFolderID(*v)
+	}
+	return _u
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (_u *SavedSearchUpdate) ClearParentFolderID() *SavedSearchUpdate {
+	_u.mutation.ClearParentFolderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SavedSearchUpdate) SetUpdatedAt(v time.Time) *SavedSearchUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (_u *SavedSearchUpdate) SetCompany(v *Company) *SavedSearchUpdate {
+	return _u.SetCompanyID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_u *SavedSearchUpdate) Mutation() *SavedSearchMutation {
+	return _u.mutation
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (_u *SavedSearchUpdate) ClearCompany() *SavedSearchUpdate {
+	_u.mutation.ClearCompany()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SavedSearchUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SavedSearchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SavedSearchUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CompanyCleared() && len(_u.mutation.CompanyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.company"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(savedsearch.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(savedsearch.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(savedsearch.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ParentFolderID(); ok {
+		_spec.SetField(savedsearch.FieldParentFolderID, field.TypeUUID, value)
+	}
+	if _u.mutation.ParentFolderIDCleared() {
+		_spec.ClearField(savedsearch.FieldParentFolderID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompanyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.CompanyTable,
+			Columns: []string{savedsearch.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.CompanyTable,
+			Columns: []string{savedsearch.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SavedSearchUpdateOne is the builder for updating a single SavedSearch entity.
+type SavedSearchUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SavedSearchMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_u *SavedSearchUpdateOne) SetCompanyID(v uuid.UUID) *SavedSearchUpdateOne {
+	_u.mutation.SetCompanyID(v)
+	return _u
+}
+
+// SetNillableCompanyID sets the "company_id" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableCompanyID(v *uuid.UUID) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetCompanyID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SavedSearchUpdateOne) SetName(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableName(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *SavedSearchUpdateOne) SetQuery(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableQuery(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *SavedSearchUpdateOne) SetFilters(v types.SearchFilters) *SavedSearchUpdateOne {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableFilters(v *types.SearchFilters) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetFilters(*v)
+	}
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *SavedSearchUpdateOne) ClearFilters() *SavedSearchUpdateOne {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SavedSearchUpdateOne) SetSort(v string) *SavedSearchUpdateOne {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableSort(v *string) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *SavedSearchUpdateOne) SetShared(v bool) *SavedSearchUpdateOne {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableShared(v *bool) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetPinned sets the "pinned" field.
+func (_u *SavedSearchUpdateOne) SetPinned(v bool) *SavedSearchUpdateOne {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillablePinned(v *bool) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
+// SetParentFolderID sets the "parent_folder_id" field.
+func (_u *SavedSearchUpdateOne) SetParentFolderID(v uuid.UUID) *SavedSearchUpdateOne {
+	_u.mutation.SetParentFolderID(v)
+	return _u
+}
+
+// SetNillableParentFolderID sets the "parent_folder_id" field if the given value is not nil.
+func (_u *SavedSearchUpdateOne) SetNillableParentFolderID(v *uuid.UUID) *SavedSearchUpdateOne {
+	if v != nil {
+		_u.SetParentFolderID(*v)
+	}
+	return _u
+}
+
+// ClearParentFolderID clears the value of the "parent_folder_id" field.
+func (_u *SavedSearchUpdateOne) ClearParentFolderID() *SavedSearchUpdateOne {
+	_u.mutation.ClearParentFolderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SavedSearchUpdateOne) SetUpdatedAt(v time.Time) *SavedSearchUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCompany sets the "company" edge to the Company entity.
+func (_u *SavedSearchUpdateOne) SetCompany(v *Company) *SavedSearchUpdateOne {
+	return _u.SetCompanyID(v.ID)
+}
+
+// Mutation returns the SavedSearchMutation object of the builder.
+func (_u *SavedSearchUpdateOne) Mutation() *SavedSearchMutation {
+	return _u.mutation
+}
+
+// ClearCompany clears the "company" edge to the Company entity.
+func (_u *SavedSearchUpdateOne) ClearCompany() *SavedSearchUpdateOne {
+	_u.mutation.ClearCompany()
+	return _u
+}
+
+// Where appends a list predicates to the SavedSearchUpdate builder.
+func (_u *SavedSearchUpdateOne) Where(ps ...predicate.SavedSearch) *SavedSearchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SavedSearchUpdateOne) Select(field string, fields ...string) *SavedSearchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SavedSearch entity.
+func (_u *SavedSearchUpdateOne) Save(ctx context.Context) (*SavedSearch, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SavedSearchUpdateOne) SaveX(ctx context.Context) *SavedSearch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SavedSearchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SavedSearchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SavedSearchUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := savedsearch.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SavedSearchUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := savedsearch.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "SavedSearch.name": %w`, err)}
+		}
+	}
+	if _u.mutation.CompanyCleared() && len(_u.mutation.CompanyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SavedSearch.company"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SavedSearchUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SavedSearchUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SavedSearchUpdateOne) sqlSave(ctx context.Context) (_node *SavedSearch, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(savedsearch.Table, savedsearch.Columns, sqlgraph.NewFieldSpec(savedsearch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SavedSearch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, savedsearch.FieldID)
+		for _, f := range fields {
+			if !savedsearch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != savedsearch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(savedsearch.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(savedsearch.FieldQuery, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(savedsearch.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(savedsearch.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(savedsearch.FieldSort, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(savedsearch.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(savedsearch.FieldPinned, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ParentFolderID(); ok {
+		_spec.SetField(savedsearch.FieldParentFolderID, field.TypeUUID, value)
+	}
+	if _u.mutation.ParentFolderIDCleared() {
+		_spec.ClearField(savedsearch.FieldParentFolderID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(savedsearch.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompanyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.CompanyTable,
+			Columns: []string{savedsearch.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CompanyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   savedsearch.CompanyTable,
+			Columns: []string{savedsearch.CompanyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(company.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SavedSearch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{savedsearch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
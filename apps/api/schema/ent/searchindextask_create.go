@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/searchindextask"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SearchIndexTaskCreate is the builder for creating a SearchIndexTask entity.
+type SearchIndexTaskCreate struct {
+	config
+	mutation *SearchIndexTaskMutation
+	hooks    []Hook
+}
+
+// SetDocumentID sets the "document_id" field.
+func (_c *SearchIndexTaskCreate) SetDocumentID(v uuid.UUID) *SearchIndexTaskCreate {
+	_c.mutation.SetDocumentID(v)
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *SearchIndexTaskCreate) SetOperation(v searchindextask.Operation) *SearchIndexTaskCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *SearchIndexTaskCreate) SetAttempts(v int) *SearchIndexTaskCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *SearchIndexTaskCreate) SetNillableAttempts(v *int) *SearchIndexTaskCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *SearchIndexTaskCreate) SetLastError(v string) *SearchIndexTaskCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *SearchIndexTaskCreate) SetNillableLastError(v *string) *SearchIndexTaskCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *SearchIndexTaskCreate) SetNextAttemptAt(v time.Time) *SearchIndexTaskCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *SearchIndexTaskCreate) SetNillableNextAttemptAt(v *time.Time) *SearchIndexTaskCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SearchIndexTaskCreate) SetCreatedAt(v time.Time) *SearchIndexTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SearchIndexTaskCreate) SetNillableCreatedAt(v *time.Time) *SearchIndexTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SearchIndexTaskCreate) SetID(v uuid.UUID) *SearchIndexTaskCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SearchIndexTaskCreate) SetNillableID(v *uuid.UUID) *SearchIndexTaskCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SearchIndexTaskMutation object of the builder.
+func (_c *SearchIndexTaskCreate) Mutation() *SearchIndexTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the SearchIndexTask in the database.
+func (_c *SearchIndexTaskCreate) Save(ctx context.Context) (*SearchIndexTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SearchIndexTaskCreate) SaveX(ctx context.Context) *SearchIndexTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchIndexTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchIndexTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SearchIndexTaskCreate) defaults() {
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := searchindextask.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		v := searchindextask.DefaultNextAttemptAt()
+		_c.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := searchindextask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := searchindextask.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SearchIndexTaskCreate) check() error {
+	if _, ok := _c.mutation.DocumentID(); !ok {
+		return &ValidationError{Name: "document_id", err: errors.New(`ent: missing required field "SearchIndexTask.document_id"`)}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "SearchIndexTask.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := searchindextask.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "SearchIndexTask.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "SearchIndexTask.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := searchindextask.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "SearchIndexTask.attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.NextAttemptAt(); !ok {
+		return &ValidationError{Name: "next_attempt_at", err: errors.New(`ent: missing required field "SearchIndexTask.next_attempt_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SearchIndexTask.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *SearchIndexTaskCreate) sqlSave(ctx context.Context) (*SearchIndexTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SearchIndexTaskCreate) createSpec() (*SearchIndexTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchIndexTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(searchindextask.Table, sqlgraph.NewFieldSpec(searchindextask.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.DocumentID(); ok {
+		_spec.SetField(searchindextask.FieldDocumentID, field.TypeUUID, value)
+		_node.DocumentID = value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(searchindextask.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(searchindextask.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(searchindextask.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(searchindextask.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(searchindextask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchIndexTaskCreateBulk is the builder for creating many SearchIndexTask entities in bulk.
+type SearchIndexTaskCreateBulk struct {
+	config
+	err      error
+	builders []*SearchIndexTaskCreate
+}
+
+// Save creates the SearchIndexTask entities in the database.
+func (_c *SearchIndexTaskCreateBulk) Save(ctx context.Context) ([]*SearchIndexTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SearchIndexTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchIndexTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SearchIndexTaskCreateBulk) SaveX(ctx context.Context) []*SearchIndexTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchIndexTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchIndexTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/searchindextask"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SearchIndexTaskUpdate is the builder for updating SearchIndexTask entities.
+type SearchIndexTaskUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SearchIndexTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SearchIndexTaskUpdate builder.
+func (_u *SearchIndexTaskUpdate) Where(ps ...predicate.SearchIndexTask) *SearchIndexTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SearchIndexTaskUpdate) SetAttempts(v int) *SearchIndexTaskUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdate) SetNillableAttempts(v *int) *SearchIndexTaskUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SearchIndexTaskUpdate) AddAttempts(v int) *SearchIndexTaskUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *SearchIndexTaskUpdate) SetLastError(v string) *SearchIndexTaskUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdate) SetNillableLastError(v *string) *SearchIndexTaskUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *SearchIndexTaskUpdate) ClearLastError() *SearchIndexTaskUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *SearchIndexTaskUpdate) SetNextAttemptAt(v time.Time) *SearchIndexTaskUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdate) SetNillableNextAttemptAt(v *time.Time) *SearchIndexTaskUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SearchIndexTaskMutation object of the builder.
+func (_u *SearchIndexTaskUpdate) Mutation() *SearchIndexTaskMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SearchIndexTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchIndexTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SearchIndexTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchIndexTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchIndexTaskUpdate) check() error {
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := searchindextask.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "SearchIndexTask.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SearchIndexTaskUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchIndexTaskUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SearchIndexTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchindextask.Table, searchindextask.Columns, sqlgraph.NewFieldSpec(searchindextask.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(searchindextask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(searchindextask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(searchindextask.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(searchindextask.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(searchindextask.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchindextask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SearchIndexTaskUpdateOne is the builder for updating a single SearchIndexTask entity.
+type SearchIndexTaskUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SearchIndexTaskMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *SearchIndexTaskUpdateOne) SetAttempts(v int) *SearchIndexTaskUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdateOne) SetNillableAttempts(v *int) *SearchIndexTaskUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *SearchIndexTaskUpdateOne) AddAttempts(v int) *SearchIndexTaskUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *SearchIndexTaskUpdateOne) SetLastError(v string) *SearchIndexTaskUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdateOne) SetNillableLastError(v *string) *SearchIndexTaskUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *SearchIndexTaskUpdateOne) ClearLastError() *SearchIndexTaskUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *SearchIndexTaskUpdateOne) SetNextAttemptAt(v time.Time) *SearchIndexTaskUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *SearchIndexTaskUpdateOne) SetNillableNextAttemptAt(v *time.Time) *SearchIndexTaskUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SearchIndexTaskMutation object of the builder.
+func (_u *SearchIndexTaskUpdateOne) Mutation() *SearchIndexTaskMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SearchIndexTaskUpdate builder.
+func (_u *SearchIndexTaskUpdateOne) Where(ps ...predicate.SearchIndexTask) *SearchIndexTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SearchIndexTaskUpdateOne) Select(field string, fields ...string) *SearchIndexTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SearchIndexTask entity.
+func (_u *SearchIndexTaskUpdateOne) Save(ctx context.Context) (*SearchIndexTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchIndexTaskUpdateOne) SaveX(ctx context.Context) *SearchIndexTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SearchIndexTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchIndexTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchIndexTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := searchindextask.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "SearchIndexTask.attempts": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SearchIndexTaskUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchIndexTaskUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SearchIndexTaskUpdateOne) sqlSave(ctx context.Context) (_node *SearchIndexTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchindextask.Table, searchindextask.Columns, sqlgraph.NewFieldSpec(searchindextask.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchIndexTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchindextask.FieldID)
+		for _, f := range fields {
+			if !searchindextask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchindextask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(searchindextask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(searchindextask.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(searchindextask.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(searchindextask.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(searchindextask.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SearchIndexTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchindextask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"techmind/schema/ent/searchlog"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// SearchLog is the model entity for the SearchLog schema.
+type SearchLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CompanyID holds the value of the "company_id" field.
+	CompanyID uuid.UUID `json:"company_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// Query holds the value of the "query" field.
+	Query *string `json:"query,omitempty"`
+	// Filters holds the value of the "filters" field.
+	Filters types.SearchFilters `json:"filters,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// Sort holds the value of the "sort" field.
+	Sort string `json:"sort,omitempty"`
+	// ResultCount holds the value of the "result_count" field.
+	ResultCount int64 `json:"result_count,omitempty"`
+	// LatencyMs holds the value of the "latency_ms" field.
+	LatencyMs int64 `json:"latency_ms,omitempty"`
+	// ClickedDocumentID holds the value of the "clicked_document_id" field.
+	ClickedDocumentID *uuid.UUID `json:"clicked_document_id,omitempty"`
+	// ClickedPosition holds the value of the "clicked_position" field.
+	ClickedPosition *int `json:"clicked_position,omitempty"`
+	// ClickedAt holds the value of the "clicked_at" field.
+	ClickedAt *time.Time `json:"clicked_at,omitempty"`
+	// Clicks holds the value of the "clicks" field.
+	Clicks int `json:"clicks,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SearchLog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case searchlog.FieldUserID, searchlog.FieldClickedDocumentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case searchlog.FieldFilters:
+			values[i] = new([]byte)
+		case searchlog.FieldResultCount, searchlog.FieldLatencyMs, searchlog.FieldClickedPosition, searchlog.FieldClicks:
+			values[i] = new(sql.NullInt64)
+		case searchlog.FieldQuery, searchlog.FieldMode, searchlog.FieldSort:
+			values[i] = new(sql.NullString)
+		case searchlog.FieldClickedAt, searchlog.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case searchlog.FieldID, searchlog.FieldCompanyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SearchLog fields.
+func (_m *SearchLog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case searchlog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case searchlog.FieldCompanyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field company_id", values[i])
+			} else if value != nil {
+				_m.CompanyID = *value
+			}
+		case searchlog.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case searchlog.FieldQuery:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field query", values[i])
+			} else if value.Valid {
+				_m.Query = new(string)
+				*_m.Query = value.String
+			}
+		case searchlog.FieldFilters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field filters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Filters); err != nil {
+					return fmt.Errorf("unmarshal field filters: %w", err)
+				}
+			}
+		case searchlog.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = value.String
+			}
+		case searchlog.FieldSort:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sort", values[i])
+			} else if value.Valid {
+				_m.Sort = value.String
+			}
+		case searchlog.FieldResultCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field result_count", values[i])
+			} else if value.Valid {
+				_m.ResultCount = value.Int64
+			}
+		case searchlog.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				_m.LatencyMs = value.Int64
+			}
+		case searchlog.FieldClickedDocumentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field clicked_document_id", values[i])
+			} else if value.Valid {
+				_m.ClickedDocumentID = new(uuid.UUID)
+				*_m.ClickedDocumentID = *value.S.(*uuid.UUID)
+			}
+		case searchlog.FieldClickedPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clicked_position", values[i])
+			} else if value.Valid {
+				_m.ClickedPosition = new(int)
+				*_m.ClickedPosition = int(value.Int64)
+			}
+		case searchlog.FieldClickedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field clicked_at", values[i])
+			} else if value.Valid {
+				_m.ClickedAt = new(time.Time)
+				*_m.ClickedAt = value.Time
+			}
+		case searchlog.FieldClicks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field clicks", values[i])
+			} else if value.Valid {
+				_m.Clicks = int(value.Int64)
+			}
+		case searchlog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SearchLog.
+// This includes values selected through modifiers, order, etc.
+func (_m *SearchLog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SearchLog.
+// Note that you need to call SearchLog.Unwrap() before calling this method if this SearchLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SearchLog) Update() *SearchLogUpdateOne {
+	return NewSearchLogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SearchLog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SearchLog) Unwrap() *SearchLog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SearchLog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SearchLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("SearchLog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("company_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CompanyID))
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Query; v != nil {
+		builder.WriteString("query=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Filters))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(_m.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("sort=")
+	builder.WriteString(_m.Sort)
+	builder.WriteString(", ")
+	builder.WriteString("result_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ResultCount))
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LatencyMs))
+	builder.WriteString(", ")
+	if v := _m.ClickedDocumentID; v != nil {
+		builder.WriteString("clicked_document_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClickedPosition; v != nil {
+		builder.WriteString("clicked_position=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClickedAt; v != nil {
+		builder.WriteString("clicked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("clicks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Clicks))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SearchLogs is a parsable slice of SearchLog.
+type SearchLogs []*SearchLog
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchlog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the searchlog type in the database.
+	Label = "search_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCompanyID holds the string denoting the company_id field in the database.
+	FieldCompanyID = "company_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldQuery holds the string denoting the query field in the database.
+	FieldQuery = "query"
+	// FieldFilters holds the string denoting the filters field in the database.
+	FieldFilters = "filters"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldSort holds the string denoting the sort field in the database.
+	FieldSort = "sort"
+	// FieldResultCount holds the string denoting the result_count field in the database.
+	FieldResultCount = "result_count"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldClickedDocumentID holds the string denoting the clicked_document_id field in the database.
+	FieldClickedDocumentID = "clicked_document_id"
+	// FieldClickedPosition holds the string denoting the clicked_position field in the database.
+	FieldClickedPosition = "clicked_position"
+	// FieldClickedAt holds the string denoting the clicked_at field in the database.
+	FieldClickedAt = "clicked_at"
+	// FieldClicks holds the string denoting the clicks field in the database.
+	FieldClicks = "clicks"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the searchlog in the database.
+	Table = "search_logs"
+)
+
+// Columns holds all SQL columns for searchlog fields.
+var Columns = []string{
+	FieldID,
+	FieldCompanyID,
+	FieldUserID,
+	FieldQuery,
+	FieldFilters,
+	FieldMode,
+	FieldSort,
+	FieldResultCount,
+	FieldLatencyMs,
+	FieldClickedDocumentID,
+	FieldClickedPosition,
+	FieldClickedAt,
+	FieldClicks,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode string
+	// DefaultSort holds the default value on creation for the "sort" field.
+	DefaultSort string
+	// DefaultResultCount holds the default value on creation for the "result_count" field.
+	DefaultResultCount int64
+	// ResultCountValidator is a validator for the "result_count" field. It is called by the builders before save.
+	ResultCountValidator func(int64) error
+	// DefaultLatencyMs holds the default value on creation for the "latency_ms" field.
+	DefaultLatencyMs int64
+	// LatencyMsValidator is a validator for the "latency_ms" field. It is called by the builders before save.
+	LatencyMsValidator func(int64) error
+	// DefaultClicks holds the default value on creation for the "clicks" field.
+	DefaultClicks int
+	// ClicksValidator is a validator for the "clicks" field. It is called by the builders before save.
+	ClicksValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SearchLog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCompanyID orders the results by the company_id field.
+func ByCompanyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompanyID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByQuery orders the results by the query field.
+func ByQuery(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuery, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// BySort orders the results by the sort field.
+func BySort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSort, opts...).ToFunc()
+}
+
+// ByResultCount orders the results by the result_count field.
+func ByResultCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultCount, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// ByClickedDocumentID orders the results by the clicked_document_id field.
+func ByClickedDocumentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickedDocumentID, opts...).ToFunc()
+}
+
+// ByClickedPosition orders the results by the clicked_position field.
+func ByClickedPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickedPosition, opts...).ToFunc()
+}
+
+// ByClickedAt orders the results by the clicked_at field.
+func ByClickedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClickedAt, opts...).ToFunc()
+}
+
+// ByClicks orders the results by the clicks field.
+func ByClicks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClicks, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
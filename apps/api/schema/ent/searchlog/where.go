@@ -0,0 +1,746 @@
+// Code generated by ent, DO NOT EDIT.
+
+package searchlog
+
+import (
+	"techmind/schema/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldID, id))
+}
+
+// CompanyID applies equality check predicate on the "company_id" field. It's identical to CompanyIDEQ.
+func CompanyID(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCompanyID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// Query applies equality check predicate on the "query" field. It's identical to QueryEQ.
+func Query(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldQuery, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldMode, v))
+}
+
+// Sort applies equality check predicate on the "sort" field. It's identical to SortEQ.
+func Sort(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldSort, v))
+}
+
+// ResultCount applies equality check predicate on the "result_count" field. It's identical to ResultCountEQ.
+func ResultCount(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldResultCount, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// ClickedDocumentID applies equality check predicate on the "clicked_document_id" field. It's identical to ClickedDocumentIDEQ.
+func ClickedDocumentID(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedDocumentID, v))
+}
+
+// ClickedPosition applies equality check predicate on the "clicked_position" field. It's identical to ClickedPositionEQ.
+func ClickedPosition(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedPosition, v))
+}
+
+// ClickedAt applies equality check predicate on the "clicked_at" field. It's identical to ClickedAtEQ.
+func ClickedAt(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// Clicks applies equality check predicate on the "clicks" field. It's identical to ClicksEQ.
+func Clicks(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClicks, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CompanyIDEQ applies the EQ predicate on the "company_id" field.
+func CompanyIDEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCompanyID, v))
+}
+
+// CompanyIDNEQ applies the NEQ predicate on the "company_id" field.
+func CompanyIDNEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldCompanyID, v))
+}
+
+// CompanyIDIn applies the In predicate on the "company_id" field.
+func CompanyIDIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldCompanyID, vs...))
+}
+
+// CompanyIDNotIn applies the NotIn predicate on the "company_id" field.
+func CompanyIDNotIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldCompanyID, vs...))
+}
+
+// CompanyIDGT applies the GT predicate on the "company_id" field.
+func CompanyIDGT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldCompanyID, v))
+}
+
+// CompanyIDGTE applies the GTE predicate on the "company_id" field.
+func CompanyIDGTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldCompanyID, v))
+}
+
+// CompanyIDLT applies the LT predicate on the "company_id" field.
+func CompanyIDLT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldCompanyID, v))
+}
+
+// CompanyIDLTE applies the LTE predicate on the "company_id" field.
+func CompanyIDLTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldCompanyID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldUserID))
+}
+
+// QueryEQ applies the EQ predicate on the "query" field.
+func QueryEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldQuery, v))
+}
+
+// QueryNEQ applies the NEQ predicate on the "query" field.
+func QueryNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldQuery, v))
+}
+
+// QueryIn applies the In predicate on the "query" field.
+func QueryIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldQuery, vs...))
+}
+
+// QueryNotIn applies the NotIn predicate on the "query" field.
+func QueryNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldQuery, vs...))
+}
+
+// QueryGT applies the GT predicate on the "query" field.
+func QueryGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldQuery, v))
+}
+
+// QueryGTE applies the GTE predicate on the "query" field.
+func QueryGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldQuery, v))
+}
+
+// QueryLT applies the LT predicate on the "query" field.
+func QueryLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldQuery, v))
+}
+
+// QueryLTE applies the LTE predicate on the "query" field.
+func QueryLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldQuery, v))
+}
+
+// QueryContains applies the Contains predicate on the "query" field.
+func QueryContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldQuery, v))
+}
+
+// QueryHasPrefix applies the HasPrefix predicate on the "query" field.
+func QueryHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldQuery, v))
+}
+
+// QueryHasSuffix applies the HasSuffix predicate on the "query" field.
+func QueryHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldQuery, v))
+}
+
+// QueryIsNil applies the IsNil predicate on the "query" field.
+func QueryIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldQuery))
+}
+
+// QueryNotNil applies the NotNil predicate on the "query" field.
+func QueryNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldQuery))
+}
+
+// QueryEqualFold applies the EqualFold predicate on the "query" field.
+func QueryEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldQuery, v))
+}
+
+// QueryContainsFold applies the ContainsFold predicate on the "query" field.
+func QueryContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldQuery, v))
+}
+
+// FiltersIsNil applies the IsNil predicate on the "filters" field.
+func FiltersIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldFilters))
+}
+
+// FiltersNotNil applies the NotNil predicate on the "filters" field.
+func FiltersNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldFilters))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldMode, v))
+}
+
+// SortEQ applies the EQ predicate on the "sort" field.
+func SortEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldSort, v))
+}
+
+// SortNEQ applies the NEQ predicate on the "sort" field.
+func SortNEQ(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldSort, v))
+}
+
+// SortIn applies the In predicate on the "sort" field.
+func SortIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldSort, vs...))
+}
+
+// SortNotIn applies the NotIn predicate on the "sort" field.
+func SortNotIn(vs ...string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldSort, vs...))
+}
+
+// SortGT applies the GT predicate on the "sort" field.
+func SortGT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldSort, v))
+}
+
+// SortGTE applies the GTE predicate on the "sort" field.
+func SortGTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldSort, v))
+}
+
+// SortLT applies the LT predicate on the "sort" field.
+func SortLT(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldSort, v))
+}
+
+// SortLTE applies the LTE predicate on the "sort" field.
+func SortLTE(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldSort, v))
+}
+
+// SortContains applies the Contains predicate on the "sort" field.
+func SortContains(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContains(FieldSort, v))
+}
+
+// SortHasPrefix applies the HasPrefix predicate on the "sort" field.
+func SortHasPrefix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasPrefix(FieldSort, v))
+}
+
+// SortHasSuffix applies the HasSuffix predicate on the "sort" field.
+func SortHasSuffix(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldHasSuffix(FieldSort, v))
+}
+
+// SortEqualFold applies the EqualFold predicate on the "sort" field.
+func SortEqualFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEqualFold(FieldSort, v))
+}
+
+// SortContainsFold applies the ContainsFold predicate on the "sort" field.
+func SortContainsFold(v string) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldContainsFold(FieldSort, v))
+}
+
+// ResultCountEQ applies the EQ predicate on the "result_count" field.
+func ResultCountEQ(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldResultCount, v))
+}
+
+// ResultCountNEQ applies the NEQ predicate on the "result_count" field.
+func ResultCountNEQ(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldResultCount, v))
+}
+
+// ResultCountIn applies the In predicate on the "result_count" field.
+func ResultCountIn(vs ...int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldResultCount, vs...))
+}
+
+// ResultCountNotIn applies the NotIn predicate on the "result_count" field.
+func ResultCountNotIn(vs ...int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldResultCount, vs...))
+}
+
+// ResultCountGT applies the GT predicate on the "result_count" field.
+func ResultCountGT(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldResultCount, v))
+}
+
+// ResultCountGTE applies the GTE predicate on the "result_count" field.
+func ResultCountGTE(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldResultCount, v))
+}
+
+// ResultCountLT applies the LT predicate on the "result_count" field.
+func ResultCountLT(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldResultCount, v))
+}
+
+// ResultCountLTE applies the LTE predicate on the "result_count" field.
+func ResultCountLTE(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldResultCount, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int64) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// ClickedDocumentIDEQ applies the EQ predicate on the "clicked_document_id" field.
+func ClickedDocumentIDEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDNEQ applies the NEQ predicate on the "clicked_document_id" field.
+func ClickedDocumentIDNEQ(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDIn applies the In predicate on the "clicked_document_id" field.
+func ClickedDocumentIDIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldClickedDocumentID, vs...))
+}
+
+// ClickedDocumentIDNotIn applies the NotIn predicate on the "clicked_document_id" field.
+func ClickedDocumentIDNotIn(vs ...uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldClickedDocumentID, vs...))
+}
+
+// ClickedDocumentIDGT applies the GT predicate on the "clicked_document_id" field.
+func ClickedDocumentIDGT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDGTE applies the GTE predicate on the "clicked_document_id" field.
+func ClickedDocumentIDGTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDLT applies the LT predicate on the "clicked_document_id" field.
+func ClickedDocumentIDLT(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDLTE applies the LTE predicate on the "clicked_document_id" field.
+func ClickedDocumentIDLTE(v uuid.UUID) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldClickedDocumentID, v))
+}
+
+// ClickedDocumentIDIsNil applies the IsNil predicate on the "clicked_document_id" field.
+func ClickedDocumentIDIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldClickedDocumentID))
+}
+
+// ClickedDocumentIDNotNil applies the NotNil predicate on the "clicked_document_id" field.
+func ClickedDocumentIDNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldClickedDocumentID))
+}
+
+// ClickedPositionEQ applies the EQ predicate on the "clicked_position" field.
+func ClickedPositionEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedPosition, v))
+}
+
+// ClickedPositionNEQ applies the NEQ predicate on the "clicked_position" field.
+func ClickedPositionNEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldClickedPosition, v))
+}
+
+// ClickedPositionIn applies the In predicate on the "clicked_position" field.
+func ClickedPositionIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldClickedPosition, vs...))
+}
+
+// ClickedPositionNotIn applies the NotIn predicate on the "clicked_position" field.
+func ClickedPositionNotIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldClickedPosition, vs...))
+}
+
+// ClickedPositionGT applies the GT predicate on the "clicked_position" field.
+func ClickedPositionGT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldClickedPosition, v))
+}
+
+// ClickedPositionGTE applies the GTE predicate on the "clicked_position" field.
+func ClickedPositionGTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldClickedPosition, v))
+}
+
+// ClickedPositionLT applies the LT predicate on the "clicked_position" field.
+func ClickedPositionLT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldClickedPosition, v))
+}
+
+// ClickedPositionLTE applies the LTE predicate on the "clicked_position" field.
+func ClickedPositionLTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldClickedPosition, v))
+}
+
+// ClickedPositionIsNil applies the IsNil predicate on the "clicked_position" field.
+func ClickedPositionIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldClickedPosition))
+}
+
+// ClickedPositionNotNil applies the NotNil predicate on the "clicked_position" field.
+func ClickedPositionNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldClickedPosition))
+}
+
+// ClickedAtEQ applies the EQ predicate on the "clicked_at" field.
+func ClickedAtEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClickedAt, v))
+}
+
+// ClickedAtNEQ applies the NEQ predicate on the "clicked_at" field.
+func ClickedAtNEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldClickedAt, v))
+}
+
+// ClickedAtIn applies the In predicate on the "clicked_at" field.
+func ClickedAtIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtNotIn applies the NotIn predicate on the "clicked_at" field.
+func ClickedAtNotIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldClickedAt, vs...))
+}
+
+// ClickedAtGT applies the GT predicate on the "clicked_at" field.
+func ClickedAtGT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldClickedAt, v))
+}
+
+// ClickedAtGTE applies the GTE predicate on the "clicked_at" field.
+func ClickedAtGTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldClickedAt, v))
+}
+
+// ClickedAtLT applies the LT predicate on the "clicked_at" field.
+func ClickedAtLT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldClickedAt, v))
+}
+
+// ClickedAtLTE applies the LTE predicate on the "clicked_at" field.
+func ClickedAtLTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldClickedAt, v))
+}
+
+// ClickedAtIsNil applies the IsNil predicate on the "clicked_at" field.
+func ClickedAtIsNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIsNull(FieldClickedAt))
+}
+
+// ClickedAtNotNil applies the NotNil predicate on the "clicked_at" field.
+func ClickedAtNotNil() predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotNull(FieldClickedAt))
+}
+
+// ClicksEQ applies the EQ predicate on the "clicks" field.
+func ClicksEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldClicks, v))
+}
+
+// ClicksNEQ applies the NEQ predicate on the "clicks" field.
+func ClicksNEQ(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldClicks, v))
+}
+
+// ClicksIn applies the In predicate on the "clicks" field.
+func ClicksIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldClicks, vs...))
+}
+
+// ClicksNotIn applies the NotIn predicate on the "clicks" field.
+func ClicksNotIn(vs ...int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldClicks, vs...))
+}
+
+// ClicksGT applies the GT predicate on the "clicks" field.
+func ClicksGT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldClicks, v))
+}
+
+// ClicksGTE applies the GTE predicate on the "clicks" field.
+func ClicksGTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldClicks, v))
+}
+
+// ClicksLT applies the LT predicate on the "clicks" field.
+func ClicksLT(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldClicks, v))
+}
+
+// ClicksLTE applies the LTE predicate on the "clicks" field.
+func ClicksLTE(v int) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldClicks, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SearchLog {
+	return predicate.SearchLog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SearchLog) predicate.SearchLog {
+	return predicate.SearchLog(sql.NotPredicates(p))
+}
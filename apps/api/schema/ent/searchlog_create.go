@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/searchlog"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SearchLogCreate is the builder for creating a SearchLog entity.
+type SearchLogCreate struct {
+	config
+	mutation *SearchLogMutation
+	hooks    []Hook
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_c *SearchLogCreate) SetCompanyID(v uuid.UUID) *SearchLogCreate {
+	_c.mutation.SetCompanyID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *SearchLogCreate) SetUserID(v uuid.UUID) *SearchLogCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableUserID(v *uuid.UUID) *SearchLogCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetQuery sets the "query" field.
+func (_c *SearchLogCreate) SetQuery(v string) *SearchLogCreate {
+	_c.mutation.SetQuery(v)
+	return _c
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableQuery(v *string) *SearchLogCreate {
+	if v != nil {
+		_c.SetQuery(*v)
+	}
+	return _c
+}
+
+// SetFilters sets the "filters" field.
+func (_c *SearchLogCreate) SetFilters(v types.SearchFilters) *SearchLogCreate {
+	_c.mutation.SetFilters(v)
+	return _c
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableFilters(v *types.SearchFilters) *SearchLogCreate {
+	if v != nil {
+		_c.SetFilters(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *SearchLogCreate) SetMode(v string) *SearchLogCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableMode(v *string) *SearchLogCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetSort sets the "sort" field.
+func (_c *SearchLogCreate) SetSort(v string) *SearchLogCreate {
+	_c.mutation.SetSort(v)
+	return _c
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableSort(v *string) *SearchLogCreate {
+	if v != nil {
+		_c.SetSort(*v)
+	}
+	return _c
+}
+
+// SetResultCount sets the "result_count" field.
+func (_c *SearchLogCreate) SetResultCount(v int64) *SearchLogCreate {
+	_c.mutation.SetResultCount(v)
+	return _c
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableResultCount(v *int64) *SearchLogCreate {
+	if v != nil {
+		_c.SetResultCount(*v)
+	}
+	return _c
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_c *SearchLogCreate) SetLatencyMs(v int64) *SearchLogCreate {
+	_c.mutation.SetLatencyMs(v)
+	return _c
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableLatencyMs(v *int64) *SearchLogCreate {
+	if v != nil {
+		_c.SetLatencyMs(*v)
+	}
+	return _c
+}
+
+// SetClickedDocumentID sets the "clicked_document_id" field.
+func (_c *SearchLogCreate) SetClickedDocumentID(v uuid.UUID) *SearchLogCreate {
+	_c.mutation.SetClickedDocumentID(v)
+	return _c
+}
+
+// SetNillableClickedDocumentID sets the "clicked_document_id" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableClickedDocumentID(v *uuid.UUID) *SearchLogCreate {
+	if v != nil {
+		_c.SetClickedDocumentID(*v)
+	}
+	return _c
+}
+
+// SetClickedPosition sets the "clicked_position" field.
+func (_c *SearchLogCreate) SetClickedPosition(v int) *SearchLogCreate {
+	_c.mutation.SetClickedPosition(v)
+	return _c
+}
+
+// SetNillableClickedPosition sets the "clicked_position" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableClickedPosition(v *int) *SearchLogCreate {
+	if v != nil {
+		_c.SetClickedPosition(*v)
+	}
+	return _c
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_c *SearchLogCreate) SetClickedAt(v time.Time) *SearchLogCreate {
+	_c.mutation.SetClickedAt(v)
+	return _c
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableClickedAt(v *time.Time) *SearchLogCreate {
+	if v != nil {
+		_c.SetClickedAt(*v)
+	}
+	return _c
+}
+
+// SetClicks sets the "clicks" field.
+func (_c *SearchLogCreate) SetClicks(v int) *SearchLogCreate {
+	_c.mutation.SetClicks(v)
+	return _c
+}
+
+// SetNillableClicks sets the "clicks" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableClicks(v *int) *SearchLogCreate {
+	if v != nil {
+		_c.SetClicks(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SearchLogCreate) SetCreatedAt(v time.Time) *SearchLogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableCreatedAt(v *time.Time) *SearchLogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SearchLogCreate) SetID(v uuid.UUID) *SearchLogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SearchLogCreate) SetNillableID(v *uuid.UUID) *SearchLogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SearchLogMutation object of the builder.
+func (_c *SearchLogCreate) Mutation() *SearchLogMutation {
+	return _c.mutation
+}
+
+// Save creates the SearchLog in the database.
+func (_c *SearchLogCreate) Save(ctx context.Context) (*SearchLog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SearchLogCreate) SaveX(ctx context.Context) *SearchLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchLogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchLogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SearchLogCreate) defaults() {
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := searchlog.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		v := searchlog.DefaultSort
+		_c.mutation.SetSort(v)
+	}
+	if _, ok := _c.mutation.ResultCount(); !ok {
+		v := searchlog.DefaultResultCount
+		_c.mutation.SetResultCount(v)
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		v := searchlog.DefaultLatencyMs
+		_c.mutation.SetLatencyMs(v)
+	}
+	if _, ok := _c.mutation.Clicks(); !ok {
+		v := searchlog.DefaultClicks
+		_c.mutation.SetClicks(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := searchlog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := searchlog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SearchLogCreate) check() error {
+	if _, ok := _c.mutation.CompanyID(); !ok {
+		return &ValidationError{Name: "company_id", err: errors.New(`ent: missing required field "SearchLog.company_id"`)}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "SearchLog.mode"`)}
+	}
+	if _, ok := _c.mutation.Sort(); !ok {
+		return &ValidationError{Name: "sort", err: errors.New(`ent: missing required field "SearchLog.sort"`)}
+	}
+	if _, ok := _c.mutation.ResultCount(); !ok {
+		return &ValidationError{Name: "result_count", err: errors.New(`ent: missing required field "SearchLog.result_count"`)}
+	}
+	if v, ok := _c.mutation.ResultCount(); ok {
+		if err := searchlog.ResultCountValidator(v); err != nil {
+			return &ValidationError{Name: "result_count", err: fmt.Errorf(`ent: validator failed for field "SearchLog.result_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LatencyMs(); !ok {
+		return &ValidationError{Name: "latency_ms", err: errors.New(`ent: missing required field "SearchLog.latency_ms"`)}
+	}
+	if v, ok := _c.mutation.LatencyMs(); ok {
+		if err := searchlog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "SearchLog.latency_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Clicks(); !ok {
+		return &ValidationError{Name: "clicks", err: errors.New(`ent: missing required field "SearchLog.clicks"`)}
+	}
+	if v, ok := _c.mutation.Clicks(); ok {
+		if err := searchlog.ClicksValidator(v); err != nil {
+			return &ValidationError{Name: "clicks", err: fmt.Errorf(`ent: validator failed for field "SearchLog.clicks": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SearchLog.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *SearchLogCreate) sqlSave(ctx context.Context) (*SearchLog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SearchLogCreate) createSpec() (*SearchLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SearchLog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(searchlog.Table, sqlgraph.NewFieldSpec(searchlog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CompanyID(); ok {
+		_spec.SetField(searchlog.FieldCompanyID, field.TypeUUID, value)
+		_node.CompanyID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(searchlog.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Query(); ok {
+		_spec.SetField(searchlog.FieldQuery, field.TypeString, value)
+		_node.Query = &value
+	}
+	if value, ok := _c.mutation.Filters(); ok {
+		_spec.SetField(searchlog.FieldFilters, field.TypeJSON, value)
+		_node.Filters = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(searchlog.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.Sort(); ok {
+		_spec.SetField(searchlog.FieldSort, field.TypeString, value)
+		_node.Sort = value
+	}
+	if value, ok := _c.mutation.ResultCount(); ok {
+		_spec.SetField(searchlog.FieldResultCount, field.TypeInt64, value)
+		_node.ResultCount = value
+	}
+	if value, ok := _c.mutation.LatencyMs(); ok {
+		_spec.SetField(searchlog.FieldLatencyMs, field.TypeInt64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := _c.mutation.ClickedDocumentID(); ok {
+		_spec.SetField(searchlog.FieldClickedDocumentID, field.TypeUUID, value)
+		_node.ClickedDocumentID = &value
+	}
+	if value, ok := _c.mutation.ClickedPosition(); ok {
+		_spec.SetField(searchlog.FieldClickedPosition, field.TypeInt, value)
+		_node.ClickedPosition = &value
+	}
+	if value, ok := _c.mutation.ClickedAt(); ok {
+		_spec.SetField(searchlog.FieldClickedAt, field.TypeTime, value)
+		_node.ClickedAt = &value
+	}
+	if value, ok := _c.mutation.Clicks(); ok {
+		_spec.SetField(searchlog.FieldClicks, field.TypeInt, value)
+		_node.Clicks = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(searchlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SearchLogCreateBulk is the builder for creating many SearchLog entities in bulk.
+type SearchLogCreateBulk struct {
+	config
+	err      error
+	builders []*SearchLogCreate
+}
+
+// Save creates the SearchLog entities in the database.
+func (_c *SearchLogCreateBulk) Save(ctx context.Context) ([]*SearchLog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SearchLog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SearchLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SearchLogCreateBulk) SaveX(ctx context.Context) []*SearchLog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SearchLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SearchLogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
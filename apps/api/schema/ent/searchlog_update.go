@@ -0,0 +1,756 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/searchlog"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SearchLogUpdate is the builder for updating SearchLog entities.
+type SearchLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SearchLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SearchLogUpdate builder.
+func (_u *SearchLogUpdate) Where(ps ...predicate.SearchLog) *SearchLogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuery sets the "query" field.
+func (_u *SearchLogUpdate) SetQuery(v string) *SearchLogUpdate {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableQuery(v *string) *SearchLogUpdate {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *SearchLogUpdate) ClearQuery() *SearchLogUpdate {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *SearchLogUpdate) SetFilters(v types.SearchFilters) *SearchLogUpdate {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableFilters(v *types.SearchFilters) *SearchLogUpdate {
+	if v != nil {
+		_u.SetFilters(*v)
+	}
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *SearchLogUpdate) ClearFilters() *SearchLogUpdate {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *SearchLogUpdate) SetMode(v string) *SearchLogUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableMode(v *string) *SearchLogUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SearchLogUpdate) SetSort(v string) *SearchLogUpdate {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableSort(v *string) *SearchLogUpdate {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetResultCount sets the "result_count" field.
+func (_u *SearchLogUpdate) SetResultCount(v int64) *SearchLogUpdate {
+	_u.mutation.ResetResultCount()
+	_u.mutation.SetResultCount(v)
+	return _u
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableResultCount(v *int64) *SearchLogUpdate {
+	if v != nil {
+		_u.SetResultCount(*v)
+	}
+	return _u
+}
+
+// AddResultCount adds value to the "result_count" field.
+func (_u *SearchLogUpdate) AddResultCount(v int64) *SearchLogUpdate {
+	_u.mutation.AddResultCount(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *SearchLogUpdate) SetLatencyMs(v int64) *SearchLogUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableLatencyMs(v *int64) *SearchLogUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *SearchLogUpdate) AddLatencyMs(v int64) *SearchLogUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetClickedDocumentID sets the "clicked_document_id" field.
+func (_u *SearchLogUpdate) SetClickedDocumentID(v uuid.UUID) *SearchLogUpdate {
+	_u.mutation.SetClickedDocumentID(v)
+	return _u
+}
+
+// SetNillableClickedDocumentID sets the "clicked_document_id" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableClickedDocumentID(v *uuid.UUID) *SearchLogUpdate {
+	if v != nil {
+		_u.SetClickedDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearClickedDocumentID clears the value of the "clicked_document_id" field.
+func (_u *SearchLogUpdate) ClearClickedDocumentID() *SearchLogUpdate {
+	_u.mutation.ClearClickedDocumentID()
+	return _u
+}
+
+// SetClickedPosition sets the "clicked_position" field.
+func (_u *SearchLogUpdate) SetClickedPosition(v int) *SearchLogUpdate {
+	_u.mutation.ResetClickedPosition()
+	_u.mutation.SetClickedPosition(v)
+	return _u
+}
+
+// SetNillableClickedPosition sets the "clicked_position" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableClickedPosition(v *int) *SearchLogUpdate {
+	if v != nil {
+		_u.SetClickedPosition(*v)
+	}
+	return _u
+}
+
+// AddClickedPosition adds value to the "clicked_position" field.
+func (_u *SearchLogUpdate) AddClickedPosition(v int) *SearchLogUpdate {
+	_u.mutation.AddClickedPosition(v)
+	return _u
+}
+
+// ClearClickedPosition clears the value of the "clicked_position" field.
+func (_u *SearchLogUpdate) ClearClickedPosition() *SearchLogUpdate {
+	_u.mutation.ClearClickedPosition()
+	return _u
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_u *SearchLogUpdate) SetClickedAt(v time.Time) *SearchLogUpdate {
+	_u.mutation.SetClickedAt(v)
+	return _u
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableClickedAt(v *time.Time) *SearchLogUpdate {
+	if v != nil {
+		_u.SetClickedAt(*v)
+	}
+	return _u
+}
+
+// ClearClickedAt clears the value of the "clicked_at" field.
+func (_u *SearchLogUpdate) ClearClickedAt() *SearchLogUpdate {
+	_u.mutation.ClearClickedAt()
+	return _u
+}
+
+// SetClicks sets the "clicks" field.
+func (_u *SearchLogUpdate) SetClicks(v int) *SearchLogUpdate {
+	_u.mutation.ResetClicks()
+	_u.mutation.SetClicks(v)
+	return _u
+}
+
+// SetNillableClicks sets the "clicks" field if the given value is not nil.
+func (_u *SearchLogUpdate) SetNillableClicks(v *int) *SearchLogUpdate {
+	if v != nil {
+		_u.SetClicks(*v)
+	}
+	return _u
+}
+
+// AddClicks adds value to the "clicks" field.
+func (_u *SearchLogUpdate) AddClicks(v int) *SearchLogUpdate {
+	_u.mutation.AddClicks(v)
+	return _u
+}
+
+// Mutation returns the SearchLogMutation object of the builder.
+func (_u *SearchLogUpdate) Mutation() *SearchLogMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SearchLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SearchLogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchLogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchLogUpdate) check() error {
+	if v, ok := _u.mutation.ResultCount(); ok {
+		if err := searchlog.ResultCountValidator(v); err != nil {
+			return &ValidationError{Name: "result_count", err: fmt.Errorf(`ent: validator failed for field "SearchLog.result_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := searchlog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "SearchLog.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Clicks(); ok {
+		if err := searchlog.ClicksValidator(v); err != nil {
+			return &ValidationError{Name: "clicks", err: fmt.Errorf(`ent: validator failed for field "SearchLog.clicks": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SearchLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchLogUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SearchLogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchlog.Table, searchlog.Columns, sqlgraph.NewFieldSpec(searchlog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(searchlog.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(searchlog.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(searchlog.FieldQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(searchlog.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(searchlog.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(searchlog.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(searchlog.FieldSort, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResultCount(); ok {
+		_spec.SetField(searchlog.FieldResultCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedResultCount(); ok {
+		_spec.AddField(searchlog.FieldResultCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(searchlog.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(searchlog.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClickedDocumentID(); ok {
+		_spec.SetField(searchlog.FieldClickedDocumentID, field.TypeUUID, value)
+	}
+	if _u.mutation.ClickedDocumentIDCleared() {
+		_spec.ClearField(searchlog.FieldClickedDocumentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClickedPosition(); ok {
+		_spec.SetField(searchlog.FieldClickedPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClickedPosition(); ok {
+		_spec.AddField(searchlog.FieldClickedPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ClickedPositionCleared() {
+		_spec.ClearField(searchlog.FieldClickedPosition, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClickedAt(); ok {
+		_spec.SetField(searchlog.FieldClickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClickedAtCleared() {
+		_spec.ClearField(searchlog.FieldClickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Clicks(); ok {
+		_spec.SetField(searchlog.FieldClicks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClicks(); ok {
+		_spec.AddField(searchlog.FieldClicks, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SearchLogUpdateOne is the builder for updating a single SearchLog entity.
+type SearchLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SearchLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuery sets the "query" field.
+func (_u *SearchLogUpdateOne) SetQuery(v string) *SearchLogUpdateOne {
+	_u.mutation.SetQuery(v)
+	return _u
+}
+
+// SetNillableQuery sets the "query" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableQuery(v *string) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetQuery(*v)
+	}
+	return _u
+}
+
+// ClearQuery clears the value of the "query" field.
+func (_u *SearchLogUpdateOne) ClearQuery() *SearchLogUpdateOne {
+	_u.mutation.ClearQuery()
+	return _u
+}
+
+// SetFilters sets the "filters" field.
+func (_u *SearchLogUpdateOne) SetFilters(v types.SearchFilters) *SearchLogUpdateOne {
+	_u.mutation.SetFilters(v)
+	return _u
+}
+
+// SetNillableFilters sets the "filters" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableFilters(v *types.SearchFilters) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetFilters(*v)
+	}
+	return _u
+}
+
+// ClearFilters clears the value of the "filters" field.
+func (_u *SearchLogUpdateOne) ClearFilters() *SearchLogUpdateOne {
+	_u.mutation.ClearFilters()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *SearchLogUpdateOne) SetMode(v string) *SearchLogUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableMode(v *string) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetSort sets the "sort" field.
+func (_u *SearchLogUpdateOne) SetSort(v string) *SearchLogUpdateOne {
+	_u.mutation.SetSort(v)
+	return _u
+}
+
+// SetNillableSort sets the "sort" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableSort(v *string) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetSort(*v)
+	}
+	return _u
+}
+
+// SetResultCount sets the "result_count" field.
+func (_u *SearchLogUpdateOne) SetResultCount(v int64) *SearchLogUpdateOne {
+	_u.mutation.ResetResultCount()
+	_u.mutation.SetResultCount(v)
+	return _u
+}
+
+// SetNillableResultCount sets the "result_count" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableResultCount(v *int64) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetResultCount(*v)
+	}
+	return _u
+}
+
+// AddResultCount adds value to the "result_count" field.
+func (_u *SearchLogUpdateOne) AddResultCount(v int64) *SearchLogUpdateOne {
+	_u.mutation.AddResultCount(v)
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *SearchLogUpdateOne) SetLatencyMs(v int64) *SearchLogUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableLatencyMs(v *int64) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *SearchLogUpdateOne) AddLatencyMs(v int64) *SearchLogUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetClickedDocumentID sets the "clicked_document_id" field.
+func (_u *SearchLogUpdateOne) SetClickedDocumentID(v uuid.UUID) *SearchLogUpdateOne {
+	_u.mutation.SetClickedDocumentID(v)
+	return _u
+}
+
+// SetNillableClickedDocumentID sets the "clicked_document_id" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableClickedDocumentID(v *uuid.UUID) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetClickedDocumentID(*v)
+	}
+	return _u
+}
+
+// ClearClickedDocumentID clears the value of the "clicked_document_id" field.
+func (_u *SearchLogUpdateOne) ClearClickedDocumentID() *SearchLogUpdateOne {
+	_u.mutation.ClearClickedDocumentID()
+	return _u
+}
+
+// SetClickedPosition sets the "clicked_position" field.
+func (_u *SearchLogUpdateOne) SetClickedPosition(v int) *SearchLogUpdateOne {
+	_u.mutation.ResetClickedPosition()
+	_u.mutation.SetClickedPosition(v)
+	return _u
+}
+
+// SetNillableClickedPosition sets the "clicked_position" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableClickedPosition(v *int) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetClickedPosition(*v)
+	}
+	return _u
+}
+
+// AddClickedPosition adds value to the "clicked_position" field.
+func (_u *SearchLogUpdateOne) AddClickedPosition(v int) *SearchLogUpdateOne {
+	_u.mutation.AddClickedPosition(v)
+	return _u
+}
+
+// ClearClickedPosition clears the value of the "clicked_position" field.
+func (_u *SearchLogUpdateOne) ClearClickedPosition() *SearchLogUpdateOne {
+	_u.mutation.ClearClickedPosition()
+	return _u
+}
+
+// SetClickedAt sets the "clicked_at" field.
+func (_u *SearchLogUpdateOne) SetClickedAt(v time.Time) *SearchLogUpdateOne {
+	_u.mutation.SetClickedAt(v)
+	return _u
+}
+
+// SetNillableClickedAt sets the "clicked_at" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableClickedAt(v *time.Time) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetClickedAt(*v)
+	}
+	return _u
+}
+
+// ClearClickedAt clears the value of the "clicked_at" field.
+func (_u *SearchLogUpdateOne) ClearClickedAt() *SearchLogUpdateOne {
+	_u.mutation.ClearClickedAt()
+	return _u
+}
+
+// SetClicks sets the "clicks" field.
+func (_u *SearchLogUpdateOne) SetClicks(v int) *SearchLogUpdateOne {
+	_u.mutation.ResetClicks()
+	_u.mutation.SetClicks(v)
+	return _u
+}
+
+// SetNillableClicks sets the "clicks" field if the given value is not nil.
+func (_u *SearchLogUpdateOne) SetNillableClicks(v *int) *SearchLogUpdateOne {
+	if v != nil {
+		_u.SetClicks(*v)
+	}
+	return _u
+}
+
+// AddClicks adds value to the "clicks" field.
+func (_u *SearchLogUpdateOne) AddClicks(v int) *SearchLogUpdateOne {
+	_u.mutation.AddClicks(v)
+	return _u
+}
+
+// Mutation returns the SearchLogMutation object of the builder.
+func (_u *SearchLogUpdateOne) Mutation() *SearchLogMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SearchLogUpdate builder.
+func (_u *SearchLogUpdateOne) Where(ps ...predicate.SearchLog) *SearchLogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SearchLogUpdateOne) Select(field string, fields ...string) *SearchLogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SearchLog entity.
+func (_u *SearchLogUpdateOne) Save(ctx context.Context) (*SearchLog, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SearchLogUpdateOne) SaveX(ctx context.Context) *SearchLog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SearchLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SearchLogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SearchLogUpdateOne) check() error {
+	if v, ok := _u.mutation.ResultCount(); ok {
+		if err := searchlog.ResultCountValidator(v); err != nil {
+			return &ValidationError{Name: "result_count", err: fmt.Errorf(`ent: validator failed for field "SearchLog.result_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LatencyMs(); ok {
+		if err := searchlog.LatencyMsValidator(v); err != nil {
+			return &ValidationError{Name: "latency_ms", err: fmt.Errorf(`ent: validator failed for field "SearchLog.latency_ms": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Clicks(); ok {
+		if err := searchlog.ClicksValidator(v); err != nil {
+			return &ValidationError{Name: "clicks", err: fmt.Errorf(`ent: validator failed for field "SearchLog.clicks": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *SearchLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SearchLogUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *SearchLogUpdateOne) sqlSave(ctx context.Context) (_node *SearchLog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(searchlog.Table, searchlog.Columns, sqlgraph.NewFieldSpec(searchlog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SearchLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, searchlog.FieldID)
+		for _, f := range fields {
+			if !searchlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != searchlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(searchlog.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Query(); ok {
+		_spec.SetField(searchlog.FieldQuery, field.TypeString, value)
+	}
+	if _u.mutation.QueryCleared() {
+		_spec.ClearField(searchlog.FieldQuery, field.TypeString)
+	}
+	if value, ok := _u.mutation.Filters(); ok {
+		_spec.SetField(searchlog.FieldFilters, field.TypeJSON, value)
+	}
+	if _u.mutation.FiltersCleared() {
+		_spec.ClearField(searchlog.FieldFilters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(searchlog.FieldMode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Sort(); ok {
+		_spec.SetField(searchlog.FieldSort, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ResultCount(); ok {
+		_spec.SetField(searchlog.FieldResultCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedResultCount(); ok {
+		_spec.AddField(searchlog.FieldResultCount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(searchlog.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(searchlog.FieldLatencyMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ClickedDocumentID(); ok {
+		_spec.SetField(searchlog.FieldClickedDocumentID, field.TypeUUID, value)
+	}
+	if _u.mutation.ClickedDocumentIDCleared() {
+		_spec.ClearField(searchlog.FieldClickedDocumentID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClickedPosition(); ok {
+		_spec.SetField(searchlog.FieldClickedPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClickedPosition(); ok {
+		_spec.AddField(searchlog.FieldClickedPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ClickedPositionCleared() {
+		_spec.ClearField(searchlog.FieldClickedPosition, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ClickedAt(); ok {
+		_spec.SetField(searchlog.FieldClickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClickedAtCleared() {
+		_spec.ClearField(searchlog.FieldClickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Clicks(); ok {
+		_spec.SetField(searchlog.FieldClicks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedClicks(); ok {
+		_spec.AddField(searchlog.FieldClicks, field.TypeInt, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &SearchLog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{searchlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
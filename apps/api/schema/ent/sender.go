@@ -3,10 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/sender"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -24,6 +26,26 @@ type Sender struct {
 	Name string `json:"name,omitempty"`
 	// Email holds the value of the "email" field.
 	Email *string `json:"email,omitempty"`
+	// LegalName holds the value of the "legal_name" field.
+	LegalName *string `json:"legal_name,omitempty"`
+	// Inn holds the value of the "inn" field.
+	Inn *string `json:"inn,omitempty"`
+	// Kpp holds the value of the "kpp" field.
+	Kpp *string `json:"kpp,omitempty"`
+	// Emails holds the value of the "emails" field.
+	Emails []string `json:"emails,omitempty"`
+	// Phones holds the value of the "phones" field.
+	Phones []string `json:"phones,omitempty"`
+	// Addresses holds the value of the "addresses" field.
+	Addresses []string `json:"addresses,omitempty"`
+	// Website holds the value of the "website" field.
+	Website *string `json:"website,omitempty"`
+	// Notes holds the value of the "notes" field.
+	Notes *string `json:"notes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SenderQuery when eager-loading is set.
 	Edges        SenderEdges `json:"edges"`
@@ -66,8 +88,12 @@ func (*Sender) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case sender.FieldName, sender.FieldEmail:
+		case sender.FieldEmails, sender.FieldPhones, sender.FieldAddresses:
+			values[i] = new([]byte)
+		case sender.FieldName, sender.FieldEmail, sender.FieldLegalName, sender.FieldInn, sender.FieldKpp, sender.FieldWebsite, sender.FieldNotes:
 			values[i] = new(sql.NullString)
+		case sender.FieldCreatedAt, sender.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case sender.FieldID, sender.FieldCompanyID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -110,6 +136,77 @@ func (_m *Sender) assignValues(columns []string, values []any) error {
 				_m.Email = new(string)
 				*_m.Email = value.String
 			}
+		case sender.FieldLegalName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field legal_name", values[i])
+			} else if value.Valid {
+				_m.LegalName = new(string)
+				*_m.LegalName = value.String
+			}
+		case sender.FieldInn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inn", values[i])
+			} else if value.Valid {
+				_m.Inn = new(string)
+				*_m.Inn = value.String
+			}
+		case sender.FieldKpp:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kpp", values[i])
+			} else if value.Valid {
+				_m.Kpp = new(string)
+				*_m.Kpp = value.String
+			}
+		case sender.FieldEmails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field emails", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Emails); err != nil {
+					return fmt.Errorf("unmarshal field emails: %w", err)
+				}
+			}
+		case sender.FieldPhones:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field phones", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Phones); err != nil {
+					return fmt.Errorf("unmarshal field phones: %w", err)
+				}
+			}
+		case sender.FieldAddresses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field addresses", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Addresses); err != nil {
+					return fmt.Errorf("unmarshal field addresses: %w", err)
+				}
+			}
+		case sender.FieldWebsite:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field website", values[i])
+			} else if value.Valid {
+				_m.Website = new(string)
+				*_m.Website = value.String
+			}
+		case sender.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = new(string)
+				*_m.Notes = value.String
+			}
+		case sender.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case sender.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -166,6 +263,46 @@ func (_m *Sender) String() string {
 		builder.WriteString("email=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.LegalName; v != nil {
+		builder.WriteString("legal_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Inn; v != nil {
+		builder.WriteString("inn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Kpp; v != nil {
+		builder.WriteString("kpp=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("emails=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Emails))
+	builder.WriteString(", ")
+	builder.WriteString("phones=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Phones))
+	builder.WriteString(", ")
+	builder.WriteString("addresses=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Addresses))
+	builder.WriteString(", ")
+	if v := _m.Website; v != nil {
+		builder.WriteString("website=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Notes; v != nil {
+		builder.WriteString("notes=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -4,6 +4,7 @@ package sender
 
 import (
 	"techmind/schema/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -70,6 +71,41 @@ func Email(v string) predicate.Sender {
 	return predicate.Sender(sql.FieldEQ(FieldEmail, v))
 }
 
+// LegalName applies equality check predicate on the "legal_name" field. It's identical to LegalNameEQ.
+func LegalName(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldLegalName, v))
+}
+
+// Inn applies equality check predicate on the "inn" field. It's identical to InnEQ.
+func Inn(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldInn, v))
+}
+
+// Kpp applies equality check predicate on the "kpp" field. It's identical to KppEQ.
+func Kpp(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldKpp, v))
+}
+
+// Website applies equality check predicate on the "website" field. It's identical to WebsiteEQ.
+func Website(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldWebsite, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldNotes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // CompanyIDEQ applies the EQ predicate on the "company_id" field.
 func CompanyIDEQ(v uuid.UUID) predicate.Sender {
 	return predicate.Sender(sql.FieldEQ(FieldCompanyID, v))
@@ -230,6 +266,491 @@ func EmailContainsFold(v string) predicate.Sender {
 	return predicate.Sender(sql.FieldContainsFold(FieldEmail, v))
 }
 
+// LegalNameEQ applies the EQ predicate on the "legal_name" field.
+func LegalNameEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldLegalName, v))
+}
+
+// LegalNameNEQ applies the NEQ predicate on the "legal_name" field.
+func LegalNameNEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldLegalName, v))
+}
+
+// LegalNameIn applies the In predicate on the "legal_name" field.
+func LegalNameIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldLegalName, vs...))
+}
+
+// LegalNameNotIn applies the NotIn predicate on the "legal_name" field.
+func LegalNameNotIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldLegalName, vs...))
+}
+
+// LegalNameGT applies the GT predicate on the "legal_name" field.
+func LegalNameGT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldLegalName, v))
+}
+
+// LegalNameGTE applies the GTE predicate on the "legal_name" field.
+func LegalNameGTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldLegalName, v))
+}
+
+// LegalNameLT applies the LT predicate on the "legal_name" field.
+func LegalNameLT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldLegalName, v))
+}
+
+// LegalNameLTE applies the LTE predicate on the "legal_name" field.
+func LegalNameLTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldLegalName, v))
+}
+
+// LegalNameContains applies the Contains predicate on the "legal_name" field.
+func LegalNameContains(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContains(FieldLegalName, v))
+}
+
+// LegalNameHasPrefix applies the HasPrefix predicate on the "legal_name" field.
+func LegalNameHasPrefix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasPrefix(FieldLegalName, v))
+}
+
+// LegalNameHasSuffix applies the HasSuffix predicate on the "legal_name" field.
+func LegalNameHasSuffix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasSuffix(FieldLegalName, v))
+}
+
+// LegalNameIsNil applies the IsNil predicate on the "legal_name" field.
+func LegalNameIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldLegalName))
+}
+
+// LegalNameNotNil applies the NotNil predicate on the "legal_name" field.
+func LegalNameNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldLegalName))
+}
+
+// LegalNameEqualFold applies the EqualFold predicate on the "legal_name" field.
+func LegalNameEqualFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEqualFold(FieldLegalName, v))
+}
+
+// LegalNameContainsFold applies the ContainsFold predicate on the "legal_name" field.
+func LegalNameContainsFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContainsFold(FieldLegalName, v))
+}
+
+// InnEQ applies the EQ predicate on the "inn" field.
+func InnEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldInn, v))
+}
+
+// InnNEQ applies the NEQ predicate on the "inn" field.
+func InnNEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldInn, v))
+}
+
+// InnIn applies the In predicate on the "inn" field.
+func InnIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldInn, vs...))
+}
+
+// InnNotIn applies the NotIn predicate on the "inn" field.
+func InnNotIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldInn, vs...))
+}
+
+// InnGT applies the GT predicate on the "inn" field.
+func InnGT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldInn, v))
+}
+
+// InnGTE applies the GTE predicate on the "inn" field.
+func InnGTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldInn, v))
+}
+
+// InnLT applies the LT predicate on the "inn" field.
+func InnLT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldInn, v))
+}
+
+// InnLTE applies the LTE predicate on the "inn" field.
+func InnLTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldInn, v))
+}
+
+// InnContains applies the Contains predicate on the "inn" field.
+func InnContains(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContains(FieldInn, v))
+}
+
+// InnHasPrefix applies the HasPrefix predicate on the "inn" field.
+func InnHasPrefix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasPrefix(FieldInn, v))
+}
+
+// InnHasSuffix applies the HasSuffix predicate on the "inn" field.
+func InnHasSuffix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasSuffix(FieldInn, v))
+}
+
+// InnIsNil applies the IsNil predicate on the "inn" field.
+func InnIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldInn))
+}
+
+// InnNotNil applies the NotNil predicate on the "inn" field.
+func InnNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldInn))
+}
+
+// InnEqualFold applies the EqualFold predicate on the "inn" field.
+func InnEqualFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEqualFold(FieldInn, v))
+}
+
+// InnContainsFold applies the ContainsFold predicate on the "inn" field.
+func InnContainsFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContainsFold(FieldInn, v))
+}
+
+// KppEQ applies the EQ predicate on the "kpp" field.
+func KppEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldKpp, v))
+}
+
+// KppNEQ applies the NEQ predicate on the "kpp" field.
+func KppNEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldKpp, v))
+}
+
+// KppIn applies the In predicate on the "kpp" field.
+func KppIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldKpp, vs...))
+}
+
+// KppNotIn applies the NotIn predicate on the "kpp" field.
+func KppNotIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldKpp, vs...))
+}
+
+// KppGT applies the GT predicate on the "kpp" field.
+func KppGT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldKpp, v))
+}
+
+// KppGTE applies the GTE predicate on the "kpp" field.
+func KppGTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldKpp, v))
+}
+
+// KppLT applies the LT predicate on the "kpp" field.
+func KppLT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldKpp, v))
+}
+
+// KppLTE applies the LTE predicate on the "kpp" field.
+func KppLTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldKpp, v))
+}
+
+// KppContains applies the Contains predicate on the "kpp" field.
+func KppContains(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContains(FieldKpp, v))
+}
+
+// KppHasPrefix applies the HasPrefix predicate on the "kpp" field.
+func KppHasPrefix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasPrefix(FieldKpp, v))
+}
+
+// KppHasSuffix applies the HasSuffix predicate on the "kpp" field.
+func KppHasSuffix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasSuffix(FieldKpp, v))
+}
+
+// KppIsNil applies the IsNil predicate on the "kpp" field.
+func KppIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldKpp))
+}
+
+// KppNotNil applies the NotNil predicate on the "kpp" field.
+func KppNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldKpp))
+}
+
+// KppEqualFold applies the EqualFold predicate on the "kpp" field.
+func KppEqualFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEqualFold(FieldKpp, v))
+}
+
+// KppContainsFold applies the ContainsFold predicate on the "kpp" field.
+func KppContainsFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContainsFold(FieldKpp, v))
+}
+
+// EmailsIsNil applies the IsNil predicate on the "emails" field.
+func EmailsIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldEmails))
+}
+
+// EmailsNotNil applies the NotNil predicate on the "emails" field.
+func EmailsNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldEmails))
+}
+
+// PhonesIsNil applies the IsNil predicate on the "phones" field.
+func PhonesIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldPhones))
+}
+
+// PhonesNotNil applies the NotNil predicate on the "phones" field.
+func PhonesNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldPhones))
+}
+
+// AddressesIsNil applies the IsNil predicate on the "addresses" field.
+func AddressesIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldAddresses))
+}
+
+// AddressesNotNil applies the NotNil predicate on the "addresses" field.
+func AddressesNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldAddresses))
+}
+
+// WebsiteEQ applies the EQ predicate on the "website" field.
+func WebsiteEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldWebsite, v))
+}
+
+// WebsiteNEQ applies the NEQ predicate on the "website" field.
+func WebsiteNEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldWebsite, v))
+}
+
+// WebsiteIn applies the In predicate on the "website" field.
+func WebsiteIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldWebsite, vs...))
+}
+
+// WebsiteNotIn applies the NotIn predicate on the "website" field.
+func WebsiteNotIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldWebsite, vs...))
+}
+
+// WebsiteGT applies the GT predicate on the "website" field.
+func WebsiteGT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldWebsite, v))
+}
+
+// WebsiteGTE applies the GTE predicate on the "website" field.
+func WebsiteGTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldWebsite, v))
+}
+
+// WebsiteLT applies the LT predicate on the "website" field.
+func WebsiteLT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldWebsite, v))
+}
+
+// WebsiteLTE applies the LTE predicate on the "website" field.
+func WebsiteLTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldWebsite, v))
+}
+
+// WebsiteContains applies the Contains predicate on the "website" field.
+func WebsiteContains(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContains(FieldWebsite, v))
+}
+
+// WebsiteHasPrefix applies the HasPrefix predicate on the "website" field.
+func WebsiteHasPrefix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasPrefix(FieldWebsite, v))
+}
+
+// WebsiteHasSuffix applies the HasSuffix predicate on the "website" field.
+func WebsiteHasSuffix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasSuffix(FieldWebsite, v))
+}
+
+// WebsiteIsNil applies the IsNil predicate on the "website" field.
+func WebsiteIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldWebsite))
+}
+
+// WebsiteNotNil applies the NotNil predicate on the "website" field.
+func WebsiteNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldWebsite))
+}
+
+// WebsiteEqualFold applies the EqualFold predicate on the "website" field.
+func WebsiteEqualFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEqualFold(FieldWebsite, v))
+}
+
+// WebsiteContainsFold applies the ContainsFold predicate on the "website" field.
+func WebsiteContainsFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContainsFold(FieldWebsite, v))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.Sender {
+	return predicate.Sender(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.Sender {
+	return predicate.Sender(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Sender {
+	return predicate.Sender(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Sender {
+	return predicate.Sender(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // HasCompany applies the HasEdge predicate on the "company" edge.
 func HasCompany() predicate.Sender {
 	return predicate.Sender(func(s *sql.Selector) {
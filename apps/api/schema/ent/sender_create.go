@@ -9,6 +9,7 @@ import (
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/document"
 	"techmind/schema/ent/sender"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -48,6 +49,122 @@ func (_c *SenderCreate) SetNillableEmail(v *string) *SenderCreate {
 	return _c
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_c *SenderCreate) SetLegalName(v string) *SenderCreate {
+	_c.mutation.SetLegalName(v)
+	return _c
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableLegalName(v *string) *SenderCreate {
+	if v != nil {
+		_c.SetLegalName(*v)
+	}
+	return _c
+}
+
+// SetInn sets the "inn" field.
+func (_c *SenderCreate) SetInn(v string) *SenderCreate {
+	_c.mutation.SetInn(v)
+	return _c
+}
+
+// SetNillableInn sets the "inn" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableInn(v *string) *SenderCreate {
+	if v != nil {
+		_c.SetInn(*v)
+	}
+	return _c
+}
+
+// SetKpp sets the "kpp" field.
+func (_c *SenderCreate) SetKpp(v string) *SenderCreate {
+	_c.mutation.SetKpp(v)
+	return _c
+}
+
+// SetNillableKpp sets the "kpp" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableKpp(v *string) *SenderCreate {
+	if v != nil {
+		_c.SetKpp(*v)
+	}
+	return _c
+}
+
+// SetEmails sets the "emails" field.
+func (_c *SenderCreate) SetEmails(v []string) *SenderCreate {
+	_c.mutation.SetEmails(v)
+	return _c
+}
+
+// SetPhones sets the "phones" field.
+func (_c *SenderCreate) SetPhones(v []string) *SenderCreate {
+	_c.mutation.SetPhones(v)
+	return _c
+}
+
+// SetAddresses sets the "addresses" field.
+func (_c *SenderCreate) SetAddresses(v []string) *SenderCreate {
+	_c.mutation.SetAddresses(v)
+	return _c
+}
+
+// SetWebsite sets the "website" field.
+func (_c *SenderCreate) SetWebsite(v string) *SenderCreate {
+	_c.mutation.SetWebsite(v)
+	return _c
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableWebsite(v *string) *SenderCreate {
+	if v != nil {
+		_c.SetWebsite(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *SenderCreate) SetNotes(v string) *SenderCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableNotes(v *string) *SenderCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SenderCreate) SetCreatedAt(v time.Time) *SenderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableCreatedAt(v *time.Time) *SenderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SenderCreate) SetUpdatedAt(v time.Time) *SenderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SenderCreate) SetNillableUpdatedAt(v *time.Time) *SenderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SenderCreate) SetID(v uuid.UUID) *SenderCreate {
 	_c.mutation.SetID(v)
@@ -117,6 +234,14 @@ func (_c *SenderCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *SenderCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := sender.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := sender.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := sender.DefaultID()
 		_c.mutation.SetID(v)
@@ -136,6 +261,12 @@ func (_c *SenderCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sender.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sender.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Sender.updated_at"`)}
+	}
 	if len(_c.mutation.CompanyIDs()) == 0 {
 		return &ValidationError{Name: "company", err: errors.New(`ent: missing required edge "Sender.company"`)}
 	}
@@ -182,6 +313,46 @@ func (_c *SenderCreate) createSpec() (*Sender, *sqlgraph.CreateSpec) {
 		_spec.SetField(sender.FieldEmail, field.TypeString, value)
 		_node.Email = &value
 	}
+	if value, ok := _c.mutation.LegalName(); ok {
+		_spec.SetField(sender.FieldLegalName, field.TypeString, value)
+		_node.LegalName = &value
+	}
+	if value, ok := _c.mutation.Inn(); ok {
+		_spec.SetField(sender.FieldInn, field.TypeString, value)
+		_node.Inn = &value
+	}
+	if value, ok := _c.mutation.Kpp(); ok {
+		_spec.SetField(sender.FieldKpp, field.TypeString, value)
+		_node.Kpp = &value
+	}
+	if value, ok := _c.mutation.Emails(); ok {
+		_spec.SetField(sender.FieldEmails, field.TypeJSON, value)
+		_node.Emails = value
+	}
+	if value, ok := _c.mutation.Phones(); ok {
+		_spec.SetField(sender.FieldPhones, field.TypeJSON, value)
+		_node.Phones = value
+	}
+	if value, ok := _c.mutation.Addresses(); ok {
+		_spec.SetField(sender.FieldAddresses, field.TypeJSON, value)
+		_node.Addresses = value
+	}
+	if value, ok := _c.mutation.Website(); ok {
+		_spec.SetField(sender.FieldWebsite, field.TypeString, value)
+		_node.Website = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(sender.FieldNotes, field.TypeString, value)
+		_node.Notes = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(sender.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if nodes := _c.mutation.CompanyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,9 +10,11 @@ import (
 	"techmind/schema/ent/document"
 	"techmind/schema/ent/predicate"
 	"techmind/schema/ent/sender"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -79,6 +81,166 @@ func (_u *SenderUpdate) ClearEmail() *SenderUpdate {
 	return _u
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_u *SenderUpdate) SetLegalName(v string) *SenderUpdate {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *SenderUpdate) SetNillableLegalName(v *string) *SenderUpdate {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (_u *SenderUpdate) ClearLegalName() *SenderUpdate {
+	_u.mutation.ClearLegalName()
+	return _u
+}
+
+// SetInn sets the "inn" field.
+func (_u *SenderUpdate) SetInn(v string) *SenderUpdate {
+	_u.mutation.SetInn(v)
+	return _u
+}
+
+// SetNillableInn sets the "inn" field if the given value is not nil.
+func (_u *SenderUpdate) SetNillableInn(v *string) *SenderUpdate {
+	if v != nil {
+		_u.SetInn(*v)
+	}
+	return _u
+}
+
+// ClearInn clears the value of the "inn" field.
+func (_u *SenderUpdate) ClearInn() *SenderUpdate {
+	_u.mutation.ClearInn()
+	return _u
+}
+
+// SetKpp sets the "kpp" field.
+func (_u *SenderUpdate) SetKpp(v string) *SenderUpdate {
+	_u.mutation.SetKpp(v)
+	return _u
+}
+
+// SetNillableKpp sets the "kpp" field if the given value is not nil.
+func (_u *SenderUpdate) SetNillableKpp(v *string) *SenderUpdate {
+	if v != nil {
+		_u.SetKpp(*v)
+	}
+	return _u
+}
+
+// ClearKpp clears the value of the "kpp" field.
+func (_u *SenderUpdate) ClearKpp() *SenderUpdate {
+	_u.mutation.ClearKpp()
+	return _u
+}
+
+// SetEmails sets the "emails" field.
+func (_u *SenderUpdate) SetEmails(v []string) *SenderUpdate {
+	_u.mutation.SetEmails(v)
+	return _u
+}
+
+// AppendEmails appends value to the "emails" field.
+func (_u *SenderUpdate) AppendEmails(v []string) *SenderUpdate {
+	_u.mutation.AppendEmails(v)
+	return _u
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (_u *SenderUpdate) ClearEmails() *SenderUpdate {
+	_u.mutation.ClearEmails()
+	return _u
+}
+
+// SetPhones sets the "phones" field.
+func (_u *SenderUpdate) SetPhones(v []string) *SenderUpdate {
+	_u.mutation.SetPhones(v)
+	return _u
+}
+
+// AppendPhones appends value to the "phones" field.
+func (_u *SenderUpdate) AppendPhones(v []string) *SenderUpdate {
+	_u.mutation.AppendPhones(v)
+	return _u
+}
+
+// ClearPhones clears the value of the "phones" field.
+func (_u *SenderUpdate) ClearPhones() *SenderUpdate {
+	_u.mutation.ClearPhones()
+	return _u
+}
+
+// SetAddresses sets the "addresses" field.
+func (_u *SenderUpdate) SetAddresses(v []string) *SenderUpdate {
+	_u.mutation.SetAddresses(v)
+	return _u
+}
+
+// AppendAddresses appends value to the "addresses" field.
+func (_u *SenderUpdate) AppendAddresses(v []string) *SenderUpdate {
+	_u.mutation.AppendAddresses(v)
+	return _u
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (_u *SenderUpdate) ClearAddresses() *SenderUpdate {
+	_u.mutation.ClearAddresses()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *SenderUpdate) SetWebsite(v string) *SenderUpdate {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *SenderUpdate) SetNillableWebsite(v *string) *SenderUpdate {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *SenderUpdate) ClearWebsite() *SenderUpdate {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *SenderUpdate) SetNotes(v string) *SenderUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *SenderUpdate) SetNillableNotes(v *string) *SenderUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *SenderUpdate) ClearNotes() *SenderUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SenderUpdate) SetUpdatedAt(v time.Time) *SenderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetCompany sets the "company" edge to the Company entity.
 func (_u *SenderUpdate) SetCompany(v *Company) *SenderUpdate {
 	return _u.SetCompanyID(v.ID)
@@ -133,6 +295,7 @@ func (_u *SenderUpdate) RemoveDocuments(v ...*Document) *SenderUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SenderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -158,6 +321,14 @@ func (_u *SenderUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *SenderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sender.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *SenderUpdate) check() error {
 	if v, ok := _u.mutation.Name(); ok {
@@ -198,6 +369,72 @@ func (_u *SenderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EmailCleared() {
 		_spec.ClearField(sender.FieldEmail, field.TypeString)
 	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(sender.FieldLegalName, field.TypeString, value)
+	}
+	if _u.mutation.LegalNameCleared() {
+		_spec.ClearField(sender.FieldLegalName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Inn(); ok {
+		_spec.SetField(sender.FieldInn, field.TypeString, value)
+	}
+	if _u.mutation.InnCleared() {
+		_spec.ClearField(sender.FieldInn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kpp(); ok {
+		_spec.SetField(sender.FieldKpp, field.TypeString, value)
+	}
+	if _u.mutation.KppCleared() {
+		_spec.ClearField(sender.FieldKpp, field.TypeString)
+	}
+	if value, ok := _u.mutation.Emails(); ok {
+		_spec.SetField(sender.FieldEmails, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldEmails, value)
+		})
+	}
+	if _u.mutation.EmailsCleared() {
+		_spec.ClearField(sender.FieldEmails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Phones(); ok {
+		_spec.SetField(sender.FieldPhones, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPhones(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldPhones, value)
+		})
+	}
+	if _u.mutation.PhonesCleared() {
+		_spec.ClearField(sender.FieldPhones, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Addresses(); ok {
+		_spec.SetField(sender.FieldAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldAddresses, value)
+		})
+	}
+	if _u.mutation.AddressesCleared() {
+		_spec.ClearField(sender.FieldAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(sender.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(sender.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(sender.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(sender.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.CompanyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -342,6 +579,166 @@ func (_u *SenderUpdateOne) ClearEmail() *SenderUpdateOne {
 	return _u
 }
 
+// SetLegalName sets the "legal_name" field.
+func (_u *SenderUpdateOne) SetLegalName(v string) *SenderUpdateOne {
+	_u.mutation.SetLegalName(v)
+	return _u
+}
+
+// SetNillableLegalName sets the "legal_name" field if the given value is not nil.
+func (_u *SenderUpdateOne) SetNillableLegalName(v *string) *SenderUpdateOne {
+	if v != nil {
+		_u.SetLegalName(*v)
+	}
+	return _u
+}
+
+// ClearLegalName clears the value of the "legal_name" field.
+func (_u *SenderUpdateOne) ClearLegalName() *SenderUpdateOne {
+	_u.mutation.ClearLegalName()
+	return _u
+}
+
+// SetInn sets the "inn" field.
+func (_u *SenderUpdateOne) SetInn(v string) *SenderUpdateOne {
+	_u.mutation.SetInn(v)
+	return _u
+}
+
+// SetNillableInn sets the "inn" field if the given value is not nil.
+func (_u *SenderUpdateOne) SetNillableInn(v *string) *SenderUpdateOne {
+	if v != nil {
+		_u.SetInn(*v)
+	}
+	return _u
+}
+
+// ClearInn clears the value of the "inn" field.
+func (_u *SenderUpdateOne) ClearInn() *SenderUpdateOne {
+	_u.mutation.ClearInn()
+	return _u
+}
+
+// SetKpp sets the "kpp" field.
+func (_u *SenderUpdateOne) SetKpp(v string) *SenderUpdateOne {
+	_u.mutation.SetKpp(v)
+	return _u
+}
+
+// SetNillableKpp sets the "kpp" field if the given value is not nil.
+func (_u *SenderUpdateOne) SetNillableKpp(v *string) *SenderUpdateOne {
+	if v != nil {
+		_u.SetKpp(*v)
+	}
+	return _u
+}
+
+// ClearKpp clears the value of the "kpp" field.
+func (_u *SenderUpdateOne) ClearKpp() *SenderUpdateOne {
+	_u.mutation.ClearKpp()
+	return _u
+}
+
+// SetEmails sets the "emails" field.
+func (_u *SenderUpdateOne) SetEmails(v []string) *SenderUpdateOne {
+	_u.mutation.SetEmails(v)
+	return _u
+}
+
+// AppendEmails appends value to the "emails" field.
+func (_u *SenderUpdateOne) AppendEmails(v []string) *SenderUpdateOne {
+	_u.mutation.AppendEmails(v)
+	return _u
+}
+
+// ClearEmails clears the value of the "emails" field.
+func (_u *SenderUpdateOne) ClearEmails() *SenderUpdateOne {
+	_u.mutation.ClearEmails()
+	return _u
+}
+
+// SetPhones sets the "phones" field.
+func (_u *SenderUpdateOne) SetPhones(v []string) *SenderUpdateOne {
+	_u.mutation.SetPhones(v)
+	return _u
+}
+
+// AppendPhones appends value to the "phones" field.
+func (_u *SenderUpdateOne) AppendPhones(v []string) *SenderUpdateOne {
+	_u.mutation.AppendPhones(v)
+	return _u
+}
+
+// ClearPhones clears the value of the "phones" field.
+func (_u *SenderUpdateOne) ClearPhones() *SenderUpdateOne {
+	_u.mutation.ClearPhones()
+	return _u
+}
+
+// SetAddresses sets the "addresses" field.
+func (_u *SenderUpdateOne) SetAddresses(v []string) *SenderUpdateOne {
+	_u.mutation.SetAddresses(v)
+	return _u
+}
+
+// AppendAddresses appends value to the "addresses" field.
+func (_u *SenderUpdateOne) AppendAddresses(v []string) *SenderUpdateOne {
+	_u.mutation.AppendAddresses(v)
+	return _u
+}
+
+// ClearAddresses clears the value of the "addresses" field.
+func (_u *SenderUpdateOne) ClearAddresses() *SenderUpdateOne {
+	_u.mutation.ClearAddresses()
+	return _u
+}
+
+// SetWebsite sets the "website" field.
+func (_u *SenderUpdateOne) SetWebsite(v string) *SenderUpdateOne {
+	_u.mutation.SetWebsite(v)
+	return _u
+}
+
+// SetNillableWebsite sets the "website" field if the given value is not nil.
+func (_u *SenderUpdateOne) SetNillableWebsite(v *string) *SenderUpdateOne {
+	if v != nil {
+		_u.SetWebsite(*v)
+	}
+	return _u
+}
+
+// ClearWebsite clears the value of the "website" field.
+func (_u *SenderUpdateOne) ClearWebsite() *SenderUpdateOne {
+	_u.mutation.ClearWebsite()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *SenderUpdateOne) SetNotes(v string) *SenderUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *SenderUpdateOne) SetNillableNotes(v *string) *SenderUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *SenderUpdateOne) ClearNotes() *SenderUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SenderUpdateOne) SetUpdatedAt(v time.Time) *SenderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
 // SetCompany sets the "company" edge to the Company entity.
 func (_u *SenderUpdateOne) SetCompany(v *Company) *SenderUpdateOne {
 	return _u.SetCompanyID(v.ID)
@@ -409,6 +806,7 @@ func (_u *SenderUpdateOne) Select(field string, fields ...string) *SenderUpdateO
 
 // Save executes the query and returns the updated Sender entity.
 func (_u *SenderUpdateOne) Save(ctx context.Context) (*Sender, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -434,6 +832,14 @@ func (_u *SenderUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *SenderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := sender.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *SenderUpdateOne) check() error {
 	if v, ok := _u.mutation.Name(); ok {
@@ -491,6 +897,72 @@ func (_u *SenderUpdateOne) sqlSave(ctx context.Context) (_node *Sender, err erro
 	if _u.mutation.EmailCleared() {
 		_spec.ClearField(sender.FieldEmail, field.TypeString)
 	}
+	if value, ok := _u.mutation.LegalName(); ok {
+		_spec.SetField(sender.FieldLegalName, field.TypeString, value)
+	}
+	if _u.mutation.LegalNameCleared() {
+		_spec.ClearField(sender.FieldLegalName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Inn(); ok {
+		_spec.SetField(sender.FieldInn, field.TypeString, value)
+	}
+	if _u.mutation.InnCleared() {
+		_spec.ClearField(sender.FieldInn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Kpp(); ok {
+		_spec.SetField(sender.FieldKpp, field.TypeString, value)
+	}
+	if _u.mutation.KppCleared() {
+		_spec.ClearField(sender.FieldKpp, field.TypeString)
+	}
+	if value, ok := _u.mutation.Emails(); ok {
+		_spec.SetField(sender.FieldEmails, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmails(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldEmails, value)
+		})
+	}
+	if _u.mutation.EmailsCleared() {
+		_spec.ClearField(sender.FieldEmails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Phones(); ok {
+		_spec.SetField(sender.FieldPhones, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPhones(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldPhones, value)
+		})
+	}
+	if _u.mutation.PhonesCleared() {
+		_spec.ClearField(sender.FieldPhones, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Addresses(); ok {
+		_spec.SetField(sender.FieldAddresses, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAddresses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sender.FieldAddresses, value)
+		})
+	}
+	if _u.mutation.AddressesCleared() {
+		_spec.ClearField(sender.FieldAddresses, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Website(); ok {
+		_spec.SetField(sender.FieldWebsite, field.TypeString, value)
+	}
+	if _u.mutation.WebsiteCleared() {
+		_spec.ClearField(sender.FieldWebsite, field.TypeString)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(sender.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(sender.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(sender.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if _u.mutation.CompanyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"techmind/schema/ent/company"
 	"techmind/schema/ent/tag"
+	"techmind/schema/ent/taggroup"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -20,8 +21,18 @@ type Tag struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// CompanyID holds the value of the "company_id" field.
 	CompanyID uuid.UUID `json:"company_id,omitempty"`
+	// ParentID holds the value of the "parent_id" field.
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID *uuid.UUID `json:"group_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Color holds the value of the "color" field.
+	Color *string `json:"color,omitempty"`
+	// Icon holds the value of the "icon" field.
+	Icon *string `json:"icon,omitempty"`
+	// Description holds the value of the "description" field.
+	Description *string `json:"description,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TagQuery when eager-loading is set.
 	Edges        TagEdges `json:"edges"`
@@ -32,11 +43,19 @@ type Tag struct {
 type TagEdges struct {
 	// Company holds the value of the company edge.
 	Company *Company `json:"company,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Tag `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Tag `json:"children,omitempty"`
+	// Group holds the value of the group edge.
+	Group *TagGroup `json:"group,omitempty"`
 	// DocumentTags holds the value of the document_tags edge.
 	DocumentTags []*DocumentTag `json:"document_tags,omitempty"`
+	// Rules holds the value of the rules edge.
+	Rules []*TagRule `json:"rules,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // CompanyOrErr returns the Company value or an error if the edge
@@ -50,21 +69,63 @@ func (e TagEdges) CompanyOrErr() (*Company, error) {
 	return nil, &NotLoadedError{edge: "company"}
 }
 
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagEdges) ParentOrErr() (*Tag, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: tag.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) ChildrenOrErr() ([]*Tag, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// GroupOrErr returns the Group value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TagEdges) GroupOrErr() (*TagGroup, error) {
+	if e.Group != nil {
+		return e.Group, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: taggroup.Label}
+	}
+	return nil, &NotLoadedError{edge: "group"}
+}
+
 // DocumentTagsOrErr returns the DocumentTags value or an error if the edge
 // was not loaded in eager-loading.
 func (e TagEdges) DocumentTagsOrErr() ([]*DocumentTag, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[4] {
 		return e.DocumentTags, nil
 	}
 	return nil, &NotLoadedError{edge: "document_tags"}
 }
 
+// RulesOrErr returns the Rules value or an error if the edge
+// was not loaded in eager-loading.
+func (e TagEdges) RulesOrErr() ([]*TagRule, error) {
+	if e.loadedTypes[5] {
+		return e.Rules, nil
+	}
+	return nil, &NotLoadedError{edge: "rules"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Tag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tag.FieldName:
+		case tag.FieldParentID, tag.FieldGroupID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case tag.FieldName, tag.FieldColor, tag.FieldIcon, tag.FieldDescription:
 			values[i] = new(sql.NullString)
 		case tag.FieldID, tag.FieldCompanyID:
 			values[i] = new(uuid.UUID)
@@ -95,12 +156,47 @@ func (_m *Tag) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.CompanyID = *value
 			}
+		case tag.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uuid.UUID)
+				*_m.ParentID = *value.S.(*uuid.UUID)
+			}
+		case tag.FieldGroupID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value.Valid {
+				_m.GroupID = new(uuid.UUID)
+				*_m.GroupID = *value.S.(*uuid.UUID)
+			}
 		case tag.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case tag.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = new(string)
+				*_m.Color = value.String
+			}
+		case tag.FieldIcon:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field icon", values[i])
+			} else if value.Valid {
+				_m.Icon = new(string)
+				*_m.Icon = value.String
+			}
+		case tag.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = new(string)
+				*_m.Description = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -119,11 +215,31 @@ func (_m *Tag) QueryCompany() *CompanyQuery {
 	return NewTagClient(_m.config).QueryCompany(_m)
 }
 
+// QueryParent queries the "parent" edge of the Tag entity.
+func (_m *Tag) QueryParent() *TagQuery {
+	return NewTagClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Tag entity.
+func (_m *Tag) QueryChildren() *TagQuery {
+	return NewTagClient(_m.config).QueryChildren(_m)
+}
+
+// QueryGroup queries the "group" edge of the Tag entity.
+func (_m *Tag) QueryGroup() *TagGroupQuery {
+	return NewTagClient(_m.config).QueryGroup(_m)
+}
+
 // QueryDocumentTags queries the "document_tags" edge of the Tag entity.
 func (_m *Tag) QueryDocumentTags() *DocumentTagQuery {
 	return NewTagClient(_m.config).QueryDocumentTags(_m)
 }
 
+// QueryRules queries the "rules" edge of the Tag entity.
+func (_m *Tag) QueryRules() *TagRuleQuery {
+	return NewTagClient(_m.config).QueryRules(_m)
+}
+
 // Update returns a builder for updating this Tag.
 // Note that you need to call Tag.Unwrap() before calling this method if this Tag
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -150,8 +266,33 @@ func (_m *Tag) String() string {
 	builder.WriteString("company_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CompanyID))
 	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.GroupID; v != nil {
+		builder.WriteString("group_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.Color; v != nil {
+		builder.WriteString("color=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Icon; v != nil {
+		builder.WriteString("icon=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
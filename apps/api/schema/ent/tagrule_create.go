@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/tag"
+	"techmind/schema/ent/tagrule"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TagRuleCreate is the builder for creating a TagRule entity.
+type TagRuleCreate struct {
+	config
+	mutation *TagRuleMutation
+	hooks    []Hook
+}
+
+// SetCompanyID sets the "company_id" field.
+func (_c *TagRuleCreate) SetCompanyID(v uuid.UUID) *TagRuleCreate {
+	_c.mutation.SetCompanyID(v)
+	return _c
+}
+
+// SetTagID sets the "tag_id" field.
+func (_c *TagRuleCreate) SetTagID(v uuid.UUID) *TagRuleCreate {
+	_c.mutation.SetTagID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TagRuleCreate) SetName(v string) *TagRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetConditions sets the "conditions" field.
+func (_c *TagRuleCreate) SetConditions(v types.TagRuleConditions) *TagRuleCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *TagRuleCreate) SetPriority(v int) *TagRuleCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillablePriority(v *int) *TagRuleCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetStop sets the "stop" field.
+func (_c *TagRuleCreate) SetStop(v bool) *TagRuleCreate {
+	_c.mutation.SetStop(v)
+	return _c
+}
+
+// SetNillableStop sets the "stop" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillableStop(v *bool) *TagRuleCreate {
+	if v != nil {
+		_c.SetStop(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *TagRuleCreate) SetEnabled(v bool) *TagRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillableEnabled(v *bool) *TagRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TagRuleCreate) SetCreatedAt(v time.Time) *TagRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillableCreatedAt(v *time.Time) *TagRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TagRuleCreate) SetUpdatedAt(v time.Time) *TagRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillableUpdatedAt(v *time.Time) *TagRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TagRuleCreate) SetID(v uuid.UUID) *TagRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TagRuleCreate) SetNillableID(v *uuid.UUID) *TagRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_c *TagRuleCreate) SetTag(v *Tag) *TagRuleCreate {
+	return _c.SetTagID(v.ID)
+}
+
+// Mutation returns the TagRuleMutation object of the builder.
+func (_c *TagRuleCreate) Mutation() *TagRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the TagRule in the database.
+func (_c *TagRuleCreate) Save(ctx context.Context) (*TagRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TagRuleCreate) SaveX(ctx context.Context) *TagRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TagRuleCreate) defaults() {
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := tagrule.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Stop(); !ok {
+		v := tagrule.DefaultStop
+		_c.mutation.SetStop(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := tagrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := tagrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := tagrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := tagrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TagRuleCreate) check() error {
+	if _, ok := _c.mutation.CompanyID(); !ok {
+		return &ValidationError{Name: "company_id", err: errors.New(`ent: missing required field "TagRule.company_id"`)}
+	}
+	if _, ok := _c.mutation.TagID(); !ok {
+		return &ValidationError{Name: "tag_id", err: errors.New(`ent: missing required field "TagRule.tag_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TagRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tagrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Conditions(); !ok {
+		return &ValidationError{Name: "conditions", err: errors.New(`ent: missing required field "TagRule.conditions"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "TagRule.priority"`)}
+	}
+	if _, ok := _c.mutation.Stop(); !ok {
+		return &ValidationError{Name: "stop", err: errors.New(`ent: missing required field "TagRule.stop"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "TagRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TagRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TagRule.updated_at"`)}
+	}
+	if len(_c.mutation.TagIDs()) == 0 {
+		return &ValidationError{Name: "tag", err: errors.New(`ent: missing required edge "TagRule.tag"`)}
+	}
+	return nil
+}
+
+func (_c *TagRuleCreate) sqlSave(ctx context.Context) (*TagRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TagRuleCreate) createSpec() (*TagRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TagRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tagrule.Table, sqlgraph.NewFieldSpec(tagrule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CompanyID(); ok {
+		_spec.SetField(tagrule.FieldCompanyID, field.TypeUUID, value)
+		_node.CompanyID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tagrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(tagrule.FieldConditions, field.TypeJSON, value)
+		_node.Conditions = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(tagrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Stop(); ok {
+		_spec.SetField(tagrule.FieldStop, field.TypeBool, value)
+		_node.Stop = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(tagrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tagrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagrule.TagTable,
+			Columns: []string{tagrule.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TagRuleCreateBulk is the builder for creating many TagRule entities in bulk.
+type TagRuleCreateBulk struct {
+	config
+	err      error
+	builders []*TagRuleCreate
+}
+
+// Save creates the TagRule entities in the database.
+func (_c *TagRuleCreateBulk) Save(ctx context.Context) ([]*TagRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TagRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TagRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TagRuleCreateBulk) SaveX(ctx context.Context) []*TagRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TagRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TagRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"techmind/schema/ent/predicate"
+	"techmind/schema/ent/tag"
+	"techmind/schema/ent/tagrule"
+	"techmind/schema/types"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TagRuleUpdate is the builder for updating TagRule entities.
+type TagRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TagRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TagRuleUpdate builder.
+func (_u *TagRuleUpdate) Where(ps ...predicate.TagRule) *TagRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagRuleUpdate) SetTagID(v uuid.UUID) *TagRuleUpdate {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillableTagID(v *uuid.UUID) *TagRuleUpdate {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagRuleUpdate) SetName(v string) *TagRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillableName(v *string) *TagRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *TagRuleUpdate) SetConditions(v types.TagRuleConditions) *TagRuleUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillableConditions(v *types.TagRuleConditions) *TagRuleUpdate {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TagRuleUpdate) SetPriority(v int) *TagRuleUpdate {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillablePriority(v *int) *TagRuleUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TagRuleUpdate) AddPriority(v int) *TagRuleUpdate {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetStop sets the "stop" field.
+func (_u *TagRuleUpdate) SetStop(v bool) *TagRuleUpdate {
+	_u.mutation.SetStop(v)
+	return _u
+}
+
+// SetNillableStop sets the "stop" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillableStop(v *bool) *TagRuleUpdate {
+	if v != nil {
+		_u.SetStop(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *TagRuleUpdate) SetEnabled(v bool) *TagRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TagRuleUpdate) SetNillableEnabled(v *bool) *TagRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TagRuleUpdate) SetUpdatedAt(v time.Time) *TagRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagRuleUpdate) SetTag(v *Tag) *TagRuleUpdate {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagRuleMutation object of the builder.
+func (_u *TagRuleUpdate) Mutation() *TagRuleMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagRuleUpdate) ClearTag() *TagRuleUpdate {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TagRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TagRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TagRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tagrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tagrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagRule.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagRule.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagRuleUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagrule.Table, tagrule.Columns, sqlgraph.NewFieldSpec(tagrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(tagrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(tagrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(tagrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Stop(); ok {
+		_spec.SetField(tagrule.FieldStop, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(tagrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagrule.TagTable,
+			Columns: []string{tagrule.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagrule.TagTable,
+			Columns: []string{tagrule.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TagRuleUpdateOne is the builder for updating a single TagRule entity.
+type TagRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TagRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTagID sets the "tag_id" field.
+func (_u *TagRuleUpdateOne) SetTagID(v uuid.UUID) *TagRuleUpdateOne {
+	_u.mutation.SetTagID(v)
+	return _u
+}
+
+// SetNillableTagID sets the "tag_id" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillableTagID(v *uuid.UUID) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetTagID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TagRuleUpdateOne) SetName(v string) *TagRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillableName(v *string) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *TagRuleUpdateOne) SetConditions(v types.TagRuleConditions) *TagRuleUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillableConditions(v *types.TagRuleConditions) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *TagRuleUpdateOne) SetPriority(v int) *TagRuleUpdateOne {
+	_u.mutation.ResetPriority()
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillablePriority(v *int) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// AddPriority adds value to the "priority" field.
+func (_u *TagRuleUpdateOne) AddPriority(v int) *TagRuleUpdateOne {
+	_u.mutation.AddPriority(v)
+	return _u
+}
+
+// SetStop sets the "stop" field.
+func (_u *TagRuleUpdateOne) SetStop(v bool) *TagRuleUpdateOne {
+	_u.mutation.SetStop(v)
+	return _u
+}
+
+// SetNillableStop sets the "stop" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillableStop(v *bool) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetStop(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *TagRuleUpdateOne) SetEnabled(v bool) *TagRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *TagRuleUpdateOne) SetNillableEnabled(v *bool) *TagRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TagRuleUpdateOne) SetUpdatedAt(v time.Time) *TagRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetTag sets the "tag" edge to the Tag entity.
+func (_u *TagRuleUpdateOne) SetTag(v *Tag) *TagRuleUpdateOne {
+	return _u.SetTagID(v.ID)
+}
+
+// Mutation returns the TagRuleMutation object of the builder.
+func (_u *TagRuleUpdateOne) Mutation() *TagRuleMutation {
+	return _u.mutation
+}
+
+// ClearTag clears the "tag" edge to the Tag entity.
+func (_u *TagRuleUpdateOne) ClearTag() *TagRuleUpdateOne {
+	_u.mutation.ClearTag()
+	return _u
+}
+
+// Where appends a list predicates to the TagRuleUpdate builder.
+func (_u *TagRuleUpdateOne) Where(ps ...predicate.TagRule) *TagRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TagRuleUpdateOne) Select(field string, fields ...string) *TagRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TagRule entity.
+func (_u *TagRuleUpdateOne) Save(ctx context.Context) (*TagRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TagRuleUpdateOne) SaveX(ctx context.Context) *TagRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TagRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TagRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TagRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := tagrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TagRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tagrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TagRule.name": %w`, err)}
+		}
+	}
+	if _u.mutation.TagCleared() && len(_u.mutation.TagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TagRule.tag"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TagRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TagRuleUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TagRuleUpdateOne) sqlSave(ctx context.Context) (_node *TagRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tagrule.Table, tagrule.Columns, sqlgraph.NewFieldSpec(tagrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TagRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tagrule.FieldID)
+		for _, f := range fields {
+			if !tagrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tagrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tagrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(tagrule.FieldConditions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(tagrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPriority(); ok {
+		_spec.AddField(tagrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Stop(); ok {
+		_spec.SetField(tagrule.FieldStop, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(tagrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tagrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.TagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagrule.TagTable,
+			Columns: []string{tagrule.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tagrule.TagTable,
+			Columns: []string{tagrule.TagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TagRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tagrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
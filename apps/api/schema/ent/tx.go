@@ -20,14 +20,34 @@ type Tx struct {
 	CompanyUser *CompanyUserClient
 	// Document is the client for interacting with the Document builders.
 	Document *DocumentClient
+	// DocumentClassifier is the client for interacting with the DocumentClassifier builders.
+	DocumentClassifier *DocumentClassifierClient
+	// DocumentContent is the client for interacting with the DocumentContent builders.
+	DocumentContent *DocumentContentClient
 	// DocumentTag is the client for interacting with the DocumentTag builders.
 	DocumentTag *DocumentTagClient
+	// DocumentTagSuppression is the client for interacting with the DocumentTagSuppression builders.
+	DocumentTagSuppression *DocumentTagSuppressionClient
+	// DocumentType is the client for interacting with the DocumentType builders.
+	DocumentType *DocumentTypeClient
 	// Folder is the client for interacting with the Folder builders.
 	Folder *FolderClient
+	// Job is the client for interacting with the Job builders.
+	Job *JobClient
+	// SavedSearch is the client for interacting with the SavedSearch builders.
+	SavedSearch *SavedSearchClient
+	// SearchIndexTask is the client for interacting with the SearchIndexTask builders.
+	SearchIndexTask *SearchIndexTaskClient
+	// SearchLog is the client for interacting with the SearchLog builders.
+	SearchLog *SearchLogClient
 	// Sender is the client for interacting with the Sender builders.
 	Sender *SenderClient
 	// Tag is the client for interacting with the Tag builders.
 	Tag *TagClient
+	// TagGroup is the client for interacting with the TagGroup builders.
+	TagGroup *TagGroupClient
+	// TagRule is the client for interacting with the TagRule builders.
+	TagRule *TagRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -164,10 +184,20 @@ func (tx *Tx) init() {
 	tx.Company = NewCompanyClient(tx.config)
 	tx.CompanyUser = NewCompanyUserClient(tx.config)
 	tx.Document = NewDocumentClient(tx.config)
+	tx.DocumentClassifier = NewDocumentClassifierClient(tx.config)
+	tx.DocumentContent = NewDocumentContentClient(tx.config)
 	tx.DocumentTag = NewDocumentTagClient(tx.config)
+	tx.DocumentTagSuppression = NewDocumentTagSuppressionClient(tx.config)
+	tx.DocumentType = NewDocumentTypeClient(tx.config)
 	tx.Folder = NewFolderClient(tx.config)
+	tx.Job = NewJobClient(tx.config)
+	tx.SavedSearch = NewSavedSearchClient(tx.config)
+	tx.SearchIndexTask = NewSearchIndexTaskClient(tx.config)
+	tx.SearchLog = NewSearchLogClient(tx.config)
 	tx.Sender = NewSenderClient(tx.config)
 	tx.Tag = NewTagClient(tx.config)
+	tx.TagGroup = NewTagGroupClient(tx.config)
+	tx.TagRule = NewTagRuleClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
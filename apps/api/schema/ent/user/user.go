@@ -19,6 +19,8 @@ const (
 	FieldEmail = "email"
 	// FieldPassword holds the string denoting the password field in the database.
 	FieldPassword = "password"
+	// FieldStoreSearchQueries holds the string denoting the store_search_queries field in the database.
+	FieldStoreSearchQueries = "store_search_queries"
 	// EdgeCompanyUsers holds the string denoting the company_users edge name in mutations.
 	EdgeCompanyUsers = "company_users"
 	// EdgeCreatedDocuments holds the string denoting the created_documents edge name in mutations.
@@ -56,6 +58,7 @@ var Columns = []string{
 	FieldName,
 	FieldEmail,
 	FieldPassword,
+	FieldStoreSearchQueries,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -75,6 +78,8 @@ var (
 	EmailValidator func(string) error
 	// PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	PasswordValidator func(string) error
+	// DefaultStoreSearchQueries holds the default value on creation for the "store_search_queries" field.
+	DefaultStoreSearchQueries bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -102,6 +107,11 @@ func ByPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPassword, opts...).ToFunc()
 }
 
+// ByStoreSearchQueries orders the results by the store_search_queries field.
+func ByStoreSearchQueries(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoreSearchQueries, opts...).ToFunc()
+}
+
 // ByCompanyUsersCount orders the results by company_users count.
 func ByCompanyUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
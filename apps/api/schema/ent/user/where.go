@@ -70,6 +70,11 @@ func Password(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldPassword, v))
 }
 
+// StoreSearchQueries applies equality check predicate on the "store_search_queries" field. It's identical to StoreSearchQueriesEQ.
+func StoreSearchQueries(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStoreSearchQueries, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldName, v))
@@ -265,6 +270,16 @@ func PasswordContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldPassword, v))
 }
 
+// StoreSearchQueriesEQ applies the EQ predicate on the "store_search_queries" field.
+func StoreSearchQueriesEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldStoreSearchQueries, v))
+}
+
+// StoreSearchQueriesNEQ applies the NEQ predicate on the "store_search_queries" field.
+func StoreSearchQueriesNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldStoreSearchQueries, v))
+}
+
 // HasCompanyUsers applies the HasEdge predicate on the "company_users" edge.
 func HasCompanyUsers() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -40,6 +40,20 @@ func (_c *UserCreate) SetPassword(v string) *UserCreate {
 	return _c
 }
 
+// SetStoreSearchQueries sets the "store_search_queries" field.
+func (_c *UserCreate) SetStoreSearchQueries(v bool) *UserCreate {
+	_c.mutation.SetStoreSearchQueries(v)
+	return _c
+}
+
+// SetNillableStoreSearchQueries sets the "store_search_queries" field if the given value is not nil.
+func (_c *UserCreate) SetNillableStoreSearchQueries(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetStoreSearchQueries(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -134,6 +148,10 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.StoreSearchQueries(); !ok {
+		v := user.DefaultStoreSearchQueries
+		_c.mutation.SetStoreSearchQueries(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := user.DefaultID()
 		_c.mutation.SetID(v)
@@ -166,6 +184,9 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "password", err: fmt.Errorf(`ent: validator failed for field "User.password": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.StoreSearchQueries(); !ok {
+		return &ValidationError{Name: "store_search_queries", err: errors.New(`ent: missing required field "User.store_search_queries"`)}
+	}
 	return nil
 }
 
@@ -213,6 +234,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 		_node.Password = value
 	}
+	if value, ok := _c.mutation.StoreSearchQueries(); ok {
+		_spec.SetField(user.FieldStoreSearchQueries, field.TypeBool, value)
+		_node.StoreSearchQueries = value
+	}
 	if nodes := _c.mutation.CompanyUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -73,6 +73,20 @@ func (_u *UserUpdate) SetNillablePassword(v *string) *UserUpdate {
 	return _u
 }
 
+// SetStoreSearchQueries sets the "store_search_queries" field.
+func (_u *UserUpdate) SetStoreSearchQueries(v bool) *UserUpdate {
+	_u.mutation.SetStoreSearchQueries(v)
+	return _u
+}
+
+// SetNillableStoreSearchQueries sets the "store_search_queries" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableStoreSearchQueries(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetStoreSearchQueries(*v)
+	}
+	return _u
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by IDs.
 func (_u *UserUpdate) AddCompanyUserIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddCompanyUserIDs(ids...)
@@ -260,6 +274,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.StoreSearchQueries(); ok {
+		_spec.SetField(user.FieldStoreSearchQueries, field.TypeBool, value)
+	}
 	if _u.mutation.CompanyUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -459,6 +476,20 @@ func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetStoreSearchQueries sets the "store_search_queries" field.
+func (_u *UserUpdateOne) SetStoreSearchQueries(v bool) *UserUpdateOne {
+	_u.mutation.SetStoreSearchQueries(v)
+	return _u
+}
+
+// SetNillableStoreSearchQueries sets the "store_search_queries" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableStoreSearchQueries(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetStoreSearchQueries(*v)
+	}
+	return _u
+}
+
 // AddCompanyUserIDs adds the "company_users" edge to the CompanyUser entity by IDs.
 func (_u *UserUpdateOne) AddCompanyUserIDs(ids ...uuid.UUID) *UserUpdateOne {
 	_u.mutation.AddCompanyUserIDs(ids...)
@@ -676,6 +707,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Password(); ok {
 		_spec.SetField(user.FieldPassword, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.StoreSearchQueries(); ok {
+		_spec.SetField(user.FieldStoreSearchQueries, field.TypeBool, value)
+	}
 	if _u.mutation.CompanyUsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Job holds the schema definition for the Job entity.
+type Job struct {
+	ent.Schema
+}
+
+// Fields of the Job.
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("type").
+			NotEmpty(),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+		field.JSON("payload", map[string]interface{}{}).
+			Optional(),
+		field.JSON("result_document_ids", []uuid.UUID{}).
+			Optional(),
+		field.String("error").
+			Optional().
+			Nillable(),
+		field.Int("progress").
+			Default(0).
+			NonNegative(),
+		field.Int("total").
+			Default(0).
+			NonNegative(),
+		field.UUID("created_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Job.
+func (Job) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("company", Company.Type).
+			Ref("jobs").
+			Field("company_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"techmind/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SavedSearch holds the schema definition for the SavedSearch entity.
+type SavedSearch struct {
+	ent.Schema
+}
+
+// Fields of the SavedSearch.
+func (SavedSearch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		field.String("query").
+			Default(""),
+		field.JSON("filters", types.SearchFilters{}).
+			Optional(),
+		field.String("sort").
+			Default(""),
+		// shared - поиск виден всем пользователям компании
+		field.Bool("shared").
+			Default(false),
+		// pinned - поиск показывается в дереве папок как умная папка
+		field.Bool("pinned").
+			Default(false),
+		field.UUID("parent_folder_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SavedSearch.
+func (SavedSearch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("company", Company.Type).
+			Ref("saved_searches").
+			Field("company_id").
+			Required().
+			Unique(),
+	}
+}
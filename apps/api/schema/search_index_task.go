@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SearchIndexTask holds the schema definition for the SearchIndexTask entity.
+// Outbox of pending search index changes, written by ent hooks together with the mutation.
+type SearchIndexTask struct {
+	ent.Schema
+}
+
+// Fields of the SearchIndexTask.
+func (SearchIndexTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// No foreign key: the task must outlive the deleted document
+		field.UUID("document_id", uuid.UUID{}).
+			Immutable(),
+		field.Enum("operation").
+			Values("index", "update", "delete").
+			Immutable(),
+		field.Int("attempts").
+			Default(0).
+			NonNegative(),
+		field.String("last_error").
+			Optional().
+			Nillable(),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
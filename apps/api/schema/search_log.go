@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"techmind/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SearchLog holds the schema definition for the SearchLog entity.
+// One record per executed search, used for search analytics.
+type SearchLog struct {
+	ent.Schema
+}
+
+// Fields of the SearchLog.
+func (SearchLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable(),
+		// query - текст запроса, nil - пользователь отказался от хранения запросов
+		// или текст удален по истечении срока хранения
+		field.String("query").
+			Optional().
+			Nillable(),
+		field.JSON("filters", types.SearchFilters{}).
+			Optional(),
+		field.String("mode").
+			Default(""),
+		field.String("sort").
+			Default(""),
+		field.Int64("result_count").
+			Default(0).
+			NonNegative(),
+		field.Int64("latency_ms").
+			Default(0).
+			NonNegative(),
+		// clicked_* - первый открытый из выдачи документ, clicks - число переходов по результатам
+		field.UUID("clicked_document_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Int("clicked_position").
+			Optional().
+			Nillable(),
+		field.Time("clicked_at").
+			Optional().
+			Nillable(),
+		field.Int("clicks").
+			Default(0).
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -21,9 +23,38 @@ func (Sender) Fields() []ent.Field {
 		field.UUID("company_id", uuid.UUID{}),
 		field.String("name").
 			NotEmpty(),
+		// email - основной адрес, emails - дополнительные адреса
 		field.String("email").
 			Optional().
 			Nillable(),
+		field.String("legal_name").
+			Optional().
+			Nillable(),
+		// inn - ИНН из 10 (организация) или 12 (ИП) цифр, kpp - КПП организации
+		field.String("inn").
+			Optional().
+			Nillable(),
+		field.String("kpp").
+			Optional().
+			Nillable(),
+		field.Strings("emails").
+			Optional(),
+		field.Strings("phones").
+			Optional(),
+		field.Strings("addresses").
+			Optional(),
+		field.String("website").
+			Optional().
+			Nillable(),
+		field.Text("notes").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
 	}
 }
 
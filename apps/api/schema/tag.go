@@ -19,8 +19,26 @@ func (Tag) Fields() []ent.Field {
 			Default(uuid.New).
 			Immutable(),
 		field.UUID("company_id", uuid.UUID{}),
+		// parent_id - родительский тег, теги образуют дерево (Финансы/Счета/Оплачено)
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		// group_id - группа тегов, в исключающей группе у документа может быть только один тег
+		field.UUID("group_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
 		field.String("name").
 			NotEmpty(),
+		// color - цвет тега в формате #RRGGBB
+		field.String("color").
+			Optional().
+			Nillable(),
+		field.String("icon").
+			Optional().
+			Nillable(),
+		field.String("description").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -32,6 +50,15 @@ func (Tag) Edges() []ent.Edge {
 			Field("company_id").
 			Required().
 			Unique(),
+		edge.To("children", Tag.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.From("group", TagGroup.Type).
+			Ref("tags").
+			Field("group_id").
+			Unique(),
 		edge.To("document_tags", DocumentTag.Type),
+		edge.To("rules", TagRule.Type),
 	}
 }
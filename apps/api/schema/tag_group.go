@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TagGroup holds the schema definition for the TagGroup entity.
+type TagGroup struct {
+	ent.Schema
+}
+
+// Fields of the TagGroup.
+func (TagGroup) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty(),
+		// exclusive - у документа может быть только один тег группы (Статус: Черновик/Согласован/В архиве)
+		field.Bool("exclusive").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the TagGroup.
+func (TagGroup) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("company", Company.Type).
+			Ref("tag_groups").
+			Field("company_id").
+			Required().
+			Unique().
+			Immutable(),
+		edge.To("tags", Tag.Type),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"techmind/schema/types"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TagRule holds the schema definition for the TagRule entity.
+// Rule of automatic tagging: assigns the tag to documents matching the conditions.
+type TagRule struct {
+	ent.Schema
+}
+
+// Fields of the TagRule.
+func (TagRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("company_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("tag_id", uuid.UUID{}),
+		field.String("name").
+			NotEmpty(),
+		field.JSON("conditions", types.TagRuleConditions{}),
+		// priority - правила проверяются по убыванию приоритета
+		field.Int("priority").
+			Default(0),
+		// stop - после срабатывания правила следующие правила не проверяются
+		field.Bool("stop").
+			Default(false),
+		field.Bool("enabled").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TagRule.
+func (TagRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tag", Tag.Type).
+			Ref("rules").
+			Field("tag_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClassifierModel - параметры модели классификации документов по типам:
+// наивный байесовский классификатор над весами TF-IDF основ слов
+type ClassifierModel struct {
+	// Terms - словарь основ слов, индекс основы - номер признака
+	Terms []string `json:"terms"`
+	// IDF - обратная документная частота каждой основы словаря
+	IDF     []float64         `json:"idf"`
+	Classes []ClassifierClass `json:"classes"`
+}
+
+// ClassifierClass - параметры модели для одного типа документа
+type ClassifierClass struct {
+	TypeID uuid.UUID `json:"type_id"`
+	// Documents - число обучающих документов типа
+	Documents int     `json:"documents"`
+	LogPrior  float64 `json:"log_prior"`
+	// LogLikelihood - логарифм вероятности каждой основы словаря в документах типа
+	LogLikelihood []float64 `json:"log_likelihood"`
+}
+
+// ClassifierEvaluation - качество модели на перекрестной проверке
+type ClassifierEvaluation struct {
+	// Folds - число частей, на которые делились обучающие документы
+	Folds     int `json:"folds"`
+	Documents int `json:"documents"`
+	// Accuracy - доля документов, тип которых предсказан верно
+	Accuracy float64           `json:"accuracy"`
+	Classes  []ClassEvaluation `json:"classes"`
+}
+
+// ClassEvaluation - качество модели для одного типа документа
+type ClassEvaluation struct {
+	TypeID uuid.UUID `json:"type_id"`
+	// Support - число документов типа, Correct - из них предсказано верно
+	Support int `json:"support"`
+	Correct int `json:"correct"`
+	// Recall - полнота: доля документов типа, предсказанных верно
+	Recall float64 `json:"recall"`
+	// Precision - доля верных среди документов, отнесенных моделью к типу
+	Precision float64 `json:"precision"`
+	F1        float64 `json:"f1"`
+}
+
+// DocumentClassification содержит предсказание типа документа по его тексту
+type DocumentClassification struct {
+	// Predictions - типы по убыванию уверенности
+	Predictions []ClassPrediction `json:"predictions"`
+	// AppliedTypeID - тип, присвоенный документу классификатором
+	AppliedTypeID *uuid.UUID `json:"applied_type_id,omitempty"`
+	// TrainedAt - время обучения модели, которой получено предсказание
+	TrainedAt    time.Time `json:"trained_at"`
+	ClassifiedAt time.Time `json:"classified_at"`
+}
+
+// ClassPrediction - уверенность модели в типе документа
+type ClassPrediction struct {
+	TypeID uuid.UUID `json:"type_id"`
+	// Confidence - вероятность типа от 0 до 1
+	Confidence float64 `json:"confidence"`
+}
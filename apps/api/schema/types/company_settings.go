@@ -0,0 +1,33 @@
+package types
+
+// CompanySettings содержит настройки компании
+type CompanySettings struct {
+	// Watermark - шаблоны водяного знака и штампа для скачивания документов
+	Watermark *WatermarkSettings `json:"watermark,omitempty"`
+	// Requisites - реквизиты самой компании, они не предлагаются как новые контрагенты
+	Requisites *CompanyRequisites `json:"requisites,omitempty"`
+}
+
+// CompanyRequisites - юридическое название, ИНН и КПП компании
+type CompanyRequisites struct {
+	LegalName string `json:"legal_name,omitempty"`
+	INN       string `json:"inn,omitempty"`
+	KPP       string `json:"kpp,omitempty"`
+}
+
+// WatermarkSettings описывает водяной знак и штамп, накладываемые на копию документа при скачивании.
+// Шаблоны используют синтаксис text/template, доступны поля
+// .UserEmail, .UserName, .DocumentID, .DocumentName, .CompanyName и .Timestamp.
+// В штампе также работают подстановки %p (номер страницы) и %P (число страниц)
+type WatermarkSettings struct {
+	// Template - текст водяного знака, пусто - водяной знак не накладывается
+	Template string `json:"template,omitempty"`
+	// Options - параметры отрисовки водяного знака (opacity, rotation, points, color...)
+	Options map[string]interface{} `json:"options,omitempty"`
+	// StampTemplate - текст штампа в колонтитуле, пусто - штамп не накладывается
+	StampTemplate string `json:"stamp_template,omitempty"`
+	// StampPages - страницы для штампа (например "1-3,5"), пусто - все страницы
+	StampPages string `json:"stamp_pages,omitempty"`
+	// StampOptions - параметры отрисовки штампа (position, offset, points...)
+	StampOptions map[string]interface{} `json:"stamp_options,omitempty"`
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DocumentMetadata содержит структурированные метаданные документа,
+// полученные при обработке файла
+type DocumentMetadata struct {
+	// PDF - метаданные, прочитанные из PDF файла
+	PDF *PDFMetadata `json:"pdf,omitempty"`
+	// OCR - результат распознавания текста для сканов
+	OCR *OCRMetadata `json:"ocr,omitempty"`
+	// SenderDetection - результат определения контрагента по тексту и метаданным
+	SenderDetection *SenderDetection `json:"sender_detection,omitempty"`
+	// FieldExtraction - значения полей типа документа, найденные в тексте по шаблону типа
+	FieldExtraction *FieldExtraction `json:"field_extraction,omitempty"`
+	// Classification - предсказание типа документа моделью компании
+	Classification *DocumentClassification `json:"classification,omitempty"`
+}
+
+// PDFMetadata содержит стандартные поля информационного словаря PDF
+type PDFMetadata struct {
+	Title        string     `json:"title,omitempty"`
+	Author       string     `json:"author,omitempty"`
+	Subject      string     `json:"subject,omitempty"`
+	Keywords     []string   `json:"keywords,omitempty"`
+	CreationDate *time.Time `json:"creation_date,omitempty"`
+	Producer     string     `json:"producer,omitempty"`
+	ReadAt       time.Time  `json:"read_at"`
+}
+
+// OCRMetadata содержит сведения о распознавании текста скана
+type OCRMetadata struct {
+	Engine    string `json:"engine"`
+	Version   string `json:"version,omitempty"`
+	Languages string `json:"languages,omitempty"`
+	// Confidence - средняя уверенность распознавания слов, от 0 до 100
+	Confidence float64         `json:"confidence"`
+	Pages      []OCRPageResult `json:"pages,omitempty"`
+	// SearchablePDFPath - путь к PDF с текстовым слоем в MinIO, если он был построен
+	SearchablePDFPath string    `json:"searchable_pdf_path,omitempty"`
+	ProcessedAt       time.Time `json:"processed_at"`
+}
+
+// OCRPageResult содержит уверенность распознавания одной страницы
+type OCRPageResult struct {
+	Number     int     `json:"number"`
+	Confidence float64 `json:"confidence"`
+}
+
+// SenderDetection содержит контрагентов, найденных в документе
+type SenderDetection struct {
+	// SenderID - контрагент, присвоенный документу автоматически
+	SenderID *uuid.UUID `json:"sender_id,omitempty"`
+	// Confidence - уверенность в присвоенном контрагенте от 0 до 1
+	Confidence float64 `json:"confidence,omitempty"`
+	// Candidates - известные контрагенты, признаки которых найдены в документе
+	Candidates []SenderCandidate `json:"candidates,omitempty"`
+	// Proposals - новые контрагенты, ожидающие подтверждения пользователем
+	Proposals  []SenderProposal `json:"proposals,omitempty"`
+	DetectedAt time.Time        `json:"detected_at"`
+}
+
+// SenderCandidate - известный контрагент, найденный в документе
+type SenderCandidate struct {
+	SenderID   uuid.UUID `json:"sender_id"`
+	Confidence float64   `json:"confidence"`
+	// Reasons - совпавшие признаки: inn and kpp, inn, sender email, email, domain, legal name, name
+	Reasons []string `json:"reasons"`
+}
+
+// SenderProposal - реквизиты организации из документа, которой нет в справочнике контрагентов
+type SenderProposal struct {
+	Name      string   `json:"name"`
+	LegalName string   `json:"legal_name,omitempty"`
+	INN       string   `json:"inn,omitempty"`
+	KPP       string   `json:"kpp,omitempty"`
+	Emails    []string `json:"emails,omitempty"`
+	Website   string   `json:"website,omitempty"`
+}
+
+// Состояния извлеченного значения поля
+const (
+	// ExtractionApplied - значение уверенно найдено и записано в документ
+	ExtractionApplied = "applied"
+	// ExtractionPending - значение ждет проверки пользователем
+	ExtractionPending = "pending"
+	// ExtractionConfirmed - значение подтверждено пользователем
+	ExtractionConfirmed = "confirmed"
+	// ExtractionRejected - значение отклонено пользователем
+	ExtractionRejected = "rejected"
+)
+
+// FieldExtraction содержит значения полей, найденные в тексте документа
+type FieldExtraction struct {
+	// TypeID - тип документа, по шаблону которого найдены значения
+	TypeID uuid.UUID        `json:"type_id"`
+	Fields []ExtractedField `json:"fields"`
+	// Pending - число значений, ожидающих проверки
+	Pending     int       `json:"pending"`
+	ExtractedAt time.Time `json:"extracted_at"`
+}
+
+// ExtractedField - значение поля, найденное в тексте документа
+type ExtractedField struct {
+	Key   string     `json:"key"`
+	Value FieldValue `json:"value"`
+	// Text - фрагмент текста, из которого прочитано значение
+	Text string `json:"text"`
+	// Confidence - уверенность в значении от 0 до 1
+	Confidence float64 `json:"confidence"`
+	// Status - одно из значений Extraction*
+	Status string `json:"status"`
+}
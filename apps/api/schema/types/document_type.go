@@ -0,0 +1,104 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Типы полей типа документа
+const (
+	FieldTypeString = "string"
+	FieldTypeNumber = "number"
+	FieldTypeDate   = "date"
+	FieldTypeEnum   = "enum"
+	FieldTypeMoney  = "money"
+	// FieldTypeSender - ссылка на контрагента компании
+	FieldTypeSender = "sender"
+)
+
+// DateLayout - формат значения поля с типом date
+const DateLayout = "2006-01-02"
+
+// DocumentField описывает поле в схеме типа документа
+type DocumentField struct {
+	// Key - ключ значения в документе и в фильтрах поиска, например invoice_number
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	// Type - одно из значений FieldType*
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+	// Options - допустимые значения поля с типом enum
+	Options []string `json:"options,omitempty"`
+	// Currency - валюта по умолчанию для поля с типом money, например RUB
+	Currency string `json:"currency,omitempty"`
+}
+
+// FieldValue - значение поля документа, заполнены только части, соответствующие типу поля:
+// String для string и enum, Number для number, Date для date, Number и Currency для money, SenderID для sender
+type FieldValue struct {
+	String   *string    `json:"string,omitempty"`
+	Number   *float64   `json:"number,omitempty"`
+	Date     *time.Time `json:"date,omitempty"`
+	Currency string     `json:"currency,omitempty"`
+	SenderID *uuid.UUID `json:"sender_id,omitempty"`
+}
+
+// MoneyValue - значение поля с типом money в API
+type MoneyValue struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// Value возвращает значение в том виде, в котором оно передается в API:
+// строку, число, дату в формате DateLayout, MoneyValue или ID контрагента
+func (v FieldValue) Value() interface{} {
+	switch {
+	case v.SenderID != nil:
+		return v.SenderID.String()
+	case v.Date != nil:
+		return v.Date.Format(DateLayout)
+	case v.Number != nil && v.Currency != "":
+		return MoneyValue{Amount: *v.Number, Currency: v.Currency}
+	case v.Number != nil:
+		return *v.Number
+	case v.String != nil:
+		return *v.String
+	}
+	return nil
+}
+
+// DefaultReviewThreshold - уверенность, ниже которой извлеченное значение поля ждет проверки пользователем
+const DefaultReviewThreshold = 0.8
+
+// Способы поиска значения поля в тексте документа
+const (
+	// LocatorRegex - регулярное выражение, группы которого по порядку заполняют поля
+	LocatorRegex = "regex"
+	// LocatorAnchor - значение стоит в той же строке после текста-якоря, например "Итого:"
+	LocatorAnchor = "anchor"
+)
+
+// ExtractionTemplate - шаблон извлечения значений полей типа документа из текста
+type ExtractionTemplate struct {
+	Locators []FieldLocator `json:"locators,omitempty"`
+	// ReviewThreshold - значения с меньшей уверенностью не записываются в документ, а ждут проверки,
+	// 0 - DefaultReviewThreshold
+	ReviewThreshold float64 `json:"review_threshold,omitempty"`
+}
+
+// FieldLocator описывает, где в тексте искать значения полей
+type FieldLocator struct {
+	// Kind - LocatorRegex или LocatorAnchor
+	Kind string `json:"kind"`
+	// Pattern - регулярное выражение без учета регистра, например "Счет № (\d+) от (date)"
+	// Макросы (date), (amount) и (number) заменяются группами для даты, суммы с валютой и числа
+	Pattern string `json:"pattern,omitempty"`
+	// Anchor - текст перед значением, например "Итого:"
+	Anchor string `json:"anchor,omitempty"`
+	// Fields - ключи полей для групп Pattern по порядку, пустой ключ пропускает группу;
+	// для якоря - одно поле
+	Fields []string `json:"fields"`
+	// Confidence - уверенность в найденном значении от 0 до 1, 0 - по умолчанию для способа поиска
+	Confidence float64 `json:"confidence,omitempty"`
+}
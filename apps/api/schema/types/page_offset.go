@@ -0,0 +1,9 @@
+package types
+
+// PageOffset задает границы страницы в извлеченном тексте документа.
+// Start и End - смещения в байтах UTF-8, End не включается
+type PageOffset struct {
+	Number int `json:"number"`
+	Start  int `json:"start"`
+	End    int `json:"end"`
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchFilters - фильтры сохраненного поиска, повторяют параметры поиска документов
+type SearchFilters struct {
+	FolderID *uuid.UUID `json:"folder_id,omitempty"`
+	// TagsAll, TagsAny и TagsNone - условия по тегам: все, хотя бы один, ни одного
+	TagsAll   []uuid.UUID `json:"tags_all,omitempty"`
+	TagsAny   []uuid.UUID `json:"tags_any,omitempty"`
+	TagsNone  []uuid.UUID `json:"tags_none,omitempty"`
+	MimeTypes []string    `json:"mime_types,omitempty"`
+	SenderIDs []uuid.UUID `json:"sender_ids,omitempty"`
+	CreatedBy []uuid.UUID `json:"created_by,omitempty"`
+	// Абсолютные даты; для скользящих периодов используется запрос, например created:this_quarter
+	CreatedFrom *time.Time `json:"created_from,omitempty"`
+	CreatedTo   *time.Time `json:"created_to,omitempty"`
+	UpdatedFrom *time.Time `json:"updated_from,omitempty"`
+	UpdatedTo   *time.Time `json:"updated_to,omitempty"`
+	SizeMin     *int64     `json:"size_min,omitempty"`
+	SizeMax     *int64     `json:"size_max,omitempty"`
+	// TypeIDs - типы документов, Fields - условия по значениям полей типа
+	TypeIDs []uuid.UUID   `json:"type_ids,omitempty"`
+	Fields  []FieldFilter `json:"fields,omitempty"`
+}
+
+// FieldFilter - условие по значению поля типа документа, заданные части условия объединяются через И
+type FieldFilter struct {
+	Key string `json:"key"`
+	// Values - значение строки, перечисления или ID контрагента совпадает с любым из значений
+	Values []string `json:"values,omitempty"`
+	// Min и Max - границы числа или суммы, From и To - границы даты, границы включаются
+	Min  *float64   `json:"min,omitempty"`
+	Max  *float64   `json:"max,omitempty"`
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
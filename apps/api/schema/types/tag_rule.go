@@ -0,0 +1,34 @@
+package types
+
+import "github.com/google/uuid"
+
+// Способы сравнения ключевых слов правила тегирования с текстом
+const (
+	// TagRuleMatchWord - слово или фраза целиком, без учета регистра
+	TagRuleMatchWord = "word"
+	// TagRuleMatchStem - слово или фраза в любой форме, слова сравниваются по основам
+	TagRuleMatchStem = "stem"
+	// TagRuleMatchSubstring - подстрока без учета регистра
+	TagRuleMatchSubstring = "substring"
+)
+
+// TagRuleConditions - условия правила автоматического тегирования
+// Правило срабатывает, если выполнены все заданные условия; пустые условия не проверяются
+type TagRuleConditions struct {
+	// Keywords - слова и фразы, которые ищутся в названии и тексте документа
+	Keywords []string `json:"keywords,omitempty"`
+	// KeywordsMode - any (по умолчанию): достаточно одного ключевого слова, all - нужны все
+	KeywordsMode string `json:"keywords_mode,omitempty"`
+	// Match - способ сравнения ключевых слов, одно из значений TagRuleMatch*, по умолчанию word
+	Match string `json:"match,omitempty"`
+	// Patterns - регулярные выражения (синтаксис RE2), достаточно совпадения одного
+	Patterns []string `json:"patterns,omitempty"`
+	// SenderIDs - документ от одного из контрагентов
+	SenderIDs []uuid.UUID `json:"sender_ids,omitempty"`
+	// MimeTypes - MIME тип документа, поддерживаются шаблоны вида image/*
+	MimeTypes []string `json:"mime_types,omitempty"`
+	// FolderIDs - документ лежит в одной из папок или в ее подпапках
+	FolderIDs []uuid.UUID `json:"folder_ids,omitempty"`
+	// FilenamePatterns - шаблоны названия файла вида *счет*.pdf, без учета регистра
+	FilenamePatterns []string `json:"filename_patterns,omitempty"`
+}
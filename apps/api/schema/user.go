@@ -26,6 +26,9 @@ func (User) Fields() []ent.Field {
 		field.String("password").
 			NotEmpty().
 			Sensitive(),
+		// store_search_queries - сохранять текст поисковых запросов пользователя в аналитике поиска
+		field.Bool("store_search_queries").
+			Default(true),
 	}
 }
 